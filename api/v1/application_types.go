@@ -0,0 +1,145 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the desired states of a system application.
+const (
+	// ApplicationStateApplied indicates that the application is uploaded and
+	// applied to the system.
+	ApplicationStateApplied = "applied"
+
+	// ApplicationStateUploaded indicates that the application is uploaded to
+	// the system but not applied.
+	ApplicationStateUploaded = "uploaded"
+)
+
+// HelmOverride defines the user overrides of a single chart of a system
+// application.
+type HelmOverride struct {
+	// Chart defines the name of the chart.
+	Chart string `json:"chart"`
+
+	// Namespace defines the namespace in which the chart is deployed.
+	Namespace string `json:"namespace"`
+
+	// Values defines the user overrides of the chart as a YAML document.
+	// They replace any user overrides previously set on the chart; an empty
+	// document clears them.
+	// +optional
+	Values *string `json:"values,omitempty"`
+
+	// Enabled defines whether the chart is deployed when the application is
+	// applied.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ApplicationSpec defines the desired state of Application
+type ApplicationSpec struct {
+	// Source defines the location of the application tarball.  Both http(s)
+	// URLs and OCI artifact references (e.g., oci://registry/repo:tag) are
+	// supported.
+	// +kubebuilder:validation:Pattern=`^(https?|oci)://.+$`
+	Source string `json:"source"`
+
+	// Version defines the application version expected to be found in the
+	// tarball.  When an applied application reports a different version it
+	// is updated from the source.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// State defines whether the application is only uploaded or also applied
+	// to the system.
+	// +kubebuilder:validation:Enum=applied;uploaded
+	// +kubebuilder:default:=applied
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// HelmOverrides defines the user overrides of the charts of the
+	// application.  The application is re-applied whenever they are
+	// modified.  Charts which are not listed are left untouched.
+	// +listType=map
+	// +listMapKey=chart
+	// +listMapKey=namespace
+	// +optional
+	HelmOverrides []HelmOverride `json:"helmOverrides,omitempty"`
+}
+
+// ApplicationStatus defines the observed state of Application
+type ApplicationStatus struct {
+	// Status defines the last known status of the application on the target
+	// system (e.g., uploaded, applying, applied, apply-failed).
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// Version defines the last known version of the application on the
+	// target system.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Progress defines the progress of the last operation reported by the
+	// target system.
+	// +optional
+	Progress string `json:"progress,omitempty"`
+
+	// Reconciled defines whether the application has reached its desired
+	// state for the current configuration generation.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the resource has been provisioned on the target system.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the application.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// Application defines the attributes that represent a system application
+// (e.g., cert-manager, ptp-notification) which is uploaded and applied to the
+// system.  The name of the resource is the name of the application.  This is
+// a composition of the following StarlingX API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/config/api-ref-sysinv-v1-config.html#applications
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="state",type="string",JSONPath=".spec.state",description="The desired application state."
+// +kubebuilder:printcolumn:name="status",type="string",JSONPath=".status.status",description="The current application status."
+// +kubebuilder:printcolumn:name="version",type="string",JSONPath=".status.version",description="The current application version."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type Application struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationSpec   `json:"spec,omitempty"`
+	Status ApplicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// ApplicationList contains a list of Application
+// +deepequal-gen=false
+type ApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Application `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Application{}, &ApplicationList{})
+}
@@ -0,0 +1,97 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/ghodss/yaml"
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Webhook response reasons
+const ApplicationAllowedReason string = "allowed to be admitted"
+
+// log is for logging in this package.
+var applicationlog = logf.Log.WithName("application-resource")
+
+func (r *Application) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-starlingx-windriver-com-v1-application,mutating=true,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=applications,verbs=create;update,versions=v1,name=mapplication.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Application{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Application) Default() {
+	applicationlog.Info("default", "name", r.Name)
+}
+
+// Validates an incoming resource update/create request.  OCI sources must
+// reference a specific tag or digest so that the content is reproducible and
+// chart overrides must be YAML documents.
+func (r *Application) validateApplication() error {
+	source, err := url.Parse(r.Spec.Source)
+	if err != nil || source.Host == "" {
+		msg := fmt.Sprintf("source %q is not a valid URL", r.Spec.Source)
+		return errors.New(msg)
+	}
+
+	if source.Scheme == "oci" {
+		if _, _, _, ok := common.ParseOCIReference(r.Spec.Source); !ok {
+			msg := fmt.Sprintf("source %q must reference a repository and a tag or digest", r.Spec.Source)
+			return errors.New(msg)
+		}
+	}
+
+	for _, o := range r.Spec.HelmOverrides {
+		if o.Values == nil {
+			continue
+		}
+
+		values := make(map[string]interface{})
+		if err := yaml.Unmarshal([]byte(*o.Values), &values); err != nil {
+			msg := fmt.Sprintf("values of chart %s/%s are not a valid YAML document: %s",
+				o.Namespace, o.Chart, err.Error())
+			return errors.New(msg)
+		}
+	}
+
+	applicationlog.Info(ApplicationAllowedReason)
+	return nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-application,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=applications,versions=v1,name=vapplication.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Application{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Application) ValidateCreate() error {
+	applicationlog.Info("validate create", "name", r.Name)
+
+	return r.validateApplication()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Application) ValidateUpdate(old runtime.Object) error {
+	applicationlog.Info("validate update", "name", r.Name)
+
+	return r.validateApplication()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Application) ValidateDelete() error {
+	applicationlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("application_webhook functions", func() {
+
+	Describe("validateApplication function is tested", func() {
+		Context("When the source is an http URL", func() {
+			It("Sucessfully validates the application", func() {
+				r := &Application{
+					Spec: ApplicationSpec{
+						Source: "https://repo.example.com/apps/cert-manager-24.09-1.tgz",
+					},
+				}
+				err := r.validateApplication()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When the source is an OCI reference with a tag", func() {
+			It("Sucessfully validates the application", func() {
+				r := &Application{
+					Spec: ApplicationSpec{
+						Source: "oci://registry.local:9001/apps/cert-manager:24.09-1",
+					},
+				}
+				err := r.validateApplication()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When the source is an OCI reference without a tag", func() {
+			It("Should throw the error the source must reference a tag or digest", func() {
+				r := &Application{
+					Spec: ApplicationSpec{
+						Source: "oci://registry.local:9001/apps/cert-manager",
+					},
+				}
+				err := r.validateApplication()
+				msg := errors.New("source \"oci://registry.local:9001/apps/cert-manager\" must reference a repository and a tag or digest")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the source has no host", func() {
+			It("Should throw the error the source is not a valid URL", func() {
+				r := &Application{
+					Spec: ApplicationSpec{
+						Source: "https:///cert-manager.tgz",
+					},
+				}
+				err := r.validateApplication()
+				msg := errors.New("source \"https:///cert-manager.tgz\" is not a valid URL")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the chart overrides are not a YAML document", func() {
+			It("Should throw the error the values are not a valid YAML document", func() {
+				values := "- replicas: 2"
+				r := &Application{
+					Spec: ApplicationSpec{
+						Source: "https://repo.example.com/apps/cert-manager-24.09-1.tgz",
+						HelmOverrides: []HelmOverride{
+							{Chart: "cert-manager", Namespace: "cert-manager", Values: &values},
+						},
+					},
+				}
+				err := r.validateApplication()
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(HavePrefix("values of chart cert-manager/cert-manager are not a valid YAML document"))
+			})
+		})
+	})
+})
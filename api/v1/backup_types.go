@@ -0,0 +1,173 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the locations at which subcloud backups are stored.
+const (
+	// BackupLocationCentral indicates that the backup is stored on the
+	// system controller.
+	BackupLocationCentral = "central"
+
+	// BackupLocationLocal indicates that the backup is stored on the
+	// subcloud controller.
+	BackupLocationLocal = "local"
+)
+
+// Defines the states of a single backup run.
+const (
+	BackupStateRunning   = "running"
+	BackupStateSucceeded = "succeeded"
+	BackupStateFailed    = "failed"
+)
+
+// BackupSpec defines the desired state of Backup
+type BackupSpec struct {
+	// Subcloud defines the name of the Subcloud resource, in the same
+	// namespace, which is backed up.
+	// +kubebuilder:validation:MinLength=1
+	Subcloud string `json:"subcloud"`
+
+	// BackupLocation defines whether the backup is stored on the system
+	// controller or on the subcloud controller.
+	// +kubebuilder:validation:Enum=central;local
+	// +kubebuilder:default:=central
+	// +optional
+	BackupLocation string `json:"backupLocation,omitempty"`
+
+	// RegistryImages defines whether the local registry images are included
+	// in the backup.  It is only supported for local backups.
+	// +optional
+	RegistryImages bool `json:"registryImages,omitempty"`
+
+	// BackupValues defines additional overrides, as a YAML document, passed
+	// to the backup playbook (e.g., exclude_dirs).
+	// +optional
+	BackupValues *string `json:"backupValues,omitempty"`
+
+	// Schedule defines when backups are taken in standard cron format (e.g.,
+	// "0 2 * * *").  When omitted a single backup is taken for each
+	// configuration generation.
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+
+	// Suspend defines whether scheduled backups are temporarily disabled.
+	// Backups already running are not interrupted.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// HistoryLimit defines the number of backup runs kept in the status.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default:=5
+	// +optional
+	HistoryLimit int `json:"historyLimit,omitempty"`
+}
+
+// BackupRecord defines the outcome of a single backup run.
+type BackupRecord struct {
+	// Name defines the unique name of the backup run.
+	Name string `json:"name"`
+
+	// State defines the state of the backup run.
+	State string `json:"state"`
+
+	// BackupStatus defines the last known backup state of the subcloud
+	// reported by the system controller (e.g., backing-up, complete-central).
+	// +optional
+	BackupStatus string `json:"backupStatus,omitempty"`
+
+	// BackupDatetime defines when the backup was taken as reported by the
+	// system controller.
+	// +optional
+	BackupDatetime string `json:"backupDatetime,omitempty"`
+
+	// StartTime defines when the backup run started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime defines when the backup run ended.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// BackupStatus defines the observed state of Backup
+type BackupStatus struct {
+	// State defines the state of the most recent backup run.
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// LastScheduleTime defines when the most recent backup run was started.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// NextScheduleTime defines when the next scheduled backup run starts.
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// History defines the most recent backup runs, newest first.
+	// +optional
+	History []BackupRecord `json:"history,omitempty"`
+
+	// Reconciled defines whether the most recent backup run for the current
+	// configuration generation has succeeded.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the resource has been provisioned on the target system.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the backup.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// Backup defines the attributes that represent an on-demand or scheduled
+// backup of the platform of a Distributed Cloud subcloud.  Each backup run is
+// requested from, and run by, the system controller which stores the backup
+// either centrally or on the subcloud controller so that it can later be
+// restored with a Restore resource.  This is a composition of the following
+// StarlingX API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/distcloud/api-ref-dcmanager-v1.html#subcloud-backups
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="subcloud",type="string",JSONPath=".spec.subcloud",description="The subcloud being backed up."
+// +kubebuilder:printcolumn:name="schedule",type="string",JSONPath=".spec.schedule",description="The backup schedule."
+// +kubebuilder:printcolumn:name="state",type="string",JSONPath=".status.state",description="The state of the most recent backup."
+// +kubebuilder:printcolumn:name="last",type="date",JSONPath=".status.lastScheduleTime",description="The time of the most recent backup."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type Backup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupSpec   `json:"spec,omitempty"`
+	Status BackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// BackupList contains a list of Backup
+// +deepequal-gen=false
+type BackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Backup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Backup{}, &BackupList{})
+}
@@ -0,0 +1,93 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Webhook response reasons
+const BackupAllowedReason string = "allowed to be admitted"
+
+// log is for logging in this package.
+var backuplog = logf.Log.WithName("backup-resource")
+
+func (r *Backup) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-starlingx-windriver-com-v1-backup,mutating=true,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=backups,verbs=create;update,versions=v1,name=mbackup.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Backup{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Backup) Default() {
+	backuplog.Info("default", "name", r.Name)
+}
+
+// Validates an incoming resource update/create request.  The schedule must be
+// a valid cron expression, the registry images can only be included in a
+// backup stored on the subcloud and the backup values must be a YAML
+// document.
+func (r *Backup) validateBackup() error {
+	if r.Spec.Schedule != nil {
+		if _, err := common.ParseSchedule(*r.Spec.Schedule); err != nil {
+			msg := fmt.Sprintf("schedule %q is not a valid cron expression: %s",
+				*r.Spec.Schedule, err.Error())
+			return errors.New(msg)
+		}
+	}
+
+	if r.Spec.RegistryImages && r.Spec.BackupLocation != BackupLocationLocal {
+		msg := fmt.Sprintf("registry images can only be included in a %q backup location",
+			BackupLocationLocal)
+		return errors.New(msg)
+	}
+
+	if r.Spec.BackupValues != nil {
+		values := make(map[string]interface{})
+		if err := yaml.Unmarshal([]byte(*r.Spec.BackupValues), &values); err != nil {
+			msg := fmt.Sprintf("backup values are not a valid YAML document: %s", err.Error())
+			return errors.New(msg)
+		}
+	}
+
+	backuplog.Info(BackupAllowedReason)
+	return nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-backup,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=backups,versions=v1,name=vbackup.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Backup{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Backup) ValidateCreate() error {
+	backuplog.Info("validate create", "name", r.Name)
+
+	return r.validateBackup()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Backup) ValidateUpdate(old runtime.Object) error {
+	backuplog.Info("validate update", "name", r.Name)
+
+	return r.validateBackup()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Backup) ValidateDelete() error {
+	backuplog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
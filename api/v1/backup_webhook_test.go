@@ -0,0 +1,81 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("backup_webhook functions", func() {
+
+	Describe("validateBackup function is tested", func() {
+		Context("When the backup is on-demand", func() {
+			It("Sucessfully validates the backup", func() {
+				r := &Backup{
+					Spec: BackupSpec{Subcloud: "subcloud1"},
+				}
+				err := r.validateBackup()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When the backup has a valid schedule and includes the registry images", func() {
+			It("Sucessfully validates the backup", func() {
+				schedule := "0 2 * * 1-5"
+				r := &Backup{
+					Spec: BackupSpec{
+						Subcloud:       "subcloud1",
+						Schedule:       &schedule,
+						BackupLocation: BackupLocationLocal,
+						RegistryImages: true,
+					},
+				}
+				err := r.validateBackup()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When the schedule is not a cron expression", func() {
+			It("Should throw the error the schedule is not valid", func() {
+				schedule := "0 25 * * *"
+				r := &Backup{
+					Spec: BackupSpec{
+						Subcloud: "subcloud1",
+						Schedule: &schedule,
+					},
+				}
+				err := r.validateBackup()
+				msg := errors.New("schedule \"0 25 * * *\" is not a valid cron expression: value \"25\" is out of range 0-23 in hour field")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the registry images are included in a central backup", func() {
+			It("Should throw the error the registry images require a local backup", func() {
+				r := &Backup{
+					Spec: BackupSpec{
+						Subcloud:       "subcloud1",
+						BackupLocation: BackupLocationCentral,
+						RegistryImages: true,
+					},
+				}
+				err := r.validateBackup()
+				msg := errors.New("registry images can only be included in a \"local\" backup location")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the backup values are not YAML", func() {
+			It("Should throw the error the backup values are not valid", func() {
+				values := "exclude_dirs: [/opt"
+				r := &Backup{
+					Spec: BackupSpec{
+						Subcloud:     "subcloud1",
+						BackupValues: &values,
+					},
+				}
+				err := r.validateBackup()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@ package v1
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -29,6 +30,10 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/volumegroups"
 	common "github.com/wind-river/cloud-platform-deployment-manager/common"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/pcidevices"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/snmpcommunities"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/snmptrapdests"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/storageceph"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -138,6 +143,13 @@ func stripPartitionNumber(path string) string {
 	return re.ReplaceAllString(path, "")
 }
 
+// Defines the host labels used to configure the platform power manager.
+const (
+	PowerManagementLabel        = "power-management"
+	PowerManagementLabelEnabled = "enabled"
+	PowerProfileLabel           = "power-profile"
+)
+
 // parseLabelInfo is a utility which parses the label data as it is presented
 // by the system API and stores the data in the form required by a profile spec.
 func parseLabelInfo(profile *HostProfileSpec, host v1info.HostInfo) error {
@@ -150,6 +162,12 @@ func parseLabelInfo(profile *HostProfileSpec, host v1info.HostInfo) error {
 		profile.Labels = result
 	}
 
+	if result[PowerManagementLabel] == PowerManagementLabelEnabled {
+		if value, ok := result[PowerProfileLabel]; ok {
+			profile.PowerProfile = &value
+		}
+	}
+
 	return nil
 }
 
@@ -314,6 +332,20 @@ func parseInterfaceInfo(profile *HostProfileSpec, host v1info.HostInfo) error {
 			data.Class = interfaces.IFClassNone
 		}
 
+		data.IPv4Mode = iface.IPv4Mode
+		if iface.IPv4Pool != nil {
+			if pool := host.FindAddressPool(*iface.IPv4Pool); pool != nil {
+				data.IPv4Pool = &pool.Name
+			}
+		}
+
+		data.IPv6Mode = iface.IPv6Mode
+		if iface.IPv6Pool != nil {
+			if pool := host.FindAddressPool(*iface.IPv6Pool); pool != nil {
+				data.IPv6Pool = &pool.Name
+			}
+		}
+
 		nets := host.BuildInterfaceNetworkList(iface)
 
 		if iface.IPv4Pool != nil {
@@ -358,14 +390,18 @@ func parseInterfaceInfo(profile *HostProfileSpec, host v1info.HostInfo) error {
 					Lower: iface.Uses[0],
 					Port:  EthernetPortInfo{Name: "dummy"}}
 			} else {
-				portname, found := host.FindInterfacePortName(iface.ID)
+				port, found := host.FindInterfacePort(iface.ID)
 				if !found {
 					msg := fmt.Sprintf("unable to find port name for interface id %s", iface.ID)
 					return NewMissingSystemResource(msg)
 				}
 				ethernet = EthernetInfo{
 					Port: EthernetPortInfo{
-						Name: portname}}
+						Name: port.Name}}
+				if port.PCIAddress != "" {
+					pciaddr := port.PCIAddress
+					ethernet.Port.PCIAddress = &pciaddr
+				}
 			}
 
 			ethernet.CommonInterfaceInfo = data
@@ -465,6 +501,113 @@ func parseAddressInfo(profile *HostProfileSpec, host v1info.HostInfo) error {
 	return nil
 }
 
+// parseDeviceInfo is a utility which parses the PCI device data as it is
+// presented by the system API and stores the data in the form required by a
+// profile spec.
+func parseDeviceInfo(profile *HostProfileSpec, host v1info.HostInfo) error {
+	result := make([]FECDeviceInfo, 0)
+	others := make([]PCIDeviceInfo, 0)
+
+	for _, d := range host.PCIDevices {
+		if d.ClassID != pcidevices.PCIClassAccelerator {
+			pciaddr := d.PCIAddress
+			vendorID := d.VendorID
+			deviceID := d.DeviceID
+			enabled := d.Enabled
+			name := d.Name
+			device := PCIDeviceInfo{
+				PCIAddress: &pciaddr,
+				VendorID:   &vendorID,
+				DeviceID:   &deviceID,
+				Enabled:    &enabled,
+				Name:       &name,
+			}
+
+			others = append(others, device)
+			continue
+		}
+
+		enabled := d.Enabled
+		device := FECDeviceInfo{
+			PCIAddress: d.PCIAddress,
+			Enabled:    &enabled,
+			Driver:     d.Driver,
+			VFCount:    d.SriovNumVFs,
+			VFDriver:   d.SriovVFDriver,
+		}
+
+		labels := host.FindDeviceLabels(d.ID)
+		if len(labels) > 0 {
+			device.Labels = labels
+		}
+
+		result = append(result, device)
+	}
+
+	if len(result) > 0 || len(others) > 0 {
+		profile.Devices = &DeviceInfo{}
+
+		if len(result) > 0 {
+			profile.Devices.FEC = result
+		}
+
+		if len(others) > 0 {
+			profile.Devices.PCI = others
+		}
+	}
+
+	return nil
+}
+
+// parseSensorGroupInfo is a utility which parses the sensor group data as it
+// is presented by the system API and stores the data in the form required by
+// a profile spec.
+func parseSensorGroupInfo(profile *HostProfileSpec, host v1info.HostInfo) error {
+	result := make([]SensorGroupInfo, 0)
+
+	for _, g := range host.SensorGroups {
+		auditInterval := g.AuditInterval
+		suppress := g.IsSuppressed()
+		group := SensorGroupInfo{
+			Name:          g.Name,
+			AuditInterval: &auditInterval,
+			Suppress:      &suppress,
+		}
+
+		if g.ActionsCritical != "" {
+			actions := g.ActionsCritical
+			group.ActionsCritical = &actions
+		}
+
+		if g.ActionsMajor != "" {
+			actions := g.ActionsMajor
+			group.ActionsMajor = &actions
+		}
+
+		if g.ActionsMinor != "" {
+			actions := g.ActionsMinor
+			group.ActionsMinor = &actions
+		}
+
+		suppressed := make([]string, 0)
+		for _, sensor := range host.FindSensorsByGroup(g.ID) {
+			if sensor.IsSuppressed() {
+				suppressed = append(suppressed, sensor.Name)
+			}
+		}
+		sort.Strings(suppressed)
+		group.SuppressedSensors = suppressed
+
+		result = append(result, group)
+	}
+
+	if len(result) > 0 {
+		profile.SensorGroups = result
+	}
+
+	return nil
+}
+
 // parseRouteInfo is a utility which parses the route data as it is presented
 // by the system API and stores the data in the form required by a profile spec.
 func parseRouteInfo(profile *HostProfileSpec, host v1info.HostInfo) error {
@@ -696,6 +839,17 @@ func autoGenerateBMSecretName() string {
 	return "bmc-secret"
 }
 
+// IsHostPoweredOn determines the power state of a host from its availability
+// status and current task.  The board is assumed to be powered on unless
+// there is a clear indication that it is not.
+func IsHostPoweredOn(host hosts.Host) bool {
+	if host.AvailabilityStatus == hosts.AvailPowerOff {
+		return host.Task != nil && *host.Task == hosts.TaskPoweringOn
+	}
+
+	return host.Task == nil || *host.Task != hosts.TaskPoweringOff
+}
+
 // parseBoardManagementInfo is a utility which parses the board management data
 // as it is presented by the system API and stores the data in the form required
 // by a profile spec.  Since the credentials are only partially presented by
@@ -836,16 +990,7 @@ func NewHostProfileSpec(host v1info.HostInfo) (*HostProfileSpec, error) {
 	ptpInstanceList := StringsToPtpInstanceItemList(ptpInstances)
 	spec.PtpInstances = ptpInstanceList
 
-	// Assume that the board is powered on unless there is a clear indication
-	// that it is not.
-	powerState := true
-	if host.AvailabilityStatus == hosts.AvailPowerOff {
-		if host.Task == nil || *host.Task != hosts.TaskPoweringOn {
-			powerState = false
-		}
-	} else if host.Task != nil && *host.Task == hosts.TaskPoweringOff {
-		powerState = false
-	}
+	powerState := IsHostPoweredOn(host.Host)
 	spec.PowerOn = &powerState
 
 	err = parseBoardManagementInfo(&spec, host)
@@ -870,6 +1015,18 @@ func NewHostProfileSpec(host v1info.HostInfo) (*HostProfileSpec, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		// Fill-in Device attributes
+		err = parseDeviceInfo(&spec, host)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Fill-in Sensor Group attributes
+	err = parseSensorGroupInfo(&spec, host)
+	if err != nil {
+		return nil, err
 	}
 
 	// Fill-in Interface attributes
@@ -984,6 +1141,30 @@ func parseServiceParameterInfo(spec *SystemSpec, serviceParams []serviceparamete
 	return nil
 }
 
+func parseSNMPInfo(spec *SystemSpec, communities []snmpcommunities.Community, trapDests []snmptrapdests.TrapDest) error {
+	communityList := make(SNMPCommunityList, 0)
+	for _, c := range communities {
+		communityList = append(communityList, SNMPCommunity(c.Community))
+	}
+
+	trapDestList := make(SNMPTrapDestinationList, 0)
+	for _, t := range trapDests {
+		info := SNMPTrapDestinationInfo{
+			Address:   t.IPAddress,
+			Community: t.Community,
+		}
+
+		trapDestList = append(trapDestList, info)
+	}
+
+	spec.SNMP = &SNMPInfo{
+		Communities:      &communityList,
+		TrapDestinations: &trapDestList,
+	}
+
+	return nil
+}
+
 func parseFileSystemInfo(spec *SystemSpec, fileSystems []controllerFilesystems.FileSystem) error {
 	result := make([]ControllerFileSystemInfo, 0)
 
@@ -1006,6 +1187,31 @@ func parseFileSystemInfo(spec *SystemSpec, fileSystems []controllerFilesystems.F
 	return nil
 }
 
+// parseCephPoolQuotaInfo adds the pool quotas of Ceph backends to the
+// storage backends already parsed into the spec.
+func parseCephPoolQuotaInfo(spec *SystemSpec, storageBackends []storagebackends.StorageBackend, pools []storageceph.StorageCeph) {
+	for _, pool := range pools {
+		for _, sb := range storageBackends {
+			if sb.ID != pool.ID {
+				continue
+			}
+
+			for i := range *spec.Storage.Backends {
+				b := &(*spec.Storage.Backends)[i]
+				if b.Name == sb.Name && b.Type == sb.Backend {
+					b.PoolQuotas = &CephPoolQuotaInfo{
+						Cinder:     pool.CinderPoolGib,
+						Glance:     pool.GlancePoolGib,
+						Ephemeral:  pool.EphemeralPoolGib,
+						Object:     pool.ObjectPoolGib,
+						Kubernetes: pool.KubePoolGib,
+					}
+				}
+			}
+		}
+	}
+}
+
 func parseStorageBackendInfo(spec *SystemSpec, storageBackends []storagebackends.StorageBackend) error {
 	result := make([]StorageBackend, 0)
 
@@ -1017,6 +1223,12 @@ func parseStorageBackendInfo(spec *SystemSpec, storageBackends []storagebackends
 			Network:           &sb.Network,
 			ReplicationFactor: &rep,
 		}
+
+		if sb.Capabilities.MinReplication != "" {
+			minRep, _ := strconv.Atoi(sb.Capabilities.MinReplication)
+			info.MinReplicationFactor = &minRep
+		}
+
 		result = append(result, info)
 	}
 
@@ -1072,6 +1284,10 @@ func NewSystemSpec(systemInfo v1info.SystemInfo) (*SystemSpec, error) {
 		spec.Contact = &systemInfo.Contact
 	}
 
+	if systemInfo.Timezone != "" {
+		spec.Timezone = &systemInfo.Timezone
+	}
+
 	if systemInfo.Latitude != "" {
 		spec.Latitude = &systemInfo.Latitude
 	}
@@ -1132,6 +1348,13 @@ func NewSystemSpec(systemInfo v1info.SystemInfo) (*SystemSpec, error) {
 		}
 	}
 
+	if len(systemInfo.SNMPCommunities) > 0 || len(systemInfo.SNMPTrapDests) > 0 {
+		err := parseSNMPInfo(&spec, systemInfo.SNMPCommunities, systemInfo.SNMPTrapDests)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if len(systemInfo.FileSystems) > 0 {
 		err := parseFileSystemInfo(&spec, systemInfo.FileSystems)
 		if err != nil {
@@ -1144,6 +1367,8 @@ func NewSystemSpec(systemInfo v1info.SystemInfo) (*SystemSpec, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		parseCephPoolQuotaInfo(&spec, systemInfo.StorageBackends, systemInfo.CephPools)
 	}
 
 	if systemInfo.License != nil {
@@ -1275,6 +1500,70 @@ func NewHostSpec(hostInfo v1info.HostInfo) (*HostSpec, error) {
 	return &spec, nil
 }
 
+// NewHostHardwareStatus builds a summary of the hardware inventory of a host
+// suitable for reporting in the host status.
+func NewHostHardwareStatus(hostInfo v1info.HostInfo) *HostHardwareStatus {
+	status := HostHardwareStatus{}
+
+	nodes := make(map[int]*HostNodeHardwareStatus)
+	getNode := func(node int) *HostNodeHardwareStatus {
+		if _, ok := nodes[node]; !ok {
+			nodes[node] = &HostNodeHardwareStatus{Node: node}
+		}
+		return nodes[node]
+	}
+
+	for _, c := range hostInfo.CPU {
+		node := getNode(c.Processor)
+		node.LogicalCores++
+		if c.Thread == 0 {
+			node.PhysicalCores++
+		}
+	}
+
+	status.NUMANodes = len(nodes)
+
+	for _, m := range hostInfo.Memory {
+		node := getNode(m.Processor)
+		node.MemoryMiB += m.Total
+	}
+
+	for _, node := range nodes {
+		status.Nodes = append(status.Nodes, *node)
+	}
+
+	sort.Slice(status.Nodes, func(i, j int) bool {
+		return status.Nodes[i].Node < status.Nodes[j].Node
+	})
+
+	for _, d := range hostInfo.Disks {
+		status.DiskCount++
+		status.DiskCapacityMiB += d.Size
+		status.Disks = append(status.Disks, HostDiskHardwareStatus{
+			Path:    d.DevicePath,
+			Node:    d.DeviceNode,
+			SizeMiB: d.Size,
+		})
+	}
+
+	sort.Slice(status.Disks, func(i, j int) bool {
+		return status.Disks[i].Path < status.Disks[j].Path
+	})
+
+	models := make(map[string]bool)
+	for _, p := range hostInfo.PortDevices {
+		model := strings.TrimSpace(fmt.Sprintf("%s %s", p.Vendor, p.Device))
+		if model != "" && !models[model] {
+			models[model] = true
+			status.NICModels = append(status.NICModels, model)
+		}
+	}
+
+	sort.Strings(status.NICModels)
+
+	return &status
+}
+
 func NewHost(name string, namespace string, hostInfo v1info.HostInfo) (*Host, error) {
 	host := Host{
 		TypeMeta: metav1.TypeMeta{
@@ -43,6 +43,10 @@ import (
 	. "github.com/onsi/gomega"
 	common "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/devicelabels"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/pcidevices"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/sensorgroups"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/sensors"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -285,6 +289,149 @@ var _ = Describe("Constructor utils for kind", func() {
 		})
 	})
 
+	Describe("Test IsHostPoweredOn", func() {
+		Context("When the host is powered off", func() {
+			It("Should report the host as powered off", func() {
+				host := hosts.Host{AvailabilityStatus: hosts.AvailPowerOff}
+				Expect(IsHostPoweredOn(host)).To(BeFalse())
+			})
+		})
+		Context("When the host is powering on", func() {
+			It("Should report the host as powered on", func() {
+				task := hosts.TaskPoweringOn
+				host := hosts.Host{AvailabilityStatus: hosts.AvailPowerOff, Task: &task}
+				Expect(IsHostPoweredOn(host)).To(BeTrue())
+			})
+		})
+		Context("When the host is powering off", func() {
+			It("Should report the host as powered off", func() {
+				task := hosts.TaskPoweringOff
+				host := hosts.Host{AvailabilityStatus: hosts.AvailOnline, Task: &task}
+				Expect(IsHostPoweredOn(host)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Test parseDeviceInfo", func() {
+		Context("When the host has accelerator devices", func() {
+			It("Should separate the FEC devices from other PCI devices", func() {
+				driver := "igb_uio"
+				vfDriver := "vfio"
+				vfCount := 8
+				host := platform.HostInfo{
+					PCIDevices: []pcidevices.PCIDevice{
+						{
+							PCIAddress:    "0000:b7:00.0",
+							ClassID:       pcidevices.PCIClassAccelerator,
+							Enabled:       true,
+							Driver:        &driver,
+							SriovNumVFs:   &vfCount,
+							SriovVFDriver: &vfDriver,
+						},
+						{
+							Name:       "pci_0000_00_02_0",
+							PCIAddress: "0000:00:02.0",
+							ClassID:    "030000",
+							VendorID:   "8086",
+							DeviceID:   "3e92",
+							Enabled:    true,
+						},
+					},
+				}
+				enabled := true
+				pciaddr := "0000:00:02.0"
+				vendorID := "8086"
+				deviceID := "3e92"
+				name := "pci_0000_00_02_0"
+				exp := &DeviceInfo{
+					FEC: FECDeviceList{
+						{
+							PCIAddress: "0000:b7:00.0",
+							Enabled:    &enabled,
+							Driver:     &driver,
+							VFCount:    &vfCount,
+							VFDriver:   &vfDriver,
+						},
+					},
+					PCI: PCIDeviceList{
+						{
+							PCIAddress: &pciaddr,
+							VendorID:   &vendorID,
+							DeviceID:   &deviceID,
+							Enabled:    &enabled,
+							Name:       &name,
+						},
+					},
+				}
+				profile := HostProfileSpec{}
+				err := parseDeviceInfo(&profile, host)
+				Expect(err).To(BeNil())
+				Expect(profile.Devices).To(Equal(exp))
+			})
+		})
+		Context("When the FEC device has device labels", func() {
+			It("Should include the labels of the device", func() {
+				host := platform.HostInfo{
+					PCIDevices: []pcidevices.PCIDevice{
+						{
+							ID:         "dev-1",
+							PCIAddress: "0000:b7:00.0",
+							ClassID:    pcidevices.PCIClassAccelerator,
+						},
+					},
+					DeviceLabels: []devicelabels.DeviceLabel{
+						{PCIDeviceID: "dev-1", Key: "fpga", Value: "functional"},
+						{PCIDeviceID: "dev-2", Key: "fpga", Value: "root-key"},
+					},
+				}
+				profile := HostProfileSpec{}
+				err := parseDeviceInfo(&profile, host)
+				Expect(err).To(BeNil())
+				Expect(profile.Devices.FEC[0].Labels).To(Equal(map[string]string{"fpga": "functional"}))
+			})
+		})
+	})
+
+	Describe("Test parseSensorGroupInfo", func() {
+		Context("When the host reports sensor groups", func() {
+			It("Should include the group policy and suppressed sensors", func() {
+				profile := &HostProfileSpec{}
+				host := platform.HostInfo{
+					SensorGroups: []sensorgroups.SensorGroup{
+						{
+							ID:              "group-1",
+							Name:            "server fans",
+							AuditInterval:   60,
+							Suppress:        sensorgroups.SuppressFalse,
+							ActionsCritical: "alarm",
+						},
+					},
+					Sensors: []sensors.Sensor{
+						{ID: "s1", SensorGroupID: "group-1", Name: "Fan 2", Suppress: sensors.SuppressTrue},
+						{ID: "s2", SensorGroupID: "group-1", Name: "Fan 1", Suppress: sensors.SuppressTrue},
+						{ID: "s3", SensorGroupID: "group-1", Name: "Fan 3", Suppress: sensors.SuppressFalse},
+					},
+				}
+				err := parseSensorGroupInfo(profile, host)
+				Expect(err).To(BeNil())
+
+				auditInterval := 60
+				suppress := false
+				actions := "alarm"
+				exp := SensorGroupList{
+					{
+						Name:              "server fans",
+						AuditInterval:     &auditInterval,
+						Suppress:          &suppress,
+						SuppressedSensors: []string{"Fan 1", "Fan 2"},
+						ActionsCritical:   &actions,
+					},
+				}
+				Expect(profile.SensorGroups).To(Equal(exp))
+			})
+		})
+	})
+
 	Describe("Test parseAddressInfo", func() {
 		Context("When host address is not a systemAddress", func() {
 			It("should check if profile address is same as host address", func() {
@@ -796,6 +943,52 @@ var _ = Describe("Constructor utils for kind", func() {
 		})
 	})
 
+	Describe("Test NewHostHardwareStatus", func() {
+		Context("When the host inventory has been collected", func() {
+			It("Returns a summary of the hardware", func() {
+				hostInfo := platform.HostInfo{
+					CPU: []cpus.CPU{
+						{Processor: 0, PhysicalCore: 0, Thread: 0},
+						{Processor: 0, PhysicalCore: 0, Thread: 1},
+						{Processor: 1, PhysicalCore: 1, Thread: 0},
+					},
+					Memory: []memory.Memory{
+						{Processor: 1, Total: 2048},
+						{Processor: 0, Total: 4096},
+					},
+					Disks: []disks.Disk{
+						{DevicePath: "/dev/disk/by-path/pci-0000:00:1f.2-ata-2.0", DeviceNode: "/dev/sdb", Size: 2048},
+						{DevicePath: "/dev/disk/by-path/pci-0000:00:1f.2-ata-1.0", DeviceNode: "/dev/sda", Size: 1024},
+					},
+					PortDevices: []platform.PortDevice{
+						{Name: "eth0", Vendor: "Intel Corporation", Device: "Ethernet Controller X710"},
+						{Name: "eth1", Vendor: "Intel Corporation", Device: "Ethernet Controller X710"},
+						{Name: "eth2", Vendor: "Mellanox Technologies", Device: "MT27800 Family"},
+					},
+				}
+				exp := HostHardwareStatus{
+					NUMANodes: 2,
+					Nodes: []HostNodeHardwareStatus{
+						{Node: 0, PhysicalCores: 1, LogicalCores: 2, MemoryMiB: 4096},
+						{Node: 1, PhysicalCores: 1, LogicalCores: 1, MemoryMiB: 2048},
+					},
+					DiskCount:       2,
+					DiskCapacityMiB: 3072,
+					Disks: []HostDiskHardwareStatus{
+						{Path: "/dev/disk/by-path/pci-0000:00:1f.2-ata-1.0", Node: "/dev/sda", SizeMiB: 1024},
+						{Path: "/dev/disk/by-path/pci-0000:00:1f.2-ata-2.0", Node: "/dev/sdb", SizeMiB: 2048},
+					},
+					NICModels: []string{
+						"Intel Corporation Ethernet Controller X710",
+						"Mellanox Technologies MT27800 Family",
+					},
+				}
+				got := NewHostHardwareStatus(hostInfo)
+				Expect(*got).To(Equal(exp))
+			})
+		})
+	})
+
 	Describe("Test NewSystemStatus", func() {
 		Context("When needs to get instance of SystemStatus", func() {
 			It("Returns instance of SystemStatus without an error", func() {
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package v1
 
@@ -60,6 +60,22 @@ type DataNetworkSpec struct {
 	VxLAN *VxLANInfo `json:"vxlan,omitempty"`
 }
 
+// DataNetworkAttachment defines a host interface which is attached to a data
+// network.
+type DataNetworkAttachment struct {
+	// Host defines the name of the host.
+	Host string `json:"host"`
+
+	// Interface defines the name of the interface attached to the data
+	// network.
+	Interface string `json:"interface"`
+}
+
+// ConditionImmutableFieldsChanged reports that the data network on the system
+// differs from the specification in attributes which cannot be modified once
+// the data network has been created.
+const ConditionImmutableFieldsChanged = "ImmutableFieldsChanged"
+
 // DataNetworkStatus defines the observed state of DataNetwork
 type DataNetworkStatus struct {
 	// ID defines the system assigned unique identifier.  This will only exist
@@ -102,6 +118,23 @@ type DataNetworkStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// DeltaAttributes lists each attribute which differs between the final
+	// profile and the current configuration in a machine readable form.
+	// +optional
+	DeltaAttributes []DeltaAttribute `json:"deltaAttributes,omitempty"`
+
+	// Attachments defines the host interfaces which were attached to the data
+	// network when it was last reconciled.
+	// +optional
+	Attachments []DataNetworkAttachment `json:"attachments,omitempty"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the data network.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package v1
 
@@ -45,6 +45,28 @@ func (r *DataNetwork) validateDataNetwork() error {
 	return nil
 }
 
+// validateDataNetworkUpdate rejects changes to the attributes which cannot be
+// modified once the data network has been created on the system.
+func (r *DataNetwork) validateDataNetworkUpdate(old *DataNetwork) error {
+	if r.Spec.Type != old.Spec.Type {
+		return errors.New("the type of a data network cannot be changed.")
+	}
+
+	var mode, oldMode *string
+	if r.Spec.VxLAN != nil {
+		mode = r.Spec.VxLAN.EndpointMode
+	}
+	if old.Spec.VxLAN != nil {
+		oldMode = old.Spec.VxLAN.EndpointMode
+	}
+
+	if mode != nil && oldMode != nil && *mode != *oldMode {
+		return errors.New("the endpoint mode of a VxLAN data network cannot be changed.")
+	}
+
+	return nil
+}
+
 // TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
 // +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-datanetwork,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=datanetworks,versions=v1,name=vdatanetwork.kb.io,admissionReviewVersions=v1
 var _ webhook.Validator = &DataNetwork{}
@@ -61,6 +83,12 @@ func (r *DataNetwork) ValidateCreate() error {
 func (r *DataNetwork) ValidateUpdate(old runtime.Object) error {
 	datanetworklog.Info("validate update", "name", r.Name)
 
+	if o, ok := old.(*DataNetwork); ok {
+		if err := r.validateDataNetworkUpdate(o); err != nil {
+			return err
+		}
+	}
+
 	return r.validateDataNetwork()
 }
 
@@ -41,4 +41,48 @@ var _ = Describe("datanetwork_webhook functions", func() {
 			})
 		})
 	})
+
+	Describe("validateDataNetworkUpdate function is tested", func() {
+		Context("When only mutable attributes are changed", func() {
+			It("Sucessfully validates the update", func() {
+				mtu, ttl := 1500, 10
+				old := &DataNetwork{Spec: DataNetworkSpec{Type: datanetworks.TypeVxLAN}}
+				r := &DataNetwork{
+					Spec: DataNetworkSpec{
+						Type:  datanetworks.TypeVxLAN,
+						MTU:   &mtu,
+						VxLAN: &VxLANInfo{TTL: &ttl},
+					},
+				}
+				Expect(r.validateDataNetworkUpdate(old)).To(BeNil())
+			})
+		})
+		Context("When the type is changed", func() {
+			It("Should reject the update", func() {
+				old := &DataNetwork{Spec: DataNetworkSpec{Type: datanetworks.TypeVLAN}}
+				r := &DataNetwork{Spec: DataNetworkSpec{Type: datanetworks.TypeFlat}}
+				msg := errors.New("the type of a data network cannot be changed.")
+				Expect(r.validateDataNetworkUpdate(old)).To(Equal(msg))
+			})
+		})
+		Context("When the endpoint mode is changed", func() {
+			It("Should reject the update", func() {
+				static, dynamic := "static", "dynamic"
+				old := &DataNetwork{
+					Spec: DataNetworkSpec{
+						Type:  datanetworks.TypeVxLAN,
+						VxLAN: &VxLANInfo{EndpointMode: &static},
+					},
+				}
+				r := &DataNetwork{
+					Spec: DataNetworkSpec{
+						Type:  datanetworks.TypeVxLAN,
+						VxLAN: &VxLANInfo{EndpointMode: &dynamic},
+					},
+				}
+				msg := errors.New("the endpoint mode of a VxLAN data network cannot be changed.")
+				Expect(r.validateDataNetworkUpdate(old)).To(Equal(msg))
+			})
+		})
+	})
 })
@@ -0,0 +1,183 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/deviceimages"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeviceImageSpec defines the desired state of DeviceImage
+type DeviceImageSpec struct {
+	// BitstreamType defines the type of device image being uploaded.
+	// +kubebuilder:validation:Enum=functional;root-key;key-revocation
+	BitstreamType string `json:"bitstreamType"`
+
+	// PCIVendor defines the PCI vendor identifier of the devices that this
+	// image applies to.
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{4}$`
+	PCIVendor string `json:"pciVendor"`
+
+	// PCIDevice defines the PCI device identifier of the devices that this
+	// image applies to.
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{4}$`
+	PCIDevice string `json:"pciDevice"`
+
+	// BitstreamID defines the bitstream identifier of a functional image.
+	// +optional
+	BitstreamID *string `json:"bitstreamID,omitempty"`
+
+	// KeySignature defines the key signature of a root-key image.
+	// +optional
+	KeySignature *string `json:"keySignature,omitempty"`
+
+	// RevokeKeyID defines the key identifier of a key-revocation image.
+	// +optional
+	RevokeKeyID *int `json:"revokeKeyID,omitempty"`
+
+	// ImageVersion defines the version of the image.
+	// +optional
+	ImageVersion *string `json:"imageVersion,omitempty"`
+
+	// Description defines a user defined description of the image.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Source defines the URL from which the image file is downloaded before
+	// being uploaded to the system.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	Source string `json:"source"`
+
+	// Labels defines the set of device labels that select the devices to
+	// which this image is applied.  Devices are labelled through the host
+	// profile device configuration.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// DeviceImageWriteStatus defines the observed write state of the image on a
+// single device.
+type DeviceImageWriteStatus struct {
+	// HostID defines the system assigned unique identifier of the host.
+	HostID string `json:"hostID"`
+
+	// DeviceID defines the system assigned unique identifier of the device.
+	DeviceID string `json:"deviceID"`
+
+	// Status defines the current write state of the image on the device.
+	Status string `json:"status"`
+}
+
+// DeviceImageStatus defines the observed state of DeviceImage
+type DeviceImageStatus struct {
+	// ID defines the system assigned unique identifier.  This will only exist
+	// once this resource has been provisioned into the system.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Reconciled defines whether the image has been successfully reconciled
+	// at least once.  If further changes are made they will be ignored by the
+	// reconciler.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the resource has been provisioned on the target system.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// DeploymentScope defines whether the resource has been deployed
+	// on the initial setup or during an update.
+	// +kubebuilder:validation:Enum=bootstrap;principal;Bootstrap;Principal;BOOTSTRAP;PRINCIPAL
+	// +optional
+	// +kubebuilder:default:=bootstrap
+	DeploymentScope string `json:"deploymentScope"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Value for configuration is updated or not
+	// +optional
+	ConfigurationUpdated bool `json:"configurationUpdated"`
+
+	// Value for configuration is updated or not
+	// +kubebuilder:validation:Enum=not_required;lock_required;unlock_required
+	// +optional
+	// +kubebuilder:default:=not_required
+	StrategyRequired string `json:"strategyRequired"`
+
+	// Delta between final profile vs current configuration
+	// +optional
+	Delta string `json:"delta"`
+
+	// DeltaAttributes lists each attribute which differs between the final
+	// profile and the current configuration in a machine readable form.
+	// +optional
+	DeltaAttributes []DeltaAttribute `json:"deltaAttributes,omitempty"`
+
+	// Progress summarizes the write progress of the image across all
+	// devices to which it has been applied (e.g., 2/3).
+	// +optional
+	Progress string `json:"progress,omitempty"`
+
+	// Writes defines the write state of the image on each device to which it
+	// has been applied.
+	// +optional
+	Writes []DeviceImageWriteStatus `json:"writes,omitempty"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the device image.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// WritesPending determines whether any device writes for this image have not
+// yet reached a final state.
+func (in *DeviceImageStatus) WritesPending() bool {
+	for _, w := range in.Writes {
+		if w.Status == deviceimages.StatusPending || w.Status == deviceimages.StatusInProgress {
+			return true
+		}
+	}
+
+	return false
+}
+
+// +kubebuilder:object:root=true
+// DeviceImage defines the attributes that represent a device image (e.g., an
+// FPGA bitstream) that is to be written to one or more PCI devices.  This is
+// a composition of the following StarlingX API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/config/api-ref-sysinv-v1-config.html#device-images
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="type",type="string",JSONPath=".spec.bitstreamType",description="The device image bitstream type."
+// +kubebuilder:printcolumn:name="progress",type="string",JSONPath=".status.progress",description="The device write progress."
+// +kubebuilder:printcolumn:name="insync",type="boolean",JSONPath=".status.inSync",description="The current synchronization state."
+// +kubebuilder:printcolumn:name="scope",type="string",JSONPath=".status.deploymentScope",description="The current deploymentScope state."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type DeviceImage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeviceImageSpec   `json:"spec,omitempty"`
+	Status DeviceImageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// DeviceImageList contains a list of DeviceImage
+// +deepequal-gen=false
+type DeviceImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeviceImage `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DeviceImage{}, &DeviceImageList{})
+}
@@ -0,0 +1,89 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/deviceimages"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Webhook response reasons
+const DeviceImageAllowedReason string = "allowed to be admitted"
+
+// log is for logging in this package.
+var deviceimagelog = logf.Log.WithName("deviceimage-resource")
+
+func (r *DeviceImage) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-starlingx-windriver-com-v1-deviceimage,mutating=true,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=deviceimages,verbs=create;update,versions=v1,name=mdeviceimage.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &DeviceImage{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *DeviceImage) Default() {
+	deviceimagelog.Info("default", "name", r.Name)
+}
+
+// Validates an incoming resource update/create request.  Each bitstream type
+// requires a different identifying attribute; the remaining validation is left
+// to the system API.
+func (r *DeviceImage) validateDeviceImage() error {
+	spec := r.Spec
+
+	switch spec.BitstreamType {
+	case deviceimages.BitstreamTypeFunctional:
+		if spec.BitstreamID == nil {
+			msg := fmt.Sprintf("a bitstream ID is required for %s images", spec.BitstreamType)
+			return errors.New(msg)
+		}
+	case deviceimages.BitstreamTypeRootKey:
+		if spec.KeySignature == nil {
+			msg := fmt.Sprintf("a key signature is required for %s images", spec.BitstreamType)
+			return errors.New(msg)
+		}
+	case deviceimages.BitstreamTypeKeyRevocation:
+		if spec.RevokeKeyID == nil {
+			msg := fmt.Sprintf("a revoke key ID is required for %s images", spec.BitstreamType)
+			return errors.New(msg)
+		}
+	}
+
+	deviceimagelog.Info(DeviceImageAllowedReason)
+	return nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-deviceimage,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=deviceimages,versions=v1,name=vdeviceimage.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &DeviceImage{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *DeviceImage) ValidateCreate() error {
+	deviceimagelog.Info("validate create", "name", r.Name)
+
+	return r.validateDeviceImage()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *DeviceImage) ValidateUpdate(old runtime.Object) error {
+	deviceimagelog.Info("validate update", "name", r.Name)
+
+	return r.validateDeviceImage()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *DeviceImage) ValidateDelete() error {
+	deviceimagelog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/deviceimages"
+)
+
+var _ = Describe("deviceimage_webhook functions", func() {
+
+	Describe("validateDeviceImage function is tested", func() {
+		Context("When a functional image has a bitstream ID", func() {
+			It("Sucessfully validates the device image", func() {
+				bitstreamID := "0x2383a62a010504"
+				r := &DeviceImage{
+					Spec: DeviceImageSpec{
+						BitstreamType: deviceimages.BitstreamTypeFunctional,
+						BitstreamID:   &bitstreamID,
+					},
+				}
+				err := r.validateDeviceImage()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When a root-key image has no key signature", func() {
+			It("Should throw the error a key signature is required", func() {
+				r := &DeviceImage{
+					Spec: DeviceImageSpec{
+						BitstreamType: deviceimages.BitstreamTypeRootKey,
+					},
+				}
+				err := r.validateDeviceImage()
+				msg := errors.New("a key signature is required for root-key images")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When a key-revocation image has no revoke key ID", func() {
+			It("Should throw the error a revoke key ID is required", func() {
+				r := &DeviceImage{
+					Spec: DeviceImageSpec{
+						BitstreamType: deviceimages.BitstreamTypeKeyRevocation,
+					},
+				}
+				err := r.validateDeviceImage()
+				msg := errors.New("a revoke key ID is required for key-revocation images")
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+})
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package v1
 
@@ -18,6 +18,13 @@ type MatchBMInfo struct {
 	// +kubebuilder:validation:Enum=none;bmc;dynamic;ipmi;redfish
 	// +optional
 	Type *string `json:"type,omitempty"`
+
+	// MACAddress defines the MAC address of the board management controller.
+	// Unlike the boot MAC address it does not change when the boot NIC of the
+	// host is replaced.
+	// +kubebuilder:validation:Pattern=`^([0-9a-fA-F]{2}[:-]){5}([0-9a-fA-F]{2})$`
+	// +optional
+	MACAddress *string `json:"macAddress,omitempty"`
 }
 
 // MatchDMIInfo defines the Desktop Management Interface attributes that can
@@ -35,30 +42,149 @@ type MatchDMIInfo struct {
 	AssetTag *string `json:"assetTag,omitempty"`
 }
 
+// Defines the operators used to combine the host match criteria.
+const (
+	MatchOperatorAnd = "and"
+	MatchOperatorOr  = "or"
+)
+
 // MatchInfo defines the attributes that can be used to dynamically match a
-// system host resource to a host CR definition.  To be considered a match
-// all of the fields defined with the match criteria must match the actual
-// attributes of the system host resource.
+// system host resource to a host CR definition.  By default all of the fields
+// defined with the match criteria must match the actual attributes of the
+// system host resource.  When the "or" operator is used a single matching
+// field is sufficient.
 type MatchInfo struct {
 	// BootMAC defines the MAC address that a host used to perform the initial
 	// software installation.
-	// +kubebuilder:validation:Pattern=`^([0-9a-fA-Z]{2}[:-]){5}([0-9a-fA-Z]{2})$`
+	// +kubebuilder:validation:Pattern=`^([0-9a-fA-F]{2}[:-]){5}([0-9a-fA-F]{2})$`
 	// +optional
 	BootMAC *string `json:"bootMAC,omitempty"`
 
 	// BoardManagement defines the board management attributes that can be used
 	// to match a system host resource to a system CR definition.
-	// NOTE:  Not yet supported.
 	// +optional
 	BoardManagement *MatchBMInfo `json:"boardManagement,omitempty"`
 
 	// DMI defines the Desktop Management Interface attributes that can be used
 	// to match a system host resource to a system CR definition.
-	// NOTE:  Not yet supported.
 	// +optional
 	DMI *MatchDMIInfo `json:"dmi,omitempty"`
+
+	// Operator defines how the match criteria are combined.  With "and" every
+	// criteria must match while with "or" any one of them is sufficient so
+	// that a host can still be matched after one of its attributes, such as
+	// its boot MAC address, has changed.  The board management type cannot be
+	// used with "or" since it does not identify a host on its own.
+	// +kubebuilder:validation:Enum=and;or
+	// +optional
+	Operator *string `json:"operator,omitempty"`
 }
 
+// VirtualMediaInfo defines the attributes used to install a host from an ISO
+// image mounted as virtual media through its Redfish board management
+// controller.
+type VirtualMediaInfo struct {
+	// Image defines the URL of the install ISO image.  The URL must be
+	// reachable from the board management controller.
+	// +kubebuilder:validation:Pattern=`^https?://.+$`
+	Image string `json:"image"`
+
+	// Insecure defines whether the board management controller certificate
+	// should be accepted without verification while the virtual media is
+	// inserted.  Other connections to the controller, such as the serial
+	// console capture, follow the "insecure" attribute of the board
+	// management settings of the profile.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
+
+// ConsoleCaptureInfo defines the attributes used to capture the serial
+// console output of a host while it is being installed.  The console is read
+// through the serial console SSH service of a Redfish board management
+// controller.
+type ConsoleCaptureInfo struct {
+	// Destination defines where the captured console output is written.
+	// Output captured to a ConfigMap is stored in a ConfigMap named after the
+	// host with a "-console" suffix.  Output captured to the log is emitted
+	// by the deployment manager one line at a time.
+	// +kubebuilder:validation:Enum=configmap;log
+	// +optional
+	Destination *string `json:"destination,omitempty"`
+
+	// MaxSize defines the maximum number of bytes of console output that are
+	// retained in the ConfigMap.  The oldest output is discarded first.
+	// +kubebuilder:validation:Minimum=1024
+	// +kubebuilder:validation:Maximum=524288
+	// +optional
+	MaxSize *int `json:"maxSize,omitempty"`
+
+	// HostKey defines the SSH public key, in authorized_keys format, that is
+	// expected to be presented by the serial console service.
+	// +optional
+	HostKey *string `json:"hostKey,omitempty"`
+
+	// Insecure defines whether the serial console service should be trusted
+	// without verifying its host key.  This is only used when a host key is
+	// not specified.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
+
+// ReconcilePolicyInfo defines the attributes that control how the
+// reconciliation of a host is retried after a failure.  Any attribute that is
+// omitted retains the behaviour of the deployment manager, which selects a
+// retry delay based on the type of error encountered.
+type ReconcilePolicyInfo struct {
+	// RetryInterval defines the delay, in seconds, before the first retry
+	// after a failure.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RetryInterval *int `json:"retryInterval,omitempty"`
+
+	// BackoffFactor defines the multiplier applied to the retry delay after
+	// each consecutive failure.  A factor of 1 retries at a fixed interval.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	BackoffFactor *int `json:"backoffFactor,omitempty"`
+
+	// MaxRetryInterval defines the upper bound, in seconds, of the retry
+	// delay.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxRetryInterval *int `json:"maxRetryInterval,omitempty"`
+
+	// RetryLimit defines the number of consecutive failures after which the
+	// host is no longer retried automatically.  Retries resume once the host
+	// resource is updated.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RetryLimit *int `json:"retryLimit,omitempty"`
+
+	// AutoRemediate defines whether configuration drift found by the
+	// periodic audit of a reconciled host is corrected automatically.  When
+	// disabled the drift is only reported in the host status.
+	// +optional
+	AutoRemediate *bool `json:"autoRemediate,omitempty"`
+}
+
+// PreflightInfo defines the attributes of the health check run before a
+// disruptive action (i.e., lock, unlock or reinstall) is issued to a host.
+// The action is withheld while the system reports an active management
+// affecting alarm.
+type PreflightInfo struct {
+	// IgnoredAlarms lists the identifiers of the alarms (e.g., 100.114) which
+	// never withhold a disruptive action.
+	// +optional
+	IgnoredAlarms []string `json:"ignoredAlarms,omitempty"`
+}
+
+// Defines the valid console capture destinations.
+const (
+	ConsoleDestinationConfigMap = "configmap"
+	ConsoleDestinationLog       = "log"
+)
+
 // HostSpec defines the desired state of Host
 type HostSpec struct {
 	// Profile defines the name of the HostProfile to use as a configuration
@@ -69,9 +195,20 @@ type HostSpec struct {
 	// composite profile that represents the intended configuration state of
 	// an individual Host resources.  This composite profile also include any
 	// individual host specific attributes defined in the "overrides" attribute
-	// defined below.
+	// defined below.  The profile may be omitted if it is cloned from another
+	// host.
+	// +optional
 	Profile string `json:"profile"`
 
+	// CloneFrom defines the name of another Host resource in the same
+	// namespace from which the profile and overrides are copied when this
+	// host is admitted.  Any overrides specified on this host take precedence
+	// over the copied overrides.  Attributes that are unique to each host,
+	// such as the match criteria, addresses, boot MAC, location, and board
+	// management address are never copied.
+	// +optional
+	CloneFrom *string `json:"cloneFrom,omitempty"`
+
 	// Match defines the attributes used to match a system host resource to a
 	// host CR definition.
 	// +optional
@@ -88,6 +225,314 @@ type HostSpec struct {
 	// "profile" attribute.
 	// +optional
 	Overrides *HostProfileSpec `json:"overrides,omitempty"`
+
+	// VirtualMedia defines the install image used to install a statically
+	// provisioned host through its Redfish board management controller.  When
+	// set, the image is mounted as a virtual CD, the host is set to boot from
+	// it once, and the host is power-cycled as soon as it has been created in
+	// the system inventory.  This allows bare-metal hosts to be installed
+	// without any PXE boot infrastructure.
+	// +optional
+	VirtualMedia *VirtualMediaInfo `json:"virtualMedia,omitempty"`
+
+	// PowerState defines the desired power state of the host.  It is enforced
+	// through the board management controller and takes precedence over the
+	// "powerOn" attribute of the host profile.  A host must be locked before
+	// it can be powered off therefore decommissioned hosts should also set
+	// the administrative state to locked.
+	// +kubebuilder:validation:Enum=on;off
+	// +optional
+	PowerState *string `json:"powerState,omitempty"`
+
+	// ConsoleCapture enables capturing the serial console output of the host
+	// while it is being installed so that failed installations can be
+	// diagnosed without accessing the board management controller directly.
+	// Capturing starts when the host is booted by the deployment manager and
+	// stops once the host comes online.
+	// +optional
+	ConsoleCapture *ConsoleCaptureInfo `json:"consoleCapture,omitempty"`
+
+	// DeletionPolicy defines what happens to the host in the system inventory
+	// when this resource is deleted.  A policy of "delete" locks the host and
+	// then removes it from the system inventory while a policy of "retain"
+	// leaves the host untouched.  The active controller is never deleted.
+	// +kubebuilder:validation:Enum=retain;delete
+	// +optional
+	DeletionPolicy *string `json:"deletionPolicy,omitempty"`
+
+	// ForceUnlock enables issuing a force unlock of the host once several
+	// consecutive normal unlock attempts have failed to unlock it.  This is
+	// intended as an escape hatch for hosts that are stuck in a locked state
+	// and should only be enabled when the cause of the failure is understood.
+	// +optional
+	ForceUnlock *bool `json:"forceUnlock,omitempty"`
+
+	// ReconcilePolicy overrides how the reconciliation of this host is
+	// retried after a failure.
+	// +optional
+	ReconcilePolicy *ReconcilePolicyInfo `json:"reconcilePolicy,omitempty"`
+
+	// Preflight defines the health check run before a disruptive action is
+	// issued to the host.  Alarms ignored here are ignored in addition to
+	// those ignored by the System resource.
+	// +optional
+	Preflight *PreflightInfo `json:"preflight,omitempty"`
+
+	// AllowReboot enables locking and unlocking this host automatically when
+	// a configuration change, such as a hugepage allocation, only takes
+	// effect after a reboot.  When omitted the "allowReboot" option of the
+	// memory reconciler applies.
+	// +optional
+	AllowReboot *bool `json:"allowReboot,omitempty"`
+}
+
+// Defines the valid host power states.
+const (
+	PowerStateOn  = "on"
+	PowerStateOff = "off"
+)
+
+// Defines the valid host deletion policies.
+const (
+	DeletionPolicyRetain = "retain"
+	DeletionPolicyDelete = "delete"
+)
+
+// Defines the condition types reported in the host status.
+const (
+	// ConditionPendingReboot indicates that configuration changes have been
+	// accepted which will only take effect after the host is locked and
+	// unlocked.
+	ConditionPendingReboot = "PendingReboot"
+
+	// ConditionBMCredentialsRejected indicates that the board management
+	// credentials last read from the BM secret could not be applied because
+	// they were rejected by the board management controller or the system.
+	ConditionBMCredentialsRejected = "BMCredentialsRejected"
+
+	// ConditionDeleting reports the progress of removing the host from the
+	// system inventory after this resource has been deleted.
+	ConditionDeleting = "Deleting"
+
+	// ConditionReinstalling reports the progress of a host reinstall that was
+	// requested through the reinstall annotation.
+	ConditionReinstalling = "Reinstalling"
+
+	// ConditionProvisioningOrder reports what the initial provisioning of the
+	// host is waiting for when hosts that must be provisioned first, or the
+	// storage monitors, are not ready yet.
+	ConditionProvisioningOrder = "ProvisioningOrder"
+)
+
+// ConditionPaused indicates that reconciliation of a resource has been
+// suspended through the paused annotation.  It is reported by every resource
+// type that honours the annotation.
+const ConditionPaused = "Paused"
+
+// ConditionPlanned reports the next change which a resource intended to make
+// to the system while plan mode is enabled.  It is only reported by resources
+// which have been reconciled in plan mode at least once.
+const ConditionPlanned = "Planned"
+
+// ConditionAuthenticated reports whether the system endpoint credentials were
+// accepted the last time the resource was reconciled.  It is only reported
+// by resources which have encountered an authentication failure at least
+// once.
+const ConditionAuthenticated = "Authenticated"
+
+// Defines the standard condition types reported by the Host, System,
+// PlatformNetwork, DataNetwork, PtpInstance and PtpInterface resources so
+// that their health can be assessed without knowledge of each resource type.
+// The PendingReboot and Paused conditions complete the standard set.
+const (
+	// ConditionReady indicates that the resource has been reconciled and
+	// matches the system configuration.
+	ConditionReady = "Ready"
+
+	// ConditionInSync indicates whether the resource matched the system
+	// configuration after its last reconciliation.
+	ConditionInSync = "InSync"
+
+	// ConditionDegraded indicates that the last reconciliation of the
+	// resource has failed.
+	ConditionDegraded = "Degraded"
+
+	// ConditionBlocked indicates that the resource cannot be reconciled until
+	// the system or another resource is ready.
+	ConditionBlocked = "Blocked"
+)
+
+// DeltaAttribute defines a single attribute of a resource whose desired value
+// differs from the value currently configured on the system.  Values are
+// reported in their JSON form so that attributes of any type can be listed.
+type DeltaAttribute struct {
+	// Path defines the location of the attribute within the resource
+	// specification (e.g., "storage.filesystems[0].size").
+	Path string `json:"path"`
+
+	// Expected defines the desired value of the attribute.  It is empty if
+	// the attribute is not part of the desired configuration.
+	// +optional
+	Expected string `json:"expected,omitempty"`
+
+	// Actual defines the value currently configured on the system.  It is
+	// empty if the attribute is not configured on the system.
+	// +optional
+	Actual string `json:"actual,omitempty"`
+}
+
+// HostKernelStatus defines the kernel state reported by the system for a
+// host.
+type HostKernelStatus struct {
+	// Provisioned defines the kernel that has been configured for the host.
+	// This becomes the running kernel once the host has been locked and
+	// unlocked.
+	// +optional
+	Provisioned string `json:"provisioned,omitempty"`
+
+	// Running defines the kernel that is currently running on the host.
+	// +optional
+	Running string `json:"running,omitempty"`
+}
+
+// HostBMCredentialsStatus defines the version of the board management
+// credentials secret that was last applied to the host.
+type HostBMCredentialsStatus struct {
+	// Secret defines the name of the secret from which the credentials were
+	// read.
+	Secret string `json:"secret"`
+
+	// ResourceVersion defines the resource version of the secret at the time
+	// the credentials were applied.  A change in the secret resource version
+	// causes the credentials to be re-applied to the host.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// HostNodeHardwareStatus defines the hardware resources reported for a single
+// NUMA node of a host.
+type HostNodeHardwareStatus struct {
+	// Node defines the NUMA node number.
+	Node int `json:"node"`
+
+	// PhysicalCores defines the number of physical cores on the node.
+	PhysicalCores int `json:"physicalCores"`
+
+	// LogicalCores defines the number of logical cores on the node including
+	// any hyper-thread siblings.
+	LogicalCores int `json:"logicalCores"`
+
+	// MemoryMiB defines the total amount of memory installed on the node in
+	// MiB.
+	MemoryMiB int `json:"memoryMiB"`
+}
+
+// HostDiskHardwareStatus defines a single physical disk of a host.
+type HostDiskHardwareStatus struct {
+	// Path defines the absolute device path of the disk.
+	Path string `json:"path"`
+
+	// Node defines the device node of the disk (e.g., /dev/sdb).
+	// +optional
+	Node string `json:"node,omitempty"`
+
+	// SizeMiB defines the capacity of the disk in MiB.
+	SizeMiB int `json:"sizeMiB"`
+}
+
+// HostHardwareStatus defines a summary of the hardware inventory reported by
+// the system for a host.
+type HostHardwareStatus struct {
+	// NUMANodes defines the number of NUMA nodes.  It is not the number of
+	// processor sockets since a socket may be split into several nodes.
+	NUMANodes int `json:"numaNodes"`
+
+	// Nodes defines the per NUMA node processor and memory resources.
+	// +optional
+	Nodes []HostNodeHardwareStatus `json:"nodes,omitempty"`
+
+	// DiskCount defines the number of physical disks.
+	DiskCount int `json:"diskCount"`
+
+	// DiskCapacityMiB defines the total capacity of all physical disks in
+	// MiB.
+	DiskCapacityMiB int `json:"diskCapacityMiB"`
+
+	// Disks defines the physical disks ordered by device path.
+	// +optional
+	Disks []HostDiskHardwareStatus `json:"disks,omitempty"`
+
+	// NICModels defines the list of distinct ethernet port models in the
+	// form "vendor device".
+	// +optional
+	NICModels []string `json:"nicModels,omitempty"`
+}
+
+// Defines the synchronization states reported for the PTP instances and GNSS
+// receivers of a host.
+const (
+	ClockSyncStateLocked   = "locked"
+	ClockSyncStateHoldover = "holdover"
+	ClockSyncStateFreerun  = "freerun"
+)
+
+// HostPTPInstanceSyncStatus defines the synchronization state of a single PTP
+// instance assigned to a host.
+type HostPTPInstanceSyncStatus struct {
+	// Name defines the name of the PTP instance.
+	Name string `json:"name"`
+
+	// State defines the synchronization state of the PTP instance.
+	// +kubebuilder:validation:Enum=locked;holdover;freerun
+	State string `json:"state"`
+}
+
+// HostClockSyncStatus defines the PTP and GNSS synchronization state of a
+// host as derived from the alarms raised by the system during its audits.
+type HostClockSyncStatus struct {
+	// State defines the overall synchronization state of the host which is
+	// the least synchronized state of its PTP instances and GNSS receivers.
+	// +kubebuilder:validation:Enum=locked;holdover;freerun
+	State string `json:"state"`
+
+	// GNSS defines the synchronization state of the GNSS receivers of the
+	// host.  It is only reported when a GNSS alarm has been raised.
+	// +kubebuilder:validation:Enum=locked;holdover;freerun
+	// +optional
+	GNSS *string `json:"gnss,omitempty"`
+
+	// Instances defines the synchronization state of each PTP instance
+	// assigned to the host.
+	// +optional
+	Instances []HostPTPInstanceSyncStatus `json:"instances,omitempty"`
+}
+
+// HostSubsectionScopes defines the deployment scope of individual
+// configuration areas of a host.  An area without a scope follows the
+// deployment scope of the host.  Areas kept in the bootstrap scope are left
+// untouched by day-2 operations so that disruptive changes can remain locked
+// while the host itself is in the principal scope.
+type HostSubsectionScopes struct {
+	// Storage defines the deployment scope of the storage configuration.
+	// +kubebuilder:validation:Enum=bootstrap;principal;Bootstrap;Principal;BOOTSTRAP;PRINCIPAL
+	// +optional
+	Storage *string `json:"storage,omitempty"`
+
+	// Interfaces defines the deployment scope of the interface
+	// configuration.
+	// +kubebuilder:validation:Enum=bootstrap;principal;Bootstrap;Principal;BOOTSTRAP;PRINCIPAL
+	// +optional
+	Interfaces *string `json:"interfaces,omitempty"`
+
+	// PTP defines the deployment scope of the PTP instance assignments and
+	// the clock synchronization source.
+	// +kubebuilder:validation:Enum=bootstrap;principal;Bootstrap;Principal;BOOTSTRAP;PRINCIPAL
+	// +optional
+	PTP *string `json:"ptp,omitempty"`
+
+	// Kernel defines the deployment scope of the kernel configuration.
+	// +kubebuilder:validation:Enum=bootstrap;principal;Bootstrap;Principal;BOOTSTRAP;PRINCIPAL
+	// +optional
+	Kernel *string `json:"kernel,omitempty"`
 }
 
 // HostStatus defines the observed state of Host
@@ -105,6 +550,13 @@ type HostStatus struct {
 	// AvailabilityStatus is the last known availability status of the host.
 	AvailabilityStatus *string `json:"availabilityStatus,omitempty"`
 
+	// Personality is the personality of the host as resolved from its
+	// composite profile.  It is recorded before the host is first provisioned
+	// so that the provisioning order of the hosts can be determined without
+	// resolving the profile of every other host.
+	// +optional
+	Personality *string `json:"personality,omitempty"`
+
 	// Defaults defines the configuration attributed collected before applying
 	// any user configuration values.
 	Defaults *string `json:"defaults,omitempty"`
@@ -126,6 +578,13 @@ type HostStatus struct {
 	// +kubebuilder:default:=bootstrap
 	DeploymentScope string `json:"deploymentScope"`
 
+	// SubsectionScopes defines the deployment scope of individual
+	// configuration areas of the host.  It can only restrict the deployment
+	// scope of the host; areas in the bootstrap scope are not updated while
+	// the host is in the principal scope.
+	// +optional
+	SubsectionScopes *HostSubsectionScopes `json:"subsectionScopes,omitempty"`
+
 	// Reflect value of configuration generation of host profile.
 	// The value will be set when configuration generation is updated.
 	// +optional
@@ -153,6 +612,86 @@ type HostStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// DeltaAttributes lists each attribute which differs between the final
+	// profile and the current configuration in a machine readable form.
+	// +optional
+	DeltaAttributes []DeltaAttribute `json:"deltaAttributes,omitempty"`
+
+	// ManagedAddresses defines the list of addresses that have been created
+	// by the Deployment Manager on this host in the form "address/prefix".
+	// It is used to distinguish addresses owned by the Deployment Manager
+	// from those allocated by the platform so that only owned addresses are
+	// removed once they are no longer present in the configuration.
+	// +optional
+	ManagedAddresses []string `json:"managedAddresses,omitempty"`
+
+	// ManagedLabels defines the list of label keys that have been applied by
+	// the Deployment Manager on this host.  It is used to distinguish labels
+	// owned by the Deployment Manager from those applied by other actors so
+	// that only owned labels are removed once they are no longer present in
+	// the configuration.
+	// +optional
+	ManagedLabels []string `json:"managedLabels,omitempty"`
+
+	// ManagedTaints defines the list of taints, identified by their key and
+	// effect, that have been applied by the Deployment Manager on the
+	// kubernetes node running on this host.  Only owned taints are removed
+	// once they are no longer present in the configuration.
+	// +optional
+	ManagedTaints []string `json:"managedTaints,omitempty"`
+
+	// Kernel defines the provisioned and running kernel of the host.
+	// +optional
+	Kernel *HostKernelStatus `json:"kernel,omitempty"`
+
+	// Hardware defines a summary of the hardware inventory of the host.
+	// +optional
+	Hardware *HostHardwareStatus `json:"hardware,omitempty"`
+
+	// ClockSync defines the PTP and GNSS synchronization state of the host.
+	// It is only reported for hosts which have PTP instances assigned.
+	// +optional
+	ClockSync *HostClockSyncStatus `json:"clockSync,omitempty"`
+
+	// PowerState is the last known power state of the host.
+	// +optional
+	PowerState *string `json:"powerState,omitempty"`
+
+	// InstallState is the last known installation stage of the host.
+	// +optional
+	InstallState *string `json:"installState,omitempty"`
+
+	// InstallStateInfo is the last known progress of the current
+	// installation stage of the host.
+	// +optional
+	InstallStateInfo *string `json:"installStateInfo,omitempty"`
+
+	// BootTime is the approximate time at which the host was last booted.
+	// It is derived from the uptime reported by the system.
+	// +optional
+	BootTime *metav1.Time `json:"bootTime,omitempty"`
+
+	// SoftwareVersion is the software version running on the host.
+	// +optional
+	SoftwareVersion *string `json:"softwareVersion,omitempty"`
+
+	// BMCredentials defines the board management credentials last applied to
+	// the host.
+	// +optional
+	BMCredentials *HostBMCredentialsStatus `json:"bmCredentials,omitempty"`
+
+	// UnlockAttempts defines the number of unlock requests that have been
+	// issued since the host was last unlocked.
+	// +optional
+	UnlockAttempts int `json:"unlockAttempts,omitempty"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the host.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -169,6 +708,9 @@ type HostStatus struct {
 // +kubebuilder:printcolumn:name="insync",type="boolean",JSONPath=".status.inSync",description="The current synchronization state."
 // +kubebuilder:printcolumn:name="scope",type="string",JSONPath=".status.deploymentScope",description="The current deploymentScope state."
 // +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+// +kubebuilder:printcolumn:name="install",type="string",JSONPath=".status.installState",description="The installation state of the host.",priority=1
+// +kubebuilder:printcolumn:name="version",type="string",JSONPath=".status.softwareVersion",description="The software version running on the host.",priority=1
+// +kubebuilder:printcolumn:name="booted",type="date",JSONPath=".status.bootTime",description="The time at which the host was last booted.",priority=1
 // +TODO(ecandotti): enhance docs/playbooks/wind-river-cloud-platform-deployment-manager.yaml#L431 since it's looking for the last column to get 'reconciled' value.
 type Host struct {
 	metav1.TypeMeta   `json:",inline"`
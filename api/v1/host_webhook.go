@@ -1,13 +1,23 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package v1
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/imdario/mergo"
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	apitypes "k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
@@ -15,10 +25,17 @@ import (
 // Webhook response reasons
 const HostAllowedReason string = "allowed to be admitted"
 
+// DefaultHostProfileAnnotation defines the annotation of a namespace which
+// names the profile assigned to hosts created in that namespace without a
+// profile or a host to clone from.
+const DefaultHostProfileAnnotation = "deployment-manager/default-host-profile"
+
 // log is for logging in this package.
 var hostlog = logf.Log.WithName("host-resource")
 
 func (r *Host) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	cl = mgr.GetClient()
+
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
 		Complete()
@@ -32,19 +49,99 @@ var _ webhook.Defaulter = &Host{}
 func (r *Host) Default() {
 	hostlog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.CloneFrom != nil && cl != nil {
+		source := &Host{}
+		name := apitypes.NamespacedName{Namespace: r.Namespace, Name: *r.Spec.CloneFrom}
+		err := cl.Get(context.TODO(), name, source)
+		if err != nil {
+			// The validation webhook will reject the host if the profile
+			// could not be determined.
+			hostlog.Error(err, "unable to get host to clone", "source", *r.Spec.CloneFrom)
+			return
+		}
+
+		err = r.cloneFrom(source)
+		if err != nil {
+			hostlog.Error(err, "unable to clone host", "source", *r.Spec.CloneFrom)
+		}
+	}
+
+	if r.Spec.Profile == "" && cl != nil {
+		namespace := &corev1.Namespace{}
+		err := cl.Get(context.TODO(), apitypes.NamespacedName{Name: r.Namespace}, namespace)
+		if err != nil {
+			// The validation webhook will reject the host since it has no
+			// profile.
+			hostlog.Error(err, "unable to get namespace for default profile", "namespace", r.Namespace)
+			return
+		}
+
+		r.applyDefaultProfile(namespace)
+	}
+}
+
+// applyDefaultProfile assigns the default profile of a namespace to a host
+// which does not specify a profile.
+func (r *Host) applyDefaultProfile(namespace *corev1.Namespace) {
+	if r.Spec.Profile != "" {
+		return
+	}
+
+	profile := strings.TrimSpace(namespace.Annotations[DefaultHostProfileAnnotation])
+	if profile == "" {
+		return
+	}
+
+	hostlog.Info("applying namespace default profile", "name", r.Name, "profile", profile)
+	r.Spec.Profile = profile
+}
+
+// cloneFrom copies the profile and overrides of another host into this host.
+// Overrides that are already present on this host are preserved while those
+// that must be unique to each host are never copied.
+func (r *Host) cloneFrom(source *Host) error {
+	if r.Spec.Profile == "" {
+		r.Spec.Profile = source.Spec.Profile
+	}
+
+	if source.Spec.Overrides == nil {
+		return nil
+	}
+
+	overrides := source.Spec.Overrides.DeepCopy()
+	overrides.Addresses = nil
+	overrides.BootMAC = nil
+	overrides.Location = nil
+	if overrides.BoardManagement != nil {
+		overrides.BoardManagement.Address = nil
+	}
+
+	if r.Spec.Overrides == nil {
+		r.Spec.Overrides = overrides
+		return nil
+	}
+
+	return mergo.Merge(r.Spec.Overrides, overrides)
 }
 
 func (r *Host) validateMatchBMInfo() error {
-	if r.Spec.Match.BoardManagement.Address == nil {
-		return errors.New("board management address must be supplied in match criteria")
+	bm := r.Spec.Match.BoardManagement
+	if bm.Address == nil && bm.MACAddress == nil {
+		return errors.New("board management address or MAC address must be supplied in match criteria")
+	}
+
+	operator := r.Spec.Match.Operator
+	if bm.Type != nil && operator != nil && *operator == MatchOperatorOr {
+		// The type alone is shared by many hosts therefore it cannot be used
+		// to identify a host on its own.
+		return errors.New("board management type cannot be used as match criteria with the \"or\" operator")
 	}
 
 	return nil
 }
 
 func (r *Host) validateMatchDMIInfo() error {
-	if r.Spec.Match.DMI.SerialNumber == nil || r.Spec.Match.DMI.AssetTag == nil {
+	if r.Spec.Match.DMI.SerialNumber == nil && r.Spec.Match.DMI.AssetTag == nil {
 		return errors.New("DMI Serial Number or Asset Tag must be supplied in match criteria")
 	}
 
@@ -75,13 +172,200 @@ func (r *Host) validateMatchInfo() error {
 	return nil
 }
 
+// knownDataNetworks returns the names of the data networks defined in the
+// namespace of the host.  Nothing is returned if they cannot be determined so
+// that data network references are not validated.
+func (r *Host) knownDataNetworks() map[string]bool {
+	list := &DataNetworkList{}
+	err := cl.List(context.TODO(), list, client.InNamespace(r.Namespace))
+	if err != nil || len(list.Items) == 0 {
+		return nil
+	}
+
+	result := make(map[string]bool)
+	for _, d := range list.Items {
+		result[d.Name] = true
+	}
+
+	return result
+}
+
+// knownPlatformNetworks returns the names of the platform networks defined in
+// the namespace of the host.  Nothing is returned if they cannot be
+// determined so that platform network references are not validated.
+func (r *Host) knownPlatformNetworks() map[string]bool {
+	list := &PlatformNetworkList{}
+	err := cl.List(context.TODO(), list, client.InNamespace(r.Namespace))
+	if err != nil || len(list.Items) == 0 {
+		return nil
+	}
+
+	result := make(map[string]bool)
+	for _, p := range list.Items {
+		result[p.Name] = true
+	}
+
+	return result
+}
+
+// roleSubFunctions returns the subfunctions which define the role of a host.
+// The lowlatency subfunction follows the kernel of the host and may change
+// at any time therefore it is not considered.
+func roleSubFunctions(list []SubFunction) []string {
+	result := make([]string, 0, len(list))
+	for _, s := range list {
+		if s != "lowlatency" && !common.ContainsString(result, string(s)) {
+			result = append(result, string(s))
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// validateProvisionedRole ensures that the personality and subfunctions of a
+// provisioned host are not changed.  The system API cannot change the role of
+// an existing host so such changes would otherwise only fail once reconciled.
+func validateProvisionedRole(profile *HostProfileSpec, provisioned *HostProfileSpec) error {
+	if profile.Personality != nil && provisioned.Personality != nil &&
+		*profile.Personality != *provisioned.Personality {
+		return fmt.Errorf("personality cannot be changed from %q to %q once the host is provisioned; delete and re-create the host instead",
+			*provisioned.Personality, *profile.Personality)
+	}
+
+	if profile.SubFunctions != nil && provisioned.SubFunctions != nil {
+		current := roleSubFunctions(provisioned.SubFunctions)
+		desired := roleSubFunctions(profile.SubFunctions)
+		if common.ListChanged(current, desired) {
+			return fmt.Errorf("subfunctions cannot be changed from %q to %q once the host is provisioned; delete and re-create the host instead",
+				strings.Join(current, ","), strings.Join(desired, ","))
+		}
+	}
+
+	return nil
+}
+
+// validateCompositeProfile flattens the profile hierarchy of the host together
+// with its overrides and rejects combinations of attributes that are invalid
+// once merged.  The validation is skipped while any of the profiles is missing
+// since they may be created after the host.
+func (r *Host) validateCompositeProfile() error {
+	if cl == nil {
+		return nil
+	}
+
+	lookup := func(name string) (*HostProfileSpec, error) {
+		profile, err := getHostProfile(r.Namespace, name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &profile.Spec, nil
+	}
+
+	profile, err := lookup(r.Spec.Profile)
+	if err != nil {
+		hostlog.Info("unable to get host profile", "profile", r.Spec.Profile, "error", err.Error())
+		return nil
+	}
+
+	// The composite profile is only complete once it is backed by the
+	// default attributes collected from the host.
+	root := &HostProfileSpec{}
+	complete := false
+	if r.Status.Defaults != nil {
+		if json.Unmarshal([]byte(*r.Status.Defaults), root) == nil {
+			complete = true
+		} else {
+			root = &HostProfileSpec{}
+		}
+	}
+
+	// The defaults hold the role of the host as it was provisioned.
+	provisioned := root.DeepCopy()
+
+	visited := map[string]bool{r.Spec.Profile: true}
+	composite, err := FlattenHostProfile(lookup, profile, root, visited)
+	if err != nil {
+		var mergeErr ProfileMergeError
+		if errors.As(err, &mergeErr) {
+			return err
+		}
+
+		hostlog.Info("unable to flatten host profile", "profile", r.Spec.Profile, "error", err.Error())
+		return nil
+	}
+
+	if r.Spec.Overrides != nil {
+		composite, err = MergeHostProfiles(composite, r.Spec.Overrides.DeepCopy())
+		if err != nil {
+			return err
+		}
+	}
+
+	if complete && r.Status.ID != nil {
+		err = validateProvisionedRole(composite, provisioned)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = ValidateCompositeProfile(composite, r.knownDataNetworks(), r.knownPlatformNetworks(), complete)
+	if err != nil {
+		return err
+	}
+
+	if r.Status.Hardware != nil {
+		err = ValidateJournalSizing(composite, r.Status.Hardware.Disks)
+		if err != nil {
+			return err
+		}
+
+		return ValidateMemorySizing(composite, r.Status.Hardware.Nodes)
+	}
+
+	return nil
+}
+
 func (r *Host) validateHost() error {
+	if r.Spec.CloneFrom != nil && *r.Spec.CloneFrom == r.Name {
+		return errors.New("host cannot be cloned from itself")
+	}
+
+	if r.Spec.Profile == "" {
+		if r.Spec.CloneFrom != nil {
+			return fmt.Errorf("unable to determine profile from host to clone: %s", *r.Spec.CloneFrom)
+		}
+
+		return fmt.Errorf("host must specify a profile or a host to clone from, or its namespace must have the %s annotation",
+			DefaultHostProfileAnnotation)
+	}
+
 	if r.Spec.Match != nil {
 		err := r.validateMatchInfo()
 		if err != nil {
 			return err
 		}
 	}
+
+	if r.Spec.Overrides != nil {
+		err := validateIgnoreFields(r.Spec.Overrides.IgnoreFields)
+		if err != nil {
+			return err
+		}
+
+		err = ValidateInterfaceClassNetworks(r.Spec.Overrides)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := r.validateCompositeProfile()
+	if err != nil {
+		return err
+	}
+
 	hostlog.Info(HostAllowedReason)
 	return nil
 }
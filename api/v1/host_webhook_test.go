@@ -7,6 +7,8 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("system_webhook functions", func() {
@@ -28,6 +30,22 @@ var _ = Describe("system_webhook functions", func() {
 				Expect(err).To(BeNil())
 			})
 		})
+		Context("When only the board management MAC address is set", func() {
+			It("validates succesfully without error", func() {
+				bmMAC := "01:02:03:04:05:07"
+				r := &Host{
+					Spec: HostSpec{
+						Match: &MatchInfo{
+							BoardManagement: &MatchBMInfo{
+								MACAddress: &bmMAC,
+							},
+						},
+					},
+				}
+				err := r.validateMatchBMInfo()
+				Expect(err).To(BeNil())
+			})
+		})
 		Context("When the board management address is nil", func() {
 			It("Throws the error board management address must be supplied in match criteria", func() {
 				r := &Host{
@@ -37,7 +55,28 @@ var _ = Describe("system_webhook functions", func() {
 						},
 					},
 				}
-				msg := errors.New("board management address must be supplied in match criteria")
+				msg := errors.New("board management address or MAC address must be supplied in match criteria")
+				err := r.validateMatchBMInfo()
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the board management type is used with the or operator", func() {
+			It("Throws the error board management type cannot be used with the or operator", func() {
+				bmAddr := "192.13.24.39"
+				bmType := "redfish"
+				operator := MatchOperatorOr
+				r := &Host{
+					Spec: HostSpec{
+						Match: &MatchInfo{
+							Operator: &operator,
+							BoardManagement: &MatchBMInfo{
+								Address: &bmAddr,
+								Type:    &bmType,
+							},
+						},
+					},
+				}
+				msg := errors.New("board management type cannot be used as match criteria with the \"or\" operator")
 				err := r.validateMatchBMInfo()
 				Expect(err).To(Equal(msg))
 			})
@@ -62,8 +101,8 @@ var _ = Describe("system_webhook functions", func() {
 				Expect(err).To(BeNil())
 			})
 		})
-		Context("When both serial no and assetTag are nil", func() {
-			It("Throws the error DMI Serial Number or Asset Tag must be supplied in match criteria", func() {
+		Context("When only the assetTag is set", func() {
+			It("successfull without any error", func() {
 				astTag := "90"
 				r := &Host{
 					Spec: HostSpec{
@@ -74,6 +113,19 @@ var _ = Describe("system_webhook functions", func() {
 						},
 					},
 				}
+				err := r.validateMatchDMIInfo()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When both serial no and assetTag are nil", func() {
+			It("Throws the error DMI Serial Number or Asset Tag must be supplied in match criteria", func() {
+				r := &Host{
+					Spec: HostSpec{
+						Match: &MatchInfo{
+							DMI: &MatchDMIInfo{},
+						},
+					},
+				}
 				msg := errors.New("DMI Serial Number or Asset Tag must be supplied in match criteria")
 				err := r.validateMatchDMIInfo()
 				Expect(err).To(Equal(msg))
@@ -129,6 +181,7 @@ var _ = Describe("system_webhook functions", func() {
 
 				r := &Host{
 					Spec: HostSpec{
+						Profile: "profile",
 						Match: &MatchInfo{
 							BoardManagement: &MatchBMInfo{
 								Address: &bmAddr,
@@ -145,5 +198,144 @@ var _ = Describe("system_webhook functions", func() {
 				Expect(err).To(BeNil())
 			})
 		})
+		Context("When neither a profile nor a host to clone is specified", func() {
+			It("Throws the host must specify a profile error", func() {
+				r := &Host{}
+				msg := errors.New("host must specify a profile or a host to clone from, or its namespace must have the deployment-manager/default-host-profile annotation")
+				err := r.validateHost()
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the host is cloned from itself", func() {
+			It("Throws the host cannot be cloned from itself error", func() {
+				name := "worker-0"
+				r := &Host{Spec: HostSpec{Profile: "profile", CloneFrom: &name}}
+				r.Name = name
+				msg := errors.New("host cannot be cloned from itself")
+				err := r.validateHost()
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+	Describe("cloneFrom function is tested", func() {
+		Context("When the source host has overrides", func() {
+			It("Copies the shared overrides and keeps host specific attributes", func() {
+				console := "ttyS0,115200"
+				location := "rack 1"
+				bootMac := "01:02:03:04:05:06"
+				bmAddr := "192.168.1.10"
+				bmType := "redfish"
+				ownConsole := "tty0"
+				source := &Host{
+					Spec: HostSpec{
+						Profile: "worker-profile",
+						Overrides: &HostProfileSpec{
+							ProfileBaseAttributes: ProfileBaseAttributes{
+								Console:  &console,
+								Location: &location,
+								BootMAC:  &bootMac,
+							},
+							BoardManagement: &BMInfo{Type: &bmType, Address: &bmAddr},
+							Addresses:       AddressList{{Interface: "mgmt0", Address: "10.10.10.3", Prefix: 24}},
+						},
+					},
+				}
+				r := &Host{
+					Spec: HostSpec{
+						Overrides: &HostProfileSpec{
+							ProfileBaseAttributes: ProfileBaseAttributes{
+								Console: &ownConsole,
+							},
+						},
+					},
+				}
+				err := r.cloneFrom(source)
+				Expect(err).To(BeNil())
+				Expect(r.Spec.Profile).To(Equal("worker-profile"))
+				Expect(*r.Spec.Overrides.Console).To(Equal(ownConsole))
+				Expect(r.Spec.Overrides.Location).To(BeNil())
+				Expect(r.Spec.Overrides.BootMAC).To(BeNil())
+				Expect(r.Spec.Overrides.Addresses).To(BeNil())
+				Expect(*r.Spec.Overrides.BoardManagement.Type).To(Equal(bmType))
+				Expect(r.Spec.Overrides.BoardManagement.Address).To(BeNil())
+				Expect(source.Spec.Overrides.BoardManagement.Address).NotTo(BeNil())
+			})
+		})
+	})
+
+	Describe("applyDefaultProfile function is tested", func() {
+		namespace := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "deployment",
+				Annotations: map[string]string{DefaultHostProfileAnnotation: "worker-profile"},
+			},
+		}
+		Context("When the host does not specify a profile", func() {
+			It("Assigns the default profile of the namespace", func() {
+				r := &Host{}
+				r.applyDefaultProfile(namespace)
+				Expect(r.Spec.Profile).To(Equal("worker-profile"))
+			})
+		})
+		Context("When the host specifies a profile", func() {
+			It("Keeps the profile of the host", func() {
+				r := &Host{Spec: HostSpec{Profile: "storage-profile"}}
+				r.applyDefaultProfile(namespace)
+				Expect(r.Spec.Profile).To(Equal("storage-profile"))
+			})
+		})
+		Context("When the namespace has no default profile", func() {
+			It("Leaves the profile unset", func() {
+				r := &Host{}
+				r.applyDefaultProfile(&corev1.Namespace{})
+				Expect(r.Spec.Profile).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("validateProvisionedRole function is tested", func() {
+		controller := "controller"
+		worker := "worker"
+		provisioned := &HostProfileSpec{
+			ProfileBaseAttributes: ProfileBaseAttributes{
+				Personality:  &controller,
+				SubFunctions: []SubFunction{"controller", "worker", "lowlatency"},
+			},
+		}
+
+		Context("When only the lowlatency subfunction changes", func() {
+			It("validates succesfully without error", func() {
+				profile := &HostProfileSpec{
+					ProfileBaseAttributes: ProfileBaseAttributes{
+						Personality:  &controller,
+						SubFunctions: []SubFunction{"worker", "controller"},
+					},
+				}
+				Expect(validateProvisionedRole(profile, provisioned)).To(BeNil())
+			})
+		})
+		Context("When the personality changes", func() {
+			It("Throws the personality cannot be changed error", func() {
+				profile := &HostProfileSpec{
+					ProfileBaseAttributes: ProfileBaseAttributes{
+						Personality: &worker,
+					},
+				}
+				err := validateProvisionedRole(profile, provisioned)
+				Expect(err).To(MatchError(ContainSubstring(`personality cannot be changed from "controller" to "worker"`)))
+			})
+		})
+		Context("When the subfunctions change", func() {
+			It("Throws the subfunctions cannot be changed error", func() {
+				profile := &HostProfileSpec{
+					ProfileBaseAttributes: ProfileBaseAttributes{
+						Personality:  &controller,
+						SubFunctions: []SubFunction{"controller"},
+					},
+				}
+				err := validateProvisionedRole(profile, provisioned)
+				Expect(err).To(MatchError(ContainSubstring(`subfunctions cannot be changed from "controller,worker" to "controller"`)))
+			})
+		})
 	})
 })
@@ -0,0 +1,123 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+)
+
+// BuiltinHostProfilePrefix defines the name prefix reserved for the
+// HostProfile templates shipped with the Deployment Manager.
+const BuiltinHostProfilePrefix = "builtin-"
+
+// BuiltinHostProfileLabel defines the label added to HostProfile resources
+// which were instantiated from a built-in template.  Its value is the version
+// of the template.
+const BuiltinHostProfileLabel = "starlingx.windriver.com/builtin-profile-version"
+
+//go:embed profiles/*.yaml
+var builtinProfileFiles embed.FS
+
+var builtinProfileNameRegex = regexp.MustCompile(`^(.+)-v([0-9]+)$`)
+
+// builtinHostProfile defines a single version of a built-in HostProfile
+// template.
+type builtinHostProfile struct {
+	version int
+	profile HostProfile
+}
+
+// builtinHostProfiles holds each built-in template indexed by both its
+// versioned name and its unversioned name.  The unversioned name always
+// refers to the latest version of the template.
+var builtinHostProfiles map[string]builtinHostProfile
+
+// loadBuiltinHostProfiles parses the embedded HostProfile templates.
+func loadBuiltinHostProfiles() (map[string]builtinHostProfile, error) {
+	result := make(map[string]builtinHostProfile)
+
+	entries, err := builtinProfileFiles.ReadDir("profiles")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		data, err := builtinProfileFiles.ReadFile(path.Join("profiles", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		profile := HostProfile{}
+		err = yaml.Unmarshal(data, &profile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse built-in profile %s: %s", entry.Name(), err.Error())
+		}
+
+		match := builtinProfileNameRegex.FindStringSubmatch(profile.Name)
+		if match == nil {
+			return nil, fmt.Errorf("built-in profile %q has no version suffix", profile.Name)
+		}
+
+		version, _ := strconv.Atoi(match[2])
+		item := builtinHostProfile{version: version, profile: profile}
+		result[profile.Name] = item
+
+		if latest, ok := result[match[1]]; !ok || latest.version < version {
+			result[match[1]] = item
+		}
+	}
+
+	return result, nil
+}
+
+// BuiltinHostProfile returns a copy of the built-in HostProfile template
+// with the specified name in the specified namespace.  A template can be
+// referenced by its versioned name (e.g., "builtin-standard-worker-v1") or by
+// its unversioned name to select the latest version.
+func BuiltinHostProfile(name, namespace string) (*HostProfile, bool) {
+	item, ok := builtinHostProfiles[name]
+	if !ok {
+		return nil, false
+	}
+
+	profile := item.profile.DeepCopy()
+	profile.TypeMeta.APIVersion = APIVersion
+	profile.TypeMeta.Kind = KindHostProfile
+	profile.Name = name
+	profile.Namespace = namespace
+	profile.Labels = map[string]string{
+		BuiltinHostProfileLabel: strconv.Itoa(item.version),
+	}
+
+	return profile, true
+}
+
+// BuiltinHostProfileNames returns the sorted list of names which can be used
+// to reference a built-in HostProfile template.
+func BuiltinHostProfileNames() []string {
+	result := make([]string, 0, len(builtinHostProfiles))
+	for name := range builtinHostProfiles {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+func init() {
+	var err error
+
+	builtinHostProfiles, err = loadBuiltinHostProfiles()
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,41 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("hostProfile library functions", func() {
+	Describe("BuiltinHostProfile function is tested", func() {
+		Context("When the template is referenced by its unversioned name", func() {
+			It("Returns the latest version of the template", func() {
+				profile, ok := BuiltinHostProfile("builtin-lowlatency-vdu-worker", "deployment")
+				Expect(ok).To(BeTrue())
+				Expect(profile.Name).To(Equal("builtin-lowlatency-vdu-worker"))
+				Expect(profile.Namespace).To(Equal("deployment"))
+				Expect(profile.Labels[BuiltinHostProfileLabel]).To(Equal("1"))
+				Expect(*profile.Spec.Kernel).To(Equal("lowlatency"))
+				Expect(profile.Spec.SubFunctions).To(ContainElement(SubFunction("lowlatency")))
+			})
+		})
+		Context("When the template does not exist", func() {
+			It("Returns nothing", func() {
+				_, ok := BuiltinHostProfile("builtin-unknown", "deployment")
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("BuiltinHostProfileNames function is tested", func() {
+		It("Lists the versioned and unversioned names of each template", func() {
+			Expect(BuiltinHostProfileNames()).To(Equal([]string{
+				"builtin-aio-controller", "builtin-aio-controller-v1",
+				"builtin-lowlatency-vdu-worker", "builtin-lowlatency-vdu-worker-v1",
+				"builtin-standard-worker", "builtin-standard-worker-v1",
+				"builtin-storage-node", "builtin-storage-node-v1",
+			}))
+		})
+	})
+})
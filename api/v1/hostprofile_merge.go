@@ -0,0 +1,653 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"fmt"
+	"net"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/alecthomas/units"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/interfaces"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/memory"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/osds"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/physicalvolumes"
+	"github.com/imdario/mergo"
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
+)
+
+// HostProfileLookup defines the function used to retrieve a HostProfileSpec
+// by name while flattening a profile hierarchy.
+// +kubebuilder:object:generate=false
+type HostProfileLookup func(name string) (*HostProfileSpec, error)
+
+// ProfileMergeError defines an error raised when a profile hierarchy cannot
+// be flattened because of the way its profiles reference each other.
+// +kubebuilder:object:generate=false
+type ProfileMergeError struct {
+	message string
+}
+
+func (in ProfileMergeError) Error() string {
+	return in.message
+}
+
+// NewProfileMergeError returns a new profile merge error.
+func NewProfileMergeError(format string, args ...interface{}) error {
+	return ProfileMergeError{message: fmt.Sprintf(format, args...)}
+}
+
+// MergeHostProfiles merges the attributes of a higher precedence profile into
+// a lower precedence profile according to the rules documented on the Base
+// attribute of the HostProfileSpec.
+func MergeHostProfiles(a, b *HostProfileSpec) (*HostProfileSpec, error) {
+	t := common.DefaultMergeTransformer
+	err := mergo.Merge(a, b, mergo.WithOverride, mergo.WithTransformers(t))
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// FlattenHostProfile merges the base profile chain, the mixins and the
+// attributes of a profile, in that order, over the supplied root attributes.
+// The visited map tracks the profiles being flattened so that loops are
+// caught while still allowing several mixins to share a common base profile.
+// Errors returned by the lookup function are returned unchanged.
+func FlattenHostProfile(lookup HostProfileLookup, current *HostProfileSpec, root *HostProfileSpec, visited map[string]bool) (*HostProfileSpec, error) {
+	result := root
+
+	if current.Base != nil {
+		parent, err := lookupVisited(lookup, *current.Base, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err = FlattenHostProfile(lookup, parent, result, visited)
+		delete(visited, *current.Base)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(current.Mixins) > 0 {
+		mixins := make([]HostProfileSpec, 0, len(current.Mixins))
+		for _, name := range current.Mixins {
+			mixin, err := lookupVisited(lookup, name, visited)
+			if err != nil {
+				return nil, err
+			}
+
+			mixins = append(mixins, *mixin.DeepCopy())
+
+			// Each mixin is flattened on its own so that attributes
+			// inherited through its base profiles are included without
+			// also pulling in the root attributes a second time.
+			fragment, err := FlattenHostProfile(lookup, mixin, &HostProfileSpec{}, visited)
+			delete(visited, name)
+			if err != nil {
+				return nil, err
+			}
+
+			fragment.Base = nil
+			fragment.Mixins = nil
+
+			result, err = MergeHostProfiles(result, fragment)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err := CheckMixinConflicts(current.Mixins, mixins)
+		if err != nil {
+			return nil, ProfileMergeError{message: err.Error()}
+		}
+	}
+
+	return MergeHostProfiles(result, current)
+}
+
+// lookupVisited retrieves a profile referenced while flattening a profile and
+// marks it as visited.  An error is returned if the profile is already being
+// flattened since that indicates a loop.
+func lookupVisited(lookup HostProfileLookup, name string, visited map[string]bool) (*HostProfileSpec, error) {
+	if value, ok := visited[name]; ok && value {
+		return nil, NewProfileMergeError("profile loop detected at: %s", name)
+	}
+
+	spec, err := lookup(name)
+	if err != nil {
+		return nil, err
+	}
+
+	visited[name] = true
+
+	return spec, nil
+}
+
+// profileInterfaceNames returns the set of interface names defined by a
+// profile.
+func profileInterfaceNames(profile *HostProfileSpec) map[string]bool {
+	result := make(map[string]bool)
+	if profile.Interfaces == nil {
+		return result
+	}
+
+	for _, e := range profile.Interfaces.Ethernet {
+		result[e.Name] = true
+	}
+
+	for _, b := range profile.Interfaces.Bond {
+		result[b.Name] = true
+	}
+
+	for _, v := range profile.Interfaces.VLAN {
+		result[v.Name] = true
+	}
+
+	for _, vf := range profile.Interfaces.VF {
+		result[vf.Name] = true
+	}
+
+	return result
+}
+
+// validateCompositeOSDs ensures that each OSD journal references an OSD which
+// is configured with the journal function and that journal OSDs do not have a
+// journal of their own.  References to OSDs which are not defined are only
+// reported when the profile is complete.
+func validateCompositeOSDs(profile *HostProfileSpec, complete bool) []string {
+	result := make([]string, 0)
+	if profile.Storage == nil || profile.Storage.OSDs == nil {
+		return result
+	}
+
+	functions := make(map[string]string)
+	for _, osd := range *profile.Storage.OSDs {
+		functions[osd.Path] = osd.Function
+	}
+
+	for _, osd := range *profile.Storage.OSDs {
+		if osd.Journal == nil {
+			continue
+		}
+
+		if osd.Function == osds.FunctionJournal {
+			path := fmt.Sprintf("$.storage.osds[path=%s].journal", osd.Path)
+			result = append(result, fmt.Sprintf("%s: journal OSDs must not have a journal", path))
+			continue
+		}
+
+		path := fmt.Sprintf("$.storage.osds[path=%s].journal.location", osd.Path)
+		function, ok := functions[osd.Journal.Location]
+		if !ok && complete {
+			result = append(result, fmt.Sprintf("%s: references undefined OSD %q", path, osd.Journal.Location))
+		} else if ok && function != osds.FunctionJournal {
+			result = append(result, fmt.Sprintf("%s: references OSD %q which is not a journal OSD", path, osd.Journal.Location))
+		}
+	}
+
+	return result
+}
+
+// findHardwareDisk returns the disk of a host referred to by either its device
+// path or its device node.
+func findHardwareDisk(disks []HostDiskHardwareStatus, path string) *HostDiskHardwareStatus {
+	for i := range disks {
+		if common.ComparePartitionPaths(disks[i].Path, path) || disks[i].Node == path {
+			return &disks[i]
+		}
+	}
+
+	return nil
+}
+
+// ValidateJournalSizing ensures that the journals allocated on each journal
+// OSD fit on its disk.  The disks are those of the hardware inventory of the
+// host; journal OSDs whose disk is not part of the inventory are not checked.
+func ValidateJournalSizing(profile *HostProfileSpec, disks []HostDiskHardwareStatus) error {
+	if profile.Storage == nil || profile.Storage.OSDs == nil || len(disks) == 0 {
+		return nil
+	}
+
+	demand := make(map[string]int)
+	for _, osd := range *profile.Storage.OSDs {
+		if osd.Journal != nil {
+			demand[osd.Journal.Location] += osd.Journal.Size
+		}
+	}
+
+	problems := make([]string, 0)
+	for _, osd := range *profile.Storage.OSDs {
+		if osd.Function != osds.FunctionJournal || demand[osd.Path] == 0 {
+			continue
+		}
+
+		disk := findHardwareDisk(disks, osd.Path)
+		if disk == nil {
+			continue
+		}
+
+		if demand[osd.Path]*1024 > disk.SizeMiB {
+			path := fmt.Sprintf("$.storage.osds[path=%s]", osd.Path)
+			problems = append(problems, fmt.Sprintf("%s: journals require %d GiB but the disk only provides %d GiB",
+				path, demand[osd.Path], disk.SizeMiB/1024))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(problems)
+
+	return fmt.Errorf("composite profile is invalid: %s", strings.Join(problems, "; "))
+}
+
+// profileMemoryMegabytes returns the amount of memory, in MiB, that a profile
+// reserves for the platform and for hugepages on a NUMA node.  The platform
+// attribute of the node takes the place of a platform function allocation.
+func profileMemoryMegabytes(node MemoryNodeInfo) (platform int, hugepages int) {
+	if node.Platform != nil {
+		platform = *node.Platform
+	}
+
+	for _, f := range node.Functions {
+		size := f.PageCount * PageSize(f.PageSize).Bytes() / int(units.Mebibyte)
+		if f.Function == memory.MemoryFunctionPlatform {
+			if node.Platform == nil {
+				platform += size
+			}
+			continue
+		}
+
+		hugepages += size
+	}
+
+	return platform, hugepages
+}
+
+// ValidateMemorySizing ensures that the platform memory and hugepages
+// requested by a profile fit within the memory of each NUMA node.  The nodes
+// are those of the hardware inventory of the host.  Only the allocations set
+// by the profile are counted since the current allocations of the host are
+// not known at admission time; the remaining allocations are checked against
+// the inventory when the profile is reconciled.
+func ValidateMemorySizing(profile *HostProfileSpec, nodes []HostNodeHardwareStatus) error {
+	if len(profile.Memory) == 0 || len(nodes) == 0 {
+		return nil
+	}
+
+	total := make(map[int]int)
+	for _, n := range nodes {
+		total[n.Node] = n.MemoryMiB
+	}
+
+	problems := make([]string, 0)
+	for _, n := range profile.Memory {
+		if total[n.Node] == 0 {
+			continue
+		}
+
+		platform, hugepages := profileMemoryMegabytes(n)
+		available := total[n.Node] - platform
+		if hugepages > available {
+			path := fmt.Sprintf("$.memory[node=%d]", n.Node)
+			problems = append(problems, fmt.Sprintf("%s: hugepages require %d MiB but only %d MiB are available after reserving %d MiB for the platform",
+				path, hugepages, available, platform))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(problems)
+
+	return fmt.Errorf("composite profile is invalid: %s", strings.Join(problems, "; "))
+}
+
+// isRootDevice determines whether a device path refers to the root device of
+// a host.  When the root device is not known the path is matched against the
+// configured deny-list patterns instead.  Paths are compared as written
+// therefore a device referred to by two different paths is not detected.
+func isRootDevice(device string, rootDevice *string) bool {
+	if rootDevice != nil {
+		return device == *rootDevice
+	}
+
+	for _, pattern := range common.GetRootDeviceDenyList() {
+		if matched, err := path.Match(pattern, device); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateRootDeviceUsage ensures that neither OSDs nor physical volume disks
+// are configured on the root device since provisioning them wipes the entire
+// disk.  Physical volume partitions are created alongside the root file
+// system therefore they are allowed.
+func validateRootDeviceUsage(profile *HostProfileSpec) []string {
+	result := make([]string, 0)
+	if profile.Storage == nil {
+		return result
+	}
+
+	if profile.Storage.OSDs != nil {
+		for _, osd := range *profile.Storage.OSDs {
+			if isRootDevice(osd.Path, profile.RootDevice) {
+				path := fmt.Sprintf("$.storage.osds[path=%s].path", osd.Path)
+				result = append(result, fmt.Sprintf("%s: must not use the root device %q", path, osd.Path))
+			}
+		}
+	}
+
+	if profile.Storage.VolumeGroups != nil {
+		for _, vg := range *profile.Storage.VolumeGroups {
+			for _, pv := range vg.PhysicalVolumes {
+				if pv.Type != physicalvolumes.PVTypeDisk || !isRootDevice(pv.Path, profile.RootDevice) {
+					continue
+				}
+
+				path := fmt.Sprintf("$.storage.volumeGroups[name=%s].physicalVolumes[path=%s].path", vg.Name, pv.Path)
+				result = append(result, fmt.Sprintf("%s: must not use the root device %q as a disk; use a partition instead", path, pv.Path))
+			}
+		}
+	}
+
+	return result
+}
+
+// Defines the bounds of the interface attributes which are validated at
+// admission time.
+const (
+	minInterfaceMTU = 576
+	maxInterfaceMTU = 9216
+	minVLANID       = 1
+	maxVLANID       = 4094
+)
+
+// validateInterfaceAttributes ensures that the attributes of the interfaces
+// of a profile are individually valid and consistent with each other.  Only
+// attributes that are set are checked so that partial profiles can be
+// validated before being merged.
+func validateInterfaceAttributes(profile *HostProfileSpec) []string {
+	result := make([]string, 0)
+
+	if profile.BootMAC != nil {
+		mac, err := net.ParseMAC(*profile.BootMAC)
+		if err != nil || len(mac) != 6 {
+			result = append(result, fmt.Sprintf("$.bootMAC: invalid MAC address %q", *profile.BootMAC))
+		}
+	}
+
+	if profile.Interfaces == nil {
+		return result
+	}
+
+	names := make(map[string]string)
+	mtus := make(map[string]*int)
+
+	checkCommon := func(path, kind string, info CommonInterfaceInfo) {
+		if other, ok := names[info.Name]; ok {
+			result = append(result, fmt.Sprintf("%s.name: duplicates the name of %s", path, other))
+		} else {
+			names[info.Name] = fmt.Sprintf("%s interface %q", kind, info.Name)
+		}
+
+		if info.MTU != nil {
+			mtus[info.Name] = info.MTU
+			if *info.MTU < minInterfaceMTU || *info.MTU > maxInterfaceMTU {
+				result = append(result, fmt.Sprintf("%s.mtu: %d is out of range %d-%d",
+					path, *info.MTU, minInterfaceMTU, maxInterfaceMTU))
+			}
+		}
+	}
+
+	checkLowerMTU := func(path string, info CommonInterfaceInfo, lower string) {
+		mtu := mtus[lower]
+		if info.MTU != nil && mtu != nil && *info.MTU > *mtu {
+			result = append(result, fmt.Sprintf("%s.mtu: %d exceeds the MTU %d of lower interface %q",
+				path, *info.MTU, *mtu, lower))
+		}
+	}
+
+	for _, e := range profile.Interfaces.Ethernet {
+		checkCommon(fmt.Sprintf("$.interfaces.ethernet[name=%s]", e.Name), "ethernet", e.CommonInterfaceInfo)
+	}
+
+	for _, b := range profile.Interfaces.Bond {
+		path := fmt.Sprintf("$.interfaces.bond[name=%s]", b.Name)
+		checkCommon(path, "bond", b.CommonInterfaceInfo)
+
+		if len(b.Members) == 0 {
+			result = append(result, fmt.Sprintf("%s.members: at least one member is required", path))
+		}
+
+		members := make(map[string]bool)
+		for _, m := range b.Members {
+			if m == b.Name {
+				result = append(result, fmt.Sprintf("%s.members: must not reference the bond itself", path))
+			} else if members[m] {
+				result = append(result, fmt.Sprintf("%s.members: duplicate member %q", path, m))
+			}
+			members[m] = true
+		}
+	}
+
+	vids := make(map[string]string)
+	for _, v := range profile.Interfaces.VLAN {
+		path := fmt.Sprintf("$.interfaces.vlan[name=%s]", v.Name)
+		checkCommon(path, "vlan", v.CommonInterfaceInfo)
+
+		if v.VID < minVLANID || v.VID > maxVLANID {
+			result = append(result, fmt.Sprintf("%s.vid: %d is out of range %d-%d",
+				path, v.VID, minVLANID, maxVLANID))
+		}
+
+		key := fmt.Sprintf("%s/%d", v.Lower, v.VID)
+		if other, ok := vids[key]; ok {
+			result = append(result, fmt.Sprintf("%s.vid: duplicates the VLAN ID of interface %q on %q",
+				path, other, v.Lower))
+		} else {
+			vids[key] = v.Name
+		}
+	}
+
+	for _, vf := range profile.Interfaces.VF {
+		checkCommon(fmt.Sprintf("$.interfaces.vf[name=%s]", vf.Name), "vf", vf.CommonInterfaceInfo)
+	}
+
+	// The lower interface of a VLAN or VF may be defined in any list
+	// therefore MTUs are only compared once all of them are known.
+	for _, v := range profile.Interfaces.VLAN {
+		checkLowerMTU(fmt.Sprintf("$.interfaces.vlan[name=%s]", v.Name), v.CommonInterfaceInfo, v.Lower)
+	}
+
+	for _, vf := range profile.Interfaces.VF {
+		checkLowerMTU(fmt.Sprintf("$.interfaces.vf[name=%s]", vf.Name), vf.CommonInterfaceInfo, vf.Lower)
+	}
+
+	return result
+}
+
+// validateInterfaceClassNetworks ensures that the networks assigned to each
+// interface are supported by its class.  Platform networks can only be
+// attached to platform and data interfaces while data networks can only be
+// attached to data, pci-sriov, and pci-passthrough interfaces.  Empty lists
+// are allowed since they remove any existing assignment.
+func validateInterfaceClassNetworks(profile *HostProfileSpec) []string {
+	result := make([]string, 0)
+	if profile.Interfaces == nil {
+		return result
+	}
+
+	check := func(path string, info CommonInterfaceInfo) {
+		platform := info.PlatformNetworks != nil && len(*info.PlatformNetworks) > 0
+		data := info.DataNetworks != nil && len(*info.DataNetworks) > 0
+
+		switch info.Class {
+		case interfaces.IFClassPCISRIOV, interfaces.IFClassPCIPassthrough:
+			if platform {
+				result = append(result, fmt.Sprintf("%s.platformNetworks: not supported by class %q", path, info.Class))
+			}
+		case interfaces.IFClassPlatform:
+			if data {
+				result = append(result, fmt.Sprintf("%s.dataNetworks: not supported by class %q", path, info.Class))
+			}
+		case interfaces.IFClassNone:
+			if platform {
+				result = append(result, fmt.Sprintf("%s.platformNetworks: not supported by class %q", path, info.Class))
+			}
+			if data {
+				result = append(result, fmt.Sprintf("%s.dataNetworks: not supported by class %q", path, info.Class))
+			}
+		}
+	}
+
+	for _, e := range profile.Interfaces.Ethernet {
+		check(fmt.Sprintf("$.interfaces.ethernet[name=%s]", e.Name), e.CommonInterfaceInfo)
+	}
+
+	for _, b := range profile.Interfaces.Bond {
+		check(fmt.Sprintf("$.interfaces.bond[name=%s]", b.Name), b.CommonInterfaceInfo)
+	}
+
+	for _, v := range profile.Interfaces.VLAN {
+		check(fmt.Sprintf("$.interfaces.vlan[name=%s]", v.Name), v.CommonInterfaceInfo)
+	}
+
+	for _, vf := range profile.Interfaces.VF {
+		check(fmt.Sprintf("$.interfaces.vf[name=%s]", vf.Name), vf.CommonInterfaceInfo)
+	}
+
+	return result
+}
+
+// ValidateInterfaceClassNetworks rejects network assignments which are not
+// supported by the class of their interface.  It must only be applied to
+// attributes declared by the user since the networks inherited from the
+// default attributes of a host are removed by the controller whenever the
+// class of an interface is changed.
+func ValidateInterfaceClassNetworks(profile *HostProfileSpec) error {
+	problems := validateInterfaceClassNetworks(profile)
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(problems)
+
+	return fmt.Errorf("interfaces are invalid: %s", strings.Join(problems, "; "))
+}
+
+// validateCompositeInterfaces ensures that interfaces, addresses and routes
+// only reference interfaces, data networks and platform networks which are
+// defined.  Interface references are only checked when the profile is
+// complete and networks are only checked when the set of known networks is
+// supplied.
+func validateCompositeInterfaces(profile *HostProfileSpec, dataNetworks, platformNetworks map[string]bool, complete bool) []string {
+	result := make([]string, 0)
+	names := profileInterfaceNames(profile)
+
+	checkLower := func(path, lower string) {
+		if complete && lower != "" && !names[lower] {
+			result = append(result, fmt.Sprintf("%s: references undefined interface %q", path, lower))
+		}
+	}
+
+	checkDataNetworks := func(path string, info CommonInterfaceInfo) {
+		if dataNetworks == nil || info.DataNetworks == nil {
+			return
+		}
+
+		for _, d := range DataNetworkItemListToStrings(*info.DataNetworks) {
+			if !dataNetworks[d] {
+				result = append(result, fmt.Sprintf("%s.dataNetworks: references undefined data network %q", path, d))
+			}
+		}
+	}
+
+	checkPlatformNetworks := func(path string, info CommonInterfaceInfo) {
+		if platformNetworks == nil || info.PlatformNetworks == nil {
+			return
+		}
+
+		for _, p := range PlatformNetworkItemListToStrings(*info.PlatformNetworks) {
+			if !platformNetworks[p] {
+				result = append(result, fmt.Sprintf("%s.platformNetworks: references undefined platform network %q", path, p))
+			}
+		}
+	}
+
+	checkNetworks := func(path string, info CommonInterfaceInfo) {
+		checkDataNetworks(path, info)
+		checkPlatformNetworks(path, info)
+	}
+
+	if profile.Interfaces != nil {
+		for _, e := range profile.Interfaces.Ethernet {
+			path := fmt.Sprintf("$.interfaces.ethernet[name=%s]", e.Name)
+			checkLower(path+".lower", e.Lower)
+			checkNetworks(path, e.CommonInterfaceInfo)
+		}
+
+		for _, b := range profile.Interfaces.Bond {
+			path := fmt.Sprintf("$.interfaces.bond[name=%s]", b.Name)
+			for _, m := range b.Members {
+				checkLower(path+".members", m)
+			}
+			checkNetworks(path, b.CommonInterfaceInfo)
+		}
+
+		for _, v := range profile.Interfaces.VLAN {
+			path := fmt.Sprintf("$.interfaces.vlan[name=%s]", v.Name)
+			checkLower(path+".lower", v.Lower)
+			checkNetworks(path, v.CommonInterfaceInfo)
+		}
+
+		for _, vf := range profile.Interfaces.VF {
+			path := fmt.Sprintf("$.interfaces.vf[name=%s]", vf.Name)
+			checkLower(path+".lower", vf.Lower)
+			checkNetworks(path, vf.CommonInterfaceInfo)
+		}
+	}
+
+	for i, a := range profile.Addresses {
+		checkLower(fmt.Sprintf("$.addresses[%d].interface", i), a.Interface)
+	}
+
+	for i, r := range profile.Routes {
+		checkLower(fmt.Sprintf("$.routes[%d].interface", i), r.Interface)
+	}
+
+	return result
+}
+
+// ValidateCompositeProfile examines a composite profile for attributes that
+// are individually valid but that reference each other inconsistently once
+// the profile hierarchy and host overrides have been merged.  Each problem is
+// reported with the path of the offending field.  A profile is complete when
+// it has been merged over the default attributes of the host; otherwise
+// references to objects which may only be defined by the defaults are not
+// reported.  Data network and platform network references are only checked
+// when the corresponding set of known networks is supplied.
+func ValidateCompositeProfile(profile *HostProfileSpec, dataNetworks, platformNetworks map[string]bool, complete bool) error {
+	problems := validateCompositeOSDs(profile, complete)
+	problems = append(problems, validateRootDeviceUsage(profile)...)
+	problems = append(problems, validateInterfaceAttributes(profile)...)
+	problems = append(problems, validateCompositeInterfaces(profile, dataNetworks, platformNetworks, complete)...)
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(problems)
+
+	return fmt.Errorf("composite profile is invalid: %s", strings.Join(problems, "; "))
+}
@@ -0,0 +1,276 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("hostProfile merge functions", func() {
+	Describe("FlattenHostProfile function is tested", func() {
+		location1 := "rack1"
+		location2 := "rack2"
+		kernel := "lowlatency"
+		base := "base"
+		profiles := map[string]HostProfileSpec{
+			"base":    {ProfileBaseAttributes: ProfileBaseAttributes{Location: &location1}},
+			"mixin-1": {ProfileBaseAttributes: ProfileBaseAttributes{Kernel: &kernel}},
+			"mixin-2": {ProfileBaseAttributes: ProfileBaseAttributes{Location: &location2}},
+			"mixin-3": {ProfileBaseAttributes: ProfileBaseAttributes{Location: &location1}},
+			"loop":    {Base: &base, Mixins: []string{"loop"}},
+		}
+		lookup := func(name string) (*HostProfileSpec, error) {
+			if spec, ok := profiles[name]; ok {
+				return spec.DeepCopy(), nil
+			}
+			return nil, fmt.Errorf("profile %q not found", name)
+		}
+
+		Context("When the mixins set different attributes", func() {
+			It("Merges the base chain, the mixins and the profile in order", func() {
+				current := &HostProfileSpec{Base: &base, Mixins: []string{"mixin-1", "mixin-2"}}
+				result, err := FlattenHostProfile(lookup, current, &HostProfileSpec{}, map[string]bool{})
+				Expect(err).To(BeNil())
+				Expect(*result.Location).To(Equal(location2))
+				Expect(*result.Kernel).To(Equal(kernel))
+			})
+		})
+		Context("When the mixins set the same attribute differently", func() {
+			It("Rejects the ambiguous merge", func() {
+				current := &HostProfileSpec{Mixins: []string{"mixin-2", "mixin-3"}}
+				_, err := FlattenHostProfile(lookup, current, &HostProfileSpec{}, map[string]bool{})
+				Expect(err).To(BeAssignableToTypeOf(ProfileMergeError{}))
+				Expect(err.Error()).To(ContainSubstring("$.location"))
+			})
+		})
+		Context("When a profile references itself", func() {
+			It("Detects the loop", func() {
+				current := &HostProfileSpec{Mixins: []string{"loop"}}
+				_, err := FlattenHostProfile(lookup, current, &HostProfileSpec{}, map[string]bool{})
+				Expect(err).To(Equal(NewProfileMergeError("profile loop detected at: loop")))
+			})
+		})
+	})
+
+	Describe("ValidateCompositeProfile function is tested", func() {
+		Context("When the composite profile references undefined objects", func() {
+			It("Reports each conflicting field", func() {
+				dataNetworks := DataNetworkItemList{"physnet0", "physnet1"}
+				profile := &HostProfileSpec{
+					Storage: &ProfileStorageInfo{
+						OSDs: &OSDList{
+							{Function: "osd", Path: "/dev/sdb", Journal: &JournalInfo{Location: "/dev/sdc", Size: 1}},
+							{Function: "osd", Path: "/dev/sdc"},
+							{Function: "osd", Path: "/dev/sdd", Journal: &JournalInfo{Location: "/dev/sde", Size: 1}},
+						},
+					},
+					Interfaces: &InterfaceInfo{
+						Ethernet: EthernetList{
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "data0", DataNetworks: &dataNetworks}},
+						},
+						VLAN: VLANList{
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "vlan10"}, Lower: "bond0", VID: 10},
+						},
+					},
+				}
+
+				known := map[string]bool{"physnet0": true}
+				err := ValidateCompositeProfile(profile, known, nil, false)
+				Expect(err).To(Equal(fmt.Errorf("composite profile is invalid: %s; %s",
+					"$.interfaces.ethernet[name=data0].dataNetworks: references undefined data network \"physnet1\"",
+					"$.storage.osds[path=/dev/sdb].journal.location: references OSD \"/dev/sdc\" which is not a journal OSD")))
+
+				err = ValidateCompositeProfile(profile, nil, nil, true)
+				Expect(err.Error()).To(ContainSubstring("$.interfaces.vlan[name=vlan10].lower: references undefined interface \"bond0\""))
+				Expect(err.Error()).To(ContainSubstring("$.storage.osds[path=/dev/sdd].journal.location: references undefined OSD \"/dev/sde\""))
+			})
+		})
+		Context("When the interface attributes are inconsistent", func() {
+			It("Reports each offending field", func() {
+				bootMAC := "00:11:22:33:44:zz"
+				mtu1500, mtu9000 := 1500, 9000
+				platformNetworks := PlatformNetworkItemList{"mgmt", "oam"}
+				profile := &HostProfileSpec{
+					ProfileBaseAttributes: ProfileBaseAttributes{BootMAC: &bootMAC},
+					Interfaces: &InterfaceInfo{
+						Ethernet: EthernetList{
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "eth0", MTU: &mtu1500, PlatformNetworks: &platformNetworks}},
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "eth1"}},
+						},
+						Bond: BondList{
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "bond0"}, Members: []string{"eth1", "eth1", "bond0"}},
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "bond1"}, Members: []string{}},
+						},
+						VLAN: VLANList{
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "vlan10", MTU: &mtu9000}, Lower: "eth0", VID: 10},
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "vlan11"}, Lower: "eth0", VID: 10},
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "eth1"}, Lower: "eth0", VID: 4095},
+						},
+					},
+				}
+
+				known := map[string]bool{"mgmt": true}
+				err := ValidateCompositeProfile(profile, nil, known, false)
+				Expect(err).To(Equal(fmt.Errorf("composite profile is invalid: %s",
+					strings.Join([]string{
+						"$.bootMAC: invalid MAC address \"00:11:22:33:44:zz\"",
+						"$.interfaces.bond[name=bond0].members: duplicate member \"eth1\"",
+						"$.interfaces.bond[name=bond0].members: must not reference the bond itself",
+						"$.interfaces.bond[name=bond1].members: at least one member is required",
+						"$.interfaces.ethernet[name=eth0].platformNetworks: references undefined platform network \"oam\"",
+						"$.interfaces.vlan[name=eth1].name: duplicates the name of ethernet interface \"eth1\"",
+						"$.interfaces.vlan[name=eth1].vid: 4095 is out of range 1-4094",
+						"$.interfaces.vlan[name=vlan10].mtu: 9000 exceeds the MTU 1500 of lower interface \"eth0\"",
+						"$.interfaces.vlan[name=vlan11].vid: duplicates the VLAN ID of interface \"vlan10\" on \"eth0\"",
+					}, "; "))))
+			})
+		})
+		Context("When a journal OSD has a journal", func() {
+			It("Reports the journal of the journal OSD", func() {
+				profile := &HostProfileSpec{
+					Storage: &ProfileStorageInfo{
+						OSDs: &OSDList{
+							{Function: "journal", Path: "/dev/sdc", Journal: &JournalInfo{Location: "/dev/sdc", Size: 1}},
+						},
+					},
+				}
+				err := ValidateCompositeProfile(profile, nil, nil, true)
+				Expect(err).To(Equal(fmt.Errorf("composite profile is invalid: %s",
+					"$.storage.osds[path=/dev/sdc].journal: journal OSDs must not have a journal")))
+			})
+		})
+		Context("When the storage uses the root device", func() {
+			It("Reports the OSDs and physical volume disks on the root device", func() {
+				rootDevice := "/dev/sdb"
+				profile := &HostProfileSpec{
+					ProfileBaseAttributes: ProfileBaseAttributes{RootDevice: &rootDevice},
+					Storage: &ProfileStorageInfo{
+						OSDs: &OSDList{
+							{Function: "osd", Path: "/dev/sdb"},
+							{Function: "osd", Path: "/dev/sda"},
+						},
+						VolumeGroups: &VolumeGroupList{
+							{Name: "cgts-vg", PhysicalVolumes: PhysicalVolumeList{
+								{Type: "partition", Path: "/dev/sdb", Size: &[]int{10}[0]},
+							}},
+							{Name: "nova-local", PhysicalVolumes: PhysicalVolumeList{
+								{Type: "disk", Path: "/dev/sdb"},
+							}},
+						},
+					},
+				}
+				err := ValidateCompositeProfile(profile, nil, nil, false)
+				Expect(err).To(Equal(fmt.Errorf("composite profile is invalid: %s; %s",
+					"$.storage.osds[path=/dev/sdb].path: must not use the root device \"/dev/sdb\"",
+					"$.storage.volumeGroups[name=nova-local].physicalVolumes[path=/dev/sdb].path: must not use the root device \"/dev/sdb\" as a disk; use a partition instead")))
+			})
+			It("Falls back to the deny-list when the root device is not known", func() {
+				profile := &HostProfileSpec{
+					Storage: &ProfileStorageInfo{
+						OSDs: &OSDList{
+							{Function: "osd", Path: "/dev/sda"},
+							{Function: "osd", Path: "/dev/sdb"},
+						},
+					},
+				}
+				err := ValidateCompositeProfile(profile, nil, nil, false)
+				Expect(err).To(Equal(fmt.Errorf("composite profile is invalid: %s",
+					"$.storage.osds[path=/dev/sda].path: must not use the root device \"/dev/sda\"")))
+			})
+		})
+		Context("When the composite profile is consistent", func() {
+			It("Successfully validates the profile", func() {
+				profile := &HostProfileSpec{
+					Storage: &ProfileStorageInfo{
+						OSDs: &OSDList{
+							{Function: "osd", Path: "/dev/sdb", Journal: &JournalInfo{Location: "/dev/sdc", Size: 1}},
+							{Function: "journal", Path: "/dev/sdc"},
+						},
+					},
+				}
+				Expect(ValidateCompositeProfile(profile, nil, nil, true)).To(BeNil())
+			})
+		})
+	})
+	Describe("ValidateJournalSizing function is tested", func() {
+		profile := &HostProfileSpec{
+			Storage: &ProfileStorageInfo{
+				OSDs: &OSDList{
+					{Function: "osd", Path: "/dev/sdb", Journal: &JournalInfo{Location: "/dev/disk/by-path/pci-0000:00:1f.2-ata-3.0", Size: 10}},
+					{Function: "osd", Path: "/dev/sdd", Journal: &JournalInfo{Location: "/dev/disk/by-path/pci-0000:00:1f.2-ata-3.0", Size: 10}},
+					{Function: "journal", Path: "/dev/disk/by-path/pci-0000:00:1f.2-ata-3.0"},
+				},
+			},
+		}
+
+		Context("When the journals fit on the journal disk", func() {
+			It("Successfully validates the profile", func() {
+				disks := []HostDiskHardwareStatus{
+					{Path: "/dev/disk/by-path/pci-0000:00:1f.2-ata-3.0", Node: "/dev/sdc", SizeMiB: 20480},
+				}
+				Expect(ValidateJournalSizing(profile, disks)).To(BeNil())
+				Expect(ValidateJournalSizing(profile, nil)).To(BeNil())
+			})
+		})
+		Context("When the journals exceed the journal disk", func() {
+			It("Reports the journal OSD", func() {
+				disks := []HostDiskHardwareStatus{
+					{Path: "/dev/disk/by-path/pci-0000:00:1f.2-ata-3.0", Node: "/dev/sdc", SizeMiB: 16384},
+				}
+				err := ValidateJournalSizing(profile, disks)
+				Expect(err).To(Equal(fmt.Errorf("composite profile is invalid: %s",
+					"$.storage.osds[path=/dev/disk/by-path/pci-0000:00:1f.2-ata-3.0]: journals require 20 GiB but the disk only provides 16 GiB")))
+			})
+		})
+	})
+	Describe("ValidateMemorySizing function is tested", func() {
+		nodes := []HostNodeHardwareStatus{{Node: 0, MemoryMiB: 16384}, {Node: 1, MemoryMiB: 16384}}
+		platform := 4096
+
+		Context("When the allocations fit on each node", func() {
+			It("Successfully validates the profile", func() {
+				profile := &HostProfileSpec{
+					Memory: MemoryNodeList{
+						{Node: 0, Platform: &platform, Functions: MemoryFunctionList{
+							{Function: "vm", PageSize: string(PageSize1G), PageCount: 8},
+							{Function: "vswitch", PageSize: string(PageSize1G), PageCount: 4},
+						}},
+						{Node: 1, Functions: MemoryFunctionList{
+							{Function: "platform", PageSize: string(PageSize4K), PageCount: 1048576},
+							{Function: "vm", PageSize: string(PageSize2M), PageCount: 6144},
+						}},
+						{Node: 2, Functions: MemoryFunctionList{
+							{Function: "vm", PageSize: string(PageSize1G), PageCount: 64},
+						}},
+					},
+				}
+				Expect(ValidateMemorySizing(profile, nodes)).To(BeNil())
+				Expect(ValidateMemorySizing(profile, nil)).To(BeNil())
+			})
+		})
+		Context("When the allocations exceed the memory of a node", func() {
+			It("Reports each node including the platform reservation", func() {
+				profile := &HostProfileSpec{
+					Memory: MemoryNodeList{
+						{Node: 0, Platform: &platform, Functions: MemoryFunctionList{
+							{Function: "vm", PageSize: string(PageSize1G), PageCount: 12},
+							{Function: "vswitch", PageSize: string(PageSize2M), PageCount: 1},
+						}},
+						{Node: 1, Functions: MemoryFunctionList{
+							{Function: "platform", PageSize: string(PageSize4K), PageCount: 1048832},
+							{Function: "vm", PageSize: string(PageSize2M), PageCount: 6144},
+						}},
+					},
+				}
+				err := ValidateMemorySizing(profile, nodes)
+				Expect(err).To(Equal(fmt.Errorf("composite profile is invalid: %s; %s",
+					"$.memory[node=0]: hugepages require 12290 MiB but only 12288 MiB are available after reserving 4096 MiB for the platform",
+					"$.memory[node=1]: hugepages require 12288 MiB but only 12287 MiB are available after reserving 4097 MiB for the platform")))
+			})
+		})
+	})
+})
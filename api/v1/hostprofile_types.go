@@ -1,14 +1,22 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package v1
 
 import (
+	"strings"
+
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/clusters"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// Defines the valid clock synchronization sources of a host.
+const (
+	ClockSynchronizationNTP = "ntp"
+	ClockSynchronizationPTP = "ptp"
+)
+
 // BMPasswordInfo defines attributes specific to password based
 // authentication.
 type BMPasswordInfo struct {
@@ -65,6 +73,12 @@ type BMInfo struct {
 	// type or credentials.
 	// +optional
 	Credentials *BMCredentials `json:"credentials,omitempty"`
+
+	// Insecure disables the verification of the TLS certificate presented by
+	// the board management controller when the deployment manager connects to
+	// it directly.  This only applies to the "redfish" type.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
 }
 
 // ProcessorFunctionInfo defines the number of cores to assign to a
@@ -134,9 +148,17 @@ type MemoryNodeInfo struct {
 	// +kubebuilder:validation:Maximum=7
 	Node int `json:"node"`
 
+	// Platform defines the amount of memory, in MiB, reserved for the platform
+	// on the given NUMA socket/node.  It is equivalent to a platform function
+	// allocation of 4KB pages and must not be combined with one.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Platform *int `json:"platform,omitempty"`
+
 	// Functions defines a list of function specific allocations for the given
 	// NUMA socket/node.
-	Functions MemoryFunctionList `json:"functions"`
+	// +optional
+	Functions MemoryFunctionList `json:"functions,omitempty"`
 }
 
 // MemoryNodeList defines a type to represent a slice of memory node objects.
@@ -296,11 +318,19 @@ type ProfileStorageInfo struct {
 
 // EthernetPortInfo defines the attributes specific to a single
 // Ethernet port.
+// +deepequal-gen:ignore-nil-fields=true
 type EthernetPortInfo struct {
 	// SystemName defines the device name of the Ethernet port.
 	// +kubebuilder:validation:MaxLength=255
 	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9\-_]+$
 	Name string `json:"name"`
+
+	// PCIAddress defines the PCI bus address of the Ethernet port.  It is
+	// used to identify the port when a NIC is replaced and the port name
+	// reported by the system no longer matches the configured name.
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$`
+	// +optional
+	PCIAddress *string `json:"pciAddress,omitempty"`
 }
 
 // +kubebuilder:validation:MaxLength=255
@@ -441,6 +471,30 @@ type CommonInterfaceInfo struct {
 	// interface.
 	// +optional
 	PtpInterfaces *PtpInterfaceItemList `json:"ptpInterfaces,omitempty"`
+
+	// IPv4Mode defines the IPv4 addressing mode of the interface.  If not
+	// specified the mode is derived from the set of static addresses and
+	// address pools associated with the interface.
+	// +kubebuilder:validation:Enum=disabled;static;pool;dhcp
+	// +optional
+	IPv4Mode *string `json:"ipv4Mode,omitempty"`
+
+	// IPv4Pool defines the name of the address pool from which IPv4 addresses
+	// are allocated.  Only applicable if the IPv4 mode is set to "pool".
+	// +optional
+	IPv4Pool *string `json:"ipv4Pool,omitempty"`
+
+	// IPv6Mode defines the IPv6 addressing mode of the interface.  If not
+	// specified the mode is derived from the set of static addresses and
+	// address pools associated with the interface.
+	// +kubebuilder:validation:Enum=disabled;static;pool;dhcp
+	// +optional
+	IPv6Mode *string `json:"ipv6Mode,omitempty"`
+
+	// IPv6Pool defines the name of the address pool from which IPv6 addresses
+	// are allocated.  Only applicable if the IPv6 mode is set to "pool".
+	// +optional
+	IPv6Pool *string `json:"ipv6Pool,omitempty"`
 }
 
 // EthernetInfo defines the attributes specific to a single
@@ -491,7 +545,7 @@ type VLANInfo struct {
 
 	// VID defines the VLAN ID value to be assigned to this VLAN interface.
 	// +kubebuilder:validation:Minimum=1
-	// +kubebuilder:validation:Maximum=4095
+	// +kubebuilder:validation:Maximum=4094
 	VID int `json:"vid"`
 }
 
@@ -621,7 +675,9 @@ type RouteInfo struct {
 	// Gateway defines the next hop gateway IP address.
 	Gateway string `json:"gateway"`
 
-	// Metric defines the route preference metric for this route.
+	// Metric defines the route preference metric for this route.  The system
+	// default metric of 1 is used when omitted.  Changing the metric of an
+	// existing route replaces the route with one using the new metric.
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=255
 	// +optional
@@ -738,6 +794,181 @@ func (in RouteInfo) IsKeyEqual(x RouteInfo) bool {
 	return false
 }
 
+// FECDeviceInfo defines the attributes specific to a single FEC accelerator
+// device.
+// +deepequal-gen:ignore-nil-fields=true
+type FECDeviceInfo struct {
+	// PCIAddress defines the PCI bus address of the device.
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-9a-fA-F]$`
+	PCIAddress string `json:"pciAddress"`
+
+	// Enabled defines whether the device is enabled for use.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Driver defines the driver to be bound to the physical function of the
+	// device.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Driver *string `json:"driver,omitempty"`
+
+	// VFCount defines the number of virtual functions to be created on the
+	// device.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	VFCount *int `json:"vfCount,omitempty"`
+
+	// VFDriver defines the driver to be bound to the virtual functions of the
+	// device.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	VFDriver *string `json:"vfDriver,omitempty"`
+
+	// Labels defines the set of device labels to be assigned to the device.
+	// Device images are written to the devices selected by these labels.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// FECDeviceList defines a type to represent a slice of FEC devices.
+// +deepequal-gen:unordered-array=true
+type FECDeviceList []FECDeviceInfo
+
+// PCIDeviceInfo defines the attributes of a PCI device that determine how it is
+// exposed to applications (e.g., through the SR-IOV device plugin or as a
+// passthrough device).  A device is selected either by its PCI bus address or
+// by its vendor and device identifiers, in which case all matching devices
+// are configured.
+// +deepequal-gen:ignore-nil-fields=true
+type PCIDeviceInfo struct {
+	// PCIAddress defines the PCI bus address of the device.
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-9a-fA-F]$`
+	// +optional
+	PCIAddress *string `json:"pciAddress,omitempty"`
+
+	// VendorID defines the PCI vendor identifier of the devices.
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{4}$`
+	// +optional
+	VendorID *string `json:"vendorID,omitempty"`
+
+	// DeviceID defines the PCI device identifier of the devices.
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{4}$`
+	// +optional
+	DeviceID *string `json:"deviceID,omitempty"`
+
+	// Enabled defines whether the device is enabled for use.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Name defines the name assigned to the device.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// PCIDeviceList defines a type to represent a slice of PCI devices.
+// +deepequal-gen:unordered-array=true
+type PCIDeviceList []PCIDeviceInfo
+
+// DeviceInfo defines the PCI devices to be configured on a host.
+type DeviceInfo struct {
+	// FEC defines the list of FEC accelerator devices to be configured on a
+	// host.
+	// +optional
+	FEC FECDeviceList `json:"fec,omitempty"`
+
+	// PCI defines the list of other PCI devices to be configured on a host.
+	// +optional
+	PCI PCIDeviceList `json:"pci,omitempty"`
+}
+
+// IsKeyEqual compares two FEC device array elements and determines if they
+// refer to the same instance.  All other attributes will be merged during
+// profile merging.
+func (in FECDeviceInfo) IsKeyEqual(x FECDeviceInfo) bool {
+	return in.PCIAddress == x.PCIAddress
+}
+
+// IsKeyEqual compares two PCI device array elements and determines if they
+// refer to the same instance.  Devices selected by address are matched by
+// address while all others are matched by vendor and device identifiers.
+func (in PCIDeviceInfo) IsKeyEqual(x PCIDeviceInfo) bool {
+	if in.PCIAddress != nil || x.PCIAddress != nil {
+		return in.PCIAddress != nil && x.PCIAddress != nil &&
+			strings.EqualFold(*in.PCIAddress, *x.PCIAddress)
+	}
+
+	return in.VendorID != nil && x.VendorID != nil &&
+		in.DeviceID != nil && x.DeviceID != nil &&
+		strings.EqualFold(*in.VendorID, *x.VendorID) &&
+		strings.EqualFold(*in.DeviceID, *x.DeviceID)
+}
+
+// Matches determines whether a PCI device entry selects the device with the
+// specified address and identifiers.
+func (in PCIDeviceInfo) Matches(pciaddr, vendorID, deviceID string) bool {
+	if in.PCIAddress != nil {
+		return strings.EqualFold(*in.PCIAddress, pciaddr)
+	}
+
+	return in.VendorID != nil && in.DeviceID != nil &&
+		strings.EqualFold(*in.VendorID, vendorID) &&
+		strings.EqualFold(*in.DeviceID, deviceID)
+}
+
+// SensorGroupInfo defines the alarm policy of a single sensor group
+// discovered from the board management controller of a host.
+// +deepequal-gen:ignore-nil-fields=true
+type SensorGroupInfo struct {
+	// Name defines the name of the sensor group as reported by the board
+	// management controller.
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// AuditInterval defines the interval, in seconds, between audits of the
+	// sensors of the group.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	AuditInterval *int `json:"auditInterval,omitempty"`
+
+	// Suppress defines whether alarms are suppressed for the whole group.
+	// +optional
+	Suppress *bool `json:"suppress,omitempty"`
+
+	// SuppressedSensors defines the names of the individual sensors of the
+	// group for which alarms are suppressed.  Sensors that are not listed are
+	// unsuppressed.  If omitted, the suppression state of the individual
+	// sensors is left unchanged.
+	// +optional
+	SuppressedSensors []string `json:"suppressedSensors,omitempty"`
+
+	// ActionsCritical defines the action taken on a critical sensor alarm.
+	// +kubebuilder:validation:Enum=ignore;log;alarm;reset;power-cycle
+	// +optional
+	ActionsCritical *string `json:"actionsCritical,omitempty"`
+
+	// ActionsMajor defines the action taken on a major sensor alarm.
+	// +kubebuilder:validation:Enum=ignore;log;alarm
+	// +optional
+	ActionsMajor *string `json:"actionsMajor,omitempty"`
+
+	// ActionsMinor defines the action taken on a minor sensor alarm.
+	// +kubebuilder:validation:Enum=ignore;log;alarm
+	// +optional
+	ActionsMinor *string `json:"actionsMinor,omitempty"`
+}
+
+// SensorGroupList defines a type to represent a slice of sensor groups.
+// +deepequal-gen:unordered-array=true
+type SensorGroupList []SensorGroupInfo
+
+// IsKeyEqual compares two sensor group array elements and determines if they
+// refer to the same instance.  All other attributes will be merged during
+// profile merging.
+func (in SensorGroupInfo) IsKeyEqual(x SensorGroupInfo) bool {
+	return in.Name == x.Name
+}
+
 // +kubebuilder:validation:Enum=controller;worker;storage;lowlatency
 type SubFunction string
 
@@ -745,6 +976,34 @@ func SubFunctionFromString(s string) SubFunction {
 	return SubFunction(s)
 }
 
+// NodeTaint defines the attributes of a taint to be applied to the kubernetes
+// node resource that is running on a host.
+type NodeTaint struct {
+	// Key defines the taint key.
+	// +kubebuilder:validation:MaxLength=316
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9.]*[a-z0-9])?/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`
+	Key string `json:"key"`
+
+	// Value defines the taint value.
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Effect defines the effect of the taint on pods that do not tolerate it.
+	// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+	Effect string `json:"effect"`
+}
+
+// ID returns the identifier of the taint on the node.  A node may only have
+// a single taint for each key and effect pair.
+func (in *NodeTaint) ID() string {
+	return in.Key + ":" + in.Effect
+}
+
+// NodeTaintList defines a type to represent a slice of NodeTaint objects.
+// +deepequal-gen:unordered-array=true
+type NodeTaintList []NodeTaint
+
 // +kubebuilder:validation:MaxLength=255
 // +kubebuilder:validation:Pattern=^[a-zA-Z0-9\-_]+$
 type PtpInstanceItem string
@@ -790,6 +1049,11 @@ type ProfileBaseAttributes struct {
 	// resources that is running on this host.
 	Labels map[string]string `json:"labels,omitempty"`
 
+	// Taints defines the set of taints to be applied to the kubernetes node
+	// resource that is running on this host.
+	// +optional
+	Taints NodeTaintList `json:"taints,omitempty"`
+
 	// InstallOutput defines the install output method.  The graphical mode is
 	// only suitable when the console attribute is set to a graphical terminal.
 	// The text mode can be used with both serial and graphical console
@@ -829,7 +1093,7 @@ type ProfileBaseAttributes struct {
 	// software installation.  This is only applicable for statically
 	// provisioned hosts and should be set on each hosts via the overrides
 	// attributes.
-	// +kubebuilder:validation:Pattern=`^([0-9a-fA-Z]{2}[:-]){5}([0-9a-fA-Z]{2})$`
+	// +kubebuilder:validation:Pattern=`^([0-9a-fA-F]{2}[:-]){5}([0-9a-fA-F]{2})$`
 	// +optional
 	BootMAC *string `json:"bootMAC,omitempty"`
 
@@ -846,7 +1110,9 @@ type ProfileBaseAttributes struct {
 	RootDevice *string `json:"rootDevice,omitempty"`
 
 	// ClockSynchronization defines the clock synchronization source of the host
-	// resource.
+	// resource.  Switching a host to "ptp" requires that it be assigned at
+	// least one PTP instance while switching to "ntp" requires that the system
+	// have NTP servers configured.
 	// +kubebuilder:validation:Enum=ntp;ptp
 	// +optional
 	ClockSynchronization *string `json:"clockSynchronization,omitempty"`
@@ -856,6 +1122,14 @@ type ProfileBaseAttributes struct {
 	// +optional
 	MaxCPUMhzConfigured *string `json:"maxCPUMhzConfigured,omitempty"`
 
+	// PowerProfile defines the CPU power profile applied to the host by the
+	// platform power manager.  Setting a power profile enables power
+	// management on the host.  The maximum CPU frequency can be capped
+	// independently with the MaxCPUMhzConfigured attribute.
+	// +kubebuilder:validation:Enum=performance;balanced-performance;balanced-power;power
+	// +optional
+	PowerProfile *string `json:"powerProfile,omitempty"`
+
 	// AppArmor defines the security model on the host.
 	// +optional
 	AppArmor *string `json:"appArmor,omitempty"`
@@ -949,6 +1223,19 @@ type HostProfileSpec struct {
 	// +optional
 	Base *string `json:"base,omitempty"`
 
+	// Mixins defines the names of additional HostProfiles whose attributes
+	// are merged into this profile.  Mixins are intended to hold reusable
+	// fragments (e.g., a common set of interfaces, filesystems or PTP
+	// instances) which can be composed into many profiles.  They are merged
+	// in the order in which they are declared after the Base profile chain
+	// has been flattened and before the attributes of this profile; therefore
+	// later mixins take precedence over earlier mixins and this profile takes
+	// precedence over all of its mixins.  Two mixins of the same profile must
+	// not set the same attribute to different values since the result would
+	// depend on their order.
+	// +optional
+	Mixins []string `json:"mixins,omitempty"`
+
 	// ProfileBaseAttributes defines the node level base attributes.  They are
 	// grouped together to take advantage of the code generated DeepEqual
 	// method to facilitate comparisons.
@@ -988,6 +1275,23 @@ type HostProfileSpec struct {
 	// therefore the host must be configured with valid addresses or configured
 	// to for automatic address assignment from a platform network.
 	Routes RouteList `json:"routes,omitempty"`
+
+	// Devices defines the PCI devices to be configured against this host.
+	// +optional
+	Devices *DeviceInfo `json:"devices,omitempty"`
+
+	// SensorGroups defines the alarm policies of the sensor groups of this
+	// host.  Only the sensor groups that are listed are configured.
+	// +optional
+	SensorGroups SensorGroupList `json:"sensorGroups,omitempty"`
+
+	// IgnoreFields defines a list of JSONPath-style field paths (e.g.,
+	// "storage.filesystems[name=scratch].size") that are skipped when
+	// comparing the configuration of a host with its current state.  It is
+	// intended for attributes that are managed outside of the Deployment
+	// Manager so that they do not cause the host to fall out of sync.
+	// +optional
+	IgnoreFields []string `json:"ignoreFields,omitempty"`
 }
 
 // HasWorkerSubfunction is a utility function that returns true if a profile
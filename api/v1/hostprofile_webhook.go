@@ -1,15 +1,22 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package v1
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net"
+	"sort"
+	"strings"
 
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/memory"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/physicalvolumes"
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	apitypes "k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -19,6 +26,8 @@ import (
 var hostprofilelog = logf.Log.WithName("hostprofile-resource")
 
 func (r *HostProfile) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	cl = mgr.GetClient()
+
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
 		Complete()
@@ -70,6 +79,12 @@ func validateMemoryInfo(obj *HostProfile) error {
 			if err != nil {
 				return err
 			}
+
+			if n.Platform != nil && f.Function == memory.MemoryFunctionPlatform {
+				msg := fmt.Sprintf("platform memory for node %d must be set either with the 'platform' attribute or a platform function, not both.",
+					n.Node)
+				return errors.New(msg)
+			}
 		}
 	}
 
@@ -125,14 +140,237 @@ func validateStorageInfo(obj *HostProfile) error {
 		}
 	}
 
+	// Journal references to OSDs which are not defined by this profile may
+	// be satisfied by other profiles of the hierarchy.
+	problems := validateCompositeOSDs(&obj.Spec, false)
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+
+	// The root device of the hosts using this profile is only known here if
+	// the profile sets it; otherwise it is checked once the profile is
+	// merged into the composite profile of each host.
+	if obj.Spec.RootDevice != nil {
+		problems := validateRootDeviceUsage(&obj.Spec)
+		if len(problems) > 0 {
+			return errors.New(strings.Join(problems, "; "))
+		}
+	}
+
+	return nil
+}
+
+func validateDeviceInfo(obj *HostProfile) error {
+	for _, d := range obj.Spec.Devices.PCI {
+		if d.PCIAddress == nil && (d.VendorID == nil || d.DeviceID == nil) {
+			msg := "PCI device entries must include either a 'pciAddress' or both a 'vendorID' and 'deviceID'."
+			return errors.New(msg)
+		}
+
+		if d.PCIAddress != nil && (d.VendorID != nil || d.DeviceID != nil) {
+			msg := fmt.Sprintf("PCI device %s must not also include a 'vendorID' or 'deviceID'.",
+				*d.PCIAddress)
+			return errors.New(msg)
+		}
+	}
+
 	return nil
 }
 
+// routeSubnet parses the destination subnet of a route.  The gateway must
+// belong to the same address family as the destination.
+func routeSubnet(route RouteInfo) (*net.IPNet, error) {
+	network := net.ParseIP(route.Network)
+	if network == nil {
+		return nil, fmt.Errorf("route subnet %q is not a valid IPv4 or IPv6 address", route.Network)
+	}
+
+	gateway := net.ParseIP(route.Gateway)
+	if gateway == nil {
+		return nil, fmt.Errorf("route gateway %q is not a valid IPv4 or IPv6 address", route.Gateway)
+	}
+
+	bits := net.IPv6len * 8
+	if network.To4() != nil {
+		bits = net.IPv4len * 8
+		network = network.To4()
+	}
+
+	if (gateway.To4() != nil) != (bits == net.IPv4len*8) {
+		return nil, fmt.Errorf("route gateway %s is not in the same address family as subnet %s",
+			route.Gateway, route.Network)
+	}
+
+	if route.Prefix > bits {
+		return nil, fmt.Errorf("route prefix %d is out of range for subnet %s", route.Prefix, route.Network)
+	}
+
+	mask := net.CIDRMask(route.Prefix, bits)
+	return &net.IPNet{IP: network.Mask(mask), Mask: mask}, nil
+}
+
+// CheckRouteConflicts ensures that the routes configured over the same
+// interface do not overlap.  Two routes overlap if they cover the same
+// destination subnet once the prefix is applied.  Routes with different
+// prefix lengths are allowed to overlap since the most specific route is
+// always preferred.
+func CheckRouteConflicts(routes RouteList) error {
+	subnets := make([]*net.IPNet, len(routes))
+	for i, route := range routes {
+		subnet, err := routeSubnet(route)
+		if err != nil {
+			return err
+		}
+
+		for j := 0; j < i; j++ {
+			other := routes[j]
+			if other.Interface != route.Interface {
+				continue
+			}
+
+			if subnets[j].String() == subnet.String() {
+				return fmt.Errorf("routes %s/%d and %s/%d overlap on interface %q",
+					other.Network, other.Prefix, route.Network, route.Prefix, route.Interface)
+			}
+		}
+
+		subnets[i] = subnet
+	}
+
+	return nil
+}
+
+// validateTaints ensures that each taint key and effect pair is only used
+// once since a node cannot hold more than one such taint.
+func validateTaints(taints NodeTaintList) error {
+	present := make(map[string]bool)
+	for _, t := range taints {
+		if present[t.ID()] {
+			msg := fmt.Sprintf("taint %q must only be specified once for effect %q", t.Key, t.Effect)
+			return errors.New(msg)
+		}
+		present[t.ID()] = true
+	}
+
+	return nil
+}
+
+// validateIgnoreFields ensures that each field path skipped by the in-sync
+// comparison is well formed.
+func validateIgnoreFields(paths []string) error {
+	for _, path := range paths {
+		if _, err := common.ParseFieldPath(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getHostProfile retrieves a HostProfile from the kubernetes API or from the
+// library of built-in templates if it is not present in the namespace.
+func getHostProfile(namespace, name string) (*HostProfile, error) {
+	profile := &HostProfile{}
+	key := apitypes.NamespacedName{Namespace: namespace, Name: name}
+	err := cl.Get(context.TODO(), key, profile)
+	if err != nil {
+		if builtin, ok := BuiltinHostProfile(name, namespace); ok && apierrors.IsNotFound(err) {
+			return builtin, nil
+		}
+
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// CheckMixinConflicts ensures that the attributes of the mixins of a profile
+// can be merged without depending on the order in which they are declared.
+// Two mixins are ambiguous if they both set the same attribute to a different
+// value.
+func CheckMixinConflicts(names []string, mixins []HostProfileSpec) error {
+	fragments := make([]HostProfileSpec, len(mixins))
+	for i := range mixins {
+		mixins[i].DeepCopyInto(&fragments[i])
+		fragments[i].Base = nil
+		fragments[i].Mixins = nil
+		fragments[i].IgnoreFields = nil
+	}
+
+	for i := range fragments {
+		for j := i + 1; j < len(fragments); j++ {
+			conflicts, err := common.FindMergeConflicts(&fragments[i], &fragments[j])
+			if err != nil {
+				return err
+			}
+
+			if len(conflicts) > 0 {
+				msg := fmt.Sprintf("mixins %q and %q both set: %s",
+					names[i], names[j], strings.Join(conflicts, ", "))
+				return errors.New(msg)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateMixins ensures that the list of mixins is well formed and, when
+// the referenced profiles already exist, that they can be merged without
+// ambiguity.  Missing profiles are reported by the controller instead since
+// they may be created after this profile.
+func (r *HostProfile) validateMixins() error {
+	found := make(map[string]bool)
+	for _, name := range r.Spec.Mixins {
+		if name == "" {
+			return errors.New("profile mixin names must not be empty")
+		} else if name == r.Name {
+			return fmt.Errorf("profile %q must not include itself as a mixin", name)
+		} else if r.Spec.Base != nil && name == *r.Spec.Base {
+			return fmt.Errorf("profile %q must not be both the base and a mixin", name)
+		} else if found[name] {
+			return fmt.Errorf("profile mixin %q must not be listed more than once", name)
+		}
+		found[name] = true
+	}
+
+	if cl == nil || len(r.Spec.Mixins) < 2 {
+		return nil
+	}
+
+	names := make([]string, 0, len(r.Spec.Mixins))
+	mixins := make([]HostProfileSpec, 0, len(r.Spec.Mixins))
+	for _, name := range r.Spec.Mixins {
+		mixin, err := getHostProfile(r.Namespace, name)
+		if err != nil {
+			hostprofilelog.Info("unable to get mixin profile", "mixin", name, "error", err.Error())
+			continue
+		}
+
+		names = append(names, name)
+		mixins = append(mixins, mixin.Spec)
+	}
+
+	return CheckMixinConflicts(names, mixins)
+}
+
 func (r *HostProfile) validateHostProfile() error {
 	if r.Spec.Base != nil && *r.Spec.Base == "" {
 		return errors.New("profile base name must not be empty")
 	}
 
+	if strings.HasPrefix(r.Name, BuiltinHostProfilePrefix) {
+		if _, ok := BuiltinHostProfile(r.Name, r.Namespace); !ok {
+			return fmt.Errorf("profile names starting with %q are reserved for built-in profiles",
+				BuiltinHostProfilePrefix)
+		}
+	}
+
+	err := r.validateMixins()
+	if err != nil {
+		return err
+	}
+
 	if r.Spec.Memory != nil {
 		err := validateMemoryInfo(r)
 		if err != nil {
@@ -154,6 +392,35 @@ func (r *HostProfile) validateHostProfile() error {
 		}
 	}
 
+	if r.Spec.Devices != nil {
+		err := validateDeviceInfo(r)
+		if err != nil {
+			return err
+		}
+	}
+
+	problems := validateInterfaceAttributes(&r.Spec)
+	problems = append(problems, validateInterfaceClassNetworks(&r.Spec)...)
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("interfaces are invalid: %s", strings.Join(problems, "; "))
+	}
+
+	err = CheckRouteConflicts(r.Spec.Routes)
+	if err != nil {
+		return err
+	}
+
+	err = validateTaints(r.Spec.Taints)
+	if err != nil {
+		return err
+	}
+
+	err = validateIgnoreFields(r.Spec.IgnoreFields)
+	if err != nil {
+		return err
+	}
+
 	hostprofilelog.Info(AllowedReason)
 	return nil
 }
@@ -51,6 +51,145 @@ var _ = Describe("hostProfile_webhook functions", func() {
 		})
 	})
 
+	Describe("validateDeviceInfo function is tested", func() {
+		Context("When a PCI device is selected by vendor and device ID", func() {
+			It("validates without throwing error", func() {
+				vendorID := "10de"
+				deviceID := "1eb8"
+				obj := &HostProfile{
+					Spec: HostProfileSpec{
+						Devices: &DeviceInfo{
+							PCI: PCIDeviceList{
+								{VendorID: &vendorID, DeviceID: &deviceID},
+							},
+						},
+					},
+				}
+				err := validateDeviceInfo(obj)
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When a PCI device has no selector", func() {
+			It("Gives the PCI device entries must include a selector error", func() {
+				vendorID := "10de"
+				obj := &HostProfile{
+					Spec: HostProfileSpec{
+						Devices: &DeviceInfo{
+							PCI: PCIDeviceList{
+								{VendorID: &vendorID},
+							},
+						},
+					},
+				}
+				err := validateDeviceInfo(obj)
+				msg := errors.New("PCI device entries must include either a 'pciAddress' or both a 'vendorID' and 'deviceID'.")
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+
+	Describe("CheckRouteConflicts function is tested", func() {
+		Context("When routes only overlap with different prefix lengths", func() {
+			It("validates without throwing error", func() {
+				routes := RouteList{
+					{Interface: "eth0", Network: "10.10.0.0", Prefix: 16, Gateway: "10.10.10.1"},
+					{Interface: "eth0", Network: "10.10.20.0", Prefix: 24, Gateway: "10.10.10.1"},
+					{Interface: "eth1", Network: "10.10.0.0", Prefix: 16, Gateway: "10.20.10.1"},
+				}
+				Expect(CheckRouteConflicts(routes)).To(BeNil())
+			})
+		})
+		Context("When two routes cover the same subnet on an interface", func() {
+			It("Gives the routes overlap error", func() {
+				routes := RouteList{
+					{Interface: "eth0", Network: "10.10.10.0", Prefix: 24, Gateway: "10.10.10.1"},
+					{Interface: "eth0", Network: "10.10.10.128", Prefix: 24, Gateway: "10.10.10.254"},
+				}
+				err := CheckRouteConflicts(routes)
+				msg := errors.New("routes 10.10.10.0/24 and 10.10.10.128/24 overlap on interface \"eth0\"")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the gateway is not in the address family of the subnet", func() {
+			It("Gives the address family error", func() {
+				routes := RouteList{
+					{Interface: "eth0", Network: "fd00:1::", Prefix: 64, Gateway: "10.10.10.1"},
+				}
+				err := CheckRouteConflicts(routes)
+				Expect(err).To(MatchError("route gateway 10.10.10.1 is not in the same address family as subnet fd00:1::"))
+			})
+		})
+		Context("When the prefix is too long for an IPv4 subnet", func() {
+			It("Gives the prefix out of range error", func() {
+				routes := RouteList{
+					{Interface: "eth0", Network: "10.10.10.0", Prefix: 64, Gateway: "10.10.10.1"},
+				}
+				err := CheckRouteConflicts(routes)
+				Expect(err).To(MatchError("route prefix 64 is out of range for subnet 10.10.10.0"))
+			})
+		})
+	})
+
+	Describe("validateTaints function is tested", func() {
+		Context("When the same key is used with different effects", func() {
+			It("validates without throwing error", func() {
+				taints := NodeTaintList{
+					{Key: "dedicated", Value: "infra", Effect: "NoSchedule"},
+					{Key: "dedicated", Value: "infra", Effect: "NoExecute"},
+				}
+				Expect(validateTaints(taints)).To(BeNil())
+			})
+		})
+		Context("When the same key and effect are used twice", func() {
+			It("Gives the taint must only be specified once error", func() {
+				taints := NodeTaintList{
+					{Key: "dedicated", Value: "infra", Effect: "NoSchedule"},
+					{Key: "dedicated", Value: "storage", Effect: "NoSchedule"},
+				}
+				err := validateTaints(taints)
+				msg := errors.New("taint \"dedicated\" must only be specified once for effect \"NoSchedule\"")
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+
+	Describe("validateInterfaceClassNetworks function is tested", func() {
+		Context("When the networks are supported by the interface class", func() {
+			It("validates without throwing error", func() {
+				platform := PlatformNetworkItemList{"mgmt"}
+				data := DataNetworkItemList{}
+				profile := &HostProfileSpec{
+					Interfaces: &InterfaceInfo{
+						Ethernet: EthernetList{
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "mgmt0", Class: "platform", PlatformNetworks: &platform, DataNetworks: &data}},
+						},
+					},
+				}
+				Expect(validateInterfaceClassNetworks(profile)).To(BeEmpty())
+			})
+		})
+		Context("When the networks are not supported by the interface class", func() {
+			It("Gives the not supported by class error", func() {
+				platform := PlatformNetworkItemList{"mgmt"}
+				data := DataNetworkItemList{"physnet0"}
+				profile := &HostProfileSpec{
+					Interfaces: &InterfaceInfo{
+						Ethernet: EthernetList{
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "sriov0", Class: "pci-sriov", PlatformNetworks: &platform}},
+						},
+						VLAN: VLANList{
+							{CommonInterfaceInfo: CommonInterfaceInfo{Name: "vlan10", Class: "platform", DataNetworks: &data}},
+						},
+					},
+				}
+				Expect(validateInterfaceClassNetworks(profile)).To(Equal([]string{
+					"$.interfaces.ethernet[name=sriov0].platformNetworks: not supported by class \"pci-sriov\"",
+					"$.interfaces.vlan[name=vlan10].dataNetworks: not supported by class \"platform\"",
+				}))
+			})
+		})
+	})
+
 	Describe("validateProcessorInfo function is tested", func() {
 		Context("When no duplicate processor entries are present", func() {
 			It("validates without throwing error", func() {
@@ -175,6 +314,32 @@ var _ = Describe("hostProfile_webhook functions", func() {
 				Expect(err).To(Equal(msg))
 			})
 		})
+
+		Context("When platform memory is set twice for a node", func() {
+			It("Throws the platform memory must be set once error", func() {
+				platform := 4096
+				obj := &HostProfile{
+					Spec: HostProfileSpec{
+						Memory: MemoryNodeList{
+							{
+								Node:     0,
+								Platform: &platform,
+								Functions: MemoryFunctionList{
+									{
+										Function:  "platform",
+										PageSize:  "4KB",
+										PageCount: 1048576,
+									},
+								},
+							},
+						},
+					},
+				}
+				err := validateMemoryInfo(obj)
+				msg := errors.New("platform memory for node 0 must be set either with the 'platform' attribute or a platform function, not both.")
+				Expect(err).To(Equal(msg))
+			})
+		})
 	})
 	Describe("validateVolumeGroupInfo function is tested", func() {
 		Context("When the vloumeGroup info has partition with size attr", func() {
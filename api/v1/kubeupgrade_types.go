@@ -0,0 +1,145 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the milestones of a Kubernetes upgrade in the order in which they
+// are reached.  Each milestone is reported as a condition of the KubeUpgrade
+// resource.
+const (
+	// KubeUpgradeImagesDownloaded indicates that the images of the target
+	// version have been downloaded.
+	KubeUpgradeImagesDownloaded = "ImagesDownloaded"
+
+	// KubeUpgradeNetworkingUpgraded indicates that the networking components
+	// have been upgraded.
+	KubeUpgradeNetworkingUpgraded = "NetworkingUpgraded"
+
+	// KubeUpgradeControlPlaneUpgraded indicates that the control plane of
+	// every controller has been upgraded.
+	KubeUpgradeControlPlaneUpgraded = "ControlPlaneUpgraded"
+
+	// KubeUpgradeKubeletsUpgraded indicates that the kubelet of every host
+	// has been upgraded and the upgrade has completed.
+	KubeUpgradeKubeletsUpgraded = "KubeletsUpgraded"
+)
+
+// KubeUpgradeRolledBack indicates that the upgrade failed and was aborted so
+// that the system was returned to its original version.
+const KubeUpgradeRolledBack = "RolledBack"
+
+// KubeUpgradeSpec defines the desired state of KubeUpgrade
+type KubeUpgradeSpec struct {
+	// ToVersion defines the Kubernetes version to which the system is
+	// upgraded.
+	// +kubebuilder:validation:Pattern=`^v[0-9]+\.[0-9]+\.[0-9]+$`
+	ToVersion string `json:"toVersion"`
+
+	// HostOrder defines the hostnames in the order in which they are
+	// upgraded.  The control plane hosts are always upgraded first and hosts
+	// which are not listed are upgraded last in alphabetical order.
+	// +optional
+	HostOrder []string `json:"hostOrder,omitempty"`
+
+	// RollbackOnFailure defines whether a failed upgrade is aborted so that
+	// the system is returned to its original version.  When disabled the
+	// failed upgrade is left on the system for inspection.
+	// +kubebuilder:default:=true
+	// +optional
+	RollbackOnFailure bool `json:"rollbackOnFailure"`
+}
+
+// KubeUpgradeHostStatus defines the upgrade state of a single host.
+type KubeUpgradeHostStatus struct {
+	// Hostname defines the name of the host.
+	Hostname string `json:"hostname"`
+
+	// ControlPlaneVersion defines the Kubernetes version of the control plane
+	// of the host.
+	// +optional
+	ControlPlaneVersion string `json:"controlPlaneVersion,omitempty"`
+
+	// KubeletVersion defines the Kubernetes version of the kubelet of the
+	// host.
+	// +optional
+	KubeletVersion string `json:"kubeletVersion,omitempty"`
+
+	// Status defines the upgrade operation in progress on the host, if any.
+	// +optional
+	Status string `json:"status,omitempty"`
+}
+
+// KubeUpgradeStatus defines the observed state of KubeUpgrade
+type KubeUpgradeStatus struct {
+	// State defines the last known state of the upgrade on the target system
+	// (e.g., downloading-images, upgrading-kubelets, upgrade-complete).
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// FromVersion defines the Kubernetes version from which the system is
+	// being upgraded.
+	// +optional
+	FromVersion string `json:"fromVersion,omitempty"`
+
+	// Hosts defines the upgrade state of each host of the system.
+	// +optional
+	Hosts []KubeUpgradeHostStatus `json:"hosts,omitempty"`
+
+	// Reconciled defines whether the upgrade has completed for the current
+	// configuration generation.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the resource has been provisioned on the target system.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Conditions defines the milestones reached by the upgrade and whether
+	// it has been rolled back.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// KubeUpgrade defines the attributes that represent an upgrade of the
+// Kubernetes version of the system which is driven host by host.  This is a
+// composition of the following StarlingX API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/config/api-ref-sysinv-v1-config.html#kubernetes-upgrade
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="version",type="string",JSONPath=".spec.toVersion",description="The target Kubernetes version."
+// +kubebuilder:printcolumn:name="state",type="string",JSONPath=".status.state",description="The current upgrade state."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type KubeUpgrade struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KubeUpgradeSpec   `json:"spec,omitempty"`
+	Status KubeUpgradeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// KubeUpgradeList contains a list of KubeUpgrade
+// +deepequal-gen=false
+type KubeUpgradeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubeUpgrade `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KubeUpgrade{}, &KubeUpgradeList{})
+}
@@ -0,0 +1,84 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/kubeupgrades"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Webhook response reasons
+const KubeUpgradeAllowedReason string = "allowed to be admitted"
+
+// log is for logging in this package.
+var kubeupgradelog = logf.Log.WithName("kubeupgrade-resource")
+
+func (r *KubeUpgrade) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-starlingx-windriver-com-v1-kubeupgrade,mutating=true,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=kubeupgrades,verbs=create;update,versions=v1,name=mkubeupgrade.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &KubeUpgrade{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *KubeUpgrade) Default() {
+	kubeupgradelog.Info("default", "name", r.Name)
+}
+
+// Validates an incoming resource update/create request.  The target version
+// itself is left to the system API to validate.
+func (r *KubeUpgrade) validateKubeUpgrade() error {
+	present := make(map[string]bool)
+	for _, hostname := range r.Spec.HostOrder {
+		if present[hostname] {
+			msg := fmt.Sprintf("host %q is listed more than once in the host order", hostname)
+			return errors.New(msg)
+		}
+		present[hostname] = true
+	}
+
+	kubeupgradelog.Info(KubeUpgradeAllowedReason)
+	return nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-kubeupgrade,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=kubeupgrades,versions=v1,name=vkubeupgrade.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &KubeUpgrade{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *KubeUpgrade) ValidateCreate() error {
+	kubeupgradelog.Info("validate create", "name", r.Name)
+
+	return r.validateKubeUpgrade()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *KubeUpgrade) ValidateUpdate(old runtime.Object) error {
+	kubeupgradelog.Info("validate update", "name", r.Name)
+
+	if o, ok := old.(*KubeUpgrade); ok && o.Spec.ToVersion != r.Spec.ToVersion {
+		status := o.Status
+		if status.State != "" && status.State != kubeupgrades.StateUpgradeAborted && !status.Reconciled {
+			return errors.New("the target version cannot be modified while the upgrade is in progress")
+		}
+	}
+
+	return r.validateKubeUpgrade()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *KubeUpgrade) ValidateDelete() error {
+	kubeupgradelog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
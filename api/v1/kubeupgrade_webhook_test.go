@@ -0,0 +1,72 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/kubeupgrades"
+)
+
+var _ = Describe("kubeupgrade_webhook functions", func() {
+
+	Describe("validateKubeUpgrade function is tested", func() {
+		Context("When each host is listed once", func() {
+			It("Sucessfully validates the upgrade", func() {
+				r := &KubeUpgrade{
+					Spec: KubeUpgradeSpec{
+						ToVersion: "v1.25.3",
+						HostOrder: []string{"controller-1", "controller-0", "worker-0"},
+					},
+				}
+				err := r.validateKubeUpgrade()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When a host is listed twice", func() {
+			It("Should throw the error the host is listed more than once", func() {
+				r := &KubeUpgrade{
+					Spec: KubeUpgradeSpec{
+						ToVersion: "v1.25.3",
+						HostOrder: []string{"worker-0", "worker-0"},
+					},
+				}
+				err := r.validateKubeUpgrade()
+				msg := errors.New("host \"worker-0\" is listed more than once in the host order")
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+
+	Describe("ValidateUpdate function is tested", func() {
+		Context("When the target version is modified during the upgrade", func() {
+			It("Should throw the error the target version cannot be modified", func() {
+				old := &KubeUpgrade{
+					Spec:   KubeUpgradeSpec{ToVersion: "v1.25.3"},
+					Status: KubeUpgradeStatus{State: kubeupgrades.StateUpgradingKubelets},
+				}
+				r := &KubeUpgrade{
+					Spec: KubeUpgradeSpec{ToVersion: "v1.26.1"},
+				}
+				err := r.ValidateUpdate(old)
+				msg := errors.New("the target version cannot be modified while the upgrade is in progress")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the target version is modified after a rollback", func() {
+			It("Sucessfully validates the upgrade", func() {
+				old := &KubeUpgrade{
+					Spec:   KubeUpgradeSpec{ToVersion: "v1.25.3"},
+					Status: KubeUpgradeStatus{State: kubeupgrades.StateUpgradeAborted},
+				}
+				r := &KubeUpgrade{
+					Spec: KubeUpgradeSpec{ToVersion: "v1.26.1"},
+				}
+				err := r.ValidateUpdate(old)
+				Expect(err).To(BeNil())
+			})
+		})
+	})
+})
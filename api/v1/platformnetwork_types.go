@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package v1
 
@@ -35,6 +35,34 @@ type AllocationInfo struct {
 	Order *string `json:"order,omitempty"`
 }
 
+// SecondaryPoolInfo defines the address pool of the second address family of
+// a dual-stack platform network.
+type SecondaryPoolInfo struct {
+	// Subnet defines the subdivision IPv4 or IPv6 network address for the
+	// pool.  It must be of the opposite address family of the primary subnet.
+	Subnet string `json:"subnet"`
+
+	// FloatingAddress defines the floating IPv4 or IPv6 network address for the pool
+	FloatingAddress string `json:"floatingAddress,omitempty"`
+
+	// Controller0Address is the controller-0 IPv4 or IPv6 network address value.
+	Controller0Address string `json:"controller0Address,omitempty"`
+
+	// Controller1Address is the controller-1 IPv4 or IPv6 network address value.
+	Controller1Address string `json:"controller1Address,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	Prefix int `json:"prefix"`
+
+	// Gateway defines the nexthop gateway IP address if applicable
+	// +optional
+	Gateway *string `json:"gateway,omitempty"`
+
+	// Allocation defines the allocation scheme details for the pool
+	Allocation AllocationInfo `json:"allocation"`
+}
+
 // PlatformNetworkSpec defines the desired state of PlatformNetwork
 type PlatformNetworkSpec struct {
 	// Type defines the intended usage of the network
@@ -63,8 +91,26 @@ type PlatformNetworkSpec struct {
 
 	// Allocation defines the allocation scheme details for the network
 	Allocation AllocationInfo `json:"allocation"`
+
+	// SecondaryPool defines the address pool of the second address family of
+	// a dual-stack network.  It is only supported on the mgmt, cluster-host
+	// and oam networks.
+	// +optional
+	SecondaryPool *SecondaryPoolInfo `json:"secondaryPool,omitempty"`
 }
 
+// Defines the condition types reported in the platform network status.
+const (
+	// ConditionOAMUpdateInProgress reports the progress of an OAM network
+	// update that has been applied to the system and is waiting for the
+	// controllers to apply the new configuration.
+	ConditionOAMUpdateInProgress = "OAMUpdateInProgress"
+
+	// ConditionDeletionBlocked reports that the deletion of a platform
+	// network is waiting for the hosts that still use it to release it.
+	ConditionDeletionBlocked = "DeletionBlocked"
+)
+
 // PlatformNetworkStatus defines the observed state of PlatformNetwork
 type PlatformNetworkStatus struct {
 	// ID defines the system assigned unique identifier.  This will only exist
@@ -78,6 +124,13 @@ type PlatformNetworkStatus struct {
 	// +optional
 	PoolUUID *string `json:"poolUUID,omitempty"`
 
+	// SecondaryPoolUUID defines the system assigned unique identifier of the
+	// address pool of the second address family of a dual-stack network.
+	// This will only exist once the secondary pool has been provisioned into
+	// the system.
+	// +optional
+	SecondaryPoolUUID *string `json:"secondaryPoolUUID,omitempty"`
+
 	// Reconciled defines whether the network has been successfully reconciled
 	// at least once.  If further changes are made they will be ignored by the
 	// reconciler.
@@ -113,6 +166,18 @@ type PlatformNetworkStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// DeltaAttributes lists each attribute which differs between the final
+	// profile and the current configuration in a machine readable form.
+	// +optional
+	DeltaAttributes []DeltaAttribute `json:"deltaAttributes,omitempty"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the platform network.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package v1
 
@@ -88,10 +88,54 @@ func (r *PlatformNetwork) validatePlatformNetwork() error {
 			return errors.New("allocation range address must be of the same family as the network subnet.")
 		}
 	}
+
+	if r.Spec.SecondaryPool != nil {
+		if err := r.validateSecondaryPool(); err != nil {
+			return err
+		}
+	}
+
 	platformnetworklog.Info(PlatformNetworkAllowedReason)
 	return nil
 }
 
+// Validates the secondary address pool of a dual-stack network.  The secondary
+// pool must be of the opposite address family of the network subnet and is
+// only supported on the networks which can be configured as dual-stack.
+func (r *PlatformNetwork) validateSecondaryPool() error {
+	switch r.Spec.Type {
+	case "mgmt", "cluster-host", "oam":
+	default:
+		return errors.New("secondary address pools are only supported on mgmt, cluster-host and oam networks")
+	}
+
+	pool := r.Spec.SecondaryPool
+	if !IsIPAddress(pool.Subnet) {
+		return errors.New("expecting a valid IPv4 or IPv6 address in secondary pool subnet")
+	}
+
+	if common.IsIPv4(pool.Subnet) == common.IsIPv4(r.Spec.Subnet) {
+		return errors.New("secondary pool subnet must be of the opposite address family of the network subnet")
+	}
+
+	if !IsValidPrefix(pool.Subnet, pool.Prefix) {
+		return errors.New("secondary pool prefix value must correspond to the subnet address family")
+	}
+
+	for _, ra := range pool.Allocation.Ranges {
+		if !IsIPAddress(ra.Start) || !IsIPAddress(ra.End) {
+			return errors.New("start and end addresses must be valid IP addresses")
+		}
+
+		if common.IsIPv4(ra.Start) != common.IsIPv4(ra.End) ||
+			common.IsIPv4(ra.Start) != common.IsIPv4(pool.Subnet) {
+			return errors.New("secondary pool allocation range address must be of the same family as the secondary pool subnet.")
+		}
+	}
+
+	return nil
+}
+
 // TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
 // +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-platformnetwork,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=platformnetworks,versions=v1,name=vplatformnetwork.kb.io,admissionReviewVersions=v1
 
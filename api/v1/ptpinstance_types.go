@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022 Wind River Systems, Inc. */
+/* Copyright(c) 2022-2024 Wind River Systems, Inc. */
 
 package v1
 
@@ -7,10 +7,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// Defines the service types supported by PTP instances.
+const (
+	PtpServicePtp4l   = "ptp4l"
+	PtpServicePhc2sys = "phc2sys"
+	PtpServiceTs2phc  = "ts2phc"
+	PtpServiceClock   = "clock"
+	PtpServiceSynce   = "synce4l"
+)
+
 // PtpInstanceSpec defines the desired state of PtpInstance
 type PtpInstanceSpec struct {
 	// Serivce defines the service type of the ptp instance
-	// +kubebuilder:validation:Enum=ptp4l;phc2sys;ts2phc;clock
+	// +kubebuilder:validation:Enum=ptp4l;phc2sys;ts2phc;clock;synce4l
 	Service string `json:"service"`
 
 	// Parameters contains a list of parameters assigned to the ptp instance
@@ -60,6 +69,18 @@ type PtpInstanceStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// DeltaAttributes lists each attribute which differs between the final
+	// profile and the current configuration in a machine readable form.
+	// +optional
+	DeltaAttributes []DeltaAttribute `json:"deltaAttributes,omitempty"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the PTP instance.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022 Wind River Systems, Inc. */
+/* Copyright(c) 2022-2024 Wind River Systems, Inc. */
 
 package v1
 
@@ -37,6 +37,38 @@ func (r *PtpInstance) Default() {
 	// TODO(user): fill in your defaulting logic.
 }
 
+// ptpServiceParameters defines the parameters which are only meaningful to a
+// single PTP service type.  Parameters which are not listed are left to the
+// system API to validate.
+var ptpServiceParameters = map[string][]string{
+	PtpServicePhc2sys: {"ha_enabled", "ha_phc2sys_com_socket", "ha_stability_timer"},
+	PtpServiceTs2phc: {"ts2phc.nmea_serialport", "ts2phc.nmea_baudrate", "ts2phc.pulsewidth",
+		"ts2phc.extts_polarity", "ts2phc.extts_correction", "ts2phc.master", "ts2phc.pin_index", "leapfile"},
+	PtpServiceClock: {"sma1", "sma2", "u.fl1", "u.fl2", "synce_rclka", "synce_rclkb"},
+	PtpServiceSynce: {"dnu_prio", "recover_time", "network_option", "eec_get_state_cmd",
+		"eec_holdover_value", "eec_locked_ho_value", "eec_locked_value", "eec_freerun_value",
+		"eec_invalid_value", "tx_heartbeat_msec", "rx_heartbeat_msec", "input_mode",
+		"external_input_QL", "external_input_ext_QL", "extended_tlv"},
+}
+
+// ptpParameterService returns the service type to which a parameter is
+// restricted, if any.
+func ptpParameterService(key string) (string, bool) {
+	for service, keys := range ptpServiceParameters {
+		for _, k := range keys {
+			if k == key {
+				return service, true
+			}
+		}
+	}
+
+	if strings.HasPrefix(key, PtpServiceTs2phc+".") {
+		return PtpServiceTs2phc, true
+	}
+
+	return "", false
+}
+
 // Validates an incoming resource update/create request.  The intent of this validation is to perform only the
 // minimum amount of validation which should normally be done by the CRD validation schema, but until kubebuilder
 // supports the necessary validation annotations we need to do this in a webhook.  All other validation is left
@@ -60,6 +92,12 @@ func (r *PtpInstance) validatePtpInstance() error {
 			return errors.New(msg)
 		}
 		present[key] = true
+
+		if service, ok := ptpParameterService(key); ok && service != r.Spec.Service {
+			msg := fmt.Sprintf("parameter %s is only supported by %s instances.",
+				key, service)
+			return errors.New(msg)
+		}
 	}
 
 	ptpinstancelog.Info(PtpInstanceAllowedReason)
@@ -0,0 +1,51 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ptpinstance_webhook functions", func() {
+
+	Describe("validatePtpInstance function is tested", func() {
+		Context("When a ts2phc instance uses GNSS parameters", func() {
+			It("Sucessfully validates the PTP instance", func() {
+				r := &PtpInstance{
+					Spec: PtpInstanceSpec{
+						Service:            PtpServiceTs2phc,
+						InstanceParameters: []string{"ts2phc.nmea_serialport=/dev/ttyGNSS_1800_0", "leapfile=/usr/share/zoneinfo/leap-seconds.list"},
+					},
+				}
+				Expect(r.validatePtpInstance()).To(BeNil())
+			})
+		})
+		Context("When a ptp4l instance uses a ts2phc parameter", func() {
+			It("Should reject the parameter", func() {
+				r := &PtpInstance{
+					Spec: PtpInstanceSpec{
+						Service:            PtpServicePtp4l,
+						InstanceParameters: []string{"domainNumber=24", "ts2phc.pulsewidth=100000000"},
+					},
+				}
+				msg := errors.New("parameter ts2phc.pulsewidth is only supported by ts2phc instances.")
+				Expect(r.validatePtpInstance()).To(Equal(msg))
+			})
+		})
+		Context("When a clock instance uses a synce4l parameter", func() {
+			It("Should reject the parameter", func() {
+				r := &PtpInstance{
+					Spec: PtpInstanceSpec{
+						Service:            PtpServiceClock,
+						InstanceParameters: []string{"sma1=output", "dnu_prio=0xf"},
+					},
+				}
+				msg := errors.New("parameter dnu_prio is only supported by synce4l instances.")
+				Expect(r.validatePtpInstance()).To(Equal(msg))
+			})
+		})
+	})
+})
@@ -0,0 +1,162 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the locations from which a subcloud backup can be restored.
+const (
+	// RestoreLocationCentral indicates that the backup is stored on the
+	// system controller.
+	RestoreLocationCentral = "central"
+
+	// RestoreLocationLocal indicates that the backup is stored on the
+	// subcloud controller being restored.
+	RestoreLocationLocal = "local"
+)
+
+// Defines the phases of a restore in the order in which they are reached.
+const (
+	// RestorePhaseRequested indicates that the restore has been requested
+	// but not yet started by the system controller.
+	RestorePhaseRequested = "requested"
+
+	// RestorePhaseRestoring indicates that the restore is running.
+	RestorePhaseRestoring = "restoring"
+
+	// RestorePhaseCompleted indicates that the restore has completed and
+	// that the subcloud is managed again if requested.
+	RestorePhaseCompleted = "completed"
+
+	// RestorePhaseFailed indicates that the restore has failed.
+	RestorePhaseFailed = "failed"
+)
+
+// RestoreSpec defines the desired state of Restore
+type RestoreSpec struct {
+	// Subcloud defines the name of the Subcloud resource, in the same
+	// namespace, which is restored.
+	// +kubebuilder:validation:MinLength=1
+	Subcloud string `json:"subcloud"`
+
+	// BackupLocation defines whether the backup is stored on the system
+	// controller or on the subcloud controller.
+	// +kubebuilder:validation:Enum=central;local
+	// +kubebuilder:default:=central
+	// +optional
+	BackupLocation string `json:"backupLocation,omitempty"`
+
+	// RegistryImages defines whether the backup of the local registry images
+	// is restored along with the platform so that container images need not
+	// be pulled again.  It requires that the images were included in the
+	// backup.
+	// +optional
+	RegistryImages bool `json:"registryImages,omitempty"`
+
+	// Reinstall defines whether the subcloud controller is first reinstalled
+	// with the install values of the subcloud so that the restore starts
+	// from a fresh controller-0.
+	// +kubebuilder:default:=true
+	// +optional
+	Reinstall bool `json:"reinstall"`
+
+	// Release defines the software release with which the subcloud is
+	// reinstalled.  The release of the subcloud is used when not specified.
+	// +kubebuilder:validation:Pattern=^[0-9]+\.[0-9]+$
+	// +optional
+	Release *string `json:"release,omitempty"`
+
+	// RestoreValues defines additional overrides, as a YAML document, passed
+	// to the restore playbook (e.g., wipe_ceph_osds).
+	// +optional
+	RestoreValues *string `json:"restoreValues,omitempty"`
+
+	// SystemNamespace defines the namespace of the System and Host resources
+	// which configure the subcloud when it is also managed by this
+	// deployment manager.  They are paused while the subcloud is restored
+	// and reconciled again once the restore has completed so that the
+	// remaining hosts are provisioned.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	SystemNamespace *string `json:"systemNamespace,omitempty"`
+}
+
+// RestoreStatus defines the observed state of Restore
+type RestoreStatus struct {
+	// Phase defines the progress of the restore (e.g., requested, restoring,
+	// completed, failed).
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// DeployStatus defines the last known deployment state of the subcloud
+	// (e.g., installing, restoring, complete).
+	// +optional
+	DeployStatus string `json:"deployStatus,omitempty"`
+
+	// ErrorDescription defines the reason reported by the system controller
+	// when the restore fails.
+	// +optional
+	ErrorDescription string `json:"errorDescription,omitempty"`
+
+	// Reconciled defines whether the restore has completed for the current
+	// configuration generation.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the resource has been provisioned on the target system.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the restore.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// Restore defines the attributes that represent the restore of a Distributed
+// Cloud subcloud from one of its platform backups.  The restore is run by the
+// system controller, optionally after reinstalling the subcloud controller,
+// and the subcloud is managed again once it completes.  The hosts of the
+// subcloud are then reconciled again if its configuration is also managed by
+// the deployment manager.  This is a composition of the following StarlingX
+// API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/distcloud/api-ref-dcmanager-v1.html#subcloud-backups
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="subcloud",type="string",JSONPath=".spec.subcloud",description="The subcloud being restored."
+// +kubebuilder:printcolumn:name="phase",type="string",JSONPath=".status.phase",description="The current restore phase."
+// +kubebuilder:printcolumn:name="deploy",type="string",JSONPath=".status.deployStatus",description="The current deployment state of the subcloud."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type Restore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RestoreSpec   `json:"spec,omitempty"`
+	Status RestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// RestoreList contains a list of Restore
+// +deepequal-gen=false
+type RestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Restore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Restore{}, &RestoreList{})
+}
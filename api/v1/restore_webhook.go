@@ -0,0 +1,83 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Webhook response reasons
+const RestoreAllowedReason string = "allowed to be admitted"
+
+// log is for logging in this package.
+var restorelog = logf.Log.WithName("restore-resource")
+
+func (r *Restore) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-starlingx-windriver-com-v1-restore,mutating=true,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=restores,verbs=create;update,versions=v1,name=mrestore.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Restore{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Restore) Default() {
+	restorelog.Info("default", "name", r.Name)
+}
+
+// Validates an incoming resource update/create request.  The registry images
+// can only be restored from a backup stored on the subcloud and the restore
+// values must be a YAML document.
+func (r *Restore) validateRestore() error {
+	if r.Spec.RegistryImages && r.Spec.BackupLocation != RestoreLocationLocal {
+		msg := fmt.Sprintf("registry images can only be restored from a %q backup location",
+			RestoreLocationLocal)
+		return errors.New(msg)
+	}
+
+	if r.Spec.RestoreValues != nil {
+		values := make(map[string]interface{})
+		if err := yaml.Unmarshal([]byte(*r.Spec.RestoreValues), &values); err != nil {
+			msg := fmt.Sprintf("restore values are not a valid YAML document: %s", err.Error())
+			return errors.New(msg)
+		}
+	}
+
+	restorelog.Info(RestoreAllowedReason)
+	return nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-restore,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=restores,versions=v1,name=vrestore.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Restore{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Restore) ValidateCreate() error {
+	restorelog.Info("validate create", "name", r.Name)
+
+	return r.validateRestore()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Restore) ValidateUpdate(old runtime.Object) error {
+	restorelog.Info("validate update", "name", r.Name)
+
+	return r.validateRestore()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Restore) ValidateDelete() error {
+	restorelog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
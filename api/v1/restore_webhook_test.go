@@ -0,0 +1,58 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("restore_webhook functions", func() {
+
+	Describe("validateRestore function is tested", func() {
+		Context("When the registry images are restored from a local backup", func() {
+			It("Sucessfully validates the restore", func() {
+				values := "wipe_ceph_osds: true\n"
+				r := &Restore{
+					Spec: RestoreSpec{
+						Subcloud:       "subcloud1",
+						BackupLocation: RestoreLocationLocal,
+						RegistryImages: true,
+						RestoreValues:  &values,
+					},
+				}
+				err := r.validateRestore()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When the registry images are restored from a central backup", func() {
+			It("Should throw the error the registry images require a local backup", func() {
+				r := &Restore{
+					Spec: RestoreSpec{
+						Subcloud:       "subcloud1",
+						BackupLocation: RestoreLocationCentral,
+						RegistryImages: true,
+					},
+				}
+				err := r.validateRestore()
+				msg := errors.New("registry images can only be restored from a \"local\" backup location")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the restore values are not a YAML map", func() {
+			It("Should throw the error the restore values are not valid", func() {
+				values := "- wipe_ceph_osds\n"
+				r := &Restore{
+					Spec: RestoreSpec{
+						Subcloud:      "subcloud1",
+						RestoreValues: &values,
+					},
+				}
+				err := r.validateRestore()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
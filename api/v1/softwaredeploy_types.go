@@ -0,0 +1,130 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SoftwareDeploySpec defines the desired state of SoftwareDeploy
+type SoftwareDeploySpec struct {
+	// Release defines the identifier of the software release deployed to the
+	// system (e.g., "stx-10.0.1").
+	// +kubebuilder:validation:MinLength=1
+	Release string `json:"release"`
+
+	// Files defines the paths, on the active controller, of the release files
+	// uploaded when the release is not already known to the system.
+	// +optional
+	Files []string `json:"files,omitempty"`
+
+	// ControllerApplyType defines how the release is deployed to controller
+	// hosts.
+	// +kubebuilder:validation:Enum=serial;parallel;ignore
+	// +kubebuilder:default:=serial
+	// +optional
+	ControllerApplyType string `json:"controllerApplyType,omitempty"`
+
+	// StorageApplyType defines how the release is deployed to storage hosts.
+	// +kubebuilder:validation:Enum=serial;parallel;ignore
+	// +kubebuilder:default:=serial
+	// +optional
+	StorageApplyType string `json:"storageApplyType,omitempty"`
+
+	// WorkerApplyType defines how the release is deployed to worker hosts.
+	// +kubebuilder:validation:Enum=serial;parallel;ignore
+	// +kubebuilder:default:=serial
+	// +optional
+	WorkerApplyType string `json:"workerApplyType,omitempty"`
+
+	// MaxParallelWorkerHosts defines the maximum number of worker hosts to
+	// which the release is deployed at the same time.  It only applies when
+	// the worker apply type is "parallel".
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxParallelWorkerHosts *int `json:"maxParallelWorkerHosts,omitempty"`
+}
+
+// SoftwareDeployHostStatus defines the deployment state of a single host.
+type SoftwareDeployHostStatus struct {
+	// Hostname defines the name of the host.
+	Hostname string `json:"hostname"`
+
+	// State defines the last known deployment state of the host (e.g.,
+	// pending, deploying, deployed, failed).
+	State string `json:"state"`
+}
+
+// SoftwareDeployStatus defines the observed state of SoftwareDeploy
+type SoftwareDeployStatus struct {
+	// State defines the last known state of the deployment on the target
+	// system (e.g., start, host, activate, completed).  Once the deployment
+	// has been removed it reflects the state of the release.
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Hosts defines the deployment state of each host of the system.
+	// +optional
+	Hosts []SoftwareDeployHostStatus `json:"hosts,omitempty"`
+
+	// Reason defines why the deployment is not progressing.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Reconciled defines whether the release has been successfully deployed
+	// for the current configuration generation.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the resource has been provisioned on the target system.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the software deployment.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// SoftwareDeploy defines the attributes that represent the deployment of a
+// software release to all hosts of the system with the Unified Software
+// Management (USM) service.  This is a composition of the following
+// StarlingX API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/update/api-ref-usm-v1-update.html
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="release",type="string",JSONPath=".spec.release",description="The software release being deployed."
+// +kubebuilder:printcolumn:name="state",type="string",JSONPath=".status.state",description="The current deployment state."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type SoftwareDeploy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SoftwareDeploySpec   `json:"spec,omitempty"`
+	Status SoftwareDeployStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// SoftwareDeployList contains a list of SoftwareDeploy
+// +deepequal-gen=false
+type SoftwareDeployList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SoftwareDeploy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SoftwareDeploy{}, &SoftwareDeployList{})
+}
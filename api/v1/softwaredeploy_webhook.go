@@ -0,0 +1,80 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Webhook response reasons
+const SoftwareDeployAllowedReason string = "allowed to be admitted"
+
+// log is for logging in this package.
+var softwaredeploylog = logf.Log.WithName("softwaredeploy-resource")
+
+func (r *SoftwareDeploy) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-starlingx-windriver-com-v1-softwaredeploy,mutating=true,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=softwaredeploys,verbs=create;update,versions=v1,name=msoftwaredeploy.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &SoftwareDeploy{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *SoftwareDeploy) Default() {
+	softwaredeploylog.Info("default", "name", r.Name)
+}
+
+// Validates an incoming resource update/create request.  The release itself
+// is left to the USM API to validate.
+func (r *SoftwareDeploy) validateSoftwareDeploy() error {
+	spec := r.Spec
+
+	if spec.MaxParallelWorkerHosts != nil && spec.WorkerApplyType != ApplyTypeParallel {
+		msg := fmt.Sprintf("the maximum number of parallel worker hosts requires the %q worker apply type", ApplyTypeParallel)
+		return errors.New(msg)
+	}
+
+	softwaredeploylog.Info(SoftwareDeployAllowedReason)
+	return nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-softwaredeploy,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=softwaredeploys,versions=v1,name=vsoftwaredeploy.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &SoftwareDeploy{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *SoftwareDeploy) ValidateCreate() error {
+	softwaredeploylog.Info("validate create", "name", r.Name)
+
+	return r.validateSoftwareDeploy()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *SoftwareDeploy) ValidateUpdate(old runtime.Object) error {
+	softwaredeploylog.Info("validate update", "name", r.Name)
+
+	if o, ok := old.(*SoftwareDeploy); ok && o.Spec.Release != r.Spec.Release {
+		if o.Status.State != "" && !o.Status.Reconciled {
+			return errors.New("the release cannot be modified while it is being deployed")
+		}
+	}
+
+	return r.validateSoftwareDeploy()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *SoftwareDeploy) ValidateDelete() error {
+	softwaredeploylog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("softwaredeploy_webhook functions", func() {
+
+	Describe("validateSoftwareDeploy function is tested", func() {
+		Context("When parallel worker hosts are set for parallel deployments", func() {
+			It("Sucessfully validates the deployment", func() {
+				hosts := 4
+				r := &SoftwareDeploy{
+					Spec: SoftwareDeploySpec{
+						Release:                "stx-10.0.1",
+						WorkerApplyType:        ApplyTypeParallel,
+						MaxParallelWorkerHosts: &hosts,
+					},
+				}
+				err := r.validateSoftwareDeploy()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When parallel worker hosts are set for serial deployments", func() {
+			It("Should throw the error the parallel apply type is required", func() {
+				hosts := 4
+				r := &SoftwareDeploy{
+					Spec: SoftwareDeploySpec{
+						Release:                "stx-10.0.1",
+						WorkerApplyType:        ApplyTypeSerial,
+						MaxParallelWorkerHosts: &hosts,
+					},
+				}
+				err := r.validateSoftwareDeploy()
+				msg := errors.New("the maximum number of parallel worker hosts requires the \"parallel\" worker apply type")
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+
+	Describe("ValidateUpdate function is tested", func() {
+		Context("When the release is modified while it is being deployed", func() {
+			It("Should throw the error the release cannot be modified", func() {
+				old := &SoftwareDeploy{
+					Spec:   SoftwareDeploySpec{Release: "stx-10.0.1"},
+					Status: SoftwareDeployStatus{State: "host"},
+				}
+				r := &SoftwareDeploy{
+					Spec: SoftwareDeploySpec{Release: "stx-10.0.2"},
+				}
+				err := r.ValidateUpdate(old)
+				msg := errors.New("the release cannot be modified while it is being deployed")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the release is modified once it has been deployed", func() {
+			It("Sucessfully validates the deployment", func() {
+				old := &SoftwareDeploy{
+					Spec:   SoftwareDeploySpec{Release: "stx-10.0.1"},
+					Status: SoftwareDeployStatus{State: "deployed", Reconciled: true},
+				}
+				r := &SoftwareDeploy{
+					Spec: SoftwareDeploySpec{Release: "stx-10.0.2"},
+				}
+				err := r.ValidateUpdate(old)
+				Expect(err).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,157 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StrategySpec defines the desired state of Strategy
+type StrategySpec struct {
+	// Type defines the type of update orchestrated by the strategy.
+	// +kubebuilder:validation:Enum=sw-patch;kube-upgrade;fw-update
+	Type string `json:"type"`
+
+	// ControllerApplyType defines how the update is applied to controller
+	// hosts.  It is not supported by kube-upgrade strategies and only the
+	// "ignore" value is supported by fw-update strategies.
+	// +kubebuilder:validation:Enum=serial;parallel;ignore
+	// +optional
+	ControllerApplyType *string `json:"controllerApplyType,omitempty"`
+
+	// StorageApplyType defines how the update is applied to storage hosts.
+	// +kubebuilder:validation:Enum=serial;parallel;ignore
+	// +optional
+	StorageApplyType *string `json:"storageApplyType,omitempty"`
+
+	// WorkerApplyType defines how the update is applied to worker hosts.
+	// +kubebuilder:validation:Enum=serial;parallel;ignore
+	// +kubebuilder:default:=serial
+	// +optional
+	WorkerApplyType string `json:"workerApplyType,omitempty"`
+
+	// MaxParallelWorkerHosts defines the maximum number of worker hosts that
+	// are updated at the same time.  It only applies when the worker apply
+	// type is "parallel".
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxParallelWorkerHosts *int `json:"maxParallelWorkerHosts,omitempty"`
+
+	// DefaultInstanceAction defines the action taken on instances running on
+	// a host being updated.
+	// +kubebuilder:validation:Enum=stop-start;migrate
+	// +kubebuilder:default:=stop-start
+	// +optional
+	DefaultInstanceAction string `json:"defaultInstanceAction,omitempty"`
+
+	// AlarmRestrictions defines whether all alarms block the strategy or
+	// whether alarms which are not management affecting are ignored.
+	// +kubebuilder:validation:Enum=strict;relaxed
+	// +kubebuilder:default:=strict
+	// +optional
+	AlarmRestrictions string `json:"alarmRestrictions,omitempty"`
+
+	// ToVersion defines the Kubernetes version to which the system is
+	// upgraded.  It is required by kube-upgrade strategies and not supported
+	// by any other strategy type.
+	// +kubebuilder:validation:Pattern=`^v[0-9]+\.[0-9]+\.[0-9]+$`
+	// +optional
+	ToVersion *string `json:"toVersion,omitempty"`
+
+	// AutoApply defines whether the strategy is applied as soon as it has
+	// been built.  When disabled the strategy is built and left ready to
+	// apply until this attribute is enabled.
+	// +kubebuilder:default:=true
+	// +optional
+	AutoApply bool `json:"autoApply"`
+}
+
+// StrategyStatus defines the observed state of Strategy
+type StrategyStatus struct {
+	// ID defines the system assigned unique identifier.  This will only exist
+	// once the strategy has been created on the target system.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// State defines the last known state of the strategy on the target
+	// system (e.g., building, ready-to-apply, applying, applied).
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Phase defines the strategy phase currently running or the last phase
+	// that ran (i.e., build, apply, abort).
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Progress defines the completion percentage of the current phase.
+	// +optional
+	Progress int `json:"progress,omitempty"`
+
+	// Stage defines the name of the stage of the current phase that is
+	// running or that last ran.
+	// +optional
+	Stage string `json:"stage,omitempty"`
+
+	// Reason defines the reason reported by the system when the strategy
+	// fails or is aborted.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Reconciled defines whether the strategy has been successfully applied
+	// for the current configuration generation.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the resource has been provisioned on the target system.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the update strategy.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// Strategy defines the attributes that represent an update orchestration
+// strategy run by the VIM to update all hosts of the system in a controlled
+// manner.  This is a composition of the following StarlingX API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/nfv/api-ref-nfv-vim-v1.html
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="type",type="string",JSONPath=".spec.type",description="The strategy type."
+// +kubebuilder:printcolumn:name="state",type="string",JSONPath=".status.state",description="The current strategy state."
+// +kubebuilder:printcolumn:name="phase",type="string",JSONPath=".status.phase",description="The current strategy phase."
+// +kubebuilder:printcolumn:name="progress",type="integer",JSONPath=".status.progress",description="The completion percentage of the current phase."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type Strategy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StrategySpec   `json:"spec,omitempty"`
+	Status StrategyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// StrategyList contains a list of Strategy
+// +deepequal-gen=false
+type StrategyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Strategy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Strategy{}, &StrategyList{})
+}
@@ -0,0 +1,109 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/strategies"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Webhook response reasons
+const StrategyAllowedReason string = "allowed to be admitted"
+
+// Defines the supported apply types.
+const (
+	ApplyTypeSerial   = "serial"
+	ApplyTypeParallel = "parallel"
+	ApplyTypeIgnore   = "ignore"
+)
+
+// log is for logging in this package.
+var strategylog = logf.Log.WithName("strategy-resource")
+
+func (r *Strategy) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-starlingx-windriver-com-v1-strategy,mutating=true,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=strategies,verbs=create;update,versions=v1,name=mstrategy.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Strategy{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Strategy) Default() {
+	strategylog.Info("default", "name", r.Name)
+}
+
+// Validates an incoming resource update/create request.  Each strategy type
+// supports a different subset of attributes; the remaining validation is left
+// to the VIM API.
+func (r *Strategy) validateStrategy() error {
+	spec := r.Spec
+
+	switch spec.Type {
+	case strategies.TypeKubeUpgrade:
+		if spec.ToVersion == nil {
+			msg := fmt.Sprintf("a target version is required for %s strategies", spec.Type)
+			return errors.New(msg)
+		}
+		if spec.ControllerApplyType != nil {
+			msg := fmt.Sprintf("the controller apply type is not supported for %s strategies", spec.Type)
+			return errors.New(msg)
+		}
+	case strategies.TypeFwUpdate:
+		if spec.ControllerApplyType != nil && *spec.ControllerApplyType != ApplyTypeIgnore {
+			msg := fmt.Sprintf("the controller apply type must be %q for %s strategies", ApplyTypeIgnore, spec.Type)
+			return errors.New(msg)
+		}
+	}
+
+	if spec.Type != strategies.TypeKubeUpgrade && spec.ToVersion != nil {
+		msg := fmt.Sprintf("a target version is not supported for %s strategies", spec.Type)
+		return errors.New(msg)
+	}
+
+	if spec.MaxParallelWorkerHosts != nil && spec.WorkerApplyType != ApplyTypeParallel {
+		msg := fmt.Sprintf("the maximum number of parallel worker hosts requires the %q worker apply type", ApplyTypeParallel)
+		return errors.New(msg)
+	}
+
+	strategylog.Info(StrategyAllowedReason)
+	return nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-strategy,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=strategies,versions=v1,name=vstrategy.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Strategy{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Strategy) ValidateCreate() error {
+	strategylog.Info("validate create", "name", r.Name)
+
+	return r.validateStrategy()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Strategy) ValidateUpdate(old runtime.Object) error {
+	strategylog.Info("validate update", "name", r.Name)
+
+	if o, ok := old.(*Strategy); ok && o.Spec.Type != r.Spec.Type {
+		return errors.New("the strategy type cannot be modified")
+	}
+
+	return r.validateStrategy()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Strategy) ValidateDelete() error {
+	strategylog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/strategies"
+)
+
+var _ = Describe("strategy_webhook functions", func() {
+
+	Describe("validateStrategy function is tested", func() {
+		Context("When a kube-upgrade strategy has a target version", func() {
+			It("Sucessfully validates the strategy", func() {
+				version := "v1.24.4"
+				r := &Strategy{
+					Spec: StrategySpec{
+						Type:            strategies.TypeKubeUpgrade,
+						WorkerApplyType: ApplyTypeSerial,
+						ToVersion:       &version,
+					},
+				}
+				err := r.validateStrategy()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When a kube-upgrade strategy has no target version", func() {
+			It("Should throw the error a target version is required", func() {
+				r := &Strategy{
+					Spec: StrategySpec{
+						Type: strategies.TypeKubeUpgrade,
+					},
+				}
+				err := r.validateStrategy()
+				msg := errors.New("a target version is required for kube-upgrade strategies")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When a fw-update strategy updates controllers", func() {
+			It("Should throw the error the controller apply type must be ignore", func() {
+				applyType := ApplyTypeSerial
+				r := &Strategy{
+					Spec: StrategySpec{
+						Type:                strategies.TypeFwUpdate,
+						ControllerApplyType: &applyType,
+					},
+				}
+				err := r.validateStrategy()
+				msg := errors.New("the controller apply type must be \"ignore\" for fw-update strategies")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When parallel worker hosts are set for serial updates", func() {
+			It("Should throw the error the parallel apply type is required", func() {
+				hosts := 4
+				r := &Strategy{
+					Spec: StrategySpec{
+						Type:                   strategies.TypeSwPatch,
+						WorkerApplyType:        ApplyTypeSerial,
+						MaxParallelWorkerHosts: &hosts,
+					},
+				}
+				err := r.validateStrategy()
+				msg := errors.New("the maximum number of parallel worker hosts requires the \"parallel\" worker apply type")
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+})
@@ -0,0 +1,150 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubcloudSpec defines the desired state of Subcloud
+type SubcloudSpec struct {
+	// BootstrapAddress defines the IP address used to reach the subcloud
+	// controller while it is being installed and bootstrapped.
+	BootstrapAddress string `json:"bootstrapAddress"`
+
+	// BootstrapValues defines the contents of the bootstrap values file, in
+	// YAML format, used to bootstrap the subcloud.
+	// +kubebuilder:validation:MinLength=1
+	BootstrapValues string `json:"bootstrapValues"`
+
+	// SysadminPasswordSecret defines the name of the secret which holds the
+	// sysadmin password of the subcloud under the "password" key.
+	// +kubebuilder:validation:MinLength=1
+	SysadminPasswordSecret string `json:"sysadminPasswordSecret"`
+
+	// InstallValuesSecret defines the name of the secret which holds the
+	// install values file, in YAML format, under the "install_values" key and
+	// the BMC password under the "bmc_password" key.  The subcloud is
+	// remotely installed only if this attribute is specified.
+	// +optional
+	InstallValuesSecret *string `json:"installValuesSecret,omitempty"`
+
+	// DeployConfig defines the name of the ConfigMap which holds the
+	// deployment configuration file, in YAML format, under the
+	// "deploy_config" key.  The subcloud is configured once bootstrapped only
+	// if this attribute is specified.
+	// +optional
+	DeployConfig *string `json:"deployConfig,omitempty"`
+
+	// Release defines the software release installed on the subcloud.  The
+	// release of the system controller is used when not specified.
+	// +kubebuilder:validation:Pattern=^[0-9]+\.[0-9]+$
+	// +optional
+	Release *string `json:"release,omitempty"`
+
+	// Description defines a free form description of the subcloud.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Location defines a free form description of the subcloud location.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// Manage defines whether the subcloud is managed by the system
+	// controller once it has been deployed.
+	// +kubebuilder:default:=true
+	// +optional
+	Manage bool `json:"manage"`
+}
+
+// SubcloudStatus defines the observed state of Subcloud
+type SubcloudStatus struct {
+	// ID defines the system assigned unique identifier.  This will only exist
+	// once the subcloud has been added to the system controller.
+	// +optional
+	ID *int `json:"id,omitempty"`
+
+	// DeployStatus defines the last known deployment state of the subcloud
+	// (e.g., installing, bootstrapping, complete).
+	// +optional
+	DeployStatus string `json:"deployStatus,omitempty"`
+
+	// AvailabilityStatus defines whether the subcloud is online or offline.
+	// +optional
+	AvailabilityStatus string `json:"availabilityStatus,omitempty"`
+
+	// ManagementState defines whether the subcloud is managed or unmanaged.
+	// +optional
+	ManagementState string `json:"managementState,omitempty"`
+
+	// SyncStatus defines the overall synchronization state of the subcloud.
+	// +optional
+	SyncStatus string `json:"syncStatus,omitempty"`
+
+	// SoftwareVersion defines the software release installed on the subcloud.
+	// +optional
+	SoftwareVersion string `json:"softwareVersion,omitempty"`
+
+	// ErrorDescription defines the reason reported by the system controller
+	// when a deployment phase fails.
+	// +optional
+	ErrorDescription string `json:"errorDescription,omitempty"`
+
+	// Reconciled defines whether the subcloud has been deployed, and managed
+	// if requested, for the current configuration generation.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the resource has been provisioned on the target system.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the subcloud.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// Subcloud defines the attributes that represent a Distributed Cloud subcloud
+// added to, and deployed by, a system controller.  This is a composition of
+// the following StarlingX API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/distcloud/api-ref-dcmanager-v1.html
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="deploy",type="string",JSONPath=".status.deployStatus",description="The current deployment state."
+// +kubebuilder:printcolumn:name="availability",type="string",JSONPath=".status.availabilityStatus",description="The current availability state."
+// +kubebuilder:printcolumn:name="management",type="string",JSONPath=".status.managementState",description="The current management state."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type Subcloud struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubcloudSpec   `json:"spec,omitempty"`
+	Status SubcloudStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// SubcloudList contains a list of Subcloud
+// +deepequal-gen=false
+type SubcloudList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subcloud `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Subcloud{}, &SubcloudList{})
+}
@@ -0,0 +1,88 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Webhook response reasons
+const SubcloudAllowedReason string = "allowed to be admitted"
+
+// log is for logging in this package.
+var subcloudlog = logf.Log.WithName("subcloud-resource")
+
+func (r *Subcloud) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-starlingx-windriver-com-v1-subcloud,mutating=true,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=subclouds,verbs=create;update,versions=v1,name=msubcloud.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Subcloud{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Subcloud) Default() {
+	subcloudlog.Info("default", "name", r.Name)
+}
+
+// Validates an incoming resource update/create request.  The contents of the
+// bootstrap values are left to the system controller to validate.
+func (r *Subcloud) validateSubcloud() error {
+	spec := r.Spec
+
+	if net.ParseIP(spec.BootstrapAddress) == nil {
+		msg := fmt.Sprintf("bootstrap address %q is not a valid IP address", spec.BootstrapAddress)
+		return errors.New(msg)
+	}
+
+	values := make(map[string]interface{})
+	err := yaml.Unmarshal([]byte(spec.BootstrapValues), &values)
+	if err != nil {
+		msg := fmt.Sprintf("bootstrap values are not a valid YAML document: %s", err.Error())
+		return errors.New(msg)
+	}
+
+	if name, ok := values["name"]; ok && name != r.Name {
+		msg := fmt.Sprintf("bootstrap values name %q does not match the subcloud name %q", name, r.Name)
+		return errors.New(msg)
+	}
+
+	subcloudlog.Info(SubcloudAllowedReason)
+	return nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-subcloud,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=subclouds,versions=v1,name=vsubcloud.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Subcloud{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Subcloud) ValidateCreate() error {
+	subcloudlog.Info("validate create", "name", r.Name)
+
+	return r.validateSubcloud()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Subcloud) ValidateUpdate(old runtime.Object) error {
+	subcloudlog.Info("validate update", "name", r.Name)
+
+	return r.validateSubcloud()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Subcloud) ValidateDelete() error {
+	subcloudlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
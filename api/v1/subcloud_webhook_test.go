@@ -0,0 +1,58 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("subcloud_webhook functions", func() {
+
+	Describe("validateSubcloud function is tested", func() {
+		newSubcloud := func(address, values string) *Subcloud {
+			return &Subcloud{
+				ObjectMeta: metav1.ObjectMeta{Name: "subcloud1"},
+				Spec: SubcloudSpec{
+					BootstrapAddress:       address,
+					BootstrapValues:        values,
+					SysadminPasswordSecret: "subcloud1-sysadmin",
+				},
+			}
+		}
+
+		Context("When the subcloud attributes are valid", func() {
+			It("Sucessfully validates the subcloud", func() {
+				r := newSubcloud("10.10.10.12", "name: subcloud1\nsystem_mode: simplex\n")
+				err := r.validateSubcloud()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When the bootstrap address is not an IP address", func() {
+			It("Should throw the error the address is not valid", func() {
+				r := newSubcloud("subcloud1", "system_mode: simplex\n")
+				err := r.validateSubcloud()
+				msg := errors.New("bootstrap address \"subcloud1\" is not a valid IP address")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the bootstrap values are not valid YAML", func() {
+			It("Should throw an error", func() {
+				r := newSubcloud("fd01::12", "system_mode: [simplex\n")
+				err := r.validateSubcloud()
+				Expect(err).ToNot(BeNil())
+			})
+		})
+		Context("When the bootstrap values name does not match", func() {
+			It("Should throw the error the name does not match", func() {
+				r := newSubcloud("10.10.10.12", "name: subcloud2\n")
+				err := r.validateSubcloud()
+				msg := errors.New("bootstrap values name \"subcloud2\" does not match the subcloud name \"subcloud1\"")
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+})
@@ -0,0 +1,15 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v1
+
+// DeploymentScopeAnnotation defines the annotation through which the
+// deployment scope requested in the spec of a newer API version is conveyed
+// to the reconcilers.  It takes precedence over the deploymentScope status
+// attribute of the last applied configuration.
+const DeploymentScopeAnnotation = "deployment-manager/deployment-scope"
+
+// Hub marks the v1 System as the version to which every other version is
+// converted.  It remains the storage version so that the reconcilers are
+// unaffected by the introduction of newer versions.
+func (*System) Hub() {}
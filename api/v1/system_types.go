@@ -32,8 +32,10 @@ const (
 // system API is not uniform for all certificate types therefore some attention
 // is required when defining these resources.
 type CertificateInfo struct {
-	// Type represents the intended usage of the certificate
-	// +kubebuilder:validation:Enum=ssl_ca
+	// Type represents the intended usage of the certificate.  The "ssl"
+	// (REST API/GUI) and "docker_registry" certificates can only be
+	// installed from a cert-manager Certificate.
+	// +kubebuilder:validation:Enum=ssl_ca;ssl;docker_registry
 	Type string `json:"type"`
 
 	// Secret is the name of a TLS secret containing the public certificate and
@@ -48,7 +50,15 @@ type CertificateInfo struct {
 	// required.  The "ca.crt" attribute is only required for the "platform" or
 	// "tpm" certificate types, and only if the supplied public certificate is
 	// signed by a non-standard root CA.
-	Secret string `json:"secret"`
+	// +optional
+	Secret string `json:"secret,omitempty"`
+
+	// Certificate is the name of a cert-manager Certificate resource whose
+	// secret is installed once the certificate has been issued.  The
+	// certificate is re-installed whenever cert-manager renews it.  It is
+	// mutually exclusive with the Secret attribute.
+	// +optional
+	Certificate string `json:"certificate,omitempty"`
 
 	// Signature is the serial number of the certificate prepended with its
 	// type. This attribute is for internal use only, when making comparisons
@@ -65,7 +75,8 @@ func (in *CertificateInfo) DeepEqual(other *CertificateInfo) bool {
 		// If signature attribute is blank, the certificate is defined outside
 		// of deployment manager's scope. Instead, compare secret names
 		if in.Signature == "" {
-			return (in.Type == other.Type) && (in.Secret == other.Secret)
+			return (in.Type == other.Type) && (in.Secret == other.Secret) &&
+				(in.Certificate == other.Certificate)
 		}
 		return (in.Type == other.Type) && (in.Signature == other.Signature)
 	}
@@ -79,7 +90,8 @@ func (in CertificateInfo) IsKeyEqual(x CertificateInfo) bool {
 	// If signature attribute is blank, the certificate is defined outside
 	// of deployment manager's scope. Instead, compare secret names
 	if (in.Signature == "") || (x.Signature == "") {
-		return (in.Type == x.Type) && (in.Secret == x.Secret)
+		return (in.Type == x.Type) && (in.Secret == x.Secret) &&
+			(in.Certificate == x.Certificate)
 	}
 	return (in.Type == x.Type) && (in.Signature == x.Signature)
 }
@@ -92,6 +104,35 @@ func (in *CertificateInfo) PrivateKeyExpected() bool {
 	return in.Type != PlatformCACertificate && in.Type != OpenstackCACertificate
 }
 
+// CertificateStatus defines the state of a certificate that was installed
+// from a secret.
+type CertificateStatus struct {
+	// Type represents the intended usage of the certificate.
+	Type string `json:"type"`
+
+	// Secret defines the name of the secret from which the certificate was
+	// installed.
+	Secret string `json:"secret"`
+
+	// Certificate defines the name of the cert-manager Certificate which
+	// issued the secret, if any.
+	// +optional
+	Certificate string `json:"certificate,omitempty"`
+
+	// ResourceVersion defines the version of the secret that was last
+	// installed.  The certificate is re-installed whenever the secret
+	// changes.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Signature is the serial number of the certificate prepended with its
+	// type as reported by the system API.
+	Signature string `json:"signature"`
+
+	// NotAfter defines the time at which the certificate expires.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+}
+
 // CertificateList defines a type to represent a slice of certificate info
 // objects.
 // +deepequal-gen:unordered-array=true
@@ -100,8 +141,9 @@ type CertificateList []CertificateInfo
 // LicenseInfo defines the attributes which specify an individual License
 // resource.
 type LicenseInfo struct {
-	// Secret is the name of a TLS secret containing the license file contents.
-	// It must refer to a Opaque Kubernetes Secret.
+	// Secret is the name of a secret containing the license file contents.
+	// It must refer to a Opaque Kubernetes Secret.  The license is installed
+	// again whenever the contents of the secret are updated.
 	Secret string `json:"secret"`
 }
 
@@ -112,6 +154,191 @@ func (in *LicenseInfo) DeepEqual(other *LicenseInfo) bool {
 	return other != nil
 }
 
+// LicenseStatus defines the attributes of the license installed from a
+// secret.
+type LicenseStatus struct {
+	// Secret is the name of the secret from which the license was installed.
+	Secret string `json:"secret"`
+
+	// ResourceVersion is the resource version of the secret at the time the
+	// license was installed.  It is used to detect updates to the secret.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+type SNMPCommunity string
+
+// SNMPCommunityList defines a type to represent a slice of SNMP community
+// strings.
+// +deepequal-gen:unordered-array=true
+type SNMPCommunityList []SNMPCommunity
+
+// SNMPTrapDestinationInfo defines the attributes which specify an individual
+// SNMP trap destination.
+type SNMPTrapDestinationInfo struct {
+	// Address is the IPv4 or IPv6 address to which traps are sent.
+	Address string `json:"address"`
+
+	// Community is the community string used to send traps to the
+	// destination.
+	// +kubebuilder:validation:MaxLength=255
+	Community string `json:"community"`
+}
+
+// SNMPTrapDestinationList defines a type to represent a slice of SNMP trap
+// destinations.
+// +deepequal-gen:unordered-array=true
+type SNMPTrapDestinationList []SNMPTrapDestinationInfo
+
+// SNMPInfo defines the system level SNMP attributes that are configurable.
+// Communities and trap destinations that are not listed are removed from the
+// system.
+type SNMPInfo struct {
+	// Communities is a list of community strings that are granted read-only
+	// access to the SNMP agent.
+	// +optional
+	Communities *SNMPCommunityList `json:"communities,omitempty"`
+
+	// TrapDestinations is a list of destinations to which SNMP traps are
+	// sent.
+	// +optional
+	TrapDestinations *SNMPTrapDestinationList `json:"trapDestinations,omitempty"`
+}
+
+// LDAPDomainInfo defines the attributes of a remote LDAP or Windows Active
+// Directory domain used to authenticate users logging in to the platform.
+type LDAPDomainInfo struct {
+	// DomainName is the name of the domain.
+	// +kubebuilder:validation:MaxLength=255
+	DomainName string `json:"domainName"`
+
+	// URI is the address of the LDAP server of the domain.
+	// +kubebuilder:validation:Pattern=`^ldaps?://.+$`
+	// +kubebuilder:validation:MaxLength=255
+	URI string `json:"uri"`
+
+	// SearchBase is the default base DN used to search the domain.
+	// +kubebuilder:validation:MaxLength=255
+	SearchBase string `json:"searchBase"`
+
+	// UserSearchBase is the base DN used to search for users.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	UserSearchBase *string `json:"userSearchBase,omitempty"`
+
+	// GroupSearchBase is the base DN used to search for groups.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	GroupSearchBase *string `json:"groupSearchBase,omitempty"`
+
+	// AccessFilter is the filter which users must match to be granted
+	// access.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	AccessFilter *string `json:"accessFilter,omitempty"`
+
+	// Secret is the name of a basic authentication secret containing the DN
+	// and password used to bind to the domain.  The DN is stored under the
+	// username key and the password under the password key.
+	// +optional
+	Secret *string `json:"secret,omitempty"`
+
+	// Parameters defines additional service parameters to be configured for
+	// the domain.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// RemoteAuthenticationInfo defines the remote domains used to authenticate
+// users logging in to the platform.
+type RemoteAuthenticationInfo struct {
+	// Domains is the list of LDAP or Windows Active Directory domains.  The
+	// domains are configured in the order listed.  Domains that are not
+	// listed are removed from the system.
+	// +kubebuilder:validation:MaxItems=3
+	Domains []LDAPDomainInfo `json:"domains"`
+}
+
+// RegistryInfo defines the attributes of a container image registry used by
+// the platform in place of a public registry.
+type RegistryInfo struct {
+	// Name identifies the public registry being replaced.
+	// +kubebuilder:validation:Enum=docker;k8s;gcr;quay;elastic;ghcr;registryk8s;icr
+	Name string `json:"name"`
+
+	// URL is the address of the registry, optionally followed by a path.
+	// +kubebuilder:validation:MaxLength=255
+	URL string `json:"url"`
+
+	// Type defines the type of registry.
+	// +kubebuilder:validation:Enum=docker;aws-ecr
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Secret is the name of a basic authentication secret containing the
+	// credentials used to access the registry.  The registry credentials are
+	// updated whenever the contents of the secret are updated.
+	// +optional
+	Secret *string `json:"secret,omitempty"`
+
+	// Insecure defines whether the registry is accessed without validating
+	// its certificate.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
+
+// RegistryList defines a type to represent a slice of registry info objects.
+// +deepequal-gen:unordered-array=true
+type RegistryList []RegistryInfo
+
+// RegistryStatus defines the credentials that have been configured for a
+// registry.
+type RegistryStatus struct {
+	// Name identifies the registry.
+	Name string `json:"name"`
+
+	// Secret is the name of the secret from which the credentials were
+	// configured.
+	Secret string `json:"secret"`
+
+	// ResourceVersion is the resource version of the secret at the time the
+	// credentials were configured.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// AuthSecretID is the identifier of the key manager secret holding the
+	// credentials.
+	AuthSecretID string `json:"authSecretID"`
+}
+
+// AlarmStatus defines an active platform alarm as reported by the fault
+// management API.
+type AlarmStatus struct {
+	// ID is the unique identifier of the alarm instance.
+	ID string `json:"id"`
+
+	// AlarmID identifies the type of the alarm (e.g., 100.114).
+	AlarmID string `json:"alarmID"`
+
+	// Severity is the severity of the alarm.
+	Severity string `json:"severity"`
+
+	// EntityInstanceID identifies the entity which raised the alarm (e.g.,
+	// host=controller-0.ntp).
+	EntityInstanceID string `json:"entityInstanceID"`
+
+	// Reason is the human readable description of the alarm.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// ManagementAffecting indicates whether the alarm prevents management
+	// actions from being safely executed.
+	// +optional
+	ManagementAffecting bool `json:"managementAffecting,omitempty"`
+
+	// Timestamp is the time at which the alarm was raised.
+	// +optional
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
 // ServiceParameterInfo defines the attributes required to define an instance of a
 // service parameter to be installed via the system API.
 type ServiceParameterInfo struct {
@@ -149,6 +376,37 @@ type ServiceParameterInfo struct {
 // +deepequal-gen:unordered-array=true
 type ServiceParameterList []ServiceParameterInfo
 
+// CephPoolQuotaInfo defines the quotas of the Ceph pools used by each
+// service - in gigabytes.
+// +deepequal-gen:ignore-nil-fields=true
+type CephPoolQuotaInfo struct {
+	// Cinder is the quota of the pool used by the block storage service.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Cinder *int `json:"cinder,omitempty"`
+
+	// Glance is the quota of the pool used by the image service.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Glance *int `json:"glance,omitempty"`
+
+	// Ephemeral is the quota of the pool used for ephemeral instance storage.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Ephemeral *int `json:"ephemeral,omitempty"`
+
+	// Object is the quota of the pool used by the object storage service.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Object *int `json:"object,omitempty"`
+
+	// Kubernetes is the quota of the pool used for Kubernetes persistent
+	// volumes.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Kubernetes *int `json:"kubernetes,omitempty"`
+}
+
 // +deepequal-gen:ignore-nil-fields=true
 type StorageBackend struct {
 	// SystemName uniquely identifies the storage backend instance.
@@ -175,6 +433,19 @@ type StorageBackend struct {
 	// +optional
 	ReplicationFactor *int `json:"replicationFactor,omitempty"`
 
+	// MinReplicationFactor is the minimum number of replicas required for
+	// the Ceph pools to accept I/O operations.
+	// This attribute is only applicable for Ceph storage backends.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3
+	// +optional
+	MinReplicationFactor *int `json:"minReplicationFactor,omitempty"`
+
+	// PoolQuotas defines the quotas of the Ceph pools used by each service.
+	// This attribute is only applicable for Ceph storage backends.
+	// +optional
+	PoolQuotas *CephPoolQuotaInfo `json:"poolQuotas,omitempty"`
+
 	// PartitionSize is the controller disk partition size to be allocated for
 	// the Ceph monitor - in gigabytes.
 	// This attribute is only applicable for Ceph storage backends.
@@ -260,7 +531,8 @@ type PTPInfo struct {
 type DNSServer string
 
 // DNSServerList defines a type to represent a slice of DNSServer objects.
-// +deepequal-gen:unordered-array=true
+// The order of the list is significant since servers are queried in the
+// order in which they are declared.
 type DNSServerList []DNSServer
 
 // DNSServerListToStrings is to convert from list type to string array
@@ -317,9 +589,124 @@ func StringsToNTPServerList(items []string) NTPServerList {
 	return a
 }
 
+// Defines the phases of the controller bootstrap sequence in the order in
+// which they are reached.  Each phase is reported as a condition of the System
+// resource.
+const (
+	// BootstrapPhaseController0Configured indicates that the system level
+	// configuration has been applied to controller-0.
+	BootstrapPhaseController0Configured = "Controller0Configured"
+
+	// BootstrapPhaseController0Unlocked indicates that controller-0 has been
+	// unlocked and is enabled.
+	BootstrapPhaseController0Unlocked = "Controller0Unlocked"
+
+	// BootstrapPhaseController1Installed indicates that controller-1 has been
+	// installed and its inventory has been collected.
+	BootstrapPhaseController1Installed = "Controller1Installed"
+
+	// BootstrapPhaseController1Unlocked indicates that controller-1 has been
+	// unlocked and is enabled.
+	BootstrapPhaseController1Unlocked = "Controller1Unlocked"
+
+	// BootstrapPhaseStorageUnlocked indicates that every storage host has
+	// been unlocked and is enabled.
+	BootstrapPhaseStorageUnlocked = "StorageUnlocked"
+
+	// BootstrapPhaseWorkersUnlocked indicates that every worker host has been
+	// unlocked and is enabled.
+	BootstrapPhaseWorkersUnlocked = "WorkersUnlocked"
+)
+
+// BootstrapPhaseCompleted is reported as the bootstrap phase once every phase
+// has been reached.
+const BootstrapPhaseCompleted = "Completed"
+
+// BootstrapPhases lists the phases of the controller bootstrap sequence in the
+// order in which they are reached.
+var BootstrapPhases = []string{
+	BootstrapPhaseController0Configured,
+	BootstrapPhaseController0Unlocked,
+	BootstrapPhaseController1Installed,
+	BootstrapPhaseController1Unlocked,
+	BootstrapPhaseStorageUnlocked,
+	BootstrapPhaseWorkersUnlocked,
+}
+
+// BootstrapHealthCheckInfo defines a health check which must pass before the
+// hosts of a bootstrap phase are unlocked.  The check fails while the system
+// reports an active alarm of the configured severity or higher.
+type BootstrapHealthCheckInfo struct {
+	// Phase defines the bootstrap phase which is withheld until the health
+	// check passes.
+	// +kubebuilder:validation:Enum=Controller1Unlocked;StorageUnlocked;WorkersUnlocked
+	Phase string `json:"phase"`
+
+	// AlarmSeverity defines the lowest severity of the active alarms which
+	// cause the health check to fail.  Only critical alarms are considered
+	// when not specified.
+	// +kubebuilder:validation:Enum=critical;major;minor;warning
+	// +optional
+	AlarmSeverity *string `json:"alarmSeverity,omitempty"`
+
+	// IgnoredAlarms lists the identifiers of the alarms (e.g., 100.114) which
+	// never cause the health check to fail.
+	// +optional
+	IgnoredAlarms []string `json:"ignoredAlarms,omitempty"`
+}
+
+// BootstrapHealthCheckList defines a type to represent a slice of bootstrap
+// health checks.
+// +deepequal-gen:unordered-array=true
+type BootstrapHealthCheckList []BootstrapHealthCheckInfo
+
+// BootstrapInfo defines the attributes which control the controller bootstrap
+// sequence.
+type BootstrapInfo struct {
+	// HealthChecks defines the health checks which gate the later phases of
+	// the bootstrap sequence.
+	// +optional
+	HealthChecks BootstrapHealthCheckList `json:"healthChecks,omitempty"`
+}
+
+// SoftwarePatchInfo defines the software patches which are applied to the
+// system before the initial unlock of controller-0.
+type SoftwarePatchInfo struct {
+	// IDs defines the patches which must be applied.  If no patches are
+	// listed then every patch uploaded from the repository is applied.
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	IDs []string `json:"ids,omitempty"`
+
+	// Repository defines the directory of controller-0 from which the patches
+	// are uploaded.  If omitted then the patches must have been uploaded to
+	// the system beforehand.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Repository *string `json:"repository,omitempty"`
+}
+
+// SoftwareInfo defines the software level to which the system is brought as
+// part of its initial deployment.
+type SoftwareInfo struct {
+	// Patches defines the software patches which are installed on controller-0
+	// before it is unlocked.
+	// +optional
+	Patches *SoftwarePatchInfo `json:"patches,omitempty"`
+}
+
 // SystemSpec defines the desired state of System
 // +deepequal-gen:ignore-nil-fields=true
 type SystemSpec struct {
+	// EndpointSecret is the name of the secret which holds the credentials,
+	// authentication URL and region of the system API endpoint.  It allows
+	// each namespace to target a different system or region.  The
+	// "system-endpoint" secret is used when not specified.
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	EndpointSecret *string `json:"endpointSecret,omitempty"`
+
 	// Description is a free form string describing the intended purpose of the
 	// system.
 	// +optional
@@ -353,9 +740,17 @@ type SystemSpec struct {
 	// +optional
 	Contact *string `json:"contact,omitempty"`
 
+	// Timezone is the name of the timezone configured on the system (e.g.,
+	// "UTC", "America/Toronto").
+	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9\-_+/]+$
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Timezone *string `json:"timezone,omitempty"`
+
 	// Nameservers is an array of Domain SystemName servers.  Each server can be
 	// specified as either an IPv4 or IPv6
-	// address.
+	// address.  Servers are configured in the order listed and any server
+	// not listed is removed from the system.
 	// +optional
 	DNSServers *DNSServerList `json:"dnsServers,omitempty"`
 
@@ -366,6 +761,8 @@ type SystemSpec struct {
 	NTPServers *NTPServerList `json:"ntpServers,omitempty"`
 
 	// PTP defines the Precision Time Protocol configuration for the system.
+	// Deprecated: PTP is configured through the PtpInstance and PtpInterface
+	// resources.  This attribute is not available in the v2 API.
 	PTP *PTPInfo `json:"ptp,omitempty"`
 
 	// Certificates is a list of references to certificates that must be
@@ -377,6 +774,21 @@ type SystemSpec struct {
 	// +optional
 	License *LicenseInfo `json:"license,omitempty"`
 
+	// SNMP defines the SNMP communities and trap destinations to be
+	// configured on the system.
+	// +optional
+	SNMP *SNMPInfo `json:"snmp,omitempty"`
+
+	// RemoteAuthentication defines the remote domains used to authenticate
+	// users logging in to the platform.
+	// +optional
+	RemoteAuthentication *RemoteAuthenticationInfo `json:"remoteAuthentication,omitempty"`
+
+	// Registries is a list of container image registries used in place of
+	// the public registries.
+	// +optional
+	Registries *RegistryList `json:"registries,omitempty"`
+
 	// ServiceParameters is a list of service parameters
 	// +optional
 	ServiceParameters *ServiceParameterList `json:"serviceParameters,omitempty"`
@@ -391,6 +803,24 @@ type SystemSpec struct {
 	// vswitch implementation.
 	// +optional
 	VSwitchType *string `json:"vswitchType,omitempty"`
+
+	// Bootstrap defines the health checks which gate the phases of the
+	// controller bootstrap sequence.  It does not describe a system
+	// attribute therefore it never causes the system to appear out of sync.
+	// +optional
+	Bootstrap *BootstrapInfo `json:"bootstrap,omitempty"`
+
+	// Software defines the software patches which are installed before the
+	// initial unlock of controller-0.  It does not describe a system attribute
+	// therefore it never causes the system to appear out of sync.
+	// +optional
+	Software *SoftwareInfo `json:"software,omitempty"`
+
+	// Preflight defines the health check run before a disruptive action is
+	// issued to any host of the system.  It does not describe a system
+	// attribute therefore it never causes the system to appear out of sync.
+	// +optional
+	Preflight *PreflightInfo `json:"preflight,omitempty"`
 }
 
 // IsKeyEqual compares two controller file system array elements and determines
@@ -472,6 +902,11 @@ type SystemStatus struct {
 	// +optional
 	Delta string `json:"delta"`
 
+	// DeltaAttributes lists each attribute which differs between the final
+	// profile and the current configuration in a machine readable form.
+	// +optional
+	DeltaAttributes []DeltaAttribute `json:"deltaAttributes,omitempty"`
+
 	// Strategy monitor status information for Day 2 operation
 	// +optional
 	// +kubebuilder:default:=false
@@ -480,6 +915,38 @@ type SystemStatus struct {
 	// Strategy monitor retry count for Day 2 operation
 	// +optional
 	StrategyRetryCount int `json:"strategyRetryCount"`
+
+	// Certificates defines the certificates that have been installed from
+	// secrets along with their expiry dates.
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
+
+	// License defines the license that has been installed from a secret.
+	// +optional
+	License *LicenseStatus `json:"license,omitempty"`
+
+	// Registries defines the registry credentials that have been configured
+	// from secrets.
+	// +optional
+	Registries []RegistryStatus `json:"registries,omitempty"`
+
+	// BootstrapPhase defines the phase of the controller bootstrap sequence
+	// which is currently in progress or "Completed" once every phase has been
+	// reached.
+	// +optional
+	BootstrapPhase string `json:"bootstrapPhase,omitempty"`
+
+	// Alarms defines the active platform alarms, most severe first.  It is
+	// only reported when alarm synchronization is enabled on the manager.
+	// +optional
+	Alarms []AlarmStatus `json:"alarms,omitempty"`
+
+	// Conditions defines the set of conditions that describe the current
+	// state of the system.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -494,6 +961,7 @@ type SystemStatus struct {
 //	https://docs.starlingx.io/api-ref/stx-config/api-ref-sysinv-v1-config.html#storage-backends
 //
 // +deepequal-gen=false
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="mode",type="string",JSONPath=".status.systemMode",description="The configured system mode."
 // +kubebuilder:printcolumn:name="type",type="string",JSONPath=".status.systemType",description="The configured system type."
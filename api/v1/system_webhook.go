@@ -7,8 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apitypes "k8s.io/apimachinery/pkg/types"
@@ -99,6 +102,19 @@ func validateBackendAttributes(backend StorageBackend) error {
 		}
 	}
 
+	if backend.MinReplicationFactor != nil || backend.PoolQuotas != nil {
+		if backend.Type != ceph {
+			msg := fmt.Sprintf("minReplicationFactor and poolQuotas only permitted with %s backend", ceph)
+			return errors.New(msg)
+		}
+	}
+
+	if backend.MinReplicationFactor != nil && backend.ReplicationFactor != nil {
+		if *backend.MinReplicationFactor > *backend.ReplicationFactor {
+			return errors.New("minReplicationFactor must not exceed replicationFactor")
+		}
+	}
+
 	return nil
 }
 
@@ -142,6 +158,18 @@ func validateStorage(obj *System) error {
 func validateCertificates(obj *System) error {
 	if obj.Spec.Certificates != nil {
 		for _, c := range *obj.Spec.Certificates {
+			if c.Certificate != "" {
+				if c.Secret != "" {
+					msg := fmt.Sprintf("%s certificate cannot refer to both secret %s and certificate %s",
+						c.Type, c.Secret, c.Certificate)
+					return errors.New(msg)
+				}
+
+				// The secret is only created once cert-manager has issued the
+				// certificate.
+				continue
+			}
+
 			// Ignore certificates installed during bootstrap/initial unlock
 			// - Openstack_CA/OpenLDAP/Docker/SSL(HTTPS)
 			if c.Type == OpenstackCACertificate || c.Type == OpenLDAPCertificate ||
@@ -174,17 +202,236 @@ func validateCertificates(obj *System) error {
 	return nil
 }
 
+func validateDNSServers(obj *System) error {
+	if obj.Spec.DNSServers == nil {
+		return nil
+	}
+
+	found := make(map[string]bool)
+	for _, s := range *obj.Spec.DNSServers {
+		server := string(s)
+		if net.ParseIP(server) == nil {
+			msg := fmt.Sprintf("DNS server %q must be a valid IPv4 or IPv6 address", server)
+			return errors.New(msg)
+		}
+
+		if found[server] {
+			msg := fmt.Sprintf("DNS server %q may only be specified once", server)
+			return errors.New(msg)
+		}
+
+		found[server] = true
+	}
+
+	return nil
+}
+
+func validateSNMP(obj *System) error {
+	if obj.Spec.SNMP == nil {
+		return nil
+	}
+
+	communities := make(map[SNMPCommunity]bool)
+	if obj.Spec.SNMP.Communities != nil {
+		for _, c := range *obj.Spec.SNMP.Communities {
+			if c == "" {
+				return errors.New("SNMP community strings must not be empty")
+			}
+
+			if communities[c] {
+				msg := fmt.Sprintf("SNMP community %q may only be specified once", c)
+				return errors.New(msg)
+			}
+
+			communities[c] = true
+		}
+	}
+
+	if obj.Spec.SNMP.TrapDestinations != nil {
+		found := make(map[string]bool)
+		for _, t := range *obj.Spec.SNMP.TrapDestinations {
+			if net.ParseIP(t.Address) == nil {
+				msg := fmt.Sprintf("SNMP trap destination %q must be a valid IPv4 or IPv6 address", t.Address)
+				return errors.New(msg)
+			}
+
+			if found[t.Address] {
+				msg := fmt.Sprintf("SNMP trap destination %q may only be specified once", t.Address)
+				return errors.New(msg)
+			}
+
+			if obj.Spec.SNMP.Communities != nil && !communities[SNMPCommunity(t.Community)] {
+				msg := fmt.Sprintf("SNMP trap destination %q refers to unknown community %q", t.Address, t.Community)
+				return errors.New(msg)
+			}
+
+			found[t.Address] = true
+		}
+	}
+
+	return nil
+}
+
+func validateRemoteAuthentication(obj *System) error {
+	if obj.Spec.RemoteAuthentication == nil {
+		return nil
+	}
+
+	if obj.Spec.ServiceParameters != nil {
+		for _, sp := range *obj.Spec.ServiceParameters {
+			if sp.Service == common.ServiceTypeIdentity &&
+				strings.HasPrefix(sp.Section, common.ServiceParamSectionIdentityLDAPDomainPrefix) {
+				msg := fmt.Sprintf("service parameter section %q is managed by remoteAuthentication", sp.Section)
+				return errors.New(msg)
+			}
+		}
+	}
+
+	reserved := []string{
+		common.ServiceParamNameLDAPDomainName,
+		common.ServiceParamNameLDAPURI,
+		common.ServiceParamNameLDAPSearchBase,
+		common.ServiceParamNameLDAPUserSearchBase,
+		common.ServiceParamNameLDAPGroupSearchBase,
+		common.ServiceParamNameLDAPAccessFilter,
+		common.ServiceParamNameLDAPBindDN,
+		common.ServiceParamNameLDAPBindPassword,
+	}
+
+	found := make(map[string]bool)
+	for _, d := range obj.Spec.RemoteAuthentication.Domains {
+		if found[d.DomainName] {
+			msg := fmt.Sprintf("remote authentication domain %q may only be specified once", d.DomainName)
+			return errors.New(msg)
+		}
+
+		found[d.DomainName] = true
+
+		for _, name := range reserved {
+			if _, ok := d.Parameters[name]; ok {
+				msg := fmt.Sprintf("parameter %q of remote authentication domain %q must be set using its dedicated attribute", name, d.DomainName)
+				return errors.New(msg)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateRegistries(obj *System) error {
+	if obj.Spec.Registries == nil {
+		return nil
+	}
+
+	found := make(map[string]bool)
+	for _, reg := range *obj.Spec.Registries {
+		if found[reg.Name] {
+			msg := fmt.Sprintf("registry %q may only be specified once", reg.Name)
+			return errors.New(msg)
+		}
+
+		found[reg.Name] = true
+	}
+
+	if obj.Spec.ServiceParameters != nil {
+		for _, sp := range *obj.Spec.ServiceParameters {
+			if sp.Service != common.ServiceTypeDocker {
+				continue
+			}
+
+			name := strings.TrimSuffix(sp.Section, common.ServiceParamSectionDockerRegistrySuffix)
+			if name != sp.Section && found[name] {
+				msg := fmt.Sprintf("service parameter section %q is managed by registries", sp.Section)
+				return errors.New(msg)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateBootstrap(obj *System) error {
+	if obj.Spec.Bootstrap == nil {
+		return nil
+	}
+
+	found := make(map[string]bool)
+	for _, check := range obj.Spec.Bootstrap.HealthChecks {
+		if found[check.Phase] {
+			msg := fmt.Sprintf("bootstrap phase %q may only have one health check", check.Phase)
+			return errors.New(msg)
+		}
+
+		found[check.Phase] = true
+	}
+
+	return nil
+}
+
+func validateSoftware(obj *System) error {
+	if obj.Spec.Software == nil || obj.Spec.Software.Patches == nil {
+		return nil
+	}
+
+	patches := obj.Spec.Software.Patches
+	if len(patches.IDs) == 0 && patches.Repository == nil {
+		return errors.New("software patches must list patch IDs or a repository")
+	}
+
+	found := make(map[string]bool)
+	for _, id := range patches.IDs {
+		if found[id] {
+			msg := fmt.Sprintf("software patch %q is listed more than once", id)
+			return errors.New(msg)
+		}
+
+		found[id] = true
+	}
+
+	return nil
+}
+
 func (r *System) validatingSystem() error {
 	err := validateStorage(r)
 	if err != nil {
 		return err
 	}
 
+	err = validateDNSServers(r)
+	if err != nil {
+		return err
+	}
+
+	err = validateSNMP(r)
+	if err != nil {
+		return err
+	}
+
+	err = validateRemoteAuthentication(r)
+	if err != nil {
+		return err
+	}
+
+	err = validateRegistries(r)
+	if err != nil {
+		return err
+	}
+
 	err = validateCertificates(r)
 	if err != nil {
 		return err
 	}
 
+	err = validateBootstrap(r)
+	if err != nil {
+		return err
+	}
+
+	err = validateSoftware(r)
+	if err != nil {
+		return err
+	}
+
 	systemlog.Info(SystemAllowedReason)
 	return nil
 }
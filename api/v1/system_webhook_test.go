@@ -63,7 +63,62 @@ var _ = Describe("system_webhook functions", func() {
 				Expect(err).To(Equal(msg))
 			})
 		})
+		Context("When minReplicationFactor or poolQuotas are present when the Type is lvm", func() {
+			It("Should return the error minReplicationFactor and poolQuotas only permitted with ceph backend", func() {
+				quota := 100
+				backend := StorageBackend{
+					PoolQuotas: &CephPoolQuotaInfo{Cinder: &quota},
+					Type:       lvm,
+				}
+
+				err := validateBackendAttributes(backend)
+				msg := errors.New("minReplicationFactor and poolQuotas only permitted with ceph backend")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When minReplicationFactor exceeds replicationFactor", func() {
+			It("Should return an error", func() {
+				repFac := 2
+				minRepFac := 3
+				backend := StorageBackend{
+					ReplicationFactor:    &repFac,
+					MinReplicationFactor: &minRepFac,
+					Type:                 ceph,
+				}
+
+				err := validateBackendAttributes(backend)
+				Expect(err).To(Equal(errors.New("minReplicationFactor must not exceed replicationFactor")))
+
+				minRepFac = 1
+				Expect(validateBackendAttributes(backend)).To(BeNil())
+			})
+		})
 	})
+	Describe("validateCertificates function is tested", func() {
+		Context("When a certificate is issued by cert-manager", func() {
+			It("Sucessfully validates the certificates", func() {
+				certs := CertificateList{
+					{Type: PlatformCertificate, Certificate: "system-restapi-gui-certificate"},
+					{Type: DockerCertificate, Certificate: "system-registry-local-certificate"},
+				}
+				obj := &System{Spec: SystemSpec{Certificates: &certs}}
+				err := validateCertificates(obj)
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("When a certificate refers to both a secret and a cert-manager certificate", func() {
+			It("Should throw the error both cannot be referenced", func() {
+				certs := CertificateList{
+					{Type: PlatformCertificate, Secret: "restapi", Certificate: "system-restapi-gui-certificate"},
+				}
+				obj := &System{Spec: SystemSpec{Certificates: &certs}}
+				err := validateCertificates(obj)
+				msg := errors.New("ssl certificate cannot refer to both secret restapi and certificate system-restapi-gui-certificate")
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+
 	Describe("validateStorageBackends function is tested", func() {
 		Context("When backend type is unique", func() {
 			It("Returns nil", func() {
@@ -144,4 +199,158 @@ var _ = Describe("system_webhook functions", func() {
 			})
 		})
 	})
+	Describe("validateDNSServers function is tested", func() {
+		Context("When the DNS servers are valid and unique", func() {
+			It("Validates without any error", func() {
+				servers := DNSServerList{"8.8.8.8", "2001:4860:4860::8888"}
+				obj := &System{Spec: SystemSpec{DNSServers: &servers}}
+				Expect(validateDNSServers(obj)).To(BeNil())
+			})
+		})
+		Context("When a DNS server is not an IP address", func() {
+			It("Returns an invalid address error", func() {
+				servers := DNSServerList{"dns.example.com"}
+				obj := &System{Spec: SystemSpec{DNSServers: &servers}}
+				msg := errors.New("DNS server \"dns.example.com\" must be a valid IPv4 or IPv6 address")
+				Expect(validateDNSServers(obj)).To(Equal(msg))
+			})
+		})
+		Context("When a DNS server is specified twice", func() {
+			It("Returns a duplicate server error", func() {
+				servers := DNSServerList{"8.8.8.8", "8.8.4.4", "8.8.8.8"}
+				obj := &System{Spec: SystemSpec{DNSServers: &servers}}
+				msg := errors.New("DNS server \"8.8.8.8\" may only be specified once")
+				Expect(validateDNSServers(obj)).To(Equal(msg))
+			})
+		})
+	})
+	Describe("validateRemoteAuthentication function is tested", func() {
+		Context("When the domains are valid", func() {
+			It("Validates without any error", func() {
+				auth := RemoteAuthenticationInfo{Domains: []LDAPDomainInfo{
+					{DomainName: "example.com", URI: "ldaps://ad.example.com", SearchBase: "dc=example,dc=com",
+						Parameters: map[string]string{"ldap_id_mapping": "true"}},
+				}}
+				obj := &System{Spec: SystemSpec{RemoteAuthentication: &auth}}
+				Expect(validateRemoteAuthentication(obj)).To(BeNil())
+			})
+		})
+		Context("When a domain section is also set as a service parameter", func() {
+			It("Returns a managed section error", func() {
+				auth := RemoteAuthenticationInfo{Domains: []LDAPDomainInfo{
+					{DomainName: "example.com", URI: "ldaps://ad.example.com", SearchBase: "dc=example,dc=com"},
+				}}
+				params := ServiceParameterList{{Service: "identity", Section: "ldap_domain1", ParamName: "ldap_uri", ParamValue: "ldap://other"}}
+				obj := &System{Spec: SystemSpec{RemoteAuthentication: &auth, ServiceParameters: &params}}
+				msg := errors.New("service parameter section \"ldap_domain1\" is managed by remoteAuthentication")
+				Expect(validateRemoteAuthentication(obj)).To(Equal(msg))
+			})
+		})
+		Context("When an additional parameter overrides a dedicated attribute", func() {
+			It("Returns a reserved parameter error", func() {
+				auth := RemoteAuthenticationInfo{Domains: []LDAPDomainInfo{
+					{DomainName: "example.com", URI: "ldaps://ad.example.com", SearchBase: "dc=example,dc=com",
+						Parameters: map[string]string{"ldap_uri": "ldap://other"}},
+				}}
+				obj := &System{Spec: SystemSpec{RemoteAuthentication: &auth}}
+				msg := errors.New("parameter \"ldap_uri\" of remote authentication domain \"example.com\" must be set using its dedicated attribute")
+				Expect(validateRemoteAuthentication(obj)).To(Equal(msg))
+			})
+		})
+	})
+	Describe("validateRegistries function is tested", func() {
+		Context("When the registries are unique", func() {
+			It("Validates without any error", func() {
+				registries := RegistryList{{Name: "docker", URL: "registry.local:9001/docker.io"}, {Name: "k8s", URL: "registry.local:9001/k8s.gcr.io"}}
+				params := ServiceParameterList{{Service: "docker", Section: "quay-registry", ParamName: "url", ParamValue: "registry.local:9001/quay.io"}}
+				obj := &System{Spec: SystemSpec{Registries: &registries, ServiceParameters: &params}}
+				Expect(validateRegistries(obj)).To(BeNil())
+			})
+		})
+		Context("When a registry is also set as a service parameter", func() {
+			It("Returns a managed section error", func() {
+				registries := RegistryList{{Name: "docker", URL: "registry.local:9001/docker.io"}}
+				params := ServiceParameterList{{Service: "docker", Section: "docker-registry", ParamName: "url", ParamValue: "other"}}
+				obj := &System{Spec: SystemSpec{Registries: &registries, ServiceParameters: &params}}
+				msg := errors.New("service parameter section \"docker-registry\" is managed by registries")
+				Expect(validateRegistries(obj)).To(Equal(msg))
+			})
+		})
+	})
+	Describe("validateBootstrap function is tested", func() {
+		Context("When each phase has a single health check", func() {
+			It("Validates without any error", func() {
+				severity := "major"
+				checks := BootstrapHealthCheckList{
+					{Phase: BootstrapPhaseController1Unlocked, AlarmSeverity: &severity},
+					{Phase: BootstrapPhaseWorkersUnlocked, IgnoredAlarms: []string{"100.114"}},
+				}
+				obj := &System{Spec: SystemSpec{Bootstrap: &BootstrapInfo{HealthChecks: checks}}}
+				Expect(validateBootstrap(obj)).To(BeNil())
+			})
+		})
+		Context("When a phase has more than one health check", func() {
+			It("Returns a duplicate phase error", func() {
+				checks := BootstrapHealthCheckList{
+					{Phase: BootstrapPhaseStorageUnlocked},
+					{Phase: BootstrapPhaseStorageUnlocked, IgnoredAlarms: []string{"100.114"}},
+				}
+				obj := &System{Spec: SystemSpec{Bootstrap: &BootstrapInfo{HealthChecks: checks}}}
+				msg := errors.New("bootstrap phase \"StorageUnlocked\" may only have one health check")
+				Expect(validateBootstrap(obj)).To(Equal(msg))
+			})
+		})
+	})
+	Describe("validateSoftware function is tested", func() {
+		Context("When patch IDs or a repository are listed", func() {
+			It("Validates without any error", func() {
+				repository := "/home/sysadmin/patches"
+				obj := &System{Spec: SystemSpec{Software: &SoftwareInfo{Patches: &SoftwarePatchInfo{Repository: &repository}}}}
+				Expect(validateSoftware(obj)).To(BeNil())
+				obj.Spec.Software.Patches.IDs = []string{"PATCH_0001", "PATCH_0002"}
+				Expect(validateSoftware(obj)).To(BeNil())
+			})
+		})
+		Context("When neither patch IDs nor a repository are listed", func() {
+			It("Returns a missing patches error", func() {
+				obj := &System{Spec: SystemSpec{Software: &SoftwareInfo{Patches: &SoftwarePatchInfo{}}}}
+				msg := errors.New("software patches must list patch IDs or a repository")
+				Expect(validateSoftware(obj)).To(Equal(msg))
+			})
+		})
+		Context("When a patch is listed more than once", func() {
+			It("Returns a duplicate patch error", func() {
+				obj := &System{Spec: SystemSpec{Software: &SoftwareInfo{Patches: &SoftwarePatchInfo{IDs: []string{"PATCH_0001", "PATCH_0001"}}}}}
+				msg := errors.New("software patch \"PATCH_0001\" is listed more than once")
+				Expect(validateSoftware(obj)).To(Equal(msg))
+			})
+		})
+	})
+	Describe("validateSNMP function is tested", func() {
+		Context("When the trap destinations refer to configured communities", func() {
+			It("Validates without any error", func() {
+				communities := SNMPCommunityList{"public", "private"}
+				destinations := SNMPTrapDestinationList{{Address: "10.10.10.1", Community: "public"}}
+				obj := &System{Spec: SystemSpec{SNMP: &SNMPInfo{Communities: &communities, TrapDestinations: &destinations}}}
+				Expect(validateSNMP(obj)).To(BeNil())
+			})
+		})
+		Context("When a trap destination is not an IP address", func() {
+			It("Returns an invalid address error", func() {
+				destinations := SNMPTrapDestinationList{{Address: "traps.example.com", Community: "public"}}
+				obj := &System{Spec: SystemSpec{SNMP: &SNMPInfo{TrapDestinations: &destinations}}}
+				msg := errors.New("SNMP trap destination \"traps.example.com\" must be a valid IPv4 or IPv6 address")
+				Expect(validateSNMP(obj)).To(Equal(msg))
+			})
+		})
+		Context("When a trap destination refers to an unknown community", func() {
+			It("Returns an unknown community error", func() {
+				communities := SNMPCommunityList{"public"}
+				destinations := SNMPTrapDestinationList{{Address: "10.10.10.1", Community: "private"}}
+				obj := &System{Spec: SystemSpec{SNMP: &SNMPInfo{Communities: &communities, TrapDestinations: &destinations}}}
+				msg := errors.New("SNMP trap destination \"10.10.10.1\" refers to unknown community \"private\"")
+				Expect(validateSNMP(obj)).To(Equal(msg))
+			})
+		})
+	})
 })
@@ -8,6 +8,7 @@
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -45,6 +46,21 @@ func (in AddressList) DeepCopy() AddressList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmStatus) DeepCopyInto(out *AlarmStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmStatus.
+func (in *AlarmStatus) DeepCopy() *AlarmStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AllocationInfo) DeepCopyInto(out *AllocationInfo) {
 	*out = *in
@@ -85,6 +101,114 @@ func (in *AllocationRange) DeepCopy() *AllocationRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Application) DeepCopyInto(out *Application) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Application.
+func (in *Application) DeepCopy() *Application {
+	if in == nil {
+		return nil
+	}
+	out := new(Application)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Application) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Application, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationList.
+func (in *ApplicationList) DeepCopy() *ApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.HelmOverrides != nil {
+		in, out := &in.HelmOverrides, &out.HelmOverrides
+		*out = make([]HelmOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSpec.
+func (in *ApplicationSpec) DeepCopy() *ApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationStatus.
+func (in *ApplicationStatus) DeepCopy() *ApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BMCredentials) DeepCopyInto(out *BMCredentials) {
 	*out = *in
@@ -123,6 +247,11 @@ func (in *BMInfo) DeepCopyInto(out *BMInfo) {
 		*out = new(BMCredentials)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BMInfo.
@@ -151,472 +280,624 @@ func (in *BMPasswordInfo) DeepCopy() *BMPasswordInfo {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BondInfo) DeepCopyInto(out *BondInfo) {
+func (in *Backup) DeepCopyInto(out *Backup) {
 	*out = *in
-	in.CommonInterfaceInfo.DeepCopyInto(&out.CommonInterfaceInfo)
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.TransmitHashPolicy != nil {
-		in, out := &in.TransmitHashPolicy, &out.TransmitHashPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.PrimaryReselect != nil {
-		in, out := &in.PrimaryReselect, &out.PrimaryReselect
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BondInfo.
-func (in *BondInfo) DeepCopy() *BondInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backup.
+func (in *Backup) DeepCopy() *Backup {
 	if in == nil {
 		return nil
 	}
-	out := new(BondInfo)
+	out := new(Backup)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Backup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in BondList) DeepCopyInto(out *BondList) {
-	{
-		in := &in
-		*out = make(BondList, len(*in))
+func (in *BackupList) DeepCopyInto(out *BackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Backup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BondList.
-func (in BondList) DeepCopy() BondList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupList.
+func (in *BackupList) DeepCopy() *BackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(BondList)
+	out := new(BackupList)
 	in.DeepCopyInto(out)
-	return *out
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateInfo) DeepCopyInto(out *CertificateInfo) {
+func (in *BackupRecord) DeepCopyInto(out *BackupRecord) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateInfo.
-func (in *CertificateInfo) DeepCopy() *CertificateInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRecord.
+func (in *BackupRecord) DeepCopy() *BackupRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateInfo)
+	out := new(BackupRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in CertificateList) DeepCopyInto(out *CertificateList) {
-	{
-		in := &in
-		*out = make(CertificateList, len(*in))
-		copy(*out, *in)
+func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
+	*out = *in
+	if in.BackupValues != nil {
+		in, out := &in.BackupValues, &out.BackupValues
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateList.
-func (in CertificateList) DeepCopy() CertificateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSpec.
+func (in *BackupSpec) DeepCopy() *BackupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateList)
+	out := new(BackupSpec)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommonInterfaceInfo) DeepCopyInto(out *CommonInterfaceInfo) {
+func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 	*out = *in
-	if in.MTU != nil {
-		in, out := &in.MTU, &out.MTU
-		*out = new(int)
-		**out = **in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
 	}
-	if in.PlatformNetworks != nil {
-		in, out := &in.PlatformNetworks, &out.PlatformNetworks
-		*out = new(PlatformNetworkItemList)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make(PlatformNetworkItemList, len(*in))
-			copy(*out, *in)
-		}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
 	}
-	if in.DataNetworks != nil {
-		in, out := &in.DataNetworks, &out.DataNetworks
-		*out = new(DataNetworkItemList)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make(DataNetworkItemList, len(*in))
-			copy(*out, *in)
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]BackupRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.PTPRole != nil {
-		in, out := &in.PTPRole, &out.PTPRole
-		*out = new(string)
-		**out = **in
-	}
-	if in.PtpInterfaces != nil {
-		in, out := &in.PtpInterfaces, &out.PtpInterfaces
-		*out = new(PtpInterfaceItemList)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make(PtpInterfaceItemList, len(*in))
-			copy(*out, *in)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonInterfaceInfo.
-func (in *CommonInterfaceInfo) DeepCopy() *CommonInterfaceInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
+func (in *BackupStatus) DeepCopy() *BackupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CommonInterfaceInfo)
+	out := new(BackupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControllerFileSystemInfo) DeepCopyInto(out *ControllerFileSystemInfo) {
+func (in *BondInfo) DeepCopyInto(out *BondInfo) {
 	*out = *in
+	in.CommonInterfaceInfo.DeepCopyInto(&out.CommonInterfaceInfo)
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TransmitHashPolicy != nil {
+		in, out := &in.TransmitHashPolicy, &out.TransmitHashPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrimaryReselect != nil {
+		in, out := &in.PrimaryReselect, &out.PrimaryReselect
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerFileSystemInfo.
-func (in *ControllerFileSystemInfo) DeepCopy() *ControllerFileSystemInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BondInfo.
+func (in *BondInfo) DeepCopy() *BondInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(ControllerFileSystemInfo)
+	out := new(BondInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ControllerFileSystemList) DeepCopyInto(out *ControllerFileSystemList) {
+func (in BondList) DeepCopyInto(out *BondList) {
 	{
 		in := &in
-		*out = make(ControllerFileSystemList, len(*in))
-		copy(*out, *in)
+		*out = make(BondList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerFileSystemList.
-func (in ControllerFileSystemList) DeepCopy() ControllerFileSystemList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BondList.
+func (in BondList) DeepCopy() BondList {
 	if in == nil {
 		return nil
 	}
-	out := new(ControllerFileSystemList)
+	out := new(BondList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in DNSServerList) DeepCopyInto(out *DNSServerList) {
-	{
-		in := &in
-		*out = make(DNSServerList, len(*in))
+func (in *BootstrapHealthCheckInfo) DeepCopyInto(out *BootstrapHealthCheckInfo) {
+	*out = *in
+	if in.AlarmSeverity != nil {
+		in, out := &in.AlarmSeverity, &out.AlarmSeverity
+		*out = new(string)
+		**out = **in
+	}
+	if in.IgnoredAlarms != nil {
+		in, out := &in.IgnoredAlarms, &out.IgnoredAlarms
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSServerList.
-func (in DNSServerList) DeepCopy() DNSServerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapHealthCheckInfo.
+func (in *BootstrapHealthCheckInfo) DeepCopy() *BootstrapHealthCheckInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSServerList)
+	out := new(BootstrapHealthCheckInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in BootstrapHealthCheckList) DeepCopyInto(out *BootstrapHealthCheckList) {
+	{
+		in := &in
+		*out = make(BootstrapHealthCheckList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapHealthCheckList.
+func (in BootstrapHealthCheckList) DeepCopy() BootstrapHealthCheckList {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapHealthCheckList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DRBDConfiguration) DeepCopyInto(out *DRBDConfiguration) {
+func (in *BootstrapInfo) DeepCopyInto(out *BootstrapInfo) {
 	*out = *in
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make(BootstrapHealthCheckList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRBDConfiguration.
-func (in *DRBDConfiguration) DeepCopy() *DRBDConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapInfo.
+func (in *BootstrapInfo) DeepCopy() *BootstrapInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(DRBDConfiguration)
+	out := new(BootstrapInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataNetwork) DeepCopyInto(out *DataNetwork) {
+func (in *CephPoolQuotaInfo) DeepCopyInto(out *CephPoolQuotaInfo) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Cinder != nil {
+		in, out := &in.Cinder, &out.Cinder
+		*out = new(int)
+		**out = **in
+	}
+	if in.Glance != nil {
+		in, out := &in.Glance, &out.Glance
+		*out = new(int)
+		**out = **in
+	}
+	if in.Ephemeral != nil {
+		in, out := &in.Ephemeral, &out.Ephemeral
+		*out = new(int)
+		**out = **in
+	}
+	if in.Object != nil {
+		in, out := &in.Object, &out.Object
+		*out = new(int)
+		**out = **in
+	}
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetwork.
-func (in *DataNetwork) DeepCopy() *DataNetwork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephPoolQuotaInfo.
+func (in *CephPoolQuotaInfo) DeepCopy() *CephPoolQuotaInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(DataNetwork)
+	out := new(CephPoolQuotaInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataNetwork) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateInfo) DeepCopyInto(out *CertificateInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateInfo.
+func (in *CertificateInfo) DeepCopy() *CertificateInfo {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(CertificateInfo)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in DataNetworkItemList) DeepCopyInto(out *DataNetworkItemList) {
+func (in CertificateList) DeepCopyInto(out *CertificateList) {
 	{
 		in := &in
-		*out = make(DataNetworkItemList, len(*in))
+		*out = make(CertificateList, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetworkItemList.
-func (in DataNetworkItemList) DeepCopy() DataNetworkItemList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateList.
+func (in CertificateList) DeepCopy() CertificateList {
 	if in == nil {
 		return nil
 	}
-	out := new(DataNetworkItemList)
+	out := new(CertificateList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataNetworkList) DeepCopyInto(out *DataNetworkList) {
+func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DataNetwork, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetworkList.
-func (in *DataNetworkList) DeepCopy() *DataNetworkList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
+func (in *CertificateStatus) DeepCopy() *CertificateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DataNetworkList)
+	out := new(CertificateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataNetworkList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataNetworkSpec) DeepCopyInto(out *DataNetworkSpec) {
+func (in *CommonInterfaceInfo) DeepCopyInto(out *CommonInterfaceInfo) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
 	if in.MTU != nil {
 		in, out := &in.MTU, &out.MTU
 		*out = new(int)
 		**out = **in
 	}
-	if in.VxLAN != nil {
-		in, out := &in.VxLAN, &out.VxLAN
-		*out = new(VxLANInfo)
-		(*in).DeepCopyInto(*out)
+	if in.PlatformNetworks != nil {
+		in, out := &in.PlatformNetworks, &out.PlatformNetworks
+		*out = new(PlatformNetworkItemList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(PlatformNetworkItemList, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.DataNetworks != nil {
+		in, out := &in.DataNetworks, &out.DataNetworks
+		*out = new(DataNetworkItemList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(DataNetworkItemList, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.PTPRole != nil {
+		in, out := &in.PTPRole, &out.PTPRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.PtpInterfaces != nil {
+		in, out := &in.PtpInterfaces, &out.PtpInterfaces
+		*out = new(PtpInterfaceItemList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(PtpInterfaceItemList, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.IPv4Mode != nil {
+		in, out := &in.IPv4Mode, &out.IPv4Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv4Pool != nil {
+		in, out := &in.IPv4Pool, &out.IPv4Pool
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6Mode != nil {
+		in, out := &in.IPv6Mode, &out.IPv6Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6Pool != nil {
+		in, out := &in.IPv6Pool, &out.IPv6Pool
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetworkSpec.
-func (in *DataNetworkSpec) DeepCopy() *DataNetworkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonInterfaceInfo.
+func (in *CommonInterfaceInfo) DeepCopy() *CommonInterfaceInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(DataNetworkSpec)
+	out := new(CommonInterfaceInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataNetworkStatus) DeepCopyInto(out *DataNetworkStatus) {
+func (in *ConsoleCaptureInfo) DeepCopyInto(out *ConsoleCaptureInfo) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.HostKey != nil {
+		in, out := &in.HostKey, &out.HostKey
 		*out = new(string)
 		**out = **in
 	}
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetworkStatus.
-func (in *DataNetworkStatus) DeepCopy() *DataNetworkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleCaptureInfo.
+func (in *ConsoleCaptureInfo) DeepCopy() *ConsoleCaptureInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(DataNetworkStatus)
+	out := new(ConsoleCaptureInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ErrMissingSystemResource) DeepCopyInto(out *ErrMissingSystemResource) {
+func (in *ControllerFileSystemInfo) DeepCopyInto(out *ControllerFileSystemInfo) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrMissingSystemResource.
-func (in *ErrMissingSystemResource) DeepCopy() *ErrMissingSystemResource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerFileSystemInfo.
+func (in *ControllerFileSystemInfo) DeepCopy() *ControllerFileSystemInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(ErrMissingSystemResource)
+	out := new(ControllerFileSystemInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EthernetInfo) DeepCopyInto(out *EthernetInfo) {
-	*out = *in
-	in.CommonInterfaceInfo.DeepCopyInto(&out.CommonInterfaceInfo)
-	if in.VFCount != nil {
-		in, out := &in.VFCount, &out.VFCount
-		*out = new(int)
-		**out = **in
-	}
-	if in.VFDriver != nil {
-		in, out := &in.VFDriver, &out.VFDriver
-		*out = new(string)
-		**out = **in
+func (in ControllerFileSystemList) DeepCopyInto(out *ControllerFileSystemList) {
+	{
+		in := &in
+		*out = make(ControllerFileSystemList, len(*in))
+		copy(*out, *in)
 	}
-	out.Port = in.Port
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthernetInfo.
-func (in *EthernetInfo) DeepCopy() *EthernetInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerFileSystemList.
+func (in ControllerFileSystemList) DeepCopy() ControllerFileSystemList {
 	if in == nil {
 		return nil
 	}
-	out := new(EthernetInfo)
+	out := new(ControllerFileSystemList)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in EthernetList) DeepCopyInto(out *EthernetList) {
+func (in DNSServerList) DeepCopyInto(out *DNSServerList) {
 	{
 		in := &in
-		*out = make(EthernetList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+		*out = make(DNSServerList, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthernetList.
-func (in EthernetList) DeepCopy() EthernetList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSServerList.
+func (in DNSServerList) DeepCopy() DNSServerList {
 	if in == nil {
 		return nil
 	}
-	out := new(EthernetList)
+	out := new(DNSServerList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EthernetPortInfo) DeepCopyInto(out *EthernetPortInfo) {
+func (in *DRBDConfiguration) DeepCopyInto(out *DRBDConfiguration) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthernetPortInfo.
-func (in *EthernetPortInfo) DeepCopy() *EthernetPortInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRBDConfiguration.
+func (in *DRBDConfiguration) DeepCopy() *DRBDConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(EthernetPortInfo)
+	out := new(DRBDConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileSystemInfo) DeepCopyInto(out *FileSystemInfo) {
+func (in *DataNetwork) DeepCopyInto(out *DataNetwork) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemInfo.
-func (in *FileSystemInfo) DeepCopy() *FileSystemInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetwork.
+func (in *DataNetwork) DeepCopy() *DataNetwork {
 	if in == nil {
 		return nil
 	}
-	out := new(FileSystemInfo)
+	out := new(DataNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in FileSystemList) DeepCopyInto(out *FileSystemList) {
-	{
-		in := &in
-		*out = make(FileSystemList, len(*in))
-		copy(*out, *in)
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemList.
-func (in FileSystemList) DeepCopy() FileSystemList {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataNetworkAttachment) DeepCopyInto(out *DataNetworkAttachment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetworkAttachment.
+func (in *DataNetworkAttachment) DeepCopy() *DataNetworkAttachment {
 	if in == nil {
 		return nil
 	}
-	out := new(FileSystemList)
+	out := new(DataNetworkAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in DataNetworkItemList) DeepCopyInto(out *DataNetworkItemList) {
+	{
+		in := &in
+		*out = make(DataNetworkItemList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetworkItemList.
+func (in DataNetworkItemList) DeepCopy() DataNetworkItemList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataNetworkItemList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Host) DeepCopyInto(out *Host) {
+func (in *DataNetworkList) DeepCopyInto(out *DataNetworkList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Host.
-func (in *Host) DeepCopy() *Host {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetworkList.
+func (in *DataNetworkList) DeepCopy() *DataNetworkList {
 	if in == nil {
 		return nil
 	}
-	out := new(Host)
+	out := new(DataNetworkList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Host) DeepCopyObject() runtime.Object {
+func (in *DataNetworkList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -624,57 +905,108 @@ func (in *Host) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostList) DeepCopyInto(out *HostList) {
+func (in *DataNetworkSpec) DeepCopyInto(out *DataNetworkSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Host, len(*in))
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int)
+		**out = **in
+	}
+	if in.VxLAN != nil {
+		in, out := &in.VxLAN, &out.VxLAN
+		*out = new(VxLANInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetworkSpec.
+func (in *DataNetworkSpec) DeepCopy() *DataNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataNetworkStatus) DeepCopyInto(out *DataNetworkStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeltaAttributes != nil {
+		in, out := &in.DeltaAttributes, &out.DeltaAttributes
+		*out = make([]DeltaAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = make([]DataNetworkAttachment, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostList.
-func (in *HostList) DeepCopy() *HostList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetworkStatus.
+func (in *DataNetworkStatus) DeepCopy() *DataNetworkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HostList)
+	out := new(DataNetworkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HostList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeltaAttribute) DeepCopyInto(out *DeltaAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeltaAttribute.
+func (in *DeltaAttribute) DeepCopy() *DeltaAttribute {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(DeltaAttribute)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostProfile) DeepCopyInto(out *HostProfile) {
+func (in *DeviceImage) DeepCopyInto(out *DeviceImage) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfile.
-func (in *HostProfile) DeepCopy() *HostProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceImage.
+func (in *DeviceImage) DeepCopy() *DeviceImage {
 	if in == nil {
 		return nil
 	}
-	out := new(HostProfile)
+	out := new(DeviceImage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HostProfile) DeepCopyObject() runtime.Object {
+func (in *DeviceImage) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -682,31 +1014,31 @@ func (in *HostProfile) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostProfileList) DeepCopyInto(out *HostProfileList) {
+func (in *DeviceImageList) DeepCopyInto(out *DeviceImageList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]HostProfile, len(*in))
+		*out = make([]DeviceImage, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfileList.
-func (in *HostProfileList) DeepCopy() *HostProfileList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceImageList.
+func (in *DeviceImageList) DeepCopy() *DeviceImageList {
 	if in == nil {
 		return nil
 	}
-	out := new(HostProfileList)
+	out := new(DeviceImageList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HostProfileList) DeepCopyObject() runtime.Object {
+func (in *DeviceImageList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -714,500 +1046,1551 @@ func (in *HostProfileList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostProfileSpec) DeepCopyInto(out *HostProfileSpec) {
+func (in *DeviceImageSpec) DeepCopyInto(out *DeviceImageSpec) {
 	*out = *in
-	if in.Base != nil {
-		in, out := &in.Base, &out.Base
+	if in.BitstreamID != nil {
+		in, out := &in.BitstreamID, &out.BitstreamID
 		*out = new(string)
 		**out = **in
 	}
-	in.ProfileBaseAttributes.DeepCopyInto(&out.ProfileBaseAttributes)
-	if in.BoardManagement != nil {
-		in, out := &in.BoardManagement, &out.BoardManagement
-		*out = new(BMInfo)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Processors != nil {
-		in, out := &in.Processors, &out.Processors
-		*out = make(ProcessorNodeList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Memory != nil {
-		in, out := &in.Memory, &out.Memory
-		*out = make(MemoryNodeList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.KeySignature != nil {
+		in, out := &in.KeySignature, &out.KeySignature
+		*out = new(string)
+		**out = **in
 	}
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(ProfileStorageInfo)
-		(*in).DeepCopyInto(*out)
+	if in.RevokeKeyID != nil {
+		in, out := &in.RevokeKeyID, &out.RevokeKeyID
+		*out = new(int)
+		**out = **in
 	}
-	if in.Interfaces != nil {
-		in, out := &in.Interfaces, &out.Interfaces
-		*out = new(InterfaceInfo)
-		(*in).DeepCopyInto(*out)
+	if in.ImageVersion != nil {
+		in, out := &in.ImageVersion, &out.ImageVersion
+		*out = new(string)
+		**out = **in
 	}
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make(AddressList, len(*in))
-		copy(*out, *in)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
 	}
-	if in.Routes != nil {
-		in, out := &in.Routes, &out.Routes
-		*out = make(RouteList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfileSpec.
-func (in *HostProfileSpec) DeepCopy() *HostProfileSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceImageSpec.
+func (in *DeviceImageSpec) DeepCopy() *DeviceImageSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HostProfileSpec)
+	out := new(DeviceImageSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostSpec) DeepCopyInto(out *HostSpec) {
+func (in *DeviceImageStatus) DeepCopyInto(out *DeviceImageStatus) {
 	*out = *in
-	if in.Match != nil {
-		in, out := &in.Match, &out.Match
-		*out = new(MatchInfo)
-		(*in).DeepCopyInto(*out)
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
 	}
-	if in.Overrides != nil {
-		in, out := &in.Overrides, &out.Overrides
-		*out = new(HostProfileSpec)
-		(*in).DeepCopyInto(*out)
+	if in.DeltaAttributes != nil {
+		in, out := &in.DeltaAttributes, &out.DeltaAttributes
+		*out = make([]DeltaAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Writes != nil {
+		in, out := &in.Writes, &out.Writes
+		*out = make([]DeviceImageWriteStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostSpec.
-func (in *HostSpec) DeepCopy() *HostSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceImageStatus.
+func (in *DeviceImageStatus) DeepCopy() *DeviceImageStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HostSpec)
+	out := new(DeviceImageStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostStatus) DeepCopyInto(out *HostStatus) {
+func (in *DeviceImageWriteStatus) DeepCopyInto(out *DeviceImageWriteStatus) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.AdministrativeState != nil {
-		in, out := &in.AdministrativeState, &out.AdministrativeState
-		*out = new(string)
-		**out = **in
-	}
-	if in.OperationalStatus != nil {
-		in, out := &in.OperationalStatus, &out.OperationalStatus
-		*out = new(string)
-		**out = **in
-	}
-	if in.AvailabilityStatus != nil {
-		in, out := &in.AvailabilityStatus, &out.AvailabilityStatus
-		*out = new(string)
-		**out = **in
-	}
-	if in.Defaults != nil {
-		in, out := &in.Defaults, &out.Defaults
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostStatus.
-func (in *HostStatus) DeepCopy() *HostStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceImageWriteStatus.
+func (in *DeviceImageWriteStatus) DeepCopy() *DeviceImageWriteStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HostStatus)
+	out := new(DeviceImageWriteStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceInfo) DeepCopyInto(out *InterfaceInfo) {
+func (in *DeviceInfo) DeepCopyInto(out *DeviceInfo) {
 	*out = *in
-	if in.Ethernet != nil {
-		in, out := &in.Ethernet, &out.Ethernet
-		*out = make(EthernetList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.VLAN != nil {
-		in, out := &in.VLAN, &out.VLAN
-		*out = make(VLANList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Bond != nil {
-		in, out := &in.Bond, &out.Bond
-		*out = make(BondList, len(*in))
+	if in.FEC != nil {
+		in, out := &in.FEC, &out.FEC
+		*out = make(FECDeviceList, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.VF != nil {
-		in, out := &in.VF, &out.VF
-		*out = make(VFList, len(*in))
+	if in.PCI != nil {
+		in, out := &in.PCI, &out.PCI
+		*out = make(PCIDeviceList, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceInfo.
-func (in *InterfaceInfo) DeepCopy() *InterfaceInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceInfo.
+func (in *DeviceInfo) DeepCopy() *DeviceInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceInfo)
+	out := new(DeviceInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JournalInfo) DeepCopyInto(out *JournalInfo) {
+func (in *ErrMissingSystemResource) DeepCopyInto(out *ErrMissingSystemResource) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JournalInfo.
-func (in *JournalInfo) DeepCopy() *JournalInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrMissingSystemResource.
+func (in *ErrMissingSystemResource) DeepCopy() *ErrMissingSystemResource {
 	if in == nil {
 		return nil
 	}
-	out := new(JournalInfo)
+	out := new(ErrMissingSystemResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LicenseInfo) DeepCopyInto(out *LicenseInfo) {
+func (in *EthernetInfo) DeepCopyInto(out *EthernetInfo) {
 	*out = *in
+	in.CommonInterfaceInfo.DeepCopyInto(&out.CommonInterfaceInfo)
+	if in.VFCount != nil {
+		in, out := &in.VFCount, &out.VFCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.VFDriver != nil {
+		in, out := &in.VFDriver, &out.VFDriver
+		*out = new(string)
+		**out = **in
+	}
+	in.Port.DeepCopyInto(&out.Port)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseInfo.
-func (in *LicenseInfo) DeepCopy() *LicenseInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthernetInfo.
+func (in *EthernetInfo) DeepCopy() *EthernetInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(LicenseInfo)
+	out := new(EthernetInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MatchBMInfo) DeepCopyInto(out *MatchBMInfo) {
-	*out = *in
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = new(string)
-		**out = **in
-	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
-		**out = **in
+func (in EthernetList) DeepCopyInto(out *EthernetList) {
+	{
+		in := &in
+		*out = make(EthernetList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchBMInfo.
-func (in *MatchBMInfo) DeepCopy() *MatchBMInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthernetList.
+func (in EthernetList) DeepCopy() EthernetList {
 	if in == nil {
 		return nil
 	}
-	out := new(MatchBMInfo)
+	out := new(EthernetList)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MatchDMIInfo) DeepCopyInto(out *MatchDMIInfo) {
+func (in *EthernetPortInfo) DeepCopyInto(out *EthernetPortInfo) {
 	*out = *in
-	if in.SerialNumber != nil {
-		in, out := &in.SerialNumber, &out.SerialNumber
-		*out = new(string)
-		**out = **in
-	}
-	if in.AssetTag != nil {
-		in, out := &in.AssetTag, &out.AssetTag
+	if in.PCIAddress != nil {
+		in, out := &in.PCIAddress, &out.PCIAddress
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchDMIInfo.
-func (in *MatchDMIInfo) DeepCopy() *MatchDMIInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthernetPortInfo.
+func (in *EthernetPortInfo) DeepCopy() *EthernetPortInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(MatchDMIInfo)
+	out := new(EthernetPortInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MatchInfo) DeepCopyInto(out *MatchInfo) {
+func (in *FECDeviceInfo) DeepCopyInto(out *FECDeviceInfo) {
 	*out = *in
-	if in.BootMAC != nil {
-		in, out := &in.BootMAC, &out.BootMAC
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
 		*out = new(string)
 		**out = **in
 	}
-	if in.BoardManagement != nil {
-		in, out := &in.BoardManagement, &out.BoardManagement
-		*out = new(MatchBMInfo)
-		(*in).DeepCopyInto(*out)
+	if in.VFCount != nil {
+		in, out := &in.VFCount, &out.VFCount
+		*out = new(int)
+		**out = **in
 	}
-	if in.DMI != nil {
-		in, out := &in.DMI, &out.DMI
-		*out = new(MatchDMIInfo)
-		(*in).DeepCopyInto(*out)
+	if in.VFDriver != nil {
+		in, out := &in.VFDriver, &out.VFDriver
+		*out = new(string)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchInfo.
-func (in *MatchInfo) DeepCopy() *MatchInfo {
-	if in == nil {
-		return nil
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	out := new(MatchInfo)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemoryFunctionInfo) DeepCopyInto(out *MemoryFunctionInfo) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryFunctionInfo.
-func (in *MemoryFunctionInfo) DeepCopy() *MemoryFunctionInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FECDeviceInfo.
+func (in *FECDeviceInfo) DeepCopy() *FECDeviceInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(MemoryFunctionInfo)
+	out := new(FECDeviceInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in MemoryFunctionList) DeepCopyInto(out *MemoryFunctionList) {
+func (in FECDeviceList) DeepCopyInto(out *FECDeviceList) {
 	{
 		in := &in
-		*out = make(MemoryFunctionList, len(*in))
-		copy(*out, *in)
+		*out = make(FECDeviceList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryFunctionList.
-func (in MemoryFunctionList) DeepCopy() MemoryFunctionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FECDeviceList.
+func (in FECDeviceList) DeepCopy() FECDeviceList {
 	if in == nil {
 		return nil
 	}
-	out := new(MemoryFunctionList)
+	out := new(FECDeviceList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemoryNodeInfo) DeepCopyInto(out *MemoryNodeInfo) {
+func (in *FileSystemInfo) DeepCopyInto(out *FileSystemInfo) {
 	*out = *in
-	if in.Functions != nil {
-		in, out := &in.Functions, &out.Functions
-		*out = make(MemoryFunctionList, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryNodeInfo.
-func (in *MemoryNodeInfo) DeepCopy() *MemoryNodeInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemInfo.
+func (in *FileSystemInfo) DeepCopy() *FileSystemInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(MemoryNodeInfo)
+	out := new(FileSystemInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in MemoryNodeList) DeepCopyInto(out *MemoryNodeList) {
+func (in FileSystemList) DeepCopyInto(out *FileSystemList) {
 	{
 		in := &in
-		*out = make(MemoryNodeList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+		*out = make(FileSystemList, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryNodeList.
-func (in MemoryNodeList) DeepCopy() MemoryNodeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemList.
+func (in FileSystemList) DeepCopy() FileSystemList {
 	if in == nil {
 		return nil
 	}
-	out := new(MemoryNodeList)
+	out := new(FileSystemList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorInfo) DeepCopyInto(out *MonitorInfo) {
+func (in *HelmOverride) DeepCopyInto(out *HelmOverride) {
 	*out = *in
-	if in.Size != nil {
-		in, out := &in.Size, &out.Size
-		*out = new(int)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorInfo.
-func (in *MonitorInfo) DeepCopy() *MonitorInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmOverride.
+func (in *HelmOverride) DeepCopy() *HelmOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorInfo)
+	out := new(HelmOverride)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in NTPServerList) DeepCopyInto(out *NTPServerList) {
-	{
-		in := &in
-		*out = make(NTPServerList, len(*in))
-		copy(*out, *in)
+func (in *Host) DeepCopyInto(out *Host) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Host.
+func (in *Host) DeepCopy() *Host {
+	if in == nil {
+		return nil
 	}
+	out := new(Host)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NTPServerList.
-func (in NTPServerList) DeepCopy() NTPServerList {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Host) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostBMCredentialsStatus) DeepCopyInto(out *HostBMCredentialsStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostBMCredentialsStatus.
+func (in *HostBMCredentialsStatus) DeepCopy() *HostBMCredentialsStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NTPServerList)
+	out := new(HostBMCredentialsStatus)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OSDInfo) DeepCopyInto(out *OSDInfo) {
+func (in *HostClockSyncStatus) DeepCopyInto(out *HostClockSyncStatus) {
 	*out = *in
-	if in.ClusterName != nil {
-		in, out := &in.ClusterName, &out.ClusterName
+	if in.GNSS != nil {
+		in, out := &in.GNSS, &out.GNSS
 		*out = new(string)
 		**out = **in
 	}
-	if in.Journal != nil {
-		in, out := &in.Journal, &out.Journal
-		*out = new(JournalInfo)
-		**out = **in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]HostPTPInstanceSyncStatus, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSDInfo.
-func (in *OSDInfo) DeepCopy() *OSDInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostClockSyncStatus.
+func (in *HostClockSyncStatus) DeepCopy() *HostClockSyncStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OSDInfo)
+	out := new(HostClockSyncStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in OSDList) DeepCopyInto(out *OSDList) {
-	{
-		in := &in
-		*out = make(OSDList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+func (in *HostDiskHardwareStatus) DeepCopyInto(out *HostDiskHardwareStatus) {
+	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSDList.
-func (in OSDList) DeepCopy() OSDList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDiskHardwareStatus.
+func (in *HostDiskHardwareStatus) DeepCopy() *HostDiskHardwareStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OSDList)
+	out := new(HostDiskHardwareStatus)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PTPInfo) DeepCopyInto(out *PTPInfo) {
+func (in *HostHardwareStatus) DeepCopyInto(out *HostHardwareStatus) {
 	*out = *in
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
-		*out = new(string)
-		**out = **in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]HostNodeHardwareStatus, len(*in))
+		copy(*out, *in)
 	}
-	if in.Transport != nil {
-		in, out := &in.Transport, &out.Transport
-		*out = new(string)
-		**out = **in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]HostDiskHardwareStatus, len(*in))
+		copy(*out, *in)
 	}
-	if in.Mechanism != nil {
-		in, out := &in.Mechanism, &out.Mechanism
-		*out = new(string)
-		**out = **in
+	if in.NICModels != nil {
+		in, out := &in.NICModels, &out.NICModels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PTPInfo.
-func (in *PTPInfo) DeepCopy() *PTPInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostHardwareStatus.
+func (in *HostHardwareStatus) DeepCopy() *HostHardwareStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PTPInfo)
+	out := new(HostHardwareStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PhysicalVolumeInfo) DeepCopyInto(out *PhysicalVolumeInfo) {
+func (in *HostKernelStatus) DeepCopyInto(out *HostKernelStatus) {
 	*out = *in
-	if in.Size != nil {
-		in, out := &in.Size, &out.Size
-		*out = new(int)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalVolumeInfo.
-func (in *PhysicalVolumeInfo) DeepCopy() *PhysicalVolumeInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostKernelStatus.
+func (in *HostKernelStatus) DeepCopy() *HostKernelStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PhysicalVolumeInfo)
+	out := new(HostKernelStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in PhysicalVolumeList) DeepCopyInto(out *PhysicalVolumeList) {
-	{
-		in := &in
-		*out = make(PhysicalVolumeList, len(*in))
+func (in *HostList) DeepCopyInto(out *HostList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Host, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostList.
+func (in *HostList) DeepCopy() *HostList {
+	if in == nil {
+		return nil
+	}
+	out := new(HostList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostNodeHardwareStatus) DeepCopyInto(out *HostNodeHardwareStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostNodeHardwareStatus.
+func (in *HostNodeHardwareStatus) DeepCopy() *HostNodeHardwareStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostNodeHardwareStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPTPInstanceSyncStatus) DeepCopyInto(out *HostPTPInstanceSyncStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPTPInstanceSyncStatus.
+func (in *HostPTPInstanceSyncStatus) DeepCopy() *HostPTPInstanceSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPTPInstanceSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostProfile) DeepCopyInto(out *HostProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfile.
+func (in *HostProfile) DeepCopy() *HostProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(HostProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostProfileList) DeepCopyInto(out *HostProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HostProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfileList.
+func (in *HostProfileList) DeepCopy() *HostProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(HostProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostProfileSpec) DeepCopyInto(out *HostProfileSpec) {
+	*out = *in
+	if in.Base != nil {
+		in, out := &in.Base, &out.Base
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mixins != nil {
+		in, out := &in.Mixins, &out.Mixins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ProfileBaseAttributes.DeepCopyInto(&out.ProfileBaseAttributes)
+	if in.BoardManagement != nil {
+		in, out := &in.BoardManagement, &out.BoardManagement
+		*out = new(BMInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Processors != nil {
+		in, out := &in.Processors, &out.Processors
+		*out = make(ProcessorNodeList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = make(MemoryNodeList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(ProfileStorageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = new(InterfaceInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make(AddressList, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make(RouteList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = new(DeviceInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SensorGroups != nil {
+		in, out := &in.SensorGroups, &out.SensorGroups
+		*out = make(SensorGroupList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IgnoreFields != nil {
+		in, out := &in.IgnoreFields, &out.IgnoreFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfileSpec.
+func (in *HostProfileSpec) DeepCopy() *HostProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HostProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostSpec) DeepCopyInto(out *HostSpec) {
+	*out = *in
+	if in.CloneFrom != nil {
+		in, out := &in.CloneFrom, &out.CloneFrom
+		*out = new(string)
+		**out = **in
+	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(MatchInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(HostProfileSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VirtualMedia != nil {
+		in, out := &in.VirtualMedia, &out.VirtualMedia
+		*out = new(VirtualMediaInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PowerState != nil {
+		in, out := &in.PowerState, &out.PowerState
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConsoleCapture != nil {
+		in, out := &in.ConsoleCapture, &out.ConsoleCapture
+		*out = new(ConsoleCaptureInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForceUnlock != nil {
+		in, out := &in.ForceUnlock, &out.ForceUnlock
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReconcilePolicy != nil {
+		in, out := &in.ReconcilePolicy, &out.ReconcilePolicy
+		*out = new(ReconcilePolicyInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowReboot != nil {
+		in, out := &in.AllowReboot, &out.AllowReboot
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostSpec.
+func (in *HostSpec) DeepCopy() *HostSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HostSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostStatus) DeepCopyInto(out *HostStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdministrativeState != nil {
+		in, out := &in.AdministrativeState, &out.AdministrativeState
+		*out = new(string)
+		**out = **in
+	}
+	if in.OperationalStatus != nil {
+		in, out := &in.OperationalStatus, &out.OperationalStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityStatus != nil {
+		in, out := &in.AvailabilityStatus, &out.AvailabilityStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.Personality != nil {
+		in, out := &in.Personality, &out.Personality
+		*out = new(string)
+		**out = **in
+	}
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubsectionScopes != nil {
+		in, out := &in.SubsectionScopes, &out.SubsectionScopes
+		*out = new(HostSubsectionScopes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeltaAttributes != nil {
+		in, out := &in.DeltaAttributes, &out.DeltaAttributes
+		*out = make([]DeltaAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedAddresses != nil {
+		in, out := &in.ManagedAddresses, &out.ManagedAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedLabels != nil {
+		in, out := &in.ManagedLabels, &out.ManagedLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedTaints != nil {
+		in, out := &in.ManagedTaints, &out.ManagedTaints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Kernel != nil {
+		in, out := &in.Kernel, &out.Kernel
+		*out = new(HostKernelStatus)
+		**out = **in
+	}
+	if in.Hardware != nil {
+		in, out := &in.Hardware, &out.Hardware
+		*out = new(HostHardwareStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClockSync != nil {
+		in, out := &in.ClockSync, &out.ClockSync
+		*out = new(HostClockSyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PowerState != nil {
+		in, out := &in.PowerState, &out.PowerState
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstallState != nil {
+		in, out := &in.InstallState, &out.InstallState
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstallStateInfo != nil {
+		in, out := &in.InstallStateInfo, &out.InstallStateInfo
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootTime != nil {
+		in, out := &in.BootTime, &out.BootTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SoftwareVersion != nil {
+		in, out := &in.SoftwareVersion, &out.SoftwareVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.BMCredentials != nil {
+		in, out := &in.BMCredentials, &out.BMCredentials
+		*out = new(HostBMCredentialsStatus)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostStatus.
+func (in *HostStatus) DeepCopy() *HostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostSubsectionScopes) DeepCopyInto(out *HostSubsectionScopes) {
+	*out = *in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = new(string)
+		**out = **in
+	}
+	if in.PTP != nil {
+		in, out := &in.PTP, &out.PTP
+		*out = new(string)
+		**out = **in
+	}
+	if in.Kernel != nil {
+		in, out := &in.Kernel, &out.Kernel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostSubsectionScopes.
+func (in *HostSubsectionScopes) DeepCopy() *HostSubsectionScopes {
+	if in == nil {
+		return nil
+	}
+	out := new(HostSubsectionScopes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceInfo) DeepCopyInto(out *InterfaceInfo) {
+	*out = *in
+	if in.Ethernet != nil {
+		in, out := &in.Ethernet, &out.Ethernet
+		*out = make(EthernetList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VLAN != nil {
+		in, out := &in.VLAN, &out.VLAN
+		*out = make(VLANList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bond != nil {
+		in, out := &in.Bond, &out.Bond
+		*out = make(BondList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VF != nil {
+		in, out := &in.VF, &out.VF
+		*out = make(VFList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceInfo.
+func (in *InterfaceInfo) DeepCopy() *InterfaceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JournalInfo) DeepCopyInto(out *JournalInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JournalInfo.
+func (in *JournalInfo) DeepCopy() *JournalInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(JournalInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeUpgrade) DeepCopyInto(out *KubeUpgrade) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeUpgrade.
+func (in *KubeUpgrade) DeepCopy() *KubeUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubeUpgrade) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeUpgradeHostStatus) DeepCopyInto(out *KubeUpgradeHostStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeUpgradeHostStatus.
+func (in *KubeUpgradeHostStatus) DeepCopy() *KubeUpgradeHostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeUpgradeHostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeUpgradeList) DeepCopyInto(out *KubeUpgradeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubeUpgrade, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeUpgradeList.
+func (in *KubeUpgradeList) DeepCopy() *KubeUpgradeList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeUpgradeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubeUpgradeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeUpgradeSpec) DeepCopyInto(out *KubeUpgradeSpec) {
+	*out = *in
+	if in.HostOrder != nil {
+		in, out := &in.HostOrder, &out.HostOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeUpgradeSpec.
+func (in *KubeUpgradeSpec) DeepCopy() *KubeUpgradeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeUpgradeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeUpgradeStatus) DeepCopyInto(out *KubeUpgradeStatus) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]KubeUpgradeHostStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeUpgradeStatus.
+func (in *KubeUpgradeStatus) DeepCopy() *KubeUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPDomainInfo) DeepCopyInto(out *LDAPDomainInfo) {
+	*out = *in
+	if in.UserSearchBase != nil {
+		in, out := &in.UserSearchBase, &out.UserSearchBase
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupSearchBase != nil {
+		in, out := &in.GroupSearchBase, &out.GroupSearchBase
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessFilter != nil {
+		in, out := &in.AccessFilter, &out.AccessFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(string)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPDomainInfo.
+func (in *LDAPDomainInfo) DeepCopy() *LDAPDomainInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPDomainInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseInfo) DeepCopyInto(out *LicenseInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseInfo.
+func (in *LicenseInfo) DeepCopy() *LicenseInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseStatus) DeepCopyInto(out *LicenseStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseStatus.
+func (in *LicenseStatus) DeepCopy() *LicenseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchBMInfo) DeepCopyInto(out *MatchBMInfo) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.MACAddress != nil {
+		in, out := &in.MACAddress, &out.MACAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchBMInfo.
+func (in *MatchBMInfo) DeepCopy() *MatchBMInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchBMInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchDMIInfo) DeepCopyInto(out *MatchDMIInfo) {
+	*out = *in
+	if in.SerialNumber != nil {
+		in, out := &in.SerialNumber, &out.SerialNumber
+		*out = new(string)
+		**out = **in
+	}
+	if in.AssetTag != nil {
+		in, out := &in.AssetTag, &out.AssetTag
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchDMIInfo.
+func (in *MatchDMIInfo) DeepCopy() *MatchDMIInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchDMIInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchInfo) DeepCopyInto(out *MatchInfo) {
+	*out = *in
+	if in.BootMAC != nil {
+		in, out := &in.BootMAC, &out.BootMAC
+		*out = new(string)
+		**out = **in
+	}
+	if in.BoardManagement != nil {
+		in, out := &in.BoardManagement, &out.BoardManagement
+		*out = new(MatchBMInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DMI != nil {
+		in, out := &in.DMI, &out.DMI
+		*out = new(MatchDMIInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchInfo.
+func (in *MatchInfo) DeepCopy() *MatchInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryFunctionInfo) DeepCopyInto(out *MemoryFunctionInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryFunctionInfo.
+func (in *MemoryFunctionInfo) DeepCopy() *MemoryFunctionInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryFunctionInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in MemoryFunctionList) DeepCopyInto(out *MemoryFunctionList) {
+	{
+		in := &in
+		*out = make(MemoryFunctionList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryFunctionList.
+func (in MemoryFunctionList) DeepCopy() MemoryFunctionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryFunctionList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryNodeInfo) DeepCopyInto(out *MemoryNodeInfo) {
+	*out = *in
+	if in.Platform != nil {
+		in, out := &in.Platform, &out.Platform
+		*out = new(int)
+		**out = **in
+	}
+	if in.Functions != nil {
+		in, out := &in.Functions, &out.Functions
+		*out = make(MemoryFunctionList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryNodeInfo.
+func (in *MemoryNodeInfo) DeepCopy() *MemoryNodeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryNodeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in MemoryNodeList) DeepCopyInto(out *MemoryNodeList) {
+	{
+		in := &in
+		*out = make(MemoryNodeList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryNodeList.
+func (in MemoryNodeList) DeepCopy() MemoryNodeList {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryNodeList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorInfo) DeepCopyInto(out *MonitorInfo) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorInfo.
+func (in *MonitorInfo) DeepCopy() *MonitorInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in NTPServerList) DeepCopyInto(out *NTPServerList) {
+	{
+		in := &in
+		*out = make(NTPServerList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NTPServerList.
+func (in NTPServerList) DeepCopy() NTPServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(NTPServerList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTaint) DeepCopyInto(out *NodeTaint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTaint.
+func (in *NodeTaint) DeepCopy() *NodeTaint {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTaint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in NodeTaintList) DeepCopyInto(out *NodeTaintList) {
+	{
+		in := &in
+		*out = make(NodeTaintList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTaintList.
+func (in NodeTaintList) DeepCopy() NodeTaintList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTaintList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSDInfo) DeepCopyInto(out *OSDInfo) {
+	*out = *in
+	if in.ClusterName != nil {
+		in, out := &in.ClusterName, &out.ClusterName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Journal != nil {
+		in, out := &in.Journal, &out.Journal
+		*out = new(JournalInfo)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSDInfo.
+func (in *OSDInfo) DeepCopy() *OSDInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(OSDInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in OSDList) DeepCopyInto(out *OSDList) {
+	{
+		in := &in
+		*out = make(OSDList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSDList.
+func (in OSDList) DeepCopy() OSDList {
+	if in == nil {
+		return nil
+	}
+	out := new(OSDList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PCIDeviceInfo) DeepCopyInto(out *PCIDeviceInfo) {
+	*out = *in
+	if in.PCIAddress != nil {
+		in, out := &in.PCIAddress, &out.PCIAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.VendorID != nil {
+		in, out := &in.VendorID, &out.VendorID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PCIDeviceInfo.
+func (in *PCIDeviceInfo) DeepCopy() *PCIDeviceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PCIDeviceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PCIDeviceList) DeepCopyInto(out *PCIDeviceList) {
+	{
+		in := &in
+		*out = make(PCIDeviceList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PCIDeviceList.
+func (in PCIDeviceList) DeepCopy() PCIDeviceList {
+	if in == nil {
+		return nil
+	}
+	out := new(PCIDeviceList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PTPInfo) DeepCopyInto(out *PTPInfo) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.Transport != nil {
+		in, out := &in.Transport, &out.Transport
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mechanism != nil {
+		in, out := &in.Mechanism, &out.Mechanism
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PTPInfo.
+func (in *PTPInfo) DeepCopy() *PTPInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PTPInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalVolumeInfo) DeepCopyInto(out *PhysicalVolumeInfo) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalVolumeInfo.
+func (in *PhysicalVolumeInfo) DeepCopy() *PhysicalVolumeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalVolumeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PhysicalVolumeList) DeepCopyInto(out *PhysicalVolumeList) {
+	{
+		in := &in
+		*out = make(PhysicalVolumeList, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1219,83 +2602,861 @@ func (in PhysicalVolumeList) DeepCopy() PhysicalVolumeList {
 	if in == nil {
 		return nil
 	}
-	out := new(PhysicalVolumeList)
+	out := new(PhysicalVolumeList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformNetwork) DeepCopyInto(out *PlatformNetwork) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetwork.
+func (in *PlatformNetwork) DeepCopy() *PlatformNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PlatformNetworkItemList) DeepCopyInto(out *PlatformNetworkItemList) {
+	{
+		in := &in
+		*out = make(PlatformNetworkItemList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkItemList.
+func (in PlatformNetworkItemList) DeepCopy() PlatformNetworkItemList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformNetworkItemList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformNetworkList) DeepCopyInto(out *PlatformNetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlatformNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkList.
+func (in *PlatformNetworkList) DeepCopy() *PlatformNetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformNetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformNetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformNetworkSpec) DeepCopyInto(out *PlatformNetworkSpec) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(string)
+		**out = **in
+	}
+	in.Allocation.DeepCopyInto(&out.Allocation)
+	if in.SecondaryPool != nil {
+		in, out := &in.SecondaryPool, &out.SecondaryPool
+		*out = new(SecondaryPoolInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkSpec.
+func (in *PlatformNetworkSpec) DeepCopy() *PlatformNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformNetworkStatus) DeepCopyInto(out *PlatformNetworkStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PoolUUID != nil {
+		in, out := &in.PoolUUID, &out.PoolUUID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryPoolUUID != nil {
+		in, out := &in.SecondaryPoolUUID, &out.SecondaryPoolUUID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeltaAttributes != nil {
+		in, out := &in.DeltaAttributes, &out.DeltaAttributes
+		*out = make([]DeltaAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkStatus.
+func (in *PlatformNetworkStatus) DeepCopy() *PlatformNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreflightInfo) DeepCopyInto(out *PreflightInfo) {
+	*out = *in
+	if in.IgnoredAlarms != nil {
+		in, out := &in.IgnoredAlarms, &out.IgnoredAlarms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightInfo.
+func (in *PreflightInfo) DeepCopy() *PreflightInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PreflightInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessorFunctionInfo) DeepCopyInto(out *ProcessorFunctionInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorFunctionInfo.
+func (in *ProcessorFunctionInfo) DeepCopy() *ProcessorFunctionInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessorFunctionInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ProcessorFunctionList) DeepCopyInto(out *ProcessorFunctionList) {
+	{
+		in := &in
+		*out = make(ProcessorFunctionList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorFunctionList.
+func (in ProcessorFunctionList) DeepCopy() ProcessorFunctionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessorFunctionList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessorInfo) DeepCopyInto(out *ProcessorInfo) {
+	*out = *in
+	if in.Functions != nil {
+		in, out := &in.Functions, &out.Functions
+		*out = make(ProcessorFunctionList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorInfo.
+func (in *ProcessorInfo) DeepCopy() *ProcessorInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessorInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ProcessorNodeList) DeepCopyInto(out *ProcessorNodeList) {
+	{
+		in := &in
+		*out = make(ProcessorNodeList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorNodeList.
+func (in ProcessorNodeList) DeepCopy() ProcessorNodeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessorNodeList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileBaseAttributes) DeepCopyInto(out *ProfileBaseAttributes) {
+	*out = *in
+	if in.Personality != nil {
+		in, out := &in.Personality, &out.Personality
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdministrativeState != nil {
+		in, out := &in.AdministrativeState, &out.AdministrativeState
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubFunctions != nil {
+		in, out := &in.SubFunctions, &out.SubFunctions
+		*out = make([]SubFunction, len(*in))
+		copy(*out, *in)
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make(NodeTaintList, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstallOutput != nil {
+		in, out := &in.InstallOutput, &out.InstallOutput
+		*out = new(string)
+		**out = **in
+	}
+	if in.Console != nil {
+		in, out := &in.Console, &out.Console
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootDevice != nil {
+		in, out := &in.BootDevice, &out.BootDevice
+		*out = new(string)
+		**out = **in
+	}
+	if in.PowerOn != nil {
+		in, out := &in.PowerOn, &out.PowerOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProvisioningMode != nil {
+		in, out := &in.ProvisioningMode, &out.ProvisioningMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootMAC != nil {
+		in, out := &in.BootMAC, &out.BootMAC
+		*out = new(string)
+		**out = **in
+	}
+	if in.PtpInstances != nil {
+		in, out := &in.PtpInstances, &out.PtpInstances
+		*out = make(PtpInstanceItemList, len(*in))
+		copy(*out, *in)
+	}
+	if in.RootDevice != nil {
+		in, out := &in.RootDevice, &out.RootDevice
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClockSynchronization != nil {
+		in, out := &in.ClockSynchronization, &out.ClockSynchronization
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxCPUMhzConfigured != nil {
+		in, out := &in.MaxCPUMhzConfigured, &out.MaxCPUMhzConfigured
+		*out = new(string)
+		**out = **in
+	}
+	if in.PowerProfile != nil {
+		in, out := &in.PowerProfile, &out.PowerProfile
+		*out = new(string)
+		**out = **in
+	}
+	if in.AppArmor != nil {
+		in, out := &in.AppArmor, &out.AppArmor
+		*out = new(string)
+		**out = **in
+	}
+	if in.HwSettle != nil {
+		in, out := &in.HwSettle, &out.HwSettle
+		*out = new(string)
+		**out = **in
+	}
+	if in.Kernel != nil {
+		in, out := &in.Kernel, &out.Kernel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileBaseAttributes.
+func (in *ProfileBaseAttributes) DeepCopy() *ProfileBaseAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileBaseAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileStorageInfo) DeepCopyInto(out *ProfileStorageInfo) {
+	*out = *in
+	if in.Monitor != nil {
+		in, out := &in.Monitor, &out.Monitor
+		*out = new(MonitorInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OSDs != nil {
+		in, out := &in.OSDs, &out.OSDs
+		*out = new(OSDList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(OSDList, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+	if in.VolumeGroups != nil {
+		in, out := &in.VolumeGroups, &out.VolumeGroups
+		*out = new(VolumeGroupList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(VolumeGroupList, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+	if in.FileSystems != nil {
+		in, out := &in.FileSystems, &out.FileSystems
+		*out = new(FileSystemList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(FileSystemList, len(*in))
+			copy(*out, *in)
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileStorageInfo.
+func (in *ProfileStorageInfo) DeepCopy() *ProfileStorageInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileStorageInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInstance) DeepCopyInto(out *PtpInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstance.
+func (in *PtpInstance) DeepCopy() *PtpInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PtpInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PtpInstanceItemList) DeepCopyInto(out *PtpInstanceItemList) {
+	{
+		in := &in
+		*out = make(PtpInstanceItemList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceItemList.
+func (in PtpInstanceItemList) DeepCopy() PtpInstanceItemList {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInstanceItemList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInstanceList) DeepCopyInto(out *PtpInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PtpInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceList.
+func (in *PtpInstanceList) DeepCopy() *PtpInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PtpInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInstanceSpec) DeepCopyInto(out *PtpInstanceSpec) {
+	*out = *in
+	if in.InstanceParameters != nil {
+		in, out := &in.InstanceParameters, &out.InstanceParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceSpec.
+func (in *PtpInstanceSpec) DeepCopy() *PtpInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInstanceStatus) DeepCopyInto(out *PtpInstanceStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeltaAttributes != nil {
+		in, out := &in.DeltaAttributes, &out.DeltaAttributes
+		*out = make([]DeltaAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceStatus.
+func (in *PtpInstanceStatus) DeepCopy() *PtpInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInterface) DeepCopyInto(out *PtpInterface) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterface.
+func (in *PtpInterface) DeepCopy() *PtpInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PtpInterface) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PtpInterfaceItemList) DeepCopyInto(out *PtpInterfaceItemList) {
+	{
+		in := &in
+		*out = make(PtpInterfaceItemList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceItemList.
+func (in PtpInterfaceItemList) DeepCopy() PtpInterfaceItemList {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInterfaceItemList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInterfaceList) DeepCopyInto(out *PtpInterfaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PtpInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceList.
+func (in *PtpInterfaceList) DeepCopy() *PtpInterfaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInterfaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PtpInterfaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInterfaceSpec) DeepCopyInto(out *PtpInterfaceSpec) {
+	*out = *in
+	if in.InterfaceParameters != nil {
+		in, out := &in.InterfaceParameters, &out.InterfaceParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceSpec.
+func (in *PtpInterfaceSpec) DeepCopy() *PtpInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInterfaceStatus) DeepCopyInto(out *PtpInterfaceStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeltaAttributes != nil {
+		in, out := &in.DeltaAttributes, &out.DeltaAttributes
+		*out = make([]DeltaAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceStatus.
+func (in *PtpInterfaceStatus) DeepCopy() *PtpInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInterfaceStatus)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlatformNetwork) DeepCopyInto(out *PlatformNetwork) {
+func (in *ReconcilePolicyInfo) DeepCopyInto(out *ReconcilePolicyInfo) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.RetryInterval != nil {
+		in, out := &in.RetryInterval, &out.RetryInterval
+		*out = new(int)
+		**out = **in
+	}
+	if in.BackoffFactor != nil {
+		in, out := &in.BackoffFactor, &out.BackoffFactor
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxRetryInterval != nil {
+		in, out := &in.MaxRetryInterval, &out.MaxRetryInterval
+		*out = new(int)
+		**out = **in
+	}
+	if in.RetryLimit != nil {
+		in, out := &in.RetryLimit, &out.RetryLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.AutoRemediate != nil {
+		in, out := &in.AutoRemediate, &out.AutoRemediate
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetwork.
-func (in *PlatformNetwork) DeepCopy() *PlatformNetwork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcilePolicyInfo.
+func (in *ReconcilePolicyInfo) DeepCopy() *ReconcilePolicyInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(PlatformNetwork)
+	out := new(ReconcilePolicyInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PlatformNetwork) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryInfo) DeepCopyInto(out *RegistryInfo) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
 	}
-	return nil
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(string)
+		**out = **in
+	}
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryInfo.
+func (in *RegistryInfo) DeepCopy() *RegistryInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryInfo)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in PlatformNetworkItemList) DeepCopyInto(out *PlatformNetworkItemList) {
+func (in RegistryList) DeepCopyInto(out *RegistryList) {
 	{
 		in := &in
-		*out = make(PlatformNetworkItemList, len(*in))
-		copy(*out, *in)
+		*out = make(RegistryList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkItemList.
-func (in PlatformNetworkItemList) DeepCopy() PlatformNetworkItemList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryList.
+func (in RegistryList) DeepCopy() RegistryList {
 	if in == nil {
 		return nil
 	}
-	out := new(PlatformNetworkItemList)
+	out := new(RegistryList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlatformNetworkList) DeepCopyInto(out *PlatformNetworkList) {
+func (in *RegistryStatus) DeepCopyInto(out *RegistryStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryStatus.
+func (in *RegistryStatus) DeepCopy() *RegistryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteAuthenticationInfo) DeepCopyInto(out *RemoteAuthenticationInfo) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]LDAPDomainInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteAuthenticationInfo.
+func (in *RemoteAuthenticationInfo) DeepCopy() *RemoteAuthenticationInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteAuthenticationInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Restore) DeepCopyInto(out *Restore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Restore.
+func (in *Restore) DeepCopy() *Restore {
+	if in == nil {
+		return nil
+	}
+	out := new(Restore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Restore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreList) DeepCopyInto(out *RestoreList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PlatformNetwork, len(*in))
+		*out = make([]Restore, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkList.
-func (in *PlatformNetworkList) DeepCopy() *PlatformNetworkList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreList.
+func (in *RestoreList) DeepCopy() *RestoreList {
 	if in == nil {
 		return nil
 	}
-	out := new(PlatformNetworkList)
+	out := new(RestoreList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PlatformNetworkList) DeepCopyObject() runtime.Object {
+func (in *RestoreList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1303,286 +3464,319 @@ func (in *PlatformNetworkList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlatformNetworkSpec) DeepCopyInto(out *PlatformNetworkSpec) {
+func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
-	if in.Gateway != nil {
-		in, out := &in.Gateway, &out.Gateway
+	if in.Release != nil {
+		in, out := &in.Release, &out.Release
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestoreValues != nil {
+		in, out := &in.RestoreValues, &out.RestoreValues
+		*out = new(string)
+		**out = **in
+	}
+	if in.SystemNamespace != nil {
+		in, out := &in.SystemNamespace, &out.SystemNamespace
 		*out = new(string)
 		**out = **in
 	}
-	in.Allocation.DeepCopyInto(&out.Allocation)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkSpec.
-func (in *PlatformNetworkSpec) DeepCopy() *PlatformNetworkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
+func (in *RestoreSpec) DeepCopy() *RestoreSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PlatformNetworkSpec)
+	out := new(RestoreSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlatformNetworkStatus) DeepCopyInto(out *PlatformNetworkStatus) {
+func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PoolUUID != nil {
-		in, out := &in.PoolUUID, &out.PoolUUID
-		*out = new(string)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkStatus.
-func (in *PlatformNetworkStatus) DeepCopy() *PlatformNetworkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
+func (in *RestoreStatus) DeepCopy() *RestoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PlatformNetworkStatus)
+	out := new(RestoreStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProcessorFunctionInfo) DeepCopyInto(out *ProcessorFunctionInfo) {
+func (in *RouteInfo) DeepCopyInto(out *RouteInfo) {
 	*out = *in
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorFunctionInfo.
-func (in *ProcessorFunctionInfo) DeepCopy() *ProcessorFunctionInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteInfo.
+func (in *RouteInfo) DeepCopy() *RouteInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessorFunctionInfo)
+	out := new(RouteInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ProcessorFunctionList) DeepCopyInto(out *ProcessorFunctionList) {
+func (in RouteList) DeepCopyInto(out *RouteList) {
 	{
 		in := &in
-		*out = make(ProcessorFunctionList, len(*in))
-		copy(*out, *in)
+		*out = make(RouteList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorFunctionList.
-func (in ProcessorFunctionList) DeepCopy() ProcessorFunctionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
+func (in RouteList) DeepCopy() RouteList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessorFunctionList)
+	out := new(RouteList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProcessorInfo) DeepCopyInto(out *ProcessorInfo) {
-	*out = *in
-	if in.Functions != nil {
-		in, out := &in.Functions, &out.Functions
-		*out = make(ProcessorFunctionList, len(*in))
+func (in SNMPCommunityList) DeepCopyInto(out *SNMPCommunityList) {
+	{
+		in := &in
+		*out = make(SNMPCommunityList, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorInfo.
-func (in *ProcessorInfo) DeepCopy() *ProcessorInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNMPCommunityList.
+func (in SNMPCommunityList) DeepCopy() SNMPCommunityList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessorInfo)
+	out := new(SNMPCommunityList)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ProcessorNodeList) DeepCopyInto(out *ProcessorNodeList) {
-	{
-		in := &in
-		*out = make(ProcessorNodeList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+func (in *SNMPInfo) DeepCopyInto(out *SNMPInfo) {
+	*out = *in
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = new(SNMPCommunityList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(SNMPCommunityList, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.TrapDestinations != nil {
+		in, out := &in.TrapDestinations, &out.TrapDestinations
+		*out = new(SNMPTrapDestinationList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(SNMPTrapDestinationList, len(*in))
+			copy(*out, *in)
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorNodeList.
-func (in ProcessorNodeList) DeepCopy() ProcessorNodeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNMPInfo.
+func (in *SNMPInfo) DeepCopy() *SNMPInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessorNodeList)
+	out := new(SNMPInfo)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProfileBaseAttributes) DeepCopyInto(out *ProfileBaseAttributes) {
+func (in *SNMPTrapDestinationInfo) DeepCopyInto(out *SNMPTrapDestinationInfo) {
 	*out = *in
-	if in.Personality != nil {
-		in, out := &in.Personality, &out.Personality
-		*out = new(string)
-		**out = **in
-	}
-	if in.AdministrativeState != nil {
-		in, out := &in.AdministrativeState, &out.AdministrativeState
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNMPTrapDestinationInfo.
+func (in *SNMPTrapDestinationInfo) DeepCopy() *SNMPTrapDestinationInfo {
+	if in == nil {
+		return nil
 	}
-	if in.SubFunctions != nil {
-		in, out := &in.SubFunctions, &out.SubFunctions
-		*out = make([]SubFunction, len(*in))
+	out := new(SNMPTrapDestinationInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in SNMPTrapDestinationList) DeepCopyInto(out *SNMPTrapDestinationList) {
+	{
+		in := &in
+		*out = make(SNMPTrapDestinationList, len(*in))
 		copy(*out, *in)
 	}
-	if in.Location != nil {
-		in, out := &in.Location, &out.Location
-		*out = new(string)
-		**out = **in
-	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.InstallOutput != nil {
-		in, out := &in.InstallOutput, &out.InstallOutput
-		*out = new(string)
-		**out = **in
-	}
-	if in.Console != nil {
-		in, out := &in.Console, &out.Console
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNMPTrapDestinationList.
+func (in SNMPTrapDestinationList) DeepCopy() SNMPTrapDestinationList {
+	if in == nil {
+		return nil
 	}
-	if in.BootDevice != nil {
-		in, out := &in.BootDevice, &out.BootDevice
+	out := new(SNMPTrapDestinationList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryPoolInfo) DeepCopyInto(out *SecondaryPoolInfo) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
 		*out = new(string)
 		**out = **in
 	}
-	if in.PowerOn != nil {
-		in, out := &in.PowerOn, &out.PowerOn
-		*out = new(bool)
-		**out = **in
+	in.Allocation.DeepCopyInto(&out.Allocation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryPoolInfo.
+func (in *SecondaryPoolInfo) DeepCopy() *SecondaryPoolInfo {
+	if in == nil {
+		return nil
 	}
-	if in.ProvisioningMode != nil {
-		in, out := &in.ProvisioningMode, &out.ProvisioningMode
-		*out = new(string)
+	out := new(SecondaryPoolInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SensorGroupInfo) DeepCopyInto(out *SensorGroupInfo) {
+	*out = *in
+	if in.AuditInterval != nil {
+		in, out := &in.AuditInterval, &out.AuditInterval
+		*out = new(int)
 		**out = **in
 	}
-	if in.BootMAC != nil {
-		in, out := &in.BootMAC, &out.BootMAC
-		*out = new(string)
+	if in.Suppress != nil {
+		in, out := &in.Suppress, &out.Suppress
+		*out = new(bool)
 		**out = **in
 	}
-	if in.PtpInstances != nil {
-		in, out := &in.PtpInstances, &out.PtpInstances
-		*out = make(PtpInstanceItemList, len(*in))
+	if in.SuppressedSensors != nil {
+		in, out := &in.SuppressedSensors, &out.SuppressedSensors
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.RootDevice != nil {
-		in, out := &in.RootDevice, &out.RootDevice
+	if in.ActionsCritical != nil {
+		in, out := &in.ActionsCritical, &out.ActionsCritical
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClockSynchronization != nil {
-		in, out := &in.ClockSynchronization, &out.ClockSynchronization
+	if in.ActionsMajor != nil {
+		in, out := &in.ActionsMajor, &out.ActionsMajor
 		*out = new(string)
 		**out = **in
 	}
-	if in.MaxCPUMhzConfigured != nil {
-		in, out := &in.MaxCPUMhzConfigured, &out.MaxCPUMhzConfigured
+	if in.ActionsMinor != nil {
+		in, out := &in.ActionsMinor, &out.ActionsMinor
 		*out = new(string)
 		**out = **in
 	}
-	if in.AppArmor != nil {
-		in, out := &in.AppArmor, &out.AppArmor
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SensorGroupInfo.
+func (in *SensorGroupInfo) DeepCopy() *SensorGroupInfo {
+	if in == nil {
+		return nil
 	}
-	if in.HwSettle != nil {
-		in, out := &in.HwSettle, &out.HwSettle
+	out := new(SensorGroupInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in SensorGroupList) DeepCopyInto(out *SensorGroupList) {
+	{
+		in := &in
+		*out = make(SensorGroupList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SensorGroupList.
+func (in SensorGroupList) DeepCopy() SensorGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(SensorGroupList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceParameterInfo) DeepCopyInto(out *ServiceParameterInfo) {
+	*out = *in
+	if in.Personality != nil {
+		in, out := &in.Personality, &out.Personality
 		*out = new(string)
 		**out = **in
 	}
-	if in.Kernel != nil {
-		in, out := &in.Kernel, &out.Kernel
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileBaseAttributes.
-func (in *ProfileBaseAttributes) DeepCopy() *ProfileBaseAttributes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceParameterInfo.
+func (in *ServiceParameterInfo) DeepCopy() *ServiceParameterInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(ProfileBaseAttributes)
+	out := new(ServiceParameterInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProfileStorageInfo) DeepCopyInto(out *ProfileStorageInfo) {
-	*out = *in
-	if in.Monitor != nil {
-		in, out := &in.Monitor, &out.Monitor
-		*out = new(MonitorInfo)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.OSDs != nil {
-		in, out := &in.OSDs, &out.OSDs
-		*out = new(OSDList)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make(OSDList, len(*in))
-			for i := range *in {
-				(*in)[i].DeepCopyInto(&(*out)[i])
-			}
-		}
-	}
-	if in.VolumeGroups != nil {
-		in, out := &in.VolumeGroups, &out.VolumeGroups
-		*out = new(VolumeGroupList)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make(VolumeGroupList, len(*in))
-			for i := range *in {
-				(*in)[i].DeepCopyInto(&(*out)[i])
-			}
-		}
-	}
-	if in.FileSystems != nil {
-		in, out := &in.FileSystems, &out.FileSystems
-		*out = new(FileSystemList)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make(FileSystemList, len(*in))
-			copy(*out, *in)
+func (in ServiceParameterList) DeepCopyInto(out *ServiceParameterList) {
+	{
+		in := &in
+		*out = make(ServiceParameterList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileStorageInfo.
-func (in *ProfileStorageInfo) DeepCopy() *ProfileStorageInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceParameterList.
+func (in ServiceParameterList) DeepCopy() ServiceParameterList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProfileStorageInfo)
+	out := new(ServiceParameterList)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInstance) DeepCopyInto(out *PtpInstance) {
+func (in *SoftwareDeploy) DeepCopyInto(out *SoftwareDeploy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1590,18 +3784,18 @@ func (in *PtpInstance) DeepCopyInto(out *PtpInstance) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstance.
-func (in *PtpInstance) DeepCopy() *PtpInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoftwareDeploy.
+func (in *SoftwareDeploy) DeepCopy() *SoftwareDeploy {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInstance)
+	out := new(SoftwareDeploy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PtpInstance) DeepCopyObject() runtime.Object {
+func (in *SoftwareDeploy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1609,50 +3803,46 @@ func (in *PtpInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in PtpInstanceItemList) DeepCopyInto(out *PtpInstanceItemList) {
-	{
-		in := &in
-		*out = make(PtpInstanceItemList, len(*in))
-		copy(*out, *in)
-	}
+func (in *SoftwareDeployHostStatus) DeepCopyInto(out *SoftwareDeployHostStatus) {
+	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceItemList.
-func (in PtpInstanceItemList) DeepCopy() PtpInstanceItemList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoftwareDeployHostStatus.
+func (in *SoftwareDeployHostStatus) DeepCopy() *SoftwareDeployHostStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInstanceItemList)
+	out := new(SoftwareDeployHostStatus)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInstanceList) DeepCopyInto(out *PtpInstanceList) {
+func (in *SoftwareDeployList) DeepCopyInto(out *SoftwareDeployList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PtpInstance, len(*in))
+		*out = make([]SoftwareDeploy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceList.
-func (in *PtpInstanceList) DeepCopy() *PtpInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoftwareDeployList.
+func (in *SoftwareDeployList) DeepCopy() *SoftwareDeployList {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInstanceList)
+	out := new(SoftwareDeployList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PtpInstanceList) DeepCopyObject() runtime.Object {
+func (in *SoftwareDeployList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1660,47 +3850,170 @@ func (in *PtpInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInstanceSpec) DeepCopyInto(out *PtpInstanceSpec) {
+func (in *SoftwareDeploySpec) DeepCopyInto(out *SoftwareDeploySpec) {
 	*out = *in
-	if in.InstanceParameters != nil {
-		in, out := &in.InstanceParameters, &out.InstanceParameters
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaxParallelWorkerHosts != nil {
+		in, out := &in.MaxParallelWorkerHosts, &out.MaxParallelWorkerHosts
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceSpec.
-func (in *PtpInstanceSpec) DeepCopy() *PtpInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoftwareDeploySpec.
+func (in *SoftwareDeploySpec) DeepCopy() *SoftwareDeploySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SoftwareDeploySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SoftwareDeployStatus) DeepCopyInto(out *SoftwareDeployStatus) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]SoftwareDeployHostStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoftwareDeployStatus.
+func (in *SoftwareDeployStatus) DeepCopy() *SoftwareDeployStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SoftwareDeployStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SoftwareInfo) DeepCopyInto(out *SoftwareInfo) {
+	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = new(SoftwarePatchInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoftwareInfo.
+func (in *SoftwareInfo) DeepCopy() *SoftwareInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(SoftwareInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SoftwarePatchInfo) DeepCopyInto(out *SoftwarePatchInfo) {
+	*out = *in
+	if in.IDs != nil {
+		in, out := &in.IDs, &out.IDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoftwarePatchInfo.
+func (in *SoftwarePatchInfo) DeepCopy() *SoftwarePatchInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(SoftwarePatchInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageBackend) DeepCopyInto(out *StorageBackend) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReplicationFactor != nil {
+		in, out := &in.ReplicationFactor, &out.ReplicationFactor
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinReplicationFactor != nil {
+		in, out := &in.MinReplicationFactor, &out.MinReplicationFactor
+		*out = new(int)
+		**out = **in
+	}
+	if in.PoolQuotas != nil {
+		in, out := &in.PoolQuotas, &out.PoolQuotas
+		*out = new(CephPoolQuotaInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PartitionSize != nil {
+		in, out := &in.PartitionSize, &out.PartitionSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackend.
+func (in *StorageBackend) DeepCopy() *StorageBackend {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInstanceSpec)
+	out := new(StorageBackend)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInstanceStatus) DeepCopyInto(out *PtpInstanceStatus) {
-	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
+func (in StorageBackendList) DeepCopyInto(out *StorageBackendList) {
+	{
+		in := &in
+		*out = make(StorageBackendList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceStatus.
-func (in *PtpInstanceStatus) DeepCopy() *PtpInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackendList.
+func (in StorageBackendList) DeepCopy() StorageBackendList {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInstanceStatus)
+	out := new(StorageBackendList)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInterface) DeepCopyInto(out *PtpInterface) {
+func (in *Strategy) DeepCopyInto(out *Strategy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1708,18 +4021,18 @@ func (in *PtpInterface) DeepCopyInto(out *PtpInterface) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterface.
-func (in *PtpInterface) DeepCopy() *PtpInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Strategy.
+func (in *Strategy) DeepCopy() *Strategy {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInterface)
+	out := new(Strategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PtpInterface) DeepCopyObject() runtime.Object {
+func (in *Strategy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1727,50 +4040,31 @@ func (in *PtpInterface) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in PtpInterfaceItemList) DeepCopyInto(out *PtpInterfaceItemList) {
-	{
-		in := &in
-		*out = make(PtpInterfaceItemList, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceItemList.
-func (in PtpInterfaceItemList) DeepCopy() PtpInterfaceItemList {
-	if in == nil {
-		return nil
-	}
-	out := new(PtpInterfaceItemList)
-	in.DeepCopyInto(out)
-	return *out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInterfaceList) DeepCopyInto(out *PtpInterfaceList) {
+func (in *StrategyList) DeepCopyInto(out *StrategyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PtpInterface, len(*in))
+		*out = make([]Strategy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceList.
-func (in *PtpInterfaceList) DeepCopy() *PtpInterfaceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrategyList.
+func (in *StrategyList) DeepCopy() *StrategyList {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInterfaceList)
+	out := new(StrategyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PtpInterfaceList) DeepCopyObject() runtime.Object {
+func (in *StrategyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1778,186 +4072,191 @@ func (in *PtpInterfaceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInterfaceSpec) DeepCopyInto(out *PtpInterfaceSpec) {
+func (in *StrategySpec) DeepCopyInto(out *StrategySpec) {
 	*out = *in
-	if in.InterfaceParameters != nil {
-		in, out := &in.InterfaceParameters, &out.InterfaceParameters
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.ControllerApplyType != nil {
+		in, out := &in.ControllerApplyType, &out.ControllerApplyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageApplyType != nil {
+		in, out := &in.StorageApplyType, &out.StorageApplyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxParallelWorkerHosts != nil {
+		in, out := &in.MaxParallelWorkerHosts, &out.MaxParallelWorkerHosts
+		*out = new(int)
+		**out = **in
+	}
+	if in.ToVersion != nil {
+		in, out := &in.ToVersion, &out.ToVersion
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceSpec.
-func (in *PtpInterfaceSpec) DeepCopy() *PtpInterfaceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrategySpec.
+func (in *StrategySpec) DeepCopy() *StrategySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInterfaceSpec)
+	out := new(StrategySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInterfaceStatus) DeepCopyInto(out *PtpInterfaceStatus) {
+func (in *StrategyStatus) DeepCopyInto(out *StrategyStatus) {
 	*out = *in
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceStatus.
-func (in *PtpInterfaceStatus) DeepCopy() *PtpInterfaceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrategyStatus.
+func (in *StrategyStatus) DeepCopy() *StrategyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInterfaceStatus)
+	out := new(StrategyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteInfo) DeepCopyInto(out *RouteInfo) {
+func (in *Subcloud) DeepCopyInto(out *Subcloud) {
 	*out = *in
-	if in.Metric != nil {
-		in, out := &in.Metric, &out.Metric
-		*out = new(int)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteInfo.
-func (in *RouteInfo) DeepCopy() *RouteInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subcloud.
+func (in *Subcloud) DeepCopy() *Subcloud {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteInfo)
+	out := new(Subcloud)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in RouteList) DeepCopyInto(out *RouteList) {
-	{
-		in := &in
-		*out = make(RouteList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
-func (in RouteList) DeepCopy() RouteList {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Subcloud) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(RouteList)
-	in.DeepCopyInto(out)
-	return *out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceParameterInfo) DeepCopyInto(out *ServiceParameterInfo) {
+func (in *SubcloudList) DeepCopyInto(out *SubcloudList) {
 	*out = *in
-	if in.Personality != nil {
-		in, out := &in.Personality, &out.Personality
-		*out = new(string)
-		**out = **in
-	}
-	if in.Resource != nil {
-		in, out := &in.Resource, &out.Resource
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Subcloud, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceParameterInfo.
-func (in *ServiceParameterInfo) DeepCopy() *ServiceParameterInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubcloudList.
+func (in *SubcloudList) DeepCopy() *SubcloudList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceParameterInfo)
+	out := new(SubcloudList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ServiceParameterList) DeepCopyInto(out *ServiceParameterList) {
-	{
-		in := &in
-		*out = make(ServiceParameterList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceParameterList.
-func (in ServiceParameterList) DeepCopy() ServiceParameterList {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubcloudList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(ServiceParameterList)
-	in.DeepCopyInto(out)
-	return *out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StorageBackend) DeepCopyInto(out *StorageBackend) {
+func (in *SubcloudSpec) DeepCopyInto(out *SubcloudSpec) {
 	*out = *in
-	if in.Services != nil {
-		in, out := &in.Services, &out.Services
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.InstallValuesSecret != nil {
+		in, out := &in.InstallValuesSecret, &out.InstallValuesSecret
+		*out = new(string)
+		**out = **in
 	}
-	if in.ReplicationFactor != nil {
-		in, out := &in.ReplicationFactor, &out.ReplicationFactor
-		*out = new(int)
+	if in.DeployConfig != nil {
+		in, out := &in.DeployConfig, &out.DeployConfig
+		*out = new(string)
 		**out = **in
 	}
-	if in.PartitionSize != nil {
-		in, out := &in.PartitionSize, &out.PartitionSize
-		*out = new(int)
+	if in.Release != nil {
+		in, out := &in.Release, &out.Release
+		*out = new(string)
 		**out = **in
 	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackend.
-func (in *StorageBackend) DeepCopy() *StorageBackend {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubcloudSpec.
+func (in *SubcloudSpec) DeepCopy() *SubcloudSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(StorageBackend)
+	out := new(SubcloudSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in StorageBackendList) DeepCopyInto(out *StorageBackendList) {
-	{
-		in := &in
-		*out = make(StorageBackendList, len(*in))
+func (in *SubcloudStatus) DeepCopyInto(out *SubcloudStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackendList.
-func (in StorageBackendList) DeepCopy() StorageBackendList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubcloudStatus.
+func (in *SubcloudStatus) DeepCopy() *SubcloudStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(StorageBackendList)
+	out := new(SubcloudStatus)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -2022,6 +4321,11 @@ func (in *SystemList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 	*out = *in
+	if in.EndpointSecret != nil {
+		in, out := &in.EndpointSecret, &out.EndpointSecret
+		*out = new(string)
+		**out = **in
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -2047,6 +4351,11 @@ func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
 	if in.DNSServers != nil {
 		in, out := &in.DNSServers, &out.DNSServers
 		*out = new(DNSServerList)
@@ -2084,6 +4393,27 @@ func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 		*out = new(LicenseInfo)
 		**out = **in
 	}
+	if in.SNMP != nil {
+		in, out := &in.SNMP, &out.SNMP
+		*out = new(SNMPInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteAuthentication != nil {
+		in, out := &in.RemoteAuthentication, &out.RemoteAuthentication
+		*out = new(RemoteAuthenticationInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = new(RegistryList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(RegistryList, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
 	if in.ServiceParameters != nil {
 		in, out := &in.ServiceParameters, &out.ServiceParameters
 		*out = new(ServiceParameterList)
@@ -2105,6 +4435,21 @@ func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = new(BootstrapInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Software != nil {
+		in, out := &in.Software, &out.Software
+		*out = new(SoftwareInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemSpec.
@@ -2125,6 +4470,40 @@ func (in *SystemStatus) DeepCopyInto(out *SystemStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DeltaAttributes != nil {
+		in, out := &in.DeltaAttributes, &out.DeltaAttributes
+		*out = make([]DeltaAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(LicenseStatus)
+		**out = **in
+	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]RegistryStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Alarms != nil {
+		in, out := &in.Alarms, &out.Alarms
+		*out = make([]AlarmStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemStatus.
@@ -2261,6 +4640,26 @@ func (in VLANList) DeepCopy() VLANList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMediaInfo) DeepCopyInto(out *VirtualMediaInfo) {
+	*out = *in
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMediaInfo.
+func (in *VirtualMediaInfo) DeepCopy() *VirtualMediaInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMediaInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeGroupInfo) DeepCopyInto(out *VolumeGroupInfo) {
 	*out = *in
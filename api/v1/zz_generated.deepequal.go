@@ -54,6 +54,38 @@ func (in *AddressList) DeepEqual(other *AddressList) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *AlarmStatus) DeepEqual(other *AlarmStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.ID != other.ID {
+		return false
+	}
+	if in.AlarmID != other.AlarmID {
+		return false
+	}
+	if in.Severity != other.Severity {
+		return false
+	}
+	if in.EntityInstanceID != other.EntityInstanceID {
+		return false
+	}
+	if in.Reason != other.Reason {
+		return false
+	}
+	if in.ManagementAffecting != other.ManagementAffecting {
+		return false
+	}
+	if in.Timestamp != other.Timestamp {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *AllocationInfo) DeepEqual(other *AllocationInfo) bool {
@@ -166,6 +198,16 @@ func (in *BMInfo) DeepEqual(other *BMInfo) bool {
 		}
 	}
 
+	if in.Insecure != nil {
+		if (in.Insecure == nil) != (other.Insecure == nil) {
+			return false
+		} else if in.Insecure != nil {
+			if *in.Insecure != *other.Insecure {
+				return false
+			}
+		}
+	}
+
 	return true
 }
 
@@ -246,6 +288,148 @@ func (in *BondList) DeepEqual(other *BondList) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *BootstrapHealthCheckInfo) DeepEqual(other *BootstrapHealthCheckInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Phase != other.Phase {
+		return false
+	}
+	if (in.AlarmSeverity == nil) != (other.AlarmSeverity == nil) {
+		return false
+	} else if in.AlarmSeverity != nil {
+		if *in.AlarmSeverity != *other.AlarmSeverity {
+			return false
+		}
+	}
+
+	if ((in.IgnoredAlarms != nil) && (other.IgnoredAlarms != nil)) || ((in.IgnoredAlarms == nil) != (other.IgnoredAlarms == nil)) {
+		in, other := &in.IgnoredAlarms, &other.IgnoredAlarms
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *BootstrapHealthCheckList) DeepEqual(other *BootstrapHealthCheckList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *BootstrapInfo) DeepEqual(other *BootstrapInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.HealthChecks != nil) && (other.HealthChecks != nil)) || ((in.HealthChecks == nil) != (other.HealthChecks == nil)) {
+		in, other := &in.HealthChecks, &other.HealthChecks
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *CephPoolQuotaInfo) DeepEqual(other *CephPoolQuotaInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Cinder != nil {
+		if (in.Cinder == nil) != (other.Cinder == nil) {
+			return false
+		} else if in.Cinder != nil {
+			if *in.Cinder != *other.Cinder {
+				return false
+			}
+		}
+	}
+
+	if in.Glance != nil {
+		if (in.Glance == nil) != (other.Glance == nil) {
+			return false
+		} else if in.Glance != nil {
+			if *in.Glance != *other.Glance {
+				return false
+			}
+		}
+	}
+
+	if in.Ephemeral != nil {
+		if (in.Ephemeral == nil) != (other.Ephemeral == nil) {
+			return false
+		} else if in.Ephemeral != nil {
+			if *in.Ephemeral != *other.Ephemeral {
+				return false
+			}
+		}
+	}
+
+	if in.Object != nil {
+		if (in.Object == nil) != (other.Object == nil) {
+			return false
+		} else if in.Object != nil {
+			if *in.Object != *other.Object {
+				return false
+			}
+		}
+	}
+
+	if in.Kubernetes != nil {
+		if (in.Kubernetes == nil) != (other.Kubernetes == nil) {
+			return false
+		} else if in.Kubernetes != nil {
+			if *in.Kubernetes != *other.Kubernetes {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *CertificateList) DeepEqual(other *CertificateList) bool {
@@ -273,6 +457,39 @@ func (in *CertificateList) DeepEqual(other *CertificateList) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *CertificateStatus) DeepEqual(other *CertificateStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Type != other.Type {
+		return false
+	}
+	if in.Secret != other.Secret {
+		return false
+	}
+	if in.Certificate != other.Certificate {
+		return false
+	}
+	if in.ResourceVersion != other.ResourceVersion {
+		return false
+	}
+	if in.Signature != other.Signature {
+		return false
+	}
+	if (in.NotAfter == nil) != (other.NotAfter == nil) {
+		return false
+	} else if in.NotAfter != nil {
+		if !in.NotAfter.Equal(other.NotAfter) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *CommonInterfaceInfo) DeepEqual(other *CommonInterfaceInfo) bool {
@@ -339,6 +556,85 @@ func (in *CommonInterfaceInfo) DeepEqual(other *CommonInterfaceInfo) bool {
 		}
 	}
 
+	if in.IPv4Mode != nil {
+		if (in.IPv4Mode == nil) != (other.IPv4Mode == nil) {
+			return false
+		} else if in.IPv4Mode != nil {
+			if *in.IPv4Mode != *other.IPv4Mode {
+				return false
+			}
+		}
+	}
+
+	if in.IPv4Pool != nil {
+		if (in.IPv4Pool == nil) != (other.IPv4Pool == nil) {
+			return false
+		} else if in.IPv4Pool != nil {
+			if *in.IPv4Pool != *other.IPv4Pool {
+				return false
+			}
+		}
+	}
+
+	if in.IPv6Mode != nil {
+		if (in.IPv6Mode == nil) != (other.IPv6Mode == nil) {
+			return false
+		} else if in.IPv6Mode != nil {
+			if *in.IPv6Mode != *other.IPv6Mode {
+				return false
+			}
+		}
+	}
+
+	if in.IPv6Pool != nil {
+		if (in.IPv6Pool == nil) != (other.IPv6Pool == nil) {
+			return false
+		} else if in.IPv6Pool != nil {
+			if *in.IPv6Pool != *other.IPv6Pool {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ConsoleCaptureInfo) DeepEqual(other *ConsoleCaptureInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Destination == nil) != (other.Destination == nil) {
+		return false
+	} else if in.Destination != nil {
+		if *in.Destination != *other.Destination {
+			return false
+		}
+	}
+	if (in.MaxSize == nil) != (other.MaxSize == nil) {
+		return false
+	} else if in.MaxSize != nil {
+		if *in.MaxSize != *other.MaxSize {
+			return false
+		}
+	}
+	if (in.HostKey == nil) != (other.HostKey == nil) {
+		return false
+	} else if in.HostKey != nil {
+		if *in.HostKey != *other.HostKey {
+			return false
+		}
+	}
+	if (in.Insecure == nil) != (other.Insecure == nil) {
+		return false
+	} else if in.Insecure != nil {
+		if *in.Insecure != *other.Insecure {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -396,15 +692,8 @@ func (in *DNSServerList) DeepEqual(other *DNSServerList) bool {
 	if len(*in) != len(*other) {
 		return false
 	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement == otherElement {
-					found = true
-					break
-				}
-			}
-			if !found {
+		for i, inElement := range *in {
+			if inElement != (*other)[i] {
 				return false
 			}
 		}
@@ -427,6 +716,23 @@ func (in *DRBDConfiguration) DeepEqual(other *DRBDConfiguration) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *DataNetworkAttachment) DeepEqual(other *DataNetworkAttachment) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Host != other.Host {
+		return false
+	}
+	if in.Interface != other.Interface {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *DataNetworkItemList) DeepEqual(other *DataNetworkItemList) bool {
@@ -528,19 +834,114 @@ func (in *DataNetworkStatus) DeepEqual(other *DataNetworkStatus) bool {
 		return false
 	}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *ErrMissingSystemResource) DeepEqual(other *ErrMissingSystemResource) bool {
-	if other == nil {
-		return false
-	}
-
-	if in.message != other.message {
-		return false
-	}
+	if ((in.DeltaAttributes != nil) && (other.DeltaAttributes != nil)) || ((in.DeltaAttributes == nil) != (other.DeltaAttributes == nil)) {
+		in, other := &in.DeltaAttributes, &other.DeltaAttributes
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.Attachments != nil) && (other.Attachments != nil)) || ((in.Attachments == nil) != (other.Attachments == nil)) {
+		in, other := &in.Attachments, &other.Attachments
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ErrMissingSystemResource) DeepEqual(other *ErrMissingSystemResource) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.message != other.message {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *DeltaAttribute) DeepEqual(other *DeltaAttribute) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Path != other.Path {
+		return false
+	}
+	if in.Expected != other.Expected {
+		return false
+	}
+	if in.Actual != other.Actual {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *DeviceInfo) DeepEqual(other *DeviceInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.FEC != nil) && (other.FEC != nil)) || ((in.FEC == nil) != (other.FEC == nil)) {
+		in, other := &in.FEC, &other.FEC
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	if ((in.PCI != nil) && (other.PCI != nil)) || ((in.PCI == nil) != (other.PCI == nil)) {
+		in, other := &in.PCI, &other.PCI
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -624,6 +1025,116 @@ func (in *EthernetPortInfo) DeepEqual(other *EthernetPortInfo) bool {
 	if in.Name != other.Name {
 		return false
 	}
+	if in.PCIAddress != nil {
+		if (in.PCIAddress == nil) != (other.PCIAddress == nil) {
+			return false
+		} else if in.PCIAddress != nil {
+			if *in.PCIAddress != *other.PCIAddress {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *FECDeviceInfo) DeepEqual(other *FECDeviceInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.PCIAddress != other.PCIAddress {
+		return false
+	}
+	if in.Enabled != nil {
+		if (in.Enabled == nil) != (other.Enabled == nil) {
+			return false
+		} else if in.Enabled != nil {
+			if *in.Enabled != *other.Enabled {
+				return false
+			}
+		}
+	}
+
+	if in.Driver != nil {
+		if (in.Driver == nil) != (other.Driver == nil) {
+			return false
+		} else if in.Driver != nil {
+			if *in.Driver != *other.Driver {
+				return false
+			}
+		}
+	}
+
+	if in.VFCount != nil {
+		if (in.VFCount == nil) != (other.VFCount == nil) {
+			return false
+		} else if in.VFCount != nil {
+			if *in.VFCount != *other.VFCount {
+				return false
+			}
+		}
+	}
+
+	if in.VFDriver != nil {
+		if (in.VFDriver == nil) != (other.VFDriver == nil) {
+			return false
+		} else if in.VFDriver != nil {
+			if *in.VFDriver != *other.VFDriver {
+				return false
+			}
+		}
+	}
+
+	if in.Labels != nil {
+		in, other := &in.Labels, &other.Labels
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for key, inValue := range *in {
+				if otherValue, present := (*other)[key]; !present {
+					return false
+				} else {
+					if inValue != otherValue {
+						return false
+					}
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *FECDeviceList) DeepEqual(other *FECDeviceList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
 
 	return true
 }
@@ -674,33 +1185,41 @@ func (in *FileSystemList) DeepEqual(other *FileSystemList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *HostProfileSpec) DeepEqual(other *HostProfileSpec) bool {
+func (in *HostBMCredentialsStatus) DeepEqual(other *HostBMCredentialsStatus) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.Base == nil) != (other.Base == nil) {
+	if in.Secret != other.Secret {
+		return false
+	}
+	if in.ResourceVersion != other.ResourceVersion {
 		return false
-	} else if in.Base != nil {
-		if *in.Base != *other.Base {
-			return false
-		}
 	}
 
-	if !in.ProfileBaseAttributes.DeepEqual(&other.ProfileBaseAttributes) {
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostClockSyncStatus) DeepEqual(other *HostClockSyncStatus) bool {
+	if other == nil {
 		return false
 	}
 
-	if (in.BoardManagement == nil) != (other.BoardManagement == nil) {
+	if in.State != other.State {
 		return false
-	} else if in.BoardManagement != nil {
-		if !in.BoardManagement.DeepEqual(other.BoardManagement) {
+	}
+	if (in.GNSS == nil) != (other.GNSS == nil) {
+		return false
+	} else if in.GNSS != nil {
+		if *in.GNSS != *other.GNSS {
 			return false
 		}
 	}
 
-	if ((in.Processors != nil) && (other.Processors != nil)) || ((in.Processors == nil) != (other.Processors == nil)) {
-		in, other := &in.Processors, &other.Processors
+	if ((in.Instances != nil) && (other.Instances != nil)) || ((in.Instances == nil) != (other.Instances == nil)) {
+		in, other := &in.Instances, &other.Instances
 		if other == nil {
 			return false
 		}
@@ -708,23 +1227,49 @@ func (in *HostProfileSpec) DeepEqual(other *HostProfileSpec) bool {
 		if len(*in) != len(*other) {
 			return false
 		} else {
-			for _, inElement := range *in {
-				found := false
-				for _, otherElement := range *other {
-					if inElement.DeepEqual(&otherElement) {
-						found = true
-						break
-					}
-				}
-				if !found {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
 					return false
 				}
 			}
 		}
 	}
 
-	if ((in.Memory != nil) && (other.Memory != nil)) || ((in.Memory == nil) != (other.Memory == nil)) {
-		in, other := &in.Memory, &other.Memory
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostDiskHardwareStatus) DeepEqual(other *HostDiskHardwareStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Path != other.Path {
+		return false
+	}
+	if in.Node != other.Node {
+		return false
+	}
+	if in.SizeMiB != other.SizeMiB {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostHardwareStatus) DeepEqual(other *HostHardwareStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.NUMANodes != other.NUMANodes {
+		return false
+	}
+	if ((in.Nodes != nil) && (other.Nodes != nil)) || ((in.Nodes == nil) != (other.Nodes == nil)) {
+		in, other := &in.Nodes, &other.Nodes
 		if other == nil {
 			return false
 		}
@@ -732,46 +1277,39 @@ func (in *HostProfileSpec) DeepEqual(other *HostProfileSpec) bool {
 		if len(*in) != len(*other) {
 			return false
 		} else {
-			for _, inElement := range *in {
-				found := false
-				for _, otherElement := range *other {
-					if inElement.DeepEqual(&otherElement) {
-						found = true
-						break
-					}
-				}
-				if !found {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
 					return false
 				}
 			}
 		}
 	}
 
-	if (in.Storage == nil) != (other.Storage == nil) {
+	if in.DiskCount != other.DiskCount {
 		return false
-	} else if in.Storage != nil {
-		if !in.Storage.DeepEqual(other.Storage) {
-			return false
-		}
 	}
-
-	if (in.Interfaces == nil) != (other.Interfaces == nil) {
+	if in.DiskCapacityMiB != other.DiskCapacityMiB {
 		return false
-	} else if in.Interfaces != nil {
-		if !in.Interfaces.DeepEqual(other.Interfaces) {
+	}
+	if ((in.Disks != nil) && (other.Disks != nil)) || ((in.Disks == nil) != (other.Disks == nil)) {
+		in, other := &in.Disks, &other.Disks
+		if other == nil {
 			return false
 		}
-	}
 
-	if ((in.Addresses != nil) && (other.Addresses != nil)) || ((in.Addresses == nil) != (other.Addresses == nil)) {
-		in, other := &in.Addresses, &other.Addresses
-		if other == nil || !in.DeepEqual(other) {
+		if len(*in) != len(*other) {
 			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
 		}
 	}
 
-	if ((in.Routes != nil) && (other.Routes != nil)) || ((in.Routes == nil) != (other.Routes == nil)) {
-		in, other := &in.Routes, &other.Routes
+	if ((in.NICModels != nil) && (other.NICModels != nil)) || ((in.NICModels == nil) != (other.NICModels == nil)) {
+		in, other := &in.NICModels, &other.NICModels
 		if other == nil {
 			return false
 		}
@@ -779,15 +1317,8 @@ func (in *HostProfileSpec) DeepEqual(other *HostProfileSpec) bool {
 		if len(*in) != len(*other) {
 			return false
 		} else {
-			for _, inElement := range *in {
-				found := false
-				for _, otherElement := range *other {
-					if inElement.DeepEqual(&otherElement) {
-						found = true
-						break
-					}
-				}
-				if !found {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
 					return false
 				}
 			}
@@ -799,28 +1330,16 @@ func (in *HostProfileSpec) DeepEqual(other *HostProfileSpec) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *HostSpec) DeepEqual(other *HostSpec) bool {
+func (in *HostKernelStatus) DeepEqual(other *HostKernelStatus) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Profile != other.Profile {
-		return false
-	}
-	if (in.Match == nil) != (other.Match == nil) {
+	if in.Provisioned != other.Provisioned {
 		return false
-	} else if in.Match != nil {
-		if !in.Match.DeepEqual(other.Match) {
-			return false
-		}
 	}
-
-	if (in.Overrides == nil) != (other.Overrides == nil) {
+	if in.Running != other.Running {
 		return false
-	} else if in.Overrides != nil {
-		if !in.Overrides.DeepEqual(other.Overrides) {
-			return false
-		}
 	}
 
 	return true
@@ -828,76 +1347,38 @@ func (in *HostSpec) DeepEqual(other *HostSpec) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *HostStatus) DeepEqual(other *HostStatus) bool {
+func (in *HostNodeHardwareStatus) DeepEqual(other *HostNodeHardwareStatus) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.ID == nil) != (other.ID == nil) {
+	if in.Node != other.Node {
 		return false
-	} else if in.ID != nil {
-		if *in.ID != *other.ID {
-			return false
-		}
 	}
-
-	if (in.AdministrativeState == nil) != (other.AdministrativeState == nil) {
+	if in.PhysicalCores != other.PhysicalCores {
 		return false
-	} else if in.AdministrativeState != nil {
-		if *in.AdministrativeState != *other.AdministrativeState {
-			return false
-		}
 	}
-
-	if (in.OperationalStatus == nil) != (other.OperationalStatus == nil) {
+	if in.LogicalCores != other.LogicalCores {
 		return false
-	} else if in.OperationalStatus != nil {
-		if *in.OperationalStatus != *other.OperationalStatus {
-			return false
-		}
 	}
-
-	if (in.AvailabilityStatus == nil) != (other.AvailabilityStatus == nil) {
+	if in.MemoryMiB != other.MemoryMiB {
 		return false
-	} else if in.AvailabilityStatus != nil {
-		if *in.AvailabilityStatus != *other.AvailabilityStatus {
-			return false
-		}
 	}
 
-	if (in.Defaults == nil) != (other.Defaults == nil) {
-		return false
-	} else if in.Defaults != nil {
-		if *in.Defaults != *other.Defaults {
-			return false
-		}
-	}
+	return true
+}
 
-	if in.Reconciled != other.Reconciled {
-		return false
-	}
-	if in.InSync != other.InSync {
-		return false
-	}
-	if in.DeploymentScope != other.DeploymentScope {
-		return false
-	}
-	if in.ObservedHostProfileGeneration != other.ObservedHostProfileGeneration {
-		return false
-	}
-	if in.ObservedGeneration != other.ObservedGeneration {
-		return false
-	}
-	if in.HostProfileConfigurationUpdated != other.HostProfileConfigurationUpdated {
-		return false
-	}
-	if in.ConfigurationUpdated != other.ConfigurationUpdated {
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostPTPInstanceSyncStatus) DeepEqual(other *HostPTPInstanceSyncStatus) bool {
+	if other == nil {
 		return false
 	}
-	if in.StrategyRequired != other.StrategyRequired {
+
+	if in.Name != other.Name {
 		return false
 	}
-	if in.Delta != other.Delta {
+	if in.State != other.State {
 		return false
 	}
 
@@ -906,20 +1387,50 @@ func (in *HostStatus) DeepEqual(other *HostStatus) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *InterfaceInfo) DeepEqual(other *InterfaceInfo) bool {
+func (in *HostProfileSpec) DeepEqual(other *HostProfileSpec) bool {
 	if other == nil {
 		return false
 	}
 
-	if ((in.Ethernet != nil) && (other.Ethernet != nil)) || ((in.Ethernet == nil) != (other.Ethernet == nil)) {
-		in, other := &in.Ethernet, &other.Ethernet
-		if other == nil || !in.DeepEqual(other) {
+	if (in.Base == nil) != (other.Base == nil) {
+		return false
+	} else if in.Base != nil {
+		if *in.Base != *other.Base {
 			return false
 		}
 	}
 
-	if ((in.VLAN != nil) && (other.VLAN != nil)) || ((in.VLAN == nil) != (other.VLAN == nil)) {
-		in, other := &in.VLAN, &other.VLAN
+	if ((in.Mixins != nil) && (other.Mixins != nil)) || ((in.Mixins == nil) != (other.Mixins == nil)) {
+		in, other := &in.Mixins, &other.Mixins
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if !in.ProfileBaseAttributes.DeepEqual(&other.ProfileBaseAttributes) {
+		return false
+	}
+
+	if (in.BoardManagement == nil) != (other.BoardManagement == nil) {
+		return false
+	} else if in.BoardManagement != nil {
+		if !in.BoardManagement.DeepEqual(other.BoardManagement) {
+			return false
+		}
+	}
+
+	if ((in.Processors != nil) && (other.Processors != nil)) || ((in.Processors == nil) != (other.Processors == nil)) {
+		in, other := &in.Processors, &other.Processors
 		if other == nil {
 			return false
 		}
@@ -942,15 +1453,8 @@ func (in *InterfaceInfo) DeepEqual(other *InterfaceInfo) bool {
 		}
 	}
 
-	if ((in.Bond != nil) && (other.Bond != nil)) || ((in.Bond == nil) != (other.Bond == nil)) {
-		in, other := &in.Bond, &other.Bond
-		if other == nil || !in.DeepEqual(other) {
-			return false
-		}
-	}
-
-	if ((in.VF != nil) && (other.VF != nil)) || ((in.VF == nil) != (other.VF == nil)) {
-		in, other := &in.VF, &other.VF
+	if ((in.Memory != nil) && (other.Memory != nil)) || ((in.Memory == nil) != (other.Memory == nil)) {
+		in, other := &in.Memory, &other.Memory
 		if other == nil {
 			return false
 		}
@@ -973,47 +1477,83 @@ func (in *InterfaceInfo) DeepEqual(other *InterfaceInfo) bool {
 		}
 	}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *JournalInfo) DeepEqual(other *JournalInfo) bool {
-	if other == nil {
+	if (in.Storage == nil) != (other.Storage == nil) {
 		return false
+	} else if in.Storage != nil {
+		if !in.Storage.DeepEqual(other.Storage) {
+			return false
+		}
 	}
 
-	if in.Location != other.Location {
+	if (in.Interfaces == nil) != (other.Interfaces == nil) {
 		return false
+	} else if in.Interfaces != nil {
+		if !in.Interfaces.DeepEqual(other.Interfaces) {
+			return false
+		}
 	}
-	if in.Size != other.Size {
-		return false
+
+	if ((in.Addresses != nil) && (other.Addresses != nil)) || ((in.Addresses == nil) != (other.Addresses == nil)) {
+		in, other := &in.Addresses, &other.Addresses
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
 	}
 
-	return true
-}
+	if ((in.Routes != nil) && (other.Routes != nil)) || ((in.Routes == nil) != (other.Routes == nil)) {
+		in, other := &in.Routes, &other.Routes
+		if other == nil {
+			return false
+		}
 
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *MatchBMInfo) DeepEqual(other *MatchBMInfo) bool {
-	if other == nil {
-		return false
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
 	}
 
-	if (in.Address == nil) != (other.Address == nil) {
+	if (in.Devices == nil) != (other.Devices == nil) {
 		return false
-	} else if in.Address != nil {
-		if *in.Address != *other.Address {
+	} else if in.Devices != nil {
+		if !in.Devices.DeepEqual(other.Devices) {
 			return false
 		}
 	}
 
-	if (in.Type == nil) != (other.Type == nil) {
-		return false
-	} else if in.Type != nil {
-		if *in.Type != *other.Type {
+	if ((in.SensorGroups != nil) && (other.SensorGroups != nil)) || ((in.SensorGroups == nil) != (other.SensorGroups == nil)) {
+		in, other := &in.SensorGroups, &other.SensorGroups
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	if ((in.IgnoreFields != nil) && (other.IgnoreFields != nil)) || ((in.IgnoreFields == nil) != (other.IgnoreFields == nil)) {
+		in, other := &in.IgnoreFields, &other.IgnoreFields
+		if other == nil {
 			return false
 		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
 	}
 
 	return true
@@ -1021,58 +1561,1245 @@ func (in *MatchBMInfo) DeepEqual(other *MatchBMInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *MatchDMIInfo) DeepEqual(other *MatchDMIInfo) bool {
+func (in *HostSpec) DeepEqual(other *HostSpec) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.SerialNumber == nil) != (other.SerialNumber == nil) {
+	if in.Profile != other.Profile {
 		return false
-	} else if in.SerialNumber != nil {
-		if *in.SerialNumber != *other.SerialNumber {
+	}
+	if (in.CloneFrom == nil) != (other.CloneFrom == nil) {
+		return false
+	} else if in.CloneFrom != nil {
+		if *in.CloneFrom != *other.CloneFrom {
 			return false
 		}
 	}
 
-	if (in.AssetTag == nil) != (other.AssetTag == nil) {
+	if (in.Match == nil) != (other.Match == nil) {
 		return false
-	} else if in.AssetTag != nil {
-		if *in.AssetTag != *other.AssetTag {
+	} else if in.Match != nil {
+		if !in.Match.DeepEqual(other.Match) {
 			return false
 		}
 	}
 
+	if (in.Overrides == nil) != (other.Overrides == nil) {
+		return false
+	} else if in.Overrides != nil {
+		if !in.Overrides.DeepEqual(other.Overrides) {
+			return false
+		}
+	}
+
+	if (in.VirtualMedia == nil) != (other.VirtualMedia == nil) {
+		return false
+	} else if in.VirtualMedia != nil {
+		if !in.VirtualMedia.DeepEqual(other.VirtualMedia) {
+			return false
+		}
+	}
+
+	if (in.PowerState == nil) != (other.PowerState == nil) {
+		return false
+	} else if in.PowerState != nil {
+		if *in.PowerState != *other.PowerState {
+			return false
+		}
+	}
+
+	if (in.ConsoleCapture == nil) != (other.ConsoleCapture == nil) {
+		return false
+	} else if in.ConsoleCapture != nil {
+		if !in.ConsoleCapture.DeepEqual(other.ConsoleCapture) {
+			return false
+		}
+	}
+
+	if (in.DeletionPolicy == nil) != (other.DeletionPolicy == nil) {
+		return false
+	} else if in.DeletionPolicy != nil {
+		if *in.DeletionPolicy != *other.DeletionPolicy {
+			return false
+		}
+	}
+
+	if (in.ForceUnlock == nil) != (other.ForceUnlock == nil) {
+		return false
+	} else if in.ForceUnlock != nil {
+		if *in.ForceUnlock != *other.ForceUnlock {
+			return false
+		}
+	}
+
+	if (in.ReconcilePolicy == nil) != (other.ReconcilePolicy == nil) {
+		return false
+	} else if in.ReconcilePolicy != nil {
+		if !in.ReconcilePolicy.DeepEqual(other.ReconcilePolicy) {
+			return false
+		}
+	}
+
+	if (in.Preflight == nil) != (other.Preflight == nil) {
+		return false
+	} else if in.Preflight != nil {
+		if !in.Preflight.DeepEqual(other.Preflight) {
+			return false
+		}
+	}
+
+	if (in.AllowReboot == nil) != (other.AllowReboot == nil) {
+		return false
+	} else if in.AllowReboot != nil {
+		if *in.AllowReboot != *other.AllowReboot {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostStatus) DeepEqual(other *HostStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.ID == nil) != (other.ID == nil) {
+		return false
+	} else if in.ID != nil {
+		if *in.ID != *other.ID {
+			return false
+		}
+	}
+
+	if (in.AdministrativeState == nil) != (other.AdministrativeState == nil) {
+		return false
+	} else if in.AdministrativeState != nil {
+		if *in.AdministrativeState != *other.AdministrativeState {
+			return false
+		}
+	}
+
+	if (in.OperationalStatus == nil) != (other.OperationalStatus == nil) {
+		return false
+	} else if in.OperationalStatus != nil {
+		if *in.OperationalStatus != *other.OperationalStatus {
+			return false
+		}
+	}
+
+	if (in.AvailabilityStatus == nil) != (other.AvailabilityStatus == nil) {
+		return false
+	} else if in.AvailabilityStatus != nil {
+		if *in.AvailabilityStatus != *other.AvailabilityStatus {
+			return false
+		}
+	}
+
+	if (in.Personality == nil) != (other.Personality == nil) {
+		return false
+	} else if in.Personality != nil {
+		if *in.Personality != *other.Personality {
+			return false
+		}
+	}
+
+	if (in.Defaults == nil) != (other.Defaults == nil) {
+		return false
+	} else if in.Defaults != nil {
+		if *in.Defaults != *other.Defaults {
+			return false
+		}
+	}
+
+	if in.Reconciled != other.Reconciled {
+		return false
+	}
+	if in.InSync != other.InSync {
+		return false
+	}
+	if in.DeploymentScope != other.DeploymentScope {
+		return false
+	}
+	if (in.SubsectionScopes == nil) != (other.SubsectionScopes == nil) {
+		return false
+	} else if in.SubsectionScopes != nil {
+		if !in.SubsectionScopes.DeepEqual(other.SubsectionScopes) {
+			return false
+		}
+	}
+
+	if in.ObservedHostProfileGeneration != other.ObservedHostProfileGeneration {
+		return false
+	}
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+	if in.HostProfileConfigurationUpdated != other.HostProfileConfigurationUpdated {
+		return false
+	}
+	if in.ConfigurationUpdated != other.ConfigurationUpdated {
+		return false
+	}
+	if in.StrategyRequired != other.StrategyRequired {
+		return false
+	}
+	if in.Delta != other.Delta {
+		return false
+	}
+
+	if ((in.DeltaAttributes != nil) && (other.DeltaAttributes != nil)) || ((in.DeltaAttributes == nil) != (other.DeltaAttributes == nil)) {
+		in, other := &in.DeltaAttributes, &other.DeltaAttributes
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+	if ((in.ManagedAddresses != nil) && (other.ManagedAddresses != nil)) || ((in.ManagedAddresses == nil) != (other.ManagedAddresses == nil)) {
+		in, other := &in.ManagedAddresses, &other.ManagedAddresses
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if (in.Kernel == nil) != (other.Kernel == nil) {
+		return false
+	} else if in.Kernel != nil {
+		if !in.Kernel.DeepEqual(other.Kernel) {
+			return false
+		}
+	}
+
+	if (in.Hardware == nil) != (other.Hardware == nil) {
+		return false
+	} else if in.Hardware != nil {
+		if !in.Hardware.DeepEqual(other.Hardware) {
+			return false
+		}
+	}
+
+	if (in.ClockSync == nil) != (other.ClockSync == nil) {
+		return false
+	} else if in.ClockSync != nil {
+		if !in.ClockSync.DeepEqual(other.ClockSync) {
+			return false
+		}
+	}
+
+	if (in.PowerState == nil) != (other.PowerState == nil) {
+		return false
+	} else if in.PowerState != nil {
+		if *in.PowerState != *other.PowerState {
+			return false
+		}
+	}
+
+	if (in.InstallState == nil) != (other.InstallState == nil) {
+		return false
+	} else if in.InstallState != nil {
+		if *in.InstallState != *other.InstallState {
+			return false
+		}
+	}
+
+	if (in.InstallStateInfo == nil) != (other.InstallStateInfo == nil) {
+		return false
+	} else if in.InstallStateInfo != nil {
+		if *in.InstallStateInfo != *other.InstallStateInfo {
+			return false
+		}
+	}
+
+	if (in.BootTime == nil) != (other.BootTime == nil) {
+		return false
+	} else if in.BootTime != nil {
+		if !in.BootTime.Equal(other.BootTime) {
+			return false
+		}
+	}
+
+	if (in.SoftwareVersion == nil) != (other.SoftwareVersion == nil) {
+		return false
+	} else if in.SoftwareVersion != nil {
+		if *in.SoftwareVersion != *other.SoftwareVersion {
+			return false
+		}
+	}
+
+	if (in.BMCredentials == nil) != (other.BMCredentials == nil) {
+		return false
+	} else if in.BMCredentials != nil {
+		if !in.BMCredentials.DeepEqual(other.BMCredentials) {
+			return false
+		}
+	}
+
+	if in.UnlockAttempts != other.UnlockAttempts {
+		return false
+	}
+
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostSubsectionScopes) DeepEqual(other *HostSubsectionScopes) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Storage == nil) != (other.Storage == nil) {
+		return false
+	} else if in.Storage != nil {
+		if *in.Storage != *other.Storage {
+			return false
+		}
+	}
+
+	if (in.Interfaces == nil) != (other.Interfaces == nil) {
+		return false
+	} else if in.Interfaces != nil {
+		if *in.Interfaces != *other.Interfaces {
+			return false
+		}
+	}
+
+	if (in.PTP == nil) != (other.PTP == nil) {
+		return false
+	} else if in.PTP != nil {
+		if *in.PTP != *other.PTP {
+			return false
+		}
+	}
+
+	if (in.Kernel == nil) != (other.Kernel == nil) {
+		return false
+	} else if in.Kernel != nil {
+		if *in.Kernel != *other.Kernel {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *InterfaceInfo) DeepEqual(other *InterfaceInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.Ethernet != nil) && (other.Ethernet != nil)) || ((in.Ethernet == nil) != (other.Ethernet == nil)) {
+		in, other := &in.Ethernet, &other.Ethernet
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	if ((in.VLAN != nil) && (other.VLAN != nil)) || ((in.VLAN == nil) != (other.VLAN == nil)) {
+		in, other := &in.VLAN, &other.VLAN
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.Bond != nil) && (other.Bond != nil)) || ((in.Bond == nil) != (other.Bond == nil)) {
+		in, other := &in.Bond, &other.Bond
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	if ((in.VF != nil) && (other.VF != nil)) || ((in.VF == nil) != (other.VF == nil)) {
+		in, other := &in.VF, &other.VF
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *JournalInfo) DeepEqual(other *JournalInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Location != other.Location {
+		return false
+	}
+	if in.Size != other.Size {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *LDAPDomainInfo) DeepEqual(other *LDAPDomainInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.DomainName != other.DomainName {
+		return false
+	}
+	if in.URI != other.URI {
+		return false
+	}
+	if in.SearchBase != other.SearchBase {
+		return false
+	}
+	if (in.UserSearchBase == nil) != (other.UserSearchBase == nil) {
+		return false
+	} else if in.UserSearchBase != nil {
+		if *in.UserSearchBase != *other.UserSearchBase {
+			return false
+		}
+	}
+
+	if (in.GroupSearchBase == nil) != (other.GroupSearchBase == nil) {
+		return false
+	} else if in.GroupSearchBase != nil {
+		if *in.GroupSearchBase != *other.GroupSearchBase {
+			return false
+		}
+	}
+
+	if (in.AccessFilter == nil) != (other.AccessFilter == nil) {
+		return false
+	} else if in.AccessFilter != nil {
+		if *in.AccessFilter != *other.AccessFilter {
+			return false
+		}
+	}
+
+	if (in.Secret == nil) != (other.Secret == nil) {
+		return false
+	} else if in.Secret != nil {
+		if *in.Secret != *other.Secret {
+			return false
+		}
+	}
+
+	if ((in.Parameters != nil) && (other.Parameters != nil)) || ((in.Parameters == nil) != (other.Parameters == nil)) {
+		in, other := &in.Parameters, &other.Parameters
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for key, inValue := range *in {
+				if otherValue, present := (*other)[key]; !present {
+					return false
+				} else {
+					if inValue != otherValue {
+						return false
+					}
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *LicenseStatus) DeepEqual(other *LicenseStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Secret != other.Secret {
+		return false
+	}
+	if in.ResourceVersion != other.ResourceVersion {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MatchBMInfo) DeepEqual(other *MatchBMInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Address == nil) != (other.Address == nil) {
+		return false
+	} else if in.Address != nil {
+		if *in.Address != *other.Address {
+			return false
+		}
+	}
+
+	if (in.Type == nil) != (other.Type == nil) {
+		return false
+	} else if in.Type != nil {
+		if *in.Type != *other.Type {
+			return false
+		}
+	}
+
+	if (in.MACAddress == nil) != (other.MACAddress == nil) {
+		return false
+	} else if in.MACAddress != nil {
+		if *in.MACAddress != *other.MACAddress {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MatchDMIInfo) DeepEqual(other *MatchDMIInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.SerialNumber == nil) != (other.SerialNumber == nil) {
+		return false
+	} else if in.SerialNumber != nil {
+		if *in.SerialNumber != *other.SerialNumber {
+			return false
+		}
+	}
+
+	if (in.AssetTag == nil) != (other.AssetTag == nil) {
+		return false
+	} else if in.AssetTag != nil {
+		if *in.AssetTag != *other.AssetTag {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MatchInfo) DeepEqual(other *MatchInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.BootMAC == nil) != (other.BootMAC == nil) {
+		return false
+	} else if in.BootMAC != nil {
+		if *in.BootMAC != *other.BootMAC {
+			return false
+		}
+	}
+
+	if (in.BoardManagement == nil) != (other.BoardManagement == nil) {
+		return false
+	} else if in.BoardManagement != nil {
+		if !in.BoardManagement.DeepEqual(other.BoardManagement) {
+			return false
+		}
+	}
+
+	if (in.DMI == nil) != (other.DMI == nil) {
+		return false
+	} else if in.DMI != nil {
+		if !in.DMI.DeepEqual(other.DMI) {
+			return false
+		}
+	}
+
+	if (in.Operator == nil) != (other.Operator == nil) {
+		return false
+	} else if in.Operator != nil {
+		if *in.Operator != *other.Operator {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MemoryFunctionInfo) DeepEqual(other *MemoryFunctionInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Function != other.Function {
+		return false
+	}
+	if in.PageSize != other.PageSize {
+		return false
+	}
+	if in.PageCount != other.PageCount {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MemoryFunctionList) DeepEqual(other *MemoryFunctionList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MemoryNodeInfo) DeepEqual(other *MemoryNodeInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Node != other.Node {
+		return false
+	}
+	if (in.Platform == nil) != (other.Platform == nil) {
+		return false
+	} else if in.Platform != nil {
+		if *in.Platform != *other.Platform {
+			return false
+		}
+	}
+
+	if ((in.Functions != nil) && (other.Functions != nil)) || ((in.Functions == nil) != (other.Functions == nil)) {
+		in, other := &in.Functions, &other.Functions
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MemoryNodeList) DeepEqual(other *MemoryNodeList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MonitorInfo) DeepEqual(other *MonitorInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Size != nil {
+		if (in.Size == nil) != (other.Size == nil) {
+			return false
+		} else if in.Size != nil {
+			if *in.Size != *other.Size {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *NTPServerList) DeepEqual(other *NTPServerList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement == otherElement {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *NodeTaint) DeepEqual(other *NodeTaint) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Key != other.Key {
+		return false
+	}
+	if in.Value != other.Value {
+		return false
+	}
+	if in.Effect != other.Effect {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *NodeTaintList) DeepEqual(other *NodeTaintList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *OSDInfo) DeepEqual(other *OSDInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Function != other.Function {
+		return false
+	}
+	if in.Path != other.Path {
+		return false
+	}
+	if in.ClusterName != nil {
+		if (in.ClusterName == nil) != (other.ClusterName == nil) {
+			return false
+		} else if in.ClusterName != nil {
+			if *in.ClusterName != *other.ClusterName {
+				return false
+			}
+		}
+	}
+
+	if in.Journal != nil {
+		if (in.Journal == nil) != (other.Journal == nil) {
+			return false
+		} else if in.Journal != nil {
+			if !in.Journal.DeepEqual(other.Journal) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *OSDList) DeepEqual(other *OSDList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PCIDeviceInfo) DeepEqual(other *PCIDeviceInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.PCIAddress != nil {
+		if (in.PCIAddress == nil) != (other.PCIAddress == nil) {
+			return false
+		} else if in.PCIAddress != nil {
+			if *in.PCIAddress != *other.PCIAddress {
+				return false
+			}
+		}
+	}
+
+	if in.VendorID != nil {
+		if (in.VendorID == nil) != (other.VendorID == nil) {
+			return false
+		} else if in.VendorID != nil {
+			if *in.VendorID != *other.VendorID {
+				return false
+			}
+		}
+	}
+
+	if in.DeviceID != nil {
+		if (in.DeviceID == nil) != (other.DeviceID == nil) {
+			return false
+		} else if in.DeviceID != nil {
+			if *in.DeviceID != *other.DeviceID {
+				return false
+			}
+		}
+	}
+
+	if in.Enabled != nil {
+		if (in.Enabled == nil) != (other.Enabled == nil) {
+			return false
+		} else if in.Enabled != nil {
+			if *in.Enabled != *other.Enabled {
+				return false
+			}
+		}
+	}
+
+	if in.Name != nil {
+		if (in.Name == nil) != (other.Name == nil) {
+			return false
+		} else if in.Name != nil {
+			if *in.Name != *other.Name {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PCIDeviceList) DeepEqual(other *PCIDeviceList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PTPInfo) DeepEqual(other *PTPInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Mode != nil {
+		if (in.Mode == nil) != (other.Mode == nil) {
+			return false
+		} else if in.Mode != nil {
+			if *in.Mode != *other.Mode {
+				return false
+			}
+		}
+	}
+
+	if in.Transport != nil {
+		if (in.Transport == nil) != (other.Transport == nil) {
+			return false
+		} else if in.Transport != nil {
+			if *in.Transport != *other.Transport {
+				return false
+			}
+		}
+	}
+
+	if in.Mechanism != nil {
+		if (in.Mechanism == nil) != (other.Mechanism == nil) {
+			return false
+		} else if in.Mechanism != nil {
+			if *in.Mechanism != *other.Mechanism {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PhysicalVolumeInfo) DeepEqual(other *PhysicalVolumeInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Type != other.Type {
+		return false
+	}
+	if in.Path != other.Path {
+		return false
+	}
+	if in.Size != nil {
+		if (in.Size == nil) != (other.Size == nil) {
+			return false
+		} else if in.Size != nil {
+			if *in.Size != *other.Size {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PhysicalVolumeList) DeepEqual(other *PhysicalVolumeList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformNetworkItemList) DeepEqual(other *PlatformNetworkItemList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement == otherElement {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
 	return true
 }
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *MatchInfo) DeepEqual(other *MatchInfo) bool {
+func (in *PlatformNetworkSpec) DeepEqual(other *PlatformNetworkSpec) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.BootMAC == nil) != (other.BootMAC == nil) {
+	if in.Type != other.Type {
 		return false
-	} else if in.BootMAC != nil {
-		if *in.BootMAC != *other.BootMAC {
+	}
+	if in.Subnet != other.Subnet {
+		return false
+	}
+	if in.FloatingAddress != other.FloatingAddress {
+		return false
+	}
+	if in.Controller0Address != other.Controller0Address {
+		return false
+	}
+	if in.Controller1Address != other.Controller1Address {
+		return false
+	}
+	if in.Prefix != other.Prefix {
+		return false
+	}
+	if (in.Gateway == nil) != (other.Gateway == nil) {
+		return false
+	} else if in.Gateway != nil {
+		if *in.Gateway != *other.Gateway {
 			return false
 		}
 	}
 
-	if (in.BoardManagement == nil) != (other.BoardManagement == nil) {
+	if !in.Allocation.DeepEqual(&other.Allocation) {
 		return false
-	} else if in.BoardManagement != nil {
-		if !in.BoardManagement.DeepEqual(other.BoardManagement) {
+	}
+
+	if (in.SecondaryPool == nil) != (other.SecondaryPool == nil) {
+		return false
+	} else if in.SecondaryPool != nil {
+		if !in.SecondaryPool.DeepEqual(other.SecondaryPool) {
 			return false
 		}
 	}
 
-	if (in.DMI == nil) != (other.DMI == nil) {
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformNetworkStatus) DeepEqual(other *PlatformNetworkStatus) bool {
+	if other == nil {
 		return false
-	} else if in.DMI != nil {
-		if !in.DMI.DeepEqual(other.DMI) {
+	}
+
+	if (in.ID == nil) != (other.ID == nil) {
+		return false
+	} else if in.ID != nil {
+		if *in.ID != *other.ID {
+			return false
+		}
+	}
+
+	if (in.PoolUUID == nil) != (other.PoolUUID == nil) {
+		return false
+	} else if in.PoolUUID != nil {
+		if *in.PoolUUID != *other.PoolUUID {
+			return false
+		}
+	}
+
+	if (in.SecondaryPoolUUID == nil) != (other.SecondaryPoolUUID == nil) {
+		return false
+	} else if in.SecondaryPoolUUID != nil {
+		if *in.SecondaryPoolUUID != *other.SecondaryPoolUUID {
+			return false
+		}
+	}
+
+	if in.Reconciled != other.Reconciled {
+		return false
+	}
+	if in.InSync != other.InSync {
+		return false
+	}
+	if in.DeploymentScope != other.DeploymentScope {
+		return false
+	}
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+	if in.ConfigurationUpdated != other.ConfigurationUpdated {
+		return false
+	}
+	if in.StrategyRequired != other.StrategyRequired {
+		return false
+	}
+	if in.Delta != other.Delta {
+		return false
+	}
+
+	if ((in.DeltaAttributes != nil) && (other.DeltaAttributes != nil)) || ((in.DeltaAttributes == nil) != (other.DeltaAttributes == nil)) {
+		in, other := &in.DeltaAttributes, &other.DeltaAttributes
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
 			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
 		}
 	}
 
@@ -1081,18 +2808,42 @@ func (in *MatchInfo) DeepEqual(other *MatchInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *MemoryFunctionInfo) DeepEqual(other *MemoryFunctionInfo) bool {
+func (in *PreflightInfo) DeepEqual(other *PreflightInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Function != other.Function {
+	if ((in.IgnoredAlarms != nil) && (other.IgnoredAlarms != nil)) || ((in.IgnoredAlarms == nil) != (other.IgnoredAlarms == nil)) {
+		in, other := &in.IgnoredAlarms, &other.IgnoredAlarms
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ProcessorFunctionInfo) DeepEqual(other *ProcessorFunctionInfo) bool {
+	if other == nil {
 		return false
 	}
-	if in.PageSize != other.PageSize {
+
+	if in.Function != other.Function {
 		return false
 	}
-	if in.PageCount != other.PageCount {
+	if in.Count != other.Count {
 		return false
 	}
 
@@ -1101,7 +2852,7 @@ func (in *MemoryFunctionInfo) DeepEqual(other *MemoryFunctionInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *MemoryFunctionList) DeepEqual(other *MemoryFunctionList) bool {
+func (in *ProcessorFunctionList) DeepEqual(other *ProcessorFunctionList) bool {
 	if other == nil {
 		return false
 	}
@@ -1128,7 +2879,7 @@ func (in *MemoryFunctionList) DeepEqual(other *MemoryFunctionList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *MemoryNodeInfo) DeepEqual(other *MemoryNodeInfo) bool {
+func (in *ProcessorInfo) DeepEqual(other *ProcessorInfo) bool {
 	if other == nil {
 		return false
 	}
@@ -1148,7 +2899,7 @@ func (in *MemoryNodeInfo) DeepEqual(other *MemoryNodeInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *MemoryNodeList) DeepEqual(other *MemoryNodeList) bool {
+func (in *ProcessorNodeList) DeepEqual(other *ProcessorNodeList) bool {
 	if other == nil {
 		return false
 	}
@@ -1164,157 +2915,263 @@ func (in *MemoryNodeList) DeepEqual(other *MemoryNodeList) bool {
 					break
 				}
 			}
-			if !found {
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ProfileBaseAttributes) DeepEqual(other *ProfileBaseAttributes) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Personality != nil {
+		if (in.Personality == nil) != (other.Personality == nil) {
+			return false
+		} else if in.Personality != nil {
+			if *in.Personality != *other.Personality {
+				return false
+			}
+		}
+	}
+
+	if in.AdministrativeState != nil {
+		if (in.AdministrativeState == nil) != (other.AdministrativeState == nil) {
+			return false
+		} else if in.AdministrativeState != nil {
+			if *in.AdministrativeState != *other.AdministrativeState {
+				return false
+			}
+		}
+	}
+
+	if ((in.SubFunctions != nil) && (other.SubFunctions != nil)) || ((in.SubFunctions == nil) != (other.SubFunctions == nil)) {
+		in, other := &in.SubFunctions, &other.SubFunctions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.Location != nil {
+		if (in.Location == nil) != (other.Location == nil) {
+			return false
+		} else if in.Location != nil {
+			if *in.Location != *other.Location {
+				return false
+			}
+		}
+	}
+
+	if ((in.Labels != nil) && (other.Labels != nil)) || ((in.Labels == nil) != (other.Labels == nil)) {
+		in, other := &in.Labels, &other.Labels
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for key, inValue := range *in {
+				if otherValue, present := (*other)[key]; !present {
+					return false
+				} else {
+					if inValue != otherValue {
+						return false
+					}
+				}
+			}
+		}
+	}
+
+	if ((in.Taints != nil) && (other.Taints != nil)) || ((in.Taints == nil) != (other.Taints == nil)) {
+		in, other := &in.Taints, &other.Taints
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.InstallOutput != nil {
+		if (in.InstallOutput == nil) != (other.InstallOutput == nil) {
+			return false
+		} else if in.InstallOutput != nil {
+			if *in.InstallOutput != *other.InstallOutput {
 				return false
 			}
 		}
 	}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *MonitorInfo) DeepEqual(other *MonitorInfo) bool {
-	if other == nil {
-		return false
+	if in.Console != nil {
+		if (in.Console == nil) != (other.Console == nil) {
+			return false
+		} else if in.Console != nil {
+			if *in.Console != *other.Console {
+				return false
+			}
+		}
 	}
 
-	if in.Size != nil {
-		if (in.Size == nil) != (other.Size == nil) {
+	if in.BootDevice != nil {
+		if (in.BootDevice == nil) != (other.BootDevice == nil) {
 			return false
-		} else if in.Size != nil {
-			if *in.Size != *other.Size {
+		} else if in.BootDevice != nil {
+			if *in.BootDevice != *other.BootDevice {
 				return false
 			}
 		}
 	}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *NTPServerList) DeepEqual(other *NTPServerList) bool {
-	if other == nil {
-		return false
+	if in.PowerOn != nil {
+		if (in.PowerOn == nil) != (other.PowerOn == nil) {
+			return false
+		} else if in.PowerOn != nil {
+			if *in.PowerOn != *other.PowerOn {
+				return false
+			}
+		}
 	}
 
-	if len(*in) != len(*other) {
-		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement == otherElement {
-					found = true
-					break
-				}
+	if in.ProvisioningMode != nil {
+		if (in.ProvisioningMode == nil) != (other.ProvisioningMode == nil) {
+			return false
+		} else if in.ProvisioningMode != nil {
+			if *in.ProvisioningMode != *other.ProvisioningMode {
+				return false
 			}
-			if !found {
+		}
+	}
+
+	if in.BootMAC != nil {
+		if (in.BootMAC == nil) != (other.BootMAC == nil) {
+			return false
+		} else if in.BootMAC != nil {
+			if *in.BootMAC != *other.BootMAC {
 				return false
 			}
 		}
 	}
 
-	return true
-}
+	if ((in.PtpInstances != nil) && (other.PtpInstances != nil)) || ((in.PtpInstances == nil) != (other.PtpInstances == nil)) {
+		in, other := &in.PtpInstances, &other.PtpInstances
+		if other == nil {
+			return false
+		}
 
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *OSDInfo) DeepEqual(other *OSDInfo) bool {
-	if other == nil {
-		return false
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement == otherElement {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
 	}
 
-	if in.Function != other.Function {
-		return false
-	}
-	if in.Path != other.Path {
-		return false
-	}
-	if in.ClusterName != nil {
-		if (in.ClusterName == nil) != (other.ClusterName == nil) {
+	if in.RootDevice != nil {
+		if (in.RootDevice == nil) != (other.RootDevice == nil) {
 			return false
-		} else if in.ClusterName != nil {
-			if *in.ClusterName != *other.ClusterName {
+		} else if in.RootDevice != nil {
+			if *in.RootDevice != *other.RootDevice {
 				return false
 			}
 		}
 	}
 
-	if in.Journal != nil {
-		if (in.Journal == nil) != (other.Journal == nil) {
+	if in.ClockSynchronization != nil {
+		if (in.ClockSynchronization == nil) != (other.ClockSynchronization == nil) {
 			return false
-		} else if in.Journal != nil {
-			if !in.Journal.DeepEqual(other.Journal) {
+		} else if in.ClockSynchronization != nil {
+			if *in.ClockSynchronization != *other.ClockSynchronization {
 				return false
 			}
 		}
 	}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *OSDList) DeepEqual(other *OSDList) bool {
-	if other == nil {
-		return false
-	}
-
-	if len(*in) != len(*other) {
-		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
-					found = true
-					break
-				}
-			}
-			if !found {
+	if in.MaxCPUMhzConfigured != nil {
+		if (in.MaxCPUMhzConfigured == nil) != (other.MaxCPUMhzConfigured == nil) {
+			return false
+		} else if in.MaxCPUMhzConfigured != nil {
+			if *in.MaxCPUMhzConfigured != *other.MaxCPUMhzConfigured {
 				return false
 			}
 		}
 	}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *PTPInfo) DeepEqual(other *PTPInfo) bool {
-	if other == nil {
-		return false
+	if in.PowerProfile != nil {
+		if (in.PowerProfile == nil) != (other.PowerProfile == nil) {
+			return false
+		} else if in.PowerProfile != nil {
+			if *in.PowerProfile != *other.PowerProfile {
+				return false
+			}
+		}
 	}
 
-	if in.Mode != nil {
-		if (in.Mode == nil) != (other.Mode == nil) {
+	if in.AppArmor != nil {
+		if (in.AppArmor == nil) != (other.AppArmor == nil) {
 			return false
-		} else if in.Mode != nil {
-			if *in.Mode != *other.Mode {
+		} else if in.AppArmor != nil {
+			if *in.AppArmor != *other.AppArmor {
 				return false
 			}
 		}
 	}
 
-	if in.Transport != nil {
-		if (in.Transport == nil) != (other.Transport == nil) {
+	if in.HwSettle != nil {
+		if (in.HwSettle == nil) != (other.HwSettle == nil) {
 			return false
-		} else if in.Transport != nil {
-			if *in.Transport != *other.Transport {
+		} else if in.HwSettle != nil {
+			if *in.HwSettle != *other.HwSettle {
 				return false
 			}
 		}
 	}
 
-	if in.Mechanism != nil {
-		if (in.Mechanism == nil) != (other.Mechanism == nil) {
+	if in.Kernel != nil {
+		if (in.Kernel == nil) != (other.Kernel == nil) {
 			return false
-		} else if in.Mechanism != nil {
-			if *in.Mechanism != *other.Mechanism {
+		} else if in.Kernel != nil {
+			if *in.Kernel != *other.Kernel {
 				return false
 			}
 		}
@@ -1325,49 +3182,46 @@ func (in *PTPInfo) DeepEqual(other *PTPInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PhysicalVolumeInfo) DeepEqual(other *PhysicalVolumeInfo) bool {
+func (in *ProfileStorageInfo) DeepEqual(other *ProfileStorageInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Type != other.Type {
-		return false
-	}
-	if in.Path != other.Path {
-		return false
-	}
-	if in.Size != nil {
-		if (in.Size == nil) != (other.Size == nil) {
+	if in.Monitor != nil {
+		if (in.Monitor == nil) != (other.Monitor == nil) {
 			return false
-		} else if in.Size != nil {
-			if *in.Size != *other.Size {
+		} else if in.Monitor != nil {
+			if !in.Monitor.DeepEqual(other.Monitor) {
 				return false
 			}
 		}
 	}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *PhysicalVolumeList) DeepEqual(other *PhysicalVolumeList) bool {
-	if other == nil {
-		return false
+	if in.OSDs != nil {
+		if (in.OSDs == nil) != (other.OSDs == nil) {
+			return false
+		} else if in.OSDs != nil {
+			if !in.OSDs.DeepEqual(other.OSDs) {
+				return false
+			}
+		}
 	}
 
-	if len(*in) != len(*other) {
-		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
-					found = true
-					break
-				}
+	if in.VolumeGroups != nil {
+		if (in.VolumeGroups == nil) != (other.VolumeGroups == nil) {
+			return false
+		} else if in.VolumeGroups != nil {
+			if !in.VolumeGroups.DeepEqual(other.VolumeGroups) {
+				return false
 			}
-			if !found {
+		}
+	}
+
+	if in.FileSystems != nil {
+		if (in.FileSystems == nil) != (other.FileSystems == nil) {
+			return false
+		} else if in.FileSystems != nil {
+			if !in.FileSystems.DeepEqual(other.FileSystems) {
 				return false
 			}
 		}
@@ -1378,7 +3232,7 @@ func (in *PhysicalVolumeList) DeepEqual(other *PhysicalVolumeList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PlatformNetworkItemList) DeepEqual(other *PlatformNetworkItemList) bool {
+func (in *PtpInstanceItemList) DeepEqual(other *PtpInstanceItemList) bool {
 	if other == nil {
 		return false
 	}
@@ -1405,39 +3259,29 @@ func (in *PlatformNetworkItemList) DeepEqual(other *PlatformNetworkItemList) boo
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PlatformNetworkSpec) DeepEqual(other *PlatformNetworkSpec) bool {
+func (in *PtpInstanceSpec) DeepEqual(other *PtpInstanceSpec) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Type != other.Type {
-		return false
-	}
-	if in.Subnet != other.Subnet {
-		return false
-	}
-	if in.FloatingAddress != other.FloatingAddress {
-		return false
-	}
-	if in.Controller0Address != other.Controller0Address {
-		return false
-	}
-	if in.Controller1Address != other.Controller1Address {
-		return false
-	}
-	if in.Prefix != other.Prefix {
+	if in.Service != other.Service {
 		return false
 	}
-	if (in.Gateway == nil) != (other.Gateway == nil) {
-		return false
-	} else if in.Gateway != nil {
-		if *in.Gateway != *other.Gateway {
+	if ((in.InstanceParameters != nil) && (other.InstanceParameters != nil)) || ((in.InstanceParameters == nil) != (other.InstanceParameters == nil)) {
+		in, other := &in.InstanceParameters, &other.InstanceParameters
+		if other == nil {
 			return false
 		}
-	}
 
-	if !in.Allocation.DeepEqual(&other.Allocation) {
-		return false
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
 	}
 
 	return true
@@ -1445,7 +3289,7 @@ func (in *PlatformNetworkSpec) DeepEqual(other *PlatformNetworkSpec) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PlatformNetworkStatus) DeepEqual(other *PlatformNetworkStatus) bool {
+func (in *PtpInstanceStatus) DeepEqual(other *PtpInstanceStatus) bool {
 	if other == nil {
 		return false
 	}
@@ -1458,14 +3302,6 @@ func (in *PlatformNetworkStatus) DeepEqual(other *PlatformNetworkStatus) bool {
 		}
 	}
 
-	if (in.PoolUUID == nil) != (other.PoolUUID == nil) {
-		return false
-	} else if in.PoolUUID != nil {
-		if *in.PoolUUID != *other.PoolUUID {
-			return false
-		}
-	}
-
 	if in.Reconciled != other.Reconciled {
 		return false
 	}
@@ -1488,67 +3324,37 @@ func (in *PlatformNetworkStatus) DeepEqual(other *PlatformNetworkStatus) bool {
 		return false
 	}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *ProcessorFunctionInfo) DeepEqual(other *ProcessorFunctionInfo) bool {
-	if other == nil {
-		return false
-	}
-
-	if in.Function != other.Function {
-		return false
-	}
-	if in.Count != other.Count {
-		return false
-	}
-
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *ProcessorFunctionList) DeepEqual(other *ProcessorFunctionList) bool {
-	if other == nil {
-		return false
-	}
+	if ((in.DeltaAttributes != nil) && (other.DeltaAttributes != nil)) || ((in.DeltaAttributes == nil) != (other.DeltaAttributes == nil)) {
+		in, other := &in.DeltaAttributes, &other.DeltaAttributes
+		if other == nil {
+			return false
+		}
 
-	if len(*in) != len(*other) {
-		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
-					found = true
-					break
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
 				}
 			}
-			if !found {
-				return false
-			}
 		}
 	}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *ProcessorInfo) DeepEqual(other *ProcessorInfo) bool {
-	if other == nil {
-		return false
-	}
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
 
-	if in.Node != other.Node {
-		return false
-	}
-	if ((in.Functions != nil) && (other.Functions != nil)) || ((in.Functions == nil) != (other.Functions == nil)) {
-		in, other := &in.Functions, &other.Functions
-		if other == nil || !in.DeepEqual(other) {
+		if len(*in) != len(*other) {
 			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
 		}
 	}
 
@@ -1557,7 +3363,7 @@ func (in *ProcessorInfo) DeepEqual(other *ProcessorInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *ProcessorNodeList) DeepEqual(other *ProcessorNodeList) bool {
+func (in *PtpInterfaceItemList) DeepEqual(other *PtpInterfaceItemList) bool {
 	if other == nil {
 		return false
 	}
@@ -1568,7 +3374,7 @@ func (in *ProcessorNodeList) DeepEqual(other *ProcessorNodeList) bool {
 		for _, inElement := range *in {
 			found := false
 			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
+				if inElement == otherElement {
 					found = true
 					break
 				}
@@ -1584,33 +3390,16 @@ func (in *ProcessorNodeList) DeepEqual(other *ProcessorNodeList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *ProfileBaseAttributes) DeepEqual(other *ProfileBaseAttributes) bool {
+func (in *PtpInterfaceSpec) DeepEqual(other *PtpInterfaceSpec) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Personality != nil {
-		if (in.Personality == nil) != (other.Personality == nil) {
-			return false
-		} else if in.Personality != nil {
-			if *in.Personality != *other.Personality {
-				return false
-			}
-		}
-	}
-
-	if in.AdministrativeState != nil {
-		if (in.AdministrativeState == nil) != (other.AdministrativeState == nil) {
-			return false
-		} else if in.AdministrativeState != nil {
-			if *in.AdministrativeState != *other.AdministrativeState {
-				return false
-			}
-		}
+	if in.PtpInstance != other.PtpInstance {
+		return false
 	}
-
-	if ((in.SubFunctions != nil) && (other.SubFunctions != nil)) || ((in.SubFunctions == nil) != (other.SubFunctions == nil)) {
-		in, other := &in.SubFunctions, &other.SubFunctions
+	if ((in.InterfaceParameters != nil) && (other.InterfaceParameters != nil)) || ((in.InterfaceParameters == nil) != (other.InterfaceParameters == nil)) {
+		in, other := &in.InterfaceParameters, &other.InterfaceParameters
 		if other == nil {
 			return false
 		}
@@ -1626,99 +3415,65 @@ func (in *ProfileBaseAttributes) DeepEqual(other *ProfileBaseAttributes) bool {
 		}
 	}
 
-	if in.Location != nil {
-		if (in.Location == nil) != (other.Location == nil) {
-			return false
-		} else if in.Location != nil {
-			if *in.Location != *other.Location {
-				return false
-			}
-		}
-	}
-
-	if ((in.Labels != nil) && (other.Labels != nil)) || ((in.Labels == nil) != (other.Labels == nil)) {
-		in, other := &in.Labels, &other.Labels
-		if other == nil {
-			return false
-		}
+	return true
+}
 
-		if len(*in) != len(*other) {
-			return false
-		} else {
-			for key, inValue := range *in {
-				if otherValue, present := (*other)[key]; !present {
-					return false
-				} else {
-					if inValue != otherValue {
-						return false
-					}
-				}
-			}
-		}
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PtpInterfaceStatus) DeepEqual(other *PtpInterfaceStatus) bool {
+	if other == nil {
+		return false
 	}
 
-	if in.InstallOutput != nil {
-		if (in.InstallOutput == nil) != (other.InstallOutput == nil) {
+	if (in.ID == nil) != (other.ID == nil) {
+		return false
+	} else if in.ID != nil {
+		if *in.ID != *other.ID {
 			return false
-		} else if in.InstallOutput != nil {
-			if *in.InstallOutput != *other.InstallOutput {
-				return false
-			}
 		}
 	}
 
-	if in.Console != nil {
-		if (in.Console == nil) != (other.Console == nil) {
-			return false
-		} else if in.Console != nil {
-			if *in.Console != *other.Console {
-				return false
-			}
-		}
+	if in.Reconciled != other.Reconciled {
+		return false
 	}
-
-	if in.BootDevice != nil {
-		if (in.BootDevice == nil) != (other.BootDevice == nil) {
-			return false
-		} else if in.BootDevice != nil {
-			if *in.BootDevice != *other.BootDevice {
-				return false
-			}
-		}
+	if in.InSync != other.InSync {
+		return false
 	}
-
-	if in.PowerOn != nil {
-		if (in.PowerOn == nil) != (other.PowerOn == nil) {
-			return false
-		} else if in.PowerOn != nil {
-			if *in.PowerOn != *other.PowerOn {
-				return false
-			}
-		}
+	if in.DeploymentScope != other.DeploymentScope {
+		return false
+	}
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+	if in.ConfigurationUpdated != other.ConfigurationUpdated {
+		return false
+	}
+	if in.StrategyRequired != other.StrategyRequired {
+		return false
+	}
+	if in.Delta != other.Delta {
+		return false
 	}
 
-	if in.ProvisioningMode != nil {
-		if (in.ProvisioningMode == nil) != (other.ProvisioningMode == nil) {
+	if ((in.DeltaAttributes != nil) && (other.DeltaAttributes != nil)) || ((in.DeltaAttributes == nil) != (other.DeltaAttributes == nil)) {
+		in, other := &in.DeltaAttributes, &other.DeltaAttributes
+		if other == nil {
 			return false
-		} else if in.ProvisioningMode != nil {
-			if *in.ProvisioningMode != *other.ProvisioningMode {
-				return false
-			}
 		}
-	}
 
-	if in.BootMAC != nil {
-		if (in.BootMAC == nil) != (other.BootMAC == nil) {
+		if len(*in) != len(*other) {
 			return false
-		} else if in.BootMAC != nil {
-			if *in.BootMAC != *other.BootMAC {
-				return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
 			}
 		}
 	}
 
-	if ((in.PtpInstances != nil) && (other.PtpInstances != nil)) || ((in.PtpInstances == nil) != (other.PtpInstances == nil)) {
-		in, other := &in.PtpInstances, &other.PtpInstances
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
 		if other == nil {
 			return false
 		}
@@ -1726,78 +3481,58 @@ func (in *ProfileBaseAttributes) DeepEqual(other *ProfileBaseAttributes) bool {
 		if len(*in) != len(*other) {
 			return false
 		} else {
-			for _, inElement := range *in {
-				found := false
-				for _, otherElement := range *other {
-					if inElement == otherElement {
-						found = true
-						break
-					}
-				}
-				if !found {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
 					return false
 				}
 			}
 		}
 	}
 
-	if in.RootDevice != nil {
-		if (in.RootDevice == nil) != (other.RootDevice == nil) {
-			return false
-		} else if in.RootDevice != nil {
-			if *in.RootDevice != *other.RootDevice {
-				return false
-			}
-		}
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ReconcilePolicyInfo) DeepEqual(other *ReconcilePolicyInfo) bool {
+	if other == nil {
+		return false
 	}
 
-	if in.ClockSynchronization != nil {
-		if (in.ClockSynchronization == nil) != (other.ClockSynchronization == nil) {
+	if (in.RetryInterval == nil) != (other.RetryInterval == nil) {
+		return false
+	} else if in.RetryInterval != nil {
+		if *in.RetryInterval != *other.RetryInterval {
 			return false
-		} else if in.ClockSynchronization != nil {
-			if *in.ClockSynchronization != *other.ClockSynchronization {
-				return false
-			}
 		}
 	}
-
-	if in.MaxCPUMhzConfigured != nil {
-		if (in.MaxCPUMhzConfigured == nil) != (other.MaxCPUMhzConfigured == nil) {
+	if (in.BackoffFactor == nil) != (other.BackoffFactor == nil) {
+		return false
+	} else if in.BackoffFactor != nil {
+		if *in.BackoffFactor != *other.BackoffFactor {
 			return false
-		} else if in.MaxCPUMhzConfigured != nil {
-			if *in.MaxCPUMhzConfigured != *other.MaxCPUMhzConfigured {
-				return false
-			}
 		}
 	}
-
-	if in.AppArmor != nil {
-		if (in.AppArmor == nil) != (other.AppArmor == nil) {
+	if (in.MaxRetryInterval == nil) != (other.MaxRetryInterval == nil) {
+		return false
+	} else if in.MaxRetryInterval != nil {
+		if *in.MaxRetryInterval != *other.MaxRetryInterval {
 			return false
-		} else if in.AppArmor != nil {
-			if *in.AppArmor != *other.AppArmor {
-				return false
-			}
 		}
 	}
-
-	if in.HwSettle != nil {
-		if (in.HwSettle == nil) != (other.HwSettle == nil) {
+	if (in.RetryLimit == nil) != (other.RetryLimit == nil) {
+		return false
+	} else if in.RetryLimit != nil {
+		if *in.RetryLimit != *other.RetryLimit {
 			return false
-		} else if in.HwSettle != nil {
-			if *in.HwSettle != *other.HwSettle {
-				return false
-			}
 		}
 	}
 
-	if in.Kernel != nil {
-		if (in.Kernel == nil) != (other.Kernel == nil) {
+	if (in.AutoRemediate == nil) != (other.AutoRemediate == nil) {
+		return false
+	} else if in.AutoRemediate != nil {
+		if *in.AutoRemediate != *other.AutoRemediate {
 			return false
-		} else if in.Kernel != nil {
-			if *in.Kernel != *other.Kernel {
-				return false
-			}
 		}
 	}
 
@@ -1806,48 +3541,38 @@ func (in *ProfileBaseAttributes) DeepEqual(other *ProfileBaseAttributes) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *ProfileStorageInfo) DeepEqual(other *ProfileStorageInfo) bool {
+func (in *RegistryInfo) DeepEqual(other *RegistryInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Monitor != nil {
-		if (in.Monitor == nil) != (other.Monitor == nil) {
-			return false
-		} else if in.Monitor != nil {
-			if !in.Monitor.DeepEqual(other.Monitor) {
-				return false
-			}
-		}
+	if in.Name != other.Name {
+		return false
 	}
-
-	if in.OSDs != nil {
-		if (in.OSDs == nil) != (other.OSDs == nil) {
+	if in.URL != other.URL {
+		return false
+	}
+	if (in.Type == nil) != (other.Type == nil) {
+		return false
+	} else if in.Type != nil {
+		if *in.Type != *other.Type {
 			return false
-		} else if in.OSDs != nil {
-			if !in.OSDs.DeepEqual(other.OSDs) {
-				return false
-			}
 		}
 	}
 
-	if in.VolumeGroups != nil {
-		if (in.VolumeGroups == nil) != (other.VolumeGroups == nil) {
+	if (in.Secret == nil) != (other.Secret == nil) {
+		return false
+	} else if in.Secret != nil {
+		if *in.Secret != *other.Secret {
 			return false
-		} else if in.VolumeGroups != nil {
-			if !in.VolumeGroups.DeepEqual(other.VolumeGroups) {
-				return false
-			}
 		}
 	}
 
-	if in.FileSystems != nil {
-		if (in.FileSystems == nil) != (other.FileSystems == nil) {
+	if (in.Insecure == nil) != (other.Insecure == nil) {
+		return false
+	} else if in.Insecure != nil {
+		if *in.Insecure != *other.Insecure {
 			return false
-		} else if in.FileSystems != nil {
-			if !in.FileSystems.DeepEqual(other.FileSystems) {
-				return false
-			}
 		}
 	}
 
@@ -1856,7 +3581,7 @@ func (in *ProfileStorageInfo) DeepEqual(other *ProfileStorageInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PtpInstanceItemList) DeepEqual(other *PtpInstanceItemList) bool {
+func (in *RegistryList) DeepEqual(other *RegistryList) bool {
 	if other == nil {
 		return false
 	}
@@ -1867,7 +3592,7 @@ func (in *PtpInstanceItemList) DeepEqual(other *PtpInstanceItemList) bool {
 		for _, inElement := range *in {
 			found := false
 			for _, otherElement := range *other {
-				if inElement == otherElement {
+				if inElement.DeepEqual(&otherElement) {
 					found = true
 					break
 				}
@@ -1883,16 +3608,36 @@ func (in *PtpInstanceItemList) DeepEqual(other *PtpInstanceItemList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PtpInstanceSpec) DeepEqual(other *PtpInstanceSpec) bool {
+func (in *RegistryStatus) DeepEqual(other *RegistryStatus) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Service != other.Service {
+	if in.Name != other.Name {
 		return false
 	}
-	if ((in.InstanceParameters != nil) && (other.InstanceParameters != nil)) || ((in.InstanceParameters == nil) != (other.InstanceParameters == nil)) {
-		in, other := &in.InstanceParameters, &other.InstanceParameters
+	if in.Secret != other.Secret {
+		return false
+	}
+	if in.ResourceVersion != other.ResourceVersion {
+		return false
+	}
+	if in.AuthSecretID != other.AuthSecretID {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *RemoteAuthenticationInfo) DeepEqual(other *RemoteAuthenticationInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.Domains != nil) && (other.Domains != nil)) || ((in.Domains == nil) != (other.Domains == nil)) {
+		in, other := &in.Domains, &other.Domains
 		if other == nil {
 			return false
 		}
@@ -1901,7 +3646,7 @@ func (in *PtpInstanceSpec) DeepEqual(other *PtpInstanceSpec) bool {
 			return false
 		} else {
 			for i, inElement := range *in {
-				if inElement != (*other)[i] {
+				if !inElement.DeepEqual(&(*other)[i]) {
 					return false
 				}
 			}
@@ -1913,39 +3658,58 @@ func (in *PtpInstanceSpec) DeepEqual(other *PtpInstanceSpec) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PtpInstanceStatus) DeepEqual(other *PtpInstanceStatus) bool {
+func (in *RouteInfo) DeepEqual(other *RouteInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.ID == nil) != (other.ID == nil) {
-		return false
-	} else if in.ID != nil {
-		if *in.ID != *other.ID {
-			return false
-		}
-	}
-
-	if in.Reconciled != other.Reconciled {
+	if in.Interface != other.Interface {
 		return false
 	}
-	if in.InSync != other.InSync {
+	if in.Network != other.Network {
 		return false
 	}
-	if in.DeploymentScope != other.DeploymentScope {
+	if in.Prefix != other.Prefix {
 		return false
 	}
-	if in.ObservedGeneration != other.ObservedGeneration {
+	if in.Gateway != other.Gateway {
 		return false
 	}
-	if in.ConfigurationUpdated != other.ConfigurationUpdated {
-		return false
+	if in.Metric != nil {
+		if (in.Metric == nil) != (other.Metric == nil) {
+			return false
+		} else if in.Metric != nil {
+			if *in.Metric != *other.Metric {
+				return false
+			}
+		}
 	}
-	if in.StrategyRequired != other.StrategyRequired {
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *RouteList) DeepEqual(other *RouteList) bool {
+	if other == nil {
 		return false
 	}
-	if in.Delta != other.Delta {
+
+	if len(*in) != len(*other) {
 		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
 	}
 
 	return true
@@ -1953,7 +3717,7 @@ func (in *PtpInstanceStatus) DeepEqual(other *PtpInstanceStatus) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PtpInterfaceItemList) DeepEqual(other *PtpInterfaceItemList) bool {
+func (in *SNMPCommunityList) DeepEqual(other *SNMPCommunityList) bool {
 	if other == nil {
 		return false
 	}
@@ -1980,28 +3744,24 @@ func (in *PtpInterfaceItemList) DeepEqual(other *PtpInterfaceItemList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PtpInterfaceSpec) DeepEqual(other *PtpInterfaceSpec) bool {
+func (in *SNMPInfo) DeepEqual(other *SNMPInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.PtpInstance != other.PtpInstance {
+	if (in.Communities == nil) != (other.Communities == nil) {
 		return false
-	}
-	if ((in.InterfaceParameters != nil) && (other.InterfaceParameters != nil)) || ((in.InterfaceParameters == nil) != (other.InterfaceParameters == nil)) {
-		in, other := &in.InterfaceParameters, &other.InterfaceParameters
-		if other == nil {
+	} else if in.Communities != nil {
+		if !in.Communities.DeepEqual(other.Communities) {
 			return false
 		}
+	}
 
-		if len(*in) != len(*other) {
+	if (in.TrapDestinations == nil) != (other.TrapDestinations == nil) {
+		return false
+	} else if in.TrapDestinations != nil {
+		if !in.TrapDestinations.DeepEqual(other.TrapDestinations) {
 			return false
-		} else {
-			for i, inElement := range *in {
-				if inElement != (*other)[i] {
-					return false
-				}
-			}
 		}
 	}
 
@@ -2010,38 +3770,79 @@ func (in *PtpInterfaceSpec) DeepEqual(other *PtpInterfaceSpec) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PtpInterfaceStatus) DeepEqual(other *PtpInterfaceStatus) bool {
+func (in *SNMPTrapDestinationInfo) DeepEqual(other *SNMPTrapDestinationInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.ID == nil) != (other.ID == nil) {
+	if in.Address != other.Address {
 		return false
-	} else if in.ID != nil {
-		if *in.ID != *other.ID {
-			return false
+	}
+	if in.Community != other.Community {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *SNMPTrapDestinationList) DeepEqual(other *SNMPTrapDestinationList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
 		}
 	}
 
-	if in.Reconciled != other.Reconciled {
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *SecondaryPoolInfo) DeepEqual(other *SecondaryPoolInfo) bool {
+	if other == nil {
 		return false
 	}
-	if in.InSync != other.InSync {
+
+	if in.Subnet != other.Subnet {
 		return false
 	}
-	if in.DeploymentScope != other.DeploymentScope {
+	if in.FloatingAddress != other.FloatingAddress {
 		return false
 	}
-	if in.ObservedGeneration != other.ObservedGeneration {
+	if in.Controller0Address != other.Controller0Address {
 		return false
 	}
-	if in.ConfigurationUpdated != other.ConfigurationUpdated {
+	if in.Controller1Address != other.Controller1Address {
 		return false
 	}
-	if in.StrategyRequired != other.StrategyRequired {
+	if in.Prefix != other.Prefix {
+		return false
+	}
+	if (in.Gateway == nil) != (other.Gateway == nil) {
 		return false
+	} else if in.Gateway != nil {
+		if *in.Gateway != *other.Gateway {
+			return false
+		}
 	}
-	if in.Delta != other.Delta {
+
+	if !in.Allocation.DeepEqual(&other.Allocation) {
 		return false
 	}
 
@@ -2050,28 +3851,76 @@ func (in *PtpInterfaceStatus) DeepEqual(other *PtpInterfaceStatus) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *RouteInfo) DeepEqual(other *RouteInfo) bool {
+func (in *SensorGroupInfo) DeepEqual(other *SensorGroupInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Interface != other.Interface {
+	if in.Name != other.Name {
 		return false
 	}
-	if in.Network != other.Network {
-		return false
+	if in.AuditInterval != nil {
+		if (in.AuditInterval == nil) != (other.AuditInterval == nil) {
+			return false
+		} else if in.AuditInterval != nil {
+			if *in.AuditInterval != *other.AuditInterval {
+				return false
+			}
+		}
 	}
-	if in.Prefix != other.Prefix {
-		return false
+
+	if in.Suppress != nil {
+		if (in.Suppress == nil) != (other.Suppress == nil) {
+			return false
+		} else if in.Suppress != nil {
+			if *in.Suppress != *other.Suppress {
+				return false
+			}
+		}
 	}
-	if in.Gateway != other.Gateway {
-		return false
+
+	if in.SuppressedSensors != nil {
+		in, other := &in.SuppressedSensors, &other.SuppressedSensors
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
 	}
-	if in.Metric != nil {
-		if (in.Metric == nil) != (other.Metric == nil) {
+
+	if in.ActionsCritical != nil {
+		if (in.ActionsCritical == nil) != (other.ActionsCritical == nil) {
 			return false
-		} else if in.Metric != nil {
-			if *in.Metric != *other.Metric {
+		} else if in.ActionsCritical != nil {
+			if *in.ActionsCritical != *other.ActionsCritical {
+				return false
+			}
+		}
+	}
+
+	if in.ActionsMajor != nil {
+		if (in.ActionsMajor == nil) != (other.ActionsMajor == nil) {
+			return false
+		} else if in.ActionsMajor != nil {
+			if *in.ActionsMajor != *other.ActionsMajor {
+				return false
+			}
+		}
+	}
+
+	if in.ActionsMinor != nil {
+		if (in.ActionsMinor == nil) != (other.ActionsMinor == nil) {
+			return false
+		} else if in.ActionsMinor != nil {
+			if *in.ActionsMinor != *other.ActionsMinor {
 				return false
 			}
 		}
@@ -2082,7 +3931,7 @@ func (in *RouteInfo) DeepEqual(other *RouteInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *RouteList) DeepEqual(other *RouteList) bool {
+func (in *SensorGroupList) DeepEqual(other *SensorGroupList) bool {
 	if other == nil {
 		return false
 	}
@@ -2172,6 +4021,59 @@ func (in *ServiceParameterList) DeepEqual(other *ServiceParameterList) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *SoftwareInfo) DeepEqual(other *SoftwareInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Patches == nil) != (other.Patches == nil) {
+		return false
+	} else if in.Patches != nil {
+		if !in.Patches.DeepEqual(other.Patches) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *SoftwarePatchInfo) DeepEqual(other *SoftwarePatchInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.IDs != nil) && (other.IDs != nil)) || ((in.IDs == nil) != (other.IDs == nil)) {
+		in, other := &in.IDs, &other.IDs
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if (in.Repository == nil) != (other.Repository == nil) {
+		return false
+	} else if in.Repository != nil {
+		if *in.Repository != *other.Repository {
+			return false
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *StorageBackend) DeepEqual(other *StorageBackend) bool {
@@ -2212,6 +4114,26 @@ func (in *StorageBackend) DeepEqual(other *StorageBackend) bool {
 		}
 	}
 
+	if in.MinReplicationFactor != nil {
+		if (in.MinReplicationFactor == nil) != (other.MinReplicationFactor == nil) {
+			return false
+		} else if in.MinReplicationFactor != nil {
+			if *in.MinReplicationFactor != *other.MinReplicationFactor {
+				return false
+			}
+		}
+	}
+
+	if in.PoolQuotas != nil {
+		if (in.PoolQuotas == nil) != (other.PoolQuotas == nil) {
+			return false
+		} else if in.PoolQuotas != nil {
+			if !in.PoolQuotas.DeepEqual(other.PoolQuotas) {
+				return false
+			}
+		}
+	}
+
 	if in.PartitionSize != nil {
 		if (in.PartitionSize == nil) != (other.PartitionSize == nil) {
 			return false
@@ -2269,6 +4191,16 @@ func (in *SystemSpec) DeepEqual(other *SystemSpec) bool {
 		return false
 	}
 
+	if in.EndpointSecret != nil {
+		if (in.EndpointSecret == nil) != (other.EndpointSecret == nil) {
+			return false
+		} else if in.EndpointSecret != nil {
+			if *in.EndpointSecret != *other.EndpointSecret {
+				return false
+			}
+		}
+	}
+
 	if in.Description != nil {
 		if (in.Description == nil) != (other.Description == nil) {
 			return false
@@ -2319,6 +4251,16 @@ func (in *SystemSpec) DeepEqual(other *SystemSpec) bool {
 		}
 	}
 
+	if in.Timezone != nil {
+		if (in.Timezone == nil) != (other.Timezone == nil) {
+			return false
+		} else if in.Timezone != nil {
+			if *in.Timezone != *other.Timezone {
+				return false
+			}
+		}
+	}
+
 	if in.DNSServers != nil {
 		if (in.DNSServers == nil) != (other.DNSServers == nil) {
 			return false
@@ -2369,6 +4311,36 @@ func (in *SystemSpec) DeepEqual(other *SystemSpec) bool {
 		}
 	}
 
+	if in.SNMP != nil {
+		if (in.SNMP == nil) != (other.SNMP == nil) {
+			return false
+		} else if in.SNMP != nil {
+			if !in.SNMP.DeepEqual(other.SNMP) {
+				return false
+			}
+		}
+	}
+
+	if in.RemoteAuthentication != nil {
+		if (in.RemoteAuthentication == nil) != (other.RemoteAuthentication == nil) {
+			return false
+		} else if in.RemoteAuthentication != nil {
+			if !in.RemoteAuthentication.DeepEqual(other.RemoteAuthentication) {
+				return false
+			}
+		}
+	}
+
+	if in.Registries != nil {
+		if (in.Registries == nil) != (other.Registries == nil) {
+			return false
+		} else if in.Registries != nil {
+			if !in.Registries.DeepEqual(other.Registries) {
+				return false
+			}
+		}
+	}
+
 	if in.ServiceParameters != nil {
 		if (in.ServiceParameters == nil) != (other.ServiceParameters == nil) {
 			return false
@@ -2399,6 +4371,36 @@ func (in *SystemSpec) DeepEqual(other *SystemSpec) bool {
 		}
 	}
 
+	if in.Bootstrap != nil {
+		if (in.Bootstrap == nil) != (other.Bootstrap == nil) {
+			return false
+		} else if in.Bootstrap != nil {
+			if !in.Bootstrap.DeepEqual(other.Bootstrap) {
+				return false
+			}
+		}
+	}
+
+	if in.Software != nil {
+		if (in.Software == nil) != (other.Software == nil) {
+			return false
+		} else if in.Software != nil {
+			if !in.Software.DeepEqual(other.Software) {
+				return false
+			}
+		}
+	}
+
+	if in.Preflight != nil {
+		if (in.Preflight == nil) != (other.Preflight == nil) {
+			return false
+		} else if in.Preflight != nil {
+			if !in.Preflight.DeepEqual(other.Preflight) {
+				return false
+			}
+		}
+	}
+
 	return true
 }
 
@@ -2450,6 +4452,23 @@ func (in *SystemStatus) DeepEqual(other *SystemStatus) bool {
 	if in.Delta != other.Delta {
 		return false
 	}
+
+	if ((in.DeltaAttributes != nil) && (other.DeltaAttributes != nil)) || ((in.DeltaAttributes == nil) != (other.DeltaAttributes == nil)) {
+		in, other := &in.DeltaAttributes, &other.DeltaAttributes
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
 	if in.StrategyApplied != other.StrategyApplied {
 		return false
 	}
@@ -2457,6 +4476,85 @@ func (in *SystemStatus) DeepEqual(other *SystemStatus) bool {
 		return false
 	}
 
+	if ((in.Certificates != nil) && (other.Certificates != nil)) || ((in.Certificates == nil) != (other.Certificates == nil)) {
+		in, other := &in.Certificates, &other.Certificates
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if (in.License == nil) != (other.License == nil) {
+		return false
+	} else if in.License != nil {
+		if !in.License.DeepEqual(other.License) {
+			return false
+		}
+	}
+
+	if ((in.Registries != nil) && (other.Registries != nil)) || ((in.Registries == nil) != (other.Registries == nil)) {
+		in, other := &in.Registries, &other.Registries
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.BootstrapPhase != other.BootstrapPhase {
+		return false
+	}
+	if ((in.Alarms != nil) && (other.Alarms != nil)) || ((in.Alarms == nil) != (other.Alarms == nil)) {
+		in, other := &in.Alarms, &other.Alarms
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
 	return true
 }
 
@@ -2611,6 +4709,27 @@ func (in *VLANList) DeepEqual(other *VLANList) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *VirtualMediaInfo) DeepEqual(other *VirtualMediaInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Image != other.Image {
+		return false
+	}
+	if (in.Insecure == nil) != (other.Insecure == nil) {
+		return false
+	} else if in.Insecure != nil {
+		if *in.Insecure != *other.Insecure {
+			return false
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *VolumeGroupInfo) DeepEqual(other *VolumeGroupInfo) bool {
@@ -0,0 +1,15 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package v2 contains API Schema definitions for the StarlingX v2 API
+// group.
+//
+// The v2 API removes the attributes which are deprecated in the v1 API.  The
+// v1 API remains the storage version and the version used by the
+// reconcilers; resources written in either version are converted by the
+// conversion webhook so that existing deployment configurations continue to
+// be accepted.  Only the resources which differ between both versions are
+// defined in this package.
+// +kubebuilder:object:generate=true
+// +groupName=starlingx.windriver.com
+package v2
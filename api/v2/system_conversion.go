@@ -0,0 +1,133 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// LegacyPTPAnnotation defines the annotation which preserves the deprecated
+// PTP attributes of a v1 System.  They are not part of the v2 API but are
+// restored when the resource is converted back to v1 so that no
+// configuration is lost.
+const LegacyPTPAnnotation = "deployment-manager/legacy-ptp"
+
+var _ conversion.Convertible = &System{}
+
+// ConvertTo converts this System to the hub (v1) version.
+func (src *System) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*starlingxv1.System)
+	spec := src.Spec.DeepCopy()
+
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	src.Status.DeepCopyInto(&dst.Status)
+
+	dst.Spec = starlingxv1.SystemSpec{
+		EndpointSecret:       spec.EndpointSecret,
+		Description:          spec.Description,
+		Location:             spec.Location,
+		Latitude:             spec.Latitude,
+		Longitude:            spec.Longitude,
+		Contact:              spec.Contact,
+		Timezone:             spec.Timezone,
+		DNSServers:           spec.DNSServers,
+		NTPServers:           spec.NTPServers,
+		Certificates:         spec.Certificates,
+		License:              spec.License,
+		SNMP:                 spec.SNMP,
+		RemoteAuthentication: spec.RemoteAuthentication,
+		Registries:           spec.Registries,
+		ServiceParameters:    spec.ServiceParameters,
+		Storage:              spec.Storage,
+		VSwitchType:          spec.VSwitchType,
+		Bootstrap:            spec.Bootstrap,
+		Software:             spec.Software,
+		Preflight:            spec.Preflight,
+	}
+
+	if value, ok := dst.Annotations[LegacyPTPAnnotation]; ok {
+		ptp := &starlingxv1.PTPInfo{}
+		err := json.Unmarshal([]byte(value), ptp)
+		if err != nil {
+			return fmt.Errorf("unable to restore PTP attributes from the %s annotation: %w",
+				LegacyPTPAnnotation, err)
+		}
+
+		dst.Spec.PTP = ptp
+		delete(dst.Annotations, LegacyPTPAnnotation)
+	}
+
+	if spec.DeploymentScope != "" {
+		if dst.Annotations == nil {
+			dst.Annotations = make(map[string]string)
+		}
+		dst.Annotations[starlingxv1.DeploymentScopeAnnotation] = spec.DeploymentScope
+	} else {
+		delete(dst.Annotations, starlingxv1.DeploymentScopeAnnotation)
+	}
+
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the hub (v1) version to this System.
+func (dst *System) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*starlingxv1.System)
+	spec := src.Spec.DeepCopy()
+
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	src.Status.DeepCopyInto(&dst.Status)
+
+	dst.Spec = SystemSpec{
+		DeploymentScope:      dst.Annotations[starlingxv1.DeploymentScopeAnnotation],
+		EndpointSecret:       spec.EndpointSecret,
+		Description:          spec.Description,
+		Location:             spec.Location,
+		Latitude:             spec.Latitude,
+		Longitude:            spec.Longitude,
+		Contact:              spec.Contact,
+		Timezone:             spec.Timezone,
+		DNSServers:           spec.DNSServers,
+		NTPServers:           spec.NTPServers,
+		Certificates:         spec.Certificates,
+		License:              spec.License,
+		SNMP:                 spec.SNMP,
+		RemoteAuthentication: spec.RemoteAuthentication,
+		Registries:           spec.Registries,
+		ServiceParameters:    spec.ServiceParameters,
+		Storage:              spec.Storage,
+		VSwitchType:          spec.VSwitchType,
+		Bootstrap:            spec.Bootstrap,
+		Software:             spec.Software,
+		Preflight:            spec.Preflight,
+	}
+
+	delete(dst.Annotations, starlingxv1.DeploymentScopeAnnotation)
+
+	if spec.PTP != nil {
+		value, err := json.Marshal(spec.PTP)
+		if err != nil {
+			return fmt.Errorf("unable to preserve PTP attributes in the %s annotation: %w",
+				LegacyPTPAnnotation, err)
+		}
+
+		if dst.Annotations == nil {
+			dst.Annotations = make(map[string]string)
+		}
+		dst.Annotations[LegacyPTPAnnotation] = string(value)
+	}
+
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	return nil
+}
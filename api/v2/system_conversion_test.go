@@ -0,0 +1,115 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v2
+
+import (
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("System conversion", func() {
+	description := "lab system"
+	mode := "hardware"
+	transport := "l2"
+	servers := starlingxv1.DNSServerList{"8.8.8.8", "8.8.4.4"}
+
+	hub := func() *starlingxv1.System {
+		return &starlingxv1.System{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "vbox",
+				Namespace:   "deployment",
+				Annotations: map[string]string{"foo": "bar"},
+			},
+			Spec: starlingxv1.SystemSpec{
+				Description: &description,
+				DNSServers:  &servers,
+				PTP:         &starlingxv1.PTPInfo{Mode: &mode, Transport: &transport},
+				Preflight:   &starlingxv1.PreflightInfo{IgnoredAlarms: []string{"100.114"}},
+			},
+			Status: starlingxv1.SystemStatus{
+				ID:              "1234",
+				DeploymentScope: "bootstrap",
+			},
+		}
+	}
+
+	Context("from v1", func() {
+		It("moves the deprecated PTP attributes to an annotation", func() {
+			dst := &System{}
+			Expect(dst.ConvertFrom(hub())).To(Succeed())
+			Expect(dst.Name).To(Equal("vbox"))
+			Expect(dst.Spec.Preflight.IgnoredAlarms).To(Equal([]string{"100.114"}))
+			Expect(*dst.Spec.Description).To(Equal(description))
+			Expect(*dst.Spec.DNSServers).To(Equal(servers))
+			Expect(dst.Spec.DeploymentScope).To(BeEmpty())
+			Expect(dst.Status.ID).To(Equal("1234"))
+			Expect(dst.Annotations).To(HaveKeyWithValue("foo", "bar"))
+			Expect(dst.Annotations).To(HaveKeyWithValue(LegacyPTPAnnotation, `{"mode":"hardware","transport":"l2"}`))
+		})
+
+		It("round trips without losing attributes", func() {
+			src := hub()
+			v2 := &System{}
+			Expect(v2.ConvertFrom(src)).To(Succeed())
+
+			dst := &starlingxv1.System{}
+			Expect(v2.ConvertTo(dst)).To(Succeed())
+			Expect(dst).To(Equal(src))
+		})
+	})
+
+	Context("to v1", func() {
+		It("round trips the preflight check", func() {
+			src := &System{
+				ObjectMeta: metav1.ObjectMeta{Name: "vbox", Namespace: "deployment"},
+				Spec: SystemSpec{
+					Preflight: &starlingxv1.PreflightInfo{IgnoredAlarms: []string{"100.114", "800.001"}},
+				},
+			}
+
+			dst := &starlingxv1.System{}
+			Expect(src.ConvertTo(dst)).To(Succeed())
+			Expect(dst.Spec.Preflight).To(Equal(src.Spec.Preflight))
+
+			back := &System{}
+			Expect(back.ConvertFrom(dst)).To(Succeed())
+			Expect(back).To(Equal(src))
+		})
+
+		It("conveys the deployment scope through an annotation", func() {
+			src := &System{
+				ObjectMeta: metav1.ObjectMeta{Name: "vbox", Namespace: "deployment"},
+				Spec: SystemSpec{
+					DeploymentScope: "principal",
+					Description:     &description,
+				},
+			}
+
+			dst := &starlingxv1.System{}
+			Expect(src.ConvertTo(dst)).To(Succeed())
+			Expect(dst.Spec.PTP).To(BeNil())
+			Expect(*dst.Spec.Description).To(Equal(description))
+			Expect(dst.Annotations).To(Equal(map[string]string{
+				starlingxv1.DeploymentScopeAnnotation: "principal"}))
+
+			back := &System{}
+			Expect(back.ConvertFrom(dst)).To(Succeed())
+			Expect(back).To(Equal(src))
+		})
+
+		It("rejects an invalid PTP annotation", func() {
+			src := &System{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "vbox",
+					Annotations: map[string]string{LegacyPTPAnnotation: "{"},
+				},
+			}
+
+			Expect(src.ConvertTo(&starlingxv1.System{})).NotTo(Succeed())
+		})
+	})
+})
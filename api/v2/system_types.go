@@ -0,0 +1,170 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package v2
+
+import (
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SystemSpec defines the desired state of System.  It differs from the v1
+// SystemSpec as follows:
+//
+//   - the deprecated PTP attribute is removed since PTP is configured through
+//     the PtpInstance and PtpInterface resources.
+//   - the deployment scope is requested through the DeploymentScope attribute
+//     rather than by setting the deploymentScope status attribute.
+type SystemSpec struct {
+	// DeploymentScope defines whether the configuration is applied as part of
+	// the initial deployment or as a Day-2 update.
+	// +kubebuilder:validation:Enum=bootstrap;principal
+	// +optional
+	DeploymentScope string `json:"deploymentScope,omitempty"`
+
+	// EndpointSecret is the name of the secret which holds the credentials,
+	// authentication URL and region of the system API endpoint.  It allows
+	// each namespace to target a different system or region.  The
+	// "system-endpoint" secret is used when not specified.
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	EndpointSecret *string `json:"endpointSecret,omitempty"`
+
+	// Description is a free form string describing the intended purpose of the
+	// system.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Location is a short description of the system's physical location.
+	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9\-_\. ]+$
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// Latitude is the latitude geolocation coordinate of the system's physical
+	// location.
+	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9\-_\. ]+$
+	// +kubebuilder:validation:MaxLength=30
+	// +optional
+	Latitude *string `json:"latitude,omitempty"`
+
+	// Longitude is the longitude geolocation coordinate of the system's physical
+	// location.
+	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9\-_\. ]+$
+	// +kubebuilder:validation:MaxLength=30
+	// +optional
+	Longitude *string `json:"longitude,omitempty"`
+
+	// Contact is a method to reach the person responsible for the system.  For
+	// example it could be an email address,
+	// phone number, or physical address.
+	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9@\-_\. ]+$
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Contact *string `json:"contact,omitempty"`
+
+	// Timezone is the name of the timezone configured on the system (e.g.,
+	// "UTC", "America/Toronto").
+	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9\-_+/]+$
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Nameservers is an array of Domain SystemName servers.  Each server can be
+	// specified as either an IPv4 or IPv6
+	// address.  Servers are configured in the order listed and any server
+	// not listed is removed from the system.
+	// +optional
+	DNSServers *starlingxv1.DNSServerList `json:"dnsServers,omitempty"`
+
+	// NTPServers is an array of Network Time Protocol servers.  Each server can
+	// be specified as either an IPv4 or IPv6
+	// address, or a FQDN hostname.
+	// +optional
+	NTPServers *starlingxv1.NTPServerList `json:"ntpServers,omitempty"`
+
+	// Certificates is a list of references to certificates that must be
+	// installed.
+	// +optional
+	Certificates *starlingxv1.CertificateList `json:"certificates,omitempty"`
+
+	// License is a reference to a license file that must be installed.
+	// +optional
+	License *starlingxv1.LicenseInfo `json:"license,omitempty"`
+
+	// SNMP defines the SNMP communities and trap destinations to be
+	// configured on the system.
+	// +optional
+	SNMP *starlingxv1.SNMPInfo `json:"snmp,omitempty"`
+
+	// RemoteAuthentication defines the remote domains used to authenticate
+	// users logging in to the platform.
+	// +optional
+	RemoteAuthentication *starlingxv1.RemoteAuthenticationInfo `json:"remoteAuthentication,omitempty"`
+
+	// Registries is a list of container image registries used in place of
+	// the public registries.
+	// +optional
+	Registries *starlingxv1.RegistryList `json:"registries,omitempty"`
+
+	// ServiceParameters is a list of service parameters
+	// +optional
+	ServiceParameters *starlingxv1.ServiceParameterList `json:"serviceParameters,omitempty"`
+
+	// Storage is a set of storage specific attributes to be configured for the
+	// system.
+	// +optional
+	Storage *starlingxv1.SystemStorageInfo `json:"storage,omitempty"`
+
+	// VSwitchType is the desired vswitch implementation to be configured. This
+	// is intentionally left unvalidated to avoid issues with proprietary
+	// vswitch implementation.
+	// +optional
+	VSwitchType *string `json:"vswitchType,omitempty"`
+
+	// Bootstrap defines the health checks which gate the phases of the
+	// controller bootstrap sequence.
+	// +optional
+	Bootstrap *starlingxv1.BootstrapInfo `json:"bootstrap,omitempty"`
+
+	// Software defines the software patches which are installed before the
+	// initial unlock of controller-0.
+	// +optional
+	Software *starlingxv1.SoftwareInfo `json:"software,omitempty"`
+
+	// Preflight defines the health check run before a disruptive action is
+	// issued to any host of the system.
+	// +optional
+	Preflight *starlingxv1.PreflightInfo `json:"preflight,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// System defines the attributes that represent the system level attributes
+// of a StarlingX system.  The status is identical to the v1 System status.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="mode",type="string",JSONPath=".status.systemMode",description="The configured system mode."
+// +kubebuilder:printcolumn:name="type",type="string",JSONPath=".status.systemType",description="The configured system type."
+// +kubebuilder:printcolumn:name="version",type="string",JSONPath=".status.softwareVersion",description="The current software version"
+// +kubebuilder:printcolumn:name="insync",type="boolean",JSONPath=".status.inSync",description="The current synchronization state."
+// +kubebuilder:printcolumn:name="scope",type="string",JSONPath=".status.deploymentScope",description="The current deploymentScope state."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type System struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SystemSpec               `json:"spec,omitempty"`
+	Status starlingxv1.SystemStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// SystemList contains a list of System
+type SystemList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []System `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&System{}, &SystemList{})
+}
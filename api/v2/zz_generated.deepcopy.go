@@ -0,0 +1,211 @@
+//go:build !ignore_autogenerated
+
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *System) DeepCopyInto(out *System) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new System.
+func (in *System) DeepCopy() *System {
+	if in == nil {
+		return nil
+	}
+	out := new(System)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *System) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemList) DeepCopyInto(out *SystemList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]System, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemList.
+func (in *SystemList) DeepCopy() *SystemList {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SystemList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
+	*out = *in
+	if in.EndpointSecret != nil {
+		in, out := &in.EndpointSecret, &out.EndpointSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.Latitude != nil {
+		in, out := &in.Latitude, &out.Latitude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Longitude != nil {
+		in, out := &in.Longitude, &out.Longitude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Contact != nil {
+		in, out := &in.Contact, &out.Contact
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = new(v1.DNSServerList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(v1.DNSServerList, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = new(v1.NTPServerList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(v1.NTPServerList, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = new(v1.CertificateList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(v1.CertificateList, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(v1.LicenseInfo)
+		**out = **in
+	}
+	if in.SNMP != nil {
+		in, out := &in.SNMP, &out.SNMP
+		*out = new(v1.SNMPInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteAuthentication != nil {
+		in, out := &in.RemoteAuthentication, &out.RemoteAuthentication
+		*out = new(v1.RemoteAuthenticationInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = new(v1.RegistryList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(v1.RegistryList, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+	if in.ServiceParameters != nil {
+		in, out := &in.ServiceParameters, &out.ServiceParameters
+		*out = new(v1.ServiceParameterList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(v1.ServiceParameterList, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(v1.SystemStorageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VSwitchType != nil {
+		in, out := &in.VSwitchType, &out.VSwitchType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = new(v1.BootstrapInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Software != nil {
+		in, out := &in.Software, &out.Software
+		*out = new(v1.SoftwareInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(v1.PreflightInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemSpec.
+func (in *SystemSpec) DeepCopy() *SystemSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -124,12 +124,53 @@ func parseIncompleteSecret(secret *v1.Secret) *IncompleteSecret {
 }
 
 // IncompleteSecret defines a struct that contains a warning message in the secret
-// data if the secret is incomplete
+// data if the secret is incomplete.  It is rendered as a Secret manifest whose
+// data is provided in plain text through the "stringData" attribute.
 type IncompleteSecret struct {
-	TypeMeta   metav1.TypeMeta
-	ObjectMeta metav1.ObjectMeta
-	Type       v1.SecretType
-	Data       map[string]string
+	TypeMeta   metav1.TypeMeta   `json:",inline"`
+	ObjectMeta metav1.ObjectMeta `json:"metadata,omitempty"`
+	Type       v1.SecretType     `json:"type,omitempty"`
+	Data       map[string]string `json:"stringData,omitempty"`
+}
+
+// redactSecret converts a complete secret to an incomplete secret so that
+// its sensitive content is not written to the deployment configuration.  Only
+// the username and the attributes provided in plain text are preserved since
+// they do not contain any credentials.
+func redactSecret(secret *v1.Secret) *IncompleteSecret {
+	warningMsg := "Warning: Redacted secret, please replace it with the secret content"
+
+	data := make(map[string]string)
+	for key, value := range secret.StringData {
+		data[key] = value
+	}
+
+	for key, value := range secret.Data {
+		if key == manager.UsernameKey || key == v1.BasicAuthUsernameKey {
+			data[key] = string(value)
+		} else {
+			data[key] = warningMsg
+		}
+	}
+
+	return &IncompleteSecret{
+		TypeMeta:   secret.TypeMeta,
+		ObjectMeta: secret.ObjectMeta,
+		Type:       secret.Type,
+		Data:       data,
+	}
+}
+
+// RedactSecrets replaces each complete secret of the deployment with a
+// placeholder secret so that the deployment configuration can be stored
+// without exposing any credentials.  The placeholders must be edited to add
+// the redacted content before the deployment configuration is applied.
+func (d *Deployment) RedactSecrets() {
+	for _, s := range d.Secrets {
+		d.IncompleteSecrets = append(d.IncompleteSecrets, redactSecret(s))
+	}
+
+	d.Secrets = nil
 }
 
 // Deployment defines the structure used to store all of the details of a
@@ -181,6 +181,68 @@ var _ = Describe("Test Build utilities:", func() {
 		})
 	})
 
+	Describe("Test redact secrets", func() {
+		Context("when the deployment contains the endpoint secret", func() {
+			It("should replace the password with a placeholder", func() {
+				warningMsg := "Warning: Redacted secret, please replace it with the secret content"
+				secret := &v1.Secret{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "Secret",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      manager.SystemEndpointSecretName,
+						Namespace: "bar",
+					},
+					Type: v1.SecretTypeOpaque,
+					Data: map[string][]byte{
+						manager.UsernameKey: []byte("admin"),
+						manager.PasswordKey: []byte("secret"),
+					},
+					StringData: map[string]string{
+						manager.RegionNameKey: "RegionOne",
+					},
+				}
+				d := &Deployment{Secrets: []*v1.Secret{secret}}
+
+				d.RedactSecrets()
+				Expect(d.Secrets).To(BeEmpty())
+				Expect(d.IncompleteSecrets).To(HaveLen(1))
+				Expect(d.IncompleteSecrets[0].ObjectMeta).To(Equal(secret.ObjectMeta))
+				Expect(d.IncompleteSecrets[0].Data).To(Equal(map[string]string{
+					manager.UsernameKey:   "admin",
+					manager.PasswordKey:   warningMsg,
+					manager.RegionNameKey: "RegionOne",
+				}))
+			})
+		})
+
+		Context("when the deployment is rendered", func() {
+			It("should render placeholders as secret manifests", func() {
+				d := &Deployment{
+					IncompleteSecrets: []*IncompleteSecret{{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: "v1",
+							Kind:       "Secret",
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "foo",
+							Namespace: "bar",
+						},
+						Type: v1.SecretTypeBasicAuth,
+						Data: map[string]string{
+							v1.BasicAuthUsernameKey: "admin",
+						},
+					}},
+				}
+
+				out, err := d.ToYAML()
+				Expect(err).To(BeNil())
+				Expect(out).To(ContainSubstring("kind: Secret\nmetadata:\n  name: foo\n  namespace: bar\nstringData:\n  username: admin\ntype: kubernetes.io/basic-auth\n"))
+			})
+		})
+	})
+
 	Describe("Test NewEndpointSecretFromEnv", func() {
 		Context("When NewEndpointSecretFromEnv is tested", func() {
 			It("Tests NewEndpointSecretFromEnv", func() {
@@ -454,6 +454,7 @@ const (
 	oamNetwork     = "oam"
 	oamIface       = "oam0"
 	adminNetwork   = "admin"
+	adminIface     = "admin0"
 )
 
 func (in *InterfaceNamingFilter) CheckInterface(info *v1.CommonInterfaceInfo) {
@@ -486,6 +487,11 @@ func (in *InterfaceNamingFilter) CheckInterface(info *v1.CommonInterfaceInfo) {
 			in.updates[info.Name] = oamIface
 			info.Name = oamIface
 		}
+	} else if utils.ContainsString(networks, adminNetwork) {
+		if info.Name != adminIface {
+			in.updates[info.Name] = adminIface
+			info.Name = adminIface
+		}
 	}
 }
 
@@ -958,3 +964,102 @@ func (in *HostKernelFilter) Filter(profile *v1.HostProfile, host *v1.Host, deplo
 
 	return nil
 }
+
+// NoBoardManagementFilter defines a host filter that removes the board
+// management configuration from hosts and profiles along with the secret
+// generated to hold the board management credentials.  This is useful when
+// the board management controllers are provisioned independently of the
+// deployment configuration.
+type NoBoardManagementFilter struct {
+}
+
+func NewNoBoardManagementFilter() *NoBoardManagementFilter {
+	return &NoBoardManagementFilter{}
+}
+
+func (in *NoBoardManagementFilter) Filter(profile *v1.HostProfile, host *v1.Host, deployment *Deployment) error {
+	bm := profile.Spec.BoardManagement
+	if bm != nil && bm.Credentials != nil && bm.Credentials.Password != nil {
+		secrets := make([]*IncompleteSecret, 0)
+		for _, s := range deployment.IncompleteSecrets {
+			if s.ObjectMeta.Name != bm.Credentials.Password.Secret {
+				secrets = append(secrets, s)
+			}
+		}
+		deployment.IncompleteSecrets = secrets
+	}
+
+	profile.Spec.BoardManagement = nil
+	if host.Spec.Overrides != nil {
+		host.Spec.Overrides.BoardManagement = nil
+	}
+
+	return nil
+}
+
+// NoPtpFilter defines a host filter that removes the PTP instances and PTP
+// interfaces from the deployment along with any reference to them from hosts
+// and profiles.  This is useful when the PTP configuration is managed
+// independently of the deployment configuration.
+type NoPtpFilter struct {
+}
+
+func NewNoPtpFilter() *NoPtpFilter {
+	return &NoPtpFilter{}
+}
+
+func (in *NoPtpFilter) CheckInterface(info *v1.CommonInterfaceInfo) {
+	info.PTPRole = nil
+	info.PtpInterfaces = nil
+}
+
+func (in *NoPtpFilter) CheckInterfaces(interfaces *v1.InterfaceInfo) {
+	if interfaces == nil {
+		return
+	}
+
+	for idx := range interfaces.Ethernet {
+		in.CheckInterface(&interfaces.Ethernet[idx].CommonInterfaceInfo)
+	}
+
+	for idx := range interfaces.Bond {
+		in.CheckInterface(&interfaces.Bond[idx].CommonInterfaceInfo)
+	}
+
+	for idx := range interfaces.VLAN {
+		in.CheckInterface(&interfaces.VLAN[idx].CommonInterfaceInfo)
+	}
+
+	for idx := range interfaces.VF {
+		in.CheckInterface(&interfaces.VF[idx].CommonInterfaceInfo)
+	}
+}
+
+func (in *NoPtpFilter) Filter(profile *v1.HostProfile, host *v1.Host, deployment *Deployment) error {
+	deployment.PtpInstances = nil
+	deployment.PtpInterfaces = nil
+
+	profile.Spec.PtpInstances = nil
+	in.CheckInterfaces(profile.Spec.Interfaces)
+
+	if host.Spec.Overrides != nil {
+		host.Spec.Overrides.PtpInstances = nil
+		in.CheckInterfaces(host.Spec.Overrides.Interfaces)
+	}
+
+	return nil
+}
+
+// NoPtpSystemFilter defines a system filter that removes the system wide PTP
+// configuration.  It complements the NoPtpFilter host filter.
+type NoPtpSystemFilter struct {
+}
+
+func NewNoPtpSystemFilter() *NoPtpSystemFilter {
+	return &NoPtpSystemFilter{}
+}
+
+func (in *NoPtpSystemFilter) Filter(system *v1.System, deployment *Deployment) error {
+	system.Spec.PTP = nil
+	return nil
+}
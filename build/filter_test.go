@@ -12,6 +12,7 @@ import (
 	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -936,4 +937,117 @@ var _ = Describe("Test filters utilities:", func() {
 			})
 		})
 	})
+	Describe("Test InterfaceNamingFilter", func() {
+		Context("When an interface carries the admin network", func() {
+			It("Renames the interface and its VLAN lower references", func() {
+				filter := NewInterfaceNamingFilter()
+				filter.Reset()
+
+				networks := v1.PlatformNetworkItemList{"admin"}
+				hp := &v1.HostProfile{
+					Spec: v1.HostProfileSpec{
+						Interfaces: &v1.InterfaceInfo{
+							Ethernet: v1.EthernetList{
+								{CommonInterfaceInfo: v1.CommonInterfaceInfo{
+									Name:             "enp0s9",
+									PlatformNetworks: &networks,
+								}},
+							},
+							VLAN: v1.VLANList{
+								{CommonInterfaceInfo: v1.CommonInterfaceInfo{
+									Name: "vlan100",
+								}, Lower: "enp0s9"},
+							},
+						},
+					},
+				}
+
+				err := filter.Filter(hp, &Deployment{})
+				Expect(err).To(BeNil())
+				Expect(hp.Spec.Interfaces.Ethernet[0].Name).To(Equal("admin0"))
+				Expect(hp.Spec.Interfaces.VLAN[0].Lower).To(Equal("admin0"))
+			})
+		})
+	})
+	Describe("Test NoBoardManagementFilter", func() {
+		Context("When board management is configured", func() {
+			It("Removes the board management attributes and secret", func() {
+				filter := NewNoBoardManagementFilter()
+
+				address := "192.168.204.10"
+				hp := &v1.HostProfile{
+					Spec: v1.HostProfileSpec{
+						BoardManagement: &v1.BMInfo{
+							Credentials: &v1.BMCredentials{
+								Password: &v1.BMPasswordInfo{Secret: "bmc-secret"},
+							},
+						},
+					},
+				}
+				h := &v1.Host{
+					Spec: v1.HostSpec{
+						Overrides: &v1.HostProfileSpec{
+							BoardManagement: &v1.BMInfo{Address: &address},
+						},
+					},
+				}
+				deployment := &Deployment{
+					IncompleteSecrets: []*IncompleteSecret{
+						{ObjectMeta: metav1.ObjectMeta{Name: "bmc-secret"}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "ssl-secret"}},
+					},
+				}
+
+				err := filter.Filter(hp, h, deployment)
+				Expect(err).To(BeNil())
+				Expect(hp.Spec.BoardManagement).To(BeNil())
+				Expect(h.Spec.Overrides.BoardManagement).To(BeNil())
+				Expect(deployment.IncompleteSecrets).To(HaveLen(1))
+				Expect(deployment.IncompleteSecrets[0].ObjectMeta.Name).To(Equal("ssl-secret"))
+			})
+		})
+	})
+	Describe("Test NoPtpFilter", func() {
+		Context("When PTP instances and interfaces are configured", func() {
+			It("Removes the PTP resources and their references", func() {
+				filter := NewNoPtpFilter()
+
+				role := "master"
+				ptpInterfaces := v1.PtpInterfaceItemList{"ptpint1"}
+				hp := &v1.HostProfile{
+					Spec: v1.HostProfileSpec{
+						ProfileBaseAttributes: v1.ProfileBaseAttributes{
+							PtpInstances: v1.PtpInstanceItemList{"ptp1"},
+						},
+						Interfaces: &v1.InterfaceInfo{
+							Ethernet: v1.EthernetList{
+								{CommonInterfaceInfo: v1.CommonInterfaceInfo{
+									Name:          "enp0s3",
+									PTPRole:       &role,
+									PtpInterfaces: &ptpInterfaces,
+								}},
+							},
+						},
+					},
+				}
+				h := &v1.Host{
+					Spec: v1.HostSpec{
+						Overrides: &v1.HostProfileSpec{},
+					},
+				}
+				deployment := &Deployment{
+					PtpInstances:  []*v1.PtpInstance{{}},
+					PtpInterfaces: []*v1.PtpInterface{{}},
+				}
+
+				err := filter.Filter(hp, h, deployment)
+				Expect(err).To(BeNil())
+				Expect(deployment.PtpInstances).To(BeNil())
+				Expect(deployment.PtpInterfaces).To(BeNil())
+				Expect(hp.Spec.PtpInstances).To(BeNil())
+				Expect(hp.Spec.Interfaces.Ethernet[0].PTPRole).To(BeNil())
+				Expect(hp.Spec.Interfaces.Ethernet[0].PtpInterfaces).To(BeNil())
+			})
+		})
+	})
 })
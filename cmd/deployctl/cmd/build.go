@@ -32,6 +32,10 @@ const (
 	NormalizeInterfaceMTUFilterArg   = "normalize-mtu"
 	NormalizeConsoleFilterArg        = "normalize-console"
 	MinimalConfigFilterArg           = "minimal-config"
+	NoBoardManagementFilterArg       = "no-bmc"
+	NoPtpFilterArg                   = "no-ptp"
+	RedactSecretsArg                 = "redact-secrets"
+	NoTimestampArg                   = "no-timestamp"
 )
 
 func CollectCmdRun(cmd *cobra.Command, args []string) {
@@ -43,6 +47,10 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 	var noCorePlatformNetworks bool
 	var noFileSystems bool
 	var noServiceParams bool
+	var noBoardManagement bool
+	var noPtp bool
+	var redactSecrets bool
+	var noTimestamp bool
 	var outputFile *os.File
 	var minimalConfig bool
 	var noProcessors bool
@@ -169,6 +177,30 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 		os.Exit(16)
 	}
 
+	if noBoardManagement, err = cmd.Flags().GetBool(NoBoardManagementFilterArg); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			NoBoardManagementFilterArg)
+		os.Exit(17)
+	}
+
+	if noPtp, err = cmd.Flags().GetBool(NoPtpFilterArg); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			NoPtpFilterArg)
+		os.Exit(18)
+	}
+
+	if redactSecrets, err = cmd.Flags().GetBool(RedactSecretsArg); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			RedactSecretsArg)
+		os.Exit(19)
+	}
+
+	if noTimestamp, err = cmd.Flags().GetBool(NoTimestampArg); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			NoTimestampArg)
+		os.Exit(20)
+	}
+
 	if minimalConfig {
 		noCACertificates = true
 		noDefaults = true
@@ -292,6 +324,20 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 		builder.AddProfileFilters(profileFilters)
 	}
 
+	hostFilters := make([]build.HostFilter, 0)
+
+	if noBoardManagement {
+		hostFilters = append(hostFilters, build.NewNoBoardManagementFilter())
+	}
+
+	if noPtp {
+		hostFilters = append(hostFilters, build.NewNoPtpFilter())
+	}
+
+	if len(hostFilters) > 0 {
+		builder.AddHostFilters(hostFilters)
+	}
+
 	systemFilters := make([]build.SystemFilter, 0)
 
 	if noDRBDLinkUtilization {
@@ -310,6 +356,10 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 		systemFilters = append(systemFilters, build.NewNoServiceParametersSystemFilter())
 	}
 
+	if noPtp {
+		systemFilters = append(systemFilters, build.NewNoPtpSystemFilter())
+	}
+
 	if len(systemFilters) > 0 {
 		builder.AddSystemFilters(systemFilters)
 	}
@@ -330,15 +380,26 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 		os.Exit(40)
 	}
 
+	if redactSecrets {
+		deployment.RedactSecrets()
+	}
+
 	yamlBuf, err := deployment.ToYAML()
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "failed to convert deployment struct to YAML: %s\n", err.Error())
 		os.Exit(41)
 	}
 
-	_, err = fmt.Fprintf(outputFile, "# Generated: %s\n# Tool version: %s\n",
-		time.Now().Format(time.UnixDate),
-		VersionToString())
+	if noTimestamp {
+		// Omit the generation time so that the output of successive runs
+		// against an unchanged system is identical.
+		_, err = fmt.Fprintf(outputFile, "# Tool version: %s\n",
+			VersionToString())
+	} else {
+		_, err = fmt.Fprintf(outputFile, "# Generated: %s\n# Tool version: %s\n",
+			time.Now().Format(time.UnixDate),
+			VersionToString())
+	}
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "failed to write to output file: %s\n", err.Error())
 		os.Exit(42)
@@ -363,8 +424,9 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 		fmt.Printf("  Secrets that must be manually edited to add information that is not\n")
 		fmt.Printf("  retrievable from the system.  For example, any BMC Secrets must be\n")
 		fmt.Printf("  edited to add the password and any SSL Secrets must be edited to add\n")
-		fmt.Printf("  the certificate and key information.  Any such information must be\n")
-		fmt.Printf("  added in base64 encoded format.\n")
+		fmt.Printf("  the certificate and key information.  Any redacted Secrets must also\n")
+		fmt.Printf("  be edited to restore their content.  Such information is provided in\n")
+		fmt.Printf("  plain text within the stringData attribute of each Secret.\n")
 		os.Exit(44)
 	}
 }
@@ -399,4 +461,8 @@ func init() {
 	collectCmd.Flags().Bool(NormalizeInterfaceMTUFilterArg, false, "Normalize interface MTU values")
 	collectCmd.Flags().Bool(NormalizeConsoleFilterArg, false, "Normalize serial console attributes")
 	collectCmd.Flags().Bool(MinimalConfigFilterArg, false, "Shorthand notation for adding all available filters")
+	collectCmd.Flags().Bool(NoBoardManagementFilterArg, false, "Exclude board management configurations and secrets")
+	collectCmd.Flags().Bool(NoPtpFilterArg, false, "Exclude all PTP configurations")
+	collectCmd.Flags().Bool(RedactSecretsArg, false, "Replace secret contents with placeholders")
+	collectCmd.Flags().Bool(NoTimestampArg, false, "Exclude the generation time from the output")
 }
@@ -0,0 +1,289 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+const (
+	StatusNamespaceArg  = "namespace"
+	StatusKubeconfigArg = "kubeconfig"
+)
+
+// statusNone is displayed in place of attributes which are not set or do not
+// apply to a resource.
+const statusNone = "-"
+
+// statusRow defines the sync state of a single resource as displayed by the
+// status subcommand.
+type statusRow struct {
+	kind        string
+	name        string
+	personality string
+	admin       string
+	oper        string
+	avail       string
+	reconciled  bool
+	inSync      bool
+	blocking    string
+	pending     []string
+}
+
+// stringOrNone returns the value of an optional attribute or a placeholder if
+// it is not set.
+func stringOrNone(value *string) string {
+	if value == nil || *value == "" {
+		return statusNone
+	}
+	return *value
+}
+
+// conditionsRow fills the blocking and pending columns of a row from the
+// standard conditions of a resource.  A Blocked condition takes precedence
+// over a Degraded condition since it explains why a resource is not progressing.
+func conditionsRow(row *statusRow, conditions []metav1.Condition, strategy string) {
+	for _, condition := range []string{starlingxv1.ConditionBlocked, starlingxv1.ConditionDegraded, starlingxv1.ConditionPaused} {
+		if c := meta.FindStatusCondition(conditions, condition); c != nil && c.Status == metav1.ConditionTrue {
+			row.blocking = fmt.Sprintf("%s: %s", c.Type, c.Message)
+			if c.Message == "" {
+				row.blocking = c.Type
+			}
+			break
+		}
+	}
+
+	if strategy != "" && strategy != manager.StrategyNotRequired {
+		row.pending = append(row.pending, strings.Replace(strategy, "_required", "", 1))
+	}
+
+	if meta.IsStatusConditionTrue(conditions, starlingxv1.ConditionPendingReboot) {
+		row.pending = append(row.pending, "reboot")
+	}
+}
+
+// hostPersonality returns the personality of a host from its overrides or
+// otherwise from its profile and each of its base profiles.
+func hostPersonality(c client.Client, host *starlingxv1.Host) string {
+	if host.Spec.Overrides != nil && host.Spec.Overrides.Personality != nil {
+		return *host.Spec.Overrides.Personality
+	}
+
+	visited := make(map[string]bool)
+	name := host.Spec.Profile
+	for name != "" && !visited[name] {
+		visited[name] = true
+
+		profile := &starlingxv1.HostProfile{}
+		key := client.ObjectKey{Namespace: host.Namespace, Name: name}
+		if err := c.Get(context.TODO(), key, profile); err != nil {
+			break
+		}
+
+		if profile.Spec.Personality != nil {
+			return *profile.Spec.Personality
+		}
+
+		name = ""
+		if profile.Spec.Base != nil {
+			name = *profile.Spec.Base
+		}
+	}
+
+	return statusNone
+}
+
+// collectStatus reads each System, Host and network resource in a namespace
+// and returns their sync state.
+func collectStatus(c client.Client, namespace string) ([]statusRow, error) {
+	opts := []client.ListOption{client.InNamespace(namespace)}
+	rows := make([]statusRow, 0)
+
+	systems := &starlingxv1.SystemList{}
+	if err := c.List(context.TODO(), systems, opts...); err != nil {
+		return nil, err
+	}
+
+	for _, s := range systems.Items {
+		row := statusRow{kind: "System", name: s.Name,
+			personality: statusNone, admin: statusNone, oper: statusNone, avail: statusNone,
+			reconciled: s.Status.Reconciled, inSync: s.Status.InSync}
+		conditionsRow(&row, s.Status.Conditions, s.Status.StrategyRequired)
+		rows = append(rows, row)
+	}
+
+	hosts := &starlingxv1.HostList{}
+	if err := c.List(context.TODO(), hosts, opts...); err != nil {
+		return nil, err
+	}
+
+	for i := range hosts.Items {
+		h := &hosts.Items[i]
+		row := statusRow{kind: "Host", name: h.Name,
+			personality: hostPersonality(c, h),
+			admin:       stringOrNone(h.Status.AdministrativeState),
+			oper:        stringOrNone(h.Status.OperationalStatus),
+			avail:       stringOrNone(h.Status.AvailabilityStatus),
+			reconciled:  h.Status.Reconciled, inSync: h.Status.InSync}
+		conditionsRow(&row, h.Status.Conditions, h.Status.StrategyRequired)
+		rows = append(rows, row)
+	}
+
+	platformNetworks := &starlingxv1.PlatformNetworkList{}
+	if err := c.List(context.TODO(), platformNetworks, opts...); err != nil {
+		return nil, err
+	}
+
+	for _, n := range platformNetworks.Items {
+		row := statusRow{kind: "PlatformNetwork", name: n.Name,
+			personality: statusNone, admin: statusNone, oper: statusNone, avail: statusNone,
+			reconciled: n.Status.Reconciled, inSync: n.Status.InSync}
+		conditionsRow(&row, n.Status.Conditions, n.Status.StrategyRequired)
+		rows = append(rows, row)
+	}
+
+	dataNetworks := &starlingxv1.DataNetworkList{}
+	if err := c.List(context.TODO(), dataNetworks, opts...); err != nil {
+		return nil, err
+	}
+
+	for _, n := range dataNetworks.Items {
+		row := statusRow{kind: "DataNetwork", name: n.Name,
+			personality: statusNone, admin: statusNone, oper: statusNone, avail: statusNone,
+			reconciled: n.Status.Reconciled, inSync: n.Status.InSync}
+		conditionsRow(&row, n.Status.Conditions, n.Status.StrategyRequired)
+		rows = append(rows, row)
+	}
+
+	ptpInstances := &starlingxv1.PtpInstanceList{}
+	if err := c.List(context.TODO(), ptpInstances, opts...); err != nil {
+		return nil, err
+	}
+
+	for _, p := range ptpInstances.Items {
+		row := statusRow{kind: "PtpInstance", name: p.Name,
+			personality: statusNone, admin: statusNone, oper: statusNone, avail: statusNone,
+			reconciled: p.Status.Reconciled, inSync: p.Status.InSync}
+		conditionsRow(&row, p.Status.Conditions, p.Status.StrategyRequired)
+		rows = append(rows, row)
+	}
+
+	ptpInterfaces := &starlingxv1.PtpInterfaceList{}
+	if err := c.List(context.TODO(), ptpInterfaces, opts...); err != nil {
+		return nil, err
+	}
+
+	for _, p := range ptpInterfaces.Items {
+		row := statusRow{kind: "PtpInterface", name: p.Name,
+			personality: statusNone, admin: statusNone, oper: statusNone, avail: statusNone,
+			reconciled: p.Status.Reconciled, inSync: p.Status.InSync}
+		conditionsRow(&row, p.Status.Conditions, p.Status.StrategyRequired)
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// printStatus renders the sync state of the resources as a table.
+func printStatus(out io.Writer, rows []statusRow) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tNAME\tPERSONALITY\tADMIN\tOPER\tAVAIL\tRECONCILED\tINSYNC\tPENDING\tBLOCKING")
+	for _, row := range rows {
+		pending := statusNone
+		if len(row.pending) > 0 {
+			pending = strings.Join(row.pending, ",")
+		}
+
+		blocking := row.blocking
+		if blocking == "" {
+			blocking = statusNone
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			row.kind, row.name, row.personality, row.admin, row.oper, row.avail,
+			strconv.FormatBool(row.reconciled), strconv.FormatBool(row.inSync),
+			pending, blocking)
+	}
+	w.Flush()
+}
+
+func StatusCmdRun(cmd *cobra.Command, args []string) {
+	namespace, _ := cmd.Flags().GetString(StatusNamespaceArg)
+	kubeconfig, _ := cmd.Flags().GetString(StatusKubeconfigArg)
+
+	if kubeconfig != "" {
+		// The controller-runtime configuration loader honours the standard
+		// environment variable.
+		os.Setenv("KUBECONFIG", kubeconfig)
+	}
+
+	cfg, err := config.GetConfig()
+	if err != nil {
+		fmt.Printf("failed to load the kubernetes configuration: %s\n", err)
+		os.Exit(1)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := starlingxv1.AddToScheme(scheme); err != nil {
+		fmt.Printf("failed to register the deployment resources: %s\n", err)
+		os.Exit(2)
+	}
+
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Printf("failed to create the kubernetes client: %s\n", err)
+		os.Exit(3)
+	}
+
+	rows, err := collectStatus(c, namespace)
+	if err != nil {
+		fmt.Printf("failed to read the deployment resources in namespace %q: %s\n", namespace, err)
+		os.Exit(4)
+	}
+
+	if len(rows) == 0 {
+		fmt.Printf("no deployment resources found in namespace %q\n", namespace)
+		return
+	}
+
+	printStatus(os.Stdout, rows)
+}
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "The status subcommand summarizes the sync state of a deployment",
+	Long: `The status subcommand summarizes the sync state of the System, Host and
+network resources of a deployment namespace in a single table.  It reports the
+personality and state of each host, whether each resource is reconciled and
+in sync, any condition which is blocking its reconciliation, and any
+disruptive action (i.e., lock, unlock, reboot) which is pending.  This command
+reads the resources from the kubernetes cluster using the current kubeconfig.
+
+When installed as "kubectl-dm" in the PATH this tool is also available as a
+kubectl plugin (i.e., "kubectl dm status").`,
+	Run: StatusCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+
+	statusCmd.Flags().StringP(StatusNamespaceArg, "n", "deployment", "The namespace containing the deployment resources")
+	statusCmd.Flags().String(StatusKubeconfigArg, "", "Path to the kubeconfig file (default is $KUBECONFIG or $HOME/.kube/config)")
+}
@@ -0,0 +1,134 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package cmd
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// statusColumns splits the rendered status table into its rows and columns.
+// The columns are separated by at least two spaces.
+func statusColumns(out string) [][]string {
+	separator := regexp.MustCompile(`\s{2,}`)
+	result := make([][]string, 0)
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		result = append(result, separator.Split(strings.TrimSpace(line), -1))
+	}
+	return result
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+var _ = Describe("Status subcommand", func() {
+	var c client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+
+		system := &starlingxv1.System{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "deployment"},
+			Status: starlingxv1.SystemStatus{
+				Reconciled:       true,
+				InSync:           true,
+				StrategyRequired: manager.StrategyNotRequired,
+			},
+		}
+
+		// controller-0 inherits its personality from the base of its profile.
+		base := &starlingxv1.HostProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "controller-base", Namespace: "deployment"},
+		}
+		base.Spec.Personality = stringPtr("controller")
+		profile := &starlingxv1.HostProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "controller-profile", Namespace: "deployment"},
+		}
+		profile.Spec.Base = stringPtr("controller-base")
+
+		controller := &starlingxv1.Host{
+			ObjectMeta: metav1.ObjectMeta{Name: "controller-0", Namespace: "deployment"},
+			Spec:       starlingxv1.HostSpec{Profile: "controller-profile"},
+			Status: starlingxv1.HostStatus{
+				AdministrativeState: stringPtr("unlocked"),
+				OperationalStatus:   stringPtr("enabled"),
+				AvailabilityStatus:  stringPtr("available"),
+				Reconciled:          true,
+				InSync:              true,
+				StrategyRequired:    manager.StrategyNotRequired,
+			},
+		}
+
+		worker := &starlingxv1.Host{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Namespace: "deployment"},
+			Spec: starlingxv1.HostSpec{
+				Profile:   "missing-profile",
+				Overrides: &starlingxv1.HostProfileSpec{},
+			},
+			Status: starlingxv1.HostStatus{
+				AdministrativeState: stringPtr("locked"),
+				StrategyRequired:    manager.StrategyUnlockRequired,
+				Conditions: []metav1.Condition{
+					{Type: starlingxv1.ConditionDegraded, Status: metav1.ConditionTrue, Reason: "Failed", Message: "last reconcile failed"},
+					{Type: starlingxv1.ConditionBlocked, Status: metav1.ConditionTrue, Reason: "Waiting", Message: "waiting for controller-0"},
+					{Type: starlingxv1.ConditionPendingReboot, Status: metav1.ConditionTrue, Reason: "Pending"},
+				},
+			},
+		}
+		worker.Spec.Overrides.Personality = stringPtr("worker")
+
+		// Resources of other namespaces are never reported.
+		other := &starlingxv1.Host{
+			ObjectMeta: metav1.ObjectMeta{Name: "controller-1", Namespace: "other"},
+		}
+
+		network := &starlingxv1.PlatformNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "deployment"},
+			Status: starlingxv1.PlatformNetworkStatus{
+				Reconciled: true,
+				Conditions: []metav1.Condition{
+					{Type: starlingxv1.ConditionPaused, Status: metav1.ConditionTrue, Reason: "Paused"},
+				},
+			},
+		}
+
+		c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			system, base, profile, controller, worker, other, network).Build()
+	})
+
+	It("should summarize the resources of the namespace in a single table", func() {
+		rows, err := collectStatus(c, "deployment")
+		Expect(err).ToNot(HaveOccurred())
+
+		var out bytes.Buffer
+		printStatus(&out, rows)
+
+		Expect(statusColumns(out.String())).To(Equal([][]string{
+			{"KIND", "NAME", "PERSONALITY", "ADMIN", "OPER", "AVAIL", "RECONCILED", "INSYNC", "PENDING", "BLOCKING"},
+			{"System", "default", "-", "-", "-", "-", "true", "true", "-", "-"},
+			{"Host", "controller-0", "controller", "unlocked", "enabled", "available", "true", "true", "-", "-"},
+			{"Host", "worker-0", "worker", "locked", "-", "-", "false", "false", "unlock,reboot", "Blocked: waiting for controller-0"},
+			{"PlatformNetwork", "mgmt", "-", "-", "-", "-", "true", "false", "-", "Paused"},
+		}))
+	})
+
+	It("should report nothing for an empty namespace", func() {
+		rows, err := collectStatus(c, "empty")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rows).To(BeEmpty())
+	})
+})
@@ -0,0 +1,136 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wind-river/cloud-platform-deployment-manager/validate"
+)
+
+const (
+	ValidateStrictArg = "strict"
+)
+
+// validateStdin is the path used to read a bundle from the standard input.
+const validateStdin = "-"
+
+// bundleFiles expands each path into the list of YAML files it designates.
+// Directories are searched recursively and kustomize configuration files are
+// skipped since they do not describe deployment resources.
+func bundleFiles(paths []string) ([]string, error) {
+	result := make([]string, 0)
+
+	for _, path := range paths {
+		if path == validateStdin {
+			result = append(result, path)
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			result = append(result, path)
+			continue
+		}
+
+		files := make([]string, 0)
+		err = filepath.WalkDir(path, func(name string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			base := strings.ToLower(entry.Name())
+			ext := filepath.Ext(base)
+			if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") &&
+				strings.TrimSuffix(base, ext) != "kustomization" {
+				files = append(files, name)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Strings(files)
+		result = append(result, files...)
+	}
+
+	return result, nil
+}
+
+func ValidateCmdRun(cmd *cobra.Command, args []string) {
+	strict, _ := cmd.Flags().GetBool(ValidateStrictArg)
+
+	files, err := bundleFiles(args)
+	if err != nil {
+		fmt.Printf("failed to find the deployment files: %s\n", err)
+		os.Exit(2)
+	}
+
+	bundle := validate.NewBundle()
+	for _, file := range files {
+		var data []byte
+		if file == validateStdin {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(file)
+		}
+		if err != nil {
+			fmt.Printf("failed to read %q: %s\n", file, err)
+			os.Exit(3)
+		}
+
+		bundle.Load(file, data)
+	}
+
+	problems := bundle.Validate()
+	for _, p := range problems {
+		fmt.Println(p.String())
+	}
+
+	if validate.HasErrors(problems) || (strict && len(problems) > 0) {
+		fmt.Printf("%d problem(s) found in %d resource(s)\n", len(problems), len(bundle.Documents))
+		os.Exit(1)
+	}
+
+	fmt.Printf("%d resource(s) validated\n", len(bundle.Documents))
+}
+
+// validateCmd represents the validate command
+var validateCmd = &cobra.Command{
+	Use:   "validate FILE|DIRECTORY|- ...",
+	Short: "The validate subcommand checks a deployment configuration offline",
+	Long: `The validate subcommand checks a full deployment configuration without
+access to a cluster or to the target system.  Each resource is decoded against
+its schema and checked with the same rules as the admission webhooks.  The
+references between resources (i.e., profiles, data networks, address pools,
+ptp instances, ptp interfaces and secrets) are resolved within the
+configuration and each profile hierarchy is flattened to catch loops,
+conflicting mixins and inconsistent composite profiles.
+
+Each problem is reported with the file and line of the resource along with
+the path of the offending field.  The command exits with a non-zero status if
+any error is found, or if any warning is found when --strict is set, so that
+it can be used as a gate in CI pipelines.`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  ValidateCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+
+	validateCmd.Flags().Bool(ValidateStrictArg, false, "Treat warnings (e.g., references to address pools not defined in the configuration) as errors")
+}
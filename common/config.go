@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package common
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	perrors "github.com/pkg/errors"
@@ -26,74 +27,102 @@ type ReconcilerName string
 
 // Defines the current list of supported reconcilers and sub-reconcilers.
 const (
-	DataNetwork       ReconcilerName = "dataNetwork"
-	Host              ReconcilerName = "host"
-	BMC               ReconcilerName = "host.bmc"
-	Kernel            ReconcilerName = "host.kernel"
-	Memory            ReconcilerName = "host.memory"
-	Processor         ReconcilerName = "host.processor"
-	Storage           ReconcilerName = "host.storage"
-	FileSystemTypes   ReconcilerName = "host.storage.fileSystemTypes"
-	FileSystemSizes   ReconcilerName = "host.storage.fileSystemSizes"
-	StorageMonitor    ReconcilerName = "host.storage.monitor"
-	OSD               ReconcilerName = "host.storage.osd"
-	Partition         ReconcilerName = "host.storage.partition"
-	PhysicalVolume    ReconcilerName = "host.storage.physicalVolume"
-	VolumeGroup       ReconcilerName = "host.storage.volumeGroup"
-	Networking        ReconcilerName = "host.networking"
-	Address           ReconcilerName = "host.networking.address"
-	Interface         ReconcilerName = "host.networking.interface"
-	Route             ReconcilerName = "host.networking.route"
-	HostProfile       ReconcilerName = "hostProfile"
-	PlatformNetwork   ReconcilerName = "platformNetwork"
-	System            ReconcilerName = "system"
-	Certificate       ReconcilerName = "system.certificate"
-	DNS               ReconcilerName = "system.dns"
-	DRBD              ReconcilerName = "system.drbd"
-	SystemFileSystems ReconcilerName = "system.filesystems"
-	License           ReconcilerName = "system.license"
-	NTP               ReconcilerName = "system.ntp"
-	PTP               ReconcilerName = "system.ptp"
-	Backends          ReconcilerName = "system.storage.backend"
-	ServiceParameters ReconcilerName = "system.serviceParameters"
-	PTPInstance       ReconcilerName = "ptpInstance"
-	PTPInterface      ReconcilerName = "ptpInterface"
+	Application          ReconcilerName = "application"
+	Backup               ReconcilerName = "backup"
+	DataNetwork          ReconcilerName = "dataNetwork"
+	DeviceImage          ReconcilerName = "deviceImage"
+	Host                 ReconcilerName = "host"
+	HostAdoption         ReconcilerName = "host.adoption"
+	BMC                  ReconcilerName = "host.bmc"
+	Kernel               ReconcilerName = "host.kernel"
+	Device               ReconcilerName = "host.device"
+	SensorGroup          ReconcilerName = "host.sensorGroup"
+	Memory               ReconcilerName = "host.memory"
+	Processor            ReconcilerName = "host.processor"
+	Storage              ReconcilerName = "host.storage"
+	FileSystemTypes      ReconcilerName = "host.storage.fileSystemTypes"
+	FileSystemSizes      ReconcilerName = "host.storage.fileSystemSizes"
+	StorageMonitor       ReconcilerName = "host.storage.monitor"
+	OSD                  ReconcilerName = "host.storage.osd"
+	Partition            ReconcilerName = "host.storage.partition"
+	PhysicalVolume       ReconcilerName = "host.storage.physicalVolume"
+	VolumeGroup          ReconcilerName = "host.storage.volumeGroup"
+	Networking           ReconcilerName = "host.networking"
+	Address              ReconcilerName = "host.networking.address"
+	Interface            ReconcilerName = "host.networking.interface"
+	Route                ReconcilerName = "host.networking.route"
+	HostProfile          ReconcilerName = "hostProfile"
+	PlatformNetwork      ReconcilerName = "platformNetwork"
+	System               ReconcilerName = "system"
+	Certificate          ReconcilerName = "system.certificate"
+	DNS                  ReconcilerName = "system.dns"
+	DRBD                 ReconcilerName = "system.drbd"
+	SystemFileSystems    ReconcilerName = "system.filesystems"
+	License              ReconcilerName = "system.license"
+	NTP                  ReconcilerName = "system.ntp"
+	PTP                  ReconcilerName = "system.ptp"
+	Backends             ReconcilerName = "system.storage.backend"
+	ServiceParameters    ReconcilerName = "system.serviceParameters"
+	SNMP                 ReconcilerName = "system.snmp"
+	RemoteAuthentication ReconcilerName = "system.remoteAuthentication"
+	Registries           ReconcilerName = "system.registries"
+	KubeUpgrade          ReconcilerName = "kubeUpgrade"
+	PTPInstance          ReconcilerName = "ptpInstance"
+	PTPInterface         ReconcilerName = "ptpInterface"
+	Restore              ReconcilerName = "restore"
+	SoftwareDeploy       ReconcilerName = "softwareDeploy"
+	Strategy             ReconcilerName = "strategy"
+	Subcloud             ReconcilerName = "subcloud"
 )
 
 // reconcilerDefaultStates is the default state of each reconciler.
 var reconcilerDefaultStates = map[ReconcilerName]bool{
-	DataNetwork:       true,
-	Host:              true,
-	BMC:               true,
-	Kernel:            true,
-	Memory:            true,
-	Processor:         true,
-	Storage:           true,
-	FileSystemTypes:   true,
-	FileSystemSizes:   true,
-	StorageMonitor:    true,
-	OSD:               true,
-	Partition:         true,
-	PhysicalVolume:    true,
-	VolumeGroup:       true,
-	Networking:        true,
-	Address:           true,
-	Interface:         true,
-	Route:             true,
-	HostProfile:       true,
-	PlatformNetwork:   true,
-	System:            true,
-	Certificate:       true,
-	DNS:               true,
-	DRBD:              true,
-	SystemFileSystems: true,
-	License:           true,
-	NTP:               true,
-	PTP:               true,
-	Backends:          true,
-	ServiceParameters: true,
-	PTPInstance:       true,
-	PTPInterface:      true,
+	Application:          true,
+	Backup:               true,
+	DataNetwork:          true,
+	DeviceImage:          true,
+	Host:                 true,
+	HostAdoption:         false,
+	BMC:                  true,
+	Kernel:               true,
+	Device:               true,
+	SensorGroup:          true,
+	Memory:               true,
+	Processor:            true,
+	Storage:              true,
+	FileSystemTypes:      true,
+	FileSystemSizes:      true,
+	StorageMonitor:       true,
+	OSD:                  true,
+	Partition:            true,
+	PhysicalVolume:       true,
+	VolumeGroup:          true,
+	Networking:           true,
+	Address:              true,
+	Interface:            true,
+	Route:                true,
+	HostProfile:          true,
+	PlatformNetwork:      true,
+	System:               true,
+	Certificate:          true,
+	DNS:                  true,
+	DRBD:                 true,
+	SystemFileSystems:    true,
+	License:              true,
+	NTP:                  true,
+	PTP:                  true,
+	Backends:             true,
+	ServiceParameters:    true,
+	SNMP:                 true,
+	RemoteAuthentication: true,
+	Registries:           true,
+	KubeUpgrade:          true,
+	PTPInstance:          true,
+	PTPInterface:         true,
+	Restore:              true,
+	SoftwareDeploy:       true,
+	Strategy:             true,
+	Subcloud:             true,
 }
 
 // OptionName is the type alias that represents the path for a reconciler
@@ -104,6 +133,11 @@ type OptionName string
 const (
 	HTTPSRequired   OptionName = "httpsRequired"
 	StopAfterInSync OptionName = "stopAfterInSync"
+	AllowReboot     OptionName = "allowReboot"
+	AllowSwact      OptionName = "allowSwact"
+
+	MaxConcurrentReconciles OptionName = "maxConcurrentReconciles"
+	DefaultProfile          OptionName = "defaultProfile"
 )
 
 // reconcilerOptionDefaults is the default value for each reconciler option.
@@ -114,9 +148,15 @@ var reconcilerOptionDefaults = map[ReconcilerName]map[OptionName]interface{}{
 	BMC: {
 		HTTPSRequired: true,
 	},
+	Memory: {
+		AllowReboot: false,
+	},
 	DataNetwork: {
 		StopAfterInSync: true,
 	},
+	DeviceImage: {
+		StopAfterInSync: true,
+	},
 	PTPInterface: {
 		StopAfterInSync: true,
 	},
@@ -124,7 +164,12 @@ var reconcilerOptionDefaults = map[ReconcilerName]map[OptionName]interface{}{
 		StopAfterInSync: true,
 	},
 	Host: {
-		StopAfterInSync: true,
+		StopAfterInSync:         true,
+		AllowSwact:              false,
+		MaxConcurrentReconciles: 4,
+	},
+	HostAdoption: {
+		DefaultProfile: "",
 	},
 	PlatformNetwork: {
 		StopAfterInSync: true,
@@ -134,6 +179,103 @@ var reconcilerOptionDefaults = map[ReconcilerName]map[OptionName]interface{}{
 	},
 }
 
+// AuditIntervalPath defines the config attribute path of the interval at
+// which reconciled resources are audited for configuration drift.  The value
+// is a duration (e.g., "30m"); auditing is disabled when it is not set.
+const AuditIntervalPath = "audit.interval"
+
+// InstantiateBuiltinProfilesPath defines the config attribute path which
+// determines whether built-in HostProfile templates are created in the
+// namespace of the hosts that reference them.  Otherwise, the templates are
+// used directly without being stored in the namespace.
+const InstantiateBuiltinProfilesPath = "profiles.instantiateBuiltin"
+
+// Defines the config attribute paths of the limits applied to the system API
+// requests of each namespace.  The rate limit is expressed in requests per
+// second and a rate limit of 0 disables rate limiting.  The circuit breaker
+// opens once the failure threshold of consecutive server errors or timeouts is
+// reached and rejects all requests until the cooldown duration has elapsed.
+const (
+	APIRateLimitPath        = "api.rateLimit"
+	APIBurstPath            = "api.burst"
+	APIFailureThresholdPath = "api.failureThreshold"
+	APICooldownPath         = "api.cooldown"
+)
+
+// Defines the default limits applied to the system API requests.
+const (
+	DefaultAPIRateLimit        = 10.0
+	DefaultAPIBurst            = 20
+	DefaultAPIFailureThreshold = 5
+	DefaultAPICooldown         = 30 * time.Second
+)
+
+// InventoryCacheTTLPath defines the config attribute path which determines
+// how long the host and system inventory snapshots read from the system API
+// are shared between reconcilers.  A TTL of 0 disables the inventory cache.
+const InventoryCacheTTLPath = "inventory.cacheTTL"
+
+// DefaultInventoryCacheTTL defines the default lifetime of the inventory
+// snapshots.
+const DefaultInventoryCacheTTL = 10 * time.Second
+
+// EventDedupWindowPath defines the config attribute path of the window during
+// which identical events generated for the same resource are collapsed into a
+// single event.  A window of 0 disables event deduplication.
+const EventDedupWindowPath = "events.dedupWindow"
+
+// DefaultEventDedupWindow defines the default event deduplication window.
+const DefaultEventDedupWindow = 5 * time.Minute
+
+// Defines the config attribute paths of the change audit log.  Every request
+// which modifies the system configuration is logged when the audit log is
+// enabled.  The previous value of the attributes being modified is read from
+// the system before each update or deletion when previous values are enabled.
+const (
+	ChangeAuditEnabledPath        = "changeAudit.enabled"
+	ChangeAuditPreviousValuesPath = "changeAudit.previousValues"
+)
+
+// PlanModeEnabledPath defines the config attribute path which determines
+// whether the manager runs in plan mode.  In plan mode the reconcilers report
+// the changes they intend to make but no request which modifies the system
+// configuration is sent.  Plan mode can also be enabled for a single
+// namespace through an annotation on the namespace.
+const PlanModeEnabledPath = "plan.enabled"
+
+// ExternalCredentialsDirectoryPath defines the config attribute path of the
+// directory into which an external secret provider (e.g., the Secrets Store
+// CSI driver) writes the system endpoint credentials.  The credentials of an
+// endpoint secret are read from the <namespace>/<secret name> subdirectory
+// when it exists.  External credentials are disabled when empty.
+const ExternalCredentialsDirectoryPath = "credentials.externalDirectory"
+
+// Defines the config attribute paths of the alarm synchronization.  When
+// enabled the active alarms of each system are polled from the fault
+// management API at the configured interval and mirrored as events and as the
+// alarms attribute of the System status.  Either may be disabled separately.
+const (
+	AlarmSyncEnabledPath  = "alarmSync.enabled"
+	AlarmSyncIntervalPath = "alarmSync.interval"
+	AlarmSyncEventsPath   = "alarmSync.events"
+	AlarmSyncStatusPath   = "alarmSync.status"
+)
+
+// DefaultAlarmSyncInterval defines the default interval at which the active
+// alarms are polled.
+const DefaultAlarmSyncInterval = time.Minute
+
+// RootDeviceDenyListPath defines the config attribute path of the device path
+// patterns which are assumed to be the root device of hosts whose root device
+// is not known.  OSDs and physical volume disks matching these patterns are
+// rejected so that the boot disk is never wiped.
+const RootDeviceDenyListPath = "storage.rootDeviceDenyList"
+
+// DefaultRootDeviceDenyList defines the default root device patterns.  The
+// platform installs the root file system on /dev/sda unless configured
+// otherwise.
+var DefaultRootDeviceDenyList = []string{"/dev/sda"}
+
 // configFilepath is the absolute path of the manager config file.
 const configFilepath = "/etc/manager/controller_manager_config.yaml"
 
@@ -216,6 +358,210 @@ func GetReconcilerOptionBool(name ReconcilerName, option OptionName, defaultValu
 	return defaultValue
 }
 
+// GetReconcilerOptionString returns the value of the specified option as a
+// String value; otherwise the specified default value is returned if the
+// option does not exist.
+func GetReconcilerOptionString(name ReconcilerName, option OptionName, defaultValue string) string {
+	value := GetReconcilerOption(name, option)
+	if value != nil {
+		if str, ok := value.(string); ok {
+			return str
+		} else {
+			log.Info("unexpected option type",
+				"option", option, "type", reflect.TypeOf(value))
+		}
+	}
+
+	// Return the caller's default if not found.
+	return defaultValue
+}
+
+// GetReconcilerOptionInt returns the value of the specified option as an Int
+// value; otherwise the specified default value is returned if the option does
+// not exist or is not a number.
+func GetReconcilerOptionInt(name ReconcilerName, option OptionName, defaultValue int) int {
+	value := GetReconcilerOption(name, option)
+	switch v := value.(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case nil:
+	default:
+		log.Info("unexpected option type",
+			"option", option, "type", reflect.TypeOf(value))
+	}
+
+	// Return the caller's default if not found.
+	return defaultValue
+}
+
+// GetAuditInterval returns the interval at which reconciled resources are
+// audited for configuration drift; otherwise 0 is returned if auditing is
+// disabled or the configured value is invalid.
+func GetAuditInterval() time.Duration {
+	value := cfg.GetString(AuditIntervalPath)
+	if value == "" {
+		return 0
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval < 0 {
+		log.Info("invalid audit interval", "value", value)
+		return 0
+	}
+
+	return interval
+}
+
+// InstantiateBuiltinProfiles returns whether built-in HostProfile templates
+// are to be created in the namespace of the hosts that reference them.
+func InstantiateBuiltinProfiles() bool {
+	return cfg.GetBool(InstantiateBuiltinProfilesPath)
+}
+
+// GetAPIRateLimit returns the maximum sustained rate of system API requests
+// per second for each namespace.
+func GetAPIRateLimit() float64 {
+	value := cfg.GetFloat64(APIRateLimitPath)
+	if value < 0 {
+		log.Info("invalid API rate limit", "value", value)
+		return DefaultAPIRateLimit
+	}
+
+	return value
+}
+
+// GetAPIBurst returns the number of system API requests that may exceed the
+// rate limit for short periods of time.
+func GetAPIBurst() int {
+	value := cfg.GetInt(APIBurstPath)
+	if value < 1 {
+		log.Info("invalid API burst", "value", value)
+		return DefaultAPIBurst
+	}
+
+	return value
+}
+
+// GetAPIFailureThreshold returns the number of consecutive system API server
+// errors or timeouts after which the circuit breaker opens.
+func GetAPIFailureThreshold() int {
+	value := cfg.GetInt(APIFailureThresholdPath)
+	if value < 1 {
+		log.Info("invalid API failure threshold", "value", value)
+		return DefaultAPIFailureThreshold
+	}
+
+	return value
+}
+
+// GetAPICooldown returns the duration during which the system API requests
+// are rejected once the circuit breaker has opened.
+func GetAPICooldown() time.Duration {
+	value := cfg.GetString(APICooldownPath)
+
+	cooldown, err := time.ParseDuration(value)
+	if err != nil || cooldown <= 0 {
+		log.Info("invalid API cooldown", "value", value)
+		return DefaultAPICooldown
+	}
+
+	return cooldown
+}
+
+// GetInventoryCacheTTL returns the duration during which an inventory
+// snapshot is reused before being read again from the system API.
+func GetInventoryCacheTTL() time.Duration {
+	value := cfg.GetString(InventoryCacheTTLPath)
+
+	ttl, err := time.ParseDuration(value)
+	if err != nil || ttl < 0 {
+		log.Info("invalid inventory cache TTL", "value", value)
+		return DefaultInventoryCacheTTL
+	}
+
+	return ttl
+}
+
+// GetEventDedupWindow returns the window during which identical events
+// generated for the same resource are collapsed into a single event.
+func GetEventDedupWindow() time.Duration {
+	value := cfg.GetString(EventDedupWindowPath)
+
+	window, err := time.ParseDuration(value)
+	if err != nil || window < 0 {
+		log.Info("invalid event deduplication window", "value", value)
+		return DefaultEventDedupWindow
+	}
+
+	return window
+}
+
+// ChangeAuditEnabled returns whether the requests which modify the system
+// configuration are recorded in the change audit log.
+func ChangeAuditEnabled() bool {
+	return cfg.GetBool(ChangeAuditEnabledPath)
+}
+
+// ChangeAuditPreviousValues returns whether the previous value of the
+// attributes being modified is recorded in the change audit log.
+func ChangeAuditPreviousValues() bool {
+	return cfg.GetBool(ChangeAuditPreviousValuesPath)
+}
+
+// PlanModeEnabled returns whether the manager runs in plan mode for every
+// namespace.
+func PlanModeEnabled() bool {
+	return cfg.GetBool(PlanModeEnabledPath)
+}
+
+// AlarmSyncEnabled returns whether the active alarms of each system are
+// mirrored into Kubernetes.
+func AlarmSyncEnabled() bool {
+	return cfg.GetBool(AlarmSyncEnabledPath)
+}
+
+// GetAlarmSyncInterval returns the interval at which the active alarms are
+// polled from the fault management API.
+func GetAlarmSyncInterval() time.Duration {
+	value := cfg.GetString(AlarmSyncIntervalPath)
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		log.Info("invalid alarm sync interval", "value", value)
+		return DefaultAlarmSyncInterval
+	}
+
+	return interval
+}
+
+// AlarmSyncEvents returns whether raised and cleared alarms are reported as
+// events on the System resource.
+func AlarmSyncEvents() bool {
+	return cfg.GetBool(AlarmSyncEventsPath)
+}
+
+// AlarmSyncStatus returns whether the active alarms are reported in the
+// System status.
+func AlarmSyncStatus() bool {
+	return cfg.GetBool(AlarmSyncStatusPath)
+}
+
+// GetRootDeviceDenyList returns the device path patterns which are assumed to
+// be the root device of hosts whose root device is not known.
+func GetRootDeviceDenyList() []string {
+	return cfg.GetStringSlice(RootDeviceDenyListPath)
+}
+
+// GetExternalCredentialsDirectory returns the directory from which the system
+// endpoint credentials written by an external secret provider are read.
+func GetExternalCredentialsDirectory() string {
+	return cfg.GetString(ExternalCredentialsDirectoryPath)
+}
+
 func init() {
 	cfg = viper.New()
 
@@ -233,6 +579,22 @@ func init() {
 		}
 	}
 
+	cfg.SetDefault(APIRateLimitPath, DefaultAPIRateLimit)
+	cfg.SetDefault(APIBurstPath, DefaultAPIBurst)
+	cfg.SetDefault(APIFailureThresholdPath, DefaultAPIFailureThreshold)
+	cfg.SetDefault(APICooldownPath, DefaultAPICooldown.String())
+	cfg.SetDefault(InventoryCacheTTLPath, DefaultInventoryCacheTTL.String())
+	cfg.SetDefault(EventDedupWindowPath, DefaultEventDedupWindow.String())
+	cfg.SetDefault(ChangeAuditEnabledPath, true)
+	cfg.SetDefault(ChangeAuditPreviousValuesPath, true)
+	cfg.SetDefault(PlanModeEnabledPath, false)
+	cfg.SetDefault(ExternalCredentialsDirectoryPath, "")
+	cfg.SetDefault(RootDeviceDenyListPath, DefaultRootDeviceDenyList)
+	cfg.SetDefault(AlarmSyncEnabledPath, false)
+	cfg.SetDefault(AlarmSyncIntervalPath, DefaultAlarmSyncInterval.String())
+	cfg.SetDefault(AlarmSyncEventsPath, true)
+	cfg.SetDefault(AlarmSyncStatusPath, true)
+
 	cfg.SetConfigFile(configFilepath)
 	cfg.AutomaticEnv()
 }
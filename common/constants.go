@@ -8,6 +8,19 @@ const ServiceTypeIdentity = "identity"
 const ServiceTypePlatform = "platform"
 const ServiceTypeRadosgw = "radosgw"
 const ServiceTypeHttp = "http"
+const ServiceTypeKubernetes = "kubernetes"
+const ServiceTypeDocker = "docker"
+
+// ApplyRequiredServiceTypes lists the service types whose parameters are only
+// put into effect once they have been explicitly applied.
+var ApplyRequiredServiceTypes = [...]string{
+	ServiceTypeIdentity,
+	ServiceTypePlatform,
+	ServiceTypeRadosgw,
+	ServiceTypeHttp,
+	ServiceTypeKubernetes,
+	ServiceTypeDocker,
+}
 
 // Service Parameter Section
 const ServiceParamSectionHttpConfig = "config"
@@ -17,6 +30,8 @@ const ServiceParamSectionPlatformKernel = "kernel"
 const ServiceParamSectionIdentityConfig = "config"
 const ServiceParamSectionRadosgwConfig = "config"
 const ServiceParamSectionSecurityCompliance = "security_compliance"
+const ServiceParamSectionIdentityLDAPDomainPrefix = "ldap_domain"
+const ServiceParamSectionDockerRegistrySuffix = "-registry"
 
 // Service Parameter Name
 const ServiceParamHttpPortHttp = "http_port"
@@ -41,6 +56,20 @@ const ServiceParamPlatMtceMnfaThreshold = "mnfa_threshold"
 const ServiceParamPlatMtceMnfaTimeout = "mnfa_timeout"
 const ServiceParamPlatMtceWorkerBootTimeout = "worker_boot_timeout"
 
+const ServiceParamNameLDAPDomainName = "domain_name"
+const ServiceParamNameLDAPURI = "ldap_uri"
+const ServiceParamNameLDAPSearchBase = "ldap_search_base"
+const ServiceParamNameLDAPUserSearchBase = "ldap_user_search_base"
+const ServiceParamNameLDAPGroupSearchBase = "ldap_group_search_base"
+const ServiceParamNameLDAPAccessFilter = "ldap_access_filter"
+const ServiceParamNameLDAPBindDN = "ldap_default_bind_dn"
+const ServiceParamNameLDAPBindPassword = "ldap_default_authtok"
+
+const ServiceParamNameRegistryURL = "url"
+const ServiceParamNameRegistryType = "type"
+const ServiceParamNameRegistryAuthSecret = "auth-secret"
+const ServiceParamNameRegistrySecure = "secure"
+
 type ServiceParam struct {
 	Service   string
 	Section   string
@@ -0,0 +1,327 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FieldPathSegment defines a single step of a field path.  A step selects a
+// field of an object by its JSON name and optionally an element of the list
+// stored in that field either by index or by the value of one of its keys.
+type FieldPathSegment struct {
+	Name     string
+	Index    *int
+	Key      string
+	KeyValue string
+}
+
+var fieldPathSegmentRegex = regexp.MustCompile(`^([A-Za-z0-9_-]+)(?:\[([^\]=]+)(?:=([^\]]*))?\])?$`)
+
+// ParseFieldPath parses a JSONPath-style field path such as
+// "storage.filesystems[name=scratch].size" or "$.interfaces.ethernet[0].mtu"
+// into its segments.
+func ParseFieldPath(path string) ([]FieldPathSegment, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if trimmed == "" {
+		return nil, fmt.Errorf("field path %q is empty", path)
+	}
+
+	result := make([]FieldPathSegment, 0)
+	for _, s := range splitFieldPath(trimmed) {
+		match := fieldPathSegmentRegex.FindStringSubmatch(s)
+		if match == nil {
+			return nil, fmt.Errorf("field path %q has an invalid segment %q", path, s)
+		}
+
+		segment := FieldPathSegment{Name: match[1]}
+		if match[2] != "" && !strings.Contains(s, "=") {
+			index, err := strconv.Atoi(match[2])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("field path %q has an invalid index %q", path, match[2])
+			}
+			segment.Index = &index
+		} else if match[2] != "" {
+			segment.Key = match[2]
+			segment.KeyValue = match[3]
+		}
+
+		result = append(result, segment)
+	}
+
+	return result, nil
+}
+
+// splitFieldPath splits a field path on the dots which are not part of a
+// list selector since selector values may themselves contain dots.
+func splitFieldPath(path string) []string {
+	result := make([]string, 0)
+	depth := 0
+	start := 0
+
+	for i, c := range path {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '.':
+			if depth == 0 {
+				result = append(result, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(result, path[start:])
+}
+
+// selected determines whether a list element is selected by a segment.
+func (s FieldPathSegment) selected(index int, element interface{}) bool {
+	if s.Index != nil {
+		return *s.Index == index
+	}
+
+	obj, ok := element.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	value, ok := obj[s.Key]
+	return ok && fmt.Sprint(value) == s.KeyValue
+}
+
+// hasSelector determines whether a segment selects a list element.
+func (s FieldPathSegment) hasSelector() bool {
+	return s.Index != nil || s.Key != ""
+}
+
+// findElement returns the index of the list element selected by a segment.
+func (s FieldPathSegment) findElement(list []interface{}) int {
+	for i, element := range list {
+		if s.selected(i, element) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// lookupFieldPath returns the value found at a field path.
+func lookupFieldPath(node interface{}, segments []FieldPathSegment) (interface{}, bool) {
+	for _, s := range segments {
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		node, ok = obj[s.Name]
+		if !ok {
+			return nil, false
+		}
+
+		if s.hasSelector() {
+			list, _ := node.([]interface{})
+			index := s.findElement(list)
+			if index < 0 {
+				return nil, false
+			}
+			node = list[index]
+		}
+	}
+
+	return node, true
+}
+
+// replaceFieldPath stores a value at a field path or removes the value found
+// at the field path when the value is not present.  Missing intermediate
+// objects are only created when storing a value.
+func replaceFieldPath(node interface{}, segments []FieldPathSegment, value interface{}, present bool) interface{} {
+	obj, ok := node.(map[string]interface{})
+	if !ok {
+		if node != nil || !present {
+			return node
+		}
+		obj = make(map[string]interface{})
+	}
+
+	s := segments[0]
+	last := len(segments) == 1
+	child, exists := obj[s.Name]
+
+	if !s.hasSelector() {
+		switch {
+		case last && present:
+			obj[s.Name] = value
+		case last:
+			delete(obj, s.Name)
+		case exists || present:
+			obj[s.Name] = replaceFieldPath(child, segments[1:], value, present)
+		}
+		return obj
+	}
+
+	list, _ := child.([]interface{})
+	index := s.findElement(list)
+
+	switch {
+	case last && present && index >= 0:
+		list[index] = value
+	case last && present:
+		list = append(list, value)
+	case last && index >= 0:
+		list = append(list[:index], list[index+1:]...)
+	case index >= 0:
+		list[index] = replaceFieldPath(list[index], segments[1:], value, present)
+	default:
+		// The selected element only exists in the current configuration
+		// and is replaced as a whole only when it is the last segment.
+		return obj
+	}
+
+	obj[s.Name] = list
+
+	return obj
+}
+
+// toFieldMap converts a value to its generic JSON representation.
+func toFieldMap(in interface{}) (interface{}, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	err = json.Unmarshal(data, &result)
+
+	return result, err
+}
+
+// IgnoreFields replaces the values found at each field path of the desired
+// configuration with those found in the current configuration so that any
+// difference at those paths is ignored by a subsequent comparison.  A value
+// which is missing from the current configuration is removed from the
+// desired configuration.  The desired configuration must be a pointer.
+func IgnoreFields(desired, current interface{}, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	desiredMap, err := toFieldMap(desired)
+	if err != nil {
+		return err
+	}
+
+	currentMap, err := toFieldMap(current)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		segments, err := ParseFieldPath(path)
+		if err != nil {
+			return err
+		}
+
+		value, present := lookupFieldPath(currentMap, segments)
+		desiredMap = replaceFieldPath(desiredMap, segments, value, present)
+	}
+
+	data, err := json.Marshal(desiredMap)
+	if err != nil {
+		return err
+	}
+
+	// Reset the desired configuration so that removed values do not survive
+	// the conversion back from the generic representation.
+	target := reflect.ValueOf(desired).Elem()
+	target.Set(reflect.Zero(target.Type()))
+
+	return json.Unmarshal(data, desired)
+}
+
+// FindMergeConflicts returns the field paths which are set to different
+// values in both configurations.  Objects are compared field by field and
+// list elements are matched by their "name" attribute; lists whose elements
+// have no name are only considered equal when they are identical.  Values
+// that are only set in one of the configurations never conflict.
+func FindMergeConflicts(a, b interface{}) ([]string, error) {
+	aMap, err := toFieldMap(a)
+	if err != nil {
+		return nil, err
+	}
+
+	bMap, err := toFieldMap(b)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	findConflicts("$", aMap, bMap, &result)
+
+	return result, nil
+}
+
+// findConflicts recursively compares two generic values and records the path
+// of each value that differs.
+func findConflicts(path string, a, b interface{}, result *[]string) {
+	if a == nil || b == nil {
+		return
+	}
+
+	aObj, aOk := a.(map[string]interface{})
+	bObj, bOk := b.(map[string]interface{})
+	if aOk && bOk {
+		keys := make([]string, 0, len(aObj))
+		for key := range aObj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			findConflicts(path+"."+key, aObj[key], bObj[key], result)
+		}
+		return
+	}
+
+	aList, aOk := a.([]interface{})
+	bList, bOk := b.([]interface{})
+	if aOk && bOk && namedElements(aList) && namedElements(bList) {
+		for _, element := range aList {
+			name := fmt.Sprint(element.(map[string]interface{})["name"])
+			s := FieldPathSegment{Key: "name", KeyValue: name}
+			if index := s.findElement(bList); index >= 0 {
+				findConflicts(fmt.Sprintf("%s[name=%s]", path, name), element, bList[index], result)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		*result = append(*result, path)
+	}
+}
+
+// namedElements determines whether each element of a list is an object with
+// a "name" attribute.
+func namedElements(list []interface{}) bool {
+	for _, element := range list {
+		obj, ok := element.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		if _, ok = obj["name"]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,123 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type testFileSystem struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+type testStorage struct {
+	FileSystems []testFileSystem `json:"filesystems,omitempty"`
+	Monitor     *string          `json:"monitor,omitempty"`
+}
+
+type testProfile struct {
+	Location *string      `json:"location,omitempty"`
+	Storage  *testStorage `json:"storage,omitempty"`
+}
+
+var _ = Describe("Field path utils", func() {
+	Describe("ParseFieldPath", func() {
+		It("should parse field names and list selectors", func() {
+			segments, err := ParseFieldPath("$.storage.filesystems[name=scratch.fs].size")
+			Expect(err).To(BeNil())
+			Expect(segments).To(HaveLen(3))
+			Expect(segments[1].Key).To(Equal("name"))
+			Expect(segments[1].KeyValue).To(Equal("scratch.fs"))
+
+			segments, err = ParseFieldPath("interfaces.ethernet[0]")
+			Expect(err).To(BeNil())
+			Expect(*segments[1].Index).To(Equal(0))
+		})
+
+		It("should reject malformed paths", func() {
+			for _, path := range []string{"", "$", "storage..size", "storage[x", "filesystems[-1]"} {
+				_, err := ParseFieldPath(path)
+				Expect(err).ToNot(BeNil(), path)
+			}
+		})
+	})
+
+	Describe("IgnoreFields", func() {
+		It("should align ignored fields with the current configuration", func() {
+			location := "rack1"
+			monitor := "ceph"
+			desired := testProfile{
+				Location: &location,
+				Storage: &testStorage{
+					FileSystems: []testFileSystem{{Name: "scratch", Size: 10}, {Name: "docker", Size: 30}},
+					Monitor:     &monitor,
+				},
+			}
+			current := testProfile{
+				Storage: &testStorage{
+					FileSystems: []testFileSystem{{Name: "scratch", Size: 20}, {Name: "docker", Size: 20}},
+				},
+			}
+
+			paths := []string{"storage.filesystems[name=scratch].size", "storage.monitor", "location"}
+			Expect(IgnoreFields(&desired, &current, paths)).To(Succeed())
+			Expect(desired.Location).To(BeNil())
+			Expect(desired.Storage.Monitor).To(BeNil())
+			Expect(desired.Storage.FileSystems).To(Equal([]testFileSystem{
+				{Name: "scratch", Size: 20}, {Name: "docker", Size: 30},
+			}))
+		})
+
+		It("should add list elements that only exist in the current configuration", func() {
+			desired := testProfile{Storage: &testStorage{}}
+			current := testProfile{
+				Storage: &testStorage{FileSystems: []testFileSystem{{Name: "scratch", Size: 20}}},
+			}
+
+			Expect(IgnoreFields(&desired, &current, []string{"storage.filesystems[name=scratch]"})).To(Succeed())
+			Expect(desired.Storage.FileSystems).To(Equal([]testFileSystem{{Name: "scratch", Size: 20}}))
+		})
+	})
+
+	Describe("FindMergeConflicts", func() {
+		It("should report values set differently in both configurations", func() {
+			monitor1 := "ceph"
+			monitor2 := "none"
+			location := "rack1"
+			a := testProfile{
+				Location: &location,
+				Storage: &testStorage{
+					FileSystems: []testFileSystem{{Name: "scratch", Size: 10}, {Name: "docker", Size: 30}},
+					Monitor:     &monitor1,
+				},
+			}
+			b := testProfile{
+				Storage: &testStorage{
+					FileSystems: []testFileSystem{{Name: "scratch", Size: 20}, {Name: "backup", Size: 20}},
+					Monitor:     &monitor2,
+				},
+			}
+
+			conflicts, err := FindMergeConflicts(&a, &b)
+			Expect(err).To(BeNil())
+			Expect(conflicts).To(Equal([]string{
+				"$.storage.filesystems[name=scratch].size", "$.storage.monitor",
+			}))
+		})
+
+		It("should not report fragments that set different attributes", func() {
+			location := "rack1"
+			a := testProfile{Location: &location}
+			b := testProfile{
+				Storage: &testStorage{FileSystems: []testFileSystem{{Name: "scratch", Size: 20}}},
+			}
+
+			conflicts, err := FindMergeConflicts(&a, &b)
+			Expect(err).To(BeNil())
+			Expect(conflicts).To(BeEmpty())
+		})
+	})
+})
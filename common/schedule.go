@@ -0,0 +1,192 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// scheduleMacros defines the shorthand expressions accepted in place of the
+// five cron fields.
+var scheduleMacros = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+}
+
+// scheduleField defines the accepted range of a single cron field.
+type scheduleField struct {
+	name string
+	min  int
+	max  int
+}
+
+var scheduleFields = []scheduleField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// Schedule is a parsed standard cron expression made of the minute, hour, day
+// of month, month and day of week fields.  Each field is stored as a bitmask
+// of the values at which it matches.
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// Defines whether the day fields were restricted.  As with cron, a day
+	// matches either restricted field when both are restricted.
+	dayOfMonthStar bool
+	dayOfWeekStar  bool
+}
+
+// ParseSchedule parses a standard five field cron expression (e.g.,
+// "30 2 * * 1-5").  Each field accepts "*", single values, ranges, steps and
+// comma separated lists of those.  The @hourly, @daily, @weekly, @monthly and
+// @yearly macros are also accepted.
+func ParseSchedule(expression string) (*Schedule, error) {
+	expression = strings.TrimSpace(expression)
+	if macro, ok := scheduleMacros[expression]; ok {
+		expression = macro
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != len(scheduleFields) {
+		return nil, fmt.Errorf("expected %d fields but found %d in schedule %q",
+			len(scheduleFields), len(fields), expression)
+	}
+
+	masks := make([]uint64, len(fields))
+	for i, f := range fields {
+		mask, err := parseScheduleField(f, scheduleFields[i])
+		if err != nil {
+			return nil, err
+		}
+		masks[i] = mask
+	}
+
+	// Sunday may be written as either 0 or 7.
+	if masks[4]&(1<<7) != 0 {
+		masks[4] |= 1
+	}
+
+	return &Schedule{
+		minute:         masks[0],
+		hour:           masks[1],
+		dayOfMonth:     masks[2],
+		month:          masks[3],
+		dayOfWeek:      masks[4],
+		dayOfMonthStar: strings.HasPrefix(fields[2], "*"),
+		dayOfWeekStar:  strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseScheduleField converts a single cron field to the bitmask of the
+// values at which it matches.
+func parseScheduleField(value string, field scheduleField) (uint64, error) {
+	var mask uint64
+
+	for _, item := range strings.Split(value, ",") {
+		expression, step := item, 1
+		if before, after, found := strings.Cut(item, "/"); found {
+			n, err := strconv.Atoi(after)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid step %q in %s field", after, field.name)
+			}
+			expression, step = before, n
+		}
+
+		low, high := field.min, field.max
+		if expression != "*" {
+			var err error
+			before, after, found := strings.Cut(expression, "-")
+			if low, err = strconv.Atoi(before); err != nil {
+				return 0, fmt.Errorf("invalid value %q in %s field", before, field.name)
+			}
+
+			high = low
+			if found {
+				if high, err = strconv.Atoi(after); err != nil {
+					return 0, fmt.Errorf("invalid value %q in %s field", after, field.name)
+				}
+			} else if step > 1 {
+				// A step applied to a single value runs to the end of the
+				// range (e.g., 5/15 is equivalent to 5-59/15).
+				high = field.max
+			}
+		}
+
+		if low < field.min || high > field.max || low > high {
+			return 0, fmt.Errorf("value %q is out of range %d-%d in %s field",
+				expression, field.min, field.max, field.name)
+		}
+
+		for v := low; v <= high; v += step {
+			mask |= 1 << uint(v)
+		}
+	}
+
+	return mask, nil
+}
+
+// dayMatches determines whether the schedule runs on the day of a given time.
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dow := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if s.dayOfMonthStar || s.dayOfWeekStar {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// Next returns the first time strictly after a given time at which the
+// schedule runs.  A zero time is returned if the schedule never runs (e.g.,
+// on the 31st of February).
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	// Any valid schedule runs at least once within a leap year cycle.
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
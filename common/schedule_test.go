@@ -0,0 +1,84 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule utils", func() {
+	Describe("ParseSchedule utility", func() {
+		Context("with cron expressions", func() {
+			It("should reject invalid expressions", func() {
+				tests := []string{
+					"",
+					"* * * *",
+					"* * * * * *",
+					"60 * * * *",
+					"* 24 * * *",
+					"* * 0 * *",
+					"* * * 13 *",
+					"* * * * 8",
+					"5-1 * * * *",
+					"*/0 * * * *",
+					"a * * * *",
+					"@reboot",
+				}
+				for _, tt := range tests {
+					_, err := ParseSchedule(tt)
+					Expect(err).To(HaveOccurred(), tt)
+				}
+			})
+		})
+	})
+
+	Describe("Schedule Next utility", func() {
+		Context("with cron expressions", func() {
+			It("should return the next run time", func() {
+				start := time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC) // Friday
+				tests := []struct {
+					name       string
+					expression string
+					want       time.Time
+				}{
+					{name: "every-minute",
+						expression: "* * * * *",
+						want:       time.Date(2024, time.March, 15, 10, 31, 0, 0, time.UTC)},
+					{name: "daily-macro",
+						expression: "@daily",
+						want:       time.Date(2024, time.March, 16, 0, 0, 0, 0, time.UTC)},
+					{name: "steps",
+						expression: "*/20 * * * *",
+						want:       time.Date(2024, time.March, 15, 10, 40, 0, 0, time.UTC)},
+					{name: "list-and-range",
+						expression: "0 1,3-5 * * *",
+						want:       time.Date(2024, time.March, 16, 1, 0, 0, 0, time.UTC)},
+					{name: "weekdays",
+						expression: "30 2 * * 1-5",
+						want:       time.Date(2024, time.March, 18, 2, 30, 0, 0, time.UTC)},
+					{name: "sunday-as-seven",
+						expression: "0 0 * * 7",
+						want:       time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC)},
+					{name: "day-of-month-or-week",
+						expression: "0 0 20 * 0",
+						want:       time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC)},
+					{name: "leap-day",
+						expression: "0 0 29 2 *",
+						want:       time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+					{name: "never",
+						expression: "0 0 31 2 *",
+						want:       time.Time{}},
+				}
+				for _, tt := range tests {
+					schedule, err := ParseSchedule(tt.expression)
+					Expect(err).ToNot(HaveOccurred(), tt.name)
+					Expect(schedule.Next(start)).To(Equal(tt.want), tt.name)
+				}
+			})
+		})
+	})
+})
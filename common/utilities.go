@@ -6,6 +6,7 @@ package common
 import (
 	"net"
 	"regexp"
+	"strings"
 )
 
 // Determines if an address is an IPv4 address
@@ -132,6 +133,33 @@ func RemoveString(slice []string, s string) (result []string) {
 	return
 }
 
+// ParseOCIReference is a utility function that splits an OCI artifact
+// reference (e.g., oci://registry/repo:tag or oci://registry/repo@digest)
+// into its registry, repository and tag or digest.  It returns false if the
+// reference does not name a registry, a repository and a tag or digest.
+func ParseOCIReference(source string) (registry, repository, reference string, ok bool) {
+	if !strings.HasPrefix(source, "oci://") {
+		return "", "", "", false
+	}
+
+	registry, repository, found := strings.Cut(strings.TrimPrefix(source, "oci://"), "/")
+	if !found || registry == "" {
+		return "", "", "", false
+	}
+
+	if index := strings.LastIndex(repository, "@"); index != -1 {
+		repository, reference = repository[:index], repository[index+1:]
+	} else if index := strings.LastIndex(repository, ":"); index > strings.LastIndex(repository, "/") {
+		repository, reference = repository[:index], repository[index+1:]
+	}
+
+	if repository == "" || reference == "" {
+		return "", "", "", false
+	}
+
+	return registry, repository, reference, true
+}
+
 // DedupeSlice is a utility function that removes a duplicated element from
 // a slice.
 // TODO(yuxing): switch to generic comparable after switch to go 1.20 which
@@ -350,6 +350,55 @@ var _ = Describe("Common utils", func() {
 		})
 	})
 
+	Describe("ParseOCIReference utility", func() {
+		Context("with OCI references", func() {
+			It("should split the references into their elements", func() {
+				tests := []struct {
+					name       string
+					source     string
+					registry   string
+					repository string
+					reference  string
+					ok         bool
+				}{
+					{name: "tag",
+						source:     "oci://registry.local:9001/apps/cert-manager:1.0-1",
+						registry:   "registry.local:9001",
+						repository: "apps/cert-manager",
+						reference:  "1.0-1",
+						ok:         true,
+					},
+					{name: "digest",
+						source:     "oci://registry.local/cert-manager@sha256:0123abcd",
+						registry:   "registry.local",
+						repository: "cert-manager",
+						reference:  "sha256:0123abcd",
+						ok:         true,
+					},
+					{name: "missing tag",
+						source: "oci://registry.local:9001/apps/cert-manager",
+						ok:     false,
+					},
+					{name: "missing repository",
+						source: "oci://registry.local:9001",
+						ok:     false,
+					},
+					{name: "http source",
+						source: "http://registry.local/cert-manager.tgz",
+						ok:     false,
+					},
+				}
+				for _, tt := range tests {
+					registry, repository, reference, ok := ParseOCIReference(tt.source)
+					Expect(ok).To(Equal(tt.ok), tt.name)
+					Expect(registry).To(Equal(tt.registry), tt.name)
+					Expect(repository).To(Equal(tt.repository), tt.name)
+					Expect(reference).To(Equal(tt.reference), tt.name)
+				}
+			})
+		})
+	})
+
 	Describe("DedupeSlice utility", func() {
 		Context("with a slice with duplicates", func() {
 			It("should remove the string duplicates", func() {
@@ -0,0 +1,872 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/applications"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/helmcharts"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logApplication = log.Log.WithName("controller").WithName("application")
+
+const ApplicationControllerName = "application-controller"
+
+const ApplicationFinalizerName = "application.finalizers.windriver.com"
+
+// ApplicationDownloadTimeout defines the maximum amount of time allowed to
+// download an application tarball from its source location.
+const ApplicationDownloadTimeout = 5 * time.Minute
+
+// Defines the media types accepted when fetching the manifest of an OCI
+// artifact.
+var ociManifestMediaTypes = []string{
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+var _ reconcile.Reconciler = &ApplicationReconciler{}
+
+// ApplicationReconciler reconciles an Application object
+type ApplicationReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+}
+
+// applicationVersionChanged determines whether the application version found
+// on the system differs from the desired version, if any.
+func applicationVersionChanged(instance *starlingxv1.Application, app *applications.Application) bool {
+	return instance.Spec.Version != nil && *instance.Spec.Version != app.Version
+}
+
+// applicationReconciled determines whether the application has reached its
+// desired state.
+func applicationReconciled(instance *starlingxv1.Application, app *applications.Application) bool {
+	return app.Status == instance.Spec.State && !applicationVersionChanged(instance, app)
+}
+
+// helmOverridesChanged determines whether the user overrides found on the
+// system differ from the desired values.  Both are compared as documents so
+// that formatting differences are ignored.
+func helmOverridesChanged(desired string, current *string) (bool, error) {
+	a := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(desired), &a); err != nil {
+		return false, err
+	}
+
+	b := make(map[string]interface{})
+	if current != nil {
+		if err := yaml.Unmarshal([]byte(*current), &b); err != nil {
+			return false, err
+		}
+	}
+
+	if len(a) == 0 && len(b) == 0 {
+		// An empty document decodes to a nil map.
+		return false, nil
+	}
+
+	return !reflect.DeepEqual(a, b), nil
+}
+
+// ociBearerToken requests an anonymous token from the authorization service
+// described by a registry authentication challenge.
+func ociBearerToken(httpClient *http.Client, challenge string) (string, error) {
+	params := make(map[string]string)
+	challenge = strings.TrimSpace(strings.TrimPrefix(challenge, "Bearer"))
+	for _, param := range strings.Split(challenge, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if found {
+			params[key] = strings.Trim(value, "\"")
+		}
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		msg := fmt.Sprintf("unsupported registry authentication challenge: %s", challenge)
+		return "", common.NewResourceConfigurationDependency(msg)
+	}
+
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if value, ok := params[key]; ok {
+			query.Set(key, value)
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	response, err := httpClient.Get(realm.String())
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to request registry token: %s", realm.Host)
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		msg := fmt.Sprintf("unable to request registry token from %s: %s",
+			realm.Host, response.Status)
+		return "", common.NewResourceConfigurationDependency(msg)
+	}
+
+	var result struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+
+	err = json.NewDecoder(response.Body).Decode(&result)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to decode registry token: %s", realm.Host)
+		return "", err
+	}
+
+	if result.Token == "" {
+		return result.AccessToken, nil
+	}
+
+	return result.Token, nil
+}
+
+// ociGet issues a registry API request and retries it with an anonymous
+// token if the registry requires one.  The token is retained so that it can
+// be reused on subsequent requests.
+func ociGet(httpClient *http.Client, target string, accept []string, token *string) (*http.Response, error) {
+	request := func() (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(accept) > 0 {
+			req.Header.Set("Accept", strings.Join(accept, ", "))
+		}
+
+		if *token != "" {
+			req.Header.Set("Authorization", "Bearer "+*token)
+		}
+
+		return httpClient.Do(req)
+	}
+
+	response, err := request()
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := response.Header.Get("WWW-Authenticate")
+	if response.StatusCode != http.StatusUnauthorized || *token != "" ||
+		!strings.HasPrefix(challenge, "Bearer") {
+		return response, nil
+	}
+
+	response.Body.Close()
+
+	*token, err = ociBearerToken(httpClient, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return request()
+}
+
+// pullApplication fetches the application tarball stored as the first layer
+// of an OCI artifact.  The caller is responsible for closing the returned
+// content.
+func pullApplication(httpClient *http.Client, source string) (string, io.ReadCloser, error) {
+	registry, repository, reference, ok := utils.ParseOCIReference(source)
+	if !ok {
+		msg := fmt.Sprintf("invalid OCI reference: %s", source)
+		return "", nil, common.NewUserDataError(msg)
+	}
+
+	base := fmt.Sprintf("https://%s/v2/%s", registry, repository)
+	token := ""
+
+	response, err := ociGet(httpClient, base+"/manifests/"+reference, ociManifestMediaTypes, &token)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to fetch application manifest: %s", source)
+		return "", nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		msg := fmt.Sprintf("unable to fetch application manifest %s: %s",
+			source, response.Status)
+		return "", nil, common.NewResourceConfigurationDependency(msg)
+	}
+
+	var manifest struct {
+		Layers []struct {
+			Digest      string            `json:"digest"`
+			Annotations map[string]string `json:"annotations"`
+		} `json:"layers"`
+	}
+
+	err = json.NewDecoder(response.Body).Decode(&manifest)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to decode application manifest: %s", source)
+		return "", nil, err
+	}
+
+	if len(manifest.Layers) == 0 {
+		msg := fmt.Sprintf("application artifact %s does not contain any layers", source)
+		return "", nil, common.NewUserDataError(msg)
+	}
+
+	layer := manifest.Layers[0]
+
+	filename := layer.Annotations["org.opencontainers.image.title"]
+	if filename == "" {
+		filename = path.Base(repository) + ".tgz"
+	}
+
+	blob, err := ociGet(httpClient, base+"/blobs/"+layer.Digest, nil, &token)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to fetch application tarball: %s", source)
+		return "", nil, err
+	}
+
+	if blob.StatusCode != http.StatusOK {
+		blob.Body.Close()
+		msg := fmt.Sprintf("unable to fetch application tarball %s: %s",
+			source, blob.Status)
+		return "", nil, common.NewResourceConfigurationDependency(msg)
+	}
+
+	return filename, blob.Body, nil
+}
+
+// downloadApplication fetches the application tarball from its source
+// location.  The caller is responsible for closing the returned content.
+func downloadApplication(instance *starlingxv1.Application) (string, io.ReadCloser, error) {
+	source := instance.Spec.Source
+
+	logApplication.Info("downloading application", "source", source)
+
+	httpClient := &http.Client{Timeout: ApplicationDownloadTimeout}
+	if strings.HasPrefix(source, "oci://") {
+		return pullApplication(httpClient, source)
+	}
+
+	response, err := httpClient.Get(source)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to download application: %s", source)
+		return "", nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		msg := fmt.Sprintf("unable to download application %s: %s",
+			source, response.Status)
+		return "", nil, common.NewResourceConfigurationDependency(msg)
+	}
+
+	return path.Base(response.Request.URL.Path), response.Body, nil
+}
+
+// ReconcileNew is a method which handles reconciling a new application by
+// uploading its tarball to the system.
+func (r *ApplicationReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.Application) (*applications.Application, error) {
+	filename, content, err := downloadApplication(instance)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	opts := applications.UploadOpts{
+		Name:       instance.Name,
+		AppVersion: instance.Spec.Version,
+	}
+
+	logApplication.Info("uploading application", "opts", opts)
+
+	app, err := applications.Upload(client, opts, filename, content).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to upload: %s", common.FormatStruct(opts))
+		return nil, err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+		"application upload has been requested")
+
+	return app, nil
+}
+
+// ReconcileUpdate is a method which handles updating an applied application
+// to the version found at its source location.
+func (r *ApplicationReconciler) ReconcileUpdate(client *gophercloud.ServiceClient, instance *starlingxv1.Application) (*applications.Application, error) {
+	filename, content, err := downloadApplication(instance)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	opts := applications.UpdateOpts{
+		Name:            instance.Name,
+		AppVersion:      instance.Spec.Version,
+		ReuseUserValues: true,
+	}
+
+	logApplication.Info("updating application", "opts", opts)
+
+	app, err := applications.Update(client, opts, filename, content).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update: %s", common.FormatStruct(opts))
+		return nil, err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"application update to version %s has been requested", app.Version)
+
+	return app, nil
+}
+
+// ReconcileApply is a method which handles applying an uploaded application.
+func (r *ApplicationReconciler) ReconcileApply(client *gophercloud.ServiceClient, instance *starlingxv1.Application) (*applications.Application, error) {
+	logApplication.Info("applying application", "name", instance.Name)
+
+	app, err := applications.Apply(client, instance.Name).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to apply application: %s", instance.Name)
+		return nil, err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"application apply has been requested")
+
+	return app, nil
+}
+
+// ReconcileRemove is a method which handles removing an applied application
+// while leaving it uploaded to the system.
+func (r *ApplicationReconciler) ReconcileRemove(client *gophercloud.ServiceClient, instance *starlingxv1.Application) (*applications.Application, error) {
+	logApplication.Info("removing application", "name", instance.Name)
+
+	app, err := applications.Remove(client, instance.Name).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to remove application: %s", instance.Name)
+		return nil, err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"application remove has been requested")
+
+	return app, nil
+}
+
+// ReconcileReplace is a method which handles replacing an uploaded
+// application with the one found at its source location.  Only applied
+// applications can be updated in place.
+func (r *ApplicationReconciler) ReconcileReplace(client *gophercloud.ServiceClient, instance *starlingxv1.Application) (*applications.Application, error) {
+	logApplication.Info("deleting application", "name", instance.Name)
+
+	err := applications.Delete(client, instance.Name).ExtractErr()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to delete application: %s", instance.Name)
+		return nil, err
+	}
+
+	return r.ReconcileNew(client, instance)
+}
+
+// ReconcileHelmOverride is a method which handles pushing the user overrides
+// and attributes of a single chart to the system.  It returns true if the
+// chart was modified.
+func (r *ApplicationReconciler) ReconcileHelmOverride(client *gophercloud.ServiceClient, instance *starlingxv1.Application, override starlingxv1.HelmOverride) (bool, error) {
+	chart, err := helmcharts.GetHelmChart(client, instance.Name, override.Namespace, override.Chart)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get chart: %s/%s", override.Namespace, override.Chart)
+		return false, err
+	} else if chart == nil {
+		msg := fmt.Sprintf("application does not provide chart %s/%s",
+			override.Namespace, override.Chart)
+		return false, common.NewUserDataError(msg)
+	}
+
+	updated := false
+
+	if override.Values != nil {
+		changed, err := helmOverridesChanged(*override.Values, chart.UserOverrides)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to parse overrides of chart: %s/%s", override.Namespace, override.Chart)
+			return false, err
+		}
+
+		if changed {
+			logApplication.Info("updating chart overrides", "chart", override.Chart, "namespace", override.Namespace)
+
+			if strings.TrimSpace(*override.Values) == "" {
+				err = helmcharts.Delete(client, instance.Name, override.Namespace, override.Chart).ExtractErr()
+			} else {
+				opts := helmcharts.OverridesOpts{Values: *override.Values, Flag: helmcharts.FlagReset}
+				_, err = helmcharts.UpdateOverrides(client, instance.Name, override.Namespace, override.Chart, opts).Extract()
+			}
+
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to update overrides of chart: %s/%s", override.Namespace, override.Chart)
+				return false, err
+			}
+
+			updated = true
+		}
+	}
+
+	if override.Enabled != nil && *override.Enabled != chart.Attributes.Enabled {
+		logApplication.Info("updating chart attributes", "chart", override.Chart, "namespace", override.Namespace,
+			"enabled", *override.Enabled)
+
+		opts := helmcharts.AttributesOpts{Enabled: override.Enabled}
+		_, err = helmcharts.UpdateAttributes(client, instance.Name, override.Namespace, override.Chart, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update attributes of chart: %s/%s", override.Namespace, override.Chart)
+			return false, err
+		}
+
+		updated = true
+	}
+
+	if updated {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"overrides of chart %s/%s have been updated", override.Namespace, override.Chart)
+	}
+
+	return updated, nil
+}
+
+// ReconcileHelmOverrides is a method which handles pushing the user overrides
+// of every listed chart to the system.  It returns true if any chart was
+// modified.
+func (r *ApplicationReconciler) ReconcileHelmOverrides(client *gophercloud.ServiceClient, instance *starlingxv1.Application) (bool, error) {
+	updated := false
+
+	for _, override := range instance.Spec.HelmOverrides {
+		changed, err := r.ReconcileHelmOverride(client, instance, override)
+		if err != nil {
+			return updated, err
+		}
+
+		updated = updated || changed
+	}
+
+	return updated, nil
+}
+
+// ReconcileFailure is a method which handles an application whose last
+// operation has failed.  The operation is retried once per configuration
+// generation; after that the failure is reported and left for the operator
+// to resolve by modifying the resource.
+func (r *ApplicationReconciler) ReconcileFailure(client *gophercloud.ServiceClient, instance *starlingxv1.Application, app *applications.Application) (*applications.Application, error) {
+	if instance.Status.Status != "" {
+		if instance.Status.Status != app.Status {
+			r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+				"application operation has failed: %s", app.Status)
+		}
+
+		return app, nil
+	}
+
+	logApplication.Info("retrying failed application", "status", app.Status)
+
+	switch {
+	case app.Status == applications.StatusUploadFailed:
+		return r.ReconcileReplace(client, instance)
+	case instance.Spec.State == starlingxv1.ApplicationStateApplied:
+		return r.ReconcileApply(client, instance)
+	default:
+		return r.ReconcileRemove(client, instance)
+	}
+}
+
+// ReconcileExisting is a method which handles moving an existing application
+// to its desired state.
+func (r *ApplicationReconciler) ReconcileExisting(client *gophercloud.ServiceClient, instance *starlingxv1.Application, app *applications.Application) (*applications.Application, error) {
+	if app.InProgress() {
+		return app, nil
+	}
+
+	if app.Status != applications.StatusUploadFailed && len(instance.Spec.HelmOverrides) > 0 {
+		// The charts are only known to the system once the application has
+		// been uploaded.
+		updated, err := r.ReconcileHelmOverrides(client, instance)
+		if err != nil {
+			return app, err
+		}
+
+		if updated && applicationReconciled(instance, app) &&
+			app.Status == applications.StatusApplied {
+			// The new overrides only take effect once re-applied.
+			return r.ReconcileApply(client, instance)
+		}
+	}
+
+	if applicationReconciled(instance, app) {
+		return app, nil
+	}
+
+	if app.Failed() {
+		return r.ReconcileFailure(client, instance, app)
+	}
+
+	switch app.Status {
+	case applications.StatusUploaded:
+		if applicationVersionChanged(instance, app) {
+			return r.ReconcileReplace(client, instance)
+		}
+
+		return r.ReconcileApply(client, instance)
+
+	case applications.StatusApplied:
+		if instance.Spec.State == starlingxv1.ApplicationStateUploaded {
+			return r.ReconcileRemove(client, instance)
+		}
+
+		return r.ReconcileUpdate(client, instance)
+	}
+
+	msg := fmt.Sprintf("application is in an unexpected state: %s", app.Status)
+	return app, common.NewResourceStatusDependency(msg)
+}
+
+// ReconciledDeleted is a method which handles reconciling a deleted
+// application resource by removing and deleting the application from the
+// system.
+func (r *ApplicationReconciler) ReconciledDeleted(client *gophercloud.ServiceClient, instance *starlingxv1.Application, app *applications.Application) error {
+	if !utils.ContainsString(instance.ObjectMeta.Finalizers, ApplicationFinalizerName) {
+		return nil
+	}
+
+	if app != nil {
+		if app.InProgress() {
+			msg := fmt.Sprintf("waiting for application to finish %s before deleting it", app.Status)
+			return common.NewResourceStatusDependency(msg)
+		}
+
+		if app.Status != applications.StatusUploaded && app.Status != applications.StatusUploadFailed {
+			_, err := r.ReconcileRemove(client, instance)
+			if err != nil {
+				return err
+			}
+
+			return common.NewResourceStatusDependency("waiting for application to be removed before deleting it")
+		}
+
+		// Unless it was already deleted go ahead and attempt to delete it.
+		err := applications.Delete(client, instance.Name).ExtractErr()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); !ok {
+				err = perrors.Wrap(err, "failed to delete application")
+				return err
+			}
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceDeleted, "application has been deleted")
+	}
+
+	// Remove the finalizer so the kubernetes delete operation can continue.
+	instance.ObjectMeta.Finalizers = utils.RemoveString(instance.ObjectMeta.Finalizers, ApplicationFinalizerName)
+	if err := r.Client.Update(context.Background(), instance); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// statusUpdateRequired is a utility function which determines whether an
+// update is required to the application status attribute.  Updating this
+// unnecessarily will result in an infinite reconciliation loop.
+func (r *ApplicationReconciler) statusUpdateRequired(instance *starlingxv1.Application, app *applications.Application, inSync bool) (result bool) {
+	status := &instance.Status
+
+	if app != nil {
+		if status.Status != app.Status {
+			status.Status = app.Status
+			result = true
+		}
+
+		if status.Version != app.Version {
+			status.Version = app.Version
+			result = true
+		}
+
+		progress := ""
+		if app.Progress != nil {
+			progress = *app.Progress
+		}
+
+		if status.Progress != progress {
+			status.Progress = progress
+			result = true
+		}
+
+		if applicationReconciled(instance, app) && !status.Reconciled {
+			// Record the fact that the application has reached its desired
+			// state for the current configuration.
+			status.Reconciled = true
+			result = true
+		}
+	}
+
+	if status.InSync != inSync {
+		status.InSync = inSync
+		result = true
+	}
+
+	return result
+}
+
+// ReconcileGeneration resets the status of the resource when its
+// configuration has been modified so that the application is moved to its
+// new desired state and a failed operation is retried.
+func (r *ApplicationReconciler) ReconcileGeneration(instance *starlingxv1.Application) {
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation {
+		return
+	}
+
+	status := &instance.Status
+	status.Status = ""
+	status.Reconciled = false
+	status.ObservedGeneration = instance.ObjectMeta.Generation
+}
+
+// ReconcileResource interacts with the system API in order to reconcile the
+// state of a system application with the state stored in the k8s database.
+func (r *ApplicationReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.Application) error {
+	app, err := applications.GetApplication(client, instance.Name)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get application: %s", instance.Name)
+		return err
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.ReconciledDeleted(client, instance, app)
+	}
+
+	r.ReconcileGeneration(instance)
+
+	if app == nil {
+		app, err = r.ReconcileNew(client, instance)
+	} else {
+		var result *applications.Application
+		result, err = r.ReconcileExisting(client, instance, app)
+		if result != nil {
+			app = result
+		}
+	}
+
+	inSync := err == nil
+
+	if instance.Status.InSync != inSync {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	if r.statusUpdateRequired(instance, app, inSync) {
+		logApplication.Info("updating application", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	if err == nil && app != nil && app.InProgress() {
+		// The system runs each operation on its own; keep the status current
+		// until it has finished.
+		msg := fmt.Sprintf("waiting for application %s to finish %s", instance.Name, app.Status)
+		m := NewApplicationProgressMonitor(instance, app)
+		return r.CloudManager.StartMonitor(m, msg)
+	}
+
+	return err
+}
+
+// Reconcile reads that state of the cluster for an Application object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=applications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=applications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=applications/finalizers,verbs=update
+func (r *ApplicationReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logApplication
+	logApplication = logApplication.WithName(request.NamespacedName.String())
+	defer func() { logApplication = savedLog }()
+
+	// Fetch the Application instance
+	instance := &starlingxv1.Application{}
+	err := r.Client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logApplication.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
+	if instance.DeletionTimestamp.IsZero() {
+		if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
+			instance.Status.Reconciled {
+			return ctrl.Result{}, nil
+		}
+
+		// Ensure that the object has a finalizer setup as a pre-delete hook so
+		// that we can remove the application from the system.
+		if !utils.ContainsString(instance.ObjectMeta.Finalizers, ApplicationFinalizerName) {
+			instance.ObjectMeta.Finalizers = append(instance.ObjectMeta.Finalizers, ApplicationFinalizerName)
+			if err := r.Client.Update(context.Background(), instance); err != nil {
+				return reconcile.Result{}, err
+			}
+
+			// Might as well return immediately as the update is going to cause
+			// another reconcile event for this resource and we don't want to
+			// access the system API more than necessary.
+			return reconcile.Result{}, nil
+		}
+	}
+
+	if !utils.IsReconcilerEnabled(utils.Application) {
+		return reconcile.Result{}, nil
+	}
+
+	platformClient := r.GetPlatformClient(request.Namespace)
+	if platformClient == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	if !r.CloudManager.GetSystemReady(request.Namespace) {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	platformClient = cloudManager.WithChangeInitiator(platformClient, instance)
+	err = r.ReconcileResource(platformClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if err != nil {
+		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logApplication}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(ApplicationControllerName), logApplication)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.Application{}).
+		Complete(r)
+}
+
+// DefaultApplicationProgressMonitorInterval represents the default interval
+// between polling attempts to check whether an application operation has
+// progressed.
+const DefaultApplicationProgressMonitorInterval = 20 * time.Second
+
+// applicationProgressMonitor waits for an application to change status or
+// for the progress of its current operation to change.  Once it has a
+// reconcilable event is generated to kick the reconciler so that the next
+// operation can be run and the status can be refreshed.
+type applicationProgressMonitor struct {
+	cloudManager.CommonMonitorBody
+	name     string
+	status   string
+	progress string
+}
+
+// NewApplicationProgressMonitor defines a convenience function to instantiate
+// a new application progress monitor with all required attributes.
+func NewApplicationProgressMonitor(instance *starlingxv1.Application, app *applications.Application) *cloudManager.Monitor {
+	logger := logApplication.WithName("progress-monitor")
+
+	return &cloudManager.Monitor{
+		MonitorBody: &applicationProgressMonitor{
+			name:     instance.Name,
+			status:   app.Status,
+			progress: instance.Status.Progress,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultApplicationProgressMonitorInterval,
+	}
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *applicationProgressMonitor) Run(client *gophercloud.ServiceClient) (stop bool, err error) {
+	app, err := applications.GetApplication(client, m.name)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to get application: %s", err.Error())
+		return false, err
+	}
+
+	if app == nil {
+		m.CommonMonitorBody.SetState("application no longer exists")
+		return true, nil
+	}
+
+	if app.Status != m.status {
+		m.CommonMonitorBody.SetState("application has progressed to %s", app.Status)
+		return true, nil
+	}
+
+	if app.Progress != nil && *app.Progress != m.progress {
+		m.CommonMonitorBody.SetState("application progress is %s", *app.Progress)
+		return true, nil
+	}
+
+	m.CommonMonitorBody.SetState("waiting for application to finish %s", app.Status)
+
+	return false, nil
+}
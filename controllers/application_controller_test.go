@@ -0,0 +1,155 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/applications"
+)
+
+var _ = Describe("Application controller", func() {
+
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	Context("Application with data", func() {
+		It("Should created successfully", func() {
+			ctx := context.Background()
+			key := types.NamespacedName{
+				Name:      "cert-manager",
+				Namespace: "default",
+			}
+
+			created := &starlingxv1.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cert-manager",
+					Namespace: "default",
+				},
+				Spec: starlingxv1.ApplicationSpec{
+					Source: "oci://registry.local:9001/apps/cert-manager:24.09-1",
+					State:  starlingxv1.ApplicationStateApplied,
+				}}
+			Expect(k8sClient.Create(ctx, created)).To(Succeed())
+
+			fetched := &starlingxv1.Application{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, key, fetched)
+				return err == nil
+			}, timeout, interval).Should(BeTrue())
+			Expect(fetched.Spec.Source).To(Equal("oci://registry.local:9001/apps/cert-manager:24.09-1"))
+		})
+	})
+
+	Context("Application status", func() {
+		It("Should be reconciled once the desired state and version are reached", func() {
+			r := &ApplicationReconciler{}
+			version := "24.09-1"
+			progress := "retrieving docker images"
+			instance := &starlingxv1.Application{
+				Spec: starlingxv1.ApplicationSpec{
+					State:   starlingxv1.ApplicationStateApplied,
+					Version: &version,
+				},
+			}
+			app := &applications.Application{
+				Name:     "cert-manager",
+				Version:  "24.09-1",
+				Status:   applications.StatusApplying,
+				Progress: &progress,
+			}
+
+			Expect(r.statusUpdateRequired(instance, app, true)).To(BeTrue())
+			Expect(instance.Status.Status).To(Equal(applications.StatusApplying))
+			Expect(instance.Status.Progress).To(Equal(progress))
+			Expect(instance.Status.Reconciled).To(BeFalse())
+			Expect(r.statusUpdateRequired(instance, app, true)).To(BeFalse())
+
+			app.Status = applications.StatusApplied
+			app.Version = "24.03-0"
+			Expect(r.statusUpdateRequired(instance, app, true)).To(BeTrue())
+			Expect(instance.Status.Reconciled).To(BeFalse())
+
+			app.Version = "24.09-1"
+			app.Progress = nil
+			Expect(r.statusUpdateRequired(instance, app, true)).To(BeTrue())
+			Expect(instance.Status.Progress).To(BeEmpty())
+			Expect(instance.Status.Reconciled).To(BeTrue())
+		})
+	})
+
+	Context("Application helm overrides", func() {
+		It("Should ignore formatting differences", func() {
+			current := "image:\n  tag: v1.2.3\nreplicas: 2\n"
+
+			changed, err := helmOverridesChanged("replicas: 2\nimage: {tag: v1.2.3}", &current)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeFalse())
+
+			changed, err = helmOverridesChanged("replicas: 3\nimage: {tag: v1.2.3}", &current)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeTrue())
+		})
+
+		It("Should treat missing overrides as empty", func() {
+			changed, err := helmOverridesChanged("", nil)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeFalse())
+
+			changed, err = helmOverridesChanged("replicas: 2", nil)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeTrue())
+		})
+	})
+
+	Context("Application OCI source", func() {
+		It("Should pull the tarball with an anonymous token", func() {
+			var server *httptest.Server
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				switch {
+				case req.URL.Path == "/token":
+					if req.URL.Query().Get("scope") != "repository:apps/cert-manager:pull" {
+						w.WriteHeader(http.StatusForbidden)
+						return
+					}
+					fmt.Fprint(w, `{"token": "secret"}`)
+				case req.Header.Get("Authorization") != "Bearer secret":
+					w.Header().Set("WWW-Authenticate", fmt.Sprintf(
+						`Bearer realm="%s/token",service="registry",scope="repository:apps/cert-manager:pull"`, server.URL))
+					w.WriteHeader(http.StatusUnauthorized)
+				case req.URL.Path == "/v2/apps/cert-manager/manifests/24.09-1":
+					fmt.Fprint(w, `{"layers": [{"digest": "sha256:abcd", "annotations": {"org.opencontainers.image.title": "cert-manager-24.09-1.tgz"}}]}`)
+				case req.URL.Path == "/v2/apps/cert-manager/blobs/sha256:abcd":
+					fmt.Fprint(w, "tarball")
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			registry := strings.TrimPrefix(server.URL, "https://")
+			filename, content, err := pullApplication(server.Client(), "oci://"+registry+"/apps/cert-manager:24.09-1")
+			Expect(err).To(BeNil())
+			defer content.Close()
+
+			data, err := io.ReadAll(content)
+			Expect(err).To(BeNil())
+			Expect(filename).To(Equal("cert-manager-24.09-1.tgz"))
+			Expect(string(data)).To(Equal("tarball"))
+		})
+	})
+})
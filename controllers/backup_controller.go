@@ -0,0 +1,455 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/subclouds"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logBackup = log.Log.WithName("controller").WithName("backup")
+
+const BackupControllerName = "backup-controller"
+
+// DefaultBackupProgressInterval represents the default interval between
+// polling attempts to check whether a subcloud backup has progressed.
+const DefaultBackupProgressInterval = time.Minute
+
+// BackupStartTimeout represents the amount of time after which a requested
+// backup which has not been reported by the system controller is considered
+// to have failed.  The system controller reports a backup as soon as it
+// starts validating it so this only happens if the request was lost or the
+// backup failed between two polling attempts.
+const BackupStartTimeout = 30 * time.Minute
+
+var _ reconcile.Reconciler = &BackupReconciler{}
+
+// BackupReconciler reconciles a Backup object
+type BackupReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+}
+
+// backupSchedule returns the time at which the next scheduled backup run is
+// due, or nil if backups are not scheduled.
+func backupSchedule(instance *starlingxv1.Backup) (*time.Time, error) {
+	if instance.Spec.Schedule == nil || instance.Spec.Suspend {
+		return nil, nil
+	}
+
+	schedule, err := utils.ParseSchedule(*instance.Spec.Schedule)
+	if err != nil {
+		msg := fmt.Sprintf("invalid backup schedule: %s", err.Error())
+		return nil, common.NewUserDataError(msg)
+	}
+
+	base := instance.CreationTimestamp.Time
+	if instance.Status.LastScheduleTime != nil {
+		base = instance.Status.LastScheduleTime.Time
+	}
+
+	next := schedule.Next(base)
+	if next.IsZero() {
+		msg := fmt.Sprintf("backup schedule %q never runs", *instance.Spec.Schedule)
+		return nil, common.NewUserDataError(msg)
+	}
+
+	return &next, nil
+}
+
+// backupRunName returns the name of a backup run started at a given time.
+func backupRunName(instance *starlingxv1.Backup, now time.Time) string {
+	return fmt.Sprintf("%s-%d", instance.Name, now.Unix()/60)
+}
+
+// backupRunState determines the state of a running backup from the backup
+// status reported for the subcloud.  The status of the subcloud is left over
+// from its previous backup until the system controller starts the requested
+// one so a completed or failed status is only considered once it differs
+// from the one recorded when the backup was requested.
+func backupRunState(record *starlingxv1.BackupRecord, subcloud *subclouds.Subcloud, now time.Time) string {
+	changed := record.BackupStatus != subcloud.BackupStatus ||
+		record.BackupDatetime != subcloud.BackupDatetime
+
+	switch {
+	case subcloud.BackupInProgress():
+		return starlingxv1.BackupStateRunning
+
+	case !changed:
+		if record.StartTime != nil && now.Sub(record.StartTime.Time) > BackupStartTimeout {
+			return starlingxv1.BackupStateFailed
+		}
+		return starlingxv1.BackupStateRunning
+
+	case subcloud.BackupComplete():
+		return starlingxv1.BackupStateSucceeded
+
+	case subcloud.BackupFailed():
+		return starlingxv1.BackupStateFailed
+	}
+
+	return starlingxv1.BackupStateRunning
+}
+
+// ReconcileHistory records the outcome of each backup run in the status of
+// the resource.  The oldest records beyond the history limit are discarded.
+// The run still in progress, if any, is returned.
+func (r *BackupReconciler) ReconcileHistory(instance *starlingxv1.Backup, subcloud *subclouds.Subcloud, now time.Time) *starlingxv1.BackupRecord {
+	history := instance.Status.History
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[j].StartTime.Before(history[i].StartTime)
+	})
+
+	limit := instance.Spec.HistoryLimit
+	if limit < 1 {
+		limit = 1
+	}
+
+	if len(history) > limit {
+		history = history[:limit]
+	}
+
+	instance.Status.History = history
+
+	var active *starlingxv1.BackupRecord
+	for i := range history {
+		record := &history[i]
+		if record.State != starlingxv1.BackupStateRunning {
+			continue
+		}
+
+		state := backupRunState(record, subcloud, now)
+		if subcloud.BackupInProgress() || state != starlingxv1.BackupStateRunning {
+			record.BackupStatus = subcloud.BackupStatus
+			record.BackupDatetime = subcloud.BackupDatetime
+		}
+
+		switch state {
+		case starlingxv1.BackupStateRunning:
+			active = record
+			continue
+
+		case starlingxv1.BackupStateSucceeded:
+			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+				"backup %s of subcloud %s has succeeded: %s", record.Name,
+				subcloud.Name, subcloud.BackupStatus)
+
+		case starlingxv1.BackupStateFailed:
+			r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+				"backup %s of subcloud %s has failed in state %s: %s", record.Name,
+				subcloud.Name, subcloud.BackupStatus, subcloud.ErrorDescription)
+		}
+
+		completionTime := metav1.NewTime(now)
+		record.State = state
+		record.CompletionTime = &completionTime
+	}
+
+	return active
+}
+
+// ReconcileNew requests a new backup of the subcloud from the system
+// controller.  The system controller only backs up managed and online
+// subclouds which are fully deployed and not already being backed up.
+func (r *BackupReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.Backup, subcloud *subclouds.Subcloud, now time.Time) error {
+	if subcloud.DeployStatus != subclouds.DeployComplete ||
+		subcloud.ManagementState != subclouds.ManagementManaged ||
+		subcloud.AvailabilityStatus != subclouds.AvailabilityOnline {
+		msg := fmt.Sprintf("waiting for subcloud %s to be deployed, managed and online", subcloud.Name)
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	if subcloud.BackupInProgress() {
+		msg := fmt.Sprintf("waiting for subcloud %s to finish its current backup", subcloud.Name)
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	resource, err := getSubcloudResource(r.Client, instance.Namespace, instance.Spec.Subcloud)
+	if err != nil {
+		return err
+	}
+
+	password, err := getSubcloudSysadminPassword(r.Client, resource)
+	if err != nil {
+		return err
+	}
+
+	opts := subclouds.BackupOpts{
+		Subcloud:         instance.Spec.Subcloud,
+		SysadminPassword: password,
+		LocalOnly:        instance.Spec.BackupLocation == starlingxv1.BackupLocationLocal,
+		RegistryImages:   instance.Spec.RegistryImages,
+	}
+
+	if instance.Spec.BackupValues != nil {
+		opts.BackupValues = []byte(*instance.Spec.BackupValues)
+	}
+
+	logBackup.Info("backing up subcloud", "subcloud", opts.Subcloud, "local", opts.LocalOnly)
+
+	err = subclouds.Backup(client, opts).ExtractErr()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to back up subcloud: %s", instance.Spec.Subcloud)
+		return err
+	}
+
+	name := backupRunName(instance, now)
+	startTime := metav1.NewTime(now)
+	instance.Status.LastScheduleTime = &startTime
+	instance.Status.History = append([]starlingxv1.BackupRecord{{
+		Name:           name,
+		State:          starlingxv1.BackupStateRunning,
+		BackupStatus:   subcloud.BackupStatus,
+		BackupDatetime: subcloud.BackupDatetime,
+		StartTime:      &startTime,
+	}}, instance.Status.History...)
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+		"backup %s of subcloud %s has been requested", name, subcloud.Name)
+
+	return nil
+}
+
+// ReconcileGeneration resets the status of the resource when its
+// configuration has been modified.  On-demand backups are taken again for
+// each new configuration generation.
+func (r *BackupReconciler) ReconcileGeneration(instance *starlingxv1.Backup) {
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation {
+		return
+	}
+
+	status := &instance.Status
+	if instance.Spec.Schedule == nil {
+		status.LastScheduleTime = nil
+	}
+	status.Reconciled = false
+	status.ObservedGeneration = instance.ObjectMeta.Generation
+}
+
+// statusUpdateRequired is a utility function which determines whether an
+// update is required to the backup status attribute.  Updating this
+// unnecessarily will result in an infinite reconciliation loop.
+func (r *BackupReconciler) statusUpdateRequired(instance *starlingxv1.Backup, previous *starlingxv1.BackupStatus, active *starlingxv1.BackupRecord, inSync bool) bool {
+	status := &instance.Status
+
+	status.State = ""
+	if len(status.History) > 0 {
+		status.State = status.History[0].State
+	}
+
+	if active == nil && status.LastScheduleTime != nil &&
+		status.State == starlingxv1.BackupStateSucceeded {
+		// Record the fact that a backup has succeeded for the current
+		// configuration.
+		status.Reconciled = true
+	}
+
+	status.InSync = inSync
+
+	return !equality.Semantic.DeepEqual(previous, status)
+}
+
+// ReconcileResource interacts with the dcmanager API in order to start a new
+// backup run whenever one is due and to record the outcome of previous runs.
+// The amount of time until the status must be checked again, if any, is
+// returned.
+func (r *BackupReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.Backup) (time.Duration, error) {
+	subcloud, err := subclouds.GetSubcloud(client, instance.Spec.Subcloud)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get subcloud: %s", instance.Spec.Subcloud)
+		return 0, err
+	}
+
+	if subcloud == nil {
+		msg := fmt.Sprintf("waiting for subcloud %q to be added", instance.Spec.Subcloud)
+		return 0, common.NewResourceStatusDependency(msg)
+	}
+
+	previous := instance.Status.DeepCopy()
+	now := time.Now()
+
+	r.ReconcileGeneration(instance)
+
+	active := r.ReconcileHistory(instance, subcloud, now)
+
+	next, err := backupSchedule(instance)
+	if err == nil {
+		due := false
+		if next != nil {
+			due = !next.After(now)
+		} else if instance.Spec.Schedule == nil && !instance.Spec.Suspend {
+			due = instance.Status.LastScheduleTime == nil
+		}
+
+		if due && active != nil && next != nil {
+			// Only a single backup runs at any time; the scheduled run is
+			// skipped rather than queued behind the running one.
+			r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+				"skipping scheduled backup while %s is still running", active.Name)
+			skipped := metav1.NewTime(now)
+			instance.Status.LastScheduleTime = &skipped
+		} else if due && active == nil {
+			err = r.ReconcileNew(client, instance, subcloud, now)
+			if err == nil {
+				active = &instance.Status.History[0]
+			}
+		}
+	}
+
+	if err == nil {
+		next, err = backupSchedule(instance)
+	}
+
+	instance.Status.NextScheduleTime = nil
+	if next != nil {
+		instance.Status.NextScheduleTime = &metav1.Time{Time: *next}
+	}
+
+	inSync := err == nil
+
+	if instance.Status.InSync != inSync {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	if r.statusUpdateRequired(instance, previous, active, inSync) {
+		logBackup.Info("updating backup", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return 0, err2
+		}
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	var requeueAfter time.Duration
+	if next != nil {
+		requeueAfter = next.Sub(now)
+	}
+
+	if active != nil && (requeueAfter == 0 || requeueAfter > DefaultBackupProgressInterval) {
+		// The system controller runs the backup on its own; keep the status
+		// current until it has finished.
+		requeueAfter = DefaultBackupProgressInterval
+	}
+
+	return requeueAfter, nil
+}
+
+// Reconcile reads that state of the cluster for a Backup object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=backups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=backups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=backups/finalizers,verbs=update
+func (r *BackupReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logBackup
+	logBackup = logBackup.WithName(request.NamespacedName.String())
+	defer func() { logBackup = savedLog }()
+
+	// Fetch the Backup instance
+	instance := &starlingxv1.Backup{}
+	err := r.Client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logBackup.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		// Backups already taken are kept by the system controller; deleting
+		// the resource only stops taking new ones.
+		return reconcile.Result{}, nil
+	}
+
+	if !utils.IsReconcilerEnabled(utils.Backup) {
+		return reconcile.Result{}, nil
+	}
+
+	if r.GetPlatformClient(request.Namespace) == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	if !r.CloudManager.GetSystemReady(request.Namespace) {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	dcClient := r.CloudManager.GetDistributedCloudClient(request.Namespace)
+	if dcClient == nil {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for dcmanager client creation")
+		return common.RetryTransientError, nil
+	}
+
+	dcClient = cloudManager.WithChangeInitiator(dcClient, instance)
+	requeueAfter, err := r.ReconcileResource(dcClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if err != nil {
+		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logBackup}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(BackupControllerName), logBackup)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.Backup{}).
+		Complete(r)
+}
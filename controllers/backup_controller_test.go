@@ -0,0 +1,160 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/subclouds"
+)
+
+var _ = Describe("Backup controller", func() {
+
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	Context("Backup with data", func() {
+		It("Should created successfully", func() {
+			ctx := context.Background()
+			key := types.NamespacedName{
+				Name:      "nightly",
+				Namespace: "default",
+			}
+
+			schedule := "0 2 * * *"
+			created := &starlingxv1.Backup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nightly",
+					Namespace: "default",
+				},
+				Spec: starlingxv1.BackupSpec{
+					Subcloud: "subcloud1",
+					Schedule: &schedule,
+				}}
+			Expect(k8sClient.Create(ctx, created)).To(Succeed())
+
+			fetched := &starlingxv1.Backup{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, key, fetched)
+				return err == nil
+			}, timeout, interval).Should(BeTrue())
+			Expect(fetched.Spec.HistoryLimit).To(Equal(5))
+			Expect(fetched.Spec.BackupLocation).To(Equal(starlingxv1.BackupLocationCentral))
+		})
+	})
+
+	Context("Backup schedule", func() {
+		It("Should run after the most recent backup", func() {
+			schedule := "0 2 * * *"
+			last := metav1.NewTime(time.Date(2024, time.March, 15, 2, 0, 0, 0, time.UTC))
+			instance := &starlingxv1.Backup{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)),
+				},
+				Spec: starlingxv1.BackupSpec{Schedule: &schedule},
+			}
+
+			next, err := backupSchedule(instance)
+			Expect(err).To(BeNil())
+			Expect(*next).To(Equal(time.Date(2024, time.March, 2, 2, 0, 0, 0, time.UTC)))
+
+			instance.Status.LastScheduleTime = &last
+			next, err = backupSchedule(instance)
+			Expect(err).To(BeNil())
+			Expect(*next).To(Equal(time.Date(2024, time.March, 16, 2, 0, 0, 0, time.UTC)))
+
+			instance.Spec.Suspend = true
+			next, err = backupSchedule(instance)
+			Expect(err).To(BeNil())
+			Expect(next).To(BeNil())
+		})
+	})
+
+	Context("Backup run", func() {
+		It("Should ignore the status left over from the previous backup", func() {
+			start := time.Date(2024, time.March, 15, 2, 0, 0, 0, time.UTC)
+			startTime := metav1.NewTime(start)
+			record := &starlingxv1.BackupRecord{
+				State:          starlingxv1.BackupStateRunning,
+				BackupStatus:   subclouds.BackupStatusCompleteCentral,
+				BackupDatetime: "2024-03-14 02:10:00",
+				StartTime:      &startTime,
+			}
+			subcloud := &subclouds.Subcloud{
+				BackupStatus:   subclouds.BackupStatusCompleteCentral,
+				BackupDatetime: "2024-03-14 02:10:00",
+			}
+
+			now := start.Add(time.Minute)
+			Expect(backupRunState(record, subcloud, now)).To(Equal(starlingxv1.BackupStateRunning))
+
+			subcloud.BackupStatus = subclouds.BackupStatusBackingUp
+			Expect(backupRunState(record, subcloud, now)).To(Equal(starlingxv1.BackupStateRunning))
+
+			subcloud.BackupStatus = subclouds.BackupStatusCompleteCentral
+			subcloud.BackupDatetime = "2024-03-15 02:10:00"
+			Expect(backupRunState(record, subcloud, now)).To(Equal(starlingxv1.BackupStateSucceeded))
+
+			subcloud.BackupStatus = subclouds.BackupStatusValidateFailed
+			Expect(backupRunState(record, subcloud, now)).To(Equal(starlingxv1.BackupStateFailed))
+		})
+
+		It("Should fail once the system controller has not reported it in time", func() {
+			start := time.Date(2024, time.March, 15, 2, 0, 0, 0, time.UTC)
+			startTime := metav1.NewTime(start)
+			record := &starlingxv1.BackupRecord{
+				State:        starlingxv1.BackupStateRunning,
+				BackupStatus: subclouds.BackupStatusFailed,
+				StartTime:    &startTime,
+			}
+			subcloud := &subclouds.Subcloud{BackupStatus: subclouds.BackupStatusFailed}
+
+			Expect(backupRunState(record, subcloud, start.Add(time.Minute))).To(Equal(starlingxv1.BackupStateRunning))
+			Expect(backupRunState(record, subcloud, start.Add(BackupStartTimeout+time.Minute))).To(Equal(starlingxv1.BackupStateFailed))
+		})
+	})
+
+	Context("Backup with the paused annotation", func() {
+		It("Should not be reconciled until the annotation is removed", func() {
+			instance := &starlingxv1.Backup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "nightly",
+					Namespace:   "default",
+					Annotations: map[string]string{cloudManager.PausedReconcile: "maintenance"},
+				},
+				Spec: starlingxv1.BackupSpec{Subcloud: "subcloud1"},
+			}
+			r := &BackupReconciler{
+				Client: ptpTestClient(instance),
+				ReconcilerEventLogger: &common.EventLogger{
+					EventRecorder: record.NewFakeRecorder(16),
+					Logger:        logBackup,
+				},
+			}
+
+			result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(instance)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			fetched := &starlingxv1.Backup{}
+			Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(instance), fetched)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(fetched.Status.Conditions, starlingxv1.ConditionPaused)).To(BeTrue())
+			Expect(fetched.Status.History).To(BeEmpty())
+		})
+	})
+})
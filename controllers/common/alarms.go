@@ -0,0 +1,80 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"sort"
+	"strings"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+)
+
+// MaxMirroredAlarms defines the maximum number of active alarms reported in
+// the status of a System resource.  The least severe alarms are omitted when
+// more alarms are active.
+const MaxMirroredAlarms = 100
+
+// NewAlarmStatus converts an alarm read from the fault management API to its
+// representation in the System status.
+func NewAlarmStatus(alarm alarms.Alarm) starlingxv1.AlarmStatus {
+	return starlingxv1.AlarmStatus{
+		ID:                  alarm.ID,
+		AlarmID:             alarm.AlarmID,
+		Severity:            strings.ToLower(alarm.Severity),
+		EntityInstanceID:    alarm.EntityInstanceID,
+		Reason:              alarm.ReasonText,
+		ManagementAffecting: alarm.IsManagementAffecting(),
+		Timestamp:           alarm.Timestamp,
+	}
+}
+
+// MirroredAlarms returns the active alarms ordered from the most to the least
+// severe and then by alarm identifier.
+func MirroredAlarms(active []alarms.Alarm) []starlingxv1.AlarmStatus {
+	result := make([]starlingxv1.AlarmStatus, 0, len(active))
+	for _, a := range active {
+		result = append(result, NewAlarmStatus(a))
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if alarmSeverityRank[a.Severity] != alarmSeverityRank[b.Severity] {
+			return alarmSeverityRank[a.Severity] > alarmSeverityRank[b.Severity]
+		}
+
+		if a.AlarmID != b.AlarmID {
+			return a.AlarmID < b.AlarmID
+		}
+
+		return a.EntityInstanceID < b.EntityInstanceID
+	})
+
+	return result
+}
+
+// AlarmChanges compares two sets of active alarms and returns the alarms
+// which have been raised and cleared since the previous set was read.
+func AlarmChanges(previous []starlingxv1.AlarmStatus, current []starlingxv1.AlarmStatus) (raised []starlingxv1.AlarmStatus, cleared []starlingxv1.AlarmStatus) {
+	known := make(map[string]bool, len(previous))
+	for _, a := range previous {
+		known[a.ID] = true
+	}
+
+	active := make(map[string]bool, len(current))
+	for _, a := range current {
+		active[a.ID] = true
+		if !known[a.ID] {
+			raised = append(raised, a)
+		}
+	}
+
+	for _, a := range previous {
+		if !active[a.ID] {
+			cleared = append(cleared, a)
+		}
+	}
+
+	return raised, cleared
+}
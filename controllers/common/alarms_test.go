@@ -0,0 +1,51 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+)
+
+var _ = Describe("Alarm utils", func() {
+	Describe("MirroredAlarms", func() {
+		Context("with alarms of several severities", func() {
+			It("should order the alarms from the most severe", func() {
+				active := []alarms.Alarm{
+					{ID: "a", AlarmID: "100.114", Severity: "major", EntityInstanceID: "host=controller-0.ntp"},
+					{ID: "b", AlarmID: "800.001", Severity: "warning", EntityInstanceID: "cluster=ceph", ManagementAffecting: "True"},
+					{ID: "c", AlarmID: "200.004", Severity: "Critical", EntityInstanceID: "host=worker-0"},
+					{ID: "d", AlarmID: "100.114", Severity: "major", EntityInstanceID: "host=controller-1.ntp"},
+				}
+				result := MirroredAlarms(active)
+				ids := make([]string, 0)
+				for _, a := range result {
+					ids = append(ids, a.ID)
+				}
+				Expect(ids).To(Equal([]string{"c", "a", "d", "b"}))
+				Expect(result[0].Severity).To(Equal("critical"))
+				Expect(result[3].ManagementAffecting).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("AlarmChanges", func() {
+		Context("with alarms raised and cleared", func() {
+			It("should return the raised and cleared alarms", func() {
+				previous := []v1.AlarmStatus{{ID: "a"}, {ID: "b"}}
+				current := []v1.AlarmStatus{{ID: "b"}, {ID: "c"}}
+				raised, cleared := AlarmChanges(previous, current)
+				Expect(raised).To(Equal([]v1.AlarmStatus{{ID: "c"}}))
+				Expect(cleared).To(Equal([]v1.AlarmStatus{{ID: "a"}}))
+
+				raised, cleared = AlarmChanges(current, current)
+				Expect(raised).To(BeEmpty())
+				Expect(cleared).To(BeEmpty())
+			})
+		})
+	})
+})
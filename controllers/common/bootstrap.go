@@ -0,0 +1,72 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"sort"
+	"strings"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+)
+
+// DefaultBootstrapAlarmSeverity defines the lowest severity of the active
+// alarms which fail a bootstrap health check unless configured otherwise.
+const DefaultBootstrapAlarmSeverity = "critical"
+
+// alarmSeverityRank ranks the alarm severities from the least to the most
+// severe.
+var alarmSeverityRank = map[string]int{
+	"warning":  1,
+	"minor":    2,
+	"major":    3,
+	"critical": 4,
+}
+
+// FindBootstrapHealthCheck returns the health check configured for a phase of
+// the bootstrap sequence or nil if the phase is not gated.
+func FindBootstrapHealthCheck(spec *starlingxv1.SystemSpec, phase string) *starlingxv1.BootstrapHealthCheckInfo {
+	if spec == nil || spec.Bootstrap == nil {
+		return nil
+	}
+
+	for i := range spec.Bootstrap.HealthChecks {
+		if spec.Bootstrap.HealthChecks[i].Phase == phase {
+			return &spec.Bootstrap.HealthChecks[i]
+		}
+	}
+
+	return nil
+}
+
+// BootstrapHealthCheckFailures returns the identifiers of the active alarms
+// which cause a bootstrap health check to fail.  The health check passes if
+// the list is empty.
+func BootstrapHealthCheckFailures(check *starlingxv1.BootstrapHealthCheckInfo, active []alarms.Alarm) []string {
+	result := make([]string, 0)
+
+	severity := DefaultBootstrapAlarmSeverity
+	if check.AlarmSeverity != nil {
+		severity = *check.AlarmSeverity
+	}
+
+	for _, a := range active {
+		if utils.ContainsString(check.IgnoredAlarms, a.AlarmID) {
+			continue
+		}
+
+		if alarmSeverityRank[strings.ToLower(a.Severity)] < alarmSeverityRank[severity] {
+			continue
+		}
+
+		if !utils.ContainsString(result, a.AlarmID) {
+			result = append(result, a.AlarmID)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
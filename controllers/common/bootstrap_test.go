@@ -0,0 +1,65 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+)
+
+var _ = Describe("Bootstrap utils", func() {
+	Describe("FindBootstrapHealthCheck", func() {
+		Context("with health checks for some phases", func() {
+			It("should only return the health check of the phase", func() {
+				spec := &v1.SystemSpec{}
+				Expect(FindBootstrapHealthCheck(spec, v1.BootstrapPhaseWorkersUnlocked)).To(BeNil())
+
+				spec.Bootstrap = &v1.BootstrapInfo{HealthChecks: v1.BootstrapHealthCheckList{
+					{Phase: v1.BootstrapPhaseController1Unlocked},
+					{Phase: v1.BootstrapPhaseWorkersUnlocked, IgnoredAlarms: []string{"100.114"}},
+				}}
+				check := FindBootstrapHealthCheck(spec, v1.BootstrapPhaseWorkersUnlocked)
+				Expect(check).ToNot(BeNil())
+				Expect(check.IgnoredAlarms).To(Equal([]string{"100.114"}))
+				Expect(FindBootstrapHealthCheck(spec, v1.BootstrapPhaseStorageUnlocked)).To(BeNil())
+			})
+		})
+	})
+
+	Describe("BootstrapHealthCheckFailures", func() {
+		active := []alarms.Alarm{
+			{AlarmID: "100.114", Severity: "major"},
+			{AlarmID: "200.004", Severity: "critical"},
+			{AlarmID: "200.004", Severity: "critical"},
+			{AlarmID: "750.004", Severity: "warning"},
+		}
+
+		Context("with the default severity", func() {
+			It("should only fail on critical alarms", func() {
+				check := &v1.BootstrapHealthCheckInfo{Phase: v1.BootstrapPhaseWorkersUnlocked}
+				Expect(BootstrapHealthCheckFailures(check, active)).To(Equal([]string{"200.004"}))
+			})
+		})
+
+		Context("with a lower severity and ignored alarms", func() {
+			It("should fail on the remaining alarms of that severity or higher", func() {
+				severity := "major"
+				check := &v1.BootstrapHealthCheckInfo{
+					Phase:         v1.BootstrapPhaseWorkersUnlocked,
+					AlarmSeverity: &severity,
+				}
+				Expect(BootstrapHealthCheckFailures(check, active)).To(Equal([]string{"100.114", "200.004"}))
+
+				check.IgnoredAlarms = []string{"200.004"}
+				Expect(BootstrapHealthCheckFailures(check, active)).To(Equal([]string{"100.114"}))
+
+				check.IgnoredAlarms = []string{"100.114", "200.004"}
+				Expect(BootstrapHealthCheckFailures(check, active)).To(BeEmpty())
+			})
+		})
+	})
+})
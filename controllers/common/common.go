@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package common
 
@@ -88,6 +88,12 @@ var (
 	// TODO(alegacy): consider backing off using a rate limiter queue.
 	RetryNetworkError = reconcile.Result{Requeue: true, RequeueAfter: 15 * time.Second}
 
+	// RetryPlanned should be used whenever a change is withheld from the
+	// system because plan mode is enabled.  Plan mode may be disabled at any
+	// time without any change to the resource itself therefore keep checking
+	// periodically.
+	RetryPlanned = reconcile.Result{Requeue: true, RequeueAfter: time.Minute}
+
 	// RetryNever is used when the reconciler will be triggered by a separate
 	// mechanism and no retry is necessary.
 	RetryNever = reconcile.Result{Requeue: false}
@@ -106,6 +112,7 @@ var (
 		"ptp":               []string{"mode", "transport", "mechanism"},
 		"serviceParameters": nil,
 		"storage":           []string{"filesystems", "drbd", "backends"},
+		"timezone":          nil,
 		"vswitchType":       nil,
 	}
 
@@ -120,6 +127,7 @@ var (
 		"bootMAC":              nil,
 		"clockSynchronization": nil,
 		"console":              nil,
+		"devices":              []string{"fec", "pci"},
 		"hwSettle":             nil,
 		"installOutput":        nil,
 		"interfaces":           []string{"bond", "ethernet", "vf", "vlan"},
@@ -129,13 +137,16 @@ var (
 		"memory":               nil,
 		"personality":          nil,
 		"powerOn":              nil,
+		"powerProfile":         nil,
 		"processors":           nil,
 		"provisioningMode":     nil,
 		"ptpInstances":         nil,
 		"rootDevice":           nil,
 		"routes":               []string{"gateway", "interface", "metric", "prefix", "subnet"},
+		"sensorGroups":         nil,
 		"storage":              []string{"filesystems", "monitor", "osds", "volumeGroups"},
 		"subfunctions":         nil,
+		"taints":               []string{"effect", "key", "value"},
 	}
 )
 
@@ -149,6 +160,7 @@ const (
 	ResourceDeleted    = "Deleted"
 	ResourceWait       = "Wait"
 	ResourceDependency = "Dependency"
+	ResourcePlanned    = "Planned"
 )
 
 func FormatStruct(obj interface{}) string {
@@ -185,6 +197,19 @@ func (h *ErrorHandler) HandleReconcilerError(request reconcile.Request, in error
 	// at the initial error before determining what actually went wrong.
 	cause := perrors.Cause(in)
 
+	if urlError, ok := cause.(*url.Error); ok {
+		// Requests rejected by the client-side throttle or withheld in plan
+		// mode are reported by the HTTP client as URL errors but the server
+		// was never contacted.
+		if throttled, ok := urlError.Err.(manager.APIThrottled); ok {
+			cause = throttled
+		} else if planned, ok := urlError.Err.(manager.PlannedChange); ok {
+			cause = planned
+		} else if failed, ok := urlError.Err.(manager.AuthenticationFailed); ok {
+			cause = failed
+		}
+	}
+
 	switch cause.(type) {
 	case gophercloud.ErrDefault400, gophercloud.ErrDefault403,
 		gophercloud.ErrDefault404, gophercloud.ErrDefault405:
@@ -297,6 +322,37 @@ func (h *ErrorHandler) HandleReconcilerError(request reconcile.Request, in error
 
 		h.Error(in, "user data error", "request", request)
 
+	case manager.APIThrottled:
+		// These errors are generated when the system API is being rate
+		// limited or has failed repeatedly.  The client is still valid so
+		// back off until the throttle lets requests through again.
+		resetClient = false
+		result = reconcile.Result{Requeue: true, RequeueAfter: cause.(manager.APIThrottled).RetryAfter}
+		err = nil
+
+		h.Info("system API request throttled", "request", request, "reason", cause.Error())
+
+	case manager.PlannedChange:
+		// These errors are generated when a change is withheld from the
+		// system because plan mode is enabled.  The client is still valid so
+		// check again later in case plan mode has been disabled.
+		resetClient = false
+		result = RetryPlanned
+		err = nil
+
+		h.Info("system configuration change withheld in plan mode", "request", request, "change", cause.Error())
+
+	case manager.AuthenticationFailed, gophercloud.ErrDefault401,
+		gophercloud.ErrUnableToReauthenticate, gophercloud.ErrErrorAfterReauthentication:
+		// These errors are generated when keystone rejects the system
+		// endpoint credentials or the token could not be renewed.  Reset the
+		// client so that it is rebuilt from the current credentials once
+		// they have been corrected.
+		result = RetryUserError
+		err = nil
+
+		h.Error(in, "authentication error", "request", request)
+
 	case manager.WaitForMonitor:
 		// These errors are explicit wait states within a reconciler.  If such
 		// an error is used then the reconciler wants to stop and wait for its
@@ -1,10 +1,11 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2023 Wind River Systems, Inc. */
+/* Copyright(c) 2023-2024 Wind River Systems, Inc. */
 
 package common
 
 import (
 	errpkg "errors"
+	"net/url"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo"
@@ -96,6 +97,18 @@ var _ = Describe("Common utils", func() {
 				Expect(result).To(Equal(RetryUserError))
 			})
 		})
+		Context("when a change is withheld in plan mode", func() {
+			It("should log info and return RetryPlanned", func() {
+				change := manager.PlannedChange{Method: "POST", Resource: "iinterfaces"}
+				testError := &url.Error{Op: "Post", URL: "http://sysinv/v1/iinterfaces", Err: change}
+				result, err := testHandler.HandleReconcilerError(request, testError)
+
+				Expect(err).To(BeNil())
+				Expect(result).To(Equal(RetryPlanned))
+				Expect(sink.infoCalled).To(BeTrue())
+				Expect(sink.errorCalled).To(BeFalse())
+			})
+		})
 		Context("when error is some text error", func() {
 			It("should log error and return RetryTransientError", func() {
 				testError := errpkg.New("error msg")
@@ -0,0 +1,283 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"context"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Defines the reasons reported with the standard conditions.
+const (
+	ReasonReconciled           = "Reconciled"
+	ReasonReconciling          = "Reconciling"
+	ReasonInSync               = "InSync"
+	ReasonOutOfSync            = "OutOfSync"
+	ReasonReconcileFailed      = "ReconcileFailed"
+	ReasonAsExpected           = "AsExpected"
+	ReasonDependencyNotReady   = "DependencyNotReady"
+	ReasonNotBlocked           = "NotBlocked"
+	ReasonChangeWithheld       = "ChangeWithheld"
+	ReasonNoPlannedChange      = "NoPlannedChange"
+	ReasonAuthenticated        = "Authenticated"
+	ReasonAuthenticationFailed = "AuthenticationFailed"
+)
+
+// IsDependencyError determines whether an error reports that a resource is
+// waiting for the system or for another resource rather than a failure.
+func IsDependencyError(in error) bool {
+	switch perrors.Cause(in).(type) {
+	case ErrSystemDependency, ErrResourceStatusDependency,
+		ErrResourceConfigurationDependency, ErrMissingKubernetesResource:
+		return true
+	}
+
+	return false
+}
+
+// setCondition updates a single condition unless it already has the same
+// status, reason, message and observed generation.  Returns true if the set
+// of conditions was modified.
+func setCondition(conditions *[]metav1.Condition, condition metav1.Condition) bool {
+	existing := meta.FindStatusCondition(*conditions, condition.Type)
+	if existing != nil && existing.Status == condition.Status &&
+		existing.Reason == condition.Reason && existing.Message == condition.Message &&
+		existing.ObservedGeneration == condition.ObservedGeneration {
+		return false
+	}
+
+	meta.SetStatusCondition(conditions, condition)
+
+	return true
+}
+
+// SetStandardConditions updates the Ready, InSync, Degraded and Blocked
+// conditions from the outcome of the last reconciliation of a resource.  The
+// error is the one returned by the reconciliation, if any.  Returns true if
+// the set of conditions was modified.
+func SetStandardConditions(conditions *[]metav1.Condition, reconciled bool, inSync bool, in error, generation int64) bool {
+	ready := metav1.Condition{
+		Type:               starlingxv1.ConditionReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonReconciling,
+		Message:            "the resource has not been reconciled yet",
+		ObservedGeneration: generation,
+	}
+
+	sync := metav1.Condition{
+		Type:               starlingxv1.ConditionInSync,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonOutOfSync,
+		Message:            "the resource does not match the system configuration",
+		ObservedGeneration: generation,
+	}
+
+	degraded := metav1.Condition{
+		Type:               starlingxv1.ConditionDegraded,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonAsExpected,
+		Message:            "no reconciliation errors",
+		ObservedGeneration: generation,
+	}
+
+	blocked := metav1.Condition{
+		Type:               starlingxv1.ConditionBlocked,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonNotBlocked,
+		Message:            "no pending dependencies",
+		ObservedGeneration: generation,
+	}
+
+	if inSync {
+		sync.Status = metav1.ConditionTrue
+		sync.Reason = ReasonInSync
+		sync.Message = "the resource matches the system configuration"
+	}
+
+	switch {
+	case in != nil && manager.IsAuthenticationError(in):
+		ready.Reason = ReasonAuthenticationFailed
+		ready.Message = in.Error()
+		degraded.Status = metav1.ConditionTrue
+		degraded.Reason = ReasonAuthenticationFailed
+		degraded.Message = in.Error()
+
+	case in != nil && IsPlannedChange(in):
+		ready.Reason = ReasonChangeWithheld
+		ready.Message = in.Error()
+
+	case in != nil && IsDependencyError(in):
+		ready.Reason = ReasonDependencyNotReady
+		ready.Message = in.Error()
+		blocked.Status = metav1.ConditionTrue
+		blocked.Reason = ReasonDependencyNotReady
+		blocked.Message = in.Error()
+
+	case in != nil:
+		ready.Reason = ReasonReconcileFailed
+		ready.Message = in.Error()
+		degraded.Status = metav1.ConditionTrue
+		degraded.Reason = ReasonReconcileFailed
+		degraded.Message = in.Error()
+
+	case reconciled && inSync:
+		ready.Status = metav1.ConditionTrue
+		ready.Reason = ReasonReconciled
+		ready.Message = "the resource has been reconciled"
+	}
+
+	result := false
+	for _, condition := range []metav1.Condition{ready, sync, degraded, blocked} {
+		if setCondition(conditions, condition) {
+			result = true
+		}
+	}
+
+	if SetPlannedCondition(conditions, in, generation) {
+		result = true
+	}
+
+	if SetAuthenticatedCondition(conditions, in, generation) {
+		result = true
+	}
+
+	return result
+}
+
+// SetPlannedCondition updates the Planned condition from the outcome of the
+// last reconciliation of a resource.  The condition is only added once a
+// change has been withheld at least once.  Returns true if the set of
+// conditions was modified.
+func SetPlannedCondition(conditions *[]metav1.Condition, in error, generation int64) bool {
+	planned := in != nil && IsPlannedChange(in)
+	if !planned && meta.FindStatusCondition(*conditions, starlingxv1.ConditionPlanned) == nil {
+		return false
+	}
+
+	condition := metav1.Condition{
+		Type:               starlingxv1.ConditionPlanned,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonNoPlannedChange,
+		Message:            "no change has been withheld",
+		ObservedGeneration: generation,
+	}
+
+	if planned {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonChangeWithheld
+		condition.Message = PlannedChangeMessage(in) + "; " + PlannedChangeLimitMessage
+	}
+
+	return setCondition(conditions, condition)
+}
+
+// SetAuthenticatedCondition updates the Authenticated condition from the
+// outcome of the last reconciliation of a resource.  The condition is only
+// added once the system endpoint credentials have been rejected at least
+// once.  Returns true if the set of conditions was modified.
+func SetAuthenticatedCondition(conditions *[]metav1.Condition, in error, generation int64) bool {
+	rejected := in != nil && manager.IsAuthenticationError(in)
+	if !rejected && meta.FindStatusCondition(*conditions, starlingxv1.ConditionAuthenticated) == nil {
+		return false
+	}
+
+	condition := metav1.Condition{
+		Type:               starlingxv1.ConditionAuthenticated,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonAuthenticated,
+		Message:            "the system endpoint credentials have been accepted",
+		ObservedGeneration: generation,
+	}
+
+	if rejected {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonAuthenticationFailed
+		condition.Message = in.Error()
+	}
+
+	return setCondition(conditions, condition)
+}
+
+// SetBlockedConditions updates the Ready and Blocked conditions of a resource
+// which cannot be reconciled until a dependency is ready.  Returns true if the
+// set of conditions was modified.
+func SetBlockedConditions(conditions *[]metav1.Condition, message string, generation int64) bool {
+	ready := setCondition(conditions, metav1.Condition{
+		Type:               starlingxv1.ConditionReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonDependencyNotReady,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+
+	blocked := setCondition(conditions, metav1.Condition{
+		Type:               starlingxv1.ConditionBlocked,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonDependencyNotReady,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+
+	return ready || blocked
+}
+
+// UpdateStandardConditions refreshes the standard conditions of a resource
+// from the outcome of its last reconciliation and updates its status if they
+// have changed.
+func UpdateStandardConditions(c client.Client, obj client.Object, conditions *[]metav1.Condition, reconciled bool, inSync bool, in error) error {
+	if !SetStandardConditions(conditions, reconciled, inSync, in, obj.GetGeneration()) {
+		return nil
+	}
+
+	err := c.Status().Update(context.TODO(), obj)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update conditions")
+		return err
+	}
+
+	return nil
+}
+
+// ReconcileConditions initializes the standard conditions of a resource from
+// its reconciled and in-sync state if they are not already present.  This is
+// used when a resource is not reconciled again because it has already reached
+// its desired state so that resources reconciled before the conditions were
+// introduced report them as well.
+func ReconcileConditions(c client.Client, obj client.Object, conditions *[]metav1.Condition, reconciled bool, inSync bool) error {
+	if meta.FindStatusCondition(*conditions, starlingxv1.ConditionReady) != nil {
+		return nil
+	}
+
+	SetStandardConditions(conditions, reconciled, inSync, nil, obj.GetGeneration())
+
+	err := c.Status().Update(context.TODO(), obj)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update conditions")
+		return err
+	}
+
+	return nil
+}
+
+// ReconcileBlocked records that a resource is waiting for a dependency and
+// updates its status if its conditions have changed.
+func ReconcileBlocked(c client.Client, obj client.Object, conditions *[]metav1.Condition, message string) error {
+	if !SetBlockedConditions(conditions, message, obj.GetGeneration()) {
+		return nil
+	}
+
+	err := c.Status().Update(context.TODO(), obj)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update conditions")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	perrors "github.com/pkg/errors"
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Condition utils", func() {
+	Describe("IsDependencyError", func() {
+		It("should only match dependency errors", func() {
+			Expect(IsDependencyError(NewResourceStatusDependency("waiting"))).To(BeTrue())
+			Expect(IsDependencyError(perrors.Wrap(NewSystemDependency("waiting"), "wrapped"))).To(BeTrue())
+			Expect(IsDependencyError(NewValidationError("invalid"))).To(BeFalse())
+			Expect(IsDependencyError(errors.New("failed"))).To(BeFalse())
+		})
+	})
+
+	Describe("SetStandardConditions", func() {
+		Context("when the resource has been reconciled", func() {
+			It("should report the resource as ready", func() {
+				conditions := []metav1.Condition{}
+				Expect(SetStandardConditions(&conditions, true, true, nil, 2)).To(BeTrue())
+				Expect(meta.IsStatusConditionTrue(conditions, v1.ConditionReady)).To(BeTrue())
+				Expect(meta.IsStatusConditionTrue(conditions, v1.ConditionInSync)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(conditions, v1.ConditionDegraded)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(conditions, v1.ConditionBlocked)).To(BeTrue())
+				for _, condition := range conditions {
+					Expect(condition.ObservedGeneration).To(Equal(int64(2)))
+				}
+
+				Expect(SetStandardConditions(&conditions, true, true, nil, 2)).To(BeFalse())
+			})
+		})
+		Context("when the reconciliation has failed", func() {
+			It("should report the resource as degraded", func() {
+				conditions := []metav1.Condition{}
+				Expect(SetStandardConditions(&conditions, true, false, errors.New("failed"), 1)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(conditions, v1.ConditionReady)).To(BeTrue())
+				Expect(meta.IsStatusConditionTrue(conditions, v1.ConditionDegraded)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(conditions, v1.ConditionBlocked)).To(BeTrue())
+				Expect(meta.FindStatusCondition(conditions, v1.ConditionReady).Reason).To(Equal(ReasonReconcileFailed))
+			})
+		})
+		Context("when the resource is waiting for a dependency", func() {
+			It("should report the resource as blocked", func() {
+				conditions := []metav1.Condition{}
+				err := NewResourceStatusDependency("waiting for host")
+				Expect(SetStandardConditions(&conditions, false, false, err, 1)).To(BeTrue())
+				Expect(meta.IsStatusConditionTrue(conditions, v1.ConditionBlocked)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(conditions, v1.ConditionDegraded)).To(BeTrue())
+				Expect(meta.FindStatusCondition(conditions, v1.ConditionReady).Reason).To(Equal(ReasonDependencyNotReady))
+			})
+		})
+	})
+
+	Describe("SetAuthenticatedCondition", func() {
+		It("should only be reported after the credentials have been rejected", func() {
+			conditions := []metav1.Condition{}
+			Expect(SetStandardConditions(&conditions, true, true, nil, 1)).To(BeTrue())
+			Expect(meta.FindStatusCondition(conditions, v1.ConditionAuthenticated)).To(BeNil())
+
+			err := perrors.Wrap(manager.NewAuthenticationFailed("rejected"), "failed to build client")
+			Expect(SetStandardConditions(&conditions, true, true, err, 1)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(conditions, v1.ConditionAuthenticated)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(conditions, v1.ConditionDegraded)).To(BeTrue())
+			Expect(meta.FindStatusCondition(conditions, v1.ConditionReady).Reason).To(Equal(ReasonAuthenticationFailed))
+
+			Expect(SetStandardConditions(&conditions, true, true, nil, 1)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(conditions, v1.ConditionAuthenticated)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(conditions, v1.ConditionReady)).To(BeTrue())
+		})
+	})
+
+	Describe("SetBlockedConditions", func() {
+		It("should only report changes", func() {
+			conditions := []metav1.Condition{}
+			Expect(SetBlockedConditions(&conditions, "waiting for system reconciliation", 1)).To(BeTrue())
+			Expect(SetBlockedConditions(&conditions, "waiting for system reconciliation", 1)).To(BeFalse())
+			Expect(meta.IsStatusConditionTrue(conditions, v1.ConditionBlocked)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(conditions, v1.ConditionReady)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,205 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+// MaxDeltaAttributes defines the maximum number of attributes reported in the
+// delta of a resource so that a resource which differs entirely from the
+// system configuration does not exceed the maximum size of its status.
+const MaxDeltaAttributes = 100
+
+// formatDeltaValue returns the representation of an attribute value within a
+// delta.  Strings are reported as is while every other value is reported in
+// its JSON form.  Missing values are reported as an empty string.
+func formatDeltaValue(value interface{}) string {
+	v := reflect.ValueOf(value)
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return ""
+	}
+
+	if v.Kind() == reflect.String {
+		return v.String()
+	}
+
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprintf("%v", v.Interface())
+	}
+
+	return string(data)
+}
+
+// NewDeltaAttribute returns the delta of a single attribute from its desired
+// value and the value currently configured on the system.
+func NewDeltaAttribute(path string, expected interface{}, actual interface{}) starlingxv1.DeltaAttribute {
+	return starlingxv1.DeltaAttribute{
+		Path:     path,
+		Expected: formatDeltaValue(expected),
+		Actual:   formatDeltaValue(actual),
+	}
+}
+
+// AppendDeltaAttribute adds the delta of a single attribute to a list of
+// attributes unless the list has already reached its maximum size.
+func AppendDeltaAttribute(attributes []starlingxv1.DeltaAttribute, path string, expected interface{}, actual interface{}) []starlingxv1.DeltaAttribute {
+	if len(attributes) >= MaxDeltaAttributes {
+		return attributes
+	}
+
+	return append(attributes, NewDeltaAttribute(path, expected, actual))
+}
+
+// ParameterDeltaAttributes returns the delta of a list of "key=value"
+// parameters from the parameters which must be added to and removed from the
+// system.  Parameters with the same key are reported as a single attribute
+// identified by their key.
+func ParameterDeltaAttributes(path string, added []string, removed []string) []starlingxv1.DeltaAttribute {
+	type values struct {
+		expected *string
+		actual   *string
+	}
+
+	keys := make([]string, 0, len(added)+len(removed))
+	parameters := make(map[string]*values)
+
+	record := func(parameter string, isExpected bool) {
+		key := parameter
+		value := parameter
+		if parts := strings.SplitN(parameter, "=", 2); len(parts) == 2 {
+			key = parts[0]
+			value = parts[1]
+		}
+
+		entry, ok := parameters[key]
+		if !ok {
+			entry = &values{}
+			parameters[key] = entry
+			keys = append(keys, key)
+		}
+
+		if isExpected {
+			entry.expected = &value
+		} else {
+			entry.actual = &value
+		}
+	}
+
+	for _, parameter := range added {
+		record(parameter, true)
+	}
+
+	for _, parameter := range removed {
+		record(parameter, false)
+	}
+
+	var attributes []starlingxv1.DeltaAttribute
+	for _, key := range keys {
+		entry := parameters[key]
+		attributes = AppendDeltaAttribute(attributes, deltaPath(path, key), entry.expected, entry.actual)
+	}
+
+	return attributes
+}
+
+// deltaPath returns the path of a field of the object at the specified path.
+func deltaPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+
+	return fmt.Sprintf("%s.%s", path, field)
+}
+
+// collectDeltaAttributes walks the JSON representation of the desired and
+// current configurations and adds every attribute which differs to the list
+// of attributes.  Lists of the same length are compared element by element
+// while lists of different lengths are reported as a whole.
+func collectDeltaAttributes(path string, expected interface{}, actual interface{}, attributes []starlingxv1.DeltaAttribute) []starlingxv1.DeltaAttribute {
+	if len(attributes) >= MaxDeltaAttributes {
+		return attributes
+	}
+
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		if a, ok := actual.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(e)+len(a))
+			for key := range e {
+				keys = append(keys, key)
+			}
+			for key := range a {
+				if _, present := e[key]; !present {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				attributes = collectDeltaAttributes(deltaPath(path, key), e[key], a[key], attributes)
+			}
+
+			return attributes
+		}
+
+	case []interface{}:
+		if a, ok := actual.([]interface{}); ok && len(a) == len(e) {
+			for i := range e {
+				attributes = collectDeltaAttributes(fmt.Sprintf("%s[%d]", path, i), e[i], a[i], attributes)
+			}
+
+			return attributes
+		}
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		attributes = AppendDeltaAttribute(attributes, path, expected, actual)
+	}
+
+	return attributes
+}
+
+// jsonData returns the generic JSON representation of a value.
+func jsonData(value interface{}) (result interface{}, err error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(data, &result)
+
+	return result, err
+}
+
+// GetDeltaAttributes returns each attribute which differs between the desired
+// configuration of a resource and the configuration currently found on the
+// system.  Attributes are identified by their path within the JSON
+// representation of the configuration.
+func GetDeltaAttributes(expected interface{}, actual interface{}) ([]starlingxv1.DeltaAttribute, error) {
+	expectedData, err := jsonData(expected)
+	if err != nil {
+		return nil, err
+	}
+
+	actualData, err := jsonData(actual)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectDeltaAttributes("", expectedData, actualData, nil), nil
+}
@@ -0,0 +1,79 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+var _ = Describe("Delta attributes", func() {
+	type fs struct {
+		Name string `json:"name"`
+		Size int    `json:"size"`
+	}
+
+	type config struct {
+		Description *string `json:"description,omitempty"`
+		Filesystems []fs    `json:"filesystems,omitempty"`
+		Servers     []string
+	}
+
+	Describe("GetDeltaAttributes", func() {
+		It("should report each attribute which differs", func() {
+			description := "lab"
+			expected := config{
+				Description: &description,
+				Filesystems: []fs{{"backup", 10}, {"docker", 30}},
+				Servers:     []string{"a", "b"},
+			}
+			actual := config{
+				Filesystems: []fs{{"backup", 5}, {"docker", 30}},
+				Servers:     []string{"a"},
+			}
+
+			attributes, err := GetDeltaAttributes(expected, actual)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(attributes).To(Equal([]v1.DeltaAttribute{
+				{Path: "Servers", Expected: `["a","b"]`, Actual: `["a"]`},
+				{Path: "description", Expected: "lab"},
+				{Path: "filesystems[0].size", Expected: "10", Actual: "5"},
+			}))
+		})
+
+		It("should report nothing when the configurations match", func() {
+			value := config{Servers: []string{"a"}}
+			attributes, err := GetDeltaAttributes(value, value)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(attributes).To(BeEmpty())
+		})
+	})
+
+	Describe("AppendDeltaAttribute", func() {
+		It("should format pointers and bound the number of attributes", func() {
+			mtu := 1500
+			attributes := AppendDeltaAttribute(nil, "mtu", &mtu, 9000)
+			Expect(attributes).To(Equal([]v1.DeltaAttribute{{Path: "mtu", Expected: "1500", Actual: "9000"}}))
+
+			for i := 0; i < 2*MaxDeltaAttributes; i++ {
+				attributes = AppendDeltaAttribute(attributes, "mtu", &mtu, nil)
+			}
+			Expect(attributes).To(HaveLen(MaxDeltaAttributes))
+		})
+	})
+
+	Describe("ParameterDeltaAttributes", func() {
+		It("should pair the parameters by key", func() {
+			attributes := ParameterDeltaAttributes("parameters",
+				[]string{"domainNumber=24", "priority1=128"}, []string{"domainNumber=0", "masterOnly"})
+			Expect(attributes).To(Equal([]v1.DeltaAttribute{
+				{Path: "parameters.domainNumber", Expected: "24", Actual: "0"},
+				{Path: "parameters.priority1", Expected: "128"},
+				{Path: "parameters.masterOnly", Actual: "masterOnly"},
+			}))
+		})
+	})
+})
@@ -0,0 +1,151 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+// eventKey identifies a unique event generated for a given object.
+type eventKey struct {
+	kind      string
+	namespace string
+	name      string
+	uid       types.UID
+	eventtype string
+	reason    string
+	message   string
+}
+
+// eventOccurrences tracks the occurrences of an event since it was last
+// generated.
+type eventOccurrences struct {
+	generated  time.Time
+	suppressed int
+}
+
+// DedupEventLogger is an implementation of a ReconcilerEventLogger which
+// collapses identical events generated for the same object within a window
+// into a single event.  Reconcilers waiting on a dependency tend to generate
+// the same event on every pass, which would otherwise flood the namespace and
+// make the events of a resource unreadable.  The first occurrence of an event
+// is always generated; repeats within the window are suppressed and counted,
+// and the next occurrence after the window has elapsed is generated with the
+// number of times that it was repeated.
+type DedupEventLogger struct {
+	ReconcilerEventLogger
+	Window time.Duration
+
+	lock   sync.Mutex
+	events map[eventKey]*eventOccurrences
+	pruned time.Time
+	now    func() time.Time
+}
+
+// NewEventLogger returns the event logger used by the reconcilers.  Events
+// are deduplicated over the configured window.
+func NewEventLogger(recorder record.EventRecorder, logger logr.Logger) ReconcilerEventLogger {
+	return NewDedupEventLogger(&EventLogger{
+		EventRecorder: recorder,
+		Logger:        logger}, utils.GetEventDedupWindow())
+}
+
+// NewDedupEventLogger returns an event logger which deduplicates the events
+// of the specified logger over a window.  A window of 0 disables the
+// deduplication.
+func NewDedupEventLogger(logger ReconcilerEventLogger, window time.Duration) *DedupEventLogger {
+	return &DedupEventLogger{
+		ReconcilerEventLogger: logger,
+		Window:                window,
+		events:                make(map[eventKey]*eventOccurrences),
+		now:                   time.Now,
+	}
+}
+
+// prune removes the events which have not been repeated within the window so
+// that the set of tracked events does not grow without bounds.  The caller
+// must hold the lock.
+func (in *DedupEventLogger) prune(now time.Time) {
+	if now.Sub(in.pruned) < in.Window {
+		return
+	}
+
+	for key, occurrences := range in.events {
+		if now.Sub(occurrences.generated) >= in.Window {
+			delete(in.events, key)
+		}
+	}
+
+	in.pruned = now
+}
+
+// filter determines whether an event is to be generated and returns the
+// message to be generated with it.
+func (in *DedupEventLogger) filter(object runtime.Object, eventtype string, reason string, msg string) (bool, string) {
+	if in.Window <= 0 {
+		return true, msg
+	}
+
+	key := eventKey{
+		kind:      fmt.Sprintf("%T", object),
+		eventtype: eventtype,
+		reason:    reason,
+		message:   msg,
+	}
+
+	if accessor, err := meta.Accessor(object); err == nil {
+		key.namespace = accessor.GetNamespace()
+		key.name = accessor.GetName()
+		key.uid = accessor.GetUID()
+	}
+
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	now := in.now()
+
+	occurrences, ok := in.events[key]
+	if ok && now.Sub(occurrences.generated) < in.Window {
+		occurrences.suppressed++
+		return false, msg
+	}
+
+	if ok && occurrences.suppressed > 0 {
+		msg = fmt.Sprintf("%s (occurred %d more times in the last %s)",
+			msg, occurrences.suppressed+1, now.Sub(occurrences.generated).Round(time.Second))
+	}
+
+	// Prune before recording the event so that the event being generated is
+	// not discarded.
+	in.prune(now)
+	in.events[key] = &eventOccurrences{generated: now}
+
+	return true, msg
+}
+
+// NormalEvent generates a "normal" event unless it has already been generated
+// within the window.
+func (in *DedupEventLogger) NormalEvent(object runtime.Object, reason string, messageFmt string, args ...interface{}) {
+	if ok, msg := in.filter(object, v1.EventTypeNormal, reason, fmt.Sprintf(messageFmt, args...)); ok {
+		in.ReconcilerEventLogger.NormalEvent(object, reason, "%s", msg)
+	}
+}
+
+// WarningEvent generates a "warning" event unless it has already been
+// generated within the window.
+func (in *DedupEventLogger) WarningEvent(object runtime.Object, reason string, messageFmt string, args ...interface{}) {
+	if ok, msg := in.filter(object, v1.EventTypeWarning, reason, fmt.Sprintf(messageFmt, args...)); ok {
+		in.ReconcilerEventLogger.WarningEvent(object, reason, "%s", msg)
+	}
+}
@@ -0,0 +1,87 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type recordedEvent struct {
+	eventtype string
+	reason    string
+	message   string
+}
+
+type stubEventLogger struct {
+	events []recordedEvent
+}
+
+func (s *stubEventLogger) NormalEvent(object runtime.Object, reason string, messageFmt string, args ...interface{}) {
+	s.events = append(s.events, recordedEvent{"Normal", reason, fmt.Sprintf(messageFmt, args...)})
+}
+
+func (s *stubEventLogger) WarningEvent(object runtime.Object, reason string, messageFmt string, args ...interface{}) {
+	s.events = append(s.events, recordedEvent{"Warning", reason, fmt.Sprintf(messageFmt, args...)})
+}
+
+var _ = Describe("Event deduplication", func() {
+	var stub *stubEventLogger
+	var logger *DedupEventLogger
+	var now time.Time
+	var host *v1.Host
+
+	BeforeEach(func() {
+		stub = &stubEventLogger{}
+		now = time.Now()
+		logger = NewDedupEventLogger(stub, time.Minute)
+		logger.now = func() time.Time { return now }
+		host = &v1.Host{ObjectMeta: metav1.ObjectMeta{Name: "controller-0", Namespace: "test"}}
+	})
+
+	It("should collapse identical events within the window", func() {
+		for i := 0; i < 5; i++ {
+			logger.NormalEvent(host, ResourceDependency, "waiting for %s", "system")
+			now = now.Add(time.Second)
+		}
+		Expect(stub.events).To(HaveLen(1))
+		Expect(stub.events[0].message).To(Equal("waiting for system"))
+
+		now = now.Add(time.Minute)
+		logger.NormalEvent(host, ResourceDependency, "waiting for %s", "system")
+		Expect(stub.events).To(HaveLen(2))
+		Expect(stub.events[1].message).To(HavePrefix("waiting for system (occurred 5 more times"))
+
+		now = now.Add(time.Minute)
+		logger.NormalEvent(host, ResourceDependency, "waiting for %s", "system")
+		Expect(stub.events).To(HaveLen(3))
+		Expect(stub.events[2].message).To(Equal("waiting for system"))
+	})
+
+	It("should not collapse distinct events", func() {
+		other := &v1.Host{ObjectMeta: metav1.ObjectMeta{Name: "controller-1", Namespace: "test"}}
+
+		logger.NormalEvent(host, ResourceDependency, "waiting for system")
+		logger.NormalEvent(other, ResourceDependency, "waiting for system")
+		logger.NormalEvent(host, ResourceDependency, "waiting for host")
+		logger.WarningEvent(host, ResourceDependency, "waiting for system")
+		logger.NormalEvent(host, ResourceUpdated, "waiting for system")
+		Expect(stub.events).To(HaveLen(5))
+	})
+
+	It("should generate every event when disabled", func() {
+		logger.Window = 0
+		for i := 0; i < 3; i++ {
+			logger.WarningEvent(host, ResourceUpdated, "failed")
+		}
+		Expect(stub.events).To(HaveLen(3))
+	})
+})
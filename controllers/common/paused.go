@@ -0,0 +1,88 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"context"
+	"strings"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Defines the reasons reported with the Paused condition.
+const (
+	PausedReasonAnnotation = "PausedByAnnotation"
+	PausedReasonResumed    = "Resumed"
+)
+
+// IsPaused determines whether reconciliation of a resource has been suspended
+// through the paused annotation.  Any value other than "false" pauses the
+// resource.
+func IsPaused(obj metav1.Object) bool {
+	value, ok := obj.GetAnnotations()[manager.PausedReconcile]
+	return ok && !strings.EqualFold(strings.TrimSpace(value), "false")
+}
+
+// SetPausedCondition updates the Paused condition in a set of conditions.  The
+// condition is only added once the resource has been paused at least once.
+// Returns true if the set of conditions was modified.
+func SetPausedCondition(conditions *[]metav1.Condition, paused bool, generation int64) bool {
+	existing := meta.FindStatusCondition(*conditions, starlingxv1.ConditionPaused)
+	if existing == nil && !paused {
+		return false
+	}
+
+	condition := metav1.Condition{
+		Type:               starlingxv1.ConditionPaused,
+		Status:             metav1.ConditionFalse,
+		Reason:             PausedReasonResumed,
+		Message:            "reconciliation is active",
+		ObservedGeneration: generation,
+	}
+
+	if paused {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = PausedReasonAnnotation
+		condition.Message = "reconciliation has been paused by the " + manager.PausedReconcile + " annotation"
+	}
+
+	if existing != nil && existing.Status == condition.Status &&
+		existing.Reason == condition.Reason && existing.ObservedGeneration == generation {
+		return false
+	}
+
+	meta.SetStatusCondition(conditions, condition)
+
+	return true
+}
+
+// ReconcilePaused refreshes the Paused condition of a resource and reports
+// whether its reconciliation has been suspended.  The caller must stop
+// reconciling the resource whenever true is returned.
+func ReconcilePaused(c client.Client, logger ReconcilerEventLogger, obj client.Object, conditions *[]metav1.Condition) (bool, error) {
+	paused := IsPaused(obj)
+
+	if !SetPausedCondition(conditions, paused, obj.GetGeneration()) {
+		return paused, nil
+	}
+
+	err := c.Status().Update(context.TODO(), obj)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update paused condition")
+		return paused, err
+	}
+
+	if paused {
+		logger.NormalEvent(obj, ResourceUpdated, "reconciliation has been paused")
+	} else {
+		logger.NormalEvent(obj, ResourceUpdated, "reconciliation has been resumed")
+	}
+
+	return paused, nil
+}
@@ -0,0 +1,62 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Paused utils", func() {
+	Describe("IsPaused", func() {
+		Context("with different annotation values", func() {
+			It("should only pause annotated resources", func() {
+				tests := []struct {
+					annotations map[string]string
+					want        bool
+				}{
+					{annotations: nil, want: false},
+					{annotations: map[string]string{"other": "true"}, want: false},
+					{annotations: map[string]string{manager.PausedReconcile: ""}, want: true},
+					{annotations: map[string]string{manager.PausedReconcile: "true"}, want: true},
+					{annotations: map[string]string{manager.PausedReconcile: "False"}, want: false},
+				}
+				for _, tt := range tests {
+					obj := &v1.DataNetwork{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+					Expect(IsPaused(obj)).To(Equal(tt.want))
+				}
+			})
+		})
+	})
+
+	Describe("SetPausedCondition", func() {
+		Context("when the resource has never been paused", func() {
+			It("should not add the condition", func() {
+				conditions := []metav1.Condition{}
+				Expect(SetPausedCondition(&conditions, false, 1)).To(BeFalse())
+				Expect(conditions).To(BeEmpty())
+			})
+		})
+		Context("when the resource is paused and then resumed", func() {
+			It("should track the paused state", func() {
+				conditions := []metav1.Condition{}
+				Expect(SetPausedCondition(&conditions, true, 1)).To(BeTrue())
+				Expect(meta.IsStatusConditionTrue(conditions, v1.ConditionPaused)).To(BeTrue())
+
+				Expect(SetPausedCondition(&conditions, true, 1)).To(BeFalse())
+
+				Expect(SetPausedCondition(&conditions, false, 1)).To(BeTrue())
+				condition := meta.FindStatusCondition(conditions, v1.ConditionPaused)
+				Expect(condition).ToNot(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Reason).To(Equal(PausedReasonResumed))
+			})
+		})
+	})
+})
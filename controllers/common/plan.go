@@ -0,0 +1,45 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"errors"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PlannedChangeLimitMessage explains why the Planned condition of a resource
+// only reports a single change.
+const PlannedChangeLimitMessage = "later changes are only evaluated once this one has been sent; the delta status lists every difference"
+
+// IsPlannedChange determines whether an error reports that a change was
+// withheld from the system because plan mode is enabled.
+func IsPlannedChange(in error) bool {
+	return errors.As(in, &manager.PlannedChange{})
+}
+
+// PlannedChangeMessage returns the description of the change withheld from
+// the system without the details of the request which carried it.
+func PlannedChangeMessage(in error) string {
+	var change manager.PlannedChange
+	if errors.As(in, &change) {
+		return change.Error()
+	}
+
+	return in.Error()
+}
+
+// ReportPlannedChange raises an event describing the change which a resource
+// intended to make to the system if the error reports that it was withheld
+// because plan mode is enabled.  Returns true if an event was raised.
+func ReportPlannedChange(logger ReconcilerEventLogger, obj runtime.Object, in error) bool {
+	if in == nil || !IsPlannedChange(in) {
+		return false
+	}
+
+	logger.NormalEvent(obj, ResourcePlanned, "%s", PlannedChangeMessage(in))
+
+	return true
+}
@@ -0,0 +1,71 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"errors"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	perrors "github.com/pkg/errors"
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Plan utils", func() {
+	change := manager.PlannedChange{Method: "PATCH", Resource: "ihosts", ID: "1234", Attributes: []string{"action"}}
+	planned := perrors.Wrap(&url.Error{Op: "Patch", URL: "http://sysinv/v1/ihosts/1234", Err: change}, "failed to lock host")
+
+	Describe("IsPlannedChange", func() {
+		It("should only match withheld changes", func() {
+			Expect(IsPlannedChange(planned)).To(BeTrue())
+			Expect(IsPlannedChange(change)).To(BeTrue())
+			Expect(IsPlannedChange(errors.New("failed"))).To(BeFalse())
+			Expect(PlannedChangeMessage(planned)).To(Equal(change.Error()))
+		})
+	})
+
+	Describe("ReportPlannedChange", func() {
+		It("should raise an event for withheld changes only", func() {
+			stub := &stubEventLogger{}
+			host := &v1.Host{}
+
+			Expect(ReportPlannedChange(stub, host, nil)).To(BeFalse())
+			Expect(ReportPlannedChange(stub, host, errors.New("failed"))).To(BeFalse())
+			Expect(ReportPlannedChange(stub, host, planned)).To(BeTrue())
+			Expect(stub.events).To(Equal([]recordedEvent{{"Normal", ResourcePlanned, change.Error()}}))
+		})
+	})
+
+	Describe("SetStandardConditions", func() {
+		Context("when a change has been withheld", func() {
+			It("should report the planned change without degrading the resource", func() {
+				conditions := []metav1.Condition{}
+				Expect(SetStandardConditions(&conditions, false, false, planned, 1)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(conditions, v1.ConditionReady)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(conditions, v1.ConditionDegraded)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(conditions, v1.ConditionBlocked)).To(BeTrue())
+
+				condition := meta.FindStatusCondition(conditions, v1.ConditionPlanned)
+				Expect(condition).ToNot(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Message).To(Equal(change.Error() + "; " + PlannedChangeLimitMessage))
+
+				Expect(SetStandardConditions(&conditions, true, true, nil, 2)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(conditions, v1.ConditionPlanned)).To(BeTrue())
+			})
+		})
+		Context("when no change has ever been withheld", func() {
+			It("should not add the planned condition", func() {
+				conditions := []metav1.Condition{}
+				SetStandardConditions(&conditions, true, true, nil, 1)
+				Expect(meta.FindStatusCondition(conditions, v1.ConditionPlanned)).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,57 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+)
+
+// alarmRaisedAgainstHost determines whether an alarm was raised against a
+// host or against one of its resources.  The entity instance is of the form
+// "host=<hostname>.<type>=<name>".
+func alarmRaisedAgainstHost(alarm alarms.Alarm, hostname string) bool {
+	entity := fmt.Sprintf("host=%s", hostname)
+	for _, part := range strings.Split(alarm.EntityInstanceID, ".") {
+		if part == entity {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PreflightAlarmFailures returns the identifiers of the active management
+// affecting alarms which withhold a disruptive action on a host.  Alarms
+// raised against the host itself are skipped since they are usually resolved
+// by the action being withheld.  The action may proceed if the list is empty.
+func PreflightAlarmFailures(hostname string, active []alarms.Alarm, ignored []string) []string {
+	result := make([]string, 0)
+
+	for _, a := range active {
+		if !a.IsManagementAffecting() {
+			continue
+		}
+
+		if utils.ContainsString(ignored, a.AlarmID) {
+			continue
+		}
+
+		if alarmRaisedAgainstHost(a, hostname) {
+			continue
+		}
+
+		if !utils.ContainsString(result, a.AlarmID) {
+			result = append(result, a.AlarmID)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
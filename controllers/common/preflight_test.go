@@ -0,0 +1,38 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package common
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+)
+
+var _ = Describe("Preflight utils", func() {
+	Describe("PreflightAlarmFailures", func() {
+		active := []alarms.Alarm{
+			{AlarmID: "100.114", EntityInstanceID: "host=controller-0.ntp", ManagementAffecting: "False"},
+			{AlarmID: "200.004", EntityInstanceID: "host=worker-1", ManagementAffecting: "True"},
+			{AlarmID: "250.001", EntityInstanceID: "host=controller-1", ManagementAffecting: "True"},
+			{AlarmID: "800.001", EntityInstanceID: "cluster=ceph", ManagementAffecting: "True"},
+			{AlarmID: "800.001", EntityInstanceID: "cluster=ceph", ManagementAffecting: "True"},
+		}
+
+		Context("without ignored alarms", func() {
+			It("should fail on the management affecting alarms of other entities", func() {
+				Expect(PreflightAlarmFailures("worker-0", active, nil)).To(Equal([]string{"200.004", "250.001", "800.001"}))
+				Expect(PreflightAlarmFailures("controller-1", active, nil)).To(Equal([]string{"200.004", "800.001"}))
+				Expect(PreflightAlarmFailures("worker-1", active, nil)).To(Equal([]string{"250.001", "800.001"}))
+			})
+		})
+
+		Context("with ignored alarms", func() {
+			It("should fail on the remaining management affecting alarms", func() {
+				Expect(PreflightAlarmFailures("worker-0", active, []string{"800.001"})).To(Equal([]string{"200.004", "250.001"}))
+				Expect(PreflightAlarmFailures("worker-1", active, []string{"250.001", "800.001"})).To(BeEmpty())
+			})
+		})
+	})
+})
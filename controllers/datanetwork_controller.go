@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package controllers
 
@@ -7,17 +7,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/datanetworks"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/interfaceDataNetworks"
 	perrors "github.com/pkg/errors"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
@@ -33,6 +38,12 @@ const DataNetworkControllerName = "datanetwork-controller"
 
 const DataNetworkFinalizerName = "datanetwork.finalizers.windriver.com"
 
+// Defines the reasons reported with the ImmutableFieldsChanged condition.
+const (
+	DataNetworkReasonImmutable = "Immutable"
+	DataNetworkReasonResolved  = "Resolved"
+)
+
 var _ reconcile.Reconciler = &DataNetworkReconciler{}
 
 // DataNetworkReconciler reconciles a DataNetwork object
@@ -50,63 +61,52 @@ type DataNetworkReconciler struct {
 // with the latest stored configuration.
 func dataNetworkUpdateRequired(instance *starlingxv1.DataNetwork, n *datanetworks.DataNetwork, r *DataNetworkReconciler) (opts datanetworks.DataNetworkOpts, result bool) {
 	var delta strings.Builder
+	var attributes []starlingxv1.DeltaAttribute
 	if instance.Name != n.Name {
 		opts.Name = &instance.Name
 		delta.WriteString(fmt.Sprintf("\t+Name: %s\n", *opts.Name))
+		attributes = common.AppendDeltaAttribute(attributes, "name", instance.Name, n.Name)
 		result = true
 	}
 
 	spec := instance.Spec
-	if spec.Type != n.Type {
-		opts.Type = &spec.Type
-		delta.WriteString(fmt.Sprintf("\t+Type: %s\n", *opts.Type))
-		result = true
-	}
-
 	if spec.MTU != nil && *spec.MTU != n.MTU {
 		opts.MTU = spec.MTU
 		delta.WriteString(fmt.Sprintf("\t+MTU: %d\n", *opts.MTU))
+		attributes = common.AppendDeltaAttribute(attributes, "mtu", spec.MTU, n.MTU)
 		result = true
 	}
 
 	if spec.Description != nil && *spec.Description != n.Description {
 		opts.Description = spec.Description
 		delta.WriteString(fmt.Sprintf("\t+Description: %s\n", *opts.Description))
+		attributes = common.AppendDeltaAttribute(attributes, "description", spec.Description, n.Description)
 		result = true
 	}
 
 	if spec.Type == datanetworks.TypeVxLAN && spec.VxLAN != nil {
+		// The endpoint mode cannot be modified and is therefore reported by
+		// dataNetworkImmutableChanges rather than being updated here.
 		vxlan := spec.VxLAN
-		if vxlan.EndpointMode != nil && n.Mode != nil {
-			if *vxlan.EndpointMode != *n.Mode {
-				opts.Mode = vxlan.EndpointMode
-				delta.WriteString(fmt.Sprintf("\t+Mode: %s\n", *opts.Mode))
-				result = true
-			}
-		}
-
-		if vxlan.UDPPortNumber != nil && n.UDPPortNumber != nil {
-			if *vxlan.UDPPortNumber != *n.UDPPortNumber {
-				opts.PortNumber = vxlan.UDPPortNumber
-				delta.WriteString(fmt.Sprintf("\t+PortNumber: %d\n", *opts.PortNumber))
-				result = true
-			}
+		if vxlan.UDPPortNumber != nil && (n.UDPPortNumber == nil || *vxlan.UDPPortNumber != *n.UDPPortNumber) {
+			opts.PortNumber = vxlan.UDPPortNumber
+			delta.WriteString(fmt.Sprintf("\t+PortNumber: %d\n", *opts.PortNumber))
+			attributes = common.AppendDeltaAttribute(attributes, "vxlan.udpPortNumber", vxlan.UDPPortNumber, n.UDPPortNumber)
+			result = true
 		}
 
-		if vxlan.TTL != nil && n.TTL != nil {
-			if *vxlan.TTL != *n.TTL {
-				opts.TTL = vxlan.TTL
-				delta.WriteString(fmt.Sprintf("\t+TTL: %d\n", *opts.TTL))
-				result = true
-			}
+		if vxlan.TTL != nil && (n.TTL == nil || *vxlan.TTL != *n.TTL) {
+			opts.TTL = vxlan.TTL
+			delta.WriteString(fmt.Sprintf("\t+TTL: %d\n", *opts.TTL))
+			attributes = common.AppendDeltaAttribute(attributes, "vxlan.ttl", vxlan.TTL, n.TTL)
+			result = true
 		}
 
-		if vxlan.MulticastGroup != nil && n.MulticastGroup != nil {
-			if *vxlan.MulticastGroup != *n.MulticastGroup {
-				opts.MulticastGroup = vxlan.MulticastGroup
-				delta.WriteString(fmt.Sprintf("\t+MulticastGroup: %s\n", *opts.MulticastGroup))
-				result = true
-			}
+		if vxlan.MulticastGroup != nil && (n.MulticastGroup == nil || *vxlan.MulticastGroup != *n.MulticastGroup) {
+			opts.MulticastGroup = vxlan.MulticastGroup
+			delta.WriteString(fmt.Sprintf("\t+MulticastGroup: %s\n", *opts.MulticastGroup))
+			attributes = common.AppendDeltaAttribute(attributes, "vxlan.multicastGroup", vxlan.MulticastGroup, n.MulticastGroup)
+			result = true
 		}
 	}
 	deltaString := delta.String()
@@ -115,6 +115,7 @@ func dataNetworkUpdateRequired(instance *starlingxv1.DataNetwork, n *datanetwork
 		logDataNetwork.Info(fmt.Sprintf("delta configuration:%s\n", deltaString))
 	}
 	instance.Status.Delta = deltaString
+	instance.Status.DeltaAttributes = attributes
 	err := r.Client.Status().Update(context.TODO(), instance)
 	if err != nil {
 		logDataNetwork.Info(fmt.Sprintf("failed to update status:  %s\n", err))
@@ -123,6 +124,56 @@ func dataNetworkUpdateRequired(instance *starlingxv1.DataNetwork, n *datanetwork
 	return opts, result
 }
 
+// dataNetworkImmutableChanges returns a description of each attribute which
+// differs between the specification and the system but which cannot be
+// modified once the data network has been created.
+func dataNetworkImmutableChanges(instance *starlingxv1.DataNetwork, n *datanetworks.DataNetwork) []string {
+	result := make([]string, 0)
+
+	spec := instance.Spec
+	if spec.Type != n.Type {
+		result = append(result, fmt.Sprintf("type (%s != %s)", spec.Type, n.Type))
+	}
+
+	if spec.Type == datanetworks.TypeVxLAN && spec.VxLAN != nil {
+		mode := spec.VxLAN.EndpointMode
+		if mode != nil && n.Mode != nil && *mode != *n.Mode {
+			result = append(result, fmt.Sprintf("endpointMode (%s != %s)", *mode, *n.Mode))
+		}
+	}
+
+	return result
+}
+
+// setImmutableFieldsCondition updates the ImmutableFieldsChanged condition on
+// the data network status.  The status is only written when the condition
+// changes.
+func (r *DataNetworkReconciler) setImmutableFieldsCondition(instance *starlingxv1.DataNetwork, status metav1.ConditionStatus, reason, message string) error {
+	current := meta.FindStatusCondition(instance.Status.Conditions, starlingxv1.ConditionImmutableFieldsChanged)
+	if current == nil && status == metav1.ConditionFalse {
+		return nil
+	} else if current != nil && current.Status == status && current.Message == message {
+		return nil
+	}
+
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:               starlingxv1.ConditionImmutableFieldsChanged,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: instance.Generation,
+	})
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
 // ReconcileNew is a method which handles reconciling a new data resource and
 // creates the corresponding system resource thru the system API.
 func (r *DataNetworkReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.DataNetwork) (*datanetworks.DataNetwork, error) {
@@ -172,6 +223,25 @@ func (r *DataNetworkReconciler) ReconcileNew(client *gophercloud.ServiceClient,
 // resource and updates the corresponding system resource thru the system API to
 // match the desired state of the resource.
 func (r *DataNetworkReconciler) ReconcileUpdated(client *gophercloud.ServiceClient, instance *starlingxv1.DataNetwork, network *datanetworks.DataNetwork) error {
+	if changes := dataNetworkImmutableChanges(instance, network); len(changes) > 0 {
+		msg := fmt.Sprintf("data network attributes cannot be modified after creation: %s",
+			strings.Join(changes, ", "))
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated, msg)
+
+		err := r.setImmutableFieldsCondition(instance, metav1.ConditionTrue, DataNetworkReasonImmutable, msg)
+		if err != nil {
+			return err
+		}
+
+		return common.NewUserDataError(msg)
+	}
+
+	err := r.setImmutableFieldsCondition(instance, metav1.ConditionFalse, DataNetworkReasonResolved,
+		"data network attributes match the system")
+	if err != nil {
+		return err
+	}
+
 	// Update existing network
 	if opts, ok := dataNetworkUpdateRequired(instance, network, r); ok {
 		if instance.Status.Reconciled && r.StopAfterInSync() {
@@ -240,12 +310,82 @@ func (r *DataNetworkReconciler) ReconciledDeleted(client *gophercloud.ServiceCli
 	return nil
 }
 
+// hostDataNetworkAttachments returns the interfaces of a host which are
+// attached to a data network.
+func hostDataNetworkAttachments(hostname string, id string, objects []interfaceDataNetworks.InterfaceDataNetwork) []starlingxv1.DataNetworkAttachment {
+	result := make([]starlingxv1.DataNetworkAttachment, 0)
+	for _, in := range objects {
+		if in.DataNetworkUUID == id {
+			result = append(result, starlingxv1.DataNetworkAttachment{
+				Host:      hostname,
+				Interface: in.InterfaceName,
+			})
+		}
+	}
+
+	return result
+}
+
+// dataNetworkAttachmentsEqual is a utility which compares two lists of data
+// network attachments.
+func dataNetworkAttachmentsEqual(a, b []starlingxv1.DataNetworkAttachment) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].DeepEqual(&b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FindAttachments collects the host interfaces which are attached to a data
+// network from the interface data network associations of every host.  The
+// list is sorted so that it only changes when the attachments change.
+func (r *DataNetworkReconciler) FindAttachments(client *gophercloud.ServiceClient, network *datanetworks.DataNetwork) ([]starlingxv1.DataNetworkAttachment, error) {
+	objects, err := hosts.ListHosts(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return nil, err
+	}
+
+	result := make([]starlingxv1.DataNetworkAttachment, 0)
+	for _, h := range objects {
+		results, err := interfaceDataNetworks.ListInterfaceDataNetworks(client, h.ID)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to list interface data networks of host: %s", h.Hostname)
+			return nil, err
+		}
+
+		result = append(result, hostDataNetworkAttachments(h.Hostname, network.ID, results)...)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Host != result[j].Host {
+			return result[i].Host < result[j].Host
+		}
+		return result[i].Interface < result[j].Interface
+	})
+
+	return result, nil
+}
+
 // statusUpdateRequired is a utility function which determines whether an update
 // is required to the host status attribute.  Updating this unnecessarily
 // will result in an infinite reconciliation loop.
-func (r *DataNetworkReconciler) statusUpdateRequired(instance *starlingxv1.DataNetwork, network *datanetworks.DataNetwork, inSync bool) (result bool) {
+func (r *DataNetworkReconciler) statusUpdateRequired(instance *starlingxv1.DataNetwork, network *datanetworks.DataNetwork, inSync bool, attachments []starlingxv1.DataNetworkAttachment) (result bool) {
 	status := &instance.Status
 
+	// A nil list means that the attachments could not be collected so the
+	// previously recorded list is kept.
+	if attachments != nil && !dataNetworkAttachmentsEqual(status.Attachments, attachments) {
+		status.Attachments = attachments
+		result = true
+	}
+
 	if network != nil {
 		if status.ID == nil || *status.ID != network.ID {
 			status.ID = &network.ID
@@ -340,7 +480,21 @@ func (r *DataNetworkReconciler) ReconcileResource(client *gophercloud.ServiceCli
 			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
 		}
 
-		if r.statusUpdateRequired(instance, network, inSync) {
+		var attachments []starlingxv1.DataNetworkAttachment
+		if network != nil {
+			var err2 error
+			attachments, err2 = r.FindAttachments(client, network)
+			if err2 != nil {
+				logDataNetwork.Error(err2, "failed to collect data network attachments")
+			}
+		}
+
+		updated := r.statusUpdateRequired(instance, network, inSync, attachments)
+		if common.SetStandardConditions(&instance.Status.Conditions, instance.Status.Reconciled, inSync, err, instance.Generation) {
+			updated = true
+		}
+
+		if updated {
 			// Update the resource status to link it to the system object.
 			logDataNetwork.Info("updating data network", "status", instance.Status)
 
@@ -536,10 +690,20 @@ func (r *DataNetworkReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return ctrl.Result{}, nil
 	}
 
-	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation && instance.Status.Reconciled {
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
 		return ctrl.Result{}, nil
 	}
 
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation && instance.Status.Reconciled {
+		err = common.ReconcileConditions(r.Client, instance, &instance.Status.Conditions,
+			instance.Status.Reconciled, instance.Status.InSync)
+		return ctrl.Result{}, err
+	}
+
 	// Update scope from configuration
 	logDataNetwork.V(2).Info("before UpdateConfigStatus", "instance", instance)
 	err = r.UpdateConfigStatus(instance)
@@ -575,16 +739,22 @@ func (r *DataNetworkReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		// wait.
 		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
 			"waiting for platform client creation")
-		return common.RetryMissingClient, nil
+		err = common.ReconcileBlocked(r.Client, instance, &instance.Status.Conditions,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, err
 	}
 
 	if !r.CloudManager.GetSystemReady(request.Namespace) {
 		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
 			"waiting for system reconciliation")
-		return common.RetrySystemNotReady, nil
+		err = common.ReconcileBlocked(r.Client, instance, &instance.Status.Conditions,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, err
 	}
 
+	platformClient = cloudManager.WithChangeInitiator(platformClient, instance)
 	err = r.ReconcileResource(platformClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
 	if err != nil {
 		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
 	}
@@ -601,9 +771,7 @@ func (r *DataNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.ReconcilerErrorHandler = &common.ErrorHandler{
 		CloudManager: tMgr,
 		Logger:       logDataNetwork}
-	r.ReconcilerEventLogger = &common.EventLogger{
-		EventRecorder: mgr.GetEventRecorderFor(DataNetworkControllerName),
-		Logger:        logDataNetwork}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(DataNetworkControllerName), logDataNetwork)
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.DataNetwork{}).
 		Complete(r)
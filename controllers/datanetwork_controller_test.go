@@ -6,6 +6,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/datanetworks"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/interfaceDataNetworks"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -57,3 +59,43 @@ var _ = Describe("Datanetwork controller", func() {
 		})
 	})
 })
+
+var _ = Describe("Datanetwork update utils", func() {
+
+	Describe("dataNetworkImmutableChanges", func() {
+		It("Should report the type and endpoint mode but not mutable attributes", func() {
+			mtu, ttl := 1500, 10
+			static, dynamic := "static", "dynamic"
+			instance := &starlingxv1.DataNetwork{
+				Spec: starlingxv1.DataNetworkSpec{
+					Type:  datanetworks.TypeVxLAN,
+					MTU:   &mtu,
+					VxLAN: &starlingxv1.VxLANInfo{TTL: &ttl, EndpointMode: &static},
+				},
+			}
+			network := &datanetworks.DataNetwork{Type: datanetworks.TypeVxLAN, MTU: 9000, Mode: &static}
+			Expect(dataNetworkImmutableChanges(instance, network)).To(BeEmpty())
+
+			network.Mode = &dynamic
+			Expect(dataNetworkImmutableChanges(instance, network)).To(Equal([]string{
+				"endpointMode (static != dynamic)",
+			}))
+
+			network.Type = datanetworks.TypeVLAN
+			Expect(dataNetworkImmutableChanges(instance, network)).To(HaveLen(2))
+		})
+	})
+
+	Describe("hostDataNetworkAttachments", func() {
+		It("Should only report interfaces attached to the data network", func() {
+			objects := []interfaceDataNetworks.InterfaceDataNetwork{
+				{DataNetworkUUID: "dn-1", InterfaceName: "data0"},
+				{DataNetworkUUID: "dn-2", InterfaceName: "data1"},
+			}
+			Expect(hostDataNetworkAttachments("compute-0", "dn-1", objects)).To(Equal([]starlingxv1.DataNetworkAttachment{
+				{Host: "compute-0", Interface: "data0"},
+			}))
+			Expect(hostDataNetworkAttachments("compute-0", "dn-3", objects)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,875 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/deviceimages"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logDeviceImage = log.Log.WithName("controller").WithName("deviceimage")
+
+const DeviceImageControllerName = "deviceimage-controller"
+
+const DeviceImageFinalizerName = "deviceimage.finalizers.windriver.com"
+
+// DeviceImageDownloadTimeout defines the maximum amount of time allowed to
+// download an image file from its source location.
+const DeviceImageDownloadTimeout = 5 * time.Minute
+
+var _ reconcile.Reconciler = &DeviceImageReconciler{}
+
+// DeviceImageReconciler reconciles a DeviceImage object
+type DeviceImageReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+}
+
+// deviceImageUpdateRequired is a utility function which determines whether
+// the attributes of a device image system resource differ from the latest
+// stored configuration.  Device images cannot be modified once uploaded
+// therefore any difference requires that the image be replaced.
+func deviceImageUpdateRequired(instance *starlingxv1.DeviceImage, image *deviceimages.DeviceImage) (delta string, attributes []starlingxv1.DeltaAttribute, result bool) {
+	var b strings.Builder
+
+	spec := instance.Spec
+	if spec.BitstreamType != image.BitstreamType {
+		b.WriteString(fmt.Sprintf("\t+BitstreamType: %s\n", spec.BitstreamType))
+		attributes = common.AppendDeltaAttribute(attributes, "bitstreamType", spec.BitstreamType, image.BitstreamType)
+		result = true
+	}
+
+	if !strings.EqualFold(spec.PCIVendor, image.PCIVendor) {
+		b.WriteString(fmt.Sprintf("\t+PCIVendor: %s\n", spec.PCIVendor))
+		attributes = common.AppendDeltaAttribute(attributes, "pciVendor", spec.PCIVendor, image.PCIVendor)
+		result = true
+	}
+
+	if !strings.EqualFold(spec.PCIDevice, image.PCIDevice) {
+		b.WriteString(fmt.Sprintf("\t+PCIDevice: %s\n", spec.PCIDevice))
+		attributes = common.AppendDeltaAttribute(attributes, "pciDevice", spec.PCIDevice, image.PCIDevice)
+		result = true
+	}
+
+	if spec.BitstreamID != nil && (image.BitstreamID == nil || *spec.BitstreamID != *image.BitstreamID) {
+		b.WriteString(fmt.Sprintf("\t+BitstreamID: %s\n", *spec.BitstreamID))
+		attributes = common.AppendDeltaAttribute(attributes, "bitstreamID", spec.BitstreamID, image.BitstreamID)
+		result = true
+	}
+
+	if spec.KeySignature != nil && (image.KeySignature == nil || *spec.KeySignature != *image.KeySignature) {
+		b.WriteString(fmt.Sprintf("\t+KeySignature: %s\n", *spec.KeySignature))
+		attributes = common.AppendDeltaAttribute(attributes, "keySignature", spec.KeySignature, image.KeySignature)
+		result = true
+	}
+
+	if spec.RevokeKeyID != nil && (image.RevokeKeyID == nil || *spec.RevokeKeyID != *image.RevokeKeyID) {
+		b.WriteString(fmt.Sprintf("\t+RevokeKeyID: %d\n", *spec.RevokeKeyID))
+		attributes = common.AppendDeltaAttribute(attributes, "revokeKeyID", spec.RevokeKeyID, image.RevokeKeyID)
+		result = true
+	}
+
+	if spec.ImageVersion != nil && (image.ImageVersion == nil || *spec.ImageVersion != *image.ImageVersion) {
+		b.WriteString(fmt.Sprintf("\t+ImageVersion: %s\n", *spec.ImageVersion))
+		attributes = common.AppendDeltaAttribute(attributes, "imageVersion", spec.ImageVersion, image.ImageVersion)
+		result = true
+	}
+
+	return b.String(), attributes, result
+}
+
+// deviceImageLabelsRequired is a utility function which determines which of the
+// desired device labels have not yet been applied to the image and which of the
+// applied labels are no longer desired.
+func deviceImageLabelsRequired(instance *starlingxv1.DeviceImage, image *deviceimages.DeviceImage) (added map[string]string, removed map[string]string) {
+	applied := make(map[string]string)
+	for _, labels := range image.AppliedLabels {
+		for key, value := range labels {
+			applied[key] = value
+		}
+	}
+
+	added = make(map[string]string)
+	for key, value := range instance.Spec.Labels {
+		if current, ok := applied[key]; !ok || current != value {
+			added[key] = value
+		}
+	}
+
+	removed = make(map[string]string)
+	for key, value := range applied {
+		if desired, ok := instance.Spec.Labels[key]; !ok || desired != value {
+			removed[key] = value
+		}
+	}
+
+	return added, removed
+}
+
+// deviceImageDeltaAttributes returns each attribute of a device image which
+// differs from the image stored on the system, including the labels which
+// have yet to be applied or removed.
+func deviceImageDeltaAttributes(instance *starlingxv1.DeviceImage, image *deviceimages.DeviceImage) []starlingxv1.DeltaAttribute {
+	_, attributes, _ := deviceImageUpdateRequired(instance, image)
+
+	added, removed := deviceImageLabelsRequired(instance, image)
+	labels := func(values map[string]string) []string {
+		result := make([]string, 0, len(values))
+		for key, value := range values {
+			result = append(result, fmt.Sprintf("%s=%s", key, value))
+		}
+		sort.Strings(result)
+		return result
+	}
+
+	return append(attributes, common.ParameterDeltaAttributes("labels", labels(added), labels(removed))...)
+}
+
+// downloadDeviceImage fetches the image file from its source location and
+// uploads it to the system.
+func (r *DeviceImageReconciler) downloadDeviceImage(client *gophercloud.ServiceClient, instance *starlingxv1.DeviceImage) (*deviceimages.DeviceImage, error) {
+	spec := instance.Spec
+
+	opts := deviceimages.DeviceImageOpts{
+		BitstreamType: spec.BitstreamType,
+		PCIVendor:     spec.PCIVendor,
+		PCIDevice:     spec.PCIDevice,
+		BitstreamID:   spec.BitstreamID,
+		KeySignature:  spec.KeySignature,
+		RevokeKeyID:   spec.RevokeKeyID,
+		Name:          &instance.Name,
+		Description:   spec.Description,
+		ImageVersion:  spec.ImageVersion,
+	}
+
+	logDeviceImage.Info("downloading device image", "source", spec.Source)
+
+	httpClient := http.Client{Timeout: DeviceImageDownloadTimeout}
+	response, err := httpClient.Get(spec.Source)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to download device image: %s", spec.Source)
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		msg := fmt.Sprintf("unable to download device image %s: %s",
+			spec.Source, response.Status)
+		return nil, common.NewResourceConfigurationDependency(msg)
+	}
+
+	logDeviceImage.Info("creating device image", "opts", opts)
+
+	image, err := deviceimages.Create(client, opts, path.Base(spec.Source), response.Body).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to create: %s", common.FormatStruct(opts))
+		return nil, err
+	}
+
+	return image, nil
+}
+
+// ReconcileLabels is a method which handles applying the device image to the
+// set of devices selected by the desired device labels, and removing it from
+// devices that are no longer selected.
+func (r *DeviceImageReconciler) ReconcileLabels(client *gophercloud.ServiceClient, instance *starlingxv1.DeviceImage, image *deviceimages.DeviceImage) error {
+	added, removed := deviceImageLabelsRequired(instance, image)
+
+	if len(removed) > 0 {
+		logDeviceImage.Info("removing device image", "uuid", image.ID, "labels", removed)
+
+		result, err := deviceimages.Remove(client, image.ID, removed).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to remove device image: %s", image.ID)
+			return err
+		}
+
+		*image = *result
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"device image has been removed from labels: %v", removed)
+	}
+
+	if len(added) > 0 {
+		logDeviceImage.Info("applying device image", "uuid", image.ID, "labels", added)
+
+		result, err := deviceimages.Apply(client, image.ID, added).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to apply device image: %s", image.ID)
+			return err
+		}
+
+		*image = *result
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"device image has been applied to labels: %v", added)
+	}
+
+	return nil
+}
+
+// ReconcileNew is a method which handles reconciling a new device image
+// resource and creates the corresponding system resource thru the system API.
+func (r *DeviceImageReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.DeviceImage) (*deviceimages.DeviceImage, error) {
+	if instance.Status.Reconciled && r.StopAfterInSync() {
+		// Do not process any further changes once we have reached a
+		// synchronized state unless there is an annotation on the resource.
+		if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+			msg := common.NoProvisioningAfterReconciled
+			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, msg)
+			return nil, common.NewChangeAfterInSync(msg)
+		} else {
+			logDeviceImage.Info(common.ProvisioningAllowedAfterReconciled)
+		}
+	}
+
+	image, err := r.downloadDeviceImage(client, instance)
+	if err != nil {
+		return nil, err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+		"device image has been created")
+
+	err = r.ReconcileLabels(client, instance, image)
+	if err != nil {
+		return image, err
+	}
+
+	return image, nil
+}
+
+// ReconcileUpdated is a method which handles reconciling an existing device
+// image resource and updates the corresponding system resource thru the system
+// API to match the desired state of the resource.
+func (r *DeviceImageReconciler) ReconcileUpdated(client *gophercloud.ServiceClient, instance *starlingxv1.DeviceImage, image *deviceimages.DeviceImage) error {
+	delta, _, replace := deviceImageUpdateRequired(instance, image)
+	added, removed := deviceImageLabelsRequired(instance, image)
+
+	if replace || len(added) > 0 || len(removed) > 0 {
+		if instance.Status.Reconciled && r.StopAfterInSync() {
+			// Do not process any further changes once we have reached a
+			// synchronized state unless there is an annotation on the resource.
+			if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+				msg := common.NoChangesAfterReconciled
+				r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, msg)
+				return common.NewChangeAfterInSync(msg)
+			} else {
+				logDeviceImage.Info(common.ChangedAllowedAfterReconciled)
+			}
+		}
+	}
+
+	if replace {
+		logDeviceImage.Info(fmt.Sprintf("delta configuration:\n%s", strings.TrimSuffix(delta, "\n")))
+
+		if len(image.AppliedLabels) > 0 {
+			// The system does not allow deleting an image that is still
+			// applied to devices.
+			unlabelled := instance.DeepCopy()
+			unlabelled.Spec.Labels = nil
+
+			err := r.ReconcileLabels(client, unlabelled, image)
+			if err != nil {
+				return err
+			}
+		}
+
+		logDeviceImage.Info("replacing device image", "uuid", image.ID)
+
+		err := deviceimages.Delete(client, image.ID).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to delete device image: %s", image.ID)
+			return err
+		}
+
+		result, err := r.downloadDeviceImage(client, instance)
+		if err != nil {
+			return err
+		}
+
+		*image = *result
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"device image has been replaced")
+	}
+
+	return r.ReconcileLabels(client, instance, image)
+}
+
+// ReconciledDeleted is a method which handles reconciling a deleted device
+// image resource and deletes the corresponding system resource thru the system
+// API.
+func (r *DeviceImageReconciler) ReconciledDeleted(client *gophercloud.ServiceClient, instance *starlingxv1.DeviceImage, image *deviceimages.DeviceImage) error {
+	if utils.ContainsString(instance.ObjectMeta.Finalizers, DeviceImageFinalizerName) {
+		if image != nil {
+			if len(image.AppliedLabels) > 0 {
+				unlabelled := instance.DeepCopy()
+				unlabelled.Spec.Labels = nil
+
+				err := r.ReconcileLabels(client, unlabelled, image)
+				if err != nil {
+					return err
+				}
+			}
+
+			// Unless it was already deleted go ahead and attempt to delete it.
+			err := deviceimages.Delete(client, image.ID).ExtractErr()
+			if err != nil {
+				if _, ok := err.(gophercloud.ErrDefault404); !ok {
+					err = perrors.Wrap(err, "failed to delete device image")
+					return err
+				}
+			}
+
+			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceDeleted, "device image has been deleted")
+		}
+
+		// Remove the finalizer so the kubernetes delete operation can continue.
+		instance.ObjectMeta.Finalizers = utils.RemoveString(instance.ObjectMeta.Finalizers, DeviceImageFinalizerName)
+		if err := r.Client.Update(context.Background(), instance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildWriteStatus is a utility function which collects the write state of the
+// device image on each device to which it has been applied.
+func buildWriteStatus(image *deviceimages.DeviceImage, states []deviceimages.DeviceImageState) (writes []starlingxv1.DeviceImageWriteStatus, progress string) {
+	completed := 0
+	for _, s := range states {
+		if s.ImageID != image.ID {
+			continue
+		}
+
+		writes = append(writes, starlingxv1.DeviceImageWriteStatus{
+			HostID:   s.HostID,
+			DeviceID: s.PCIDeviceID,
+			Status:   s.Status,
+		})
+
+		if s.Status == deviceimages.StatusCompleted {
+			completed++
+		}
+	}
+
+	if len(writes) > 0 {
+		progress = fmt.Sprintf("%d/%d", completed, len(writes))
+	}
+
+	return writes, progress
+}
+
+// writeStatusUpdateRequired is a utility function which refreshes the device
+// write states stored in the status and determines whether they have changed.
+func (r *DeviceImageReconciler) writeStatusUpdateRequired(client *gophercloud.ServiceClient, instance *starlingxv1.DeviceImage, image *deviceimages.DeviceImage) (bool, error) {
+	status := &instance.Status
+
+	var writes []starlingxv1.DeviceImageWriteStatus
+	var progress string
+
+	if image != nil {
+		states, err := deviceimages.ListDeviceImageStates(client)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to list device image states")
+			return false, err
+		}
+
+		writes, progress = buildWriteStatus(image, states)
+	}
+
+	result := false
+	if status.Progress != progress {
+		status.Progress = progress
+		result = true
+	}
+
+	if len(status.Writes) != len(writes) {
+		result = true
+	} else {
+		for i := range writes {
+			if status.Writes[i] != writes[i] {
+				result = true
+				break
+			}
+		}
+	}
+	status.Writes = writes
+
+	return result, nil
+}
+
+// statusUpdateRequired is a utility function which determines whether an update
+// is required to the device image status attribute.  Updating this unnecessarily
+// will result in an infinite reconciliation loop.
+func (r *DeviceImageReconciler) statusUpdateRequired(instance *starlingxv1.DeviceImage, image *deviceimages.DeviceImage, inSync bool) (result bool) {
+	status := &instance.Status
+
+	if image != nil {
+		if status.ID == nil || *status.ID != image.ID {
+			status.ID = &image.ID
+			result = true
+		}
+	} else {
+		status.ID = nil
+	}
+
+	if status.InSync != inSync {
+		status.InSync = inSync
+		result = true
+	}
+
+	var attributes []starlingxv1.DeltaAttribute
+	if image != nil && !inSync {
+		attributes = deviceImageDeltaAttributes(instance, image)
+	}
+
+	if !reflect.DeepEqual(status.DeltaAttributes, attributes) {
+		status.DeltaAttributes = attributes
+		result = true
+	}
+
+	if status.InSync && !status.Reconciled {
+		// Record the fact that we have reached inSync at least once.
+		status.Reconciled = true
+		status.ConfigurationUpdated = false
+		status.StrategyRequired = cloudManager.StrategyNotRequired
+		if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
+			r.CloudManager.SetResourceInfo(cloudManager.ResourceDeviceimage, "", instance.Name, status.Reconciled, status.StrategyRequired)
+		}
+		result = true
+	}
+
+	return result
+}
+
+// FindExistingResource attempts to re-use the existing resource referenced by
+// the ID value stored in the status or to find another resource with a matching
+// name.
+func (r *DeviceImageReconciler) FindExistingResource(client *gophercloud.ServiceClient, instance *starlingxv1.DeviceImage) (image *deviceimages.DeviceImage, err error) {
+	id := instance.Status.ID
+	if id != nil {
+		// This image was previously provisioned.
+		image, err = deviceimages.Get(client, *id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); !ok {
+				err = perrors.Wrapf(err, "failed to get: %s", *id)
+				return nil, err
+			}
+
+			// The resource may have been deleted by the system or operator
+			// therefore continue and attempt to recreate it.
+			logDeviceImage.Info("resource no longer exists", "id", *id)
+			return nil, nil
+		}
+
+	} else {
+		// This image needs to be provisioned if it doesn't already exist.
+		results, err := deviceimages.ListDeviceImages(client)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to list")
+			return nil, err
+		}
+
+		for _, img := range results {
+			if img.Name != nil && *img.Name == instance.Name {
+				logDeviceImage.Info("found existing device image", "uuid", img.ID)
+				image = &img
+				break
+			}
+		}
+	}
+
+	return image, err
+}
+
+// ReconcileResource interacts with the system API in order to reconcile the
+// state of a device image with the state stored in the k8s database.
+func (r *DeviceImageReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.DeviceImage) error {
+	image, err := r.FindExistingResource(client, instance)
+	if err != nil {
+		return err
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.ReconciledDeleted(client, instance, image)
+	}
+
+	if image == nil {
+		image, err = r.ReconcileNew(client, instance)
+	} else {
+		err = r.ReconcileUpdated(client, instance, image)
+	}
+
+	inSync := err == nil
+
+	if instance.Status.InSync != inSync {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	writesChanged, err2 := r.writeStatusUpdateRequired(client, instance, image)
+	if err2 != nil {
+		return err2
+	}
+
+	if r.statusUpdateRequired(instance, image, inSync) || writesChanged {
+		// Update the resource status to link it to the system object.
+		logDeviceImage.Info("updating device image", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	if err == nil && instance.Status.WritesPending() {
+		// The writes are driven by each host reconciler; keep the status
+		// current until they have all finished.
+		msg := "waiting for device image writes to complete"
+		m := NewDeviceImageWriteMonitor(instance, image.ID)
+		return r.CloudManager.StartMonitor(m, msg)
+	}
+
+	return err
+}
+
+// StopAfterInSync determines whether the reconciler should continue processing
+// change requests after the configuration has been reconciled a first time.
+func (r *DeviceImageReconciler) StopAfterInSync() bool {
+	// If the option is not found or the option was specified in a form other
+	// than a bool then assume the safest default value possible.
+	return utils.GetReconcilerOptionBool(utils.DeviceImage, utils.StopAfterInSync, true)
+}
+
+// Obtain deploymentScope value from configuration
+// Taking this value from annotation in instance
+// (It seems Client.Get does not update Status value from configuration)
+// "bootstrap" if "bootstrap" in configuration or deploymentScope not specified
+// "principal" if "principal" in configuration
+func (r *DeviceImageReconciler) GetScopeConfig(instance *starlingxv1.DeviceImage) (scope string, err error) {
+	// Set default value for deployment scope
+	deploymentScope := cloudManager.ScopeBootstrap
+	// Set DeploymentScope from configuration
+	annotation := instance.GetObjectMeta().GetAnnotations()
+	if annotation != nil {
+		config, ok := annotation["kubectl.kubernetes.io/last-applied-configuration"]
+		if ok {
+			status_config := &starlingxv1.DeviceImage{}
+			err := json.Unmarshal([]byte(config), &status_config)
+			if err == nil {
+				if status_config.Status.DeploymentScope != "" {
+					lowerCaseScope := strings.ToLower(status_config.Status.DeploymentScope)
+					switch lowerCaseScope {
+					case cloudManager.ScopeBootstrap:
+						deploymentScope = cloudManager.ScopeBootstrap
+					case cloudManager.ScopePrincipal:
+						deploymentScope = cloudManager.ScopePrincipal
+					default:
+						err = fmt.Errorf("Unsupported DeploymentScope: %s",
+							status_config.Status.DeploymentScope)
+						return deploymentScope, err
+					}
+				}
+			} else {
+				err = perrors.Wrapf(err, "failed to Unmarshal annotaion last-applied-configuration")
+				return deploymentScope, err
+			}
+		}
+	}
+	return deploymentScope, nil
+}
+
+// Update deploymentScope and ReconcileAfterInSync in instance
+// ReconcileAfterInSync value will be:
+// "true"  if deploymentScope is "principal" because it is day 2 operation (update configuration)
+// "false" if deploymentScope is "bootstrap"
+// Then reflect these values to cluster object
+func (r *DeviceImageReconciler) UpdateConfigStatus(instance *starlingxv1.DeviceImage) (err error) {
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := r.Client.Get(context.TODO(), types.NamespacedName{
+			Name:      instance.Name,
+			Namespace: instance.Namespace,
+		}, instance)
+		if err != nil {
+			return err
+		}
+		deploymentScope, err := r.GetScopeConfig(instance)
+		if err != nil {
+			return err
+		}
+		logDeviceImage.V(2).Info("deploymentScope in configuration", "deploymentScope", deploymentScope)
+
+		// Put ReconcileAfterInSync values depends on scope
+		// "true"  if scope is "principal" because it is day 2 operation (update configuration)
+		// "false" if scope is "bootstrap" or None
+		afterInSync, ok := instance.Annotations[cloudManager.ReconcileAfterInSync]
+		if deploymentScope == cloudManager.ScopePrincipal {
+			if !ok || afterInSync != "true" {
+				if instance.Annotations == nil {
+					instance.Annotations = make(map[string]string)
+				}
+				instance.Annotations[cloudManager.ReconcileAfterInSync] = "true"
+			}
+		} else {
+			if ok && afterInSync == "true" {
+				delete(instance.Annotations, cloudManager.ReconcileAfterInSync)
+			}
+		}
+		return r.Client.Update(context.TODO(), instance)
+	})
+
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update profile annotation ReconcileAfterInSync")
+		return err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := r.Client.Get(context.TODO(), types.NamespacedName{
+			Name:      instance.Name,
+			Namespace: instance.Namespace,
+		}, instance)
+		if err != nil {
+			return err
+		}
+
+		// Update scope status
+		deploymentScope, err := r.GetScopeConfig(instance)
+		if err != nil {
+			return err
+		}
+		logDeviceImage.V(2).Info("deploymentScope in configuration", "deploymentScope", deploymentScope)
+		instance.Status.DeploymentScope = deploymentScope
+
+		// Set default value for StrategyRequired
+		if instance.Status.StrategyRequired == "" {
+			instance.Status.StrategyRequired = cloudManager.StrategyNotRequired
+		}
+
+		// Check if the configuration is updated
+		if instance.Status.ObservedGeneration != instance.ObjectMeta.Generation {
+			if instance.Status.ObservedGeneration == 0 &&
+				instance.Status.Reconciled {
+				// Case: DM upgrade in reconciled node
+				instance.Status.ConfigurationUpdated = false
+			} else {
+				// Case: Fresh install or Day-2 operation
+				instance.Status.ConfigurationUpdated = true
+				if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
+					instance.Status.Reconciled = false
+					// Update strategy required status for strategy monitor
+					r.CloudManager.UpdateConfigVersion()
+					r.CloudManager.SetResourceInfo(cloudManager.ResourceDeviceimage, "", instance.Name, instance.Status.Reconciled, cloudManager.StrategyNotRequired)
+				}
+			}
+			instance.Status.ObservedGeneration = instance.ObjectMeta.Generation
+			// Reset strategy when new configuration is applied
+			instance.Status.StrategyRequired = cloudManager.StrategyNotRequired
+		}
+
+		return r.Client.Status().Update(context.TODO(), instance)
+	})
+
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
+// Reconcile reads that state of the cluster for a DeviceImage object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=deviceimages,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=deviceimages/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=deviceimages/finalizers,verbs=update
+func (r *DeviceImageReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logDeviceImage
+	logDeviceImage = logDeviceImage.WithName(request.NamespacedName.String())
+	defer func() { logDeviceImage = savedLog }()
+
+	// Fetch the DeviceImage instance
+	instance := &starlingxv1.DeviceImage{}
+	err := r.Client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logDeviceImage.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
+		instance.Status.Reconciled && !instance.Status.WritesPending() {
+		return ctrl.Result{}, nil
+	}
+
+	// Update scope from configuration
+	logDeviceImage.V(2).Info("before UpdateConfigStatus", "instance", instance)
+	err = r.UpdateConfigStatus(instance)
+	if err != nil {
+		logDeviceImage.Error(err, "unable to update scope")
+		return reconcile.Result{}, err
+	}
+	logDeviceImage.V(2).Info("after UpdateConfigStatus", "instance", instance)
+
+	if instance.DeletionTimestamp.IsZero() {
+		// Ensure that the object has a finalizer setup as a pre-delete hook so
+		// that we can delete any system resources that we previously added.
+		if !utils.ContainsString(instance.ObjectMeta.Finalizers, DeviceImageFinalizerName) {
+			instance.ObjectMeta.Finalizers = append(instance.ObjectMeta.Finalizers, DeviceImageFinalizerName)
+			if err := r.Client.Update(context.Background(), instance); err != nil {
+				return reconcile.Result{}, err
+			}
+
+			// Might as well return immediately as the update is going to cause
+			// another reconcile event for this resource and we don't want to
+			// access the system API more than necessary.
+			return reconcile.Result{}, nil
+		}
+	}
+
+	if !utils.IsReconcilerEnabled(utils.DeviceImage) {
+		return reconcile.Result{}, nil
+	}
+
+	platformClient := r.GetPlatformClient(request.Namespace)
+	if platformClient == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	if !r.CloudManager.GetSystemReady(request.Namespace) {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	platformClient = cloudManager.WithChangeInitiator(platformClient, instance)
+	err = r.ReconcileResource(platformClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if err != nil {
+		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DeviceImageReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logDeviceImage}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(DeviceImageControllerName), logDeviceImage)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.DeviceImage{}).
+		Complete(r)
+}
+
+// DefaultDeviceImageWriteMonitorInterval represents the default interval
+// between polling attempts to check whether the device image writes have
+// finished.  Writing an FPGA image can take tens of minutes so there is no
+// point in polling frequently.
+const DefaultDeviceImageWriteMonitorInterval = time.Minute
+
+// deviceImageWriteMonitor waits for all outstanding writes of a device image
+// to reach a final state.  Once they have a reconcilable event is generated to
+// kick the reconciler so that the status can be refreshed.
+type deviceImageWriteMonitor struct {
+	cloudManager.CommonMonitorBody
+	imageID string
+}
+
+// NewDeviceImageWriteMonitor defines a convenience function to instantiate
+// a new device image write monitor with all required attributes.
+func NewDeviceImageWriteMonitor(instance *starlingxv1.DeviceImage, imageID string) *cloudManager.Monitor {
+	logger := logDeviceImage.WithName("write-monitor")
+	return &cloudManager.Monitor{
+		MonitorBody: &deviceImageWriteMonitor{
+			imageID: imageID,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultDeviceImageWriteMonitorInterval,
+	}
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *deviceImageWriteMonitor) Run(client *gophercloud.ServiceClient) (stop bool, err error) {
+	states, err := deviceimages.ListDeviceImageStates(client)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to get device image states: %s", err.Error())
+		return false, err
+	}
+
+	for _, s := range states {
+		if s.ImageID != m.imageID {
+			continue
+		}
+
+		switch s.Status {
+		case deviceimages.StatusPending, deviceimages.StatusInProgress:
+			m.CommonMonitorBody.SetState("waiting for device image write on device %q", s.PCIDeviceID)
+			return false, nil
+		}
+	}
+
+	m.CommonMonitorBody.SetState("all device image writes have finished")
+
+	return true, nil
+}
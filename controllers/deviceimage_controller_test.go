@@ -0,0 +1,92 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	comm "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/deviceimages"
+)
+
+var _ = Describe("DeviceImage controller", func() {
+
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	Context("DeviceImage with data", func() {
+		It("Should created successfully", func() {
+			ctx := context.Background()
+			key := types.NamespacedName{
+				Name:      "foo",
+				Namespace: "default",
+			}
+			bitstreamID := "0x2383a62a010504"
+
+			created := &starlingxv1.DeviceImage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Spec: starlingxv1.DeviceImageSpec{
+					BitstreamType: "functional",
+					PCIVendor:     "8086",
+					PCIDevice:     "0b30",
+					BitstreamID:   &bitstreamID,
+					Source:        "http://images.example.com/functional.gbs",
+				}}
+			Expect(k8sClient.Create(ctx, created)).To(Succeed())
+
+			expected := created.DeepCopy()
+
+			fetched := &starlingxv1.DeviceImage{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, key, fetched)
+				return err == nil &&
+					fetched.ObjectMeta.ResourceVersion != expected.ObjectMeta.ResourceVersion
+			}, timeout, interval).Should(BeTrue())
+			_, found := comm.ListIntersect(fetched.ObjectMeta.Finalizers, []string{DeviceImageFinalizerName})
+			Expect(found).To(BeTrue())
+		})
+	})
+
+	Context("DeviceImage labels", func() {
+		It("Should compute the labels to apply and remove", func() {
+			instance := &starlingxv1.DeviceImage{
+				Spec: starlingxv1.DeviceImageSpec{
+					Labels: map[string]string{"fpga": "functional", "site": "east"},
+				}}
+			image := &deviceimages.DeviceImage{
+				AppliedLabels: []map[string]string{{"fpga": "functional"}, {"site": "west"}},
+			}
+
+			added, removed := deviceImageLabelsRequired(instance, image)
+			Expect(added).To(Equal(map[string]string{"site": "east"}))
+			Expect(removed).To(Equal(map[string]string{"site": "west"}))
+		})
+	})
+
+	Context("DeviceImage write status", func() {
+		It("Should only report writes for the image", func() {
+			image := &deviceimages.DeviceImage{ID: "image-1"}
+			states := []deviceimages.DeviceImageState{
+				{HostID: "host-1", PCIDeviceID: "dev-1", ImageID: "image-1", Status: "completed"},
+				{HostID: "host-2", PCIDeviceID: "dev-2", ImageID: "image-1", Status: "in-progress"},
+				{HostID: "host-2", PCIDeviceID: "dev-2", ImageID: "image-2", Status: "pending"},
+			}
+
+			writes, progress := buildWriteStatus(image, states)
+			Expect(writes).To(HaveLen(2))
+			Expect(progress).To(Equal("1/2"))
+		})
+	})
+})
@@ -0,0 +1,230 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logAdoption = log.Log.WithName("controller").WithName("host-adoption")
+
+const HostAdoptionControllerName = "host-adoption-controller"
+
+// DefaultHostAdoptionInterval defines the interval at which the system
+// inventory is searched for newly discovered hosts.
+const DefaultHostAdoptionInterval = time.Minute
+
+var _ reconcile.Reconciler = &HostAdoptionReconciler{}
+
+// HostAdoptionReconciler creates a Host resource for each host which is
+// discovered in the system inventory without a matching Host resource.  The
+// reconciler is disabled by default and is driven by the System resource of
+// each namespace since the system inventory does not generate any events.
+type HostAdoptionReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerEventLogger
+}
+
+// adoptionProfile returns the name of the HostProfile assigned to the adopted
+// hosts.
+func adoptionProfile() string {
+	return utils.GetReconcilerOptionString(utils.HostAdoption, utils.DefaultProfile, "")
+}
+
+// adoptedHostName returns the name of the Host resource created for a
+// discovered host.  The name is derived from the boot MAC address since a
+// newly discovered host has not been assigned a hostname yet.
+func adoptedHostName(h hosts.Host) string {
+	return "host-" + strings.ToLower(strings.NewReplacer(":", "", "-", "").Replace(h.BootMAC))
+}
+
+// adoptedHost builds the skeleton Host resource of a discovered host.  The
+// resource is paused so that the host is not provisioned until the operator
+// has reviewed the generated configuration.
+func adoptedHost(h hosts.Host, namespace string, profile string) *starlingxv1.Host {
+	bootMAC := h.BootMAC
+	match := &starlingxv1.MatchInfo{
+		BootMAC: &bootMAC,
+	}
+
+	if h.SerialNumber != nil && *h.SerialNumber != "" {
+		serialNumber := *h.SerialNumber
+		match.DMI = &starlingxv1.MatchDMIInfo{
+			SerialNumber: &serialNumber,
+		}
+	}
+
+	return &starlingxv1.Host{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      adoptedHostName(h),
+			Namespace: namespace,
+			Annotations: map[string]string{
+				cloudManager.PausedReconcile: "true",
+				cloudManager.AdoptedHost:     h.ID,
+			},
+		},
+		Spec: starlingxv1.HostSpec{
+			Profile: profile,
+			Match:   match,
+		},
+	}
+}
+
+// unmatchedHosts returns the newly discovered hosts of the system inventory
+// which do not match any of the Host resources.  Hosts which have already
+// been assigned a personality are never considered to be newly discovered.
+func unmatchedHosts(platformClient *gophercloud.ServiceClient, objects []hosts.Host, list []starlingxv1.Host) ([]hosts.Host, error) {
+	var bmMACs map[string]string
+	matched := make(map[string]bool)
+
+	for _, instance := range list {
+		if bmMACs == nil {
+			macs, err := boardManagementMACs(platformClient, instance.Spec.Match)
+			if err != nil {
+				return nil, err
+			}
+			bmMACs = macs
+		}
+
+		var bootMAC *string
+		if instance.Spec.Overrides != nil {
+			bootMAC = instance.Spec.Overrides.BootMAC
+		}
+
+		host := FindExistingHost(objects, bmMACs, instance.Name, instance.Spec.Match, bootMAC)
+		if host != nil {
+			matched[host.ID] = true
+		}
+	}
+
+	result := make([]hosts.Host, 0)
+	for _, h := range objects {
+		if h.Personality != "" || h.BootMAC == "" || matched[h.ID] {
+			continue
+		}
+
+		result = append(result, h)
+	}
+
+	return result, nil
+}
+
+// ReconcileAdoption creates a paused Host resource for each newly discovered
+// host which does not match any of the existing Host resources.
+func (r *HostAdoptionReconciler) ReconcileAdoption(platformClient *gophercloud.ServiceClient, instance *starlingxv1.System, profile string) error {
+	objects, err := hosts.ListHosts(platformClient)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return err
+	}
+
+	list := &starlingxv1.HostList{}
+	err = r.List(context.TODO(), list, client.InNamespace(instance.Namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list host resources")
+		return err
+	}
+
+	discovered, err := unmatchedHosts(platformClient, objects, list.Items)
+	if err != nil {
+		return err
+	}
+
+	for _, h := range discovered {
+		host := adoptedHost(h, instance.Namespace, profile)
+
+		err = r.Create(context.TODO(), host)
+		if errors.IsAlreadyExists(err) {
+			// A resource of the same name exists but does not match the
+			// host; leave it for the operator to resolve.
+			logAdoption.Info("host resource already exists", "name", host.Name, "id", h.ID)
+			continue
+		} else if err != nil {
+			err = perrors.Wrapf(err, "failed to create host resource: %s", host.Name)
+			return err
+		}
+
+		r.NormalEvent(instance, common.ResourceCreated,
+			"host %s has been adopted as %s", h.ID, host.Name)
+	}
+
+	return nil
+}
+
+// Reconcile searches the system inventory of the namespace of a System
+// resource for newly discovered hosts and adopts them.
+func (r *HostAdoptionReconciler) Reconcile(ctx context.Context, request ctrl.Request) (result ctrl.Result, err error) {
+	if !utils.IsReconcilerEnabled(utils.HostAdoption) {
+		return ctrl.Result{}, nil
+	}
+
+	instance := &starlingxv1.System{}
+	err = r.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		logAdoption.Error(err, "unable to read object: %v", request)
+		return ctrl.Result{}, err
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	profile := adoptionProfile()
+	if profile == "" {
+		logAdoption.Info("no default profile has been configured; hosts are not adopted")
+		return ctrl.Result{}, nil
+	}
+
+	platformClient := r.GetPlatformClient(request.Namespace)
+	if platformClient == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		return common.RetryMissingClient, nil
+	}
+
+	platformClient = cloudManager.WithChangeInitiator(platformClient, instance)
+	err = r.ReconcileAdoption(platformClient, instance, profile)
+	if err != nil {
+		logAdoption.Error(err, "failed to adopt hosts", "namespace", request.Namespace)
+		return common.RetryTransientError, nil
+	}
+
+	return ctrl.Result{RequeueAfter: DefaultHostAdoptionInterval}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *HostAdoptionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(HostAdoptionControllerName), logAdoption)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(HostAdoptionControllerName).
+		For(&starlingxv1.System{}).
+		Complete(r)
+}
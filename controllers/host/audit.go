@@ -0,0 +1,119 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"time"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// autoRemediationEnabled determines whether configuration drift found by the
+// periodic audit of a host may be corrected automatically.
+func autoRemediationEnabled(instance *starlingxv1.Host) bool {
+	policy := instance.Spec.ReconcilePolicy
+	return policy != nil && policy.AutoRemediate != nil && *policy.AutoRemediate
+}
+
+// auditDelay computes the delay before a host must be reconciled again to
+// audit its state.  A zero delay means that no audit is required.
+func auditDelay(instance *starlingxv1.Host, interval time.Duration) time.Duration {
+	delay := time.Duration(0)
+	if instance.Status.Reconciled {
+		delay = interval
+	}
+
+	if instance.Status.ClockSync != nil && (delay == 0 || ClockSyncAuditInterval < delay) {
+		delay = ClockSyncAuditInterval
+	}
+
+	return delay
+}
+
+// requeueForAudit adjusts the result of a reconcile so that the host is
+// reconciled again once its next audit is due.  Results which already retry
+// sooner are left untouched.
+func requeueForAudit(instance *starlingxv1.Host, result ctrl.Result) ctrl.Result {
+	delay := auditDelay(instance, utils.GetAuditInterval())
+	if delay == 0 {
+		return result
+	}
+
+	if result.Requeue && (result.RequeueAfter == 0 || result.RequeueAfter <= delay) {
+		return result
+	}
+
+	if result.RequeueAfter > 0 && result.RequeueAfter <= delay {
+		return result
+	}
+
+	return ctrl.Result{RequeueAfter: delay}
+}
+
+// auditState tracks the periodic audit of a single host.
+type auditState struct {
+	last   time.Time
+	active bool
+}
+
+// auditDue determines whether the configuration of a reconciled host must be
+// compared against the system again.  When due, the audit is marked as
+// active until endAudit is called.  The time of the last audit is only kept
+// in memory so every host is audited once after a restart.
+func (r *HostReconciler) auditDue(instance *starlingxv1.Host) bool {
+	interval := utils.GetAuditInterval()
+	if interval == 0 || !instance.Status.Reconciled {
+		return false
+	}
+
+	name := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+
+	r.auditLock.Lock()
+	defer r.auditLock.Unlock()
+
+	if r.audits == nil {
+		r.audits = make(map[types.NamespacedName]*auditState)
+	}
+
+	state, ok := r.audits[name]
+	if ok && time.Since(state.last) < interval {
+		return false
+	} else if !ok {
+		state = &auditState{}
+		r.audits[name] = state
+	}
+
+	state.last = time.Now()
+	state.active = true
+
+	return true
+}
+
+// auditInProgress determines whether the current reconcile of a host is a
+// periodic audit.  Drift is only remediated during an audit so that changes
+// made to the configuration itself still follow the deployment scope.
+func (r *HostReconciler) auditInProgress(instance *starlingxv1.Host) bool {
+	name := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+
+	r.auditLock.Lock()
+	defer r.auditLock.Unlock()
+
+	state, ok := r.audits[name]
+	return ok && state.active
+}
+
+// endAudit marks the audit of a host as complete.
+func (r *HostReconciler) endAudit(instance *starlingxv1.Host) {
+	name := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+
+	r.auditLock.Lock()
+	defer r.auditLock.Unlock()
+
+	if state, ok := r.audits[name]; ok {
+		state.active = false
+	}
+}
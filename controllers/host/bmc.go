@@ -0,0 +1,232 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/redfish"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Defines the reasons reported with the BMCredentialsRejected condition.
+const (
+	BMCredentialsReasonRejected = "Rejected"
+	BMCredentialsReasonAccepted = "Accepted"
+)
+
+// bmCredentialsRotationRequired determines whether the BM secret last applied
+// to the host has changed since it was applied.
+func (r *HostReconciler) bmCredentialsRotationRequired(instance *starlingxv1.Host) bool {
+	status := instance.Status.BMCredentials
+	if status == nil {
+		return false
+	}
+
+	secret, err := r.getBMSecret(instance.Namespace, status.Secret)
+	if err != nil {
+		// Let the regular reconcile path deal with missing secrets.
+		return false
+	}
+
+	return secret.ResourceVersion != status.ResourceVersion
+}
+
+// findHostsForBMSecret maps a secret to the set of hosts to which its contents
+// were last applied as BM credentials so that those hosts are reconciled
+// whenever the secret changes.
+func (r *HostReconciler) findHostsForBMSecret(obj client.Object) []reconcile.Request {
+	list := &starlingxv1.HostList{}
+	err := r.Client.List(context.TODO(), list, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		logHost.Error(err, "failed to list hosts for BM secret", "secret", obj.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, h := range list.Items {
+		status := h.Status.BMCredentials
+		if status == nil || status.Secret != obj.GetName() {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: h.Namespace, Name: h.Name},
+		})
+	}
+
+	return requests
+}
+
+// bmInsecure determines whether the TLS certificate of a board management
+// controller is verified when connecting to it directly.
+func bmInsecure(bm *starlingxv1.BMInfo) bool {
+	return bm.Insecure != nil && *bm.Insecure
+}
+
+// verifyBMCredentials confirms that the BMC accepts a set of credentials before
+// they are applied to the host.  This is only possible for Redfish BMCs;
+// other types are accepted as is.  Failures other than an explicit rejection
+// are logged and ignored since the BMC may not be reachable from this
+// controller.
+func verifyBMCredentials(bm *starlingxv1.BMInfo, username, password string) error {
+	if bm.Type == nil || !strings.EqualFold(*bm.Type, BMTypeRedfish) || bm.Address == nil {
+		return nil
+	}
+
+	err := redfish.NewClient(*bm.Address, username, password, bmInsecure(bm)).VerifyCredentials()
+	if err != nil {
+		if _, ok := err.(redfish.ErrUnauthorized); ok {
+			return err
+		}
+
+		logHost.Info("unable to verify BM credentials", "address", *bm.Address, "error", err.Error())
+	}
+
+	return nil
+}
+
+// setBMCredentialsCondition updates the BMCredentialsRejected condition on the
+// host status.  The condition is only added once credentials have been
+// rejected at least once.
+func setBMCredentialsCondition(instance *starlingxv1.Host, status metav1.ConditionStatus, reason, message string) {
+	existing := meta.FindStatusCondition(instance.Status.Conditions, starlingxv1.ConditionBMCredentialsRejected)
+	if existing == nil && status == metav1.ConditionFalse {
+		return
+	}
+
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:               starlingxv1.ConditionBMCredentialsRejected,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: instance.Generation,
+	})
+}
+
+// rejectBMCredentials records that the credentials in a BM secret could not
+// be applied to the host.
+func (r *HostReconciler) rejectBMCredentials(instance *starlingxv1.Host, name string, cause error) error {
+	msg := fmt.Sprintf("BM credentials from secret %q were rejected: %s", name, cause.Error())
+	setBMCredentialsCondition(instance, metav1.ConditionTrue, BMCredentialsReasonRejected, msg)
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated, msg)
+
+	return nil
+}
+
+// ReconcileBMCredentials is responsible for re-applying the BM credentials of
+// a host whenever the referenced secret is updated or replaced.  The version
+// of the secret that was last applied is tracked in the host status.
+func (r *HostReconciler) ReconcileBMCredentials(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *hosts.Host) error {
+	bm := profile.BoardManagement
+	if bm == nil || bm.Credentials == nil || bm.Credentials.Password == nil {
+		return nil
+	}
+
+	if host.BMUsername == nil {
+		// The credentials have not been applied yet.  This is handled as part
+		// of the regular host attributes.
+		return nil
+	}
+
+	name := bm.Credentials.Password.Secret
+	secret, err := r.getBMSecret(instance.Namespace, name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			msg := fmt.Sprintf("waiting for BM credentials secret: %q", name)
+			m := NewKubernetesSecretMonitor(instance, types.NamespacedName{Namespace: instance.Namespace, Name: name})
+			return r.CloudManager.StartMonitor(m, msg)
+		}
+
+		return err
+	}
+
+	status := instance.Status.BMCredentials
+	if status != nil && status.Secret == name && status.ResourceVersion == secret.ResourceVersion {
+		return nil
+	}
+
+	if status != nil {
+		username, password, err := parseBMPasswordCredentials(secret)
+		if err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(client.Endpoint, cloudManager.HTTPPrefix) && r.HTTPSRequired() {
+			// Do not send password information in the clear.
+			msg := "it is unsafe to configure BM credentials thru a non HTTPS URL"
+			return common.NewSystemDependency(msg)
+		}
+
+		err = verifyBMCredentials(bm, username, password)
+		if err != nil {
+			err = r.rejectBMCredentials(instance, name, err)
+			if err != nil {
+				return err
+			}
+
+			msg := fmt.Sprintf("BMC rejected the credentials in secret %q", name)
+			return common.NewUserDataError(msg)
+		}
+
+		logHost.Info("rotating BM credentials", "secret", name)
+
+		opts := hosts.HostOpts{BMUsername: &username, BMPassword: &password}
+		result, err := hosts.Update(client, host.ID, opts).Extract()
+		if err != nil || result == nil {
+			if err != nil {
+				err2 := r.rejectBMCredentials(instance, name, err)
+				if err2 != nil {
+					return err2
+				}
+			}
+
+			err = perrors.Wrapf(err, "failed to update BM credentials: %s", host.ID)
+			return err
+		}
+
+		*host = *result
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"BM credentials have been rotated from secret %q", name)
+	}
+
+	instance.Status.BMCredentials = &starlingxv1.HostBMCredentialsStatus{
+		Secret:          name,
+		ResourceVersion: secret.ResourceVersion,
+	}
+
+	setBMCredentialsCondition(instance, metav1.ConditionFalse, BMCredentialsReasonAccepted,
+		fmt.Sprintf("BM credentials from secret %q have been applied", name))
+
+	err = r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
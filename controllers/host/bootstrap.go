@@ -0,0 +1,82 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// bootstrapPhase returns the phase of the bootstrap sequence which is reached
+// once a host is unlocked.  Controller-0 is never gated since every other
+// phase depends on it.
+func bootstrapPhase(name string, personality string) string {
+	switch personality {
+	case hosts.PersonalityController:
+		if name != hosts.Controller0 {
+			return starlingxv1.BootstrapPhaseController1Unlocked
+		}
+	case hosts.PersonalityStorage:
+		return starlingxv1.BootstrapPhaseStorageUnlocked
+	case hosts.PersonalityWorker:
+		return starlingxv1.BootstrapPhaseWorkersUnlocked
+	}
+
+	return ""
+}
+
+// ReconcileBootstrapHealthCheck delays the initial unlock of a host until the
+// health check configured for its bootstrap phase on the System resource
+// passes.  Hosts whose phase has no health check are never delayed.
+func (r *HostReconciler) ReconcileBootstrapHealthCheck(instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec) error {
+	phase := bootstrapPhase(instance.Name, *profile.Personality)
+	if phase == "" {
+		return nil
+	}
+
+	list := &starlingxv1.SystemList{}
+	err := r.List(context.TODO(), list, client.InNamespace(instance.Namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list systems")
+		return err
+	}
+
+	if len(list.Items) == 0 {
+		return nil
+	}
+
+	check := common.FindBootstrapHealthCheck(&list.Items[0].Spec, phase)
+	if check == nil {
+		return nil
+	}
+
+	faultClient := r.CloudManager.GetFaultClient(instance.Namespace)
+	if faultClient == nil {
+		msg := fmt.Sprintf("waiting for the fault management API to evaluate the %s health check", phase)
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	active, err := alarms.ListAlarms(faultClient)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list alarms")
+		return err
+	}
+
+	failures := common.BootstrapHealthCheckFailures(check, active)
+	if len(failures) > 0 {
+		msg := fmt.Sprintf("waiting for the %s health check to pass; active alarms: %s",
+			phase, strings.Join(failures, ", "))
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	return nil
+}
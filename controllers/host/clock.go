@@ -0,0 +1,308 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/ntp"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/ptpinstances"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+)
+
+const (
+	// PTPAlarmID is the alarm raised by the system when the PTP or GNSS
+	// synchronization of a host degrades.
+	PTPAlarmID = "100.119"
+
+	// ClockSyncAuditInterval defines how often the synchronization state of
+	// a reconciled host with PTP instances is refreshed.
+	ClockSyncAuditInterval = 5 * time.Minute
+)
+
+// clockSourceReady determines whether the time source that a host is being
+// switched to is available so that the host is never left without an active
+// time source.  A host switching to PTP must first be assigned a PTP instance
+// while a host switching to NTP requires NTP servers to be configured on the
+// system.
+func clockSourceReady(source string, host *v1info.HostInfo, ntpServers string) (bool, string) {
+	switch source {
+	case starlingxv1.ClockSynchronizationPTP:
+		if len(host.PTPInstances) == 0 {
+			return false, "waiting for a PTP instance to be assigned before switching to ptp clock synchronization"
+		}
+
+	case starlingxv1.ClockSynchronizationNTP:
+		if strings.TrimSpace(ntpServers) == "" {
+			return false, "waiting for NTP servers to be configured before switching to ntp clock synchronization"
+		}
+	}
+
+	return true, ""
+}
+
+// ReconcileClockSynchronization is responsible for switching the clock
+// synchronization source of a provisioned host.  It runs after the PTP
+// instances of the host have been reconciled so that a host switching to PTP
+// already has an instance to synchronize with.  The switch is delayed until
+// the new time source is available.
+func (r *HostReconciler) ReconcileClockSynchronization(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	desired := profile.ClockSynchronization
+	if desired == nil {
+		return nil
+	}
+
+	current := host.ClockSynchronization
+	if current != nil && *current == *desired {
+		return nil
+	}
+
+	ntpServers := ""
+	if *desired == starlingxv1.ClockSynchronizationNTP {
+		result, err := ntp.GetDefaultNTP(client)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to get NTP configuration")
+			return err
+		}
+
+		if result != nil {
+			ntpServers = result.NTPServers
+		}
+	}
+
+	if ok, msg := clockSourceReady(*desired, host, ntpServers); !ok {
+		return common.NewResourceConfigurationDependency(msg)
+	}
+
+	opts := hosts.HostOpts{ClockSynchronization: desired}
+
+	logHost.Info("switching clock synchronization", "opts", opts)
+
+	result, err := hosts.Update(client, host.ID, opts).Extract()
+	if err != nil || result == nil {
+		err = perrors.Wrapf(err, "failed to update clock synchronization: %s, %s",
+			host.ID, common.FormatStruct(opts))
+		return err
+	}
+
+	host.Host = *result
+
+	from := "none"
+	if current != nil {
+		from = *current
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"clock synchronization has been switched from %s to %s", from, *desired)
+
+	if from == starlingxv1.ClockSynchronizationPTP {
+		// PTP instances that were kept while the host depended on them can
+		// now be removed.
+		return r.ReconcilePTPInstances(client, instance, profile, host)
+	}
+
+	return nil
+}
+
+// ptpInstanceRemovalAllowed determines whether the last PTP instance may be
+// removed from a host.  A host that still synchronizes its clock with PTP
+// keeps its last instance until it has been switched to another source.
+func ptpInstanceRemovalAllowed(host *v1info.HostInfo, remaining int) bool {
+	if remaining > 0 {
+		return true
+	}
+
+	return host.ClockSynchronization == nil || *host.ClockSynchronization != starlingxv1.ClockSynchronizationPTP
+}
+
+// clockSyncRank orders the synchronization states from the most to the least
+// synchronized.
+var clockSyncRank = map[string]int{
+	starlingxv1.ClockSyncStateLocked:   0,
+	starlingxv1.ClockSyncStateHoldover: 1,
+	starlingxv1.ClockSyncStateFreerun:  2,
+}
+
+// worstClockSyncState returns the least synchronized of two states.
+func worstClockSyncState(a, b string) string {
+	if clockSyncRank[b] > clockSyncRank[a] {
+		return b
+	}
+
+	return a
+}
+
+// parseEntityInstanceID splits an alarm entity instance ID of the form
+// "host=<hostname>.instance=<name>.ptp=<state>" into its key/value pairs.
+func parseEntityInstanceID(id string) map[string]string {
+	result := make(map[string]string)
+	for _, field := range strings.Split(id, ".") {
+		if key, value, ok := strings.Cut(field, "="); ok {
+			result[strings.ToLower(key)] = value
+		}
+	}
+
+	return result
+}
+
+// alarmClockSyncState determines the synchronization state described by a
+// PTP alarm.  Alarms which do not describe a loss of lock (e.g., a clock
+// that is out of tolerance) do not change the state.
+func alarmClockSyncState(alarm alarms.Alarm) string {
+	reason := strings.ToLower(alarm.ReasonText + " " + alarm.EntityInstanceID)
+
+	switch {
+	case strings.Contains(reason, "holdover"):
+		return starlingxv1.ClockSyncStateHoldover
+	case strings.Contains(reason, "freerun"),
+		strings.Contains(reason, "free run"),
+		strings.Contains(reason, "not locked"),
+		strings.Contains(reason, "no-lock"),
+		strings.Contains(reason, "signal loss"),
+		strings.Contains(reason, "signal-loss"):
+		return starlingxv1.ClockSyncStateFreerun
+	}
+
+	return ""
+}
+
+// isGNSSAlarm determines whether a PTP alarm was raised for a GNSS receiver
+// rather than for a PTP instance.
+func isGNSSAlarm(alarm alarms.Alarm, entity map[string]string) bool {
+	if _, ok := entity["gnss"]; ok {
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(alarm.ReasonText), "gnss")
+}
+
+// hostClockSyncStatus derives the PTP and GNSS synchronization state of a
+// host from the PTP alarms raised against it.  Instances without an alarm
+// are considered to be locked.
+func hostClockSyncStatus(hostname string, instances []ptpinstances.PTPInstance, active []alarms.Alarm) *starlingxv1.HostClockSyncStatus {
+	if len(instances) == 0 {
+		return nil
+	}
+
+	states := make(map[string]string)
+	for _, i := range instances {
+		states[i.Name] = starlingxv1.ClockSyncStateLocked
+	}
+
+	status := starlingxv1.HostClockSyncStatus{State: starlingxv1.ClockSyncStateLocked}
+
+	for _, a := range active {
+		if a.AlarmID != PTPAlarmID {
+			continue
+		}
+
+		entity := parseEntityInstanceID(a.EntityInstanceID)
+		if entity["host"] != hostname {
+			continue
+		}
+
+		state := alarmClockSyncState(a)
+		if state == "" {
+			continue
+		}
+
+		status.State = worstClockSyncState(status.State, state)
+
+		if name, ok := entity["instance"]; ok {
+			if current, ok := states[name]; ok {
+				states[name] = worstClockSyncState(current, state)
+			}
+		} else if isGNSSAlarm(a, entity) {
+			gnss := state
+			if status.GNSS != nil {
+				gnss = worstClockSyncState(*status.GNSS, state)
+			}
+			status.GNSS = &gnss
+		}
+	}
+
+	status.Instances = make([]starlingxv1.HostPTPInstanceSyncStatus, 0, len(states))
+	for name, state := range states {
+		status.Instances = append(status.Instances, starlingxv1.HostPTPInstanceSyncStatus{Name: name, State: state})
+	}
+
+	sort.Slice(status.Instances, func(i, j int) bool {
+		return status.Instances[i].Name < status.Instances[j].Name
+	})
+
+	return &status
+}
+
+// updateClockSyncStatus is responsible for recording the PTP and GNSS
+// synchronization state of a host in its status so that the timing health of
+// the fleet is visible without inspecting each host.  The state is derived
+// from the alarms raised by the system audits; failing to read the alarms is
+// not considered an error since it does not affect the configuration.
+func (r *HostReconciler) updateClockSyncStatus(instance *starlingxv1.Host, instances []ptpinstances.PTPInstance) error {
+	var status *starlingxv1.HostClockSyncStatus
+
+	if len(instances) > 0 {
+		client := r.CloudManager.GetFaultClient(instance.Namespace)
+		if client == nil {
+			return nil
+		}
+
+		active, err := alarms.ListAlarms(client)
+		if err != nil {
+			logHost.Error(err, "failed to list alarms")
+			return nil
+		}
+
+		status = hostClockSyncStatus(instance.Name, instances, active)
+	}
+
+	if (status == nil && instance.Status.ClockSync == nil) ||
+		(status != nil && status.DeepEqual(instance.Status.ClockSync)) {
+		return nil
+	}
+
+	if status != nil && status.State != starlingxv1.ClockSyncStateLocked {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"clock synchronization state is %s", status.State)
+	}
+
+	instance.Status.ClockSync = status
+
+	logHost.V(2).Info("updating clock synchronization status", "status", status)
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
+// AuditClockSync refreshes the synchronization state of a reconciled host
+// which reports one.  It is run periodically since alarms are raised and
+// cleared by the system without any change to the host resource.
+func (r *HostReconciler) AuditClockSync(client *gophercloud.ServiceClient, instance *starlingxv1.Host) error {
+	if instance.Status.ID == nil || instance.Status.ClockSync == nil {
+		return nil
+	}
+
+	instances, err := ptpinstances.ListHostPTPInstances(client, *instance.Status.ID)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list host PTP instances")
+		return err
+	}
+
+	return r.updateClockSyncStatus(instance, instances)
+}
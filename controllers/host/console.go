@@ -0,0 +1,315 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/redfish"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// Defines the attributes of the ConfigMap used to store captured console
+// output.
+const (
+	ConsoleConfigMapSuffix = "-console"
+	ConsoleConfigMapKey    = "console.log"
+)
+
+// DefaultConsoleMaxSize defines the number of bytes of console output that
+// are retained when the host does not specify a limit.
+const DefaultConsoleMaxSize = 256 * 1024
+
+// consoleFlushInterval defines how often captured console output is written
+// to the ConfigMap.
+const consoleFlushInterval = 15 * time.Second
+
+// consoleCaptureTimeout defines the maximum amount of time that a console is
+// captured for.  This protects against hosts that never come online.
+const consoleCaptureTimeout = 3 * time.Hour
+
+// consoleCapture defines the state of a single serial console capture.
+type consoleCapture struct {
+	instance *starlingxv1.Host
+	info     starlingxv1.ConsoleCaptureInfo
+	client   *redfish.Client
+	output   []byte
+	dirty    bool
+	stop     chan struct{}
+}
+
+// destination returns the destination to which the console output is
+// written.
+func (c *consoleCapture) destination() string {
+	if c.info.Destination != nil {
+		return *c.info.Destination
+	}
+
+	return starlingxv1.ConsoleDestinationConfigMap
+}
+
+// maxSize returns the number of bytes of console output that are retained.
+func (c *consoleCapture) maxSize() int {
+	if c.info.MaxSize != nil {
+		return *c.info.MaxSize
+	}
+
+	return DefaultConsoleMaxSize
+}
+
+// append adds a single line of console output to the capture.  Output sent
+// to the log is emitted immediately while output sent to a ConfigMap is
+// buffered until the next flush.
+func (c *consoleCapture) append(line string) {
+	line = strings.TrimRight(line, "\r")
+
+	if c.destination() == starlingxv1.ConsoleDestinationLog {
+		logHost.Info("console", "host", c.instance.Name, "output", line)
+		return
+	}
+
+	c.output = append(c.output, line...)
+	c.output = append(c.output, '\n')
+	if excess := len(c.output) - c.maxSize(); excess > 0 {
+		c.output = c.output[excess:]
+	}
+
+	c.dirty = true
+}
+
+// consoleConfigMapName returns the name of the ConfigMap used to store the
+// console output of a host.
+func consoleConfigMapName(instance *starlingxv1.Host) string {
+	return instance.Name + ConsoleConfigMapSuffix
+}
+
+// flushConsoleCapture writes the buffered console output to the ConfigMap of
+// the host.  The ConfigMap is owned by the host so that it is removed along
+// with it.
+func (r *HostReconciler) flushConsoleCapture(c *consoleCapture) error {
+	if !c.dirty {
+		return nil
+	}
+
+	name := types.NamespacedName{Namespace: c.instance.Namespace, Name: consoleConfigMapName(c.instance)}
+
+	cm := &v1.ConfigMap{}
+	err := r.Client.Get(context.TODO(), name, cm)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+
+		cm = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: name.Namespace,
+				Name:      name.Name,
+			},
+			Data: map[string]string{ConsoleConfigMapKey: string(c.output)},
+		}
+
+		err = controllerutil.SetControllerReference(c.instance, cm, r.Scheme)
+		if err != nil {
+			return err
+		}
+
+		err = r.Client.Create(context.TODO(), cm)
+		if err != nil {
+			return err
+		}
+
+	} else {
+		cm.Data = map[string]string{ConsoleConfigMapKey: string(c.output)}
+
+		err = r.Client.Update(context.TODO(), cm)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.dirty = false
+
+	return nil
+}
+
+// runConsoleCapture reads the serial console of a host until the capture is
+// stopped, the session ends, or the capture times out.
+func (r *HostReconciler) runConsoleCapture(c *consoleCapture) {
+	name := types.NamespacedName{Namespace: c.instance.Namespace, Name: c.instance.Name}
+	defer r.releaseConsoleCapture(name, c)
+
+	session, err := c.client.OpenSerialConsole(
+		stringValue(c.info.HostKey), c.info.Insecure != nil && *c.info.Insecure)
+	if err != nil {
+		logHost.Error(err, "failed to open serial console", "host", c.instance.Name)
+		r.ReconcilerEventLogger.WarningEvent(c.instance, common.ResourceUpdated,
+			"failed to open serial console: %s", err.Error())
+		return
+	}
+	defer session.Close()
+
+	logHost.Info("capturing serial console", "host", c.instance.Name, "destination", c.destination())
+
+	// The reader must also give up when the capture ends on its own (e.g.,
+	// on a timeout) otherwise it would block forever on a scanned line.
+	done := make(chan struct{})
+	defer close(done)
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(session)
+		scanner.Buffer(make([]byte, 0, 4096), DefaultConsoleMaxSize)
+		for scanner.Scan() {
+			select {
+			case lines <- scanner.Text():
+			case <-c.stop:
+				return
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(consoleFlushInterval)
+	defer ticker.Stop()
+
+	timeout := time.NewTimer(consoleCaptureTimeout)
+	defer timeout.Stop()
+
+	for stopped := false; !stopped; {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				stopped = true
+				break
+			}
+			c.append(line)
+			continue
+
+		case <-c.stop:
+			stopped = true
+
+		case <-timeout.C:
+			logHost.Info("serial console capture timed out", "host", c.instance.Name)
+			stopped = true
+
+		case <-ticker.C:
+		}
+
+		err = r.flushConsoleCapture(c)
+		if err != nil {
+			logHost.Error(err, "failed to store serial console output", "host", c.instance.Name)
+		}
+	}
+
+	logHost.Info("serial console capture has stopped", "host", c.instance.Name)
+}
+
+// stringValue is a utility which returns the value of an optional string.
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+// releaseConsoleCapture removes a capture from the set of active captures
+// unless it has already been replaced.
+func (r *HostReconciler) releaseConsoleCapture(name types.NamespacedName, c *consoleCapture) {
+	r.consoleLock.Lock()
+	defer r.consoleLock.Unlock()
+
+	if r.consoles[name] == c {
+		delete(r.consoles, name)
+	}
+}
+
+// StartConsoleCapture starts capturing the serial console output of a host
+// that has just been booted for installation.  Capturing is best effort
+// therefore failures are reported as events rather than errors so that they
+// do not interfere with the installation.
+func (r *HostReconciler) StartConsoleCapture(instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec) {
+	info := instance.Spec.ConsoleCapture
+	if info == nil {
+		return
+	}
+
+	bm := profile.BoardManagement
+	if bm == nil || bm.Type == nil || !strings.EqualFold(*bm.Type, BMTypeRedfish) ||
+		bm.Address == nil || bm.Credentials == nil || bm.Credentials.Password == nil {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"serial console capture requires a %q board management controller", BMTypeRedfish)
+		return
+	}
+
+	username, password, err := r.getBMPasswordCredentials(instance.Namespace, bm.Credentials.Password.Secret)
+	if err != nil {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"unable to start serial console capture: %s", err.Error())
+		return
+	}
+
+	c := &consoleCapture{
+		instance: instance.DeepCopy(),
+		info:     *info,
+		client:   redfish.NewClient(*bm.Address, username, password, bmInsecure(bm)),
+		stop:     make(chan struct{}),
+	}
+
+	name := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+
+	r.consoleLock.Lock()
+	if r.consoles == nil {
+		r.consoles = make(map[types.NamespacedName]*consoleCapture)
+	}
+	if previous, ok := r.consoles[name]; ok {
+		close(previous.stop)
+	}
+	r.consoles[name] = c
+	r.consoleLock.Unlock()
+
+	go r.runConsoleCapture(c)
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"serial console capture to %s has been started", consoleCaptureTarget(instance))
+}
+
+// StopConsoleCapture stops capturing the serial console output of a host if
+// a capture is in progress.
+func (r *HostReconciler) StopConsoleCapture(instance *starlingxv1.Host) {
+	name := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+
+	r.consoleLock.Lock()
+	defer r.consoleLock.Unlock()
+
+	if c, ok := r.consoles[name]; ok {
+		close(c.stop)
+		delete(r.consoles, name)
+
+		logHost.Info("stopping serial console capture", "host", instance.Name)
+	}
+}
+
+// consoleCaptureTarget returns a description of where the console output of
+// a host is being captured to.
+func consoleCaptureTarget(instance *starlingxv1.Host) string {
+	info := instance.Spec.ConsoleCapture
+	if info != nil && info.Destination != nil && *info.Destination == starlingxv1.ConsoleDestinationLog {
+		return "controller log"
+	}
+
+	return fmt.Sprintf("ConfigMap %s", consoleConfigMapName(instance))
+}
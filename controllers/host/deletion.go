@@ -0,0 +1,60 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the reasons reported with the Deleting condition.
+const (
+	DeletingReasonWaitingForStable = "WaitingForStableState"
+	DeletingReasonLocking          = "Locking"
+	DeletingReasonDeleting         = "Deleting"
+	DeletingReasonRetained         = "Retained"
+	DeletingReasonActiveController = "ActiveController"
+)
+
+// deletionPolicy returns the deletion policy of a host.  Hosts without an
+// explicit policy are deleted from the system inventory.
+func deletionPolicy(instance *starlingxv1.Host) string {
+	if instance.Spec.DeletionPolicy != nil {
+		return *instance.Spec.DeletionPolicy
+	}
+
+	return starlingxv1.DeletionPolicyDelete
+}
+
+// setDeletingCondition updates the Deleting condition on the host status so
+// that the progress of the teardown is visible while the finalizer is still
+// present.
+func (r *HostReconciler) setDeletingCondition(instance *starlingxv1.Host, reason, message string) error {
+	existing := meta.FindStatusCondition(instance.Status.Conditions, starlingxv1.ConditionDeleting)
+	if existing != nil && existing.Reason == reason {
+		return nil
+	}
+
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:               starlingxv1.ConditionDeleting,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: instance.Generation,
+	})
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/hostdetails"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// bootTimeTolerance defines how far the boot time derived from the reported
+// uptime may drift before the status is updated.  The uptime is only
+// refreshed periodically by the system therefore small differences are
+// ignored to avoid updating the status on every reconcile.
+const bootTimeTolerance = 5 * time.Minute
+
+// getHostDetails reads the runtime details of a host.  The details are only
+// used to populate the status therefore failures are logged and ignored.
+func getHostDetails(client *gophercloud.ServiceClient, id string) *hostdetails.HostDetails {
+	details, err := hostdetails.Get(client, id).Extract()
+	if err != nil {
+		logHost.Error(err, "failed to get host details", "id", id)
+		return nil
+	}
+
+	return details
+}
+
+// stringsEqual is a utility which compares two optional strings.
+func stringsEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// hostDetailsUpdateRequired records the runtime details of a host in its
+// status and determines whether the status has changed as a result.
+func hostDetailsUpdateRequired(status *starlingxv1.HostStatus, details *hostdetails.HostDetails, now time.Time) (result bool) {
+	if details == nil {
+		return false
+	}
+
+	if !stringsEqual(status.InstallState, details.InstallState) {
+		status.InstallState = details.InstallState
+		result = true
+	}
+
+	if !stringsEqual(status.InstallStateInfo, details.InstallStateInfo) {
+		status.InstallStateInfo = details.InstallStateInfo
+		result = true
+	}
+
+	if !stringsEqual(status.SoftwareVersion, details.SoftwareLoad) {
+		status.SoftwareVersion = details.SoftwareLoad
+		result = true
+	}
+
+	if details.Uptime > 0 {
+		bootTime := now.Add(-time.Duration(details.Uptime) * time.Second).Truncate(time.Second)
+		if status.BootTime == nil || bootTime.Sub(status.BootTime.Time).Abs() > bootTimeTolerance {
+			status.BootTime = &metav1.Time{Time: bootTime}
+			result = true
+		}
+	}
+
+	return result
+}
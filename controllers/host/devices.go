@@ -0,0 +1,309 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/deviceimages"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/devicelabels"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/pcidevices"
+)
+
+// fecDeviceUpdateRequired is a utility function which determines whether an
+// update is required to adjust the configuration of a FEC device.
+func fecDeviceUpdateRequired(info starlingxv1.FECDeviceInfo, device *pcidevices.PCIDevice) (opts pcidevices.PCIDeviceOpts, result bool) {
+	if info.Enabled != nil && *info.Enabled != device.Enabled {
+		opts.Enabled = info.Enabled
+		result = true
+	}
+
+	if info.Driver != nil && (device.Driver == nil || *info.Driver != *device.Driver) {
+		opts.Driver = info.Driver
+		result = true
+	}
+
+	if info.VFCount != nil && (device.SriovNumVFs == nil || *info.VFCount != *device.SriovNumVFs) {
+		opts.SriovNumVFs = info.VFCount
+		result = true
+	}
+
+	if info.VFDriver != nil && (device.SriovVFDriver == nil || *info.VFDriver != *device.SriovVFDriver) {
+		opts.SriovVFDriver = info.VFDriver
+		result = true
+	}
+
+	return opts, result
+}
+
+// ReconcileDeviceLabels is responsible for reconciling the labels assigned to a
+// single PCI device.  Labels that are no longer desired, or that have a
+// different value, are removed before the desired labels are added.
+func (r *HostReconciler) ReconcileDeviceLabels(client *gophercloud.ServiceClient, instance *starlingxv1.Host, labels map[string]string, device *pcidevices.PCIDevice, host *v1info.HostInfo) (bool, error) {
+	updated := false
+
+	for _, l := range host.DeviceLabels {
+		if l.PCIDeviceID != device.ID {
+			continue
+		}
+
+		if value, ok := labels[l.Key]; ok && value == l.Value {
+			continue
+		}
+
+		logHost.Info("deleting device label", "pciaddr", device.PCIAddress, "key", l.Key)
+
+		err := devicelabels.Delete(client, l.ID).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to delete device label: %s", l.ID)
+			return updated, err
+		}
+
+		updated = true
+	}
+
+	current := host.FindDeviceLabels(device.ID)
+	added := make(map[string]string)
+	for key, value := range labels {
+		if v, ok := current[key]; !ok || v != value {
+			added[key] = value
+		}
+	}
+
+	if len(added) > 0 {
+		logHost.Info("adding device labels", "pciaddr", device.PCIAddress, "labels", added)
+
+		err := devicelabels.Create(client, device.ID, added).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to add device labels: %s, %v",
+				device.ID, added)
+			return updated, err
+		}
+
+		updated = true
+	}
+
+	if updated {
+		r.NormalEvent(instance, utils.ResourceUpdated,
+			"labels of device %q have been updated", device.PCIAddress)
+	}
+
+	return updated, nil
+}
+
+// ReconcileFECDevices is responsible for reconciling the FEC accelerator
+// devices of a host resource.
+func (r *HostReconciler) ReconcileFECDevices(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) (bool, error) {
+	updated := false
+
+	for _, info := range profile.Devices.FEC {
+		device, found := host.FindPCIDeviceByAddress(info.PCIAddress)
+		if !found {
+			msg := fmt.Sprintf("unable to find FEC device with PCI address: %s", info.PCIAddress)
+			return updated, starlingxv1.NewMissingSystemResource(msg)
+		}
+
+		if device.ClassID != pcidevices.PCIClassAccelerator {
+			msg := fmt.Sprintf("PCI device %s is not an accelerator device", info.PCIAddress)
+			return updated, utils.NewUserDataError(msg)
+		}
+
+		if info.VFCount != nil && device.SriovTotalVFs != nil && *info.VFCount > *device.SriovTotalVFs {
+			msg := fmt.Sprintf("FEC device %s supports at most %d VFs",
+				info.PCIAddress, *device.SriovTotalVFs)
+			return updated, utils.NewUserDataError(msg)
+		}
+
+		if opts, ok := fecDeviceUpdateRequired(info, device); ok {
+			logHost.Info("updating FEC device", "pciaddr", info.PCIAddress, "opts", opts)
+
+			_, err := pcidevices.Update(client, device.ID, opts).Extract()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to update FEC device: %s, %s",
+					device.ID, utils.FormatStruct(opts))
+				return updated, err
+			}
+
+			r.NormalEvent(instance, utils.ResourceUpdated,
+				"FEC device %q has been updated", info.PCIAddress)
+
+			updated = true
+		}
+
+		if info.Labels != nil {
+			changed, err := r.ReconcileDeviceLabels(client, instance, info.Labels, device, host)
+			if err != nil {
+				return updated, err
+			}
+
+			updated = updated || changed
+		}
+	}
+
+	return updated, nil
+}
+
+// pciDeviceUpdateRequired is a utility function which determines whether an
+// update is required to adjust the configuration of a PCI device.
+func pciDeviceUpdateRequired(info starlingxv1.PCIDeviceInfo, device *pcidevices.PCIDevice) (opts pcidevices.PCIDeviceOpts, result bool) {
+	if info.Enabled != nil && *info.Enabled != device.Enabled {
+		opts.Enabled = info.Enabled
+		result = true
+	}
+
+	if info.Name != nil && *info.Name != device.Name {
+		opts.Name = info.Name
+		result = true
+	}
+
+	return opts, result
+}
+
+// ReconcilePCIDevices is responsible for reconciling the enabled state and
+// name of the PCI devices of a host resource that are exposed through the
+// SR-IOV device plugin or as passthrough devices.
+func (r *HostReconciler) ReconcilePCIDevices(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) (bool, error) {
+	updated := false
+
+	for _, info := range profile.Devices.PCI {
+		found := false
+
+		for i := range host.PCIDevices {
+			device := &host.PCIDevices[i]
+			if device.ClassID == pcidevices.PCIClassAccelerator {
+				continue
+			}
+
+			if !info.Matches(device.PCIAddress, device.VendorID, device.DeviceID) {
+				continue
+			}
+
+			found = true
+
+			if opts, ok := pciDeviceUpdateRequired(info, device); ok {
+				logHost.Info("updating PCI device", "pciaddr", device.PCIAddress, "opts", opts)
+
+				_, err := pcidevices.Update(client, device.ID, opts).Extract()
+				if err != nil {
+					err = perrors.Wrapf(err, "failed to update PCI device: %s, %s",
+						device.ID, utils.FormatStruct(opts))
+					return updated, err
+				}
+
+				r.NormalEvent(instance, utils.ResourceUpdated,
+					"PCI device %q has been updated", device.PCIAddress)
+
+				updated = true
+			}
+		}
+
+		if !found && info.PCIAddress != nil {
+			msg := fmt.Sprintf("unable to find PCI device with PCI address: %s", *info.PCIAddress)
+			return updated, starlingxv1.NewMissingSystemResource(msg)
+		}
+	}
+
+	return updated, nil
+}
+
+// ReconcileDevices is responsible for reconciling the PCI device configuration
+// of a host resource.
+func (r *HostReconciler) ReconcileDevices(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	if profile.Devices == nil || !common.IsReconcilerEnabled(common.Device) {
+		return nil
+	}
+
+	updated, err := r.ReconcileFECDevices(client, instance, profile, host)
+	if err != nil {
+		return err
+	}
+
+	changed, err := r.ReconcilePCIDevices(client, instance, profile, host)
+	if err != nil {
+		return err
+	}
+
+	updated = updated || changed
+
+	if updated {
+		results, err := pcidevices.ListPCIDevices(client, host.ID)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to refresh host PCI device list")
+			return err
+		}
+
+		host.PCIDevices = results
+
+		labels, err := v1info.ListDeviceLabels(client, host.ID)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to refresh device label list")
+			return err
+		}
+
+		host.DeviceLabels = labels
+	}
+
+	return nil
+}
+
+// ReconcileDeviceImages is responsible for writing any device images that
+// have been applied to the devices of a host.  The write is initiated once
+// and the host is then monitored until all writes have finished.
+func (r *HostReconciler) ReconcileDeviceImages(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	if profile.Devices == nil || !common.IsReconcilerEnabled(common.Device) {
+		return nil
+	}
+
+	states, err := deviceimages.ListDeviceImageStates(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list device image states")
+		return err
+	}
+
+	pending := false
+	for _, s := range states {
+		if s.HostID != host.ID {
+			continue
+		}
+
+		switch s.Status {
+		case deviceimages.StatusInProgress:
+			msg := "waiting for device image update to complete"
+			m := NewDeviceImageUpdateMonitor(instance, host.ID)
+			return r.CloudManager.StartMonitor(m, msg)
+
+		case deviceimages.StatusPending:
+			pending = true
+
+		case deviceimages.StatusFailed:
+			r.WarningEvent(instance, utils.ResourceUpdated,
+				"device image %q failed to write to device %q", s.ImageID, s.PCIDeviceID)
+		}
+	}
+
+	if !pending {
+		return nil
+	}
+
+	logHost.Info("starting device image update")
+
+	err = deviceimages.UpdateHost(client, host.ID).ExtractErr()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to start device image update: %s", host.ID)
+		return err
+	}
+
+	r.NormalEvent(instance, utils.ResourceUpdated,
+		"device image update has been started")
+
+	msg := "waiting for device image update to complete"
+	m := NewDeviceImageUpdateMonitor(instance, host.ID)
+	return r.CloudManager.StartMonitor(m, msg)
+}
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package host
 
@@ -8,8 +8,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud"
@@ -23,6 +26,8 @@ import (
 	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/hostdetails"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/redfish"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -30,8 +35,11 @@ import (
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 var logHost = log.Log.WithName("controller").WithName("host")
@@ -68,6 +76,10 @@ var DefaultHostProfile = starlingxv1.HostProfileSpec{
 
 var CephPrimaryGroup []string
 
+// cephPrimaryGroupLock serializes access to CephPrimaryGroup since hosts are
+// reconciled in parallel.
+var cephPrimaryGroupLock sync.Mutex
+
 // Only the listed file systems are allow to create and delete
 var FileSystemCreationAllowed = []string{"instances", "image-conversion"}
 
@@ -81,56 +93,102 @@ type HostReconciler struct {
 	cloudManager.CloudManager
 	common.ReconcilerErrorHandler
 	common.ReconcilerEventLogger
-	hosts []hosts.Host
+	hosts       []hosts.Host
+	hostsLock   sync.RWMutex
+	consoles    map[types.NamespacedName]*consoleCapture
+	consoleLock sync.Mutex
+	retries     map[types.NamespacedName]*retryState
+	retryLock   sync.Mutex
+	audits      map[types.NamespacedName]*auditState
+	auditLock   sync.Mutex
+}
+
+// matchAttribute compares a match attribute against the value reported by a
+// host.  Attributes which are not reported by the host never match.
+func matchAttribute(expected string, actual *string) bool {
+	return actual != nil && strings.EqualFold(expected, *actual)
 }
 
 // hostMatchesCriteria evaluates whether a host matches the criteria specified
 // by the operator.  All match attributes must match for a host to match a
-// profile.
-func hostMatchesCriteria(h hosts.Host, criteria *starlingxv1.MatchInfo) bool {
-	result := true
-	count := 0
-
+// profile unless the "or" operator is specified in which case any single
+// matching attribute is sufficient.  The board management MAC address is not
+// reported with the host therefore it is provided separately.
+func hostMatchesCriteria(h hosts.Host, bmMAC *string, criteria *starlingxv1.MatchInfo) bool {
 	if criteria == nil {
 		return false
 	}
 
+	results := make([]bool, 0)
+
 	if criteria.BootMAC != nil {
-		count++
-		result = result && strings.EqualFold(h.BootMAC, *criteria.BootMAC)
+		results = append(results, matchAttribute(*criteria.BootMAC, &h.BootMAC))
 	}
 
 	if criteria.BoardManagement != nil {
 		bm := criteria.BoardManagement
 		if bm.Address != nil {
-			count++
-			result = result && strings.EqualFold(*bm.Address, *h.BMAddress)
+			results = append(results, matchAttribute(*bm.Address, h.BMAddress))
 		}
 
 		if bm.Type != nil {
-			count++
-			result = result && strings.EqualFold(*bm.Type, *h.BMType)
+			results = append(results, matchAttribute(*bm.Type, h.BMType))
+		}
+
+		if bm.MACAddress != nil {
+			results = append(results, matchAttribute(*bm.MACAddress, bmMAC))
 		}
 	}
 
 	if criteria.DMI != nil {
 		dmi := criteria.DMI
 		if dmi.SerialNumber != nil {
-			count++
-			if h.SerialNumber != nil {
-				result = result && strings.EqualFold(*dmi.SerialNumber, *h.SerialNumber)
-			}
+			results = append(results, matchAttribute(*dmi.SerialNumber, h.SerialNumber))
 		}
 
 		if dmi.AssetTag != nil {
-			count++
-			if h.AssetTag != nil {
-				result = result && strings.EqualFold(*dmi.AssetTag, *h.AssetTag)
-			}
+			results = append(results, matchAttribute(*dmi.AssetTag, h.AssetTag))
+		}
+	}
+
+	if len(results) == 0 {
+		return false
+	}
+
+	anyOf := criteria.Operator != nil && *criteria.Operator == starlingxv1.MatchOperatorOr
+	for _, matched := range results {
+		if anyOf && matched {
+			return true
+		} else if !anyOf && !matched {
+			return false
+		}
+	}
+
+	return !anyOf
+}
+
+// boardManagementMACs returns the board management MAC address of each host
+// keyed by host ID.  The addresses are not reported with the host therefore
+// they are only read when required by the match criteria.
+func boardManagementMACs(client *gophercloud.ServiceClient, match *starlingxv1.MatchInfo) (map[string]string, error) {
+	if match == nil || match.BoardManagement == nil || match.BoardManagement.MACAddress == nil {
+		return nil, nil
+	}
+
+	objects, err := hostdetails.ListHostDetails(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list host details")
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	for _, d := range objects {
+		if d.BMMAC != nil {
+			result[d.ID] = *d.BMMAC
 		}
 	}
 
-	return result && count > 0
+	return result, nil
 }
 
 // Defines the keys used to access BM credential information stored in a secret.
@@ -139,24 +197,30 @@ const (
 	passwordKey = "password"
 )
 
-// getBMPasswordCredentials is a utility to retrieve the host's board management
-// credentials from the information stored in the specified secret.
-func (r *HostReconciler) getBMPasswordCredentials(namespace string, name string) (username, password string, err error) {
+// getBMSecret is a utility to retrieve the secret which holds the host's
+// board management credentials.
+func (r *HostReconciler) getBMSecret(namespace string, name string) (*v1.Secret, error) {
 	secret := &v1.Secret{}
 	secretName := types.NamespacedName{Namespace: namespace, Name: name}
 
 	// Lookup the secret via the system client.
-	err = r.Client.Get(context.TODO(), secretName, secret)
+	err := r.Client.Get(context.TODO(), secretName, secret)
 	if err != nil {
 		if !errors.IsNotFound(err) {
 			err = perrors.Wrap(err, "failed to get host BM secret")
 		}
-		return "", "", err
+		return nil, err
 	}
 
+	return secret, nil
+}
+
+// parseBMPasswordCredentials is a utility to extract the host's board
+// management credentials from the information stored in a secret.
+func parseBMPasswordCredentials(secret *v1.Secret) (username, password string, err error) {
 	// Make sure that required keys are present.
 	for _, key := range []string{usernameKey, passwordKey} {
-		if _, ok := secret.Data[usernameKey]; !ok {
+		if _, ok := secret.Data[key]; !ok {
 			msg := fmt.Sprintf("missing %q key within BM credential secret", key)
 			return "", "", common.NewUserDataError(msg)
 		}
@@ -165,6 +229,80 @@ func (r *HostReconciler) getBMPasswordCredentials(namespace string, name string)
 	return string(secret.Data[usernameKey]), string(secret.Data[passwordKey]), nil
 }
 
+// getBMPasswordCredentials is a utility to retrieve the host's board management
+// credentials from the information stored in the specified secret.
+func (r *HostReconciler) getBMPasswordCredentials(namespace string, name string) (username, password string, err error) {
+	secret, err := r.getBMSecret(namespace, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	return parseBMPasswordCredentials(secret)
+}
+
+// BMTypeRedfish defines the board management type required to install a host
+// from virtual media.
+const BMTypeRedfish = "redfish"
+
+// validateVirtualMediaInfo is a utility which ensures that the board
+// management attributes required to install a host from virtual media are
+// present before the host is created.
+func validateVirtualMediaInfo(profile *starlingxv1.HostProfileSpec) error {
+	bm := profile.BoardManagement
+	if bm == nil || bm.Type == nil || !strings.EqualFold(*bm.Type, BMTypeRedfish) {
+		msg := "virtual media installation requires a 'redfish' board management type"
+		return common.NewUserDataError(msg)
+	}
+
+	if bm.Address == nil {
+		msg := "virtual media installation requires a board management address"
+		return common.NewUserDataError(msg)
+	}
+
+	if bm.Credentials == nil || bm.Credentials.Password == nil {
+		msg := "virtual media installation requires board management credentials"
+		return common.NewUserDataError(msg)
+	}
+
+	return nil
+}
+
+// BootFromVirtualMedia is responsible for installing a newly created host
+// through its Redfish board management controller.  The install image is
+// mounted as a virtual CD, the host is set to boot from it once, and the host
+// is then power-cycled.
+func (r *HostReconciler) BootFromVirtualMedia(instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec) error {
+	media := instance.Spec.VirtualMedia
+	bm := profile.BoardManagement
+
+	username, password, err := r.getBMPasswordCredentials(instance.Namespace, bm.Credentials.Password.Secret)
+	if err != nil {
+		return err
+	}
+
+	insecure := media.Insecure != nil && *media.Insecure
+	client := redfish.NewClient(*bm.Address, username, password, insecure)
+
+	logHost.Info("booting host from virtual media", "address", *bm.Address, "image", media.Image)
+
+	err = client.BootFromVirtualMedia(media.Image)
+	if err != nil {
+		if _, ok := err.(redfish.ErrUnauthorized); ok {
+			msg := fmt.Sprintf("BMC rejected the credentials in secret %q: %s",
+				bm.Credentials.Password.Secret, err.Error())
+			return common.NewUserDataError(msg)
+		}
+
+		err = perrors.Wrapf(err, "failed to boot host from virtual media: %s", media.Image)
+		return err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"host is booting from virtual media image %q", media.Image)
+
+	return nil
+}
+
 // buildInitialHostOpts is a utility to assemble the options required to
 // provision a host that needs to be statically provisioned.  Further
 // provisioning of other host attributes will be handled at a later stage.
@@ -190,7 +328,7 @@ func provisioningAllowed(objects []hosts.Host) bool {
 // configuring new hosts.  The primary controller must be enabled for these
 // actions to be allowed.
 func (r *HostReconciler) ProvisioningAllowed() bool {
-	return provisioningAllowed(r.hosts)
+	return provisioningAllowed(r.getHosts())
 }
 
 func MonitorsEnabled(objects []hosts.Host, required int) bool {
@@ -210,7 +348,7 @@ func MonitorsEnabled(objects []hosts.Host, required int) bool {
 // enabled or not. Provisioning certain storage resources requires that a
 // certain number of monitors be enabled.
 func (r *HostReconciler) MonitorsEnabled(required int) bool {
-	return MonitorsEnabled(r.hosts, required)
+	return MonitorsEnabled(r.getHosts(), required)
 }
 
 func AllControllerNodesEnabled(objects []hosts.Host, required int) bool {
@@ -231,7 +369,7 @@ func AllControllerNodesEnabled(objects []hosts.Host, required int) bool {
 // nodes to be unlocked.  To avoid issues with provisioning storage resources
 // we need to wait for both controllers to be unlocked/enabled.
 func (r *HostReconciler) AllControllerNodesEnabled(required int) bool {
-	return AllControllerNodesEnabled(r.hosts, required)
+	return AllControllerNodesEnabled(r.getHosts(), required)
 }
 
 // UpdateRequired determines if any of the configured attributes mismatch with
@@ -357,7 +495,9 @@ func (r *HostReconciler) UpdateRequired(instance *starlingxv1.Host, profile *sta
 		}
 	}
 
-	if profile.ClockSynchronization != nil {
+	if profile.ClockSynchronization != nil && h.Hostname == "" {
+		// Changes to the clock synchronization of a provisioned host must be
+		// sequenced with its PTP instances; see ReconcileClockSynchronization.
 		if h.ClockSynchronization == nil || *profile.ClockSynchronization != *h.ClockSynchronization {
 			result = true
 			opts.ClockSynchronization = profile.ClockSynchronization
@@ -436,68 +576,99 @@ func findPTPInstanceByName(client *gophercloud.ServiceClient, name string) (*ptp
 	return nil, nil
 }
 
-// ReconcilePTPInstances is responsible for reconciling the PTP instances
-// associated with each host.
-func (r *HostReconciler) ReconcilePTPInstances(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
-	updated := false
-
-	// Remove any stale PTP instances
-	for _, existing := range host.PTPInstances {
+// ptpInstanceDelta compares the PTP instances configured for a host with
+// those currently assigned to it and returns the names of the instances that
+// must be assigned and the assigned instances that must be removed.
+func ptpInstanceDelta(configured starlingxv1.PtpInstanceItemList, existing []ptpinstances.PTPInstance) (added []string, removed []ptpinstances.PTPInstance) {
+	for _, e := range existing {
 		found := false
-		for _, configured := range profile.PtpInstances {
-			if string(configured) == existing.Name {
+		for _, c := range configured {
+			if string(c) == e.Name {
 				found = true
 				break
 			}
 		}
 
 		if !found {
-			logHost.Info("removing PTP instance", "PTP instance", existing)
-
-			opt := ptpinstances.PTPInstToHostOpts{
-				PTPInstanceID: &existing.ID,
-			}
-			_, err := ptpinstances.RemovePTPInstanceFromHost(client, host.ID, opt).Extract()
-			if err != nil {
-				err = perrors.Wrapf(err, "failed to remove PTP instance from host: %s", host.ID)
-				return err
-			}
-			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
-				"ptp instance %s removed from host", existing.Name)
-			updated = true
+			removed = append(removed, e)
 		}
 	}
 
-	for _, configured := range profile.PtpInstances {
+	for _, c := range configured {
 		found := false
-		for _, result := range host.PTPInstances {
-			if string(configured) == result.Name {
+		for _, e := range existing {
+			if string(c) == e.Name {
 				found = true
 				break
 			}
 		}
 
 		if !found {
-			result, err := findPTPInstanceByName(client, string(configured))
-			if err != nil {
-				err = perrors.Wrapf(err, "failed to find PTP instance for host: %s", host.ID)
-				return err
-			} else if result == nil {
-				return common.NewResourceStatusDependency("PTP instance is not created, waiting for the creation")
-			}
+			added = append(added, string(c))
+		}
+	}
 
-			opt2 := ptpinstances.PTPInstToHostOpts{
-				PTPInstanceID: &result.ID,
-			}
-			_, err = ptpinstances.AddPTPInstanceToHost(client, host.ID, opt2).Extract()
-			if err != nil {
-				err = perrors.Wrapf(err, "failed to add PTP instance to host: %s", host.ID)
-				return err
+	return added, removed
+}
+
+// ReconcilePTPInstances is responsible for reconciling the PTP instances
+// associated with each host.  Instances that have been removed from the
+// configuration are unassigned from the host and the PTP configuration is
+// re-applied whenever the set of assigned instances changes so that the PTP
+// services running on the host match the configuration.
+func (r *HostReconciler) ReconcilePTPInstances(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	updated := false
+	added, removed := ptpInstanceDelta(profile.PtpInstances, host.PTPInstances)
+	remaining := len(host.PTPInstances)
+
+	// Remove any stale PTP instances
+	for _, existing := range removed {
+		if !ptpInstanceRemovalAllowed(host, remaining-1) {
+			if profile.ClockSynchronization == nil || *profile.ClockSynchronization == starlingxv1.ClockSynchronizationPTP {
+				msg := "a host using ptp clock synchronization must be assigned at least one PTP instance"
+				return common.NewUserDataError(msg)
 			}
-			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
-				"ptp instance %s added to host", configured)
-			updated = true
+
+			logHost.Info("keeping PTP instance until clock synchronization is switched", "PTP instance", existing.Name)
+			continue
+		}
+
+		logHost.Info("removing PTP instance", "PTP instance", existing)
+
+		opt := ptpinstances.PTPInstToHostOpts{
+			PTPInstanceID: &existing.ID,
 		}
+		_, err := ptpinstances.RemovePTPInstanceFromHost(client, host.ID, opt).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to remove PTP instance from host: %s", host.ID)
+			return err
+		}
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"ptp instance %s removed from host", existing.Name)
+		remaining--
+		updated = true
+	}
+
+	for _, configured := range added {
+		result, err := findPTPInstanceByName(client, configured)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to find PTP instance for host: %s", host.ID)
+			return err
+		} else if result == nil {
+			return common.NewResourceStatusDependency("PTP instance is not created, waiting for the creation")
+		}
+
+		opt2 := ptpinstances.PTPInstToHostOpts{
+			PTPInstanceID: &result.ID,
+		}
+		_, err = ptpinstances.AddPTPInstanceToHost(client, host.ID, opt2).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to add PTP instance to host: %s", host.ID)
+			return err
+		}
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"ptp instance %s added to host", configured)
+		updated = true
 	}
 
 	if updated {
@@ -508,39 +679,117 @@ func (r *HostReconciler) ReconcilePTPInstances(client *gophercloud.ServiceClient
 		}
 
 		host.PTPInstances = results
+
+		logHost.Info("applying ptp configuration")
+
+		err = ptpinstances.Apply(client, ptpinstances.PTPInstanceOpts{}).Err
+		if err != nil {
+			err = perrors.Wrap(err, "failed to apply ptp configuration")
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"ptp configuration has been applied")
+	}
+
+	return nil
+}
+
+// updateManagedLabels persists the list of labels owned by the Deployment
+// Manager to the host status.
+func (r *HostReconciler) updateManagedLabels(instance *starlingxv1.Host) error {
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to update managed labels")
+		return err
 	}
 
 	return nil
 }
 
-// ReconcileAttributes is responsible for reconciling the labels on each host.
+// removeDefaultLabels removes any labels owned by the Deployment Manager from
+// the set of default attributes.  Defaults may be re-collected after labels
+// have already been applied and those labels must not be treated as platform
+// defaults otherwise they would never be removed.
+func removeDefaultLabels(instance *starlingxv1.Host, defaults *starlingxv1.HostProfileSpec) {
+	if len(defaults.Labels) == 0 || len(instance.Status.ManagedLabels) == 0 {
+		return
+	}
+
+	result := make(map[string]string)
+	for key, value := range defaults.Labels {
+		if !utils.ContainsString(instance.Status.ManagedLabels, key) {
+			result[key] = value
+		}
+	}
+
+	if len(result) == 0 {
+		result = nil
+	}
+
+	defaults.Labels = result
+}
+
+// removeForeignLabels removes the labels applied by other actors from the
+// current configuration.  Those labels are never removed from the host
+// therefore they must not cause the host to appear out of sync.
+func removeForeignLabels(instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, current *starlingxv1.HostProfileSpec) {
+	if len(current.Labels) == 0 {
+		return
+	}
+
+	result := make(map[string]string)
+	for key, value := range current.Labels {
+		if _, ok := profile.Labels[key]; ok || utils.ContainsString(instance.Status.ManagedLabels, key) {
+			result[key] = value
+		}
+	}
+
+	if len(result) == 0 {
+		result = nil
+	}
+
+	current.Labels = result
+}
+
+// ReconcileLabels is responsible for reconciling the labels on each host.
+// Labels which are no longer part of the profile are only removed if they
+// were applied by the Deployment Manager; labels applied by other actors are
+// left untouched unless the profile sets them to a different value.
 func (r *HostReconciler) ReconcileLabels(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
 	updated := false
+	managed := false
 
 	// Remove any stale or modified labels
 	for _, label := range host.Labels {
-		found := false
-		for key, value := range profile.Labels {
-			if label.Key == key && value == label.Value {
-				found = true
-				break
-			}
+		value, desired := profile.Labels[label.Key]
+		if desired && value == label.Value {
+			continue
 		}
 
-		if !found {
-			logHost.Info("removing label", "label", label)
+		owned := utils.ContainsString(instance.Status.ManagedLabels, label.Key)
+		if !desired && !owned {
+			// The label was applied by another actor.
+			continue
+		}
 
-			err := labels.Delete(client, label.ID).ExtractErr()
-			if err != nil {
-				err = perrors.Wrapf(err, "failed to remove label %s", label.ID)
-				return err
-			}
+		logHost.Info("removing label", "label", label)
 
-			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
-				"label %q removed", label.Key)
+		err := labels.Delete(client, label.ID).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to remove label %s", label.ID)
+			return err
+		}
 
-			updated = true
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"label %q removed", label.Key)
+
+		if !desired {
+			instance.Status.ManagedLabels = utils.RemoveString(instance.Status.ManagedLabels, label.Key)
+			managed = true
 		}
+
+		updated = true
 	}
 
 	// Add missing labels
@@ -564,6 +813,14 @@ func (r *HostReconciler) ReconcileLabels(client *gophercloud.ServiceClient, inst
 		for k := range request {
 			keys = append(keys, k)
 		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			if !utils.ContainsString(instance.Status.ManagedLabels, k) {
+				instance.Status.ManagedLabels = append(instance.Status.ManagedLabels, k)
+				managed = true
+			}
+		}
 
 		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
 			"labels %q added", strings.Join(keys, ","))
@@ -571,6 +828,13 @@ func (r *HostReconciler) ReconcileLabels(client *gophercloud.ServiceClient, inst
 		updated = true
 	}
 
+	if managed {
+		err := r.updateManagedLabels(instance)
+		if err != nil {
+			return err
+		}
+	}
+
 	if updated {
 		result, err := labels.ListLabels(client, host.ID)
 		if err != nil {
@@ -655,6 +919,16 @@ func (r *HostReconciler) ReconcileInitialState(client *gophercloud.ServiceClient
 	if desiredState != nil && *desiredState != host.AdministrativeState &&
 		instance.Status.DeploymentScope == cloudManager.ScopeBootstrap {
 		if *desiredState == hosts.AdminLocked {
+			err := r.ReconcilePreflight(instance, hosts.ActionLock)
+			if err != nil {
+				return err
+			}
+
+			err = r.ReconcileSwact(client, instance, &host.Host)
+			if err != nil {
+				return err
+			}
+
 			action := hosts.ActionLock
 			opts := hosts.HostOpts{
 				Action: &action,
@@ -714,24 +988,24 @@ func (r *HostReconciler) ReconcileFinalState(client *gophercloud.ServiceClient,
 		}
 	}
 
-	action := hosts.ActionUnlock
-	opts := hosts.HostOpts{
-		Action: &action,
+	// The phases of the bootstrap sequence may be gated on health checks
+	// configured on the system rather than only on the state of the hosts.
+	err := r.ReconcileBootstrapHealthCheck(instance, profile)
+	if err != nil {
+		return err
 	}
 
-	logHost.Info("unlocking host", "opts", opts)
-
-	result, err := hosts.Update(client, host.ID, opts).Extract()
-	if err != nil || result == nil {
-		err = perrors.Wrapf(err, "failed to unlock host: %s, %s",
-			host.ID, common.FormatStruct(opts))
+	// Controller-0 is brought to the configured patch level before its
+	// initial unlock.
+	err = r.ReconcileSoftwarePatches(instance)
+	if err != nil {
 		return err
 	}
 
-	host.Host = *result
-
-	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
-		"host has been unlocked")
+	err = r.ReconcileUnlock(client, instance, &host.Host)
+	if err != nil {
+		return err
+	}
 
 	// Return a retry result here because we know that it won't be possible to
 	// make any other changes until this change is complete.
@@ -760,6 +1034,13 @@ func (r *HostReconciler) ReconcileEnabledHost(client *gophercloud.ServiceClient,
 		}
 	}
 
+	// PTP instance assignments can be changed on an unlocked host since
+	// the configuration is re-applied to the running services.
+	err = r.ReconcilePTPInstances(client, instance, profile, host)
+	if err != nil {
+		return err
+	}
+
 	// Update/Add routes
 	err = r.ReconcileRoutes(client, instance, profile, host)
 	if err != nil {
@@ -771,6 +1052,11 @@ func (r *HostReconciler) ReconcileEnabledHost(client *gophercloud.ServiceClient,
 		return err
 	}
 
+	err = r.ReconcileSensorGroups(client, instance, profile, host)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -793,6 +1079,11 @@ func (r *HostReconciler) ReconcileDisabledHost(client *gophercloud.ServiceClient
 		return err
 	}
 
+	err = r.ReconcileClockSynchronization(client, instance, profile, host)
+	if err != nil {
+		return err
+	}
+
 	if profile.HasWorkerSubFunction() {
 		// The system API only supports setting these attributes on nodes
 		// that support the compute subfunction.
@@ -811,6 +1102,11 @@ func (r *HostReconciler) ReconcileDisabledHost(client *gophercloud.ServiceClient
 		if err != nil {
 			return err
 		}
+
+		err = r.ReconcileDevices(client, instance, profile, host)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = r.ReconcileNetworking(client, instance, profile, host)
@@ -973,6 +1269,12 @@ func (r *HostReconciler) CompareEnabledAttributes(in *starlingxv1.HostProfileSpe
 		}
 	}
 
+	if utils.IsReconcilerEnabled(utils.SensorGroup) && in.SensorGroups != nil {
+		if !in.SensorGroups.DeepEqual(&other.SensorGroups) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1020,6 +1322,16 @@ func (r *HostReconciler) CompareDisabledAttributes(in *starlingxv1.HostProfileSp
 		}
 	}
 
+	if utils.IsReconcilerEnabled(utils.Device) {
+		if (in.Devices == nil) != (other.Devices == nil) {
+			return false
+		} else if in.Devices != nil {
+			if !in.Devices.DeepEqual(other.Devices) {
+				return false
+			}
+		}
+	}
+
 	if utils.IsReconcilerEnabled(utils.Networking) {
 		if utils.IsReconcilerEnabled(utils.Interface) {
 			if (in.Interfaces == nil) != (other.Interfaces == nil) {
@@ -1084,6 +1396,11 @@ func (r *HostReconciler) ReconcileHostByState(client *gophercloud.ServiceClient,
 		strategy_required = true
 	}
 
+	err := r.ReconcileMemoryPendingReboot(client, instance, profile, current, host)
+	if err != nil {
+		return err
+	}
+
 	if host.IsUnlockedEnabled() {
 		if !r.CompareEnabledAttributes(profile, current, instance, host.Personality) {
 			err := r.ReconcileEnabledHost(client, instance, profile, host)
@@ -1285,6 +1602,47 @@ func containsAdminPlatformNetwork(platformNetworks starlingxv1.PlatformNetworkIt
 	return false
 }
 
+// hardwareStatusUpdateRequired is a utility function which determines whether
+// the hardware summary of the host status needs to be updated to reflect the
+// inventory reported by the system.
+func hardwareStatusUpdateRequired(instance *starlingxv1.Host, hostInfo *v1info.HostInfo) bool {
+	if !hostInfo.IsInventoryCollected() {
+		return false
+	}
+
+	hardware := starlingxv1.NewHostHardwareStatus(*hostInfo)
+	if instance.Status.Hardware != nil && instance.Status.Hardware.DeepEqual(hardware) {
+		return false
+	}
+
+	instance.Status.Hardware = hardware
+
+	return true
+}
+
+// updateInventoryStatus is responsible for recording the kernel and hardware
+// inventory reported by the system in the host status so that it can be
+// audited without accessing the system directly.
+func (r *HostReconciler) updateInventoryStatus(instance *starlingxv1.Host, hostInfo *v1info.HostInfo) error {
+	kernelUpdated := kernelStatusUpdateRequired(instance, &hostInfo.Kernel)
+	hardwareUpdated := hardwareStatusUpdateRequired(instance, hostInfo)
+	if !kernelUpdated && !hardwareUpdated {
+		return nil
+	}
+
+	logHost.V(2).Info("updating inventory status", "kernel", instance.Status.Kernel,
+		"hardware", instance.Status.Hardware)
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update inventory status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
 // statusUpdateRequired is a utility function which determines whether an update
 // is required to the host status attribute.  Updating this unnecessarily
 // will result in an infinite reconciliation loop.
@@ -1295,8 +1653,12 @@ func (r *HostReconciler) statusUpdateRequired(instance *starlingxv1.Host, host *
 		status.ID = &host.ID
 		// If the ID is being set or changed then make sure the defaults are
 		// reset back to nil so that the host is re-inventoried before being
-		// configured.
+		// configured.  Any addresses, labels or taints recorded against a
+		// different host are no longer relevant.
 		status.Defaults = nil
+		status.ManagedAddresses = nil
+		status.ManagedLabels = nil
+		status.ManagedTaints = nil
 		result = true
 	}
 
@@ -1315,6 +1677,23 @@ func (r *HostReconciler) statusUpdateRequired(instance *starlingxv1.Host, host *
 		result = true
 	}
 
+	powerState := starlingxv1.PowerStateOff
+	if starlingxv1.IsHostPoweredOn(*host) {
+		powerState = starlingxv1.PowerStateOn
+	}
+
+	if status.PowerState == nil || *status.PowerState != powerState {
+		status.PowerState = &powerState
+		result = true
+	}
+
+	if status.UnlockAttempts != 0 && host.AdministrativeState == hosts.AdminUnlocked {
+		// The host has been unlocked so any previous failures are no longer
+		// relevant.
+		status.UnlockAttempts = 0
+		result = true
+	}
+
 	if status.InSync != inSync {
 		status.InSync = inSync
 		result = true
@@ -1363,14 +1742,19 @@ func (r *HostReconciler) statusUpdateRequired(instance *starlingxv1.Host, host *
 
 // findExistingHost searches the current list of hosts and attempts to find one
 // that fits the provided match criteria.
-func FindExistingHost(objects []hosts.Host, hostname string, match *starlingxv1.MatchInfo, bootMAC *string) *hosts.Host {
+func FindExistingHost(objects []hosts.Host, bmMACs map[string]string, hostname string, match *starlingxv1.MatchInfo, bootMAC *string) *hosts.Host {
 	for _, host := range objects {
+		var bmMAC *string
+		if mac, ok := bmMACs[host.ID]; ok {
+			bmMAC = &mac
+		}
+
 		if host.Hostname != "" && host.Hostname == hostname {
 			// Forgo the match criteria if the hostname is a match.
 			return &host
 		}
 
-		if hostMatchesCriteria(host, match) {
+		if hostMatchesCriteria(host, bmMAC, match) {
 			// The host satisfies the match criteria, but as an additional
 			// sanity check of the data we need to make sure that the
 			// hostname matches as well.  This is to help avoid typos that
@@ -1398,7 +1782,12 @@ func FindExistingHost(objects []hosts.Host, hostname string, match *starlingxv1.
 // new host is created then the 'host' return parameter will be updated with a
 // pointer to the new host object.
 func (r *HostReconciler) ReconcileNewHost(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec) (host *hosts.Host, err error) {
-	host = FindExistingHost(r.hosts, instance.Name, instance.Spec.Match, profile.BootMAC)
+	bmMACs, err := boardManagementMACs(client, instance.Spec.Match)
+	if err != nil {
+		return nil, err
+	}
+
+	host = FindExistingHost(r.getHosts(), bmMACs, instance.Name, instance.Spec.Match, profile.BootMAC)
 	if host != nil {
 		logHost.Info("found matching host", "id", host.ID)
 	}
@@ -1432,6 +1821,13 @@ func (r *HostReconciler) ReconcileNewHost(client *gophercloud.ServiceClient, ins
 				return nil, err // Already logged
 			}
 
+			if instance.Spec.VirtualMedia != nil {
+				err = validateVirtualMediaInfo(profile)
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			logHost.Info("creating host", "opts", opts)
 
 			host, err = hosts.Create(client, opts).Extract()
@@ -1441,7 +1837,17 @@ func (r *HostReconciler) ReconcileNewHost(client *gophercloud.ServiceClient, ins
 				return nil, err
 			}
 
-			if profile.BoardManagement != nil && (profile.PowerOn != nil && *profile.PowerOn) {
+			if instance.Spec.VirtualMedia != nil {
+				// The host is powered-on as part of the virtual media boot
+				// sequence so the explicit power-on below is not required.
+				err = r.BootFromVirtualMedia(instance, profile)
+				if err != nil {
+					return nil, err
+				}
+
+				r.StartConsoleCapture(instance, profile)
+
+			} else if profile.BoardManagement != nil && (profile.PowerOn != nil && *profile.PowerOn) {
 				// Attempt to power-on the host; otherwise the user will need
 				// to do this manually.
 				action := hosts.ActionReinstall
@@ -1451,6 +1857,8 @@ func (r *HostReconciler) ReconcileNewHost(client *gophercloud.ServiceClient, ins
 					err = perrors.Wrapf(err, "failed to power-on host")
 					return nil, err
 				}
+
+				r.StartConsoleCapture(instance, profile)
 			}
 
 			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
@@ -1496,6 +1904,17 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 	var defaults *starlingxv1.HostProfileSpec
 	var current *starlingxv1.HostProfileSpec
 
+	err := r.ReconcileReinstall(client, instance, profile, host)
+	if err != nil {
+		return err
+	}
+
+	if host.AvailabilityStatus != hosts.AvailOffline {
+		// The host has finished installing therefore its console no longer
+		// needs to be captured.
+		r.StopConsoleCapture(instance)
+	}
+
 	if !host.Stable() {
 		msg := "waiting for a stable state for existing host"
 		m := NewStableHostMonitor(instance, host.ID)
@@ -1504,8 +1923,23 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 
 	// Gather all host attributes so that they can be reused by various
 	// functions without needing to be re-queried each time.
-	hostInfo := v1info.HostInfo{}
-	err := hostInfo.PopulateHostInfo(client, host.ID)
+	info, err := r.CloudManager.GetHostInfo(instance.Namespace, client, host)
+	if err != nil {
+		return err
+	}
+	hostInfo := *info
+
+	err = r.updateInventoryStatus(instance, &hostInfo)
+	if err != nil {
+		return err
+	}
+
+	err = r.updateClockSyncStatus(instance, hostInfo.PTPInstances)
+	if err != nil {
+		return err
+	}
+
+	err = r.ReconcileProfileSnapshot(instance, &hostInfo)
 	if err != nil {
 		return err
 	}
@@ -1570,6 +2004,10 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 	}
 	defaults.BoardManagement = &bmInfo
 
+	// Keep the attributes declared by the user so that those inherited from
+	// the defaults can be told apart once merged.
+	declared := profile.DeepCopy()
+
 	// Create a new composite profile that is backed by the host's default
 	// configuration.  This will ensure that if a user deletes an optional
 	// attribute that we will know how to restore the original value.
@@ -1582,9 +2020,20 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 	// As the Merge Profiles will overwrite some formate in the default profile
 	// parsed in the constructor, move this process after it.
 	FixProfileAttributes(defaults, profile, current, &hostInfo)
+	FixInterfaceClassNetworks(profile, declared)
 
 	FillEmptyUuidbyName(defaults, current)
 
+	// Replaced NICs may be reported with a different port name so follow the
+	// port by its PCI address.
+	for _, repair := range SyncPortNameByPCIAddress(profile, current) {
+		if repair.Pending {
+			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+				"ethernet interface %q port %q has been replaced by port %q at PCI address %s",
+				repair.Interface, repair.OldPort, repair.NewPort, repair.PCIAddress)
+		}
+	}
+
 	// TODO(alegacy): Need to move ProvisioningMode out of the profile or
 	//  find a way to populate it into profiles generated from the running
 	//  configuration.
@@ -1596,10 +2045,71 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 		SyncIFNameByUuid(profile, current)
 	}
 
+	// BM credentials may be rotated at any time by updating the referenced
+	// secret so re-apply them regardless of the profile state.
+	err = r.ReconcileBMCredentials(client, instance, profile, host)
+	if err != nil {
+		return err
+	}
+
+	// Device images may be applied to this host's devices at any time so
+	// check for pending writes regardless of the profile state.
+	if host.IsUnlockedEnabled() {
+		err = r.ReconcileDeviceImages(client, instance, profile, &hostInfo)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Node taints can also be changed at any time so they are applied
+	// directly and the resulting taints are used as the current state.
+	taints, err := r.ReconcileTaints(instance, profile, &hostInfo)
+	if err != nil {
+		return err
+	}
+
+	if current != nil {
+		current.Taints = taints
+	}
+
+	// Configuration areas kept in the bootstrap scope are not changed by
+	// day-2 operations.
+	if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
+		locked := lockBootstrapSubsections(profile, current, instance.Status.SubsectionScopes)
+		if len(locked) > 0 {
+			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+				"ignoring changes to %s since they are in the bootstrap scope",
+				strings.Join(locked, ", "))
+		}
+	}
+
+	// Attributes managed outside of the Deployment Manager are aligned with
+	// the current configuration so that they never appear out of sync.
+	if len(profile.IgnoreFields) > 0 && current != nil {
+		err = utils.IgnoreFields(profile, current, profile.IgnoreFields)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to apply ignored fields")
+			return err
+		}
+	}
+
+	// Labels applied by other actors are left in place so they are not
+	// compared against the profile.
+	if current != nil {
+		removeForeignLabels(instance, profile, current)
+	}
+
+	// TLS verification of the board management controller is a setting of
+	// the deployment manager which the system never reports.
+	if current != nil && current.BoardManagement != nil && profile.BoardManagement != nil {
+		current.BoardManagement.Insecure = profile.BoardManagement.Insecure
+	}
+
 	inSync := r.CompareAttributes(profile, current, instance, host.Personality)
 	if inSync {
 		logHost.V(2).Info("no changes between composite profile and current configuration")
 		instance.Status.Delta = ""
+		instance.Status.DeltaAttributes = nil
 		return nil
 	}
 
@@ -1614,17 +2124,33 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 		logHost.Info(fmt.Sprintf("failed to get Delta status:  %s\n", err))
 	}
 
-	if deltaString != "" {
+	deltaAttributes, err := common.GetDeltaAttributes(profile, current)
+	if err != nil {
+		logHost.Info(fmt.Sprintf("failed to get delta attributes:  %s\n", err))
+	}
+
+	if deltaString != "" || len(deltaAttributes) > 0 {
 		logHost.V(2).Info(fmt.Sprintf("delta configuration:%s\n", deltaString))
 		instance.Status.Delta = deltaString
+		instance.Status.DeltaAttributes = deltaAttributes
 
 		err = r.Client.Status().Update(context.TODO(), instance)
 		if err != nil {
 			logHost.Info(fmt.Sprintf("failed to update status: %s", err))
 		}
+
+		if instance.Status.Reconciled && r.auditInProgress(instance) {
+			cloudManager.RecordDrift(instance.Namespace, starlingxv1.KindHost)
+		}
 	}
 
-	if instance.Status.Reconciled && r.StopAfterInSync() {
+	if instance.Status.Reconciled && r.StopAfterInSync() &&
+		autoRemediationEnabled(instance) && r.auditInProgress(instance) {
+		// Drift introduced by changes made directly on the system is
+		// corrected since the configuration itself has not changed.
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"remediating configuration drift: %s", deltaString)
+	} else if instance.Status.Reconciled && r.StopAfterInSync() {
 		if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
 			if !host.IsUnlockedAvailable() {
 				msg := "waiting for the host reach available state"
@@ -1652,24 +2178,40 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 	return nil
 }
 
-// ReconcileDeletedHost is responsible for dealing with the provisioning of an
-// existing host.
+// ReconcileDeletedHost is responsible for removing a host from the system
+// inventory once its resource has been deleted.  The host is locked before it
+// is deleted and the progress is reported through the Deleting condition.
 func (r *HostReconciler) ReconcileDeletedHost(client *gophercloud.ServiceClient, instance *starlingxv1.Host, host *hosts.Host) (err error) {
+	r.StopConsoleCapture(instance)
+
 	if host.Capabilities.Personality != nil {
 		if strings.EqualFold(*host.Capabilities.Personality, hosts.ActiveController) {
 			// Always leave the active controller installed.
 			logHost.Info("skipping delete for active controller")
-			return nil
+			return r.setDeletingCondition(instance, DeletingReasonActiveController,
+				"the active controller is never deleted from the system inventory")
 		}
 	}
 
 	if !host.Stable() {
+		err = r.setDeletingCondition(instance, DeletingReasonWaitingForStable,
+			"waiting for a stable state before deleting host")
+		if err != nil {
+			return err
+		}
+
 		msg := "waiting for a stable state before deleting host"
 		m := NewStableHostMonitor(instance, host.ID)
 		return r.CloudManager.StartMonitor(m, msg)
 	}
 
 	if !host.IsLockedDisabled() {
+		err = r.setDeletingCondition(instance, DeletingReasonLocking,
+			"locking host before deleting it")
+		if err != nil {
+			return err
+		}
+
 		action := hosts.ActionLock
 		opts := hosts.HostOpts{Action: &action}
 
@@ -1692,6 +2234,12 @@ func (r *HostReconciler) ReconcileDeletedHost(client *gophercloud.ServiceClient,
 		return r.CloudManager.StartMonitor(m, msg)
 	}
 
+	err = r.setDeletingCondition(instance, DeletingReasonDeleting,
+		"deleting host from the system inventory")
+	if err != nil {
+		return err
+	}
+
 	logHost.Info("deleting host")
 
 	err = hosts.Delete(client, host.ID).ExtractErr()
@@ -1740,7 +2288,18 @@ func (r *HostReconciler) ReconcileResource(
 		if utils.ContainsString(instance.ObjectMeta.Finalizers, HostFinalizerName) {
 			// A finalizer is still present so we need to try to delete the
 			// host from the system.
-			if host != nil {
+			if host != nil && deletionPolicy(instance) == starlingxv1.DeletionPolicyRetain {
+				r.StopConsoleCapture(instance)
+
+				err = r.setDeletingCondition(instance, DeletingReasonRetained,
+					"host has been retained in the system inventory")
+				if err != nil {
+					return err
+				}
+
+				logHost.Info("retaining host in system inventory")
+
+			} else if host != nil {
 				err = r.ReconcileDeletedHost(client, instance, host)
 				if err != nil {
 					return err
@@ -1759,10 +2318,12 @@ func (r *HostReconciler) ReconcileResource(
 
 		// Remove deleted host from CephPrimaryGroup
 		host_uid := string(instance.UID)
+		cephPrimaryGroupLock.Lock()
 		if utils.ContainsString(CephPrimaryGroup, host_uid) {
 			CephPrimaryGroup = utils.RemoveString(CephPrimaryGroup, host_uid)
 			logHost.Info("host is no longer present as a ceph primary group")
 		}
+		cephPrimaryGroupLock.Unlock()
 
 		return nil
 	}
@@ -1771,12 +2332,22 @@ func (r *HostReconciler) ReconcileResource(
 	// a matching host record if one is not already found as well as to
 	// determine when it is safe/allowed to configure new hosts or unlock
 	// existing hosts.
-	r.hosts, err = hosts.ListHosts(client)
+	results, err := hosts.ListHosts(client)
 	if err != nil {
 		err = perrors.Wrap(err, "failed to list hosts")
 		return err
 	}
 
+	r.setHosts(results)
+
+	// Hosts are reconciled in parallel but their initial provisioning must
+	// follow the order of their personalities.  This relies on the snapshot
+	// of hosts to know whether the storage monitors are enabled.
+	err = r.ReconcileProvisioningOrder(instance, profile)
+	if err != nil {
+		return err
+	}
+
 	if host == nil {
 		// This host either needs to be provisioned for the first time or we
 		// need to audit the list of hosts so that we can find one that already
@@ -1793,7 +2364,10 @@ func (r *HostReconciler) ReconcileResource(
 	inSync = err == nil
 	oldInSync := instance.Status.InSync
 
-	if r.statusUpdateRequired(instance, host, inSync) {
+	details := getHostDetails(client, host.ID)
+	detailsChanged := hostDetailsUpdateRequired(&instance.Status, details, time.Now())
+
+	if r.statusUpdateRequired(instance, host, inSync) || detailsChanged {
 		logHost.V(2).Info("updating host status", "status", instance.Status)
 
 		err2 := r.Client.Status().Update(context.TODO(), instance)
@@ -1844,6 +2418,9 @@ func CephReplicationFactor(client *gophercloud.ServiceClient) (rep int, err erro
 // primary group or not. Add host uid to primary group
 // list up to replication factor.
 func IsCephPrimaryGroup(host_uid string, rep int) (pg bool, err error) {
+	cephPrimaryGroupLock.Lock()
+	defer cephPrimaryGroupLock.Unlock()
+
 	if len(host_uid) > 0 {
 		for _, c := range CephPrimaryGroup {
 			if c == host_uid {
@@ -1868,7 +2445,7 @@ func (r *HostReconciler) GetCephPrimaryGroupReady(client *gophercloud.ServiceCli
 	}
 	cephReady := false
 	num := 0
-	for _, host := range r.hosts {
+	for _, host := range r.getHosts() {
 		if host.Personality == hosts.PersonalityStorage && host.IsUnlockedAvailable() {
 			num += 1
 		}
@@ -2013,6 +2590,12 @@ func (r *HostReconciler) UpdateConfigStatus(
 		logHost.V(2).Info("deploymentScope in configuration", "deploymentScope", deploymentScope)
 		instance.Status.DeploymentScope = deploymentScope
 
+		subsectionScopes, err := r.GetSubsectionScopeConfig(instance)
+		if err != nil {
+			return err
+		}
+		instance.Status.SubsectionScopes = subsectionScopes
+
 		// Set default value for StrategyRequired
 		if instance.Status.StrategyRequired == "" {
 			instance.Status.StrategyRequired = cloudManager.StrategyNotRequired
@@ -2088,16 +2671,16 @@ func (r *HostReconciler) UpdateConfigStatus(
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hosts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hosts/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hosts/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;update;patch
 func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (result ctrl.Result, err error) {
 	_ = log.FromContext(ctx)
 	// FIXME: check log object
 	// _ = r.Log.WithValues("host", request.NamespacedName)
 
-	savedLog := logHost
-	logHost = logHost.WithName(request.NamespacedName.String())
-	defer func() { logHost = savedLog }()
-
-	logHost.V(2).Info("reconcile called")
+	// The logger is shared by hosts reconciled in parallel so the host is
+	// named explicitly rather than thru a per-request logger.
+	logHost.V(2).Info("reconcile called", "host", request.NamespacedName)
 
 	// Fetch the Host instance
 	instance := &starlingxv1.Host{}
@@ -2127,12 +2710,36 @@ func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (r
 		return ctrl.Result{}, nil
 	}
 
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
 	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
 		instance.Status.Reconciled &&
-		instance.Status.DeploymentScope == "bootstrap" {
-		return ctrl.Result{}, nil
+		instance.Status.DeploymentScope == "bootstrap" &&
+		!r.bmCredentialsRotationRequired(instance) &&
+		!reinstallRequested(instance) &&
+		!snapshotRequested(instance) &&
+		!r.auditDue(instance) {
+		if instance.Status.ClockSync != nil {
+			if platformClient := r.CloudManager.GetPlatformClient(request.Namespace); platformClient != nil {
+				if err := r.AuditClockSync(platformClient, instance); err != nil {
+					logHost.Error(err, "failed to audit clock synchronization")
+				}
+			}
+		}
+
+		err = common.ReconcileConditions(r.Client, instance, &instance.Status.Conditions,
+			instance.Status.Reconciled, instance.Status.InSync)
+		return requeueForAudit(instance, ctrl.Result{}), err
 	}
 
+	defer r.endAudit(instance)
+
 	if instance.DeletionTimestamp.IsZero() {
 		// Ensure that the object has a finalizer setup as a pre-delete hook so
 		// that we can delete any hosts that we have previously added.
@@ -2159,13 +2766,17 @@ func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (r
 		// wait.
 		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
 			"waiting for platform client creation")
-		return common.RetryMissingClient, nil
+		err = common.ReconcileBlocked(r.Client, instance, &instance.Status.Conditions,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, err
 	}
 
 	if !r.CloudManager.GetSystemReady(request.Namespace) {
 		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
 			"waiting for system reconciliation")
-		return common.RetrySystemNotReady, nil
+		err = common.ReconcileBlocked(r.Client, instance, &instance.Status.Conditions,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, err
 	}
 
 	// Build a composite profile based on the profile chain and host overrides
@@ -2208,12 +2819,29 @@ func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (r
 		logHost.V(2).Info("not storage node or in ceph primary group. continue")
 	}
 
+	platformClient = cloudManager.WithChangeInitiator(platformClient, instance)
 	err = r.ReconcileResource(platformClient, instance, profile)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if instance.DeletionTimestamp.IsZero() {
+		err2 := common.UpdateStandardConditions(r.Client, instance, &instance.Status.Conditions,
+			instance.Status.Reconciled, instance.Status.InSync, err)
+		if err2 != nil {
+			logHost.Error(err2, "failed to update host conditions")
+		}
+	}
 	if err != nil {
-		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+		cause := err
+		result, err = r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+		result, err = r.applyReconcilePolicy(instance, cause, result, err)
+		if err == nil {
+			result = requeueForAudit(instance, result)
+		}
+		return result, err
 	}
 
-	return ctrl.Result{}, nil
+	r.clearReconcileFailures(instance)
+
+	return requeueForAudit(instance, ctrl.Result{}), nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -2225,11 +2853,13 @@ func (r *HostReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.ReconcilerErrorHandler = &common.ErrorHandler{
 		CloudManager: tMgr,
 		Logger:       logHost}
-	r.ReconcilerEventLogger = &common.EventLogger{
-		EventRecorder: mgr.GetEventRecorderFor(HostControllerName),
-		Logger:        logHost}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(HostControllerName), logHost)
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.Host{}).
+		Watches(&source.Kind{Type: &v1.Secret{}},
+			handler.EnqueueRequestsFromMapFunc(r.findHostsForBMSecret)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: maxConcurrentReconciles()}).
 		Complete(r)
 }
 
@@ -1,19 +1,31 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 package host
 
 import (
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/ptpinstances"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	comm "github.com/wind-river/cloud-platform-deployment-manager/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/hostdetails"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/patches"
 )
 
 var _ = Describe("Host controller", func() {
@@ -351,5 +363,823 @@ var _ = Describe("Host controller", func() {
 				Expect(containsAdmin).To(BeFalse())
 			})
 		})
+
+		Describe("validateVirtualMediaInfo", func() {
+			It("Should accept a redfish BMC with an address and credentials", func() {
+				bmType := "redfish"
+				address := "10.10.10.10"
+				profile := &starlingxv1.HostProfileSpec{
+					BoardManagement: &starlingxv1.BMInfo{
+						Type:    &bmType,
+						Address: &address,
+						Credentials: &starlingxv1.BMCredentials{
+							Password: &starlingxv1.BMPasswordInfo{Secret: "bmc-secret"},
+						},
+					},
+				}
+
+				Expect(validateVirtualMediaInfo(profile)).To(BeNil())
+			})
+
+			It("Should reject a non-redfish BMC", func() {
+				bmType := "ipmi"
+				address := "10.10.10.10"
+				profile := &starlingxv1.HostProfileSpec{
+					BoardManagement: &starlingxv1.BMInfo{
+						Type:    &bmType,
+						Address: &address,
+					},
+				}
+
+				Expect(validateVirtualMediaInfo(profile)).NotTo(BeNil())
+			})
+		})
+
+		Describe("setBMCredentialsCondition", func() {
+			It("Should not add an accepted condition if nothing was rejected", func() {
+				instance := &starlingxv1.Host{}
+				setBMCredentialsCondition(instance, metav1.ConditionFalse, BMCredentialsReasonAccepted, "accepted")
+
+				Expect(instance.Status.Conditions).To(BeEmpty())
+			})
+
+			It("Should clear a previously rejected condition", func() {
+				instance := &starlingxv1.Host{}
+				setBMCredentialsCondition(instance, metav1.ConditionTrue, BMCredentialsReasonRejected, "rejected")
+				setBMCredentialsCondition(instance, metav1.ConditionFalse, BMCredentialsReasonAccepted, "accepted")
+
+				Expect(instance.Status.Conditions).To(HaveLen(1))
+				Expect(instance.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+				Expect(instance.Status.Conditions[0].Reason).To(Equal(BMCredentialsReasonAccepted))
+			})
+		})
+
+		Describe("consoleCapture", func() {
+			It("Should discard the oldest output once the size limit is reached", func() {
+				maxSize := 8
+				c := &consoleCapture{
+					instance: &starlingxv1.Host{},
+					info:     starlingxv1.ConsoleCaptureInfo{MaxSize: &maxSize},
+				}
+				c.append("first\r")
+				c.append("second")
+
+				Expect(string(c.output)).To(Equal("\nsecond\n"))
+				Expect(c.dirty).To(BeTrue())
+			})
+
+			It("Should not buffer output that is sent to the log", func() {
+				destination := starlingxv1.ConsoleDestinationLog
+				c := &consoleCapture{
+					instance: &starlingxv1.Host{},
+					info:     starlingxv1.ConsoleCaptureInfo{Destination: &destination},
+				}
+				c.append("output")
+
+				Expect(c.output).To(BeEmpty())
+				Expect(c.dirty).To(BeFalse())
+			})
+		})
+
+		Describe("reinstallRequested", func() {
+			It("Should only trigger when the annotation is set to true", func() {
+				instance := &starlingxv1.Host{}
+				Expect(reinstallRequested(instance)).To(BeFalse())
+
+				instance.Annotations = map[string]string{cloudManager.ReinstallHost: "false"}
+				Expect(reinstallRequested(instance)).To(BeFalse())
+
+				instance.Annotations[cloudManager.ReinstallHost] = "True"
+				Expect(reinstallRequested(instance)).To(BeTrue())
+			})
+		})
+
+		Describe("reinstallInProgress", func() {
+			It("Should not report a completed reinstall as in progress", func() {
+				instance := &starlingxv1.Host{}
+				instance.Status.Conditions = []metav1.Condition{
+					{Type: starlingxv1.ConditionReinstalling, Status: metav1.ConditionFalse, Reason: ReinstallReasonCompleted},
+				}
+				_, ok := reinstallInProgress(instance)
+				Expect(ok).To(BeFalse())
+			})
+
+			It("Should not report a pending lock as in progress", func() {
+				instance := &starlingxv1.Host{}
+				instance.Status.Conditions = []metav1.Condition{
+					{Type: starlingxv1.ConditionReinstalling, Status: metav1.ConditionTrue, Reason: ReinstallReasonLocking},
+				}
+				_, ok := reinstallInProgress(instance)
+				Expect(ok).To(BeFalse())
+			})
+
+			It("Should report the current phase of an issued reinstall", func() {
+				instance := &starlingxv1.Host{}
+				instance.Status.Conditions = []metav1.Condition{
+					{Type: starlingxv1.ConditionReinstalling, Status: metav1.ConditionTrue, Reason: ReinstallReasonInstalling},
+				}
+				reason, ok := reinstallInProgress(instance)
+				Expect(ok).To(BeTrue())
+				Expect(reason).To(Equal(ReinstallReasonInstalling))
+			})
+		})
+
+		Describe("findStandbyController", func() {
+			It("Should only select another available controller", func() {
+				active := hosts.ActiveController
+				objects := []hosts.Host{
+					{
+						ID:                  "1",
+						Hostname:            "controller-0",
+						Personality:         hosts.PersonalityController,
+						Capabilities:        hosts.Capabilities{Personality: &active},
+						AdministrativeState: hosts.AdminUnlocked,
+						OperationalStatus:   hosts.OperEnabled,
+						AvailabilityStatus:  hosts.AvailAvailable,
+					},
+					{
+						ID:                  "2",
+						Hostname:            "worker-0",
+						Personality:         hosts.PersonalityWorker,
+						AdministrativeState: hosts.AdminUnlocked,
+						OperationalStatus:   hosts.OperEnabled,
+						AvailabilityStatus:  hosts.AvailAvailable,
+					},
+					{
+						ID:                  "3",
+						Hostname:            "controller-1",
+						Personality:         hosts.PersonalityController,
+						AdministrativeState: hosts.AdminUnlocked,
+						OperationalStatus:   hosts.OperEnabled,
+						AvailabilityStatus:  hosts.AvailAvailable,
+					},
+				}
+
+				Expect(isActiveController(objects[0])).To(BeTrue())
+				Expect(findStandbyController(objects, "1").Hostname).To(Equal("controller-1"))
+
+				objects[2].AvailabilityStatus = hosts.AvailOffline
+				Expect(findStandbyController(objects, "1")).To(BeNil())
+			})
+		})
+
+		Describe("deletionPolicy", func() {
+			It("Should delete hosts that do not specify a policy", func() {
+				instance := &starlingxv1.Host{}
+				Expect(deletionPolicy(instance)).To(Equal(starlingxv1.DeletionPolicyDelete))
+			})
+
+			It("Should honour an explicit retain policy", func() {
+				policy := starlingxv1.DeletionPolicyRetain
+				instance := &starlingxv1.Host{}
+				instance.Spec.DeletionPolicy = &policy
+				Expect(deletionPolicy(instance)).To(Equal(starlingxv1.DeletionPolicyRetain))
+			})
+		})
+
+		Describe("unlockAction", func() {
+			It("Should only force unlock when enabled and the threshold is reached", func() {
+				instance := &starlingxv1.Host{}
+				instance.Status.UnlockAttempts = ForceUnlockThreshold
+				Expect(unlockAction(instance)).To(Equal(hosts.ActionUnlock))
+
+				force := true
+				instance.Spec.ForceUnlock = &force
+				Expect(unlockAction(instance)).To(Equal(ActionForceUnlock))
+
+				instance.Status.UnlockAttempts = ForceUnlockThreshold - 1
+				Expect(unlockAction(instance)).To(Equal(hosts.ActionUnlock))
+			})
+		})
+
+		Describe("memoryRebootAllowed", func() {
+			enabled := &v1info.HostInfo{Host: hosts.Host{
+				AdministrativeState: hosts.AdminUnlocked,
+				OperationalStatus:   hosts.OperEnabled,
+			}}
+			allow := true
+			deny := false
+
+			It("Should allow the lock when the host allows reboots", func() {
+				instance := &starlingxv1.Host{}
+				instance.Spec.AllowReboot = &allow
+				Expect(memoryRebootAllowed(instance, enabled)).To(BeTrue())
+			})
+
+			It("Should not allow the lock when the host does not allow reboots", func() {
+				instance := &starlingxv1.Host{}
+				instance.Spec.AllowReboot = &deny
+				Expect(memoryRebootAllowed(instance, enabled)).To(BeFalse())
+
+				// The memory reconciler does not allow reboots by default.
+				instance.Spec.AllowReboot = nil
+				Expect(memoryRebootAllowed(instance, enabled)).To(BeFalse())
+			})
+
+			It("Should not allow the lock when the host is not unlocked and enabled", func() {
+				instance := &starlingxv1.Host{}
+				instance.Spec.AllowReboot = &allow
+				locked := &v1info.HostInfo{Host: hosts.Host{
+					AdministrativeState: hosts.AdminLocked,
+					OperationalStatus:   hosts.OperDisabled,
+				}}
+				Expect(memoryRebootAllowed(instance, locked)).To(BeFalse())
+			})
+
+			It("Should allow the lock for Day-2 changes in the principal scope", func() {
+				instance := &starlingxv1.Host{}
+				instance.Spec.AllowReboot = &allow
+				instance.Status.DeploymentScope = cloudManager.ScopePrincipal
+				Expect(memoryRebootAllowed(instance, enabled)).To(BeTrue())
+			})
+		})
+
+		Describe("retryDelay", func() {
+			It("Should back off up to the maximum retry interval", func() {
+				interval := 10
+				factor := 2
+				limit := 60
+				policy := &starlingxv1.ReconcilePolicyInfo{}
+				Expect(retryDelay(policy, 3, time.Minute)).To(Equal(time.Minute))
+
+				policy.RetryInterval = &interval
+				Expect(retryDelay(policy, 3, time.Minute)).To(Equal(10 * time.Second))
+
+				policy.BackoffFactor = &factor
+				Expect(retryDelay(policy, 1, time.Minute)).To(Equal(10 * time.Second))
+				Expect(retryDelay(policy, 3, time.Minute)).To(Equal(40 * time.Second))
+
+				policy.MaxRetryInterval = &limit
+				Expect(retryDelay(policy, 4, time.Minute)).To(Equal(60 * time.Second))
+				Expect(retryDelay(policy, 100, time.Minute)).To(Equal(60 * time.Second))
+			})
+		})
+
+		Describe("hostDetailsUpdateRequired", func() {
+			It("Should only update the boot time once it drifts past the tolerance", func() {
+				now := time.Now()
+				state := "completed"
+				load := "22.12"
+				details := &hostdetails.HostDetails{InstallState: &state, SoftwareLoad: &load, Uptime: 3600}
+				status := &starlingxv1.HostStatus{}
+				Expect(hostDetailsUpdateRequired(status, details, now)).To(BeTrue())
+				Expect(*status.InstallState).To(Equal(state))
+				Expect(*status.SoftwareVersion).To(Equal(load))
+				Expect(status.BootTime).ToNot(BeNil())
+
+				details.Uptime = 3660
+				Expect(hostDetailsUpdateRequired(status, details, now.Add(2*time.Minute))).To(BeFalse())
+
+				details.Uptime = 60
+				Expect(hostDetailsUpdateRequired(status, details, now)).To(BeTrue())
+				Expect(hostDetailsUpdateRequired(status, nil, now)).To(BeFalse())
+			})
+		})
+
+		Describe("clockSourceReady", func() {
+			It("Should require the new time source to be available", func() {
+				host := &v1info.HostInfo{}
+				ok, _ := clockSourceReady(starlingxv1.ClockSynchronizationPTP, host, "")
+				Expect(ok).To(BeFalse())
+
+				host.PTPInstances = []ptpinstances.PTPInstance{{Name: "ptp1"}}
+				ok, _ = clockSourceReady(starlingxv1.ClockSynchronizationPTP, host, "")
+				Expect(ok).To(BeTrue())
+
+				ok, _ = clockSourceReady(starlingxv1.ClockSynchronizationNTP, host, " ")
+				Expect(ok).To(BeFalse())
+
+				ok, _ = clockSourceReady(starlingxv1.ClockSynchronizationNTP, host, "0.pool.ntp.org")
+				Expect(ok).To(BeTrue())
+			})
+		})
+
+		Describe("ptpInstanceRemovalAllowed", func() {
+			It("Should keep the last PTP instance of a host using PTP", func() {
+				source := starlingxv1.ClockSynchronizationPTP
+				host := &v1info.HostInfo{}
+				host.ClockSynchronization = &source
+				Expect(ptpInstanceRemovalAllowed(host, 1)).To(BeTrue())
+				Expect(ptpInstanceRemovalAllowed(host, 0)).To(BeFalse())
+
+				source = starlingxv1.ClockSynchronizationNTP
+				Expect(ptpInstanceRemovalAllowed(host, 0)).To(BeTrue())
+			})
+		})
+
+		Describe("auditDelay", func() {
+			It("Should audit reconciled hosts at the configured interval", func() {
+				instance := &starlingxv1.Host{}
+				Expect(auditDelay(instance, 30*time.Minute)).To(BeZero())
+
+				instance.Status.Reconciled = true
+				Expect(auditDelay(instance, 0)).To(BeZero())
+				Expect(auditDelay(instance, 30*time.Minute)).To(Equal(30 * time.Minute))
+
+				instance.Status.ClockSync = &starlingxv1.HostClockSyncStatus{}
+				Expect(auditDelay(instance, 30*time.Minute)).To(Equal(ClockSyncAuditInterval))
+				Expect(auditDelay(instance, time.Minute)).To(Equal(time.Minute))
+
+				result := requeueForAudit(instance, ctrl.Result{Requeue: true, RequeueAfter: time.Second})
+				Expect(result.RequeueAfter).To(Equal(time.Second))
+				result = requeueForAudit(instance, ctrl.Result{})
+				Expect(result.RequeueAfter).To(Equal(ClockSyncAuditInterval))
+			})
+		})
+
+		Describe("autoRemediationEnabled", func() {
+			It("Should only remediate drift when requested by the reconcile policy", func() {
+				instance := &starlingxv1.Host{}
+				Expect(autoRemediationEnabled(instance)).To(BeFalse())
+
+				enabled := true
+				instance.Spec.ReconcilePolicy = &starlingxv1.ReconcilePolicyInfo{AutoRemediate: &enabled}
+				Expect(autoRemediationEnabled(instance)).To(BeTrue())
+			})
+		})
+
+		Describe("lockBootstrapSubsections", func() {
+			It("Should keep the current configuration of bootstrap scoped areas", func() {
+				bootstrap := cloudManager.ScopeBootstrap
+				principal := cloudManager.ScopePrincipal
+				lowlatency := "lowlatency"
+				standard := "standard"
+
+				profile := &starlingxv1.HostProfileSpec{}
+				profile.Kernel = &lowlatency
+				profile.PtpInstances = starlingxv1.PtpInstanceItemList{"ptp2"}
+				current := &starlingxv1.HostProfileSpec{}
+				current.Kernel = &standard
+				current.PtpInstances = starlingxv1.PtpInstanceItemList{"ptp1"}
+
+				Expect(lockBootstrapSubsections(profile, current, nil)).To(BeEmpty())
+				Expect(*profile.Kernel).To(Equal(lowlatency))
+
+				scopes := &starlingxv1.HostSubsectionScopes{Kernel: &bootstrap, PTP: &principal}
+				Expect(lockBootstrapSubsections(profile, current, scopes)).To(Equal([]string{SubsectionKernel}))
+				Expect(*profile.Kernel).To(Equal(standard))
+				Expect(profile.PtpInstances).To(Equal(starlingxv1.PtpInstanceItemList{"ptp2"}))
+			})
+		})
+
+		Describe("removeDefaultLabels", func() {
+			It("Should only remove managed labels from the defaults", func() {
+				defaults := &starlingxv1.HostProfileSpec{}
+				defaults.Labels = map[string]string{"sriovdp": "enabled", "kube-cpu-mgr-policy": "static"}
+				instance := &starlingxv1.Host{Status: starlingxv1.HostStatus{ManagedLabels: []string{"kube-cpu-mgr-policy"}}}
+
+				removeDefaultLabels(instance, defaults)
+				Expect(defaults.Labels).To(Equal(map[string]string{"sriovdp": "enabled"}))
+
+				instance.Status.ManagedLabels = []string{"sriovdp"}
+				removeDefaultLabels(instance, defaults)
+				Expect(defaults.Labels).To(BeNil())
+			})
+		})
+
+		Describe("removeForeignLabels", func() {
+			It("Should ignore labels applied by other actors", func() {
+				profile := &starlingxv1.HostProfileSpec{}
+				profile.Labels = map[string]string{"sriovdp": "enabled"}
+				current := &starlingxv1.HostProfileSpec{}
+				current.Labels = map[string]string{"sriovdp": "disabled", "stale": "enabled", "foreign": "enabled"}
+				instance := &starlingxv1.Host{Status: starlingxv1.HostStatus{ManagedLabels: []string{"stale"}}}
+
+				removeForeignLabels(instance, profile, current)
+				Expect(current.Labels).To(Equal(map[string]string{"sriovdp": "disabled", "stale": "enabled"}))
+
+				current.Labels = map[string]string{"foreign": "enabled"}
+				removeForeignLabels(instance, profile, current)
+				Expect(current.Labels).To(BeNil())
+			})
+		})
+
+		Describe("mergeNodeTaints", func() {
+			It("Should only remove taints applied by the deployment manager", func() {
+				existing := []v1.Taint{
+					{Key: "foreign", Effect: v1.TaintEffectNoSchedule},
+					{Key: "stale", Effect: v1.TaintEffectNoSchedule},
+					{Key: "dedicated", Value: "infra", Effect: v1.TaintEffectNoExecute},
+				}
+				desired := starlingxv1.NodeTaintList{
+					{Key: "dedicated", Value: "infra", Effect: "NoExecute"},
+					{Key: "dedicated", Value: "infra", Effect: "NoSchedule"},
+				}
+
+				result, owned, changed := mergeNodeTaints(existing, desired, []string{"stale:NoSchedule"})
+				Expect(changed).To(BeTrue())
+				Expect(owned).To(Equal([]string{"dedicated:NoSchedule"}))
+				Expect(result).To(Equal([]v1.Taint{
+					{Key: "foreign", Effect: v1.TaintEffectNoSchedule},
+					{Key: "dedicated", Value: "infra", Effect: v1.TaintEffectNoExecute},
+					{Key: "dedicated", Value: "infra", Effect: v1.TaintEffectNoSchedule},
+				}))
+
+				_, owned, changed = mergeNodeTaints(result, desired, owned)
+				Expect(changed).To(BeFalse())
+				Expect(owned).To(Equal([]string{"dedicated:NoSchedule"}))
+			})
+
+			It("Should replace a taint whose value has changed", func() {
+				existing := []v1.Taint{{Key: "dedicated", Value: "infra", Effect: v1.TaintEffectNoSchedule}}
+				desired := starlingxv1.NodeTaintList{{Key: "dedicated", Value: "storage", Effect: "NoSchedule"}}
+
+				result, owned, changed := mergeNodeTaints(existing, desired, nil)
+				Expect(changed).To(BeTrue())
+				Expect(owned).To(Equal([]string{"dedicated:NoSchedule"}))
+				Expect(result).To(Equal([]v1.Taint{{Key: "dedicated", Value: "storage", Effect: v1.TaintEffectNoSchedule}}))
+			})
+		})
+
+		Describe("ownedNodeTaints", func() {
+			It("Should ignore taints applied by other actors", func() {
+				taints := []v1.Taint{
+					{Key: "foreign", Effect: v1.TaintEffectNoSchedule},
+					{Key: "stale", Effect: v1.TaintEffectNoSchedule},
+					{Key: "dedicated", Value: "infra", Effect: v1.TaintEffectNoSchedule},
+				}
+				profile := &starlingxv1.HostProfileSpec{}
+				profile.Taints = starlingxv1.NodeTaintList{{Key: "dedicated", Value: "infra", Effect: "NoSchedule"}}
+
+				Expect(ownedNodeTaints(taints, profile, []string{"stale:NoSchedule"})).To(Equal(starlingxv1.NodeTaintList{
+					{Key: "stale", Effect: "NoSchedule"},
+					{Key: "dedicated", Value: "infra", Effect: "NoSchedule"},
+				}))
+			})
+		})
+
+		Describe("ptpInstanceDelta", func() {
+			It("Should detect PTP instances added to and removed from a host", func() {
+				existing := []ptpinstances.PTPInstance{{ID: 1, Name: "ptp1"}, {ID: 2, Name: "ptp2"}}
+
+				added, removed := ptpInstanceDelta(starlingxv1.PtpInstanceItemList{"ptp1", "ptp2"}, existing)
+				Expect(added).To(BeEmpty())
+				Expect(removed).To(BeEmpty())
+
+				added, removed = ptpInstanceDelta(starlingxv1.PtpInstanceItemList{"ptp1", "ptp3"}, existing)
+				Expect(added).To(Equal([]string{"ptp3"}))
+				Expect(removed).To(Equal([]ptpinstances.PTPInstance{{ID: 2, Name: "ptp2"}}))
+
+				added, removed = ptpInstanceDelta(nil, existing)
+				Expect(added).To(BeEmpty())
+				Expect(removed).To(Equal(existing))
+			})
+		})
+
+		Describe("provisioningTier", func() {
+			It("Should order controllers before storage nodes and workers", func() {
+				controller := hosts.PersonalityController
+				storage := hosts.PersonalityStorage
+				worker := hosts.PersonalityWorker
+
+				Expect(provisioningTier("worker-0", nil)).To(Equal(TierWorker))
+				Expect(provisioningTier(hosts.Controller0, &controller)).To(Equal(TierActiveController))
+				Expect(provisioningTier("controller-1", &controller)).To(Equal(TierController))
+				Expect(provisioningTier("storage-0", &storage)).To(Equal(TierStorage))
+				Expect(provisioningTier("worker-0", &worker)).To(Equal(TierWorker))
+			})
+		})
+
+		Describe("PendingPredecessors", func() {
+			It("Should order hosts by the personality recorded in their status", func() {
+				controller := hosts.PersonalityController
+				storage := hosts.PersonalityStorage
+				worker := hosts.PersonalityWorker
+
+				host := func(name string, personality *string, reconciled bool) *starlingxv1.Host {
+					return &starlingxv1.Host{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+						Status: starlingxv1.HostStatus{
+							Personality: personality,
+							Reconciled:  reconciled,
+						},
+					}
+				}
+
+				scheme := runtime.NewScheme()
+				Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+				r := &HostReconciler{
+					Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+						host(hosts.Controller0, &controller, true),
+						host("controller-1", &controller, false),
+						host("storage-0", &storage, false),
+						host("storage-1", &storage, false),
+						host("worker-0", &worker, false),
+						// The profile of this host cannot be resolved and
+						// its personality was never recorded.
+						host("worker-1", nil, false),
+					).Build(),
+				}
+
+				tests := []struct {
+					name        string
+					personality string
+					want        []string
+				}{
+					{name: hosts.Controller0, personality: controller, want: []string{}},
+					{name: "controller-1", personality: controller, want: []string{}},
+					{name: "storage-0", personality: storage, want: []string{"controller-1"}},
+					{name: "storage-1", personality: storage, want: []string{"controller-1", "storage-0"}},
+					{name: "worker-0", personality: worker, want: []string{"controller-1", "storage-0", "storage-1"}},
+				}
+				for _, tt := range tests {
+					profile := &starlingxv1.HostProfileSpec{}
+					profile.Personality = &tt.personality
+					pending, err := r.PendingPredecessors(host(tt.name, nil, false), profile)
+					Expect(err).ToNot(HaveOccurred(), tt.name)
+					Expect(pending).To(Equal(tt.want), tt.name)
+				}
+			})
+
+			It("Should record the personality of the host in its status", func() {
+				storage := hosts.PersonalityStorage
+				instance := &starlingxv1.Host{
+					ObjectMeta: metav1.ObjectMeta{Name: "storage-0", Namespace: "default"},
+				}
+
+				scheme := runtime.NewScheme()
+				Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+				r := &HostReconciler{
+					Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).Build(),
+				}
+
+				profile := &starlingxv1.HostProfileSpec{}
+				profile.Personality = &storage
+				Expect(r.recordPersonality(instance, profile)).To(Succeed())
+
+				fetched := &starlingxv1.Host{}
+				Expect(r.Get(context.TODO(), types.NamespacedName{Name: "storage-0", Namespace: "default"}, fetched)).To(Succeed())
+				Expect(fetched.Status.Personality).To(Equal(&storage))
+			})
+		})
+
+		Describe("ReconcileProvisioningOrder", func() {
+			It("Should keep the personality order when hosts are reconciled concurrently", func() {
+				controller := hosts.PersonalityController
+				storage := hosts.PersonalityStorage
+				worker := hosts.PersonalityWorker
+				monitor := hosts.StorFunctionMonitor
+
+				profile := func(name string, personality *string) *starlingxv1.HostProfile {
+					result := &starlingxv1.HostProfile{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+					}
+					result.Spec.Personality = personality
+					return result
+				}
+
+				host := func(name string, profile string) *starlingxv1.Host {
+					return &starlingxv1.Host{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+						Spec:       starlingxv1.HostSpec{Profile: profile},
+					}
+				}
+
+				names := []string{"worker-2", "worker-1", "storage-1", "worker-0",
+					"storage-0", "controller-1", hosts.Controller0}
+
+				scheme := runtime.NewScheme()
+				Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+				r := &HostReconciler{
+					Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+						profile("controller-profile", &controller),
+						profile("storage-profile", &storage),
+						profile("worker-profile", &worker),
+						host(hosts.Controller0, "controller-profile"),
+						host("controller-1", "controller-profile"),
+						host("storage-0", "storage-profile"),
+						host("storage-1", "storage-profile"),
+						host("worker-0", "worker-profile"),
+						host("worker-1", "worker-profile"),
+						host("worker-2", "worker-profile"),
+					).Build(),
+				}
+
+				// The storage monitors run on the controllers.
+				enabled := hosts.Host{
+					AdministrativeState: hosts.AdminUnlocked,
+					OperationalStatus:   hosts.OperEnabled,
+				}
+				enabled.Capabilities.StorFunction = &monitor
+				r.setHosts([]hosts.Host{enabled, enabled})
+
+				// Emulate the controller work queue: each worker takes the
+				// next host, reconciles it if it is no longer waiting for
+				// other hosts, and otherwise puts it back at the end of the
+				// queue.
+				queue := make(chan string, len(names))
+				for _, name := range names {
+					queue <- name
+				}
+
+				var lock sync.Mutex
+				var order []string
+				var wg sync.WaitGroup
+				for i := 0; i < DefaultMaxConcurrentReconciles; i++ {
+					wg.Add(1)
+					go func() {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						for name := range queue {
+							instance := &starlingxv1.Host{}
+							Expect(r.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "default"}, instance)).To(Succeed())
+
+							composite, err := r.BuildCompositeProfile(instance)
+							Expect(err).ToNot(HaveOccurred())
+
+							err = r.ReconcileProvisioningOrder(instance, composite)
+							if err != nil {
+								queue <- name
+								continue
+							}
+
+							instance.Status.Reconciled = true
+							Expect(r.Status().Update(context.TODO(), instance)).To(Succeed())
+
+							lock.Lock()
+							order = append(order, name)
+							if len(order) == len(names) {
+								close(queue)
+							}
+							lock.Unlock()
+						}
+					}()
+				}
+				wg.Wait()
+
+				position := make(map[string]int)
+				for i, name := range order {
+					position[name] = i
+				}
+
+				Expect(order).To(HaveLen(len(names)))
+				Expect(order[0]).To(Equal(hosts.Controller0))
+				Expect(position["controller-1"]).To(BeNumerically("<", position["storage-0"]))
+				Expect(position["storage-0"]).To(BeNumerically("<", position["storage-1"]))
+				for _, name := range []string{"worker-0", "worker-1", "worker-2"} {
+					Expect(position["storage-1"]).To(BeNumerically("<", position[name]), name)
+				}
+			})
+		})
+
+		Describe("provisionedBefore", func() {
+			It("Should order storage nodes by their ordinal", func() {
+				ordinal, ok := hostOrdinal("storage-12")
+				Expect(ok).To(BeTrue())
+				Expect(ordinal).To(Equal(12))
+				_, ok = hostOrdinal("storage")
+				Expect(ok).To(BeFalse())
+
+				Expect(provisionedBefore("storage-1", TierStorage, "storage-0", TierStorage)).To(BeTrue())
+				Expect(provisionedBefore("storage-0", TierStorage, "storage-1", TierStorage)).To(BeFalse())
+				Expect(provisionedBefore("storage-0", TierStorage, "storage-a", TierStorage)).To(BeFalse())
+				Expect(provisionedBefore("storage-0", TierStorage, "controller-1", TierController)).To(BeTrue())
+				Expect(provisionedBefore("worker-1", TierWorker, "worker-0", TierWorker)).To(BeFalse())
+			})
+		})
+
+		Describe("bootstrapPhase", func() {
+			It("Should gate every host except controller-0", func() {
+				Expect(bootstrapPhase(hosts.Controller0, hosts.PersonalityController)).To(BeEmpty())
+				Expect(bootstrapPhase("controller-1", hosts.PersonalityController)).To(Equal(starlingxv1.BootstrapPhaseController1Unlocked))
+				Expect(bootstrapPhase("storage-0", hosts.PersonalityStorage)).To(Equal(starlingxv1.BootstrapPhaseStorageUnlocked))
+				Expect(bootstrapPhase("worker-0", hosts.PersonalityWorker)).To(Equal(starlingxv1.BootstrapPhaseWorkersUnlocked))
+			})
+		})
+
+		Describe("hostMatchesCriteria", func() {
+			It("Should combine the match attributes with the operator", func() {
+				serial := "SN-1234"
+				assetTag := "tag-1"
+				bmMAC := "00:11:22:33:44:55"
+				other := "other"
+				h := hosts.Host{SerialNumber: &serial}
+				criteria := &starlingxv1.MatchInfo{
+					DMI: &starlingxv1.MatchDMIInfo{SerialNumber: &serial, AssetTag: &assetTag},
+					BoardManagement: &starlingxv1.MatchBMInfo{
+						MACAddress: &bmMAC,
+					},
+				}
+
+				Expect(hostMatchesCriteria(h, &bmMAC, nil)).To(BeFalse())
+				Expect(hostMatchesCriteria(h, &bmMAC, &starlingxv1.MatchInfo{})).To(BeFalse())
+				Expect(hostMatchesCriteria(h, &bmMAC, criteria)).To(BeFalse())
+
+				h.AssetTag = &assetTag
+				Expect(hostMatchesCriteria(h, &bmMAC, criteria)).To(BeTrue())
+				Expect(hostMatchesCriteria(h, nil, criteria)).To(BeFalse())
+
+				operator := starlingxv1.MatchOperatorOr
+				criteria.Operator = &operator
+				h.SerialNumber = &other
+				h.AssetTag = nil
+				Expect(hostMatchesCriteria(h, &bmMAC, criteria)).To(BeTrue())
+				Expect(hostMatchesCriteria(h, &other, criteria)).To(BeFalse())
+			})
+		})
+
+		Describe("unmatchedHosts", func() {
+			It("Should only adopt new hosts which do not match a host resource", func() {
+				bootMAC := "08:00:27:a5:1b:3c"
+				serial := "SN-1234"
+				objects := []hosts.Host{
+					{ID: "1", Hostname: hosts.Controller0, Personality: hosts.PersonalityController, BootMAC: "08:00:27:00:00:01"},
+					{ID: "2", BootMAC: bootMAC},
+					{ID: "3", BootMAC: "08:00:27:A5:1B:3D", SerialNumber: &serial},
+					{ID: "4"},
+				}
+				list := []starlingxv1.Host{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "worker-0"},
+						Spec:       starlingxv1.HostSpec{Match: &starlingxv1.MatchInfo{BootMAC: &bootMAC}},
+					},
+				}
+
+				result, err := unmatchedHosts(nil, objects, list)
+				Expect(err).To(BeNil())
+				Expect(result).To(HaveLen(1))
+				Expect(result[0].ID).To(Equal("3"))
+
+				host := adoptedHost(result[0], "vbox", "worker-profile")
+				Expect(host.Name).To(Equal("host-080027a51b3d"))
+				Expect(host.Namespace).To(Equal("vbox"))
+				Expect(host.Spec.Profile).To(Equal("worker-profile"))
+				Expect(*host.Spec.Match.BootMAC).To(Equal("08:00:27:A5:1B:3D"))
+				Expect(*host.Spec.Match.DMI.SerialNumber).To(Equal(serial))
+				Expect(host.Annotations).To(HaveKeyWithValue(cloudManager.PausedReconcile, "true"))
+				Expect(host.Annotations).To(HaveKeyWithValue(cloudManager.AdoptedHost, "3"))
+			})
+		})
+
+		Describe("requiredPatches", func() {
+			It("Should only apply the listed patches which are not applied", func() {
+				current := map[string]patches.Patch{
+					"PATCH_0002": {RepoState: patches.StateAvailable},
+					"PATCH_0001": {RepoState: patches.StateApplied},
+					"PATCH_0003": {RepoState: patches.StateCommitted},
+				}
+
+				required, missing := requiredPatches(&starlingxv1.SoftwarePatchInfo{}, current)
+				Expect(required).To(Equal([]string{"PATCH_0001", "PATCH_0002", "PATCH_0003"}))
+				Expect(missing).To(BeEmpty())
+				Expect(pendingPatches(required, current)).To(Equal([]string{"PATCH_0002"}))
+
+				info := &starlingxv1.SoftwarePatchInfo{IDs: []string{"PATCH_0001", "PATCH_0004"}}
+				required, missing = requiredPatches(info, current)
+				Expect(required).To(Equal([]string{"PATCH_0001"}))
+				Expect(missing).To(Equal([]string{"PATCH_0004"}))
+				Expect(pendingPatches(required, current)).To(BeEmpty())
+			})
+		})
+
+		Describe("snapshotProfileName", func() {
+			It("Should name the snapshot after the annotation or the host", func() {
+				instance := &starlingxv1.Host{}
+				instance.Name = "controller-0"
+				instance.Annotations = map[string]string{}
+				Expect(snapshotRequested(instance)).To(BeFalse())
+
+				instance.Annotations[cloudManager.SnapshotProfile] = ""
+				Expect(snapshotRequested(instance)).To(BeTrue())
+				Expect(snapshotProfileName(instance)).To(Equal("controller-0-snapshot"))
+
+				instance.Annotations[cloudManager.SnapshotProfile] = "golden-worker"
+				Expect(snapshotProfileName(instance)).To(Equal("golden-worker"))
+			})
+		})
+
+		Describe("hostClockSyncStatus", func() {
+			It("Should derive the synchronization state from PTP alarms", func() {
+				instances := []ptpinstances.PTPInstance{{Name: "ptp2"}, {Name: "ptp1"}}
+				Expect(hostClockSyncStatus("controller-0", nil, nil)).To(BeNil())
+
+				status := hostClockSyncStatus("controller-0", instances, nil)
+				Expect(status.State).To(Equal(starlingxv1.ClockSyncStateLocked))
+				Expect(status.GNSS).To(BeNil())
+				Expect(status.Instances).To(Equal([]starlingxv1.HostPTPInstanceSyncStatus{
+					{Name: "ptp1", State: starlingxv1.ClockSyncStateLocked},
+					{Name: "ptp2", State: starlingxv1.ClockSyncStateLocked},
+				}))
+
+				active := []alarms.Alarm{
+					{AlarmID: PTPAlarmID, EntityInstanceID: "host=controller-0.instance=ptp1.ptp=no-lock",
+						ReasonText: "controller-0 is not locked to remote PTP Grand Master"},
+					{AlarmID: PTPAlarmID, EntityInstanceID: "host=controller-0.interface=enp1s0.gnss=signal-loss",
+						ReasonText: "controller-0 GNSS signal loss state: holdover"},
+					{AlarmID: PTPAlarmID, EntityInstanceID: "host=controller-1.instance=ptp2.ptp=no-lock",
+						ReasonText: "controller-1 is not locked to remote PTP Grand Master"},
+					{AlarmID: "100.114", EntityInstanceID: "host=controller-0.ntp",
+						ReasonText: "NTP configuration does not contain any valid or reachable NTP servers"},
+				}
+				status = hostClockSyncStatus("controller-0", instances, active)
+				Expect(status.State).To(Equal(starlingxv1.ClockSyncStateFreerun))
+				Expect(*status.GNSS).To(Equal(starlingxv1.ClockSyncStateHoldover))
+				Expect(status.Instances).To(Equal([]starlingxv1.HostPTPInstanceSyncStatus{
+					{Name: "ptp1", State: starlingxv1.ClockSyncStateFreerun},
+					{Name: "ptp2", State: starlingxv1.ClockSyncStateLocked},
+				}))
+			})
+		})
 	})
 })
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package host
 
@@ -21,6 +21,10 @@ func (r *HostReconciler) BuildHostDefaults(instance *starlingxv1.Host, host v1in
 		return nil, err
 	}
 
+	removeDefaultAddresses(instance, defaults)
+	removeDefaultLabels(instance, defaults)
+	removeDefaultAddressing(defaults)
+
 	buffer, err := json.Marshal(defaults)
 	if err != nil {
 		err = perrors.Wrap(err, "failed to marshal host defaults")
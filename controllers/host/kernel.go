@@ -4,6 +4,8 @@
 package host
 
 import (
+	"context"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/kernel"
 	perrors "github.com/pkg/errors"
@@ -28,7 +30,51 @@ func GetKernelUpdateOpts(kernelResult *kernel.Kernel, profile *starlingxv1.HostP
 	return opts, updateRequired
 }
 
-// ReconcileKernel is responsible for reconciling the Memory configuration of a
+// kernelStatusUpdateRequired is a utility function which determines whether
+// the kernel status of the host needs to be updated to reflect the kernel
+// reported by the system.
+func kernelStatusUpdateRequired(instance *starlingxv1.Host, kernelResult *kernel.Kernel) bool {
+	if kernelResult.ProvisionedKernel == "" && kernelResult.RunningKernel == "" {
+		// The system did not report any kernel information for this host.
+		return false
+	}
+
+	status := starlingxv1.HostKernelStatus{
+		Provisioned: kernelResult.ProvisionedKernel,
+		Running:     kernelResult.RunningKernel,
+	}
+
+	if instance.Status.Kernel != nil && instance.Status.Kernel.DeepEqual(&status) {
+		return false
+	}
+
+	instance.Status.Kernel = &status
+
+	return true
+}
+
+// updateKernelStatus is responsible for recording the provisioned and running
+// kernel of a host resource in its status.  A difference between the two
+// indicates that the host must be locked and unlocked before the provisioned
+// kernel takes effect.
+func (r *HostReconciler) updateKernelStatus(instance *starlingxv1.Host, hostinfo *v1info.HostInfo) error {
+	if !kernelStatusUpdateRequired(instance, &hostinfo.Kernel) {
+		return nil
+	}
+
+	logHost.V(2).Info("updating kernel status", "kernel", instance.Status.Kernel)
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update kernel status: %s",
+			utils.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
+// ReconcileKernel is responsible for reconciling the Kernel configuration of a
 // host resource.
 func (r *HostReconciler) ReconcileKernel(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, hostinfo *v1info.HostInfo) error {
 	updated := false
@@ -69,6 +115,11 @@ func (r *HostReconciler) ReconcileKernel(client *gophercloud.ServiceClient, inst
 
 		// update the hostinfo 'cache'
 		hostinfo.Kernel = *kernelResult
+
+		err = r.updateKernelStatus(instance, hostinfo)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,84 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package host
+
+import (
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/cpus"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/kernel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+)
+
+var _ = Describe("Inventory status utils", func() {
+	Describe("kernelStatusUpdateRequired utility", func() {
+		It("should only update the status when the reported kernel changes", func() {
+			tests := []struct {
+				name    string
+				current *starlingxv1.HostKernelStatus
+				result  kernel.Kernel
+				want    bool
+			}{
+				{name: "not-reported",
+					current: nil,
+					result:  kernel.Kernel{},
+					want:    false},
+				{name: "first-report",
+					current: nil,
+					result:  kernel.Kernel{ProvisionedKernel: "standard", RunningKernel: "standard"},
+					want:    true},
+				{name: "unchanged",
+					current: &starlingxv1.HostKernelStatus{Provisioned: "standard", Running: "standard"},
+					result:  kernel.Kernel{ProvisionedKernel: "standard", RunningKernel: "standard"},
+					want:    false},
+				{name: "pending-reboot",
+					current: &starlingxv1.HostKernelStatus{Provisioned: "standard", Running: "standard"},
+					result:  kernel.Kernel{ProvisionedKernel: "lowlatency", RunningKernel: "standard"},
+					want:    true},
+				{name: "rebooted",
+					current: &starlingxv1.HostKernelStatus{Provisioned: "lowlatency", Running: "standard"},
+					result:  kernel.Kernel{ProvisionedKernel: "lowlatency", RunningKernel: "lowlatency"},
+					want:    true},
+			}
+			for _, tt := range tests {
+				instance := &starlingxv1.Host{}
+				instance.Status.Kernel = tt.current
+				Expect(kernelStatusUpdateRequired(instance, &tt.result)).To(Equal(tt.want), tt.name)
+				if tt.want {
+					Expect(instance.Status.Kernel).To(Equal(&starlingxv1.HostKernelStatus{
+						Provisioned: tt.result.ProvisionedKernel,
+						Running:     tt.result.RunningKernel,
+					}), tt.name)
+				} else {
+					Expect(instance.Status.Kernel).To(Equal(tt.current), tt.name)
+				}
+			}
+		})
+	})
+
+	Describe("hardwareStatusUpdateRequired utility", func() {
+		It("should only update the status once inventoried and when the hardware changes", func() {
+			collected := hosts.InventoryCollected
+			hostInfo := &v1info.HostInfo{
+				CPU: []cpus.CPU{{Processor: 0, Thread: 0}, {Processor: 0, Thread: 1}},
+			}
+
+			instance := &starlingxv1.Host{}
+			Expect(hardwareStatusUpdateRequired(instance, hostInfo)).To(BeFalse())
+			Expect(instance.Status.Hardware).To(BeNil())
+
+			hostInfo.InventoryState = &collected
+			Expect(hardwareStatusUpdateRequired(instance, hostInfo)).To(BeTrue())
+			Expect(instance.Status.Hardware).To(Equal(starlingxv1.NewHostHardwareStatus(*hostInfo)))
+
+			Expect(hardwareStatusUpdateRequired(instance, hostInfo)).To(BeFalse())
+
+			hostInfo.CPU = append(hostInfo.CPU, cpus.CPU{Processor: 1, Thread: 0})
+			Expect(hardwareStatusUpdateRequired(instance, hostInfo)).To(BeTrue())
+			Expect(instance.Status.Hardware.Nodes).To(HaveLen(2))
+		})
+	})
+})
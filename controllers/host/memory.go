@@ -4,16 +4,26 @@
 package host
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/alecthomas/units"
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/memory"
 	perrors "github.com/pkg/errors"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"github.com/wind-river/cloud-platform-deployment-manager/common"
 	utils "github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the reasons reported with the PendingReboot host condition.
+const (
+	PendingRebootReasonMemoryChanged = "MemoryChanged"
+	PendingRebootReasonCompleted     = "RebootCompleted"
 )
 
 // vswitchCountMemoryByFunction returns the number of pages of a particular size
@@ -144,6 +154,106 @@ func memoryUpdateRequired(f starlingxv1.MemoryFunctionInfo, count int) (opts mem
 	return opts, result
 }
 
+// profileMemoryCount returns the number of pages of a particular size that the
+// profile requests for a function on a NUMA node, and whether the profile
+// includes any entry at all for that function on the node.
+func profileMemoryCount(profile *starlingxv1.HostProfileSpec, node int, function string, pagesize starlingxv1.PageSize) (count int, found bool, functionFound bool) {
+	for _, nodeInfo := range profile.Memory {
+		if nodeInfo.Node != node {
+			continue
+		}
+
+		for _, f := range nodeInfo.Functions {
+			if f.Function != function {
+				continue
+			}
+
+			functionFound = true
+			if starlingxv1.PageSize(f.PageSize) == pagesize {
+				count += f.PageCount
+				found = true
+			}
+		}
+	}
+
+	return count, found, functionFound
+}
+
+// desiredMemoryMegabytes returns the amount of memory, in MiB, that will be
+// assigned to a function on a NUMA node once the profile has been applied.
+// Page sizes that are not present in the profile retain their current
+// allocation.  The vswitch only uses a single page size so any vswitch entry in
+// the profile replaces the current allocation entirely.
+func desiredMemoryMegabytes(profile *starlingxv1.HostProfileSpec, memories []memory.Memory, node int, function string) (int, error) {
+	pageSizes := []starlingxv1.PageSize{starlingxv1.PageSize2M, starlingxv1.PageSize1G}
+	if function == memory.MemoryFunctionPlatform {
+		pageSizes = []starlingxv1.PageSize{starlingxv1.PageSize4K}
+	}
+
+	total := 0
+	for _, pageSize := range pageSizes {
+		count, found, functionFound := profileMemoryCount(profile, node, function, pageSize)
+		if !found {
+			if function == memory.MemoryFunctionVSwitch && functionFound {
+				continue
+			}
+
+			var err error
+			count, err = memoryCountByFunction(memories, node, function, pageSize)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		total += count * pageSize.Bytes() / int(units.Mebibyte)
+	}
+
+	return total, nil
+}
+
+// validateMemory ensures that the requested hugepage allocations can be
+// satisfied by the memory reported on each NUMA node.  Nodes for which the
+// inventory has not yet been collected are skipped and left to the system API
+// to validate.
+func validateMemory(profile *starlingxv1.HostProfileSpec, memories []memory.Memory) error {
+	for _, nodeInfo := range profile.Memory {
+		total := 0
+		for _, mem := range memories {
+			if mem.Processor == nodeInfo.Node {
+				total += mem.Total
+			}
+		}
+
+		if total == 0 {
+			continue
+		}
+
+		platform, err := desiredMemoryMegabytes(profile, memories, nodeInfo.Node, memory.MemoryFunctionPlatform)
+		if err != nil {
+			return err
+		}
+
+		required := 0
+		for _, function := range []string{memory.MemoryFunctionVM, memory.MemoryFunctionVSwitch} {
+			size, err := desiredMemoryMegabytes(profile, memories, nodeInfo.Node, function)
+			if err != nil {
+				return err
+			}
+
+			required += size
+		}
+
+		available := total - platform
+		if required > available {
+			msg := fmt.Sprintf("hugepage allocations on node %d require %d MiB but only %d MiB are available after reserving %d MiB for the platform; reduce the allocations by at least %d MiB",
+				nodeInfo.Node, required, available, platform, required-available)
+			return utils.NewUserDataError(msg)
+		}
+	}
+
+	return nil
+}
+
 // ReconcileMemory is responsible for reconciling the Memory configuration of a
 // host resource.
 func (r *HostReconciler) ReconcileMemory(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
@@ -160,6 +270,11 @@ func (r *HostReconciler) ReconcileMemory(client *gophercloud.ServiceClient, inst
 		return err
 	}
 
+	err = validateMemory(profile, objects)
+	if err != nil {
+		return err
+	}
+
 	for _, nodeInfo := range profile.Memory {
 		// For each NUMA node configuration
 		mem, ok := host.FindMemory(nodeInfo.Node)
@@ -206,3 +321,123 @@ func (r *HostReconciler) ReconcileMemory(client *gophercloud.ServiceClient, inst
 
 	return nil
 }
+
+// memoryChanged determines whether the memory configuration in the
+// profile differs from the current memory configuration of the host.
+func memoryChanged(profile *starlingxv1.HostProfileSpec, current *starlingxv1.HostProfileSpec) bool {
+	if !common.IsReconcilerEnabled(common.Memory) {
+		return false
+	}
+
+	return !profile.Memory.DeepEqual(&current.Memory)
+}
+
+// setPendingRebootCondition updates the PendingReboot condition on the host
+// status and persists it if it has changed.
+func (r *HostReconciler) setPendingRebootCondition(instance *starlingxv1.Host, status metav1.ConditionStatus, reason, message string) error {
+	existing := meta.FindStatusCondition(instance.Status.Conditions, starlingxv1.ConditionPendingReboot)
+	if existing == nil && status == metav1.ConditionFalse {
+		// Nothing has ever been pending so there is nothing to report.
+		return nil
+	} else if existing != nil && existing.Status == status && existing.Reason == reason {
+		return nil
+	}
+
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:               starlingxv1.ConditionPendingReboot,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: instance.Generation,
+	})
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			utils.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
+// memoryRebootAllowed determines whether an enabled host may be locked
+// automatically to apply memory allocation changes.  The setting of the host
+// takes precedence over the "allowReboot" option of the memory reconciler.
+func memoryRebootAllowed(instance *starlingxv1.Host, host *v1info.HostInfo) bool {
+	if !host.IsUnlockedEnabled() {
+		return false
+	}
+
+	if instance.Spec.AllowReboot != nil {
+		return *instance.Spec.AllowReboot
+	}
+
+	return common.GetReconcilerOptionBool(common.Memory, common.AllowReboot, false)
+}
+
+// ReconcileMemoryPendingReboot is responsible for tracking hugepage changes
+// that have been requested on an enabled host.  Memory allocations can only be
+// changed while the host is locked and only take effect once it is unlocked.
+// The PendingReboot condition is raised until the host returns to the enabled
+// state with the requested allocations.  If the memory reconciler is
+// configured to allow reboots, or the host itself allows them, then the host
+// is locked automatically so that the change can be applied; otherwise the
+// lock is left to the operator or to the Day-2 strategy.
+func (r *HostReconciler) ReconcileMemoryPendingReboot(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, current *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	if !profile.HasWorkerSubFunction() {
+		return nil
+	}
+
+	if !memoryChanged(profile, current) {
+		if host.IsUnlockedEnabled() {
+			return r.setPendingRebootCondition(instance, metav1.ConditionFalse,
+				PendingRebootReasonCompleted, "memory allocations are in effect")
+		}
+
+		return nil
+	}
+
+	err := r.setPendingRebootCondition(instance, metav1.ConditionTrue,
+		PendingRebootReasonMemoryChanged,
+		"memory allocation changes require the host to be locked and unlocked")
+	if err != nil {
+		return err
+	}
+
+	if !memoryRebootAllowed(instance, host) {
+		// The lock is left to the operator or to the deployment strategy.
+		return nil
+	}
+
+	err = r.ReconcilePreflight(instance, hosts.ActionLock)
+	if err != nil {
+		return err
+	}
+
+	err = r.ReconcileSwact(client, instance, &host.Host)
+	if err != nil {
+		return err
+	}
+
+	action := hosts.ActionLock
+	opts := hosts.HostOpts{
+		Action: &action,
+	}
+
+	logHost.Info("locking host to apply memory changes", "opts", opts)
+
+	result, err := hosts.Update(client, host.ID, opts).Extract()
+	if err != nil || result == nil {
+		err = perrors.Wrapf(err, "failed to lock host: %s, %s",
+			host.ID, utils.FormatStruct(opts))
+		return err
+	}
+
+	host.Host = *result
+
+	r.NormalEvent(instance, utils.ResourceUpdated,
+		"host has been locked to apply memory allocation changes")
+
+	return utils.NewResourceStatusDependency("waiting for host to lock before applying memory changes")
+}
@@ -0,0 +1,71 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package host
+
+import (
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/memory"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+)
+
+var _ = Describe("Memory utils", func() {
+	Describe("validateMemory utility", func() {
+		// Node 0 has 16 GiB of which 4 GiB are currently reserved for the
+		// platform and 2 GiB are allocated to 1G vm hugepages.
+		memories := []memory.Memory{
+			{Processor: 0, Total: 16384, Platform: 4096, VM1GHugepagesCount: 2},
+		}
+
+		It("should enforce the over-commit boundary", func() {
+			tests := []struct {
+				name      string
+				functions starlingxv1.MemoryFunctionList
+				wantErr   bool
+			}{
+				{name: "current-platform-exact-fit",
+					functions: starlingxv1.MemoryFunctionList{
+						{Function: memory.MemoryFunctionVM, PageSize: string(starlingxv1.PageSize1G), PageCount: 12}}},
+				{name: "current-platform-over-commit",
+					functions: starlingxv1.MemoryFunctionList{
+						{Function: memory.MemoryFunctionVM, PageSize: string(starlingxv1.PageSize1G), PageCount: 12},
+						{Function: memory.MemoryFunctionVM, PageSize: string(starlingxv1.PageSize2M), PageCount: 1}},
+					wantErr: true},
+				{name: "reduced-platform-fit",
+					functions: starlingxv1.MemoryFunctionList{
+						{Function: memory.MemoryFunctionPlatform, PageSize: string(starlingxv1.PageSize4K), PageCount: 786432},
+						{Function: memory.MemoryFunctionVM, PageSize: string(starlingxv1.PageSize1G), PageCount: 13}}},
+				{name: "increased-platform-over-commit",
+					functions: starlingxv1.MemoryFunctionList{
+						{Function: memory.MemoryFunctionPlatform, PageSize: string(starlingxv1.PageSize4K), PageCount: 1048832},
+						{Function: memory.MemoryFunctionVM, PageSize: string(starlingxv1.PageSize1G), PageCount: 12}},
+					wantErr: true},
+				{name: "current-vm-with-vswitch-over-commit",
+					functions: starlingxv1.MemoryFunctionList{
+						{Function: memory.MemoryFunctionVSwitch, PageSize: string(starlingxv1.PageSize1G), PageCount: 11}},
+					wantErr: true},
+			}
+			for _, tt := range tests {
+				profile := &starlingxv1.HostProfileSpec{
+					Memory: starlingxv1.MemoryNodeList{{Node: 0, Functions: tt.functions}},
+				}
+				err := validateMemory(profile, memories)
+				if tt.wantErr {
+					Expect(err).To(BeAssignableToTypeOf(common.ErrUserDataError{}), tt.name)
+				} else {
+					Expect(err).To(BeNil(), tt.name)
+				}
+			}
+		})
+
+		It("should skip nodes without inventory", func() {
+			profile := &starlingxv1.HostProfileSpec{
+				Memory: starlingxv1.MemoryNodeList{{Node: 1, Functions: starlingxv1.MemoryFunctionList{
+					{Function: memory.MemoryFunctionVM, PageSize: string(starlingxv1.PageSize1G), PageCount: 64}}}},
+			}
+			Expect(validateMemory(profile, memories)).To(BeNil())
+		})
+	})
+})
@@ -15,6 +15,7 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagetiers"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/deviceimages"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -72,6 +73,60 @@ func (m *partitionStateMonitor) Run(client *gophercloud.ServiceClient) (stop boo
 	return true, nil
 }
 
+// DefaultDeviceImageUpdateMonitorInterval represents the default interval
+// between polling attempts to check the device image write state on a host.
+// Writing an FPGA image can take tens of minutes so this interval is kept long.
+const DefaultDeviceImageUpdateMonitorInterval = time.Minute
+
+// deviceImageUpdateMonitor defines a monitor that can check the state of the
+// device image writes on a host and signal the host reconciler once all of
+// the writes have finished.
+type deviceImageUpdateMonitor struct {
+	manager.CommonMonitorBody
+	id string
+}
+
+// NewDeviceImageUpdateMonitor defines a convenience function to instantiate
+// a new device image update monitor with all required attributes.
+func NewDeviceImageUpdateMonitor(instance *starlingxv1.Host, id string) *manager.Monitor {
+	logger := logHost.WithName("device-image-monitor")
+	return &manager.Monitor{
+		MonitorBody: &deviceImageUpdateMonitor{
+			id: id,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultDeviceImageUpdateMonitorInterval,
+	}
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *deviceImageUpdateMonitor) Run(client *gophercloud.ServiceClient) (stop bool, err error) {
+	objects, err := deviceimages.ListDeviceImageStates(client)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to get device image states: %s", err.Error())
+		return false, err
+	}
+
+	for _, s := range objects {
+		if s.HostID != m.id {
+			continue
+		}
+
+		switch s.Status {
+		case deviceimages.StatusPending, deviceimages.StatusInProgress:
+			m.CommonMonitorBody.SetState("waiting for device image write on device %q", s.PCIDeviceID)
+			return false, nil
+		}
+	}
+
+	m.CommonMonitorBody.SetState("all device image writes have finished")
+
+	return true, nil
+}
+
 // DefaultPartitionMonitorInterval represents the default interval between
 // polling attempts to check whether a cluster exists or not.  A user may
 // need to intervene to create a cluster so set this to a value long enough
@@ -456,6 +511,63 @@ func (m *stableHostMonitor) Run(client *gophercloud.ServiceClient) (stop bool, e
 	return true, nil
 }
 
+// DefaultSwactMonitorInterval represents the default interval between polling
+// attempts to check whether a controller swact has completed.
+const DefaultSwactMonitorInterval = 30 * time.Second
+
+// swactMonitor waits for the services of the active controller to have been
+// transferred to the standby controller.  Once the host is no longer the
+// active controller and its peer has taken over a reconcilable event is
+// generated to kick the reconciler.
+type swactMonitor struct {
+	manager.CommonMonitorBody
+	hostID string
+}
+
+// NewSwactMonitor defines a convenience function to instantiate a new swact
+// monitor with all required attributes.
+func NewSwactMonitor(instance *starlingxv1.Host, id string) *manager.Monitor {
+	logger := logHost.WithName("swact-monitor")
+	return &manager.Monitor{
+		MonitorBody: &swactMonitor{
+			hostID: id,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultSwactMonitorInterval,
+	}
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitoring one or more resources and returning true when all conditions
+// are satisfied.
+func (m *swactMonitor) Run(client *gophercloud.ServiceClient) (stop bool, err error) {
+	objects, err := hosts.ListHosts(client)
+	if err != nil {
+		// The system API is expected to be briefly unavailable while it moves
+		// to the other controller.
+		m.CommonMonitorBody.SetState("failed to list hosts: %s", err.Error())
+		return false, nil
+	}
+
+	for _, host := range objects {
+		if host.ID == m.hostID {
+			if !host.Idle() || isActiveController(host) {
+				m.CommonMonitorBody.SetState("waiting for host to become the standby controller")
+				return false, nil
+			}
+
+		} else if isActiveController(host) && !host.IsUnlockedAvailable() {
+			m.CommonMonitorBody.SetState("waiting for %s to become available", host.Hostname)
+			return false, nil
+		}
+	}
+
+	m.CommonMonitorBody.SetState("controller services have been transferred")
+
+	return true, nil
+}
+
 // DefaultInventoryCollectedMonitorInterval represents the default interval
 // between polling attempts to check whether a host has reached the desired
 // state before beginning to collect default values.
@@ -646,7 +758,13 @@ func (m *dynamicHostMonitor) Run(client *gophercloud.ServiceClient) (stop bool,
 		return false, err
 	}
 
-	host := FindExistingHost(objects, m.hostname, m.match, m.bootMAC)
+	bmMACs, err := boardManagementMACs(client, m.match)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to query host details: %s", err.Error())
+		return false, err
+	}
+
+	host := FindExistingHost(objects, bmMACs, m.hostname, m.match, m.bootMAC)
 	if host != nil {
 		m.CommonMonitorBody.SetState("host inventory record has been found for %q", m.hostname)
 		return true, nil
@@ -4,6 +4,7 @@
 package host
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -192,10 +193,11 @@ func findConfiguredRoute(route routes.Route, profile *starlingxv1.HostProfileSpe
 		if x.Interface == route.InterfaceName &&
 			strings.EqualFold(x.Network, route.Network) &&
 			x.Prefix == route.Prefix &&
-			strings.EqualFold(x.Gateway, route.Gateway) &&
-			(x.Metric == nil || *x.Metric == route.Metric) {
-			// Routes cannot be updated so all fields must match
-			// otherwise re-provisioning is required.
+			strings.EqualFold(x.Gateway, route.Gateway) {
+			// The metric is not considered here since a metric change is
+			// reconciled by ReconcileRoutes without first removing the
+			// route.  All other fields must match otherwise
+			// re-provisioning is required.
 			return &x, true
 		}
 	}
@@ -347,6 +349,80 @@ func (r *HostReconciler) ReconcileStalePTPInterfaces(client *gophercloud.Service
 	return nil
 }
 
+// managedAddressKey returns the key used to record an address created by the
+// Deployment Manager in the host status.
+func managedAddressKey(address string, prefix int) string {
+	return fmt.Sprintf("%s/%d", address, prefix)
+}
+
+// updateManagedAddresses persists the list of addresses owned by the
+// Deployment Manager to the host status.
+func (r *HostReconciler) updateManagedAddresses(instance *starlingxv1.Host) error {
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to update managed addresses")
+		return err
+	}
+
+	return nil
+}
+
+// removeDefaultAddresses removes any addresses owned by the Deployment Manager
+// from the set of default attributes.  Defaults may be re-collected after
+// user addresses have already been provisioned and those addresses must not
+// be treated as platform defaults otherwise they would never be removed.
+func removeDefaultAddresses(instance *starlingxv1.Host, defaults *starlingxv1.HostProfileSpec) {
+	if defaults.Addresses == nil || len(instance.Status.ManagedAddresses) == 0 {
+		return
+	}
+
+	result := make([]starlingxv1.AddressInfo, 0)
+	for _, a := range defaults.Addresses {
+		key := managedAddressKey(a.Address, a.Prefix)
+		if !utils.ContainsString(instance.Status.ManagedAddresses, key) {
+			result = append(result, a)
+		}
+	}
+
+	defaults.Addresses = result
+}
+
+// clearInterfaceAddressing removes the addressing mode attributes from an
+// interface.
+func clearInterfaceAddressing(info *starlingxv1.CommonInterfaceInfo) {
+	info.IPv4Mode = nil
+	info.IPv4Pool = nil
+	info.IPv6Mode = nil
+	info.IPv6Pool = nil
+}
+
+// removeDefaultAddressing removes the interface addressing modes from the set
+// of default attributes.  Unless explicitly requested by the user the
+// addressing modes are derived from the configured addresses and networks so
+// the values collected from the system must not be carried forward into the
+// final profile.
+func removeDefaultAddressing(defaults *starlingxv1.HostProfileSpec) {
+	if defaults.Interfaces == nil {
+		return
+	}
+
+	for i := range defaults.Interfaces.Ethernet {
+		clearInterfaceAddressing(&defaults.Interfaces.Ethernet[i].CommonInterfaceInfo)
+	}
+
+	for i := range defaults.Interfaces.VLAN {
+		clearInterfaceAddressing(&defaults.Interfaces.VLAN[i].CommonInterfaceInfo)
+	}
+
+	for i := range defaults.Interfaces.Bond {
+		clearInterfaceAddressing(&defaults.Interfaces.Bond[i].CommonInterfaceInfo)
+	}
+
+	for i := range defaults.Interfaces.VF {
+		clearInterfaceAddressing(&defaults.Interfaces.VF[i].CommonInterfaceInfo)
+	}
+}
+
 // ReconcileStaleAddresses examines the current set of addresses and deletes
 // any addresses that are stale or need to be re-provisioned.  An address needs
 // to be deleted if:
@@ -354,6 +430,8 @@ func (r *HostReconciler) ReconcileStalePTPInterfaces(client *gophercloud.Service
 //	A) The system address does not have an equivalent configured entry
 //	B) The configured address has moved to a different underlying interface
 //	C) The underlying interface needs to be deleted and re-added.
+//
+// Addresses allocated by the platform from an address pool are never deleted.
 func (r *HostReconciler) ReconcileStaleAddresses(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
 	updated := false
 
@@ -361,8 +439,10 @@ func (r *HostReconciler) ReconcileStaleAddresses(client *gophercloud.ServiceClie
 		return nil
 	}
 
+	managed := false
+
 	for _, addr := range host.Addresses {
-		if addr.PoolUUID != nil {
+		if host.IsSystemAddress(&addr) {
 			// Automatically assigned addresses should be ignored.  The system
 			// will remove them when the pool is removed from the interface.
 			continue
@@ -408,10 +488,23 @@ func (r *HostReconciler) ReconcileStaleAddresses(client *gophercloud.ServiceClie
 			r.NormalEvent(instance, common.ResourceDeleted,
 				"stale address '%s/%d' has been deleted", addr.Address, addr.Prefix)
 
+			key := managedAddressKey(addr.Address, addr.Prefix)
+			if utils.ContainsString(instance.Status.ManagedAddresses, key) {
+				instance.Status.ManagedAddresses = utils.RemoveString(instance.Status.ManagedAddresses, key)
+				managed = true
+			}
+
 			updated = true
 		}
 	}
 
+	if managed {
+		err := r.updateManagedAddresses(instance)
+		if err != nil {
+			return err
+		}
+	}
+
 	if updated {
 		results, err := addresses.ListAddresses(client, host.ID)
 		if err != nil {
@@ -716,7 +809,20 @@ func hasIPv6DynamicAddresses(info starlingxv1.CommonInterfaceInfo, host *v1info.
 func getInterfaceIPv4Addressing(info starlingxv1.CommonInterfaceInfo, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) (mode string, pool *string) {
 	var ok bool
 
-	if hasIPv4StaticAddresses(info, profile) {
+	if info.IPv4Mode != nil {
+		// The user has explicitly requested an addressing mode so use it
+		// rather than trying to infer it from the addresses and networks.
+		mode = *info.IPv4Mode
+		if mode == interfaces.AddressModePool {
+			if info.IPv4Pool != nil {
+				if obj := host.FindAddressPoolByName(*info.IPv4Pool); obj != nil {
+					pool = &obj.ID
+				}
+			} else {
+				pool, _ = hasIPv4DynamicAddresses(info, host)
+			}
+		}
+	} else if hasIPv4StaticAddresses(info, profile) {
 		mode = interfaces.AddressModeStatic
 	} else if pool, ok = hasIPv4DynamicAddresses(info, host); ok {
 		mode = interfaces.AddressModePool
@@ -732,7 +838,20 @@ func getInterfaceIPv4Addressing(info starlingxv1.CommonInterfaceInfo, profile *s
 func getInterfaceIPv6Addressing(info starlingxv1.CommonInterfaceInfo, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) (mode string, pool *string) {
 	var ok bool
 
-	if hasIPv6StaticAddresses(info, profile) {
+	if info.IPv6Mode != nil {
+		// The user has explicitly requested an addressing mode so use it
+		// rather than trying to infer it from the addresses and networks.
+		mode = *info.IPv6Mode
+		if mode == interfaces.AddressModePool {
+			if info.IPv6Pool != nil {
+				if obj := host.FindAddressPoolByName(*info.IPv6Pool); obj != nil {
+					pool = &obj.ID
+				}
+			} else {
+				pool, _ = hasIPv6DynamicAddresses(info, host)
+			}
+		}
+	} else if hasIPv6StaticAddresses(info, profile) {
 		mode = interfaces.AddressModeStatic
 	} else if pool, ok = hasIPv6DynamicAddresses(info, host); ok {
 		mode = interfaces.AddressModePool
@@ -743,6 +862,30 @@ func getInterfaceIPv6Addressing(info starlingxv1.CommonInterfaceInfo, profile *s
 	return mode, pool
 }
 
+// interfaceClassChanges returns the names of the system interfaces whose class
+// differs from the one configured in the profile.
+func interfaceClassChanges(profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) []string {
+	result := make([]string, 0)
+	if profile.Interfaces == nil {
+		return result
+	}
+
+	for i := range host.Interfaces {
+		iface := &host.Interfaces[i]
+		if info, found := findConfiguredInterface(iface, profile, host); found {
+			if strings.EqualFold(info.Class, iface.Class) {
+				continue
+			} else if info.Class == interfaces.IFClassNone && iface.Class == "" {
+				continue
+			}
+
+			result = append(result, iface.Name)
+		}
+	}
+
+	return result
+}
+
 // interfaceUpdateRequired is a utility function which determines whether the
 // common interface attributes have changed and if so fills in the opts struct
 // with the values that must be passed to the system API.
@@ -775,10 +918,12 @@ func interfaceUpdateRequired(info starlingxv1.CommonInterfaceInfo, iface *interf
 		result = true
 	}
 
-	if info.Class == interfaces.IFClassData || hasIPv4StaticAddresses(info, profile) || hasIPv6StaticAddresses(info, profile) {
+	explicit := info.IPv4Mode != nil || info.IPv6Mode != nil
+	if info.Class == interfaces.IFClassData || explicit || hasIPv4StaticAddresses(info, profile) || hasIPv6StaticAddresses(info, profile) {
 		// TODO(alegacy): We might need to remove this restriction and manage
 		//  these attributes for other interface classes, but for now limit our
-		//  handling of these for data interfaces only.
+		//  handling of these for data interfaces only unless the addressing
+		//  mode was explicitly requested.
 
 		mode, pool := getInterfaceIPv4Addressing(info, profile, host)
 		if iface.IPv4Mode == nil && mode != interfaces.AddressModeDisabled ||
@@ -794,17 +939,19 @@ func interfaceUpdateRequired(info starlingxv1.CommonInterfaceInfo, iface *interf
 			result = true
 		}
 
-		mode, pool = getInterfaceIPv6Addressing(info, profile, host)
-		if iface.IPv6Mode == nil && mode != interfaces.AddressModeDisabled ||
-			iface.IPv6Mode != nil && mode != *iface.IPv6Mode {
-			opts.IPv6Mode = &mode
+		// Use separate variables for IPv6 so that the IPv4 options which
+		// reference the values above are not overwritten.
+		mode6, pool6 := getInterfaceIPv6Addressing(info, profile, host)
+		if iface.IPv6Mode == nil && mode6 != interfaces.AddressModeDisabled ||
+			iface.IPv6Mode != nil && mode6 != *iface.IPv6Mode {
+			opts.IPv6Mode = &mode6
 			result = true
 		}
-		if pool == nil && iface.IPv6Pool != nil || pool != nil && iface.IPv6Pool == nil {
-			opts.IPv6Pool = pool
+		if pool6 == nil && iface.IPv6Pool != nil || pool6 != nil && iface.IPv6Pool == nil {
+			opts.IPv6Pool = pool6
 			result = true
-		} else if pool != nil && iface.IPv6Pool != nil && *pool != *iface.IPv6Pool {
-			opts.IPv6Pool = pool
+		} else if pool6 != nil && iface.IPv6Pool != nil && *pool6 != *iface.IPv6Pool {
+			opts.IPv6Pool = pool6
 			result = true
 		}
 	}
@@ -1393,6 +1540,13 @@ func (r *HostReconciler) ReconcileBondInterfaces(client *gophercloud.ServiceClie
 					return err
 				}
 
+				if opts.UsesModify != nil {
+					added, removed, _ := utils.ListDelta(iface.Uses, bondInfo.Members)
+					r.NormalEvent(instance, common.ResourceUpdated,
+						"bond interface %q members have been repaired; added: %v, removed: %v",
+						bondInfo.Name, added, removed)
+				}
+
 				r.NormalEvent(instance, common.ResourceUpdated,
 					"ethernet interface %q has been updated", bondInfo.Name)
 
@@ -1838,10 +1992,20 @@ func (r *HostReconciler) ReconcileAddresses(client *gophercloud.ServiceClient, i
 		r.NormalEvent(instance, common.ResourceCreated,
 			"address '%s/%d' has been created", addrInfo.Address, addrInfo.Prefix)
 
+		key := managedAddressKey(addrInfo.Address, addrInfo.Prefix)
+		if !utils.ContainsString(instance.Status.ManagedAddresses, key) {
+			instance.Status.ManagedAddresses = append(instance.Status.ManagedAddresses, key)
+		}
+
 		updated = true
 	}
 
 	if updated {
+		err := r.updateManagedAddresses(instance)
+		if err != nil {
+			return err
+		}
+
 		objects, err := addresses.ListAddresses(client, host.ID)
 		if err != nil {
 			err = perrors.Wrapf(err, "failed to refresh addresses for hostid: %s",
@@ -1863,8 +2027,13 @@ func (r *HostReconciler) ReconcileRoutes(client *gophercloud.ServiceClient, inst
 	}
 
 	for _, routeInfo := range profile.Routes {
-		_, found := host.FindRouteUUID(routeInfo.Interface, routeInfo.Network, routeInfo.Prefix)
-		if found {
+		metric := routes.DefaultMetric
+		if routeInfo.Metric != nil {
+			metric = *routeInfo.Metric
+		}
+
+		existing, found := host.FindRouteUUID(routeInfo.Interface, routeInfo.Network, routeInfo.Prefix)
+		if found && existing.Metric == metric {
 			continue
 		}
 
@@ -1879,17 +2048,24 @@ func (r *HostReconciler) ReconcileRoutes(client *gophercloud.ServiceClient, inst
 			Prefix:        &routeInfo.Prefix,
 			Gateway:       &routeInfo.Gateway,
 			InterfaceUUID: &iface.ID,
+			Metric:        &metric,
 		}
 
-		if routeInfo.Metric != nil {
-			opts.Metric = routeInfo.Metric
+		if existing != nil {
+			// The system API does not support modifying a route therefore a
+			// metric change is applied by replacing the route.
+			logHost.Info("replacing route to update its metric", "uuid", existing.ID,
+				"old", existing.Metric, "new", metric)
+
+			err := routes.Delete(client, existing.ID).ExtractErr()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to delete route %s", existing.ID)
+				return err
+			}
 		} else {
-			metric := routes.DefaultMetric
-			opts.Metric = &metric
+			logHost.Info("creating route", "opts", opts)
 		}
 
-		logHost.Info("creating route", "opts", opts)
-
 		_, err := routes.Create(client, opts).Extract()
 		if err != nil {
 			err = perrors.Wrapf(err, "failed to create route %s",
@@ -1897,9 +2073,15 @@ func (r *HostReconciler) ReconcileRoutes(client *gophercloud.ServiceClient, inst
 			return err
 		}
 
-		r.NormalEvent(instance, common.ResourceCreated,
-			"route '%s/%d' via %q has been created",
-			routeInfo.Network, routeInfo.Prefix, routeInfo.Gateway)
+		if existing != nil {
+			r.NormalEvent(instance, common.ResourceUpdated,
+				"route '%s/%d' via %q metric has been updated from %d to %d",
+				routeInfo.Network, routeInfo.Prefix, routeInfo.Gateway, existing.Metric, metric)
+		} else {
+			r.NormalEvent(instance, common.ResourceCreated,
+				"route '%s/%d' via %q has been created",
+				routeInfo.Network, routeInfo.Prefix, routeInfo.Gateway)
+		}
 
 		updated = true
 	}
@@ -1927,6 +2109,13 @@ func (r *HostReconciler) ReconcileNetworking(client *gophercloud.ServiceClient,
 		return nil
 	}
 
+	// The system API only accepts interface class changes on locked hosts.
+	if changed := interfaceClassChanges(profile, host); len(changed) > 0 && !host.IsLockedDisabled() {
+		msg := fmt.Sprintf("waiting for the host to be locked to change the class of interfaces: %s",
+			strings.Join(changed, ", "))
+		return common.NewResourceStatusDependency(msg)
+	}
+
 	// Remove stale routes or routes on addresses that will be updated.
 	err = r.ReconcileStaleRoutes(client, instance, profile, host)
 	if err != nil {
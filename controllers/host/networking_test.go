@@ -9,6 +9,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresses"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresspools"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/ports"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/routes"
 
@@ -500,7 +502,7 @@ var _ = Describe("Networking utils", func() {
 						want:  &sample.Routes[2],
 						want1: true,
 					},
-					{name: "find-ipv4-with-wrong-metric",
+					{name: "find-ipv4-with-different-metric",
 						args: args{
 							route: routes.Route{
 								ID:            "uuid10",
@@ -512,8 +514,8 @@ var _ = Describe("Networking utils", func() {
 							},
 							profile: &sample,
 						},
-						want:  nil,
-						want1: false,
+						want:  &sample.Routes[2],
+						want1: true,
 					},
 					{name: "find-ipv4-with-wrong-gateway",
 						args: args{
@@ -787,4 +789,153 @@ var _ = Describe("Networking utils", func() {
 			})
 		})
 	})
+	Describe("removeDefaultAddresses utility", func() {
+		Context("with managed addresses", func() {
+			It("should only remove managed addresses from the defaults", func() {
+				defaults := starlingxv1.HostProfileSpec{
+					Addresses: starlingxv1.AddressList{
+						starlingxv1.AddressInfo{
+							Interface: "eth0",
+							Address:   "10.10.10.10",
+							Prefix:    24,
+						},
+						starlingxv1.AddressInfo{
+							Interface: "eth1",
+							Address:   "fd00:1::10",
+							Prefix:    64,
+						},
+					},
+				}
+				instance := starlingxv1.Host{
+					Status: starlingxv1.HostStatus{
+						ManagedAddresses: []string{"fd00:1::10/64", "11.11.11.10/24"},
+					},
+				}
+				expected := starlingxv1.AddressList{defaults.Addresses[0]}
+
+				removeDefaultAddresses(&instance, &defaults)
+				Expect(reflect.DeepEqual(defaults.Addresses, expected)).To(BeTrue())
+			})
+		})
+		Context("without managed addresses", func() {
+			It("should leave the defaults unchanged", func() {
+				defaults := starlingxv1.HostProfileSpec{
+					Addresses: starlingxv1.AddressList{
+						starlingxv1.AddressInfo{
+							Interface: "eth0",
+							Address:   "10.10.10.10",
+							Prefix:    24,
+						},
+					},
+				}
+				expected := defaults.DeepCopy()
+
+				removeDefaultAddresses(&starlingxv1.Host{}, &defaults)
+				Expect(reflect.DeepEqual(&defaults, expected)).To(BeTrue())
+			})
+		})
+	})
+	Describe("getInterfaceIPv4Addressing utility", func() {
+		Context("with an explicit addressing mode", func() {
+			It("should use the requested mode and pool", func() {
+				mode := "pool"
+				poolName := "data-pool"
+				info := starlingxv1.CommonInterfaceInfo{
+					Name:     "data0",
+					Class:    "data",
+					IPv4Mode: &mode,
+					IPv4Pool: &poolName,
+				}
+				host := v1info.HostInfo{
+					Pools: []addresspools.AddressPool{
+						{ID: "pool-uuid", Name: poolName, Network: "192.168.1.0"},
+					},
+				}
+				gotMode, gotPool := getInterfaceIPv4Addressing(info, &starlingxv1.HostProfileSpec{}, &host)
+				Expect(gotMode).To(Equal("pool"))
+				Expect(gotPool).ToNot(BeNil())
+				Expect(*gotPool).To(Equal("pool-uuid"))
+			})
+		})
+		Context("without an explicit addressing mode", func() {
+			It("should derive the mode from the static addresses", func() {
+				info := starlingxv1.CommonInterfaceInfo{
+					Name:  "data0",
+					Class: "data",
+				}
+				profile := starlingxv1.HostProfileSpec{
+					Addresses: starlingxv1.AddressList{
+						starlingxv1.AddressInfo{
+							Interface: "data0",
+							Address:   "10.10.10.10",
+							Prefix:    24,
+						},
+					},
+				}
+				gotMode, gotPool := getInterfaceIPv4Addressing(info, &profile, &v1info.HostInfo{})
+				Expect(gotMode).To(Equal("static"))
+				Expect(gotPool).To(BeNil())
+			})
+		})
+	})
+
+	Describe("interfaceClassChanges utility", func() {
+		profile := starlingxv1.HostProfileSpec{
+			Interfaces: &starlingxv1.InterfaceInfo{
+				Ethernet: starlingxv1.EthernetList{
+					{
+						CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+							Name:  "data0",
+							Class: "pci-sriov",
+						},
+						Port: starlingxv1.EthernetPortInfo{Name: "eth1"},
+					},
+					{
+						CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+							Name:  "mgmt0",
+							Class: "platform",
+						},
+						Port: starlingxv1.EthernetPortInfo{Name: "eth0"},
+					},
+					{
+						CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+							Name:  "eth2",
+							Class: "none",
+						},
+						Port: starlingxv1.EthernetPortInfo{Name: "eth2"},
+					},
+				},
+			},
+		}
+		host := v1info.HostInfo{
+			Interfaces: []interfaces.Interface{
+				{ID: "if0", Name: "mgmt0", Type: interfaces.IFTypeEthernet, Class: "platform"},
+				{ID: "if1", Name: "data0", Type: interfaces.IFTypeEthernet, Class: "data"},
+				{ID: "if2", Name: "eth2", Type: interfaces.IFTypeEthernet, Class: ""},
+			},
+			Ports: []ports.Port{
+				{Name: "eth0", InterfaceID: "if0"},
+				{Name: "eth1", InterfaceID: "if1"},
+				{Name: "eth2", InterfaceID: "if2"},
+			},
+		}
+
+		Context("with an interface changing class", func() {
+			It("should only report that interface", func() {
+				Expect(interfaceClassChanges(&profile, &host)).To(Equal([]string{"data0"}))
+			})
+		})
+
+		Context("with an unlocked host", func() {
+			It("should wait for the host to be locked", func() {
+				unlocked := host
+				unlocked.AdministrativeState = hosts.AdminUnlocked
+				unlocked.OperationalStatus = hosts.OperEnabled
+				r := &HostReconciler{}
+				err := r.ReconcileNetworking(nil, &starlingxv1.Host{}, &profile, &unlocked)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("data0"))
+			})
+		})
+	})
 })
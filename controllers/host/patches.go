@@ -0,0 +1,202 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/patches"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// requiredPatches determines which of the patches known to the system must be
+// applied along with the configured patches which are not known to the
+// system.  Every known patch is required if no patch IDs are configured.
+func requiredPatches(info *starlingxv1.SoftwarePatchInfo, current map[string]patches.Patch) (required []string, missing []string) {
+	if len(info.IDs) == 0 {
+		for id := range current {
+			required = append(required, id)
+		}
+
+		sort.Strings(required)
+
+		return required, nil
+	}
+
+	for _, id := range info.IDs {
+		if _, ok := current[id]; ok {
+			required = append(required, id)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	return required, missing
+}
+
+// pendingPatches returns the required patches which have not been applied.
+func pendingPatches(required []string, current map[string]patches.Patch) []string {
+	result := make([]string, 0)
+	for _, id := range required {
+		if !current[id].IsApplied() {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
+
+// findPatchState returns the patch state of a host or nil if it is not
+// reported.
+func findPatchState(states []patches.HostState, hostname string) *patches.HostState {
+	for i := range states {
+		if states[i].Hostname == hostname {
+			return &states[i]
+		}
+	}
+
+	return nil
+}
+
+// uploadPatches uploads the patches of the repository if any of the
+// configured patches are not known to the system.  The patches are also
+// uploaded if no patch IDs are configured and the system has no patches.
+func (r *HostReconciler) uploadPatches(client *gophercloud.ServiceClient, instance *starlingxv1.Host, info *starlingxv1.SoftwarePatchInfo, current map[string]patches.Patch) (map[string]patches.Patch, error) {
+	if info.Repository == nil {
+		return current, nil
+	}
+
+	_, missing := requiredPatches(info, current)
+	if len(missing) == 0 && len(current) > 0 {
+		return current, nil
+	}
+
+	logHost.Info("uploading software patches", "repository", *info.Repository)
+
+	err := patches.UploadDir(client, *info.Repository).ExtractErr()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to upload software patches from: %s", *info.Repository)
+		return nil, err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"software patches have been uploaded from %s", *info.Repository)
+
+	current, err = patches.ListPatches(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list software patches")
+		return nil, err
+	}
+
+	return current, nil
+}
+
+// applyPatches uploads and applies the configured software patches and then
+// installs them on the host.
+func (r *HostReconciler) applyPatches(client *gophercloud.ServiceClient, instance *starlingxv1.Host, info *starlingxv1.SoftwarePatchInfo) error {
+	current, err := patches.ListPatches(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list software patches")
+		return err
+	}
+
+	current, err = r.uploadPatches(client, instance, info, current)
+	if err != nil {
+		return err
+	}
+
+	required, missing := requiredPatches(info, current)
+	if len(missing) > 0 {
+		msg := fmt.Sprintf("software patches are not available on the system: %s",
+			strings.Join(missing, ", "))
+		return common.NewUserDataError(msg)
+	}
+
+	pending := pendingPatches(required, current)
+	if len(pending) > 0 {
+		logHost.Info("applying software patches", "patches", pending)
+
+		err = patches.Apply(client, pending).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to apply software patches: %s",
+				strings.Join(pending, ", "))
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"software patches have been applied: %s", strings.Join(pending, ", "))
+	}
+
+	states, err := patches.ListHostStates(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list host patch states")
+		return err
+	}
+
+	state := findPatchState(states, instance.Name)
+	if state == nil {
+		return common.NewResourceStatusDependency("waiting for the host patch state to be reported")
+	} else if state.PatchCurrent {
+		return nil
+	}
+
+	if state.State != patches.HostStateInstalling {
+		logHost.Info("installing software patches", "failed", state.PatchFailed)
+
+		err = patches.HostInstall(client, instance.Name).ExtractErr()
+		if err != nil {
+			err = perrors.Wrap(err, "failed to install software patches")
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"software patches are being installed")
+	}
+
+	return common.NewResourceStatusDependency("waiting for software patches to be installed")
+}
+
+// ReconcileSoftwarePatches delays the initial unlock of controller-0 until
+// the software patches configured on the System resource have been applied
+// and installed on it.  The other hosts are installed from the patched
+// controller therefore they are never delayed.
+func (r *HostReconciler) ReconcileSoftwarePatches(instance *starlingxv1.Host) error {
+	if instance.Name != hosts.Controller0 || instance.Status.Reconciled {
+		return nil
+	}
+
+	list := &starlingxv1.SystemList{}
+	err := r.List(context.TODO(), list, client.InNamespace(instance.Namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list systems")
+		return err
+	}
+
+	if len(list.Items) == 0 {
+		return nil
+	}
+
+	software := list.Items[0].Spec.Software
+	if software == nil || software.Patches == nil {
+		return nil
+	}
+
+	patchingClient := r.CloudManager.GetPatchingClient(instance.Namespace)
+	if patchingClient == nil {
+		msg := "waiting for the patching API to install the software patches"
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	patchingClient = cloudManager.WithChangeInitiator(patchingClient, instance)
+	return r.applyPatches(patchingClient, instance, software.Patches)
+}
@@ -0,0 +1,73 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// preflightIgnoredAlarms returns the alarms which never withhold a disruptive
+// action on a host.  These are the alarms ignored by either the host or the
+// system resource.
+func (r *HostReconciler) preflightIgnoredAlarms(instance *starlingxv1.Host) ([]string, error) {
+	result := make([]string, 0)
+
+	if instance.Spec.Preflight != nil {
+		result = append(result, instance.Spec.Preflight.IgnoredAlarms...)
+	}
+
+	list := &starlingxv1.SystemList{}
+	err := r.List(context.TODO(), list, client.InNamespace(instance.Namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list systems")
+		return nil, err
+	}
+
+	if len(list.Items) > 0 && list.Items[0].Spec.Preflight != nil {
+		result = append(result, list.Items[0].Spec.Preflight.IgnoredAlarms...)
+	}
+
+	return result, nil
+}
+
+// ReconcilePreflight withholds a disruptive action (i.e., lock, unlock or
+// reinstall) on a host while the system reports an active management
+// affecting alarm which is not explicitly ignored.  The dependency error
+// returned lists the alarms so that they are reported by the Blocked
+// condition of the host.
+func (r *HostReconciler) ReconcilePreflight(instance *starlingxv1.Host, action string) error {
+	ignored, err := r.preflightIgnoredAlarms(instance)
+	if err != nil {
+		return err
+	}
+
+	faultClient := r.CloudManager.GetFaultClient(instance.Namespace)
+	if faultClient == nil {
+		msg := fmt.Sprintf("waiting for the fault management API to check the system health before the %s action", action)
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	active, err := alarms.ListAlarms(faultClient)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list alarms")
+		return err
+	}
+
+	failures := common.PreflightAlarmFailures(instance.Name, active, ignored)
+	if len(failures) > 0 {
+		msg := fmt.Sprintf("waiting for management affecting alarms to clear before the %s action; active alarms: %s",
+			action, strings.Join(failures, ", "))
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	return nil
+}
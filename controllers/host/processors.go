@@ -1,13 +1,16 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package host
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/cpus"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
 	perrors "github.com/pkg/errors"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	com "github.com/wind-river/cloud-platform-deployment-manager/common"
@@ -53,6 +56,150 @@ func (r *HostReconciler) GetCPUUpdateOpts(profile *starlingxv1.HostProfileSpec,
 	return opts, updateRequired
 }
 
+// countPhysicalCores returns the number of physical cores available on a
+// NUMA node.  Hyper-thread siblings are not included.
+func countPhysicalCores(host *v1info.HostInfo, node int) int {
+	count := 0
+	for _, c := range host.CPU {
+		if c.Thread == 0 && c.Processor == node {
+			count++
+		}
+	}
+
+	return count
+}
+
+// desiredCPUCount returns the number of cores that will be assigned to a
+// function on a NUMA node once the profile has been applied.  Functions that
+// are not present in the profile retain their current allocation.
+func desiredCPUCount(profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo, node int, function string) int {
+	for _, nodeInfo := range profile.Processors {
+		if nodeInfo.Node != node {
+			continue
+		}
+
+		for _, f := range nodeInfo.Functions {
+			if f.Function == function {
+				return f.Count
+			}
+		}
+	}
+
+	return host.CountCPUByFunction(node, function)
+}
+
+// validateProcessors ensures that the requested core allocations can be
+// satisfied by each NUMA node and that at least one platform core remains once
+// the vswitch and other dedicated functions have been reshaped.
+func validateProcessors(profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	dedicated := []string{
+		cpus.CPUFunctionPlatform,
+		cpus.CPUFunctionVSwitch,
+		cpus.CPUFunctionShared,
+		cpus.CPUFunctionApplicationIsolated,
+	}
+
+	platform := 0
+	for _, nodeInfo := range profile.Processors {
+		isolated := desiredCPUCount(profile, host, nodeInfo.Node, cpus.CPUFunctionApplicationIsolated)
+		if isolated > 0 && !profile.HasWorkerSubFunction() {
+			msg := fmt.Sprintf("application-isolated cores on node %d require the worker subfunction",
+				nodeInfo.Node)
+			return common.NewUserDataError(msg)
+		}
+
+		available := countPhysicalCores(host, nodeInfo.Node)
+		if available == 0 {
+			msg := fmt.Sprintf("no processor cores found on node %d", nodeInfo.Node)
+			return common.NewUserDataError(msg)
+		}
+
+		required := 0
+		for _, function := range dedicated {
+			required += desiredCPUCount(profile, host, nodeInfo.Node, function)
+		}
+
+		if required > available {
+			msg := fmt.Sprintf("processor allocations on node %d require %d cores but only %d are available",
+				nodeInfo.Node, required, available)
+			return common.NewUserDataError(msg)
+		}
+	}
+
+	nodes := make(map[int]bool)
+	for _, c := range host.CPU {
+		nodes[c.Processor] = true
+	}
+
+	for node := range nodes {
+		platform += desiredCPUCount(profile, host, node, cpus.CPUFunctionPlatform)
+	}
+
+	if platform == 0 {
+		msg := "at least one processor core must remain assigned to the platform function"
+		return common.NewUserDataError(msg)
+	}
+
+	return nil
+}
+
+// hasVSwitchCores determines whether the profile requests any cores for the
+// vswitch function.
+func hasVSwitchCores(profile *starlingxv1.HostProfileSpec) bool {
+	for _, nodeInfo := range profile.Processors {
+		for _, f := range nodeInfo.Functions {
+			if f.Function == cpus.CPUFunctionVSwitch && f.Count > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hostVSwitchEnabled determines whether a host runs a vswitch which can be
+// assigned dedicated cores.  The vswitch only runs on hosts with the worker
+// subfunction, and the inventory reports the size of the hugepages reserved
+// for it on each NUMA node once the vswitch type has been applied to the host.
+func hostVSwitchEnabled(host *v1info.HostInfo) bool {
+	worker := false
+	for _, s := range strings.Split(host.SubFunctions, ",") {
+		if strings.TrimSpace(s) == hosts.SubFunctionWorker {
+			worker = true
+		}
+	}
+
+	if !worker {
+		return false
+	}
+
+	for _, m := range host.Memory {
+		if m.VSwitchHugepagesSize > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateVSwitchCores ensures that vswitch cores are only requested for a
+// host which runs a vswitch.  A worker host may still be waiting for the
+// vswitch type to be applied therefore that case is reported as a dependency
+// rather than as an error in the profile.
+func validateVSwitchCores(profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	if !hasVSwitchCores(profile) || hostVSwitchEnabled(host) {
+		return nil
+	}
+
+	if !profile.HasWorkerSubFunction() {
+		msg := "vswitch cores require the worker subfunction"
+		return common.NewUserDataError(msg)
+	}
+
+	msg := "waiting for a vswitch to be configured on the host before assigning vswitch cores"
+	return common.NewSystemDependency(msg)
+}
+
 // ReconcileProcessors is responsible for reconciling the CPU configuration of a
 // host resource.
 func (r *HostReconciler) ReconcileProcessors(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
@@ -65,9 +212,19 @@ func (r *HostReconciler) ReconcileProcessors(client *gophercloud.ServiceClient,
 	opts, updateRequired := r.GetCPUUpdateOpts(profile, host)
 
 	if updateRequired {
+		err := validateProcessors(profile, host)
+		if err != nil {
+			return err
+		}
+
+		err = validateVSwitchCores(profile, host)
+		if err != nil {
+			return err
+		}
+
 		logHost.Info("updating CPU configuration", "opts", opts)
 
-		_, err := cpus.Update(client, host.ID, opts).Extract()
+		_, err = cpus.Update(client, host.ID, opts).Extract()
 		if err != nil {
 			err = perrors.Wrapf(err, "failed to update processors: %s, %s",
 				host.ID, common.FormatStruct(opts))
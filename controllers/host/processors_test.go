@@ -0,0 +1,180 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package host
+
+import (
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/cpus"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/memory"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+)
+
+// processorHost returns a host with the given number of physical cores on
+// each of two NUMA nodes.  Each core has a hyper-thread sibling and the first
+// core of each node is assigned to the platform function.
+func processorHost(cores int) *v1info.HostInfo {
+	host := &v1info.HostInfo{}
+	for node := 0; node < 2; node++ {
+		for core := 0; core < cores; core++ {
+			function := cpus.CPUFunctionApplication
+			if core == 0 {
+				function = cpus.CPUFunctionPlatform
+			}
+
+			for thread := 0; thread < 2; thread++ {
+				host.CPU = append(host.CPU, cpus.CPU{
+					Processor:    node,
+					PhysicalCore: core,
+					Thread:       thread,
+					Function:     function,
+				})
+			}
+		}
+	}
+
+	return host
+}
+
+func processorProfile(functions ...starlingxv1.ProcessorFunctionInfo) *starlingxv1.HostProfileSpec {
+	subfunctions := []starlingxv1.SubFunction{hosts.SubFunctionController, hosts.SubFunctionWorker}
+	return &starlingxv1.HostProfileSpec{
+		ProfileBaseAttributes: starlingxv1.ProfileBaseAttributes{SubFunctions: subfunctions},
+		Processors: starlingxv1.ProcessorNodeList{
+			{Node: 0, Functions: starlingxv1.ProcessorFunctionList(functions)},
+		},
+	}
+}
+
+var _ = Describe("Processor utils", func() {
+	Describe("countPhysicalCores utility", func() {
+		It("should only count the first thread of each core on the node", func() {
+			host := processorHost(4)
+			Expect(countPhysicalCores(host, 0)).To(Equal(4))
+			Expect(countPhysicalCores(host, 1)).To(Equal(4))
+			Expect(countPhysicalCores(host, 2)).To(Equal(0))
+		})
+	})
+
+	Describe("desiredCPUCount utility", func() {
+		It("should prefer the profile over the current allocation", func() {
+			host := processorHost(4)
+			profile := processorProfile(starlingxv1.ProcessorFunctionInfo{Function: cpus.CPUFunctionPlatform, Count: 2})
+			tests := []struct {
+				name     string
+				node     int
+				function string
+				want     int
+			}{
+				{name: "profile", node: 0, function: cpus.CPUFunctionPlatform, want: 2},
+				{name: "other-node", node: 1, function: cpus.CPUFunctionPlatform, want: 1},
+				{name: "current", node: 0, function: cpus.CPUFunctionApplication, want: 3},
+				{name: "unassigned", node: 0, function: cpus.CPUFunctionVSwitch, want: 0},
+			}
+			for _, tt := range tests {
+				Expect(desiredCPUCount(profile, host, tt.node, tt.function)).To(Equal(tt.want), tt.name)
+			}
+		})
+	})
+
+	Describe("validateProcessors utility", func() {
+		It("should enforce the core count rules", func() {
+			host := processorHost(4)
+			tests := []struct {
+				name      string
+				functions []starlingxv1.ProcessorFunctionInfo
+				worker    bool
+				wantErr   bool
+			}{
+				{name: "fits",
+					functions: []starlingxv1.ProcessorFunctionInfo{
+						{Function: cpus.CPUFunctionPlatform, Count: 1},
+						{Function: cpus.CPUFunctionVSwitch, Count: 1},
+						{Function: cpus.CPUFunctionApplicationIsolated, Count: 2}},
+					worker: true},
+				{name: "all-cores",
+					functions: []starlingxv1.ProcessorFunctionInfo{
+						{Function: cpus.CPUFunctionPlatform, Count: 2},
+						{Function: cpus.CPUFunctionVSwitch, Count: 2}},
+					worker: true},
+				{name: "over-commit",
+					functions: []starlingxv1.ProcessorFunctionInfo{
+						{Function: cpus.CPUFunctionPlatform, Count: 2},
+						{Function: cpus.CPUFunctionVSwitch, Count: 3}},
+					worker:  true,
+					wantErr: true},
+				{name: "platform-on-other-node",
+					functions: []starlingxv1.ProcessorFunctionInfo{
+						{Function: cpus.CPUFunctionPlatform, Count: 0}},
+					worker: true},
+				{name: "isolated-without-worker",
+					functions: []starlingxv1.ProcessorFunctionInfo{
+						{Function: cpus.CPUFunctionApplicationIsolated, Count: 1}},
+					wantErr: true},
+			}
+			for _, tt := range tests {
+				profile := processorProfile(tt.functions...)
+				if !tt.worker {
+					profile.SubFunctions = []starlingxv1.SubFunction{hosts.SubFunctionController}
+				}
+				err := validateProcessors(profile, host)
+				if tt.wantErr {
+					Expect(err).To(HaveOccurred(), tt.name)
+					Expect(err).To(BeAssignableToTypeOf(common.ErrUserDataError{}), tt.name)
+				} else {
+					Expect(err).ToNot(HaveOccurred(), tt.name)
+				}
+			}
+		})
+
+		It("should require a platform core on at least one node", func() {
+			host := processorHost(4)
+			profile := processorProfile(starlingxv1.ProcessorFunctionInfo{Function: cpus.CPUFunctionPlatform, Count: 0})
+			profile.Processors = append(profile.Processors, starlingxv1.ProcessorInfo{
+				Node:      1,
+				Functions: starlingxv1.ProcessorFunctionList{{Function: cpus.CPUFunctionPlatform, Count: 0}},
+			})
+			Expect(validateProcessors(profile, host)).To(HaveOccurred())
+		})
+	})
+
+	Describe("validateVSwitchCores utility", func() {
+		It("should only allow vswitch cores on hosts running a vswitch", func() {
+			vswitch := []memory.Memory{{Processor: 0, VSwitchHugepagesSize: 1024}}
+			tests := []struct {
+				name         string
+				count        int
+				subfunctions string
+				memory       []memory.Memory
+				worker       bool
+				want         error
+			}{
+				{name: "no-vswitch-cores", count: 0, subfunctions: "controller", worker: false},
+				{name: "vswitch-enabled", count: 1, subfunctions: "controller,worker", memory: vswitch, worker: true},
+				{name: "vswitch-pending", count: 1, subfunctions: "controller,worker", worker: true,
+					want: common.NewSystemDependency("waiting for a vswitch to be configured on the host before assigning vswitch cores")},
+				{name: "not-worker", count: 1, subfunctions: "controller", memory: vswitch, worker: false,
+					want: common.NewUserDataError("vswitch cores require the worker subfunction")},
+			}
+			for _, tt := range tests {
+				host := processorHost(4)
+				host.SubFunctions = tt.subfunctions
+				host.Memory = tt.memory
+				profile := processorProfile(starlingxv1.ProcessorFunctionInfo{Function: cpus.CPUFunctionVSwitch, Count: tt.count})
+				if !tt.worker {
+					profile.SubFunctions = []starlingxv1.SubFunction{hosts.SubFunctionController}
+				}
+				Expect(hasVSwitchCores(profile)).To(Equal(tt.count > 0), tt.name)
+				if tt.want == nil {
+					Expect(validateVSwitchCores(profile, host)).To(BeNil(), tt.name)
+				} else {
+					Expect(validateVSwitchCores(profile, host)).To(Equal(tt.want), tt.name)
+				}
+			}
+		})
+	})
+})
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package host
 
@@ -10,14 +10,19 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/alecthomas/units"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/interfaces"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/memory"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/routes"
 	"github.com/imdario/mergo"
 	perrors "github.com/pkg/errors"
 	"github.com/samber/lo"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/pcidevices"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -65,13 +70,306 @@ func FixProfileAttributes(a, b, c *starlingxv1.HostProfileSpec, hostInfo *v1info
 		}
 	}
 
-	// If a hostprofile is composed based on a base profile, remove it to avoid
-	// delta between the final profile and the current config
+	// If a hostprofile is composed based on a base profile or mixins, remove
+	// them to avoid delta between the final profile and the current config
 	a.Base = nil
 	b.Base = nil
+	a.Mixins = nil
+	b.Mixins = nil
 
 	FixProfileDevicePath(a, hostInfo)
 	FixKernelSubfunction(a)
+	FixProcessorFunctions(b, c)
+	FixPowerProfileLabels(b)
+	FixPlatformMemory(b)
+	FixPCIDeviceSelectors(b, hostInfo)
+	FixSensorGroups(b, c)
+}
+
+// FixPCIDeviceSelectors is to expand any PCI device entries that select devices
+// by vendor and device identifiers into one entry per matching device address.
+// The current configuration reports devices by address so without this the
+// profile would never be considered in sync.  Attributes set through a
+// selector take precedence over those of the individual address entries.
+func FixPCIDeviceSelectors(profile *starlingxv1.HostProfileSpec, hostInfo *v1info.HostInfo) {
+	if profile.Devices == nil || len(profile.Devices.PCI) == 0 || hostInfo == nil {
+		return
+	}
+
+	result := make(starlingxv1.PCIDeviceList, 0, len(profile.Devices.PCI))
+	selectors := make([]starlingxv1.PCIDeviceInfo, 0)
+	for _, info := range profile.Devices.PCI {
+		if info.PCIAddress != nil {
+			result = append(result, info)
+		} else {
+			selectors = append(selectors, info)
+		}
+	}
+
+	for _, selector := range selectors {
+		for _, d := range hostInfo.PCIDevices {
+			if d.ClassID == pcidevices.PCIClassAccelerator {
+				continue
+			}
+
+			if !selector.Matches(d.PCIAddress, d.VendorID, d.DeviceID) {
+				continue
+			}
+
+			idx := -1
+			for i := range result {
+				if strings.EqualFold(*result[i].PCIAddress, d.PCIAddress) {
+					idx = i
+					break
+				}
+			}
+
+			if idx == -1 {
+				pciaddr := d.PCIAddress
+				result = append(result, starlingxv1.PCIDeviceInfo{PCIAddress: &pciaddr})
+				idx = len(result) - 1
+			}
+
+			if selector.Enabled != nil {
+				result[idx].Enabled = selector.Enabled
+			}
+
+			if selector.Name != nil {
+				result[idx].Name = selector.Name
+			}
+		}
+	}
+
+	profile.Devices.PCI = result
+}
+
+// FixSensorGroups is to restrict the current sensor group configuration to the
+// sensor groups that are listed in the profile.  The board management
+// controller reports every sensor group of the host but only those listed in
+// the profile are managed.  The suppressed sensor names are also sorted so
+// that they can be compared to those of the current configuration.
+func FixSensorGroups(profile *starlingxv1.HostProfileSpec, current *starlingxv1.HostProfileSpec) {
+	if profile == nil || current == nil {
+		return
+	}
+
+	for i := range profile.SensorGroups {
+		if profile.SensorGroups[i].SuppressedSensors != nil {
+			sort.Strings(profile.SensorGroups[i].SuppressedSensors)
+		}
+	}
+
+	if current.SensorGroups == nil {
+		return
+	}
+
+	result := make(starlingxv1.SensorGroupList, 0)
+	for _, c := range current.SensorGroups {
+		for _, p := range profile.SensorGroups {
+			if p.IsKeyEqual(c) {
+				result = append(result, c)
+				break
+			}
+		}
+	}
+
+	current.SensorGroups = result
+}
+
+// FixPowerProfileLabels is to translate the power profile attribute into the
+// set of host labels used by the platform power manager.  The power manager
+// is configured entirely through host labels so the labels reconciler takes
+// care of applying and removing them.
+func FixPowerProfileLabels(profile *starlingxv1.HostProfileSpec) {
+	if profile.PowerProfile == nil {
+		return
+	}
+
+	// Copy the labels since the map may be shared with the source profile.
+	labels := make(map[string]string, len(profile.Labels)+2)
+	for k, v := range profile.Labels {
+		labels[k] = v
+	}
+
+	labels[starlingxv1.PowerManagementLabel] = starlingxv1.PowerManagementLabelEnabled
+	labels[starlingxv1.PowerProfileLabel] = *profile.PowerProfile
+	profile.Labels = labels
+}
+
+// FixPlatformMemory is to translate the platform memory attribute of each
+// NUMA node into the equivalent platform function allocation of 4KB pages.
+// The memory reconciler and the current configuration only deal with
+// function allocations so the attribute is cleared once translated.
+func FixPlatformMemory(profile *starlingxv1.HostProfileSpec) {
+	if len(profile.Memory) == 0 {
+		return
+	}
+
+	// Copy the list since it may be shared with the source profile.
+	nodes := make(starlingxv1.MemoryNodeList, 0, len(profile.Memory))
+	for _, n := range profile.Memory {
+		if n.Platform == nil {
+			nodes = append(nodes, n)
+			continue
+		}
+
+		platform := starlingxv1.MemoryFunctionInfo{
+			Function:  memory.MemoryFunctionPlatform,
+			PageSize:  string(starlingxv1.PageSize4K),
+			PageCount: (*n.Platform * int(units.Mebibyte)) / starlingxv1.PageSize4K.Bytes(),
+		}
+
+		functions := starlingxv1.MemoryFunctionList{platform}
+		for _, f := range n.Functions {
+			if !f.IsKeyEqual(platform) {
+				functions = append(functions, f)
+			}
+		}
+
+		nodes = append(nodes, starlingxv1.MemoryNodeInfo{
+			Node:      n.Node,
+			Functions: functions,
+		})
+	}
+
+	profile.Memory = nodes
+}
+
+// FixProcessorFunctions is to add any processor functions which are configured
+// with a zero count in the profile but are absent from the current
+// configuration.  The system API does not report functions that have no cores
+// assigned (e.g., application-isolated) so without this the profile would
+// never be considered in sync after such a function is released.
+func FixProcessorFunctions(profile *starlingxv1.HostProfileSpec, current *starlingxv1.HostProfileSpec) {
+	if profile == nil || current == nil {
+		return
+	}
+
+	for _, nodeInfo := range profile.Processors {
+		idx := -1
+		for i := range current.Processors {
+			if current.Processors[i].Node == nodeInfo.Node {
+				idx = i
+				break
+			}
+		}
+
+		for _, f := range nodeInfo.Functions {
+			if f.Count != 0 {
+				continue
+			}
+
+			if idx == -1 {
+				current.Processors = append(current.Processors, starlingxv1.ProcessorInfo{
+					Node:      nodeInfo.Node,
+					Functions: starlingxv1.ProcessorFunctionList{},
+				})
+				idx = len(current.Processors) - 1
+			}
+
+			found := false
+			for _, c := range current.Processors[idx].Functions {
+				if c.Function == f.Function {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				current.Processors[idx].Functions = append(current.Processors[idx].Functions, f)
+			}
+		}
+	}
+}
+
+// declaredInterfaces returns the common attributes of each interface declared
+// by a profile indexed by interface name.
+func declaredInterfaces(profile *starlingxv1.HostProfileSpec) map[string]*starlingxv1.CommonInterfaceInfo {
+	result := make(map[string]*starlingxv1.CommonInterfaceInfo)
+	if profile == nil || profile.Interfaces == nil {
+		return result
+	}
+
+	for i := range profile.Interfaces.Ethernet {
+		result[profile.Interfaces.Ethernet[i].Name] = &profile.Interfaces.Ethernet[i].CommonInterfaceInfo
+	}
+
+	for i := range profile.Interfaces.VLAN {
+		result[profile.Interfaces.VLAN[i].Name] = &profile.Interfaces.VLAN[i].CommonInterfaceInfo
+	}
+
+	for i := range profile.Interfaces.Bond {
+		result[profile.Interfaces.Bond[i].Name] = &profile.Interfaces.Bond[i].CommonInterfaceInfo
+	}
+
+	for i := range profile.Interfaces.VF {
+		result[profile.Interfaces.VF[i].Name] = &profile.Interfaces.VF[i].CommonInterfaceInfo
+	}
+
+	return result
+}
+
+// fixCommonInterfaceNetworks clears the inherited network assignments which
+// are not supported by the interface class.  Platform networks can only be
+// attached to platform and data interfaces while data networks can only be
+// attached to data, pci-sriov, and pci-passthrough interfaces.  Networks that
+// were declared alongside the class are left untouched since conflicting
+// declarations are rejected by the validation of the profile.
+func fixCommonInterfaceNetworks(info *starlingxv1.CommonInterfaceInfo, declared *starlingxv1.CommonInterfaceInfo) {
+	clearPlatform := declared == nil || declared.PlatformNetworks == nil
+	clearData := declared == nil || declared.DataNetworks == nil
+
+	switch info.Class {
+	case interfaces.IFClassPCISRIOV, interfaces.IFClassPCIPassthrough:
+		if clearPlatform {
+			info.PlatformNetworks = &starlingxv1.PlatformNetworkItemList{}
+		}
+	case interfaces.IFClassPlatform:
+		if clearData {
+			info.DataNetworks = &starlingxv1.DataNetworkItemList{}
+		}
+	case interfaces.IFClassNone:
+		if clearPlatform {
+			info.PlatformNetworks = &starlingxv1.PlatformNetworkItemList{}
+		}
+		if clearData {
+			info.DataNetworks = &starlingxv1.DataNetworkItemList{}
+		}
+	}
+}
+
+// FixInterfaceClassNetworks is to remove network assignments inherited from
+// the default attributes of the host which are no longer valid once the
+// class of an interface has changed.  The declared profile is the composite
+// profile as it was before being merged over the defaults.  The resulting
+// empty lists cause the stale interface-network and interface-datanetwork
+// records to be removed before the interface class is modified.
+func FixInterfaceClassNetworks(a *starlingxv1.HostProfileSpec, declared *starlingxv1.HostProfileSpec) {
+	if a.Interfaces == nil {
+		return
+	}
+
+	names := declaredInterfaces(declared)
+
+	for i := range a.Interfaces.Ethernet {
+		info := &a.Interfaces.Ethernet[i].CommonInterfaceInfo
+		fixCommonInterfaceNetworks(info, names[info.Name])
+	}
+
+	for i := range a.Interfaces.VLAN {
+		info := &a.Interfaces.VLAN[i].CommonInterfaceInfo
+		fixCommonInterfaceNetworks(info, names[info.Name])
+	}
+
+	for i := range a.Interfaces.Bond {
+		info := &a.Interfaces.Bond[i].CommonInterfaceInfo
+		fixCommonInterfaceNetworks(info, names[info.Name])
+	}
+
+	for i := range a.Interfaces.VF {
+		info := &a.Interfaces.VF[i].CommonInterfaceInfo
+		fixCommonInterfaceNetworks(info, names[info.Name])
+	}
 }
 
 // FixProfileDevicePath is to fix the device path if it is offered as device node
@@ -213,6 +511,8 @@ func (r *HostReconciler) GetHostProfile(namespace, profile string) (*starlingxv1
 		if !errors.IsNotFound(err) {
 			err = perrors.Wrapf(err, "failed to get profile: %s", name)
 			return nil, err
+		} else if builtin, ok := starlingxv1.BuiltinHostProfile(profile, namespace); ok {
+			return r.instantiateBuiltinHostProfile(builtin)
 		} else {
 			msg := fmt.Sprintf("host profile %q not present", name)
 			return nil, common.NewResourceConfigurationDependency(msg)
@@ -222,6 +522,25 @@ func (r *HostReconciler) GetHostProfile(namespace, profile string) (*starlingxv1
 	return instance, nil
 }
 
+// instantiateBuiltinHostProfile creates a built-in HostProfile template in
+// the namespace of the host that references it when configured to do so.
+// Otherwise, the template is used as is.
+func (r *HostReconciler) instantiateBuiltinHostProfile(profile *starlingxv1.HostProfile) (*starlingxv1.HostProfile, error) {
+	if !utils.InstantiateBuiltinProfiles() {
+		return profile, nil
+	}
+
+	logProfileUtils.Info("creating built-in host profile", "name", profile.Name, "namespace", profile.Namespace)
+
+	err := r.Create(context.TODO(), profile)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		err = perrors.Wrapf(err, "failed to create built-in profile: %s", profile.Name)
+		return nil, err
+	}
+
+	return profile, nil
+}
+
 // DeleteHostProfile deletes a HostProfile from the kubernetes API
 func (r *HostReconciler) DeleteHostProfile(namespace, profile string) error {
 	instance := &starlingxv1.HostProfile{}
@@ -250,27 +569,21 @@ func (r *HostReconciler) DeleteHostProfile(namespace, profile string) error {
 // their values.  Array entries that are not found in the base profile are
 // added to the array.
 func (r *HostReconciler) mergeProfileChain(namespace string, current *starlingxv1.HostProfileSpec, visited map[string]bool) (*starlingxv1.HostProfileSpec, error) {
-	if current.Base != nil {
-		if value, ok := visited[*current.Base]; ok && value {
-			msg := fmt.Sprintf("profile loop detected at: %s", *current.Base)
-			return nil, common.NewValidationError(msg)
-		}
-
-		parent, err := r.GetHostProfileSpec(namespace, *current.Base)
-		if err != nil {
-			return nil, err
-		}
+	lookup := func(name string) (*starlingxv1.HostProfileSpec, error) {
+		return r.GetHostProfileSpec(namespace, name)
+	}
 
-		parent, err = r.mergeProfileChain(namespace, parent, visited)
-		if err != nil {
-			return nil, err
+	result, err := starlingxv1.FlattenHostProfile(lookup, current, DefaultHostProfile.DeepCopy(), visited)
+	if err != nil {
+		var mergeErr starlingxv1.ProfileMergeError
+		if perrors.As(err, &mergeErr) {
+			return nil, common.NewValidationError(err.Error())
 		}
 
-		return MergeProfiles(parent, current)
+		return nil, err
 	}
 
-	defaultCopy := DefaultHostProfile.DeepCopy()
-	return MergeProfiles(defaultCopy, current)
+	return result, nil
 }
 
 // BuildAndValidateCompositeProfile combines the methods of BuildCompositeProfile
@@ -306,7 +619,7 @@ func (r *HostReconciler) BuildCompositeProfile(host *starlingxv1.Host) (*starlin
 
 	// Initialize map to track which profiles have already been visited so
 	// that we can catch loops.
-	visited := make(map[string]bool)
+	visited := map[string]bool{host.Spec.Profile: true}
 
 	// Traverse the list of profiles until the root profile is found.
 	// Attributes from lower profiles (those closest to the host level) are
@@ -326,6 +639,13 @@ func (r *HostReconciler) BuildCompositeProfile(host *starlingxv1.Host) (*starlin
 		}
 	}
 
+	if host.Spec.PowerState != nil {
+		// The host level power state takes precedence over the power-on
+		// attribute inherited from the profile chain.
+		powerOn := *host.Spec.PowerState == starlingxv1.PowerStateOn
+		composite.PowerOn = &powerOn
+	}
+
 	if composite.Interfaces != nil && len(composite.Interfaces.Ethernet) == 0 {
 		// In some cases it is necessary to set the "ethernet" attribute to
 		// an empty array in order to override the list of interfaces from a
@@ -351,12 +671,19 @@ func (r *HostReconciler) BuildCompositeProfile(host *starlingxv1.Host) (*starlin
 
 	routeList := make([]starlingxv1.RouteInfo, 0)
 	for _, rt := range composite.Routes {
+		// An omitted metric is provisioned with the system default so use
+		// it explicitly to detect a route modified to a different metric.
+		metric := routes.DefaultMetric
+		if rt.Metric != nil {
+			metric = *rt.Metric
+		}
+
 		route := starlingxv1.RouteInfo{
 			Interface: rt.Interface,
 			Network:   net.ParseIP(rt.Network).String(),
 			Prefix:    rt.Prefix,
 			Gateway:   net.ParseIP(rt.Gateway).String(),
-			Metric:    rt.Metric,
+			Metric:    &metric,
 		}
 		routeList = append(routeList, route)
 	}
@@ -421,6 +748,53 @@ func (r *HostReconciler) validateLoopbackInterface(host *starlingxv1.Host, profi
 	return nil
 }
 
+// validateInterfaceAddressing validates that an address pool is only specified
+// on an interface when the corresponding addressing mode is set to "pool".
+func validateInterfaceAddressing(info starlingxv1.CommonInterfaceInfo) error {
+	if info.IPv4Pool != nil && (info.IPv4Mode == nil || *info.IPv4Mode != interfaces.AddressModePool) {
+		msg := fmt.Sprintf("'ipv4Pool' requires 'ipv4Mode' to be set to %q on interface %s",
+			interfaces.AddressModePool, info.Name)
+		return common.NewValidationError(msg)
+	}
+
+	if info.IPv6Pool != nil && (info.IPv6Mode == nil || *info.IPv6Mode != interfaces.AddressModePool) {
+		msg := fmt.Sprintf("'ipv6Pool' requires 'ipv6Mode' to be set to %q on interface %s",
+			interfaces.AddressModePool, info.Name)
+		return common.NewValidationError(msg)
+	}
+
+	return nil
+}
+
+// validateProfileAddressing validates the addressing modes of all interfaces.
+func (r *HostReconciler) validateProfileAddressing(host *starlingxv1.Host, profile *starlingxv1.HostProfileSpec) error {
+	for _, e := range profile.Interfaces.Ethernet {
+		if err := validateInterfaceAddressing(e.CommonInterfaceInfo); err != nil {
+			return err
+		}
+	}
+
+	for _, b := range profile.Interfaces.Bond {
+		if err := validateInterfaceAddressing(b.CommonInterfaceInfo); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range profile.Interfaces.VLAN {
+		if err := validateInterfaceAddressing(v.CommonInterfaceInfo); err != nil {
+			return err
+		}
+	}
+
+	for _, vf := range profile.Interfaces.VF {
+		if err := validateInterfaceAddressing(vf.CommonInterfaceInfo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // validateProfileInterfaces does minimal validation over the list of
 // interfaces to be configured.
 func (r *HostReconciler) validateProfileInterfaces(host *starlingxv1.Host, profile *starlingxv1.HostProfileSpec) error {
@@ -437,6 +811,10 @@ func (r *HostReconciler) validateProfileInterfaces(host *starlingxv1.Host, profi
 	if err != nil {
 		return err
 	}
+	err = r.validateProfileAddressing(host, profile)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -494,6 +872,13 @@ func (r *HostReconciler) validateProfileAddresses(host *starlingxv1.Host, profil
 		}
 	}
 
+	// Routes inherited from different profiles may still overlap even if
+	// each profile was accepted individually.
+	err := starlingxv1.CheckRouteConflicts(profile.Routes)
+	if err != nil {
+		return common.NewValidationError(err.Error())
+	}
+
 	return nil
 }
 
@@ -514,6 +899,10 @@ func (r *HostReconciler) validateProfileSpec(host *starlingxv1.Host, profile *st
 			msg := "'kernel' profile attributes are only supported on nodes which include the worker subfunction"
 			return common.NewValidationError(msg)
 		}
+		if profile.Devices != nil && (len(profile.Devices.FEC) > 0 || len(profile.Devices.PCI) > 0) {
+			msg := "'devices' profile attributes are only supported on nodes which include the worker subfunction"
+			return common.NewValidationError(msg)
+		}
 	}
 
 	if *profile.Personality != hosts.PersonalityWorker {
@@ -550,6 +939,16 @@ func (r *HostReconciler) validateProfileSpec(host *starlingxv1.Host, profile *st
 		return err
 	}
 
+	err = starlingxv1.ValidateCompositeProfile(profile, nil, nil, false)
+	if err != nil {
+		return common.NewValidationError(err.Error())
+	}
+
+	err = starlingxv1.ValidateInterfaceClassNetworks(profile)
+	if err != nil {
+		return common.NewValidationError(err.Error())
+	}
+
 	err = r.validateProfileAddresses(host, profile)
 	if err != nil {
 		return err
@@ -607,6 +1006,90 @@ func SyncIFNameByUuid(
 	}
 }
 
+// PortRepair describes an ethernet port which was replaced by a different
+// port installed at the same PCI address.
+type PortRepair struct {
+	Interface  string
+	OldPort    string
+	NewPort    string
+	PCIAddress string
+	Pending    bool
+}
+
+// SyncPortNameByPCIAddress updates the port name of any ethernet interface
+// whose configured port no longer exists but for which another port is
+// installed at the same PCI address.  This happens when a failed NIC is
+// replaced and the new device is reported with a different port name.  The
+// list of repaired ports is returned.  A repair is considered pending until
+// the system interface associated to the new port has been renamed to match
+// the configured interface.
+func SyncPortNameByPCIAddress(
+	profile *starlingxv1.HostProfileSpec, current *starlingxv1.HostProfileSpec,
+) []PortRepair {
+	result := make([]PortRepair, 0)
+
+	if profile == nil || current == nil {
+		return result
+	}
+
+	if profile.Interfaces == nil || current.Interfaces == nil {
+		return result
+	}
+
+	eth_current := current.Interfaces.Ethernet
+	eth_profile := profile.Interfaces.Ethernet
+	for idx_profile := range eth_profile {
+		port_profile := eth_profile[idx_profile].Port
+		if eth_profile[idx_profile].Lower != "" || port_profile.PCIAddress == nil {
+			continue
+		}
+
+		if findEthernetInfoByPortName(eth_current, port_profile.Name) != nil {
+			// The configured port still exists so there is nothing to repair.
+			continue
+		}
+
+		for idx_current := range eth_current {
+			port_current := eth_current[idx_current].Port
+			if port_current.PCIAddress == nil ||
+				!strings.EqualFold(*port_current.PCIAddress, *port_profile.PCIAddress) {
+				continue
+			}
+
+			name := eth_profile[idx_profile].Name
+			logProfileUtils.Info(
+				"Ethernet port sync", "interface", name,
+				"profile", port_profile.Name, "current", port_current.Name,
+				"pciaddr", *port_profile.PCIAddress)
+
+			result = append(result, PortRepair{
+				Interface:  name,
+				OldPort:    port_profile.Name,
+				NewPort:    port_current.Name,
+				PCIAddress: *port_profile.PCIAddress,
+				Pending:    eth_current[idx_current].Name != name,
+			})
+
+			eth_profile[idx_profile].Port.Name = port_current.Name
+			break
+		}
+	}
+
+	return result
+}
+
+// findEthernetInfoByPortName searches a list of ethernet interfaces for the
+// interface associated to the port name specified.
+func findEthernetInfoByPortName(list starlingxv1.EthernetList, portname string) *starlingxv1.EthernetInfo {
+	for idx := range list {
+		if list[idx].Port.Name == portname {
+			return &list[idx]
+		}
+	}
+
+	return nil
+}
+
 // FillEmptyUuidbyName fills the empty uuid by its name. Normally, the uuids can
 // be searched from the system and merged to the default profile.
 func FillEmptyUuidbyName(
@@ -11,6 +11,7 @@ import (
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/pcidevices"
 )
 
 var _ = Describe("Profile utils", func() {
@@ -637,6 +638,25 @@ var _ = Describe("Profile utils", func() {
 							info: &v1info.HostInfo{},
 						},
 					},
+					{
+						name: "Profile has mixin profiles",
+						args: args{
+							a: &starlingxv1.HostProfileSpec{
+								Mixins: []string{"mixin-1", "mixin-2"},
+							},
+							b: &starlingxv1.HostProfileSpec{
+								Mixins: []string{"mixin-1"},
+							},
+							c:    &starlingxv1.HostProfileSpec{},
+							info: &v1info.HostInfo{},
+						},
+						want: args{
+							a:    &starlingxv1.HostProfileSpec{},
+							b:    &starlingxv1.HostProfileSpec{},
+							c:    &starlingxv1.HostProfileSpec{},
+							info: &v1info.HostInfo{},
+						},
+					},
 					{
 						name: "Profile has no Base profile",
 						args: args{
@@ -663,6 +683,131 @@ var _ = Describe("Profile utils", func() {
 		})
 	})
 
+	Describe("FixInterfaceClassNetworks", func() {
+		Context("with interfaces changing class", func() {
+			It("should clear the networks not supported by the class", func() {
+				platformNetworks := starlingxv1.PlatformNetworkItemList{"mgmt"}
+				dataNetworks := starlingxv1.DataNetworkItemList{"physnet0"}
+				empty := starlingxv1.PlatformNetworkItemList{}
+				emptyData := starlingxv1.DataNetworkItemList{}
+				newPlatformNetworks := func() *starlingxv1.PlatformNetworkItemList {
+					list := platformNetworks.DeepCopy()
+					return &list
+				}
+				newDataNetworks := func() *starlingxv1.DataNetworkItemList {
+					list := dataNetworks.DeepCopy()
+					return &list
+				}
+				profile := &starlingxv1.HostProfileSpec{
+					Interfaces: &starlingxv1.InterfaceInfo{
+						Ethernet: starlingxv1.EthernetList{
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name:             "sriov0",
+									Class:            "pci-sriov",
+									PlatformNetworks: newPlatformNetworks(),
+									DataNetworks:     newDataNetworks(),
+								},
+							},
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name:             "mgmt0",
+									Class:            "platform",
+									PlatformNetworks: newPlatformNetworks(),
+									DataNetworks:     newDataNetworks(),
+								},
+							},
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name:             "data0",
+									Class:            "data",
+									PlatformNetworks: newPlatformNetworks(),
+									DataNetworks:     newDataNetworks(),
+								},
+							},
+						},
+						VLAN: starlingxv1.VLANList{
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name:             "vlan10",
+									Class:            "none",
+									PlatformNetworks: newPlatformNetworks(),
+									DataNetworks:     newDataNetworks(),
+								},
+							},
+						},
+					},
+				}
+				FixInterfaceClassNetworks(profile, nil)
+
+				ethernet := profile.Interfaces.Ethernet
+				Expect(*ethernet[0].PlatformNetworks).To(Equal(empty))
+				Expect(*ethernet[0].DataNetworks).To(Equal(dataNetworks))
+				Expect(*ethernet[1].PlatformNetworks).To(Equal(platformNetworks))
+				Expect(*ethernet[1].DataNetworks).To(Equal(emptyData))
+				Expect(*ethernet[2].PlatformNetworks).To(Equal(platformNetworks))
+				Expect(*ethernet[2].DataNetworks).To(Equal(dataNetworks))
+				Expect(*profile.Interfaces.VLAN[0].PlatformNetworks).To(Equal(empty))
+				Expect(*profile.Interfaces.VLAN[0].DataNetworks).To(Equal(emptyData))
+			})
+			It("should keep the networks declared by the user", func() {
+				platformNetworks := starlingxv1.PlatformNetworkItemList{"oam"}
+				inherited := starlingxv1.PlatformNetworkItemList{"mgmt"}
+				dataNetworks := starlingxv1.DataNetworkItemList{"physnet0"}
+				emptyData := starlingxv1.DataNetworkItemList{}
+				profile := &starlingxv1.HostProfileSpec{
+					Interfaces: &starlingxv1.InterfaceInfo{
+						Ethernet: starlingxv1.EthernetList{
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name:             "oam0",
+									Class:            "platform",
+									PlatformNetworks: &platformNetworks,
+									DataNetworks:     &dataNetworks,
+								},
+							},
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name:             "sriov0",
+									Class:            "pci-sriov",
+									PlatformNetworks: &inherited,
+									DataNetworks:     &dataNetworks,
+								},
+							},
+						},
+					},
+				}
+				declared := &starlingxv1.HostProfileSpec{
+					Interfaces: &starlingxv1.InterfaceInfo{
+						Ethernet: starlingxv1.EthernetList{
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name:             "oam0",
+									Class:            "platform",
+									PlatformNetworks: &platformNetworks,
+								},
+							},
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name:         "sriov0",
+									Class:        "pci-sriov",
+									DataNetworks: &dataNetworks,
+								},
+							},
+						},
+					},
+				}
+				FixInterfaceClassNetworks(profile, declared)
+
+				ethernet := profile.Interfaces.Ethernet
+				Expect(*ethernet[0].PlatformNetworks).To(Equal(platformNetworks))
+				Expect(*ethernet[0].DataNetworks).To(Equal(emptyData))
+				Expect(*ethernet[1].PlatformNetworks).To(Equal(starlingxv1.PlatformNetworkItemList{}))
+				Expect(*ethernet[1].DataNetworks).To(Equal(dataNetworks))
+			})
+		})
+	})
+
 	Describe("Test SyncIFNameByUuid", func() {
 		Context("When uuid is the same", func() {
 			It("Should copy interface name from current to profile", func() {
@@ -1009,4 +1154,244 @@ var _ = Describe("Profile utils", func() {
 			})
 		})
 	})
+
+	Describe("Test SyncPortNameByPCIAddress", func() {
+		Context("When a port has been replaced", func() {
+			It("Should follow the port by its PCI address", func() {
+				pciaddr := "0000:18:00.0"
+				profile := &starlingxv1.HostProfileSpec{
+					Interfaces: &starlingxv1.InterfaceInfo{
+						Ethernet: starlingxv1.EthernetList{
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name: "data0",
+								},
+								Port: starlingxv1.EthernetPortInfo{
+									Name:       "enp24s0f0",
+									PCIAddress: &pciaddr,
+								},
+							},
+						},
+					},
+				}
+				currentAddr := "0000:18:00.0"
+				current := &starlingxv1.HostProfileSpec{
+					Interfaces: &starlingxv1.InterfaceInfo{
+						Ethernet: starlingxv1.EthernetList{
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name: "ens1f0",
+								},
+								Port: starlingxv1.EthernetPortInfo{
+									Name:       "ens1f0",
+									PCIAddress: &currentAddr,
+								},
+							},
+						},
+					},
+				}
+				repairs := SyncPortNameByPCIAddress(profile, current)
+				Expect(repairs).To(HaveLen(1))
+				Expect(repairs[0].OldPort).To(Equal("enp24s0f0"))
+				Expect(repairs[0].NewPort).To(Equal("ens1f0"))
+				Expect(repairs[0].Pending).To(BeTrue())
+				Expect(profile.Interfaces.Ethernet[0].Port.Name).To(Equal("ens1f0"))
+			})
+		})
+		Context("When the port still exists", func() {
+			It("Should not change the port name", func() {
+				pciaddr := "0000:18:00.0"
+				profile := &starlingxv1.HostProfileSpec{
+					Interfaces: &starlingxv1.InterfaceInfo{
+						Ethernet: starlingxv1.EthernetList{
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name: "data0",
+								},
+								Port: starlingxv1.EthernetPortInfo{
+									Name:       "enp24s0f0",
+									PCIAddress: &pciaddr,
+								},
+							},
+						},
+					},
+				}
+				current := profile.DeepCopy()
+				repairs := SyncPortNameByPCIAddress(profile, current)
+				Expect(repairs).To(BeEmpty())
+				Expect(profile.Interfaces.Ethernet[0].Port.Name).To(Equal("enp24s0f0"))
+			})
+		})
+	})
+	Describe("FixProcessorFunctions", func() {
+		Context("When the profile releases all application-isolated cores", func() {
+			It("Should add a zero count function to the current profile", func() {
+				profile := &starlingxv1.HostProfileSpec{
+					Processors: starlingxv1.ProcessorNodeList{
+						{
+							Node: 0,
+							Functions: starlingxv1.ProcessorFunctionList{
+								{Function: "platform", Count: 2},
+								{Function: "application-isolated", Count: 0},
+							},
+						},
+						{
+							Node: 1,
+							Functions: starlingxv1.ProcessorFunctionList{
+								{Function: "application-isolated", Count: 0},
+							},
+						},
+					},
+				}
+				current := &starlingxv1.HostProfileSpec{
+					Processors: starlingxv1.ProcessorNodeList{
+						{
+							Node: 0,
+							Functions: starlingxv1.ProcessorFunctionList{
+								{Function: "platform", Count: 2},
+							},
+						},
+					},
+				}
+				FixProcessorFunctions(profile, current)
+				Expect(current.Processors.DeepEqual(&profile.Processors)).To(BeTrue())
+			})
+		})
+		Context("When the profile assigns application-isolated cores", func() {
+			It("Should not change the current profile", func() {
+				profile := &starlingxv1.HostProfileSpec{
+					Processors: starlingxv1.ProcessorNodeList{
+						{
+							Node: 0,
+							Functions: starlingxv1.ProcessorFunctionList{
+								{Function: "application-isolated", Count: 4},
+							},
+						},
+					},
+				}
+				current := &starlingxv1.HostProfileSpec{}
+				FixProcessorFunctions(profile, current)
+				Expect(current.Processors).To(BeEmpty())
+			})
+		})
+	})
+	Describe("FixPowerProfileLabels", func() {
+		Context("When a power profile is set", func() {
+			It("Should add the power manager labels", func() {
+				powerProfile := "balanced-power"
+				profile := &starlingxv1.HostProfileSpec{
+					ProfileBaseAttributes: starlingxv1.ProfileBaseAttributes{
+						PowerProfile: &powerProfile,
+						Labels: map[string]string{
+							"sriovdp": "enabled",
+						},
+					},
+				}
+				FixPowerProfileLabels(profile)
+				Expect(profile.Labels).To(Equal(map[string]string{
+					"sriovdp":          "enabled",
+					"power-management": "enabled",
+					"power-profile":    "balanced-power",
+				}))
+			})
+		})
+		Context("When no power profile is set", func() {
+			It("Should not change the labels", func() {
+				profile := &starlingxv1.HostProfileSpec{}
+				FixPowerProfileLabels(profile)
+				Expect(profile.Labels).To(BeNil())
+			})
+		})
+	})
+	Describe("FixPlatformMemory", func() {
+		Context("When platform memory is set in MiB", func() {
+			It("Should replace the platform function allocation", func() {
+				platform := 8192
+				profile := &starlingxv1.HostProfileSpec{
+					Memory: starlingxv1.MemoryNodeList{
+						{
+							Node:     0,
+							Platform: &platform,
+							Functions: starlingxv1.MemoryFunctionList{
+								{Function: "platform", PageSize: "4KB", PageCount: 1024},
+								{Function: "vm", PageSize: "1GB", PageCount: 4},
+							},
+						},
+						{
+							Node: 1,
+							Functions: starlingxv1.MemoryFunctionList{
+								{Function: "platform", PageSize: "4KB", PageCount: 1024},
+							},
+						},
+					},
+				}
+				FixPlatformMemory(profile)
+				Expect(profile.Memory).To(Equal(starlingxv1.MemoryNodeList{
+					{
+						Node: 0,
+						Functions: starlingxv1.MemoryFunctionList{
+							{Function: "platform", PageSize: "4KB", PageCount: 2097152},
+							{Function: "vm", PageSize: "1GB", PageCount: 4},
+						},
+					},
+					{
+						Node: 1,
+						Functions: starlingxv1.MemoryFunctionList{
+							{Function: "platform", PageSize: "4KB", PageCount: 1024},
+						},
+					},
+				}))
+			})
+		})
+	})
+	Describe("FixPCIDeviceSelectors", func() {
+		Context("When a PCI device is selected by vendor and device ID", func() {
+			It("Should expand the selector to each matching device", func() {
+				vendorID := "10de"
+				deviceID := "1eb8"
+				enabled := true
+				profile := &starlingxv1.HostProfileSpec{
+					Devices: &starlingxv1.DeviceInfo{
+						PCI: starlingxv1.PCIDeviceList{
+							{VendorID: &vendorID, DeviceID: &deviceID, Enabled: &enabled},
+						},
+					},
+				}
+				hostInfo := &v1info.HostInfo{
+					PCIDevices: []pcidevices.PCIDevice{
+						{PCIAddress: "0000:3b:00.0", ClassID: "030200", VendorID: "10de", DeviceID: "1eb8"},
+						{PCIAddress: "0000:af:00.0", ClassID: "030200", VendorID: "10de", DeviceID: "1eb8"},
+						{PCIAddress: "0000:00:02.0", ClassID: "030000", VendorID: "8086", DeviceID: "3e92"},
+					},
+				}
+				FixPCIDeviceSelectors(profile, hostInfo)
+				addr1 := "0000:3b:00.0"
+				addr2 := "0000:af:00.0"
+				Expect(profile.Devices.PCI).To(Equal(starlingxv1.PCIDeviceList{
+					{PCIAddress: &addr1, Enabled: &enabled},
+					{PCIAddress: &addr2, Enabled: &enabled},
+				}))
+			})
+		})
+	})
+	Describe("FixSensorGroups", func() {
+		Context("When the profile lists a subset of the sensor groups", func() {
+			It("Should only keep the listed groups in the current configuration", func() {
+				profile := &starlingxv1.HostProfileSpec{
+					SensorGroups: starlingxv1.SensorGroupList{
+						{Name: "server fans", SuppressedSensors: []string{"Fan 2", "Fan 1"}},
+					},
+				}
+				current := &starlingxv1.HostProfileSpec{
+					SensorGroups: starlingxv1.SensorGroupList{
+						{Name: "server fans"},
+						{Name: "server power"},
+					},
+				}
+				FixSensorGroups(profile, current)
+				Expect(current.SensorGroups).To(Equal(starlingxv1.SensorGroupList{{Name: "server fans"}}))
+				Expect(profile.SensorGroups[0].SuppressedSensors).To(Equal([]string{"Fan 1", "Fan 2"}))
+			})
+		})
+	})
 })
@@ -0,0 +1,237 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the reasons reported with the Reinstalling condition.
+const (
+	ReinstallReasonLocking      = "Locking"
+	ReinstallReasonReinstalling = "Reinstalling"
+	ReinstallReasonInstalling   = "Installing"
+	ReinstallReasonCompleted    = "Completed"
+	ReinstallReasonRejected     = "Rejected"
+)
+
+// reinstallRequested determines whether the reinstall annotation has been
+// set on a host.
+func reinstallRequested(instance *starlingxv1.Host) bool {
+	value, ok := instance.Annotations[cloudManager.ReinstallHost]
+	return ok && strings.EqualFold(value, "true")
+}
+
+// reinstallInProgress determines whether a reinstall has been issued to the
+// system and has not yet completed.
+func reinstallInProgress(instance *starlingxv1.Host) (string, bool) {
+	condition := meta.FindStatusCondition(instance.Status.Conditions, starlingxv1.ConditionReinstalling)
+	if condition == nil || condition.Status != metav1.ConditionTrue {
+		return "", false
+	}
+
+	switch condition.Reason {
+	case ReinstallReasonReinstalling, ReinstallReasonInstalling:
+		return condition.Reason, true
+	}
+
+	return "", false
+}
+
+// setReinstallingCondition updates the Reinstalling condition on the host
+// status.
+func (r *HostReconciler) setReinstallingCondition(instance *starlingxv1.Host, status metav1.ConditionStatus, reason, message string) error {
+	existing := meta.FindStatusCondition(instance.Status.Conditions, starlingxv1.ConditionReinstalling)
+	if existing != nil && existing.Status == status && existing.Reason == reason {
+		return nil
+	}
+
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:               starlingxv1.ConditionReinstalling,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: instance.Generation,
+	})
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
+// clearReinstallAnnotation removes the reinstall annotation from the host so
+// that the reinstall is only issued once.
+func (r *HostReconciler) clearReinstallAnnotation(instance *starlingxv1.Host) error {
+	delete(instance.Annotations, cloudManager.ReinstallHost)
+
+	err := r.Client.Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to remove %q annotation", cloudManager.ReinstallHost)
+		return err
+	}
+
+	return nil
+}
+
+// reconcileReinstallProgress is responsible for tracking a reinstall that has
+// already been issued.  The host is expected to go offline while it is being
+// installed and to return to the locked/disabled/online state once it is
+// ready to be configured again.
+func (r *HostReconciler) reconcileReinstallProgress(instance *starlingxv1.Host, reason string, host *hosts.Host) error {
+	if reason == ReinstallReasonReinstalling {
+		if host.AvailabilityStatus != hosts.AvailOffline && host.Idle() {
+			msg := "waiting for host to go offline for reinstall"
+			m := NewStateChangeMonitor(instance, host.ID)
+			return r.CloudManager.StartMonitor(m, msg)
+		}
+
+		err := r.setReinstallingCondition(instance, metav1.ConditionTrue,
+			ReinstallReasonInstalling, "host is being installed")
+		if err != nil {
+			return err
+		}
+	}
+
+	if host.AvailabilityStatus == hosts.AvailOffline || !host.IsLockedDisabled() {
+		msg := "waiting for host to come back online after reinstall"
+		admin := hosts.AdminLocked
+		oper := hosts.OperDisabled
+		avail := hosts.AvailOnline
+		m := NewStateMonitor(instance, host.ID, &admin, &oper, &avail)
+		return r.CloudManager.StartMonitor(m, msg)
+	}
+
+	// The host is back so the full profile needs to be applied again as if
+	// it were being provisioned for the first time.
+	instance.Status.Reconciled = false
+	instance.Status.InSync = false
+
+	err := r.setReinstallingCondition(instance, metav1.ConditionFalse,
+		ReinstallReasonCompleted, "host has been reinstalled")
+	if err != nil {
+		return err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"host has been reinstalled")
+
+	return nil
+}
+
+// ReconcileReinstall is responsible for rebuilding a host when the reinstall
+// annotation is set.  The host is locked, the reinstall is issued, and the
+// reconciler then waits for the host to be installed before the profile is
+// applied again.
+func (r *HostReconciler) ReconcileReinstall(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *hosts.Host) error {
+	if reason, ok := reinstallInProgress(instance); ok {
+		return r.reconcileReinstallProgress(instance, reason, host)
+	}
+
+	if !reinstallRequested(instance) {
+		return nil
+	}
+
+	if isActiveController(*host) && !r.SwactAllowed() {
+		msg := "the active controller cannot be reinstalled"
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated, msg)
+
+		err := r.setReinstallingCondition(instance, metav1.ConditionFalse, ReinstallReasonRejected, msg)
+		if err != nil {
+			return err
+		}
+
+		return r.clearReinstallAnnotation(instance)
+	}
+
+	if !host.Stable() {
+		msg := "waiting for a stable state before reinstalling host"
+		m := NewStableHostMonitor(instance, host.ID)
+		return r.CloudManager.StartMonitor(m, msg)
+	}
+
+	if !host.IsLockedDisabled() {
+		err := r.setReinstallingCondition(instance, metav1.ConditionTrue,
+			ReinstallReasonLocking, "locking host before reinstalling it")
+		if err != nil {
+			return err
+		}
+
+		err = r.ReconcilePreflight(instance, hosts.ActionLock)
+		if err != nil {
+			return err
+		}
+
+		err = r.ReconcileSwact(client, instance, host)
+		if err != nil {
+			return err
+		}
+
+		action := hosts.ActionLock
+		opts := hosts.HostOpts{Action: &action}
+
+		logHost.Info("locking host", "opts", opts)
+
+		_, err = hosts.Update(client, host.ID, opts).Extract()
+		if err != nil {
+			err = perrors.Wrap(err, "failed to lock host")
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "host has been locked")
+
+		msg := "waiting for host to lock before reinstalling it"
+		m := NewLockedDisabledHostMonitor(instance, host.ID)
+		return r.CloudManager.StartMonitor(m, msg)
+	}
+
+	err := r.ReconcilePreflight(instance, hosts.ActionReinstall)
+	if err != nil {
+		return err
+	}
+
+	action := hosts.ActionReinstall
+	opts := hosts.HostOpts{Action: &action}
+
+	logHost.Info("reinstalling host", "opts", opts)
+
+	_, err = hosts.Update(client, host.ID, opts).Extract()
+	if err != nil {
+		err = perrors.Wrap(err, "failed to reinstall host")
+		return err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "host reinstall has been issued")
+
+	err = r.setReinstallingCondition(instance, metav1.ConditionTrue,
+		ReinstallReasonReinstalling, "host reinstall has been issued")
+	if err != nil {
+		return err
+	}
+
+	err = r.clearReinstallAnnotation(instance)
+	if err != nil {
+		return err
+	}
+
+	r.StartConsoleCapture(instance, profile)
+
+	msg := "waiting for host to go offline for reinstall"
+	m := NewStateChangeMonitor(instance, host.ID)
+	return r.CloudManager.StartMonitor(m, msg)
+}
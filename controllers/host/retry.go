@@ -0,0 +1,120 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"time"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// retryState tracks the consecutive reconcile failures of a single host.  The
+// count is discarded whenever the host resource is updated.
+type retryState struct {
+	generation int64
+	failures   int
+}
+
+// retryDelay computes the delay before the next retry of a host based on its
+// reconcile policy and the number of consecutive failures.  The delay chosen
+// by the error handler is used as the initial delay unless overridden.
+func retryDelay(policy *starlingxv1.ReconcilePolicyInfo, failures int, delay time.Duration) time.Duration {
+	if policy.RetryInterval != nil {
+		delay = time.Duration(*policy.RetryInterval) * time.Second
+	}
+
+	if policy.BackoffFactor != nil {
+		for i := 1; i < failures; i++ {
+			delay *= time.Duration(*policy.BackoffFactor)
+			if policy.MaxRetryInterval != nil && delay >= time.Duration(*policy.MaxRetryInterval)*time.Second {
+				break
+			}
+		}
+	}
+
+	if policy.MaxRetryInterval != nil {
+		if limit := time.Duration(*policy.MaxRetryInterval) * time.Second; delay > limit {
+			delay = limit
+		}
+	}
+
+	return delay
+}
+
+// recordReconcileFailure increments the number of consecutive reconcile
+// failures of a host and returns the new count.
+func (r *HostReconciler) recordReconcileFailure(instance *starlingxv1.Host) int {
+	name := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+
+	r.retryLock.Lock()
+	defer r.retryLock.Unlock()
+
+	if r.retries == nil {
+		r.retries = make(map[types.NamespacedName]*retryState)
+	}
+
+	state, ok := r.retries[name]
+	if !ok || state.generation != instance.Generation {
+		state = &retryState{generation: instance.Generation}
+		r.retries[name] = state
+	}
+
+	state.failures++
+
+	return state.failures
+}
+
+// clearReconcileFailures discards the consecutive reconcile failures of a
+// host once it has been reconciled successfully.
+func (r *HostReconciler) clearReconcileFailures(instance *starlingxv1.Host) {
+	name := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+
+	r.retryLock.Lock()
+	defer r.retryLock.Unlock()
+
+	delete(r.retries, name)
+}
+
+// applyReconcilePolicy adjusts the result selected by the error handler
+// according to the reconcile policy of the host.  Waiting on the state of a
+// resource is not considered a failure and is left untouched, as are results
+// which do not request a retry.
+func (r *HostReconciler) applyReconcilePolicy(instance *starlingxv1.Host, cause error, result ctrl.Result, err error) (ctrl.Result, error) {
+	policy := instance.Spec.ReconcilePolicy
+	if policy == nil {
+		return result, err
+	}
+
+	if _, ok := perrors.Cause(cause).(common.ErrResourceStatusDependency); ok {
+		return result, err
+	}
+
+	if err == nil && !result.Requeue && result.RequeueAfter == 0 {
+		return result, err
+	}
+
+	failures := r.recordReconcileFailure(instance)
+
+	if policy.RetryLimit != nil && failures >= *policy.RetryLimit {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"reconcile has failed %d consecutive times; retries are suspended until the host is updated", failures)
+		return ctrl.Result{}, nil
+	}
+
+	if err != nil {
+		// The policy replaces the default rate limiter therefore the error
+		// is logged here rather than being returned.
+		logHost.Error(err, "reconcile failed", "failures", failures)
+		err = nil
+	}
+
+	delay := retryDelay(policy, failures, result.RequeueAfter)
+	logHost.V(2).Info("retrying reconcile", "failures", failures, "delay", delay)
+
+	return ctrl.Result{Requeue: true, RequeueAfter: delay}, err
+}
@@ -0,0 +1,284 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultMaxConcurrentReconciles defines the number of hosts which are
+// reconciled in parallel unless configured otherwise.
+const DefaultMaxConcurrentReconciles = 4
+
+// Defines the order in which hosts are provisioned.  Hosts of the same tier
+// are reconciled in parallel while a host is only provisioned once all hosts
+// of the lower tiers have been reconciled.
+const (
+	TierActiveController = iota
+	TierController
+	TierStorage
+	TierWorker
+)
+
+// Defines the reasons reported with the ProvisioningOrder condition.
+const (
+	ProvisioningReasonWaitingForHosts    = "WaitingForHosts"
+	ProvisioningReasonWaitingForMonitors = "WaitingForMonitors"
+	ProvisioningReasonScheduled          = "Scheduled"
+)
+
+// maxConcurrentReconciles returns the number of hosts which may be reconciled
+// in parallel.
+func maxConcurrentReconciles() int {
+	value := utils.GetReconcilerOptionInt(utils.Host, utils.MaxConcurrentReconciles, DefaultMaxConcurrentReconciles)
+	if value < 1 {
+		return 1
+	}
+
+	return value
+}
+
+// provisioningTier returns the provisioning tier of a host based on its name
+// and personality.
+func provisioningTier(name string, personality *string) int {
+	if personality == nil {
+		return TierWorker
+	}
+
+	switch *personality {
+	case hosts.PersonalityController:
+		if name == hosts.Controller0 {
+			return TierActiveController
+		}
+		return TierController
+	case hosts.PersonalityStorage:
+		return TierStorage
+	}
+
+	return TierWorker
+}
+
+// hostOrdinal returns the number at the end of a host name.  Storage nodes
+// are numbered in the order in which they must be provisioned.
+func hostOrdinal(name string) (int, bool) {
+	index := strings.LastIndexFunc(name, func(c rune) bool {
+		return c < '0' || c > '9'
+	})
+
+	value, err := strconv.Atoi(name[index+1:])
+	if err != nil {
+		return 0, false
+	}
+
+	return value, true
+}
+
+// provisionedBefore determines whether a host must be provisioned before
+// another host.  Hosts of a lower tier always come first while storage nodes
+// are provisioned one at a time in the order of their ordinal so that
+// storage-0 is added before storage-1.
+func provisionedBefore(name string, tier int, other string, otherTier int) bool {
+	if otherTier != tier {
+		return otherTier < tier
+	}
+
+	if tier != TierStorage {
+		return false
+	}
+
+	ordinal, ok := hostOrdinal(name)
+	otherOrdinal, otherOk := hostOrdinal(other)
+	if !ok || !otherOk {
+		return false
+	}
+
+	return otherOrdinal < ordinal
+}
+
+// setHosts stores the latest snapshot of the hosts of the system.
+func (r *HostReconciler) setHosts(objects []hosts.Host) {
+	r.hostsLock.Lock()
+	defer r.hostsLock.Unlock()
+
+	r.hosts = objects
+}
+
+// getHosts returns the latest snapshot of the hosts of the system.  The
+// snapshot is shared by all hosts being reconciled and must not be modified.
+func (r *HostReconciler) getHosts() []hosts.Host {
+	r.hostsLock.RLock()
+	defer r.hostsLock.RUnlock()
+
+	return r.hosts
+}
+
+// storedPersonality returns the personality recorded in the status of a host.
+// Hosts which have not recorded it yet have their profile resolved instead.
+func (r *HostReconciler) storedPersonality(instance *starlingxv1.Host) (*string, error) {
+	if instance.Status.Personality != nil {
+		return instance.Status.Personality, nil
+	}
+
+	profile, err := r.BuildCompositeProfile(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile.Personality, nil
+}
+
+// PendingPredecessors returns the names of the hosts which must be provisioned
+// before a host and have yet to be reconciled.  The tier of the other hosts is
+// based on the personality recorded in their status.  Hosts whose profile
+// cannot be resolved are ignored so that they do not block the others.
+func (r *HostReconciler) PendingPredecessors(instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec) ([]string, error) {
+	result := make([]string, 0)
+
+	tier := provisioningTier(instance.Name, profile.Personality)
+	if tier == TierActiveController {
+		return result, nil
+	}
+
+	list := &starlingxv1.HostList{}
+	err := r.List(context.TODO(), list, client.InNamespace(instance.Namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return nil, err
+	}
+
+	for i := range list.Items {
+		other := &list.Items[i]
+		if other.Name == instance.Name || other.Status.Reconciled || !other.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		personality, err := r.storedPersonality(other)
+		if err != nil {
+			logHost.V(2).Info("ignoring host with unresolved profile", "host", other.Name, "error", err.Error())
+			continue
+		}
+
+		otherTier := provisioningTier(other.Name, personality)
+		if provisionedBefore(instance.Name, tier, other.Name, otherTier) {
+			result = append(result, other.Name)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// recordPersonality stores the personality of a host in its status so that
+// the other hosts can determine their provisioning order from it.
+func (r *HostReconciler) recordPersonality(instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec) error {
+	if profile.Personality == nil {
+		return nil
+	} else if instance.Status.Personality != nil && *instance.Status.Personality == *profile.Personality {
+		return nil
+	}
+
+	personality := *profile.Personality
+	instance.Status.Personality = &personality
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
+// setProvisioningOrderCondition updates the ProvisioningOrder condition on
+// the host status.  The condition is only added once a host has had to wait
+// for other hosts at least once.
+func (r *HostReconciler) setProvisioningOrderCondition(instance *starlingxv1.Host, status metav1.ConditionStatus, reason, message string) error {
+	existing := meta.FindStatusCondition(instance.Status.Conditions, starlingxv1.ConditionProvisioningOrder)
+	if existing == nil && status == metav1.ConditionFalse {
+		return nil
+	} else if existing != nil && existing.Status == status && existing.Reason == reason && existing.Message == message {
+		return nil
+	}
+
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:               starlingxv1.ConditionProvisioningOrder,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: instance.Generation,
+	})
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
+// ReconcileProvisioningOrder delays the initial reconciliation of a host until
+// the hosts which must be provisioned before it have been reconciled.  The
+// order is controller-0, the other controllers, the storage nodes and then the
+// worker nodes.  Storage nodes are provisioned in the order of their ordinal
+// and only once the minimum number of storage monitors are enabled.  Hosts
+// which have already been reconciled are never delayed.  The reason a host is
+// waiting is reported with the ProvisioningOrder condition.
+func (r *HostReconciler) ReconcileProvisioningOrder(instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec) error {
+	if instance.Status.Reconciled {
+		return nil
+	}
+
+	err := r.recordPersonality(instance, profile)
+	if err != nil {
+		return err
+	}
+
+	pending, err := r.PendingPredecessors(instance, profile)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) > 0 {
+		msg := fmt.Sprintf("waiting for hosts to be reconciled first: %s", strings.Join(pending, ", "))
+		err = r.setProvisioningOrderCondition(instance, metav1.ConditionTrue, ProvisioningReasonWaitingForHosts, msg)
+		if err != nil {
+			return err
+		}
+
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	if provisioningTier(instance.Name, profile.Personality) == TierStorage {
+		if !r.MonitorsEnabled(hosts.OSDMinimumMonitorCount) {
+			msg := fmt.Sprintf("waiting for %d storage monitors to be enabled",
+				hosts.OSDMinimumMonitorCount)
+			err = r.setProvisioningOrderCondition(instance, metav1.ConditionTrue, ProvisioningReasonWaitingForMonitors, msg)
+			if err != nil {
+				return err
+			}
+
+			return common.NewResourceStatusDependency(msg)
+		}
+	}
+
+	return r.setProvisioningOrderCondition(instance, metav1.ConditionFalse,
+		ProvisioningReasonScheduled, "the host is no longer waiting for other hosts")
+}
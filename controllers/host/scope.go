@@ -0,0 +1,155 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+)
+
+// Defines the configuration areas of a host which support a deployment scope
+// of their own.
+const (
+	SubsectionStorage    = "storage"
+	SubsectionInterfaces = "interfaces"
+	SubsectionPTP        = "ptp"
+	SubsectionKernel     = "kernel"
+)
+
+// normalizeScope converts a deployment scope to its lower case form and
+// rejects unsupported values.
+func normalizeScope(scope *string) (*string, error) {
+	if scope == nil || *scope == "" {
+		return nil, nil
+	}
+
+	result := strings.ToLower(*scope)
+	switch result {
+	case cloudManager.ScopeBootstrap, cloudManager.ScopePrincipal:
+		return &result, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported DeploymentScope: %s", *scope)
+}
+
+// GetSubsectionScopeConfig obtains the deployment scope of each configuration
+// area from the last applied configuration in the same way as the deployment
+// scope of the host.  A nil result means that every area follows the scope
+// of the host.
+func (r *HostReconciler) GetSubsectionScopeConfig(instance *starlingxv1.Host) (*starlingxv1.HostSubsectionScopes, error) {
+	annotation := instance.GetObjectMeta().GetAnnotations()
+	if annotation == nil {
+		return nil, nil
+	}
+
+	config, ok := annotation["kubectl.kubernetes.io/last-applied-configuration"]
+	if !ok {
+		return nil, nil
+	}
+
+	status_config := &starlingxv1.Host{}
+	err := json.Unmarshal([]byte(config), &status_config)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to Unmarshal annotaion last-applied-configuration")
+		return nil, err
+	}
+
+	in := status_config.Status.SubsectionScopes
+	if in == nil {
+		return nil, nil
+	}
+
+	result := starlingxv1.HostSubsectionScopes{}
+	for _, s := range []struct {
+		in  *string
+		out **string
+	}{
+		{in.Storage, &result.Storage},
+		{in.Interfaces, &result.Interfaces},
+		{in.PTP, &result.PTP},
+		{in.Kernel, &result.Kernel},
+	} {
+		*s.out, err = normalizeScope(s.in)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &result, nil
+}
+
+// subsectionInBootstrap determines whether a configuration area has been
+// kept in the bootstrap scope.
+func subsectionInBootstrap(scope *string) bool {
+	return scope != nil && *scope == cloudManager.ScopeBootstrap
+}
+
+// lockBootstrapSubsections replaces the configuration areas of the desired
+// profile which are kept in the bootstrap scope with the current
+// configuration so that day-2 operations do not change them.  The names of
+// the areas that differ from the current configuration are returned.
+func lockBootstrapSubsections(profile, current *starlingxv1.HostProfileSpec, scopes *starlingxv1.HostSubsectionScopes) []string {
+	locked := make([]string, 0)
+	if scopes == nil || current == nil {
+		return locked
+	}
+
+	if subsectionInBootstrap(scopes.Storage) {
+		if (profile.Storage == nil) != (current.Storage == nil) ||
+			(profile.Storage != nil && !profile.Storage.DeepEqual(current.Storage)) {
+			locked = append(locked, SubsectionStorage)
+		}
+		profile.Storage = current.Storage.DeepCopy()
+	}
+
+	if subsectionInBootstrap(scopes.Interfaces) {
+		if (profile.Interfaces == nil) != (current.Interfaces == nil) ||
+			(profile.Interfaces != nil && !profile.Interfaces.DeepEqual(current.Interfaces)) {
+			locked = append(locked, SubsectionInterfaces)
+		}
+		profile.Interfaces = current.Interfaces.DeepCopy()
+	}
+
+	if subsectionInBootstrap(scopes.PTP) {
+		if !profile.PtpInstances.DeepEqual(&current.PtpInstances) ||
+			!stringPointersEqual(profile.ClockSynchronization, current.ClockSynchronization) {
+			locked = append(locked, SubsectionPTP)
+		}
+		profile.PtpInstances = current.PtpInstances.DeepCopy()
+		profile.ClockSynchronization = copyStringPointer(current.ClockSynchronization)
+	}
+
+	if subsectionInBootstrap(scopes.Kernel) {
+		if !stringPointersEqual(profile.Kernel, current.Kernel) {
+			locked = append(locked, SubsectionKernel)
+		}
+		profile.Kernel = copyStringPointer(current.Kernel)
+	}
+
+	return locked
+}
+
+// stringPointersEqual compares the values referenced by two string pointers.
+func stringPointersEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// copyStringPointer returns a pointer to a copy of the referenced string.
+func copyStringPointer(in *string) *string {
+	if in == nil {
+		return nil
+	}
+
+	out := *in
+	return &out
+}
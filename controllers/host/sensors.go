@@ -0,0 +1,159 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/sensorgroups"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/sensors"
+)
+
+// formatSuppress is a utility function which converts a boolean suppression
+// state to the string representation expected by the system API.
+func formatSuppress(value bool) string {
+	if value {
+		return sensorgroups.SuppressTrue
+	}
+	return sensorgroups.SuppressFalse
+}
+
+// sensorGroupUpdateRequired is a utility function which determines whether an
+// update is required to adjust the configuration of a sensor group.
+func sensorGroupUpdateRequired(info starlingxv1.SensorGroupInfo, group *sensorgroups.SensorGroup) (opts sensorgroups.SensorGroupOpts, result bool) {
+	if info.AuditInterval != nil && *info.AuditInterval != group.AuditInterval {
+		opts.AuditInterval = info.AuditInterval
+		result = true
+	}
+
+	if info.Suppress != nil && *info.Suppress != group.IsSuppressed() {
+		suppress := formatSuppress(*info.Suppress)
+		opts.Suppress = &suppress
+		result = true
+	}
+
+	if info.ActionsCritical != nil && *info.ActionsCritical != group.ActionsCritical {
+		opts.ActionsCritical = info.ActionsCritical
+		result = true
+	}
+
+	if info.ActionsMajor != nil && *info.ActionsMajor != group.ActionsMajor {
+		opts.ActionsMajor = info.ActionsMajor
+		result = true
+	}
+
+	if info.ActionsMinor != nil && *info.ActionsMinor != group.ActionsMinor {
+		opts.ActionsMinor = info.ActionsMinor
+		result = true
+	}
+
+	return opts, result
+}
+
+// ReconcileSuppressedSensors is responsible for reconciling the suppression
+// state of the individual sensors of a sensor group.
+func (r *HostReconciler) ReconcileSuppressedSensors(client *gophercloud.ServiceClient, instance *starlingxv1.Host, info starlingxv1.SensorGroupInfo, group *sensorgroups.SensorGroup, host *v1info.HostInfo) (bool, error) {
+	updated := false
+
+	suppressed := make(map[string]bool)
+	for _, name := range info.SuppressedSensors {
+		suppressed[name] = true
+	}
+
+	for _, sensor := range host.FindSensorsByGroup(group.ID) {
+		if suppressed[sensor.Name] == sensor.IsSuppressed() {
+			continue
+		}
+
+		suppress := formatSuppress(suppressed[sensor.Name])
+		opts := sensors.SensorOpts{Suppress: &suppress}
+
+		logHost.Info("updating sensor", "group", group.Name, "sensor", sensor.Name, "opts", opts)
+
+		_, err := sensors.Update(client, sensor.ID, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update sensor: %s, %s",
+				sensor.ID, utils.FormatStruct(opts))
+			return updated, err
+		}
+
+		updated = true
+	}
+
+	return updated, nil
+}
+
+// ReconcileSensorGroups is responsible for reconciling the alarm policies of
+// the sensor groups of a host resource.
+func (r *HostReconciler) ReconcileSensorGroups(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	if len(profile.SensorGroups) == 0 || !common.IsReconcilerEnabled(common.SensorGroup) {
+		return nil
+	}
+
+	updated := false
+
+	for _, info := range profile.SensorGroups {
+		group, found := host.FindSensorGroupByName(info.Name)
+		if !found {
+			msg := fmt.Sprintf("unable to find sensor group: %s", info.Name)
+			return starlingxv1.NewMissingSystemResource(msg)
+		}
+
+		if opts, ok := sensorGroupUpdateRequired(info, group); ok {
+			logHost.Info("updating sensor group", "name", info.Name, "opts", opts)
+
+			_, err := sensorgroups.Update(client, group.ID, opts).Extract()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to update sensor group: %s, %s",
+					group.ID, utils.FormatStruct(opts))
+				return err
+			}
+
+			r.NormalEvent(instance, utils.ResourceUpdated,
+				"sensor group %q has been updated", info.Name)
+
+			updated = true
+		}
+
+		if info.SuppressedSensors != nil {
+			changed, err := r.ReconcileSuppressedSensors(client, instance, info, group, host)
+			if err != nil {
+				return err
+			}
+
+			if changed {
+				r.NormalEvent(instance, utils.ResourceUpdated,
+					"suppressed sensors of group %q have been updated", info.Name)
+			}
+
+			updated = updated || changed
+		}
+	}
+
+	if updated {
+		results, err := sensorgroups.ListSensorGroups(client, host.ID)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to refresh host sensor group list")
+			return err
+		}
+
+		host.SensorGroups = results
+
+		objects, err := sensors.ListSensors(client, host.ID)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to refresh host sensor list")
+			return err
+		}
+
+		host.Sensors = objects
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+	"fmt"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// snapshotRequested determines whether the snapshot annotation has been
+// added to the host.
+func snapshotRequested(instance *starlingxv1.Host) bool {
+	_, ok := instance.Annotations[cloudManager.SnapshotProfile]
+	return ok
+}
+
+// snapshotProfileName returns the name of the profile to be created from the
+// current configuration of a host.  The annotation value is used when it is
+// set; otherwise the name is derived from the host name.
+func snapshotProfileName(instance *starlingxv1.Host) string {
+	if name := instance.Annotations[cloudManager.SnapshotProfile]; name != "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s-snapshot", instance.Name)
+}
+
+// NewSnapshotProfile builds a HostProfile from the current configuration of a
+// host.  Attributes which are unique to each host are removed so that the
+// resulting profile can be applied to other hosts.
+func NewSnapshotProfile(name string, namespace string, hostInfo v1info.HostInfo) (*starlingxv1.HostProfile, error) {
+	profile, err := starlingxv1.NewHostProfile(name, namespace, hostInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.Name = name
+	profile.Spec.BootMAC = nil
+	profile.Spec.Location = nil
+	profile.Spec.Addresses = nil
+	profile.Spec.ProvisioningMode = nil
+
+	if profile.Spec.BoardManagement != nil {
+		profile.Spec.BoardManagement.Address = nil
+	}
+
+	return profile, nil
+}
+
+// clearSnapshotAnnotation removes the snapshot annotation from the host so
+// that the snapshot is only taken once.
+func (r *HostReconciler) clearSnapshotAnnotation(instance *starlingxv1.Host) error {
+	delete(instance.Annotations, cloudManager.SnapshotProfile)
+
+	err := r.Client.Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to remove %q annotation", cloudManager.SnapshotProfile)
+		return err
+	}
+
+	return nil
+}
+
+// ReconcileProfileSnapshot is responsible for capturing the current
+// configuration of a host into a new HostProfile resource whenever the
+// snapshot annotation is added to the host.  An existing profile is never
+// overwritten.
+func (r *HostReconciler) ReconcileProfileSnapshot(instance *starlingxv1.Host, hostInfo *v1info.HostInfo) error {
+	if !snapshotRequested(instance) {
+		return nil
+	}
+
+	if !hostInfo.IsInventoryCollected() {
+		// The snapshot is taken on a later reconciliation once the
+		// configuration of the host is complete.
+		logHost.Info("waiting for inventory collection to complete before taking a profile snapshot")
+		return nil
+	}
+
+	name := snapshotProfileName(instance)
+
+	existing := &starlingxv1.HostProfile{}
+	key := types.NamespacedName{Namespace: instance.Namespace, Name: name}
+	err := r.Client.Get(context.TODO(), key, existing)
+	if err == nil {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceCreated,
+			"profile snapshot not taken; host profile %q already exists", name)
+		return r.clearSnapshotAnnotation(instance)
+	} else if !errors.IsNotFound(err) {
+		err = perrors.Wrapf(err, "failed to get profile: %s", key)
+		return err
+	}
+
+	profile, err := NewSnapshotProfile(name, instance.Namespace, *hostInfo)
+	if err != nil {
+		return err
+	}
+
+	logHost.Info("creating profile snapshot", "profile", name)
+
+	err = r.Client.Create(context.TODO(), profile)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to create profile snapshot: %s", name)
+		return err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+		"host profile %q has been created from the current configuration", name)
+
+	return r.clearSnapshotAnnotation(instance)
+}
@@ -624,6 +624,14 @@ func (r *HostReconciler) ReconcileOSDs(client *gophercloud.ServiceClient, instan
 		return nil
 	}
 
+	// Journals that do not fit on their disk would otherwise only be rejected
+	// by the system API once the regular OSDs are created.
+	hardware := starlingxv1.NewHostHardwareStatus(*host)
+	err := starlingxv1.ValidateJournalSizing(profile, hardware.Disks)
+	if err != nil {
+		return ctrlcommon.NewUserDataError(err.Error())
+	}
+
 	// Journal OSDs must be added before regular OSDs since regular OSDs must
 	// reference Journal OSDs by UUID.
 	for _, f := range []string{osds.FunctionJournal, osds.FunctionOSD} {
@@ -0,0 +1,85 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+)
+
+// ActionSwact defines the host action used to transfer the services of the
+// active controller to the standby controller.
+const ActionSwact = "swact"
+
+// isActiveController is a utility which determines whether a host is
+// currently the active controller.
+func isActiveController(host hosts.Host) bool {
+	return host.Capabilities.Personality != nil &&
+		strings.EqualFold(*host.Capabilities.Personality, hosts.ActiveController)
+}
+
+// findStandbyController is a utility which returns the controller that is able
+// to take over the services of the active controller.
+func findStandbyController(objects []hosts.Host, id string) *hosts.Host {
+	for i := range objects {
+		host := &objects[i]
+		if host.ID == id || host.Personality != hosts.PersonalityController {
+			continue
+		}
+
+		if host.IsUnlockedAvailable() {
+			return host
+		}
+	}
+
+	return nil
+}
+
+// SwactAllowed determines whether the reconciler may swact the active
+// controller before locking it.
+func (r *HostReconciler) SwactAllowed() bool {
+	return utils.GetReconcilerOptionBool(utils.Host, utils.AllowSwact, false)
+}
+
+// ReconcileSwact is responsible for moving the services of the active
+// controller to the standby controller so that the host can then be locked.
+// Nothing is done unless the host is the active controller and swacts have
+// been allowed by the operator.  A monitor is started once the swact has been
+// issued therefore callers must not continue with the lock until this
+// function returns nil.
+func (r *HostReconciler) ReconcileSwact(client *gophercloud.ServiceClient, instance *starlingxv1.Host, host *hosts.Host) error {
+	if !isActiveController(*host) || !r.SwactAllowed() {
+		return nil
+	}
+
+	standby := findStandbyController(r.getHosts(), host.ID)
+	if standby == nil {
+		msg := "waiting for an available standby controller before swact"
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	action := ActionSwact
+	opts := hosts.HostOpts{Action: &action}
+
+	logHost.Info("swacting controller", "standby", standby.Hostname, "opts", opts)
+
+	_, err := hosts.Update(client, host.ID, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to swact host: %s", host.ID)
+		return err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"services are being transferred to %s", standby.Hostname)
+
+	msg := "waiting for controller services to be transferred"
+	m := NewSwactMonitor(instance, host.ID)
+	return r.CloudManager.StartMonitor(m, msg)
+}
@@ -0,0 +1,198 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// taintID returns the identifier of a node taint.  It matches the identifier
+// used for the taints of a host profile.
+func taintID(taint v1.Taint) string {
+	return taint.Key + ":" + string(taint.Effect)
+}
+
+// mergeNodeTaints returns the taints to be set on a node along with the
+// updated list of taints owned by the Deployment Manager.  Taints which are
+// no longer desired are only removed if they are owned; taints applied by
+// other actors are left untouched unless the profile sets a taint with the
+// same key and effect.  Existing taints which already match the profile are
+// kept as-is so that their creation time is preserved.
+func mergeNodeTaints(existing []v1.Taint, desired starlingxv1.NodeTaintList, managed []string) (result []v1.Taint, owned []string, changed bool) {
+	wanted := make(map[string]starlingxv1.NodeTaint)
+	for _, t := range desired {
+		wanted[t.ID()] = t
+	}
+
+	matched := make(map[string]bool)
+	for _, t := range existing {
+		id := taintID(t)
+		if w, ok := wanted[id]; ok {
+			if w.Value == t.Value {
+				matched[id] = true
+				result = append(result, t)
+			} else {
+				changed = true
+			}
+		} else if utils.ContainsString(managed, id) {
+			changed = true
+		} else {
+			result = append(result, t)
+		}
+	}
+
+	for _, t := range desired {
+		id := t.ID()
+		if !matched[id] {
+			result = append(result, v1.Taint{
+				Key:    t.Key,
+				Value:  t.Value,
+				Effect: v1.TaintEffect(t.Effect),
+			})
+			owned = append(owned, id)
+			changed = true
+		} else if utils.ContainsString(managed, id) {
+			owned = append(owned, id)
+		}
+	}
+
+	sort.Strings(owned)
+
+	return result, owned, changed
+}
+
+// ownedNodeTaints returns the taints of a node which are either part of the
+// profile or owned by the Deployment Manager.  Taints applied by other actors
+// are never removed therefore they must not cause the host to appear out of
+// sync.
+func ownedNodeTaints(taints []v1.Taint, profile *starlingxv1.HostProfileSpec, managed []string) starlingxv1.NodeTaintList {
+	var result starlingxv1.NodeTaintList
+
+	for _, t := range taints {
+		id := taintID(t)
+
+		desired := false
+		for _, p := range profile.Taints {
+			if p.ID() == id {
+				desired = true
+				break
+			}
+		}
+
+		if desired || utils.ContainsString(managed, id) {
+			result = append(result, starlingxv1.NodeTaint{
+				Key:    t.Key,
+				Value:  t.Value,
+				Effect: string(t.Effect),
+			})
+		}
+	}
+
+	return result
+}
+
+// updateManagedTaints persists the list of taints owned by the Deployment
+// Manager to the host status.
+func (r *HostReconciler) updateManagedTaints(instance *starlingxv1.Host, owned []string) error {
+	if !utils.ListChanged(owned, instance.Status.ManagedTaints) {
+		return nil
+	}
+
+	if len(owned) == 0 {
+		owned = nil
+	}
+
+	instance.Status.ManagedTaints = owned
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to update managed taints")
+		return err
+	}
+
+	return nil
+}
+
+// ReconcileTaints is responsible for reconciling the taints of the kubernetes
+// node running on the host.  Taints can be changed without locking the host
+// therefore they are reconciled regardless of the host state.  The node
+// taints which are part of the profile or owned by the Deployment Manager are
+// returned so that they can be compared against the profile.
+func (r *HostReconciler) ReconcileTaints(instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) (starlingxv1.NodeTaintList, error) {
+	if len(profile.Taints) == 0 && len(instance.Status.ManagedTaints) == 0 {
+		return nil, nil
+	}
+
+	node := &v1.Node{}
+	owned := instance.Status.ManagedTaints
+	updated := false
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		key := types.NamespacedName{Name: host.Hostname}
+		err := r.Client.Get(context.TODO(), key, node)
+		if err != nil {
+			return err
+		}
+
+		var taints []v1.Taint
+		var changed bool
+
+		taints, owned, changed = mergeNodeTaints(node.Spec.Taints, profile.Taints, instance.Status.ManagedTaints)
+		if !changed {
+			return nil
+		}
+
+		logHost.Info("updating node taints", "node", node.Name, "taints", taints)
+
+		node.Spec.Taints = taints
+		err = r.Client.Update(context.TODO(), node)
+		if err != nil {
+			return err
+		}
+
+		updated = true
+		return nil
+	})
+
+	if errors.IsNotFound(err) {
+		if host.IsUnlockedEnabled() {
+			msg := fmt.Sprintf("waiting for kubernetes node %s to be registered before applying taints",
+				host.Hostname)
+			return nil, common.NewResourceConfigurationDependency(msg)
+		}
+
+		// The node is only registered once the host is first unlocked so
+		// there is nothing to compare against until then.
+		logHost.V(2).Info("kubernetes node not registered yet", "node", host.Hostname)
+		return profile.Taints, nil
+
+	} else if err != nil {
+		err = perrors.Wrapf(err, "failed to update taints of node %s", host.Hostname)
+		return nil, err
+	}
+
+	if updated {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"taints updated on node %q", host.Hostname)
+	}
+
+	err = r.updateManagedTaints(instance, owned)
+	if err != nil {
+		return nil, err
+	}
+
+	return ownedNodeTaints(node.Spec.Taints, profile, instance.Status.ManagedTaints), nil
+}
@@ -0,0 +1,107 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+)
+
+// ActionForceUnlock defines the host action used to unlock a host while
+// bypassing the checks that would otherwise reject a normal unlock.
+const ActionForceUnlock = "force-unlock"
+
+// ForceUnlockThreshold defines the number of normal unlock attempts that must
+// fail before a force unlock is issued.
+const ForceUnlockThreshold = 3
+
+// forceUnlockEnabled determines whether the operator has allowed the host to
+// be force unlocked.
+func forceUnlockEnabled(instance *starlingxv1.Host) bool {
+	return instance.Spec.ForceUnlock != nil && *instance.Spec.ForceUnlock
+}
+
+// unlockAction determines which unlock action should be issued to the host
+// based on the number of unlock attempts that have already failed.
+func unlockAction(instance *starlingxv1.Host) string {
+	if forceUnlockEnabled(instance) && instance.Status.UnlockAttempts >= ForceUnlockThreshold {
+		return ActionForceUnlock
+	}
+
+	return hosts.ActionUnlock
+}
+
+// recordUnlockAttempt increments the number of unlock requests issued to the
+// host and stores it in the host status so that it survives restarts of the
+// reconciler.
+func (r *HostReconciler) recordUnlockAttempt(instance *starlingxv1.Host) error {
+	instance.Status.UnlockAttempts++
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
+// ReconcileUnlock is responsible for issuing the unlock request to a host.
+// The unlock is withheld while management affecting alarms are active.
+// Repeated failures are reported as warnings, and once the threshold has been
+// reached the host is force unlocked if the operator has enabled it.
+func (r *HostReconciler) ReconcileUnlock(client *gophercloud.ServiceClient, instance *starlingxv1.Host, host *hosts.Host) error {
+	err := r.ReconcilePreflight(instance, hosts.ActionUnlock)
+	if err != nil {
+		return err
+	}
+
+	attempts := instance.Status.UnlockAttempts
+	if attempts >= ForceUnlockThreshold && !forceUnlockEnabled(instance) {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"host has failed to unlock after %d attempts; set forceUnlock to force unlock the host", attempts)
+	}
+
+	action := unlockAction(instance)
+	opts := hosts.HostOpts{
+		Action: &action,
+	}
+
+	if action == ActionForceUnlock {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"host has failed to unlock after %d attempts; issuing a force unlock", attempts)
+	}
+
+	logHost.Info("unlocking host", "opts", opts)
+
+	err = r.recordUnlockAttempt(instance)
+	if err != nil {
+		return err
+	}
+
+	result, err := hosts.Update(client, host.ID, opts).Extract()
+	if err != nil || result == nil {
+		err = perrors.Wrapf(err, "failed to unlock host: %s, %s",
+			host.ID, common.FormatStruct(opts))
+		return err
+	}
+
+	*host = *result
+
+	if action == ActionForceUnlock {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"host has been force unlocked")
+	} else {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"host has been unlocked")
+	}
+
+	return nil
+}
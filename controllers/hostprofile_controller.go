@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package controllers
 
@@ -36,34 +36,49 @@ type HostProfileReconciler struct {
 var _ reconcile.Reconciler = &HostProfileReconciler{}
 
 // ProfileUses determines whether the 'base' profile references the 'target'
-// profile either directly or indirectly via one of its parent/base profiles.
+// profile either directly or indirectly via one of its parent/base profiles or
+// mixins.
 func (r *HostProfileReconciler) ProfileUses(namespace, base, target string) (bool, error) {
-	profile := &starlingxv1.HostProfile{}
+	pending := []string{base}
+	visited := map[string]bool{base: true}
+
+	for len(pending) > 0 {
+		// Retrieve the next profile to be examined
+		profile := &starlingxv1.HostProfile{}
+		name := types.NamespacedName{Namespace: namespace, Name: pending[0]}
+		pending = pending[1:]
 
-	for {
-		// Retrieve the current level profile
-		name := types.NamespacedName{Namespace: namespace, Name: base}
 		err := r.Client.Get(context.TODO(), name, profile)
 		if err != nil {
 			if !errors.IsNotFound(err) {
-				err = perrors.Wrapf(err, "failed to lookup profile: %s", base)
+				err = perrors.Wrapf(err, "failed to lookup profile: %s", name.Name)
 				return false, err
 			}
 
-			return false, nil
+			continue
 		}
 
-		if profile.Spec.Base != nil && *profile.Spec.Base == target {
-			// If it references the target profile then return true
-			return true, nil
-		} else if profile.Spec.Base == nil {
-			// If we have reached the top of the profile chain return false
-			return false, nil
+		references := profile.Spec.Mixins
+		if profile.Spec.Base != nil {
+			references = append([]string{*profile.Spec.Base}, references...)
 		}
 
-		// Otherwise, repeat with the next profile in the chain
-		base = *profile.Spec.Base
+		for _, reference := range references {
+			if reference == target {
+				// If it references the target profile then return true
+				return true, nil
+			}
+
+			// Otherwise, repeat with each referenced profile that has not
+			// been examined yet.
+			if !visited[reference] {
+				visited[reference] = true
+				pending = append(pending, reference)
+			}
+		}
 	}
+
+	return false, nil
 }
 
 // UpdateHosts will force a update to each host that references this profile.
@@ -165,9 +180,7 @@ func (r *HostProfileReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 func (r *HostProfileReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Client = mgr.GetClient()
 	r.Scheme = mgr.GetScheme()
-	r.ReconcilerEventLogger = &common.EventLogger{
-		EventRecorder: mgr.GetEventRecorderFor(HostProfileControllerName),
-		Logger:        logHostProfile}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(HostProfileControllerName), logHostProfile)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.HostProfile{}).
@@ -0,0 +1,800 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/kubeupgrades"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logKubeUpgrade = log.Log.WithName("controller").WithName("kubeupgrade")
+
+const KubeUpgradeControllerName = "kubeupgrade-controller"
+
+// Defines the reasons reported with the upgrade conditions.
+const (
+	ReasonMilestoneReached    = "Reached"
+	ReasonMilestoneNotReached = "NotReached"
+	ReasonRollingBack         = "RollingBack"
+	ReasonRollbackFailed      = "RollbackFailed"
+	ReasonRolledBack          = "RolledBack"
+)
+
+var _ reconcile.Reconciler = &KubeUpgradeReconciler{}
+
+// KubeUpgradeReconciler reconciles a KubeUpgrade object
+type KubeUpgradeReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+}
+
+// kubeUpgradeStateRank orders the upgrade states so that the milestones
+// which have been reached can be determined.  A failed state has the same
+// rank as the state which failed.  The abort states are not ranked.
+var kubeUpgradeStateRank = map[string]int{
+	kubeupgrades.StateUpgradeStarted:              0,
+	kubeupgrades.StateDownloadingImages:           1,
+	kubeupgrades.StateDownloadingImagesFailed:     1,
+	kubeupgrades.StateDownloadedImages:            2,
+	kubeupgrades.StateUpgradingNetworking:         3,
+	kubeupgrades.StateUpgradingNetworkingFailed:   3,
+	kubeupgrades.StateUpgradedNetworking:          4,
+	kubeupgrades.StateUpgradingFirstMaster:        5,
+	kubeupgrades.StateUpgradingFirstMasterFailed:  5,
+	kubeupgrades.StateUpgradedFirstMaster:         6,
+	kubeupgrades.StateUpgradingSecondMaster:       7,
+	kubeupgrades.StateUpgradingSecondMasterFailed: 7,
+	kubeupgrades.StateUpgradedSecondMaster:        8,
+	kubeupgrades.StateUpgradingKubelets:           9,
+	kubeupgrades.StateUpgradeComplete:             10,
+}
+
+// kubeUpgradeMilestones lists each milestone condition along with the state
+// at which it is reached.
+var kubeUpgradeMilestones = []struct {
+	condition string
+	state     string
+}{
+	{starlingxv1.KubeUpgradeImagesDownloaded, kubeupgrades.StateDownloadedImages},
+	{starlingxv1.KubeUpgradeNetworkingUpgraded, kubeupgrades.StateUpgradedNetworking},
+	{starlingxv1.KubeUpgradeControlPlaneUpgraded, kubeupgrades.StateUpgradingKubelets},
+	{starlingxv1.KubeUpgradeKubeletsUpgraded, kubeupgrades.StateUpgradeComplete},
+}
+
+// kubeUpgradeInProgress determines whether an upgrade is moving on its own or
+// is waiting on this controller to move it to its next state.
+func kubeUpgradeInProgress(upgrade *kubeupgrades.KubeUpgrade) bool {
+	return upgrade != nil && !upgrade.Failed() &&
+		upgrade.State != kubeupgrades.StateUpgradeComplete &&
+		upgrade.State != kubeupgrades.StateUpgradeAborted
+}
+
+// kubeUpgradeOrder sorts the hosts in the order in which they are upgraded.
+// Controllers are always upgraded first, then the hosts follow the order
+// configured in the spec, and the remaining hosts are sorted by hostname.
+func kubeUpgradeOrder(instance *starlingxv1.KubeUpgrade, objects []hosts.Host) []hosts.Host {
+	rank := make(map[string]int)
+	for i, hostname := range instance.Spec.HostOrder {
+		rank[hostname] = i
+	}
+
+	position := func(h hosts.Host) int {
+		if i, ok := rank[h.Hostname]; ok {
+			return i
+		}
+		return len(instance.Spec.HostOrder)
+	}
+
+	result := make([]hosts.Host, len(objects))
+	copy(result, objects)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		aController := a.Personality == hosts.PersonalityController
+		bController := b.Personality == hosts.PersonalityController
+		if aController != bController {
+			return aController
+		}
+
+		if position(a) != position(b) {
+			return position(a) < position(b)
+		}
+
+		return a.Hostname < b.Hostname
+	})
+
+	return result
+}
+
+// kubeUpgradeHosts pairs the upgrade state of each host with the host itself
+// in the order in which the hosts are upgraded.  Hosts which are not reported
+// by the upgrade are omitted.
+func kubeUpgradeHosts(instance *starlingxv1.KubeUpgrade, objects []hosts.Host, hostUpgrades []kubeupgrades.HostUpgrade) ([]hosts.Host, map[string]kubeupgrades.HostUpgrade) {
+	states := make(map[string]kubeupgrades.HostUpgrade)
+	for _, hu := range hostUpgrades {
+		states[hu.HostID] = hu
+	}
+
+	ordered := make([]hosts.Host, 0)
+	for _, h := range kubeUpgradeOrder(instance, objects) {
+		if _, ok := states[h.ID]; ok {
+			ordered = append(ordered, h)
+		}
+	}
+
+	return ordered, states
+}
+
+// nextControlPlaneHost returns the next controller of which the control plane
+// must be upgraded or nil if every control plane has been upgraded.
+func nextControlPlaneHost(instance *starlingxv1.KubeUpgrade, ordered []hosts.Host, states map[string]kubeupgrades.HostUpgrade) *hosts.Host {
+	for i := range ordered {
+		h := &ordered[i]
+		if h.Personality != hosts.PersonalityController {
+			continue
+		}
+
+		if states[h.ID].ControlPlaneVersion != instance.Spec.ToVersion {
+			return h
+		}
+	}
+
+	return nil
+}
+
+// nextKubeletHost returns the next host of which the kubelet must be
+// upgraded or nil if every kubelet has been upgraded.  Kubelets are upgraded
+// one host at a time therefore nil is also returned while a kubelet is being
+// upgraded.  The boolean result reports whether a kubelet upgrade has failed.
+func nextKubeletHost(instance *starlingxv1.KubeUpgrade, ordered []hosts.Host, states map[string]kubeupgrades.HostUpgrade) (*hosts.Host, bool) {
+	var next *hosts.Host
+
+	for i := range ordered {
+		h := &ordered[i]
+		state := states[h.ID]
+
+		if state.Status != nil {
+			switch *state.Status {
+			case kubeupgrades.HostStatusUpgradingKubelet:
+				return nil, false
+			case kubeupgrades.HostStatusUpgradingKubeletFailed:
+				return nil, true
+			}
+		}
+
+		if next == nil && state.KubeletVersion != instance.Spec.ToVersion {
+			next = h
+		}
+	}
+
+	return next, false
+}
+
+// kubeUpgradeDone determines whether every kubelet has been upgraded.
+func kubeUpgradeDone(instance *starlingxv1.KubeUpgrade, states map[string]kubeupgrades.HostUpgrade) bool {
+	for _, state := range states {
+		if state.KubeletVersion != instance.Spec.ToVersion {
+			return false
+		}
+	}
+
+	return true
+}
+
+// moveKubeUpgrade requests that the upgrade be moved to the specified state
+// and refreshes the upgrade with the result.
+func (r *KubeUpgradeReconciler) moveKubeUpgrade(client *gophercloud.ServiceClient, instance *starlingxv1.KubeUpgrade, upgrade *kubeupgrades.KubeUpgrade, state string) error {
+	logKubeUpgrade.Info("moving kubernetes upgrade", "from", upgrade.State, "to", state)
+
+	result, err := kubeupgrades.Update(client, state).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to move kubernetes upgrade to state: %s", state)
+		return err
+	} else if result != nil && result.State != "" {
+		*upgrade = *result
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"kubernetes upgrade has been moved to %s", state)
+
+	return nil
+}
+
+// ReconcileFailure rolls back a failed upgrade by aborting it unless the
+// rollback has been disabled, in which case the failed upgrade is left on the
+// system for inspection.
+func (r *KubeUpgradeReconciler) ReconcileFailure(client *gophercloud.ServiceClient, instance *starlingxv1.KubeUpgrade, upgrade *kubeupgrades.KubeUpgrade, reason string) error {
+	r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+		"kubernetes upgrade to %s has failed: %s", upgrade.ToVersion, reason)
+
+	if !instance.Spec.RollbackOnFailure {
+		return nil
+	}
+
+	return r.moveKubeUpgrade(client, instance, upgrade, kubeupgrades.StateUpgradeAborting)
+}
+
+// ReconcileHosts upgrades the control plane of each controller and then the
+// kubelet of each host, one host at a time in the configured order.  The
+// lock and unlock of the hosts which require it are left to the operator.
+func (r *KubeUpgradeReconciler) ReconcileHosts(client *gophercloud.ServiceClient, instance *starlingxv1.KubeUpgrade, upgrade *kubeupgrades.KubeUpgrade, ordered []hosts.Host, states map[string]kubeupgrades.HostUpgrade) error {
+	opts := kubeupgrades.HostUpgradeOpts{}
+
+	switch upgrade.State {
+	case kubeupgrades.StateUpgradedNetworking, kubeupgrades.StateUpgradedFirstMaster:
+		h := nextControlPlaneHost(instance, ordered, states)
+		if h == nil {
+			return r.moveKubeUpgrade(client, instance, upgrade, kubeupgrades.StateUpgradingKubelets)
+		}
+
+		logKubeUpgrade.Info("upgrading control plane", "hostname", h.Hostname)
+
+		err := kubeupgrades.UpgradeControlPlane(client, h.ID, opts).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to upgrade control plane of host: %s", h.Hostname)
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"control plane of host %s is being upgraded", h.Hostname)
+
+	case kubeupgrades.StateUpgradedSecondMaster:
+		return r.moveKubeUpgrade(client, instance, upgrade, kubeupgrades.StateUpgradingKubelets)
+
+	case kubeupgrades.StateUpgradingKubelets:
+		h, failed := nextKubeletHost(instance, ordered, states)
+		if failed {
+			return r.ReconcileFailure(client, instance, upgrade, "kubelet upgrade failed")
+		} else if h == nil {
+			if kubeUpgradeDone(instance, states) {
+				return r.moveKubeUpgrade(client, instance, upgrade, kubeupgrades.StateUpgradeComplete)
+			}
+			return nil
+		}
+
+		logKubeUpgrade.Info("upgrading kubelet", "hostname", h.Hostname)
+
+		err := kubeupgrades.UpgradeKubelet(client, h.ID, opts).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to upgrade kubelet of host: %s", h.Hostname)
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"kubelet of host %s is being upgraded", h.Hostname)
+	}
+
+	return nil
+}
+
+// ReconcileExisting is a method which handles moving an existing upgrade thru
+// its lifecycle.  The upgrade is moved to its next state whenever the system
+// has finished the current one, and it is removed from the system once it has
+// completed or has been rolled back.
+func (r *KubeUpgradeReconciler) ReconcileExisting(client *gophercloud.ServiceClient, instance *starlingxv1.KubeUpgrade, upgrade *kubeupgrades.KubeUpgrade, ordered []hosts.Host, states map[string]kubeupgrades.HostUpgrade) error {
+	switch upgrade.State {
+	case kubeupgrades.StateUpgradeStarted:
+		return r.moveKubeUpgrade(client, instance, upgrade, kubeupgrades.StateDownloadingImages)
+
+	case kubeupgrades.StateDownloadedImages:
+		return r.moveKubeUpgrade(client, instance, upgrade, kubeupgrades.StateUpgradingNetworking)
+
+	case kubeupgrades.StateUpgradedNetworking, kubeupgrades.StateUpgradedFirstMaster,
+		kubeupgrades.StateUpgradedSecondMaster, kubeupgrades.StateUpgradingKubelets:
+		return r.ReconcileHosts(client, instance, upgrade, ordered, states)
+
+	case kubeupgrades.StateUpgradeComplete:
+		err := kubeupgrades.Delete(client).ExtractErr()
+		if err != nil {
+			err = perrors.Wrap(err, "failed to delete kubernetes upgrade")
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"kubernetes has been upgraded to %s", upgrade.ToVersion)
+
+	case kubeupgrades.StateUpgradeAborted:
+		err := kubeupgrades.Delete(client).ExtractErr()
+		if err != nil {
+			err = perrors.Wrap(err, "failed to delete kubernetes upgrade")
+			return err
+		}
+
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"kubernetes upgrade to %s has been rolled back", upgrade.ToVersion)
+
+	case kubeupgrades.StateUpgradeAbortingFailed:
+		if instance.Status.State != upgrade.State {
+			r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+				"kubernetes upgrade to %s could not be rolled back", upgrade.ToVersion)
+		}
+
+	default:
+		if upgrade.Failed() {
+			return r.ReconcileFailure(client, instance, upgrade, upgrade.State)
+		}
+	}
+
+	return nil
+}
+
+// ReconcileNew is a method which handles starting the upgrade of a new
+// resource.  Nothing is started if the system already runs the target
+// version.
+func (r *KubeUpgradeReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.KubeUpgrade) (*kubeupgrades.KubeUpgrade, error) {
+	active, err := kubeupgrades.ActiveVersion(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to get active kubernetes version")
+		return nil, err
+	}
+
+	if active == instance.Spec.ToVersion {
+		// Report the upgrade as complete so that the resource is
+		// reconciled.
+		return &kubeupgrades.KubeUpgrade{
+			FromVersion: active,
+			ToVersion:   active,
+			State:       kubeupgrades.StateUpgradeComplete,
+		}, nil
+	}
+
+	opts := kubeupgrades.KubeUpgradeOpts{ToVersion: instance.Spec.ToVersion}
+
+	logKubeUpgrade.Info("starting kubernetes upgrade", "from", active, "to", opts.ToVersion)
+
+	upgrade, err := kubeupgrades.Create(client, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to create: %s", common.FormatStruct(opts))
+		return nil, err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+		"kubernetes upgrade to %s has been started", opts.ToVersion)
+
+	return upgrade, nil
+}
+
+// setKubeUpgradeConditions updates the milestone and rollback conditions from
+// the current upgrade state.  The milestones are left untouched while the
+// upgrade is being rolled back.  Returns true if the set of conditions was
+// modified.
+func setKubeUpgradeConditions(instance *starlingxv1.KubeUpgrade, state string) bool {
+	conditions := make([]metav1.Condition, 0)
+
+	if rank, ok := kubeUpgradeStateRank[state]; ok {
+		for _, milestone := range kubeUpgradeMilestones {
+			c := metav1.Condition{
+				Type:    milestone.condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  ReasonMilestoneNotReached,
+				Message: fmt.Sprintf("waiting for the upgrade to reach %s", milestone.state),
+			}
+
+			if rank >= kubeUpgradeStateRank[milestone.state] {
+				c.Status = metav1.ConditionTrue
+				c.Reason = ReasonMilestoneReached
+				c.Message = fmt.Sprintf("the upgrade has reached %s", milestone.state)
+			}
+
+			conditions = append(conditions, c)
+		}
+	}
+
+	switch state {
+	case kubeupgrades.StateUpgradeAborting:
+		conditions = append(conditions, metav1.Condition{
+			Type:    starlingxv1.KubeUpgradeRolledBack,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonRollingBack,
+			Message: "the failed upgrade is being rolled back",
+		})
+	case kubeupgrades.StateUpgradeAbortingFailed:
+		conditions = append(conditions, metav1.Condition{
+			Type:    starlingxv1.KubeUpgradeRolledBack,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonRollbackFailed,
+			Message: "the failed upgrade could not be rolled back",
+		})
+	case kubeupgrades.StateUpgradeAborted:
+		conditions = append(conditions, metav1.Condition{
+			Type:    starlingxv1.KubeUpgradeRolledBack,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonRolledBack,
+			Message: "the failed upgrade has been rolled back",
+		})
+	}
+
+	changed := false
+	for _, c := range conditions {
+		existing := meta.FindStatusCondition(instance.Status.Conditions, c.Type)
+		if existing != nil && existing.Status == c.Status && existing.Reason == c.Reason &&
+			existing.Message == c.Message && existing.ObservedGeneration == instance.Generation {
+			continue
+		}
+
+		c.ObservedGeneration = instance.Generation
+		meta.SetStatusCondition(&instance.Status.Conditions, c)
+		changed = true
+	}
+
+	return changed
+}
+
+// kubeUpgradeHostStatus builds the host status of the resource from the
+// upgrade state of each host.
+func kubeUpgradeHostStatus(ordered []hosts.Host, states map[string]kubeupgrades.HostUpgrade) []starlingxv1.KubeUpgradeHostStatus {
+	result := make([]starlingxv1.KubeUpgradeHostStatus, 0)
+	for _, h := range ordered {
+		state := states[h.ID]
+
+		status := starlingxv1.KubeUpgradeHostStatus{
+			Hostname:            h.Hostname,
+			ControlPlaneVersion: state.ControlPlaneVersion,
+			KubeletVersion:      state.KubeletVersion,
+		}
+
+		if state.Status != nil {
+			status.Status = *state.Status
+		}
+
+		result = append(result, status)
+	}
+
+	return result
+}
+
+// statusUpdateRequired is a utility function which determines whether an
+// update is required to the upgrade status attribute.  Updating this
+// unnecessarily will result in an infinite reconciliation loop.
+func (r *KubeUpgradeReconciler) statusUpdateRequired(instance *starlingxv1.KubeUpgrade, upgrade *kubeupgrades.KubeUpgrade, hostStatus []starlingxv1.KubeUpgradeHostStatus, inSync bool) (result bool) {
+	status := &instance.Status
+
+	if upgrade != nil {
+		if status.State != upgrade.State {
+			status.State = upgrade.State
+			result = true
+		}
+
+		if status.FromVersion != upgrade.FromVersion {
+			status.FromVersion = upgrade.FromVersion
+			result = true
+		}
+
+		if setKubeUpgradeConditions(instance, upgrade.State) {
+			result = true
+		}
+
+		if upgrade.State == kubeupgrades.StateUpgradeComplete && !status.Reconciled {
+			// Record the fact that the upgrade has completed for the current
+			// configuration.
+			status.Reconciled = true
+			result = true
+		}
+	}
+
+	if hostStatus != nil {
+		if len(hostStatus) != len(status.Hosts) {
+			status.Hosts = hostStatus
+			result = true
+		} else {
+			for i := range hostStatus {
+				if hostStatus[i] != status.Hosts[i] {
+					status.Hosts = hostStatus
+					result = true
+					break
+				}
+			}
+		}
+	}
+
+	if status.InSync != inSync {
+		status.InSync = inSync
+		result = true
+	}
+
+	return result
+}
+
+// ReconcileGeneration resets the status of the resource when its
+// configuration has been modified so that a new upgrade is started.
+func (r *KubeUpgradeReconciler) ReconcileGeneration(instance *starlingxv1.KubeUpgrade) {
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation {
+		return
+	}
+
+	status := &instance.Status
+	status.State = ""
+	status.FromVersion = ""
+	status.Hosts = nil
+	status.Reconciled = false
+	status.Conditions = nil
+	status.ObservedGeneration = instance.ObjectMeta.Generation
+}
+
+// reconcileUpgrade moves the upgrade to its next step and returns it along
+// with the upgrade state of each host.
+func (r *KubeUpgradeReconciler) reconcileUpgrade(client *gophercloud.ServiceClient, instance *starlingxv1.KubeUpgrade) (*kubeupgrades.KubeUpgrade, []starlingxv1.KubeUpgradeHostStatus, error) {
+	upgrade, err := kubeupgrades.GetKubeUpgrade(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to get kubernetes upgrade")
+		return nil, nil, err
+	}
+
+	if upgrade != nil && upgrade.ToVersion != instance.Spec.ToVersion {
+		// The system only supports a single upgrade at a time therefore
+		// wait for the other upgrade to be removed.
+		msg := fmt.Sprintf("another kubernetes upgrade already exists: %s (%s)",
+			upgrade.ToVersion, upgrade.State)
+		return nil, nil, common.NewResourceConfigurationDependency(msg)
+	}
+
+	if upgrade == nil {
+		if instance.Status.State == kubeupgrades.StateUpgradeAborted {
+			// The upgrade has been rolled back; it is only retried once
+			// the resource is modified.
+			return nil, nil, nil
+		}
+
+		upgrade, err = r.ReconcileNew(client, instance)
+		if err != nil || upgrade.State == kubeupgrades.StateUpgradeComplete {
+			return upgrade, nil, err
+		}
+	}
+
+	objects, err := hosts.ListHosts(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return upgrade, nil, err
+	}
+
+	hostUpgrades, err := kubeupgrades.ListHostUpgrades(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list host kubernetes upgrades")
+		return upgrade, nil, err
+	}
+
+	ordered, states := kubeUpgradeHosts(instance, objects, hostUpgrades)
+
+	err = r.ReconcileExisting(client, instance, upgrade, ordered, states)
+
+	return upgrade, kubeUpgradeHostStatus(ordered, states), err
+}
+
+// ReconcileResource interacts with the system API in order to reconcile the
+// state of a Kubernetes upgrade with the state stored in the k8s database.
+func (r *KubeUpgradeReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.KubeUpgrade) error {
+	r.ReconcileGeneration(instance)
+
+	upgrade, hostStatus, err := r.reconcileUpgrade(client, instance)
+
+	inSync := err == nil
+
+	if instance.Status.InSync != inSync {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	if r.statusUpdateRequired(instance, upgrade, hostStatus, inSync) {
+		logKubeUpgrade.Info("updating kubernetes upgrade", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	if err == nil && kubeUpgradeInProgress(upgrade) {
+		// The system runs each step on its own; keep the status current
+		// until it has finished.
+		msg := fmt.Sprintf("waiting for kubernetes upgrade to %s to progress", instance.Spec.ToVersion)
+		m := NewKubeUpgradeProgressMonitor(instance, upgrade, hostStatus)
+		return r.CloudManager.StartMonitor(m, msg)
+	}
+
+	return err
+}
+
+// Reconcile reads that state of the cluster for a KubeUpgrade object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=kubeupgrades,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=kubeupgrades/status,verbs=get;update;patch
+func (r *KubeUpgradeReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logKubeUpgrade
+	logKubeUpgrade = logKubeUpgrade.WithName(request.NamespacedName.String())
+	defer func() { logKubeUpgrade = savedLog }()
+
+	// Fetch the KubeUpgrade instance
+	instance := &starlingxv1.KubeUpgrade{}
+	err := r.Client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logKubeUpgrade.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		// An upgrade which is in progress is left on the system since it
+		// cannot be stopped without rolling back the hosts.
+		return reconcile.Result{}, nil
+	}
+
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
+		instance.Status.Reconciled {
+		return ctrl.Result{}, nil
+	}
+
+	if !utils.IsReconcilerEnabled(utils.KubeUpgrade) {
+		return reconcile.Result{}, nil
+	}
+
+	platformClient := r.GetPlatformClient(request.Namespace)
+	if platformClient == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	if !r.CloudManager.GetSystemReady(request.Namespace) {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	platformClient = cloudManager.WithChangeInitiator(platformClient, instance)
+	err = r.ReconcileResource(platformClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if err != nil {
+		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KubeUpgradeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logKubeUpgrade}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(KubeUpgradeControllerName), logKubeUpgrade)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.KubeUpgrade{}).
+		Complete(r)
+}
+
+// DefaultKubeUpgradeProgressMonitorInterval represents the default interval
+// between polling attempts to check whether a Kubernetes upgrade has
+// progressed.
+const DefaultKubeUpgradeProgressMonitorInterval = 30 * time.Second
+
+// kubeUpgradeProgressMonitor waits for a Kubernetes upgrade to change state
+// or for the upgrade operation of any of its hosts to change.  Once it has a
+// reconcilable event is generated to kick the reconciler so that the next
+// step can be run and the status can be refreshed.
+type kubeUpgradeProgressMonitor struct {
+	cloudManager.CommonMonitorBody
+	state string
+	hosts map[string]string
+}
+
+// NewKubeUpgradeProgressMonitor defines a convenience function to instantiate
+// a new Kubernetes upgrade progress monitor with all required attributes.
+func NewKubeUpgradeProgressMonitor(instance *starlingxv1.KubeUpgrade, upgrade *kubeupgrades.KubeUpgrade, hostStatus []starlingxv1.KubeUpgradeHostStatus) *cloudManager.Monitor {
+	logger := logKubeUpgrade.WithName("progress-monitor")
+
+	hostStates := make(map[string]string)
+	for _, s := range hostStatus {
+		hostStates[s.Hostname] = s.Status
+	}
+
+	return &cloudManager.Monitor{
+		MonitorBody: &kubeUpgradeProgressMonitor{
+			state: upgrade.State,
+			hosts: hostStates,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultKubeUpgradeProgressMonitorInterval,
+	}
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *kubeUpgradeProgressMonitor) Run(client *gophercloud.ServiceClient) (stop bool, err error) {
+	upgrade, err := kubeupgrades.GetKubeUpgrade(client)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to get kubernetes upgrade: %s", err.Error())
+		return false, err
+	}
+
+	if upgrade == nil {
+		m.CommonMonitorBody.SetState("kubernetes upgrade no longer exists")
+		return true, nil
+	}
+
+	if upgrade.State != m.state {
+		m.CommonMonitorBody.SetState("kubernetes upgrade has progressed to %s", upgrade.State)
+		return true, nil
+	}
+
+	objects, err := hosts.ListHosts(client)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to list hosts: %s", err.Error())
+		return false, err
+	}
+
+	hostUpgrades, err := kubeupgrades.ListHostUpgrades(client)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to list host kubernetes upgrades: %s", err.Error())
+		return false, err
+	}
+
+	hostnames := make(map[string]string)
+	for _, h := range objects {
+		hostnames[h.ID] = h.Hostname
+	}
+
+	for _, hu := range hostUpgrades {
+		status := ""
+		if hu.Status != nil {
+			status = *hu.Status
+		}
+
+		hostname := hostnames[hu.HostID]
+		if m.hosts[hostname] != status {
+			m.CommonMonitorBody.SetState("host %s upgrade has progressed to %q", hostname, status)
+			return true, nil
+		}
+	}
+
+	m.CommonMonitorBody.SetState("waiting for kubernetes upgrade to progress from %s", m.state)
+
+	return false, nil
+}
@@ -0,0 +1,139 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/kubeupgrades"
+)
+
+var _ = Describe("KubeUpgrade controller", func() {
+
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	Context("KubeUpgrade with data", func() {
+		It("Should created successfully", func() {
+			ctx := context.Background()
+			key := types.NamespacedName{
+				Name:      "foo",
+				Namespace: "default",
+			}
+
+			created := &starlingxv1.KubeUpgrade{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Spec: starlingxv1.KubeUpgradeSpec{
+					ToVersion:         "v1.25.3",
+					RollbackOnFailure: true,
+				}}
+			Expect(k8sClient.Create(ctx, created)).To(Succeed())
+
+			fetched := &starlingxv1.KubeUpgrade{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, key, fetched)
+				return err == nil
+			}, timeout, interval).Should(BeTrue())
+			Expect(fetched.Spec.ToVersion).To(Equal("v1.25.3"))
+		})
+	})
+
+	Context("KubeUpgrade host order", func() {
+		objects := []hosts.Host{
+			{ID: "1", Hostname: "worker-0", Personality: hosts.PersonalityWorker},
+			{ID: "2", Hostname: "controller-0", Personality: hosts.PersonalityController},
+			{ID: "3", Hostname: "worker-1", Personality: hosts.PersonalityWorker},
+			{ID: "4", Hostname: "controller-1", Personality: hosts.PersonalityController},
+			{ID: "5", Hostname: "worker-2", Personality: hosts.PersonalityWorker},
+		}
+
+		It("Should upgrade the control plane hosts first", func() {
+			instance := &starlingxv1.KubeUpgrade{
+				Spec: starlingxv1.KubeUpgradeSpec{
+					HostOrder: []string{"worker-2", "controller-1"},
+				},
+			}
+
+			names := make([]string, 0)
+			for _, h := range kubeUpgradeOrder(instance, objects) {
+				names = append(names, h.Hostname)
+			}
+
+			Expect(names).To(Equal([]string{"controller-1", "controller-0", "worker-2", "worker-0", "worker-1"}))
+		})
+
+		It("Should upgrade one kubelet at a time", func() {
+			instance := &starlingxv1.KubeUpgrade{
+				Spec: starlingxv1.KubeUpgradeSpec{ToVersion: "v1.25.3"},
+			}
+			upgrading := kubeupgrades.HostStatusUpgradingKubelet
+			hostUpgrades := []kubeupgrades.HostUpgrade{
+				{HostID: "1", KubeletVersion: "v1.24.4"},
+				{HostID: "2", KubeletVersion: "v1.25.3"},
+				{HostID: "4", KubeletVersion: "v1.24.4"},
+			}
+
+			ordered, states := kubeUpgradeHosts(instance, objects, hostUpgrades)
+			Expect(ordered).To(HaveLen(3))
+
+			h, failed := nextKubeletHost(instance, ordered, states)
+			Expect(failed).To(BeFalse())
+			Expect(h.Hostname).To(Equal("controller-1"))
+
+			hostUpgrades[2].Status = &upgrading
+			ordered, states = kubeUpgradeHosts(instance, objects, hostUpgrades)
+			h, failed = nextKubeletHost(instance, ordered, states)
+			Expect(failed).To(BeFalse())
+			Expect(h).To(BeNil())
+			Expect(kubeUpgradeDone(instance, states)).To(BeFalse())
+		})
+	})
+
+	Context("KubeUpgrade status", func() {
+		It("Should report the milestones reached by the upgrade", func() {
+			r := &KubeUpgradeReconciler{}
+			instance := &starlingxv1.KubeUpgrade{}
+			upgrade := &kubeupgrades.KubeUpgrade{
+				FromVersion: "v1.24.4",
+				ToVersion:   "v1.25.3",
+				State:       kubeupgrades.StateUpgradedNetworking,
+			}
+
+			Expect(r.statusUpdateRequired(instance, upgrade, nil, true)).To(BeTrue())
+			Expect(instance.Status.State).To(Equal(kubeupgrades.StateUpgradedNetworking))
+			Expect(instance.Status.FromVersion).To(Equal("v1.24.4"))
+			conditions := instance.Status.Conditions
+			Expect(meta.IsStatusConditionTrue(conditions, starlingxv1.KubeUpgradeImagesDownloaded)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(conditions, starlingxv1.KubeUpgradeNetworkingUpgraded)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(conditions, starlingxv1.KubeUpgradeControlPlaneUpgraded)).To(BeFalse())
+			Expect(instance.Status.Reconciled).To(BeFalse())
+			Expect(r.statusUpdateRequired(instance, upgrade, nil, true)).To(BeFalse())
+
+			upgrade.State = kubeupgrades.StateUpgradeAborted
+			Expect(r.statusUpdateRequired(instance, upgrade, nil, true)).To(BeTrue())
+			conditions = instance.Status.Conditions
+			Expect(meta.IsStatusConditionTrue(conditions, starlingxv1.KubeUpgradeRolledBack)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(conditions, starlingxv1.KubeUpgradeNetworkingUpgraded)).To(BeTrue())
+			Expect(instance.Status.Reconciled).To(BeFalse())
+
+			upgrade.State = kubeupgrades.StateUpgradeComplete
+			Expect(r.statusUpdateRequired(instance, upgrade, nil, true)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, starlingxv1.KubeUpgradeKubeletsUpgraded)).To(BeTrue())
+			Expect(instance.Status.Reconciled).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,295 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	common "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var logChangeAudit = logf.Log.WithName("change-audit")
+
+// Defines the headers used to identify the resource which initiated a system
+// API request.  They are removed from the request before it is sent.
+const (
+	ChangeInitiatorHeader           = "X-Deployment-Manager-Initiator"
+	ChangeInitiatorGenerationHeader = "X-Deployment-Manager-Generation"
+)
+
+// redactedValue replaces the value of sensitive attributes in the audit log.
+const redactedValue = "<redacted>"
+
+// sensitiveAttribute matches the name of attributes whose value must never be
+// written to the audit log.
+var sensitiveAttribute = regexp.MustCompile(`(?i)password|secret|token|key|passphrase|certificate|signature`)
+
+// WithChangeInitiator returns a copy of a platform client whose requests are
+// attributed to the specified resource in the change audit log.  The copy
+// shares the provider client and its authentication with the original client.
+func WithChangeInitiator(c *gophercloud.ServiceClient, obj client.Object) *gophercloud.ServiceClient {
+	if c == nil {
+		return nil
+	}
+
+	result := *c
+	result.MoreHeaders = make(map[string]string, len(c.MoreHeaders)+2)
+	for key, value := range c.MoreHeaders {
+		result.MoreHeaders[key] = value
+	}
+
+	kind := reflect.TypeOf(obj).Elem().Name()
+	result.MoreHeaders[ChangeInitiatorHeader] = fmt.Sprintf("%s/%s/%s", kind, obj.GetNamespace(), obj.GetName())
+	result.MoreHeaders[ChangeInitiatorGenerationHeader] = strconv.FormatInt(obj.GetGeneration(), 10)
+
+	return &result
+}
+
+// ChangeAuditRoundTripper wraps a RoundTripper so that every request which
+// modifies the system configuration is recorded in the change audit log along
+// with the resource which initiated it.
+type ChangeAuditRoundTripper struct {
+	Rt        http.RoundTripper
+	Namespace string
+}
+
+// isMutating determines whether a request may modify the system configuration.
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+
+	return true
+}
+
+// redact replaces the value of every sensitive attribute of a decoded JSON
+// document.  JSON patch operations are redacted based on their path.
+func redact(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		if path, ok := v["path"].(string); ok && sensitiveAttribute.MatchString(path) {
+			if _, present := v["value"]; present {
+				v["value"] = redactedValue
+			}
+		}
+
+		for key, value := range v {
+			if sensitiveAttribute.MatchString(key) {
+				v[key] = redactedValue
+			} else {
+				v[key] = redact(value)
+			}
+		}
+
+	case []interface{}:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+	}
+
+	return data
+}
+
+// decodeBody returns the decoded and redacted JSON content of a request or
+// response body; otherwise nil is returned if the body is not JSON.
+func decodeBody(body []byte) interface{} {
+	var data interface{}
+
+	if len(body) == 0 || json.Unmarshal(body, &data) != nil {
+		return nil
+	}
+
+	return redact(data)
+}
+
+// encodeValue returns the representation of a decoded value in the audit log.
+func encodeValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// patchedAttributes returns the names of the top-level attributes modified by
+// a request.  Requests are either JSON patches or partial documents.
+func patchedAttributes(data interface{}) []string {
+	result := make([]string, 0)
+
+	switch v := data.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if op, ok := item.(map[string]interface{}); ok {
+				if path, ok := op["path"].(string); ok {
+					result = append(result, strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)[0])
+				}
+			}
+		}
+
+	case map[string]interface{}:
+		for key := range v {
+			result = append(result, key)
+		}
+	}
+
+	return result
+}
+
+// hostReference returns the host targeted by a request from either its path
+// or the host attribute of its content.
+func hostReference(resource string, id string, documents ...interface{}) string {
+	if resource == "ihosts" && id != "" {
+		return id
+	}
+
+	for _, document := range documents {
+		if attributes, ok := document.(map[string]interface{}); ok {
+			for _, key := range []string{"ihost_uuid", "host_uuid", "hostname"} {
+				if value, ok := attributes[key].(string); ok && value != "" {
+					return value
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// resourceID returns the identifier of the resource addressed by a request
+// path, if any.
+func resourceID(req *http.Request, resource string) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		if segment == resource && i+1 < len(segments) {
+			return segments[i+1]
+		}
+	}
+
+	return ""
+}
+
+// previousValues reads the current content of the resource addressed by a
+// request before it is modified.
+func (rt *ChangeAuditRoundTripper) previousValues(req *http.Request) interface{} {
+	get, err := http.NewRequestWithContext(req.Context(), http.MethodGet, req.URL.String(), nil)
+	if err != nil {
+		return nil
+	}
+
+	for _, header := range []string{"X-Auth-Token", "Accept", "User-Agent"} {
+		if value := req.Header.Get(header); value != "" {
+			get.Header.Set(header, value)
+		}
+	}
+
+	resp, err := rt.Rt.RoundTrip(get)
+	if err != nil {
+		return nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil
+	}
+
+	return decodeBody(body)
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (rt *ChangeAuditRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	initiator := req.Header.Get(ChangeInitiatorHeader)
+	generation := req.Header.Get(ChangeInitiatorGenerationHeader)
+
+	if initiator != "" || generation != "" {
+		// The headers are only meant for the audit log therefore they are
+		// never sent to the system.
+		req = req.Clone(req.Context())
+		req.Header.Del(ChangeInitiatorHeader)
+		req.Header.Del(ChangeInitiatorGenerationHeader)
+	}
+
+	if !isMutating(req.Method) || !common.ChangeAuditEnabled() {
+		return rt.Rt.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resource := apiResource(req)
+	id := resourceID(req, resource)
+	values := decodeBody(body)
+
+	var previous, current interface{}
+	if id != "" && req.Method != http.MethodPost && common.ChangeAuditPreviousValues() {
+		current = rt.previousValues(req)
+		if req.Method == http.MethodDelete {
+			previous = current
+		} else if attributes, ok := current.(map[string]interface{}); ok {
+			subset := make(map[string]interface{})
+			for _, name := range patchedAttributes(values) {
+				subset[name] = attributes[name]
+			}
+			previous = subset
+		}
+	}
+
+	resp, err := rt.Rt.RoundTrip(req)
+
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(resp.StatusCode)
+	} else if errors.As(err, &PlannedChange{}) {
+		status = "planned"
+	}
+
+	if initiator == "" {
+		initiator = "unknown"
+	}
+
+	logChangeAudit.Info("system configuration change",
+		"namespace", rt.Namespace,
+		"initiator", initiator,
+		"generation", generation,
+		"method", req.Method,
+		"resource", resource,
+		"id", id,
+		"host", hostReference(resource, id, values, current),
+		"old", encodeValue(previous),
+		"new", encodeValue(values),
+		"status", status)
+
+	return resp, err
+}
@@ -0,0 +1,106 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type recordingRoundTripper struct {
+	requests []*http.Request
+	bodies   []string
+}
+
+func (s *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		data, _ := io.ReadAll(req.Body)
+		body = string(data)
+	}
+	s.requests = append(s.requests, req)
+	s.bodies = append(s.bodies, body)
+
+	content := `{"uuid": "1234", "ihost_uuid": "5678", "mtu": 1500, "bm_password": "secret"}`
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(content)), Request: req}, nil
+}
+
+var _ = Describe("Change audit", func() {
+	It("should attribute requests to the initiating resource", func() {
+		c := &gophercloud.ServiceClient{MoreHeaders: map[string]string{"X-Test": "value"}}
+		host := &v1.Host{ObjectMeta: metav1.ObjectMeta{Name: "controller-0", Namespace: "test", Generation: 3}}
+
+		audited := WithChangeInitiator(c, host)
+		Expect(audited.MoreHeaders).To(HaveKeyWithValue(ChangeInitiatorHeader, "Host/test/controller-0"))
+		Expect(audited.MoreHeaders).To(HaveKeyWithValue(ChangeInitiatorGenerationHeader, "3"))
+		Expect(audited.MoreHeaders).To(HaveKeyWithValue("X-Test", "value"))
+		Expect(c.MoreHeaders).ToNot(HaveKey(ChangeInitiatorHeader))
+	})
+
+	It("should read previous values and never forward the initiator", func() {
+		stub := &recordingRoundTripper{}
+		rt := &ChangeAuditRoundTripper{Rt: stub, Namespace: "test"}
+
+		patch := `[{"op": "replace", "path": "/mtu", "value": 9000}]`
+		req := httptest.NewRequest("PATCH", "http://sysinv/v1/iinterfaces/1234", strings.NewReader(patch))
+		req.Header.Set(ChangeInitiatorHeader, "Host/test/controller-0")
+		req.Header.Set(ChangeInitiatorGenerationHeader, "3")
+
+		_, err := rt.RoundTrip(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stub.requests).To(HaveLen(2))
+		Expect(stub.requests[0].Method).To(Equal(http.MethodGet))
+		Expect(stub.requests[1].Method).To(Equal(http.MethodPatch))
+		Expect(stub.bodies[1]).To(Equal(patch))
+		for _, r := range stub.requests {
+			Expect(r.Header.Get(ChangeInitiatorHeader)).To(BeEmpty())
+			Expect(r.Header.Get(ChangeInitiatorGenerationHeader)).To(BeEmpty())
+		}
+	})
+
+	It("should forward multipart requests to other services unchanged", func() {
+		stub := &recordingRoundTripper{}
+		rt := &ChangeAuditRoundTripper{Rt: stub, Namespace: "test"}
+
+		form := "--boundary\r\nContent-Disposition: form-data; name=\"subcloud\"\r\n\r\nsubcloud1\r\n--boundary--\r\n"
+		req := httptest.NewRequest("POST", "http://dcmanager/v1.0/subcloud-backup", strings.NewReader(form))
+		req.Header.Set("Content-Type", "multipart/form-data; boundary=boundary")
+		req.Header.Set(ChangeInitiatorHeader, "Backup/test/subcloud1")
+
+		_, err := rt.RoundTrip(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stub.requests).To(HaveLen(1))
+		Expect(stub.bodies[0]).To(Equal(form))
+		Expect(stub.requests[0].Header.Get(ChangeInitiatorHeader)).To(BeEmpty())
+	})
+
+	It("should not audit read requests", func() {
+		stub := &recordingRoundTripper{}
+		rt := &ChangeAuditRoundTripper{Rt: stub, Namespace: "test"}
+
+		_, err := rt.RoundTrip(httptest.NewRequest("GET", "http://sysinv/v1/ihosts/1234", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stub.requests).To(HaveLen(1))
+	})
+
+	It("should redact sensitive attributes", func() {
+		data := decodeBody([]byte(`[{"op": "replace", "path": "/bm_password", "value": "secret"}, {"op": "replace", "path": "/mtu", "value": 9000}]`))
+		Expect(encodeValue(data)).ToNot(ContainSubstring("secret"))
+		Expect(encodeValue(data)).To(ContainSubstring("9000"))
+		Expect(patchedAttributes(data)).To(Equal([]string{"bm_password", "mtu"}))
+
+		data = decodeBody([]byte(`{"ihost_uuid": "5678", "bm_password": "secret"}`))
+		Expect(encodeValue(data)).ToNot(ContainSubstring("secret"))
+		Expect(hostReference("iinterfaces", "1234", data)).To(Equal("5678"))
+		Expect(hostReference("ihosts", "1234", data)).To(Equal("1234"))
+	})
+})
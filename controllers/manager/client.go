@@ -1,10 +1,10 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package manager
 
 import (
-	"context"
+	"crypto/tls"
 	"net"
 	"net/http"
 	"net/url"
@@ -14,13 +14,11 @@ import (
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/acceptance/clients"
-	"github.com/gophercloud/gophercloud/openstack"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/system"
 	"github.com/gophercloud/gophercloud/starlingx/nfv/v1/systemconfigupdate"
 	perrors "github.com/pkg/errors"
 	common "github.com/wind-river/cloud-platform-deployment-manager/common"
 	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
@@ -42,34 +40,52 @@ const (
 	ProjectNameKey                 = "OS_PROJECT_NAME"
 	InterfaceKey                   = "OS_INTERFACE"
 	DebugKey                       = "OS_DEBUG"
+	NewPasswordKey                 = "OS_NEW_PASSWORD"
 )
 
 const (
 	// Well-known openstack API attribute values for the system API
-	SystemEndpointName  = "sysinv"
-	SystemEndpointType  = "platform"
-	VimEndpointName     = "vim"
-	VimEndpointType     = "nfv"
-	KeystoneEndpointURL = "http://controller:5000/v3"
+	SystemEndpointName     = "sysinv"
+	SystemEndpointType     = "platform"
+	VimEndpointName        = "vim"
+	VimEndpointType        = "nfv"
+	FaultEndpointName      = "fm"
+	FaultEndpointType      = "faultmanagement"
+	PatchingEndpointName   = "patching"
+	PatchingEndpointType   = "patching"
+	USMEndpointName        = "usm"
+	USMEndpointType        = "usm"
+	KeyManagerEndpointName = "barbican"
+	DCManagerEndpointName  = "dcmanager"
+	DCManagerEndpointType  = "dcmanager"
+	KeyManagerEndpointType = "key-manager"
+	IdentityEndpointType   = "identity"
+	KeystoneEndpointURL    = "http://controller:5000/v3"
 )
 
 // Builds the client authentication options from a given secret which should
 // contain environment variable like values.  For example, OS_AUTH_URL,
 // OS_USERNAME, etc...
 func GetAuthOptionsFromSecret(endpointSecret *v1.Secret) ([]gophercloud.AuthOptions, error) {
-	username := string(endpointSecret.Data[UsernameKey])
-	password := string(endpointSecret.Data[PasswordKey])
-	authURL := string(endpointSecret.Data[AuthUrlKey])
-	userID := string(endpointSecret.Data[UserIDKey])
-	tenantID := string(endpointSecret.Data[TenantIDKey])
-	tenantName := string(endpointSecret.Data[TenantNameKey])
-	domainID := string(endpointSecret.Data[DomainIDKey])
-	domainName := string(endpointSecret.Data[DomainNameKey])
-	applicationCredentialID := string(endpointSecret.Data[ApplicationCredentialIDKey])
-	applicationCredentialName := string(endpointSecret.Data[ApplicationCredentialNameKey])
-	applicationCredentialSecret := string(endpointSecret.Data[ApplicationCredentialSecretKey])
-	projectID := string(endpointSecret.Data[ProjectIDKey])
-	projectName := string(endpointSecret.Data[ProjectNameKey])
+	return GetAuthOptionsFromData(endpointSecret.Data)
+}
+
+// Builds the client authentication options from the data of a secret or of
+// an external credentials directory.
+func GetAuthOptionsFromData(data map[string][]byte) ([]gophercloud.AuthOptions, error) {
+	username := string(data[UsernameKey])
+	password := string(data[PasswordKey])
+	authURL := string(data[AuthUrlKey])
+	userID := string(data[UserIDKey])
+	tenantID := string(data[TenantIDKey])
+	tenantName := string(data[TenantNameKey])
+	domainID := string(data[DomainIDKey])
+	domainName := string(data[DomainNameKey])
+	applicationCredentialID := string(data[ApplicationCredentialIDKey])
+	applicationCredentialName := string(data[ApplicationCredentialNameKey])
+	applicationCredentialSecret := string(data[ApplicationCredentialSecretKey])
+	projectID := string(data[ProjectIDKey])
+	projectName := string(data[ProjectNameKey])
 
 	if projectID != "" {
 		// If OS_PROJECT_ID is set, overwrite tenantID with the value.
@@ -263,62 +279,35 @@ func GetAuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 }
 
 func (m *PlatformManager) BuildPlatformClient(namespace string, endpointName string, endpointType string) (*gophercloud.ServiceClient, error) {
-	var provider *gophercloud.ProviderClient
-
-	secret := &v1.Secret{}
-	secretName := types.NamespacedName{Namespace: namespace, Name: SystemEndpointSecretName}
-
-	// Lookup the system endpoint secret for this namespace
-	err := m.GetClient().Get(context.TODO(), secretName, secret)
+	// Lookup the system endpoint credentials for this namespace
+	credentials, err := m.GetEndpointCredentials(namespace)
 	if err != nil {
-		err = perrors.Wrap(err, "failed to find system endpoint secret")
 		return nil, err
 	}
 
-	options, err := GetAuthOptionsFromSecret(secret)
+	options, err := GetAuthOptionsFromData(credentials.Data)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, authOptions := range options {
-		// Force re-authentication on failures.
-		authOptions.AllowReauth = true
-
-	retry:
-		// Authenticate against the openstack API
-		provider, err = openstack.AuthenticatedClient(authOptions)
-		if err != nil {
-			if urlError, ok := err.(*url.Error); ok {
-				if urlError.Err.Error() == "EOF" && strings.Contains(authOptions.IdentityEndpoint, HTTPPrefix) {
-					// The endpoint has been switched to HTTPS mode so automatically
-					// update our endpoint to HTTPS so that we can continue.
-					authOptions.IdentityEndpoint = strings.Replace(authOptions.IdentityEndpoint, HTTPPrefix, HTTPSPrefix, 1)
-					log.Info("retrying authentication request with HTTPS enabled")
-					goto retry
-
-				} else if strings.Contains(err.Error(), HTTPSNotEnabled) && strings.Contains(authOptions.IdentityEndpoint, HTTPSPrefix) {
-					// The endpoint has been switched to HTTP mode so automatically
-					// update our endpoint to HTTP so that we can continue.
-					authOptions.IdentityEndpoint = strings.Replace(authOptions.IdentityEndpoint, HTTPSPrefix, HTTPPrefix, 1)
-					log.Info("retrying authentication request with HTTPS disabled")
-					goto retry
-				}
-			}
+	// Lookup the CA bundle and client certificate referenced by the
+	// credentials, if any, so that the endpoint can be reached with HTTPS
+	// using a private CA.
+	endpointTLS, err := GetEndpointTLS(m.GetClient(), namespace, credentials.Data)
+	if err != nil {
+		return nil, err
+	}
 
-			authOptions.Password = "***REDACTED***" // redact for logging
-			log.Error(err, "failed to authenticate client", "url", authOptions.IdentityEndpoint, "options", authOptions)
+	version := endpointVersion(credentials, endpointTLS)
 
-		} else {
-			// Use the first successful client
-			break
-		}
+	session, err := m.endpointSession(namespace, endpointName, options, endpointTLS.Config, version)
+	if err != nil {
+		return nil, err
 	}
 
-	if provider == nil {
-		return nil, perrors.Wrap(err, "failed to authenticate against all available auth URL options")
-	}
+	provider := session.NewProviderClient()
 
-	availability := gophercloud.Availability(secret.Data[InterfaceKey])
+	availability := gophercloud.Availability(credentials.Data[InterfaceKey])
 	if availability == "" {
 		availability = gophercloud.AvailabilityPublic
 	}
@@ -328,7 +317,7 @@ func (m *PlatformManager) BuildPlatformClient(namespace string, endpointName str
 		Name:         endpointName,
 		Type:         endpointType,
 		Availability: availability,
-		Region:       string(secret.Data[RegionNameKey]),
+		Region:       string(credentials.Data[RegionNameKey]),
 	}
 
 	// Get the system API URL
@@ -343,7 +332,7 @@ func (m *PlatformManager) BuildPlatformClient(namespace string, endpointName str
 		Endpoint:       urlEndpoint,
 		ResourceBase:   urlEndpoint}
 
-	debug, err := strconv.ParseBool(string(secret.Data[DebugKey]))
+	debug, err := strconv.ParseBool(string(credentials.Data[DebugKey]))
 	if err == nil && debug {
 		// Debug is enabled so log all API requests/responses
 		t := c.HTTPClient.Transport
@@ -354,6 +343,32 @@ func (m *PlatformManager) BuildPlatformClient(namespace string, endpointName str
 	}
 
 	if endpointName == SystemEndpointName {
+		// Every system API client of the namespace shares the same throttle
+		// so that all reconcilers are rate limited and back off together.
+		t := c.HTTPClient.Transport
+		if t == nil {
+			t = http.DefaultTransport
+		}
+		t = &InstrumentedRoundTripper{Rt: t, Namespace: namespace}
+		c.HTTPClient.Transport = &ThrottledRoundTripper{Rt: t, Throttle: m.apiThrottle(namespace)}
+
+		// Any change made to the system configuration invalidates the cached
+		// inventory snapshots of the namespace.
+		c.HTTPClient.Transport = &InvalidatingRoundTripper{
+			Rt:         c.HTTPClient.Transport,
+			Invalidate: func() { m.InvalidateInventory(namespace) },
+		}
+
+		// Changes are withheld from the system while plan mode is enabled but
+		// they are still recorded in the change audit log.
+		c.HTTPClient.Transport = m.planRoundTripper(namespace, c)
+
+		// Every change made to the system configuration is recorded along
+		// with the resource which initiated it.
+		c.HTTPClient.Transport = &ChangeAuditRoundTripper{
+			Rt:        c.HTTPClient.Transport,
+			Namespace: namespace,
+		}
 
 		// Test the client because the authentication endpoint is different from
 		// the resource endpoint therefore there is no guarantee that it works.
@@ -367,20 +382,133 @@ func (m *PlatformManager) BuildPlatformClient(namespace string, endpointName str
 		defer func() { m.lock.Unlock() }()
 
 		if obj, ok := m.systems[namespace]; !ok {
-			m.systems[namespace] = &SystemNamespace{client: c}
+			m.systems[namespace] = &SystemNamespace{client: c, session: session, secretVersion: version}
 			m.strategyStatus.Namespace = namespace
 		} else {
 			obj.client = c
+			obj.faultClient = nil
+			obj.vimClient = nil
+			obj.dcClient = nil
+			obj.patchingClient = nil
+			obj.usmClient = nil
+			obj.session = session
+			obj.secretVersion = version
 		}
-	} else if endpointName == VimEndpointName {
-		// Test the client because the authentication endpoint is different from
-		// the resource endpoint therefore there is no guarantee that it works.
-		res, err := systemconfigupdate.Show(c)
-		if err != nil || res == nil {
-			err = perrors.Wrap(err, "failed to test vim client connection")
-			return nil, err
+	} else {
+		// Changes are withheld from every other service of the system while
+		// plan mode is enabled.
+		c.HTTPClient.Transport = m.planRoundTripper(namespace, c)
+
+		// Changes made through the other services are audited the same way
+		// as those made through the system API.
+		c.HTTPClient.Transport = &ChangeAuditRoundTripper{
+			Rt:        c.HTTPClient.Transport,
+			Namespace: namespace,
+		}
+
+		if endpointName == VimEndpointName {
+			// Test the client because the authentication endpoint is different
+			// from the resource endpoint therefore there is no guarantee that
+			// it works.
+			res, err := systemconfigupdate.Show(c)
+			if err != nil || res == nil {
+				err = perrors.Wrap(err, "failed to test vim client connection")
+				return nil, err
+			}
 		}
 	}
 
 	return c, nil
 }
+
+// planRoundTripper returns a round tripper which withholds the changes made
+// through a client while plan mode is enabled for its namespace.
+func (m *PlatformManager) planRoundTripper(namespace string, c *gophercloud.ServiceClient) http.RoundTripper {
+	t := c.HTTPClient.Transport
+	if t == nil {
+		t = http.DefaultTransport
+	}
+
+	return &PlanRoundTripper{
+		Rt:       t,
+		Endpoint: c.Endpoint,
+		Enabled:  func() bool { return m.PlanModeEnabled(namespace) },
+	}
+}
+
+// apiThrottle returns the throttle shared by the system API clients of a
+// namespace.  It is created from the current configuration on first use.
+func (m *PlatformManager) apiThrottle(namespace string) *APIThrottle {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if t, ok := m.throttles[namespace]; ok {
+		return t
+	}
+
+	t := NewAPIThrottle(namespace, common.GetAPIRateLimit(), common.GetAPIBurst(),
+		common.GetAPIFailureThreshold(), common.GetAPICooldown())
+	m.throttles[namespace] = t
+
+	return t
+}
+
+// endpointSession returns the keystone session used to build a client of a
+// namespace.  Building the system API client always authenticates again
+// since it is rebuilt whenever the credentials change or the client fails.
+// The clients of the other services share the session of the system API
+// client as long as it was created from the same credentials.
+func (m *PlatformManager) endpointSession(namespace string, endpointName string, options []gophercloud.AuthOptions, config *tls.Config, version string) (*EndpointSession, error) {
+	if endpointName != SystemEndpointName {
+		m.lock.Lock()
+		obj, ok := m.systems[namespace]
+		if ok && obj.session != nil && obj.session.version == version {
+			session := obj.session
+			m.lock.Unlock()
+			return session, nil
+		}
+		m.lock.Unlock()
+	}
+
+	return NewEndpointSession(options, config, version)
+}
+
+// endpointVersion combines the versions of the endpoint credentials and of
+// the secrets referenced for TLS so that a change to any of them is detected.
+func endpointVersion(credentials *EndpointCredentials, endpointTLS *EndpointTLS) string {
+	if endpointTLS.Version == "" {
+		return credentials.Version
+	}
+
+	return credentials.Version + ";" + endpointTLS.Version
+}
+
+// PlatformClientStale determines whether the system endpoint secret of a
+// namespace, or any secret it references, has been modified since its
+// platform client was built.  A stale client must be rebuilt so that it uses
+// the current credentials.  The credentials of an external secret provider
+// are re-read on each call so that a rotation made by the provider is picked
+// up.
+func (m *PlatformManager) PlatformClientStale(namespace string) bool {
+	m.lock.Lock()
+	obj, ok := m.systems[namespace]
+	if !ok || obj.client == nil {
+		m.lock.Unlock()
+		return false
+	}
+	version := obj.secretVersion
+	m.lock.Unlock()
+
+	credentials, err := m.GetEndpointCredentials(namespace)
+	if err != nil {
+		// Let the client be rebuilt when it fails rather than guessing.
+		return false
+	}
+
+	endpointTLS, err := GetEndpointTLS(m.GetClient(), namespace, credentials.Data)
+	if err != nil {
+		return false
+	}
+
+	return endpointVersion(credentials, endpointTLS) != version
+}
@@ -0,0 +1,146 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	perrors "github.com/pkg/errors"
+	common "github.com/wind-river/cloud-platform-deployment-manager/common"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Defines the sources from which the system endpoint credentials are read.
+const (
+	CredentialSourceSecret   = "secret"
+	CredentialSourceExternal = "external"
+)
+
+// EndpointCredentials defines the contents of the system endpoint secret
+// along with a version which changes whenever the contents change.  The
+// contents are either read from a Kubernetes Secret or from the files
+// written by an external secret provider.
+type EndpointCredentials struct {
+	Data    map[string][]byte
+	Version string
+	Source  string
+}
+
+// ExternalCredentialsDir returns the directory from which the credentials of
+// an endpoint secret are read when an external secret provider (e.g., the
+// Secrets Store CSI driver or the Vault agent) is used.  Each file of the
+// directory holds a single value named after its key (e.g., OS_PASSWORD).
+// The boolean result is false when no external credentials are available.
+func ExternalCredentialsDir(namespace string, name string) (string, bool) {
+	base := common.GetExternalCredentialsDirectory()
+	if base == "" {
+		return "", false
+	}
+
+	dir := filepath.Join(base, namespace, name)
+	info, err := os.Stat(dir)
+	if err != nil || !info.IsDir() {
+		return "", false
+	}
+
+	return dir, true
+}
+
+// readCredentialsDir reads every key of an external credentials directory.
+// The hidden entries created by the atomic writer of projected volumes
+// (e.g., ..data) are skipped.  The version is a digest of the contents so
+// that any rotation made by the provider is detected without relying on
+// file timestamps.
+func readCredentialsDir(dir string) (*EndpointCredentials, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string][]byte)
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		// Follow symlinks since projected volumes link each key to the
+		// current revision of the data.
+		path := filepath.Join(dir, entry.Name())
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+
+		value, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		// Templates rendered by secret agents commonly end with a newline
+		// which is never part of the credential.
+		data[entry.Name()] = []byte(strings.TrimRight(string(value), "\r\n"))
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	digest := sha256.New()
+	for _, key := range keys {
+		digest.Write([]byte(key))
+		digest.Write([]byte{0})
+		digest.Write(data[key])
+		digest.Write([]byte{0})
+	}
+
+	result := EndpointCredentials{
+		Data:    data,
+		Version: "sha256:" + hex.EncodeToString(digest.Sum(nil)),
+		Source:  CredentialSourceExternal,
+	}
+
+	return &result, nil
+}
+
+// GetEndpointCredentials returns the current system endpoint credentials of
+// a namespace.  The credentials provided by an external secret provider take
+// precedence over the Kubernetes Secret of the same name.
+func (m *PlatformManager) GetEndpointCredentials(namespace string) (*EndpointCredentials, error) {
+	name := m.GetEndpointSecret(namespace)
+
+	if dir, ok := ExternalCredentialsDir(namespace, name); ok {
+		credentials, err := readCredentialsDir(dir)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to read external system endpoint credentials from %s", dir)
+			return nil, err
+		}
+
+		return credentials, nil
+	}
+
+	secret := &v1.Secret{}
+	secretName := types.NamespacedName{Namespace: namespace, Name: name}
+	err := m.GetClient().Get(context.TODO(), secretName, secret)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to find system endpoint secret")
+		return nil, err
+	}
+
+	result := EndpointCredentials{
+		Data:    secret.Data,
+		Version: secret.ResourceVersion,
+		Source:  CredentialSourceSecret,
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,88 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("External credentials", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "credentials")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	// write replaces the contents of the directory the same way the atomic
+	// writer of projected volumes does.
+	write := func(revision string, data map[string]string) {
+		target := filepath.Join(dir, revision)
+		Expect(os.Mkdir(target, 0700)).To(Succeed())
+		for key, value := range data {
+			Expect(os.WriteFile(filepath.Join(target, key), []byte(value), 0600)).To(Succeed())
+		}
+
+		link := filepath.Join(dir, "..data_tmp")
+		Expect(os.Symlink(revision, link)).To(Succeed())
+		Expect(os.Rename(link, filepath.Join(dir, "..data"))).To(Succeed())
+
+		for key := range data {
+			path := filepath.Join(dir, key)
+			if _, err := os.Lstat(path); err != nil {
+				Expect(os.Symlink(filepath.Join("..data", key), path)).To(Succeed())
+			}
+		}
+	}
+
+	It("should read each key from its own file", func() {
+		write("..rev1", map[string]string{
+			AuthUrlKey:  "http://192.168.204.2:5000/v3\n",
+			UsernameKey: "admin",
+			PasswordKey: "secret\n",
+		})
+
+		credentials, err := readCredentialsDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials.Source).To(Equal(CredentialSourceExternal))
+		Expect(credentials.Data).To(HaveLen(3))
+		Expect(string(credentials.Data[AuthUrlKey])).To(Equal("http://192.168.204.2:5000/v3"))
+		Expect(string(credentials.Data[PasswordKey])).To(Equal("secret"))
+
+		options, err := GetAuthOptionsFromData(credentials.Data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(options).To(HaveLen(1))
+		Expect(options[0].Username).To(Equal("admin"))
+	})
+
+	It("should change version when the provider rotates the credentials", func() {
+		write("..rev1", map[string]string{UsernameKey: "admin", PasswordKey: "secret"})
+		before, err := readCredentialsDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+
+		again, err := readCredentialsDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(again.Version).To(Equal(before.Version))
+
+		write("..rev2", map[string]string{UsernameKey: "admin", PasswordKey: "rotated"})
+		after, err := readCredentialsDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(after.Data[PasswordKey])).To(Equal("rotated"))
+		Expect(after.Version).ToNot(Equal(before.Version))
+	})
+
+	It("should not find a directory when no external path is configured", func() {
+		_, ok := ExternalCredentialsDir("deployment", SystemEndpointSecretName)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,62 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+)
+
+var _ = Describe("Endpoint secret", func() {
+	var m *PlatformManager
+
+	BeforeEach(func() {
+		m = &PlatformManager{
+			systems:         make(map[string]*SystemNamespace),
+			endpointSecrets: make(map[string]string),
+			inventory:       NewInventoryCache(time.Minute),
+		}
+	})
+
+	It("should default to the system endpoint secret", func() {
+		Expect(m.GetEndpointSecret("edge-1")).To(Equal(SystemEndpointSecretName))
+
+		m.SetEndpointSecret("edge-1", "")
+		Expect(m.GetEndpointSecret("edge-1")).To(Equal(SystemEndpointSecretName))
+	})
+
+	It("should track the secret of each namespace independently", func() {
+		m.SetEndpointSecret("edge-1", "edge-1-endpoint")
+		m.SetEndpointSecret("edge-2", "edge-2-endpoint")
+
+		Expect(m.GetEndpointSecret("edge-1")).To(Equal("edge-1-endpoint"))
+		Expect(m.GetEndpointSecret("edge-2")).To(Equal("edge-2-endpoint"))
+	})
+
+	It("should discard the clients of a namespace when its secret changes", func() {
+		c := &gophercloud.ServiceClient{}
+		m.systems["edge-1"] = &SystemNamespace{client: c, faultClient: c, vimClient: c, dcClient: c, patchingClient: c, usmClient: c}
+		m.systems["edge-2"] = &SystemNamespace{client: c}
+		m.inventory.SetSystem("edge-1", m.inventory.Generation("edge-1"), &v1info.SystemInfo{})
+
+		m.SetEndpointSecret("edge-1", SystemEndpointSecretName)
+		Expect(m.systems["edge-1"].client).To(Equal(c))
+
+		m.SetEndpointSecret("edge-1", "edge-1-endpoint")
+		Expect(m.systems["edge-1"].client).To(BeNil())
+		Expect(m.systems["edge-1"].faultClient).To(BeNil())
+		Expect(m.systems["edge-1"].vimClient).To(BeNil())
+		Expect(m.systems["edge-1"].dcClient).To(BeNil())
+		Expect(m.systems["edge-1"].patchingClient).To(BeNil())
+		Expect(m.systems["edge-1"].usmClient).To(BeNil())
+		Expect(m.systems["edge-2"].client).To(Equal(c))
+
+		_, ok := m.inventory.GetSystem("edge-1")
+		Expect(ok).To(BeFalse())
+	})
+})
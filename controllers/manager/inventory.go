@@ -0,0 +1,284 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Defines the outcomes recorded for each inventory cache lookup.
+const (
+	InventoryCacheHit  = "hit"
+	InventoryCacheMiss = "miss"
+)
+
+var inventoryCacheRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "deployment_manager_inventory_cache_requests_total",
+		Help: "Number of host and system inventory lookups served by the inventory cache.",
+	},
+	[]string{"namespace", "kind", "result"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(inventoryCacheRequests)
+}
+
+// inventoryEntry defines a cached inventory snapshot along with the time at
+// which it expires.
+type inventoryEntry struct {
+	expires time.Time
+	value   interface{}
+}
+
+// inventoryNamespace defines the cached snapshots of a single namespace.
+type inventoryNamespace struct {
+	// generation is incremented each time the namespace is invalidated so
+	// that a snapshot collected concurrently with a mutation is discarded
+	// rather than stored.
+	generation uint64
+	system     *inventoryEntry
+	hosts      map[string]*inventoryEntry
+}
+
+// InventoryCache holds short lived HostInfo and SystemInfo snapshots so that
+// reconcilers running within a few seconds of each other do not each re-read
+// the full inventory from the system API.  A TTL of 0 disables the cache.
+type InventoryCache struct {
+	ttl        time.Duration
+	lock       sync.Mutex
+	namespaces map[string]*inventoryNamespace
+	now        func() time.Time
+}
+
+// NewInventoryCache returns a new inventory cache with the specified TTL.
+func NewInventoryCache(ttl time.Duration) *InventoryCache {
+	return &InventoryCache{
+		ttl:        ttl,
+		namespaces: make(map[string]*inventoryNamespace),
+		now:        time.Now,
+	}
+}
+
+// namespace returns the cached snapshots of a namespace.  The cache lock must
+// be held by the caller.
+func (c *InventoryCache) namespace(namespace string) *inventoryNamespace {
+	obj, ok := c.namespaces[namespace]
+	if !ok {
+		obj = &inventoryNamespace{hosts: make(map[string]*inventoryEntry)}
+		c.namespaces[namespace] = obj
+	}
+
+	return obj
+}
+
+// Generation returns the current invalidation generation of a namespace.  It
+// must be read before collecting a snapshot and passed back when storing it.
+func (c *InventoryCache) Generation(namespace string) uint64 {
+	c.lock.Lock()
+	defer func() { c.lock.Unlock() }()
+
+	return c.namespace(namespace).generation
+}
+
+// lookup returns the value of an entry if it has not yet expired.
+func (c *InventoryCache) lookup(entry *inventoryEntry) (interface{}, bool) {
+	if entry == nil || !c.now().Before(entry.expires) {
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+// store builds a new entry unless the namespace has been invalidated since
+// the snapshot was collected.
+func (c *InventoryCache) store(ns *inventoryNamespace, generation uint64, value interface{}) *inventoryEntry {
+	if c.ttl <= 0 || ns.generation != generation {
+		return nil
+	}
+
+	return &inventoryEntry{expires: c.now().Add(c.ttl), value: value}
+}
+
+// GetHost returns a copy of the cached snapshot of a host.
+func (c *InventoryCache) GetHost(namespace string, hostID string) (*v1info.HostInfo, bool) {
+	c.lock.Lock()
+	defer func() { c.lock.Unlock() }()
+
+	value, ok := c.lookup(c.namespace(namespace).hosts[hostID])
+	if !ok {
+		return nil, false
+	}
+
+	result := *value.(*v1info.HostInfo)
+
+	return &result, true
+}
+
+// SetHost stores a copy of the snapshot of a host.
+func (c *InventoryCache) SetHost(namespace string, generation uint64, info *v1info.HostInfo) {
+	c.lock.Lock()
+	defer func() { c.lock.Unlock() }()
+
+	value := *info
+	ns := c.namespace(namespace)
+	if entry := c.store(ns, generation, &value); entry != nil {
+		ns.hosts[info.ID] = entry
+	}
+}
+
+// GetSystem returns a copy of the cached snapshot of the system.
+func (c *InventoryCache) GetSystem(namespace string) (*v1info.SystemInfo, bool) {
+	c.lock.Lock()
+	defer func() { c.lock.Unlock() }()
+
+	value, ok := c.lookup(c.namespace(namespace).system)
+	if !ok {
+		return nil, false
+	}
+
+	result := *value.(*v1info.SystemInfo)
+
+	return &result, true
+}
+
+// SetSystem stores a copy of the snapshot of the system.
+func (c *InventoryCache) SetSystem(namespace string, generation uint64, info *v1info.SystemInfo) {
+	c.lock.Lock()
+	defer func() { c.lock.Unlock() }()
+
+	value := *info
+	ns := c.namespace(namespace)
+	if entry := c.store(ns, generation, &value); entry != nil {
+		ns.system = entry
+	}
+}
+
+// InvalidateHost discards the cached snapshot of a single host.
+func (c *InventoryCache) InvalidateHost(namespace string, hostID string) {
+	c.lock.Lock()
+	defer func() { c.lock.Unlock() }()
+
+	ns := c.namespace(namespace)
+	ns.generation++
+	delete(ns.hosts, hostID)
+}
+
+// Invalidate discards every cached snapshot of a namespace.  Host snapshots
+// include system wide resources (e.g., networks, address pools) therefore
+// any mutation may affect all of them.
+func (c *InventoryCache) Invalidate(namespace string) {
+	c.lock.Lock()
+	defer func() { c.lock.Unlock() }()
+
+	ns := c.namespace(namespace)
+	ns.generation++
+	ns.system = nil
+	ns.hosts = make(map[string]*inventoryEntry)
+}
+
+// inventoryCache returns the inventory cache of the manager.  It is created
+// from the current configuration on first use.
+func (m *PlatformManager) inventoryCache() *InventoryCache {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if m.inventory == nil {
+		m.inventory = NewInventoryCache(common.GetInventoryCacheTTL())
+	}
+
+	return m.inventory
+}
+
+// GetHostInfo returns the inventory snapshot of a host.  A cached snapshot is
+// only used if its host record still matches the current host record so that
+// state transitions (e.g., inventory collection) are never missed.
+func (m *PlatformManager) GetHostInfo(namespace string, client *gophercloud.ServiceClient, host *hosts.Host) (*v1info.HostInfo, error) {
+	cache := m.inventoryCache()
+
+	if info, ok := cache.GetHost(namespace, host.ID); ok && reflect.DeepEqual(info.Host, *host) {
+		inventoryCacheRequests.WithLabelValues(namespace, "host", InventoryCacheHit).Inc()
+		return info, nil
+	}
+
+	inventoryCacheRequests.WithLabelValues(namespace, "host", InventoryCacheMiss).Inc()
+
+	generation := cache.Generation(namespace)
+
+	info := v1info.HostInfo{}
+	err := info.PopulateHostInfo(client, host.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.SetHost(namespace, generation, &info)
+
+	return &info, nil
+}
+
+// GetSystemInfo returns the inventory snapshot of the system.
+func (m *PlatformManager) GetSystemInfo(namespace string, client *gophercloud.ServiceClient) (*v1info.SystemInfo, error) {
+	cache := m.inventoryCache()
+
+	if info, ok := cache.GetSystem(namespace); ok {
+		inventoryCacheRequests.WithLabelValues(namespace, "system", InventoryCacheHit).Inc()
+		return info, nil
+	}
+
+	inventoryCacheRequests.WithLabelValues(namespace, "system", InventoryCacheMiss).Inc()
+
+	generation := cache.Generation(namespace)
+
+	info := v1info.SystemInfo{}
+	err := info.PopulateSystemInfo(client)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.SetSystem(namespace, generation, &info)
+
+	return &info, nil
+}
+
+// InvalidateHostInfo discards the cached inventory snapshot of a host.
+func (m *PlatformManager) InvalidateHostInfo(namespace string, hostID string) {
+	m.inventoryCache().InvalidateHost(namespace, hostID)
+}
+
+// InvalidateInventory discards every cached inventory snapshot of a namespace.
+func (m *PlatformManager) InvalidateInventory(namespace string) {
+	m.inventoryCache().Invalidate(namespace)
+}
+
+// InvalidatingRoundTripper wraps a RoundTripper so that every request which
+// may modify the system configuration invalidates the cached inventory of the
+// namespace.  This covers mutations made by any reconciler without requiring
+// each of them to track the resources they have changed.
+type InvalidatingRoundTripper struct {
+	Rt         http.RoundTripper
+	Invalidate func()
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (rt *InvalidatingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.Rt.RoundTrip(req)
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		rt.Invalidate()
+	}
+
+	return resp, err
+}
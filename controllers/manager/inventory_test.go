@@ -0,0 +1,107 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+)
+
+var _ = Describe("Inventory cache", func() {
+	var cache *InventoryCache
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Now()
+		cache = NewInventoryCache(10 * time.Second)
+		cache.now = func() time.Time { return now }
+	})
+
+	newHostInfo := func(id string) *v1info.HostInfo {
+		return &v1info.HostInfo{Host: hosts.Host{ID: id, Hostname: "controller-0"}}
+	}
+
+	It("should return host snapshots until they expire", func() {
+		cache.SetHost("test", cache.Generation("test"), newHostInfo("1"))
+
+		info, ok := cache.GetHost("test", "1")
+		Expect(ok).To(BeTrue())
+		Expect(info.Hostname).To(Equal("controller-0"))
+
+		now = now.Add(10 * time.Second)
+		_, ok = cache.GetHost("test", "1")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should return copies of the snapshots", func() {
+		cache.SetSystem("test", cache.Generation("test"), &v1info.SystemInfo{Timezone: "UTC"})
+
+		info, ok := cache.GetSystem("test")
+		Expect(ok).To(BeTrue())
+		info.Timezone = "EST"
+
+		info, _ = cache.GetSystem("test")
+		Expect(info.Timezone).To(Equal("UTC"))
+	})
+
+	It("should discard snapshots once invalidated", func() {
+		cache.SetHost("test", cache.Generation("test"), newHostInfo("1"))
+		cache.SetHost("test", cache.Generation("test"), newHostInfo("2"))
+		cache.SetSystem("test", cache.Generation("test"), &v1info.SystemInfo{})
+		cache.SetSystem("other", cache.Generation("other"), &v1info.SystemInfo{})
+
+		cache.InvalidateHost("test", "1")
+		_, ok := cache.GetHost("test", "1")
+		Expect(ok).To(BeFalse())
+		_, ok = cache.GetHost("test", "2")
+		Expect(ok).To(BeTrue())
+
+		cache.Invalidate("test")
+		_, ok = cache.GetHost("test", "2")
+		Expect(ok).To(BeFalse())
+		_, ok = cache.GetSystem("test")
+		Expect(ok).To(BeFalse())
+		_, ok = cache.GetSystem("other")
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should not store snapshots collected before an invalidation", func() {
+		generation := cache.Generation("test")
+		cache.Invalidate("test")
+		cache.SetHost("test", generation, newHostInfo("1"))
+
+		_, ok := cache.GetHost("test", "1")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should not store snapshots when disabled", func() {
+		cache = NewInventoryCache(0)
+		cache.SetSystem("test", cache.Generation("test"), &v1info.SystemInfo{})
+
+		_, ok := cache.GetSystem("test")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should invalidate the cache on mutating requests only", func() {
+		invalidated := 0
+		rt := &InvalidatingRoundTripper{
+			Rt:         &stubRoundTripper{status: http.StatusOK},
+			Invalidate: func() { invalidated++ },
+		}
+
+		_, err := rt.RoundTrip(httptest.NewRequest("GET", "http://sysinv/v1/ihosts", nil))
+		Expect(err).To(BeNil())
+		Expect(invalidated).To(Equal(0))
+
+		_, err = rt.RoundTrip(httptest.NewRequest("PATCH", "http://sysinv/v1/ihosts/1", nil))
+		Expect(err).To(BeNil())
+		Expect(invalidated).To(Equal(1))
+	})
+})
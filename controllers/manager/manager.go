@@ -14,6 +14,7 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/nfv/v1/systemconfigupdate"
 	perrors "github.com/pkg/errors"
 	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -42,6 +43,11 @@ const (
 	NotificationCountKey = "deployment-manager/notifications"
 	ReconcileAfterInSync = "deployment-manager/reconcile-after-insync"
 	RestoreInProgress    = "deployment-manager/restore-in-progress"
+	ReinstallHost        = "deployment-manager/reinstall"
+	PausedReconcile      = "deployment-manager/paused"
+	PlanMode             = "deployment-manager/plan"
+	SnapshotProfile      = "deployment-manager/snapshot-profile"
+	AdoptedHost          = "deployment-manager/adopted-host"
 )
 
 const (
@@ -86,10 +92,13 @@ const (
 type CloudManager interface {
 	ResetPlatformClient(namespace string) error
 	GetPlatformClient(namespace string) *gophercloud.ServiceClient
+	PlatformClientStale(namespace string) bool
 	SetGetPlatformClient(f func(namespace string) *gophercloud.ServiceClient)
 	SetDefaultGetPlatformClient()
 	GetKubernetesClient() client.Client
 	BuildPlatformClient(namespace string, endpointName string, endpointType string) (*gophercloud.ServiceClient, error)
+	SetEndpointSecret(namespace string, name string)
+	GetEndpointSecret(namespace string) string
 	NotifySystemDependencies(namespace string) error
 	NotifyResource(object client.Object) error
 	SetSystemReady(namespace string, value bool)
@@ -99,6 +108,12 @@ type CloudManager interface {
 	StartMonitor(monitor *Monitor, message string) error
 	CancelMonitor(object client.Object)
 
+	// Inventory cache related methods
+	GetHostInfo(namespace string, client *gophercloud.ServiceClient, host *hosts.Host) (*v1info.HostInfo, error)
+	GetSystemInfo(namespace string, client *gophercloud.ServiceClient) (*v1info.SystemInfo, error)
+	InvalidateHostInfo(namespace string, hostID string)
+	InvalidateInventory(namespace string)
+
 	// Strategy related methods
 	SetResourceInfo(resourcetype string, personality string, resourcename string, reconciled bool, required string)
 	GetStrategyRequiredList() map[string]*ResourceInfo
@@ -112,6 +127,11 @@ type CloudManager interface {
 	ClearStragey()
 	GetStrageyNamespace() string
 	GetVimClient() *gophercloud.ServiceClient
+	GetFaultClient(namespace string) *gophercloud.ServiceClient
+	GetOrchestrationClient(namespace string) *gophercloud.ServiceClient
+	GetDistributedCloudClient(namespace string) *gophercloud.ServiceClient
+	GetPatchingClient(namespace string) *gophercloud.ServiceClient
+	GetSoftwareClient(namespace string) *gophercloud.ServiceClient
 	SetStrategyAppliedSent(namespace string, applied bool) error
 	StartStrategyMonitor()
 	SetStrategyRetryCount(c int) error
@@ -143,9 +163,16 @@ const (
 )
 
 type SystemNamespace struct {
-	client     *gophercloud.ServiceClient
-	ready      bool
-	systemType SystemType
+	client         *gophercloud.ServiceClient
+	faultClient    *gophercloud.ServiceClient
+	vimClient      *gophercloud.ServiceClient
+	dcClient       *gophercloud.ServiceClient
+	patchingClient *gophercloud.ServiceClient
+	usmClient      *gophercloud.ServiceClient
+	session        *EndpointSession
+	secretVersion  string
+	ready          bool
+	systemType     SystemType
 }
 
 // Strategy related consts and defines
@@ -154,6 +181,7 @@ const (
 	ResourceHost            = "host"
 	ResourcePlatformnetwork = "platformnetwork"
 	ResourceDatanetwork     = "datanetwork"
+	ResourceDeviceimage     = "deviceimage"
 	ResourcePtpinstance     = "ptpinstance"
 	ResourcePtpinterface    = "ptpinterface"
 )
@@ -192,8 +220,10 @@ type PlatformManager struct {
 	lock                            sync.Mutex
 	systems                         map[string]*SystemNamespace
 	monitors                        map[string]*Monitor
+	throttles                       map[string]*APIThrottle
+	inventory                       *InventoryCache
+	endpointSecrets                 map[string]string
 	strategyStatus                  *StrategyStatus
-	vimClient                       *gophercloud.ServiceClient
 	PlatformNetworkReconcilerStatus bool
 	GetPlatformClientImpl           func(namespace string) *gophercloud.ServiceClient
 }
@@ -214,10 +244,12 @@ func NewStrategyStatus() *StrategyStatus {
 
 func NewPlatformManager(manager manager.Manager) CloudManager {
 	return &PlatformManager{
-		Manager:        manager,
-		systems:        make(map[string]*SystemNamespace),
-		monitors:       make(map[string]*Monitor),
-		strategyStatus: NewStrategyStatus(),
+		Manager:         manager,
+		systems:         make(map[string]*SystemNamespace),
+		monitors:        make(map[string]*Monitor),
+		throttles:       make(map[string]*APIThrottle),
+		endpointSecrets: make(map[string]string),
+		strategyStatus:  NewStrategyStatus(),
 	}
 }
 
@@ -496,6 +528,12 @@ func (m *PlatformManager) ResetPlatformClient(namespace string) error {
 			return nil
 		}
 		obj.client = nil
+		obj.faultClient = nil
+		obj.vimClient = nil
+		obj.dcClient = nil
+		obj.patchingClient = nil
+		obj.usmClient = nil
+		obj.session = nil
 	} else {
 		// SystemNamespace doesn't exist yet
 		return nil
@@ -506,6 +544,59 @@ func (m *PlatformManager) ResetPlatformClient(namespace string) error {
 	return m.NotifySystemController(namespace)
 }
 
+// SetEndpointSecret sets the name of the secret from which the clients of a
+// namespace are built.  The existing clients of the namespace are discarded
+// when the secret changes so that they are rebuilt against the new endpoint.
+func (m *PlatformManager) SetEndpointSecret(namespace string, name string) {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if name == "" {
+		name = SystemEndpointSecretName
+	}
+
+	previous, ok := m.endpointSecrets[namespace]
+	if !ok {
+		previous = SystemEndpointSecretName
+	}
+
+	m.endpointSecrets[namespace] = name
+
+	if previous == name {
+		return
+	}
+
+	log.Info("system endpoint secret changed", "namespace", namespace,
+		"previous", previous, "secret", name)
+
+	if obj, ok := m.systems[namespace]; ok {
+		obj.client = nil
+		obj.faultClient = nil
+		obj.vimClient = nil
+		obj.dcClient = nil
+		obj.patchingClient = nil
+		obj.usmClient = nil
+		obj.session = nil
+	}
+
+	if m.inventory != nil {
+		m.inventory.Invalidate(namespace)
+	}
+}
+
+// GetEndpointSecret returns the name of the secret from which the clients of
+// a namespace are built.
+func (m *PlatformManager) GetEndpointSecret(namespace string) string {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if name, ok := m.endpointSecrets[namespace]; ok {
+		return name
+	}
+
+	return SystemEndpointSecretName
+}
+
 // SetSystemReady allows setting the readiness state for a given namespace.
 func (m *PlatformManager) SetSystemReady(namespace string, value bool) {
 	m.lock.Lock()
@@ -788,22 +879,156 @@ func (m *PlatformManager) GetStrageyNamespace() string {
 	return m.strategyStatus.Namespace
 }
 
-// GetVimClient returns vim client for system update
+// GetVimClient returns vim client for system update.  The client belongs to
+// the namespace of the system configuration update strategy so that it
+// targets the same system as the strategy.
 func (m *PlatformManager) GetVimClient() *gophercloud.ServiceClient {
-	if m.vimClient == nil {
-		namespace := m.GetStrageyNamespace()
-		if namespace == "" {
-			log.Info("No Namespace. Waiting for platform client creation")
-		} else {
-			c, err := m.BuildPlatformClient(namespace, VimEndpointName, VimEndpointType)
-			if err != nil {
-				log.Error(err, "Create client failed")
-			} else {
-				m.vimClient = c
-			}
-		}
+	namespace := m.GetStrageyNamespace()
+	if namespace == "" {
+		log.Info("No Namespace. Waiting for platform client creation")
+		return nil
+	}
+
+	return m.GetOrchestrationClient(namespace)
+}
+
+// GetFaultClient returns the fault management client of a namespace.  The
+// client is built on first use and is discarded along with the platform
+// client so that it is rebuilt whenever the credentials change.
+func (m *PlatformManager) GetFaultClient(namespace string) *gophercloud.ServiceClient {
+	m.lock.Lock()
+	if obj, ok := m.systems[namespace]; ok && obj.faultClient != nil {
+		c := obj.faultClient
+		m.lock.Unlock()
+		return c
+	}
+	m.lock.Unlock()
+
+	c, err := m.BuildPlatformClient(namespace, FaultEndpointName, FaultEndpointType)
+	if err != nil {
+		log.Error(err, "failed to create fault management client", "namespace", namespace)
+		return nil
+	}
+
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if obj, ok := m.systems[namespace]; ok {
+		obj.faultClient = c
+	}
+
+	return c
+}
+
+// GetOrchestrationClient returns the VIM client of a namespace used to
+// manage update orchestration strategies.  The client is discarded along with
+// the platform client.
+func (m *PlatformManager) GetOrchestrationClient(namespace string) *gophercloud.ServiceClient {
+	m.lock.Lock()
+	if obj, ok := m.systems[namespace]; ok && obj.vimClient != nil {
+		c := obj.vimClient
+		m.lock.Unlock()
+		return c
+	}
+	m.lock.Unlock()
+
+	c, err := m.BuildPlatformClient(namespace, VimEndpointName, VimEndpointType)
+	if err != nil {
+		log.Error(err, "failed to create vim client", "namespace", namespace)
+		return nil
+	}
+
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if obj, ok := m.systems[namespace]; ok {
+		obj.vimClient = c
+	}
+
+	return c
+}
+
+// GetDistributedCloudClient returns the dcmanager client of a namespace used
+// to manage the subclouds of a system controller.  The client is discarded
+// along with the platform client.
+func (m *PlatformManager) GetDistributedCloudClient(namespace string) *gophercloud.ServiceClient {
+	m.lock.Lock()
+	if obj, ok := m.systems[namespace]; ok && obj.dcClient != nil {
+		c := obj.dcClient
+		m.lock.Unlock()
+		return c
+	}
+	m.lock.Unlock()
+
+	c, err := m.BuildPlatformClient(namespace, DCManagerEndpointName, DCManagerEndpointType)
+	if err != nil {
+		log.Error(err, "failed to create dcmanager client", "namespace", namespace)
+		return nil
+	}
+
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if obj, ok := m.systems[namespace]; ok {
+		obj.dcClient = c
+	}
+
+	return c
+}
+
+// GetPatchingClient returns the patching client of a namespace used to
+// upload and apply software patches.  The client is discarded along with the
+// platform client.
+func (m *PlatformManager) GetPatchingClient(namespace string) *gophercloud.ServiceClient {
+	m.lock.Lock()
+	if obj, ok := m.systems[namespace]; ok && obj.patchingClient != nil {
+		c := obj.patchingClient
+		m.lock.Unlock()
+		return c
 	}
-	return m.vimClient
+	m.lock.Unlock()
+
+	c, err := m.BuildPlatformClient(namespace, PatchingEndpointName, PatchingEndpointType)
+	if err != nil {
+		log.Error(err, "failed to create patching client", "namespace", namespace)
+		return nil
+	}
+
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if obj, ok := m.systems[namespace]; ok {
+		obj.patchingClient = c
+	}
+
+	return c
+}
+
+// GetSoftwareClient returns the USM client of a namespace used to deploy
+// software releases.  The client is discarded along with the platform client.
+func (m *PlatformManager) GetSoftwareClient(namespace string) *gophercloud.ServiceClient {
+	m.lock.Lock()
+	if obj, ok := m.systems[namespace]; ok && obj.usmClient != nil {
+		c := obj.usmClient
+		m.lock.Unlock()
+		return c
+	}
+	m.lock.Unlock()
+
+	c, err := m.BuildPlatformClient(namespace, USMEndpointName, USMEndpointType)
+	if err != nil {
+		log.Error(err, "failed to create usm client", "namespace", namespace)
+		return nil
+	}
+
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if obj, ok := m.systems[namespace]; ok {
+		obj.usmClient = c
+	}
+
+	return c
 }
 
 func (m *PlatformManager) IsPlatformNetworkReconciling() bool {
@@ -0,0 +1,279 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	apiRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "deployment_manager_api_request_duration_seconds",
+			Help:    "Latency of the system API requests by resource type.",
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{"namespace", "resource", "method", "code"},
+	)
+
+	activeMonitors = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "deployment_manager_monitors_active",
+			Help: "Number of monitors currently waiting for a resource to change state.",
+		},
+		[]string{"namespace", "kind"},
+	)
+
+	driftDetected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "deployment_manager_drift_detected_total",
+			Help: "Number of audits which found that a reconciled resource no longer matches the system configuration.",
+		},
+		[]string{"namespace", "kind"},
+	)
+
+	activeAlarms = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "deployment_manager_alarms_active",
+			Help: "Number of active platform alarms by severity as last polled from the system.",
+		},
+		[]string{"namespace", "severity"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(apiRequestDuration, activeMonitors, driftDetected, activeAlarms)
+}
+
+// alarmSeverities defines the alarm severities which are always reported so
+// that a severity without active alarms reports a count of 0 rather than no
+// value at all.
+var alarmSeverities = []string{"critical", "major", "minor", "warning"}
+
+// RecordActiveAlarms records the number of active platform alarms of a
+// namespace by severity.
+func RecordActiveAlarms(namespace string, active []v1.AlarmStatus) {
+	counts := make(map[string]int)
+	for _, severity := range alarmSeverities {
+		counts[severity] = 0
+	}
+
+	for _, a := range active {
+		counts[strings.ToLower(a.Severity)]++
+	}
+
+	for severity, count := range counts {
+		activeAlarms.WithLabelValues(namespace, severity).Set(float64(count))
+	}
+}
+
+// ClearActiveAlarms removes the active platform alarm counts of a namespace
+// once its alarms are no longer polled.
+func ClearActiveAlarms(namespace string) {
+	activeAlarms.DeletePartialMatch(prometheus.Labels{"namespace": namespace})
+}
+
+// RecordDrift records that the audit of a reconciled resource has found that
+// it no longer matches the system configuration.
+func RecordDrift(namespace string, kind string) {
+	driftDetected.WithLabelValues(namespace, kind).Inc()
+}
+
+// monitorKind returns the kind of the resource being monitored.  The GVK of
+// typed objects is not always populated therefore the Go type name is used.
+func monitorKind(object client.Object) string {
+	t := reflect.TypeOf(object)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
+// apiVersionSegment matches the version segment of a system API URL path.
+var apiVersionSegment = regexp.MustCompile(`^v[0-9.]+$`)
+
+// apiResource returns the resource type addressed by a system API request
+// (e.g., "ihosts" for "/v1/ihosts/<uuid>/ports").  Only the first segment
+// after the API version is used so that resource identifiers do not end up
+// in the metric labels.
+func apiResource(req *http.Request) string {
+	for _, segment := range strings.Split(strings.Trim(req.URL.Path, "/"), "/") {
+		if segment == "" || apiVersionSegment.MatchString(segment) {
+			continue
+		}
+
+		return segment
+	}
+
+	return "unknown"
+}
+
+// InstrumentedRoundTripper wraps a RoundTripper so that the latency of every
+// system API request is recorded.
+type InstrumentedRoundTripper struct {
+	Rt        http.RoundTripper
+	Namespace string
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (rt *InstrumentedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	resp, err := rt.Rt.RoundTrip(req)
+
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+
+	apiRequestDuration.WithLabelValues(rt.Namespace, apiResource(req), req.Method, code).
+		Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
+
+var (
+	resourcesDesc = prometheus.NewDesc(
+		"deployment_manager_resources",
+		"Number of resources of each kind by synchronization state.",
+		[]string{"namespace", "kind", "reconciled", "in_sync"}, nil,
+	)
+
+	hostsDesc = prometheus.NewDesc(
+		"deployment_manager_hosts",
+		"Number of hosts in each state as last reported by the system.",
+		[]string{"namespace", "administrative", "operational", "availability"}, nil,
+	)
+
+	hostStateDesc = prometheus.NewDesc(
+		"deployment_manager_host_state",
+		"State of each host as last reported by the system.  The value is always 1.",
+		[]string{"namespace", "host", "administrative", "operational", "availability"}, nil,
+	)
+)
+
+// ResourceCollector reports the state of the reconciled resources.  The
+// values are computed from the manager cache whenever the metrics are
+// scraped so that they never diverge from the resources themselves.
+type ResourceCollector struct {
+	Reader client.Reader
+}
+
+// NewResourceCollector returns a new collector which reads the resources
+// from the specified reader.
+func NewResourceCollector(reader client.Reader) *ResourceCollector {
+	return &ResourceCollector{Reader: reader}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *ResourceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- resourcesDesc
+	ch <- hostsDesc
+	ch <- hostStateDesc
+}
+
+// collectedKinds returns the list types of the resource kinds reported by the
+// collector.
+func collectedKinds() map[string]client.ObjectList {
+	return map[string]client.ObjectList{
+		v1.KindHost:            &v1.HostList{},
+		v1.KindSystem:          &v1.SystemList{},
+		v1.KindPlatformNetwork: &v1.PlatformNetworkList{},
+		v1.KindDataNetwork:     &v1.DataNetworkList{},
+		v1.KindPTPInstance:     &v1.PtpInstanceList{},
+		v1.KindPTPInterface:    &v1.PtpInterfaceList{},
+	}
+}
+
+// stateLabel returns the label value of an optional state attribute.
+func stateLabel(value *string) string {
+	if value == nil || *value == "" {
+		return "unknown"
+	}
+
+	return *value
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *ResourceCollector) Collect(ch chan<- prometheus.Metric) {
+	type resourceKey struct {
+		namespace  string
+		kind       string
+		reconciled bool
+		inSync     bool
+	}
+
+	type hostKey struct {
+		namespace      string
+		administrative string
+		operational    string
+		availability   string
+	}
+
+	resources := make(map[resourceKey]int)
+	hosts := make(map[hostKey]int)
+
+	for kind, list := range collectedKinds() {
+		err := c.Reader.List(context.TODO(), list)
+		if err != nil {
+			log.V(2).Info("unable to list resources for metrics", "kind", kind, "error", err.Error())
+			continue
+		}
+
+		objects, err := meta.ExtractList(list)
+		if err != nil {
+			continue
+		}
+
+		for _, obj := range objects {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+			if err != nil {
+				continue
+			}
+
+			accessor, _ := meta.Accessor(obj)
+			reconciled, _, _ := unstructured.NestedBool(content, "status", "reconciled")
+			inSync, _, _ := unstructured.NestedBool(content, "status", "inSync")
+			resources[resourceKey{accessor.GetNamespace(), kind, reconciled, inSync}]++
+
+			if host, ok := obj.(*v1.Host); ok {
+				key := hostKey{
+					namespace:      host.Namespace,
+					administrative: stateLabel(host.Status.AdministrativeState),
+					operational:    stateLabel(host.Status.OperationalStatus),
+					availability:   stateLabel(host.Status.AvailabilityStatus),
+				}
+				hosts[key]++
+
+				ch <- prometheus.MustNewConstMetric(hostStateDesc, prometheus.GaugeValue, 1,
+					host.Namespace, host.Name, key.administrative, key.operational, key.availability)
+			}
+		}
+	}
+
+	for key, count := range resources {
+		ch <- prometheus.MustNewConstMetric(resourcesDesc, prometheus.GaugeValue, float64(count),
+			key.namespace, key.kind, strconv.FormatBool(key.reconciled), strconv.FormatBool(key.inSync))
+	}
+
+	for key, count := range hosts {
+		ch <- prometheus.MustNewConstMetric(hostsDesc, prometheus.GaugeValue, float64(count),
+			key.namespace, key.administrative, key.operational, key.availability)
+	}
+}
@@ -0,0 +1,110 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Metrics", func() {
+	Describe("apiResource", func() {
+		It("should only report the resource type of the request", func() {
+			for path, expected := range map[string]string{
+				"http://sysinv/v1/ihosts":                    "ihosts",
+				"http://sysinv/v1/ihosts/1234/ports":         "ihosts",
+				"http://sysinv/v1/iinterfaces/1234?limit=10": "iinterfaces",
+				"http://sysinv/":                             "unknown",
+			} {
+				req := httptest.NewRequest("GET", path, nil)
+				Expect(apiResource(req)).To(Equal(expected))
+			}
+		})
+	})
+
+	Describe("InstrumentedRoundTripper", func() {
+		It("should record the latency of each request", func() {
+			stub := &stubRoundTripper{status: http.StatusOK}
+			rt := &InstrumentedRoundTripper{Rt: stub, Namespace: "metrics"}
+			req := httptest.NewRequest("PATCH", "http://sysinv/v1/ihosts/1234", nil)
+
+			_, err := rt.RoundTrip(req)
+			Expect(err).To(BeNil())
+			Expect(stub.calls).To(Equal(1))
+
+			observer, err := apiRequestDuration.GetMetricWithLabelValues("metrics", "ihosts", "PATCH", "200")
+			Expect(err).To(BeNil())
+			metric := &dto.Metric{}
+			Expect(observer.(prometheus.Metric).Write(metric)).To(Succeed())
+			Expect(metric.GetHistogram().GetSampleCount()).To(Equal(uint64(1)))
+		})
+	})
+
+	Describe("RecordActiveAlarms", func() {
+		It("should count the active alarms by severity", func() {
+			RecordActiveAlarms("alarms", []v1.AlarmStatus{
+				{ID: "a", Severity: "critical"},
+				{ID: "b", Severity: "major"},
+				{ID: "c", Severity: "Major"},
+			})
+			Expect(testutil.ToFloat64(activeAlarms.WithLabelValues("alarms", "critical"))).To(Equal(1.0))
+			Expect(testutil.ToFloat64(activeAlarms.WithLabelValues("alarms", "major"))).To(Equal(2.0))
+			Expect(testutil.ToFloat64(activeAlarms.WithLabelValues("alarms", "warning"))).To(Equal(0.0))
+
+			ClearActiveAlarms("alarms")
+			Expect(testutil.CollectAndCount(activeAlarms)).To(Equal(0))
+		})
+	})
+
+	Describe("ResourceCollector", func() {
+		It("should count the resources and hosts by state", func() {
+			scheme := runtime.NewScheme()
+			Expect(v1.AddToScheme(scheme)).To(Succeed())
+
+			available := "available"
+			reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&v1.Host{
+					ObjectMeta: metav1.ObjectMeta{Name: "controller-0", Namespace: "metrics"},
+					Status: v1.HostStatus{
+						Reconciled:         true,
+						InSync:             true,
+						AvailabilityStatus: &available,
+					},
+				},
+				&v1.Host{
+					ObjectMeta: metav1.ObjectMeta{Name: "controller-1", Namespace: "metrics"},
+				},
+			).Build()
+
+			expected := `
+# HELP deployment_manager_hosts Number of hosts in each state as last reported by the system.
+# TYPE deployment_manager_hosts gauge
+deployment_manager_hosts{administrative="unknown",availability="available",namespace="metrics",operational="unknown"} 1
+deployment_manager_hosts{administrative="unknown",availability="unknown",namespace="metrics",operational="unknown"} 1
+# HELP deployment_manager_host_state State of each host as last reported by the system.  The value is always 1.
+# TYPE deployment_manager_host_state gauge
+deployment_manager_host_state{administrative="unknown",availability="available",host="controller-0",namespace="metrics",operational="unknown"} 1
+deployment_manager_host_state{administrative="unknown",availability="unknown",host="controller-1",namespace="metrics",operational="unknown"} 1
+# HELP deployment_manager_resources Number of resources of each kind by synchronization state.
+# TYPE deployment_manager_resources gauge
+deployment_manager_resources{in_sync="false",kind="Host",namespace="metrics",reconciled="false"} 1
+deployment_manager_resources{in_sync="true",kind="Host",namespace="metrics",reconciled="true"} 1
+`
+			err := testutil.CollectAndCompare(NewResourceCollector(reader), strings.NewReader(expected))
+			Expect(err).To(BeNil())
+		})
+	})
+})
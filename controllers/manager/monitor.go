@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
 
 package manager
 
@@ -135,6 +135,10 @@ func (m *Monitor) Start(manager CloudManager) {
 	m.stopCh = make(chan struct{})
 
 	go func(stopCh <-chan struct{}) {
+		gauge := activeMonitors.WithLabelValues(m.GetNamespace(), monitorKind(m.Object))
+		gauge.Inc()
+		defer gauge.Dec()
+
 		// Set initial interval to immediately run once on startup
 		interval := time.Nanosecond
 
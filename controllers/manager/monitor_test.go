@@ -7,9 +7,11 @@ import (
 	"errors"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
 	"github.com/gophercloud/gophercloud/starlingx/nfv/v1/systemconfigupdate"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -320,6 +322,9 @@ func (m *Dummymanager) GetPlatformClient(namespace string) *gophercloud.ServiceC
 	c := &gophercloud.ServiceClient{}
 	return c
 }
+func (m *Dummymanager) PlatformClientStale(namespace string) bool {
+	return false
+}
 func (m *Dummymanager) GetKubernetesClient() client.Client {
 	return nil
 }
@@ -392,6 +397,36 @@ func (m *Dummymanager) GetVimClient() *gophercloud.ServiceClient {
 		return nil
 	}
 }
+func (m *Dummymanager) GetFaultClient(namespace string) *gophercloud.ServiceClient {
+	return nil
+}
+func (m *Dummymanager) GetOrchestrationClient(namespace string) *gophercloud.ServiceClient {
+	return nil
+}
+func (m *Dummymanager) GetDistributedCloudClient(namespace string) *gophercloud.ServiceClient {
+	return nil
+}
+func (m *Dummymanager) GetPatchingClient(namespace string) *gophercloud.ServiceClient {
+	return nil
+}
+func (m *Dummymanager) GetSoftwareClient(namespace string) *gophercloud.ServiceClient {
+	return nil
+}
+func (m *Dummymanager) SetEndpointSecret(namespace string, name string) {
+}
+func (m *Dummymanager) GetEndpointSecret(namespace string) string {
+	return SystemEndpointSecretName
+}
+func (m *Dummymanager) GetHostInfo(namespace string, client *gophercloud.ServiceClient, host *hosts.Host) (*v1info.HostInfo, error) {
+	return nil, nil
+}
+func (m *Dummymanager) GetSystemInfo(namespace string, client *gophercloud.ServiceClient) (*v1info.SystemInfo, error) {
+	return nil, nil
+}
+func (m *Dummymanager) InvalidateHostInfo(namespace string, hostID string) {
+}
+func (m *Dummymanager) InvalidateInventory(namespace string) {
+}
 func (m *Dummymanager) SetStrategyAppliedSent(namespace string, applied bool) error {
 	return nil
 }
@@ -0,0 +1,114 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	common "github.com/wind-river/cloud-platform-deployment-manager/common"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var logPlan = logf.Log.WithName("plan")
+
+// PlannedChange defines the error returned when a request which modifies the
+// system configuration is withheld because plan mode is enabled.  The request
+// was not sent therefore the reconciler must stop and report the change it
+// intended to make.  Only the first change of each reconciliation is known
+// since the requests that would follow depend on the response of the system
+// to this one.
+type PlannedChange struct {
+	Method     string
+	Resource   string
+	ID         string
+	Attributes []string
+}
+
+func (in PlannedChange) Error() string {
+	target := in.Resource
+	if in.ID != "" {
+		target = fmt.Sprintf("%s/%s", in.Resource, in.ID)
+	}
+
+	if len(in.Attributes) > 0 {
+		target = fmt.Sprintf("%s (%s)", target, strings.Join(in.Attributes, ", "))
+	}
+
+	return fmt.Sprintf("plan mode is enabled; %s %s was not sent to the system", in.Method, target)
+}
+
+// IsPlanModeAnnotated determines whether plan mode has been enabled on an
+// object through the plan annotation.  Any value other than "false" enables
+// plan mode.
+func IsPlanModeAnnotated(obj metav1.Object) bool {
+	value, ok := obj.GetAnnotations()[PlanMode]
+	return ok && !strings.EqualFold(strings.TrimSpace(value), "false")
+}
+
+// PlanModeEnabled determines whether plan mode is enabled for a namespace
+// either for the whole manager or through the plan annotation of the
+// namespace.
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+func (m *PlatformManager) PlanModeEnabled(namespace string) bool {
+	if common.PlanModeEnabled() {
+		return true
+	}
+
+	ns := &v1.Namespace{}
+	err := m.GetClient().Get(context.TODO(), types.NamespacedName{Name: namespace}, ns)
+	if err != nil {
+		// Without the namespace there is no way of knowing whether plan mode
+		// was requested so err on the side of caution.
+		logPlan.Error(err, "failed to read namespace; assuming plan mode", "namespace", namespace)
+		return true
+	}
+
+	return IsPlanModeAnnotated(ns)
+}
+
+// PlanRoundTripper wraps a RoundTripper so that requests which modify the
+// configuration of a system are withheld while plan mode is enabled.  Only
+// requests addressed to the endpoint of the client are withheld so that the
+// client can still authenticate.
+type PlanRoundTripper struct {
+	Rt       http.RoundTripper
+	Endpoint string
+	Enabled  func() bool
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (rt *PlanRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isMutating(req.Method) || !strings.HasPrefix(req.URL.String(), rt.Endpoint) || !rt.Enabled() {
+		return rt.Rt.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		body, _ = io.ReadAll(req.Body)
+		req.Body.Close()
+	}
+
+	resource := apiResource(req)
+	attributes := patchedAttributes(decodeBody(body))
+	sort.Strings(attributes)
+
+	change := PlannedChange{
+		Method:     req.Method,
+		Resource:   resource,
+		ID:         resourceID(req, resource),
+		Attributes: attributes,
+	}
+
+	logPlan.Info("withholding system configuration change", "request", change.Error())
+
+	return nil, change
+}
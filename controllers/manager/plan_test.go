@@ -0,0 +1,85 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Plan mode", func() {
+	enabled := func() bool { return true }
+	disabled := func() bool { return false }
+
+	It("should withhold changes while plan mode is enabled", func() {
+		stub := &recordingRoundTripper{}
+		rt := &PlanRoundTripper{Rt: stub, Endpoint: "http://sysinv/v1/", Enabled: enabled}
+
+		patch := `[{"op": "replace", "path": "/mtu", "value": 9000}, {"op": "replace", "path": "/ifname", "value": "data0"}]`
+		_, err := rt.RoundTrip(httptest.NewRequest("PATCH", "http://sysinv/v1/iinterfaces/1234", strings.NewReader(patch)))
+		Expect(err).To(Equal(PlannedChange{
+			Method:     http.MethodPatch,
+			Resource:   "iinterfaces",
+			ID:         "1234",
+			Attributes: []string{"ifname", "mtu"},
+		}))
+		Expect(err.Error()).To(Equal("plan mode is enabled; PATCH iinterfaces/1234 (ifname, mtu) was not sent to the system"))
+		Expect(stub.requests).To(BeEmpty())
+	})
+
+	It("should send read requests while plan mode is enabled", func() {
+		stub := &recordingRoundTripper{}
+		rt := &PlanRoundTripper{Rt: stub, Endpoint: "http://sysinv/v1/", Enabled: enabled}
+
+		_, err := rt.RoundTrip(httptest.NewRequest("GET", "http://sysinv/v1/ihosts/1234", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stub.requests).To(HaveLen(1))
+	})
+
+	It("should send authentication requests while plan mode is enabled", func() {
+		stub := &recordingRoundTripper{}
+		rt := &PlanRoundTripper{Rt: stub, Endpoint: "http://sysinv/v1/", Enabled: enabled}
+
+		_, err := rt.RoundTrip(httptest.NewRequest("POST", "http://keystone/v3/auth/tokens", strings.NewReader("{}")))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stub.requests).To(HaveLen(1))
+	})
+
+	It("should send changes while plan mode is disabled", func() {
+		stub := &recordingRoundTripper{}
+		rt := &PlanRoundTripper{Rt: stub, Endpoint: "http://sysinv/v1/", Enabled: disabled}
+
+		_, err := rt.RoundTrip(httptest.NewRequest("POST", "http://sysinv/v1/iinterfaces", strings.NewReader(`{"ifname": "data0"}`)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stub.requests).To(HaveLen(1))
+	})
+
+	It("should record withheld changes in the change audit log", func() {
+		stub := &recordingRoundTripper{}
+		plan := &PlanRoundTripper{Rt: stub, Endpoint: "http://sysinv/v1/", Enabled: enabled}
+		rt := &ChangeAuditRoundTripper{Rt: plan, Namespace: "test"}
+
+		_, err := rt.RoundTrip(httptest.NewRequest("DELETE", "http://sysinv/v1/iinterfaces/1234", nil))
+		Expect(err).To(BeAssignableToTypeOf(PlannedChange{}))
+		Expect(stub.requests).To(HaveLen(1))
+		Expect(stub.requests[0].Method).To(Equal(http.MethodGet))
+	})
+
+	It("should honour the plan annotation", func() {
+		ns := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+		Expect(IsPlanModeAnnotated(ns)).To(BeFalse())
+
+		ns.Annotations = map[string]string{PlanMode: "true"}
+		Expect(IsPlanModeAnnotated(ns)).To(BeTrue())
+
+		ns.Annotations[PlanMode] = "False"
+		Expect(IsPlanModeAnnotated(ns)).To(BeFalse())
+	})
+})
@@ -0,0 +1,253 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	perrors "github.com/pkg/errors"
+)
+
+// TokenRefreshMargin defines how long before its expiry a keystone token is
+// replaced.  Requests are never sent with a token which is about to expire
+// so that they are not rejected while in flight.
+const TokenRefreshMargin = 5 * time.Minute
+
+// AuthenticationFailed defines the error returned when keystone rejects the
+// system endpoint credentials.  The client cannot be used until the
+// credentials are corrected therefore it is reported separately from other
+// client errors.
+type AuthenticationFailed struct {
+	BaseError
+}
+
+// NewAuthenticationFailed defines a wrapper to correctly instantiate an
+// authentication failure error.
+func NewAuthenticationFailed(msg string) error {
+	return perrors.WithStack(AuthenticationFailed{BaseError{msg}})
+}
+
+// IsAuthenticationError determines whether an error reports that keystone
+// rejected the credentials or a token of the system endpoint.
+func IsAuthenticationError(in error) bool {
+	return errors.As(in, &AuthenticationFailed{}) ||
+		errors.As(in, &gophercloud.ErrDefault401{}) ||
+		errors.As(in, &gophercloud.ErrUnableToReauthenticate{}) ||
+		errors.As(in, &gophercloud.ErrErrorAfterReauthentication{})
+}
+
+// EndpointSession defines the keystone session shared by all the clients of
+// a namespace.  A single token is used by every client and it is replaced
+// before it expires, or once when it is rejected, regardless of how many
+// clients are in use.
+type EndpointSession struct {
+	lock      sync.Mutex
+	provider  *gophercloud.ProviderClient
+	version   string
+	token     string
+	expiresAt time.Time
+}
+
+// tokenExpiry returns the expiry time of the current token of a provider or
+// the zero time if it cannot be determined.
+func tokenExpiry(provider *gophercloud.ProviderClient) time.Time {
+	result, ok := provider.GetAuthResult().(tokens.CreateResult)
+	if !ok {
+		return time.Time{}
+	}
+
+	token, err := result.ExtractToken()
+	if err != nil {
+		return time.Time{}
+	}
+
+	return token.ExpiresAt
+}
+
+// NewEndpointSession authenticates against the first of the auth options
+// which accepts the credentials.  The version identifies the credentials
+// used so that the session is only shared by clients built from the same
+// credentials.
+func NewEndpointSession(options []gophercloud.AuthOptions, config *tls.Config, version string) (*EndpointSession, error) {
+	var provider *gophercloud.ProviderClient
+	var err error
+
+	rejected := false
+	for _, authOptions := range options {
+		// Force re-authentication on failures.
+		authOptions.AllowReauth = true
+
+	retry:
+		// Authenticate against the openstack API
+		provider, err = NewAuthenticatedClient(authOptions, config)
+		if err != nil {
+			if urlError, ok := err.(*url.Error); ok {
+				if urlError.Err.Error() == "EOF" && strings.Contains(authOptions.IdentityEndpoint, HTTPPrefix) {
+					// The endpoint has been switched to HTTPS mode so automatically
+					// update our endpoint to HTTPS so that we can continue.
+					authOptions.IdentityEndpoint = strings.Replace(authOptions.IdentityEndpoint, HTTPPrefix, HTTPSPrefix, 1)
+					log.Info("retrying authentication request with HTTPS enabled")
+					goto retry
+
+				} else if strings.Contains(err.Error(), HTTPSNotEnabled) && strings.Contains(authOptions.IdentityEndpoint, HTTPSPrefix) {
+					// The endpoint has been switched to HTTP mode so automatically
+					// update our endpoint to HTTP so that we can continue.
+					authOptions.IdentityEndpoint = strings.Replace(authOptions.IdentityEndpoint, HTTPSPrefix, HTTPPrefix, 1)
+					log.Info("retrying authentication request with HTTPS disabled")
+					goto retry
+				}
+			}
+
+			if IsAuthenticationError(err) {
+				rejected = true
+			}
+
+			authOptions.Password = "***REDACTED***" // redact for logging
+			log.Error(err, "failed to authenticate client", "url", authOptions.IdentityEndpoint, "options", authOptions)
+
+		} else {
+			// Use the first successful client
+			break
+		}
+	}
+
+	if provider == nil {
+		if rejected {
+			msg := fmt.Sprintf("system endpoint credentials were rejected: %s", err.Error())
+			return nil, NewAuthenticationFailed(msg)
+		}
+
+		return nil, perrors.Wrap(err, "failed to authenticate against all available auth URL options")
+	}
+
+	provider.UseTokenLock()
+
+	s := EndpointSession{
+		provider:  provider,
+		version:   version,
+		token:     provider.Token(),
+		expiresAt: tokenExpiry(provider),
+	}
+
+	return &s, nil
+}
+
+// Token returns the current token of the session.  The token is replaced
+// first if it expires within the refresh margin.
+func (s *EndpointSession) Token() (string, error) {
+	s.lock.Lock()
+	defer func() { s.lock.Unlock() }()
+
+	if token := s.provider.Token(); token != s.token {
+		// The token was replaced after being rejected by a service.
+		s.token = token
+		s.expiresAt = tokenExpiry(s.provider)
+	}
+
+	if !s.expiresAt.IsZero() && time.Until(s.expiresAt) < TokenRefreshMargin {
+		log.V(2).Info("refreshing token before it expires", "expiresAt", s.expiresAt)
+
+		err := s.provider.Reauthenticate(s.token)
+		if err != nil {
+			msg := fmt.Sprintf("failed to refresh the system endpoint token: %s", err.Error())
+			return "", AuthenticationFailed{BaseError{msg}}
+		}
+
+		s.token = s.provider.Token()
+		s.expiresAt = tokenExpiry(s.provider)
+	}
+
+	return s.token, nil
+}
+
+// ExpiresAt returns the expiry time of the current token of the session.
+func (s *EndpointSession) ExpiresAt() time.Time {
+	s.lock.Lock()
+	defer func() { s.lock.Unlock() }()
+
+	return s.expiresAt
+}
+
+// reauthenticate replaces the token of the session after it was rejected
+// unless it was already replaced since the rejected request was sent.
+func (s *EndpointSession) reauthenticate(previous string) error {
+	s.lock.Lock()
+	defer func() { s.lock.Unlock() }()
+
+	return s.provider.Reauthenticate(previous)
+}
+
+// NewProviderClient returns a provider client which authenticates its
+// requests with the token of the session.  Each service client needs its own
+// provider client because its transport is specific to the service.
+func (s *EndpointSession) NewProviderClient() *gophercloud.ProviderClient {
+	t := s.provider.HTTPClient.Transport
+	if t == nil {
+		t = http.DefaultTransport
+	}
+
+	p := &gophercloud.ProviderClient{
+		IdentityBase:     s.provider.IdentityBase,
+		IdentityEndpoint: s.provider.IdentityEndpoint,
+		EndpointLocator:  s.provider.EndpointLocator,
+		UserAgent:        s.provider.UserAgent,
+	}
+	p.UseTokenLock()
+	p.CopyTokenFrom(s.provider)
+
+	p.ReauthFunc = func() error {
+		err := s.reauthenticate(p.Token())
+		if err != nil {
+			return err
+		}
+
+		p.CopyTokenFrom(s.provider)
+		return nil
+	}
+
+	p.HTTPClient.Transport = &SessionRoundTripper{Rt: t, Session: s, Provider: p}
+
+	return p
+}
+
+// SessionRoundTripper sends each authenticated request with the current
+// token of the session so that a token which is about to expire is replaced
+// before the request is sent rather than after it is rejected.
+type SessionRoundTripper struct {
+	Rt       http.RoundTripper
+	Session  *EndpointSession
+	Provider *gophercloud.ProviderClient
+}
+
+func (rt *SessionRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	current := request.Header.Get("X-Auth-Token")
+	if current == "" {
+		return rt.Rt.RoundTrip(request)
+	}
+
+	token, err := rt.Session.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if token != current {
+		// Keep the token of the provider aligned with the token actually
+		// sent so that a rejection triggers a single re-authentication.
+		rt.Provider.CopyTokenFrom(rt.Session.provider)
+
+		request = request.Clone(request.Context())
+		request.Header.Set("X-Auth-Token", token)
+	}
+
+	return rt.Rt.RoundTrip(request)
+}
@@ -0,0 +1,174 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	perrors "github.com/pkg/errors"
+)
+
+// fakeKeystone issues numbered tokens and serves a single system API
+// resource which only accepts tokens that have not been revoked.
+type fakeKeystone struct {
+	lock     sync.Mutex
+	server   *httptest.Server
+	issued   int
+	lifetime []time.Duration
+	revoked  map[string]bool
+	seen     []string
+}
+
+func newFakeKeystone() *fakeKeystone {
+	k := &fakeKeystone{revoked: make(map[string]bool)}
+	k.server = httptest.NewServer(http.HandlerFunc(k.handle))
+	return k
+}
+
+func (k *fakeKeystone) handle(w http.ResponseWriter, r *http.Request) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	switch r.URL.Path {
+	case "/v3/auth/tokens":
+		body := struct {
+			Auth struct {
+				Identity struct {
+					Password struct {
+						User struct {
+							Password string `json:"password"`
+						} `json:"user"`
+					} `json:"password"`
+				} `json:"identity"`
+			} `json:"auth"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Auth.Identity.Password.User.Password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		lifetime := time.Hour
+		if k.issued < len(k.lifetime) {
+			lifetime = k.lifetime[k.issued]
+		}
+		k.issued++
+
+		w.Header().Set("X-Subject-Token", fmt.Sprintf("token-%d", k.issued))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": {"expires_at": %q, "catalog": [{"type": %q, "name": %q, "endpoints": [{"interface": "public", "region": "RegionOne", "url": "%s/v1"}]}]}}`,
+			time.Now().Add(lifetime).UTC().Format(gophercloud.RFC3339Milli), SystemEndpointType, SystemEndpointName, k.server.URL)
+
+	case "/v1/isystems":
+		token := r.Header.Get("X-Auth-Token")
+		k.seen = append(k.seen, token)
+		if token == "" || k.revoked[token] {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"isystems": []}`)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (k *fakeKeystone) options(password string) []gophercloud.AuthOptions {
+	return []gophercloud.AuthOptions{{
+		IdentityEndpoint: k.server.URL + "/v3/",
+		Username:         "admin",
+		Password:         password,
+		DomainName:       "Default",
+		TenantName:       "admin",
+	}}
+}
+
+// serviceClient returns a system API client which uses the session.
+func serviceClient(session *EndpointSession) *gophercloud.ServiceClient {
+	provider := session.NewProviderClient()
+	url, err := provider.EndpointLocator(gophercloud.EndpointOpts{
+		Name:         SystemEndpointName,
+		Type:         SystemEndpointType,
+		Availability: gophercloud.AvailabilityPublic,
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	return &gophercloud.ServiceClient{ProviderClient: provider, Endpoint: url, ResourceBase: url}
+}
+
+// listSystems sends a single authenticated request to the system API.
+func listSystems(c *gophercloud.ServiceClient) error {
+	_, err := c.Get(c.ServiceURL("isystems"), nil, nil)
+	return err
+}
+
+var _ = Describe("Endpoint session", func() {
+	var keystone *fakeKeystone
+
+	BeforeEach(func() {
+		keystone = newFakeKeystone()
+	})
+
+	AfterEach(func() {
+		keystone.server.Close()
+	})
+
+	It("should report rejected credentials as an authentication failure", func() {
+		_, err := NewEndpointSession(keystone.options("wrong"), nil, "1")
+		Expect(err).To(HaveOccurred())
+		Expect(perrors.Cause(err)).To(BeAssignableToTypeOf(AuthenticationFailed{}))
+		Expect(IsAuthenticationError(perrors.Wrap(err, "failed to build client"))).To(BeTrue())
+	})
+
+	It("should track the lifetime of the token", func() {
+		session, err := NewEndpointSession(keystone.options("secret"), nil, "1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(session.ExpiresAt()).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+	})
+
+	It("should replace a token before it expires", func() {
+		keystone.lifetime = []time.Duration{time.Minute}
+
+		session, err := NewEndpointSession(keystone.options("secret"), nil, "1")
+		Expect(err).ToNot(HaveOccurred())
+
+		c := serviceClient(session)
+		Expect(listSystems(c)).To(Succeed())
+		Expect(listSystems(c)).To(Succeed())
+
+		Expect(keystone.issued).To(Equal(2))
+		Expect(keystone.seen).To(Equal([]string{"token-2", "token-2"}))
+		Expect(c.ProviderClient.Token()).To(Equal("token-2"))
+	})
+
+	It("should re-authenticate once for all clients of the session", func() {
+		session, err := NewEndpointSession(keystone.options("secret"), nil, "1")
+		Expect(err).ToNot(HaveOccurred())
+
+		first := serviceClient(session)
+		second := serviceClient(session)
+		Expect(listSystems(first)).To(Succeed())
+
+		keystone.lock.Lock()
+		keystone.revoked["token-1"] = true
+		keystone.lock.Unlock()
+
+		Expect(listSystems(first)).To(Succeed())
+		Expect(listSystems(second)).To(Succeed())
+
+		Expect(keystone.issued).To(Equal(2))
+		Expect(keystone.seen).To(Equal([]string{"token-1", "token-1", "token-2", "token-2"}))
+	})
+})
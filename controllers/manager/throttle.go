@@ -0,0 +1,227 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// DefaultAPIMaxWait defines the longest amount of time a request waits for
+// the rate limiter before it is rejected.  Waiting longer would hold a
+// reconciler worker that could otherwise be used for another system.
+const DefaultAPIMaxWait = 30 * time.Second
+
+// Defines the reasons for which a system API request is rejected.
+const (
+	RejectCircuitOpen = "circuit_open"
+	RejectRateLimited = "rate_limited"
+)
+
+var (
+	apiRejectedRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "deployment_manager_api_rejected_requests_total",
+			Help: "Number of system API requests rejected before being sent.",
+		},
+		[]string{"namespace", "reason"},
+	)
+
+	apiCircuitOpen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "deployment_manager_api_circuit_open",
+			Help: "Whether the system API circuit breaker is open (1) or closed (0).",
+		},
+		[]string{"namespace"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(apiRejectedRequests, apiCircuitOpen)
+}
+
+// APIThrottled defines the error returned when a system API request is
+// rejected by the client-side rate limiter or circuit breaker.  The request
+// was not sent therefore it is safe to retry after the specified delay.
+type APIThrottled struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (in APIThrottled) Error() string {
+	return fmt.Sprintf("system API request rejected (%s); retry after %s", in.Reason, in.RetryAfter)
+}
+
+// APIThrottle limits the rate of the system API requests of a namespace and
+// stops sending requests for a cooldown period once the API has failed
+// repeatedly.  A single instance is shared by every client of a namespace so
+// that all reconcilers back off together.
+type APIThrottle struct {
+	namespace string
+	limiter   *rate.Limiter
+	maxWait   time.Duration
+	threshold int
+	cooldown  time.Duration
+
+	lock      sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// NewAPIThrottle returns a new throttle for a namespace.  A rate limit of 0
+// disables the rate limiter but not the circuit breaker.
+func NewAPIThrottle(namespace string, limit float64, burst int, threshold int, cooldown time.Duration) *APIThrottle {
+	t := &APIThrottle{
+		namespace: namespace,
+		maxWait:   DefaultAPIMaxWait,
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+
+	if limit > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(limit), burst)
+	}
+
+	apiCircuitOpen.WithLabelValues(namespace).Set(0)
+
+	return t
+}
+
+// reject records a rejected request and returns the matching error.
+func (t *APIThrottle) reject(reason string, retryAfter time.Duration) error {
+	apiRejectedRequests.WithLabelValues(t.namespace, reason).Inc()
+	return APIThrottled{Reason: reason, RetryAfter: retryAfter}
+}
+
+// allow determines whether the circuit breaker lets a request through.  Once
+// the cooldown has elapsed a single request is let through to probe the API;
+// the other requests are rejected until the outcome of that request is known.
+func (t *APIThrottle) allow(now time.Time) (bool, time.Duration) {
+	t.lock.Lock()
+	defer func() { t.lock.Unlock() }()
+
+	if t.failures < t.threshold {
+		return true, 0
+	}
+
+	if now.Before(t.openUntil) {
+		return false, t.openUntil.Sub(now)
+	}
+
+	if t.probing {
+		return false, t.cooldown
+	}
+
+	t.probing = true
+
+	return true, 0
+}
+
+// record updates the circuit breaker with the outcome of a request.
+func (t *APIThrottle) record(failed bool, now time.Time) {
+	t.lock.Lock()
+	defer func() { t.lock.Unlock() }()
+
+	t.probing = false
+
+	if !failed {
+		if t.failures >= t.threshold {
+			log.Info("system API circuit breaker closed", "namespace", t.namespace)
+			apiCircuitOpen.WithLabelValues(t.namespace).Set(0)
+		}
+		t.failures = 0
+		return
+	}
+
+	t.failures++
+	if t.failures >= t.threshold {
+		if t.failures == t.threshold {
+			log.Info("system API circuit breaker opened", "namespace", t.namespace,
+				"failures", t.failures, "cooldown", t.cooldown)
+			apiCircuitOpen.WithLabelValues(t.namespace).Set(1)
+		}
+		t.openUntil = now.Add(t.cooldown)
+	}
+}
+
+// wait blocks until the rate limiter lets a request through.  The request is
+// rejected instead if that would take longer than the maximum wait time or if
+// the request is cancelled in the meantime.
+func (t *APIThrottle) wait(req *http.Request) error {
+	if t.limiter == nil {
+		return nil
+	}
+
+	r := t.limiter.Reserve()
+	delay := r.Delay()
+	if !r.OK() || delay > t.maxWait {
+		r.Cancel()
+		return t.reject(RejectRateLimited, t.maxWait)
+	}
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		r.Cancel()
+		return req.Context().Err()
+	}
+}
+
+// isAPIFailure determines whether the outcome of a request indicates that
+// the API is unhealthy, as opposed to the request itself being invalid.
+func isAPIFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		var netErr net.Error
+		return errors.As(err, &netErr) && netErr.Timeout()
+	}
+
+	return resp.StatusCode >= http.StatusInternalServerError
+}
+
+// ThrottledRoundTripper wraps a RoundTripper so that every request is subject
+// to the rate limiter and circuit breaker of a throttle.
+type ThrottledRoundTripper struct {
+	Rt       http.RoundTripper
+	Throttle *APIThrottle
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (rt *ThrottledRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	t := rt.Throttle
+
+	if ok, retryAfter := t.allow(time.Now()); !ok {
+		return nil, t.reject(RejectCircuitOpen, retryAfter)
+	}
+
+	if err := t.wait(req); err != nil {
+		// The request was never sent so it does not count toward the circuit
+		// breaker, but a pending probe must be released.
+		t.lock.Lock()
+		t.probing = false
+		t.lock.Unlock()
+		return nil, err
+	}
+
+	resp, err := rt.Rt.RoundTrip(req)
+	t.record(isAPIFailure(resp, err), time.Now())
+
+	return resp, err
+}
@@ -0,0 +1,80 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type stubRoundTripper struct {
+	status int
+	calls  int
+}
+
+func (s *stubRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.calls++
+	return &http.Response{StatusCode: s.status, Body: http.NoBody, Request: req}, nil
+}
+
+var _ = Describe("API throttle", func() {
+	Describe("circuit breaker", func() {
+		It("should reject requests once the failure threshold is reached", func() {
+			stub := &stubRoundTripper{status: http.StatusServiceUnavailable}
+			rt := &ThrottledRoundTripper{Rt: stub, Throttle: NewAPIThrottle("test", 0, 1, 2, time.Hour)}
+			req := httptest.NewRequest("GET", "http://sysinv/v1/ihosts", nil)
+
+			for i := 0; i < 2; i++ {
+				_, err := rt.RoundTrip(req)
+				Expect(err).To(BeNil())
+			}
+
+			_, err := rt.RoundTrip(req)
+			var throttled APIThrottled
+			Expect(errors.As(err, &throttled)).To(BeTrue())
+			Expect(throttled.Reason).To(Equal(RejectCircuitOpen))
+			Expect(stub.calls).To(Equal(2))
+		})
+
+		It("should let a single probe through after the cooldown", func() {
+			t := NewAPIThrottle("test", 0, 1, 1, time.Minute)
+			now := time.Now()
+
+			t.record(true, now)
+			ok, _ := t.allow(now.Add(time.Second))
+			Expect(ok).To(BeFalse())
+
+			ok, _ = t.allow(now.Add(2 * time.Minute))
+			Expect(ok).To(BeTrue())
+			ok, _ = t.allow(now.Add(2 * time.Minute))
+			Expect(ok).To(BeFalse())
+
+			t.record(false, now.Add(2*time.Minute))
+			ok, _ = t.allow(now.Add(2 * time.Minute))
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	Describe("rate limiter", func() {
+		It("should reject requests that would wait too long", func() {
+			stub := &stubRoundTripper{status: http.StatusOK}
+			t := NewAPIThrottle("test", 0.001, 1, 5, time.Minute)
+			t.maxWait = time.Millisecond
+			rt := &ThrottledRoundTripper{Rt: stub, Throttle: t}
+			req := httptest.NewRequest("GET", "http://sysinv/v1/ihosts", nil)
+
+			_, err := rt.RoundTrip(req)
+			Expect(err).To(BeNil())
+
+			_, err = rt.RoundTrip(req)
+			Expect(err).To(Equal(APIThrottled{Reason: RejectRateLimited, RetryAfter: time.Millisecond}))
+			Expect(stub.calls).To(Equal(1))
+		})
+	})
+})
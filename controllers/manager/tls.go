@@ -0,0 +1,129 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	perrors "github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// Optional endpoint secret keys which reference the secrets used to
+	// secure the connection to the system endpoint.  The CA secret holds
+	// the bundle of certificate authorities trusted in addition to the
+	// system roots and the client certificate secret holds the certificate
+	// and key presented for mutual TLS authentication.
+	CACertSecretKey     = "OS_CACERT_SECRET"
+	ClientCertSecretKey = "OS_CERT_SECRET"
+
+	// Expected keys of the referenced secrets.
+	CACertKey     = "ca.crt"
+	ClientCertKey = v1.TLSCertKey
+	ClientKeyKey  = v1.TLSPrivateKeyKey
+)
+
+// EndpointTLS defines the TLS configuration used to access the system
+// endpoint along with a version which changes whenever any of the
+// referenced secrets change.
+type EndpointTLS struct {
+	Config  *tls.Config
+	Version string
+}
+
+// GetEndpointTLS builds the TLS configuration referenced by the endpoint
+// credentials of a namespace.  A nil configuration is returned when the
+// credentials do not reference any CA or client certificate secret so that
+// the default transport is used.
+func GetEndpointTLS(cl client.Client, namespace string, data map[string][]byte) (*EndpointTLS, error) {
+	caName := string(data[CACertSecretKey])
+	certName := string(data[ClientCertSecretKey])
+
+	if caName == "" && certName == "" {
+		return &EndpointTLS{}, nil
+	}
+
+	versions := make([]string, 0, 2)
+	config := tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caName != "" {
+		secret := &v1.Secret{}
+		err := cl.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: caName}, secret)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to find endpoint CA secret %s", caName)
+			return nil, err
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(secret.Data[CACertKey]) {
+			msg := fmt.Sprintf("endpoint CA secret %s does not contain any PEM certificate under %s", caName, CACertKey)
+			return nil, NewClientError(msg)
+		}
+
+		config.RootCAs = pool
+		versions = append(versions, "ca:"+secret.ResourceVersion)
+	}
+
+	if certName != "" {
+		secret := &v1.Secret{}
+		err := cl.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: certName}, secret)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to find endpoint client certificate secret %s", certName)
+			return nil, err
+		}
+
+		cert, err := tls.X509KeyPair(secret.Data[ClientCertKey], secret.Data[ClientKeyKey])
+		if err != nil {
+			msg := fmt.Sprintf("endpoint client certificate secret %s is invalid: %s", certName, err.Error())
+			return nil, NewClientError(msg)
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+		versions = append(versions, "cert:"+secret.ResourceVersion)
+	}
+
+	result := EndpointTLS{
+		Config:  &config,
+		Version: strings.Join(versions, ","),
+	}
+
+	return &result, nil
+}
+
+// NewAuthenticatedClient authenticates against the identity endpoint of the
+// auth options using the TLS configuration of the system endpoint.  A nil
+// configuration behaves like openstack.AuthenticatedClient.
+func NewAuthenticatedClient(options gophercloud.AuthOptions, config *tls.Config) (*gophercloud.ProviderClient, error) {
+	provider, err := openstack.NewClient(options.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if config != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = config.Clone()
+		provider.HTTPClient.Transport = transport
+	}
+
+	err = openstack.Authenticate(provider, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
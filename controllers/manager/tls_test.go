@@ -0,0 +1,120 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newClientCertificate returns a PEM encoded self-signed certificate and key.
+func newClientCertificate() ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	Expect(err).ToNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+var _ = Describe("Endpoint TLS", func() {
+	var server *httptest.Server
+	var cl client.Client
+
+	BeforeEach(func() {
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		cert, key := newClientCertificate()
+		ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+		scheme := runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		cl = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "endpoint-ca", Namespace: "deployment"},
+				Data:       map[string][]byte{CACertKey: ca},
+			},
+			&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "endpoint-client", Namespace: "deployment"},
+				Type:       v1.SecretTypeTLS,
+				Data:       map[string][]byte{ClientCertKey: cert, ClientKeyKey: key},
+			},
+			&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "endpoint-invalid", Namespace: "deployment"},
+				Data:       map[string][]byte{CACertKey: []byte("not a certificate")},
+			},
+		).Build()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should use the default transport when no secret is referenced", func() {
+		result, err := GetEndpointTLS(cl, "deployment", map[string][]byte{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Config).To(BeNil())
+		Expect(result.Version).To(BeEmpty())
+	})
+
+	It("should trust the CA bundle and present the client certificate", func() {
+		data := map[string][]byte{
+			CACertSecretKey:     []byte("endpoint-ca"),
+			ClientCertSecretKey: []byte("endpoint-client"),
+		}
+
+		result, err := GetEndpointTLS(cl, "deployment", data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Config).ToNot(BeNil())
+		Expect(result.Config.Certificates).To(HaveLen(1))
+		Expect(result.Version).To(MatchRegexp(`^ca:\d+,cert:\d+$`))
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = result.Config
+		response, err := (&http.Client{Transport: transport}).Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("should reject a CA secret without any certificate", func() {
+		data := map[string][]byte{CACertSecretKey: []byte("endpoint-invalid")}
+		_, err := GetEndpointTLS(cl, "deployment", data)
+		Expect(err).To(MatchError(ContainSubstring("does not contain any PEM certificate")))
+	})
+
+	It("should report a missing secret", func() {
+		data := map[string][]byte{ClientCertSecretKey: []byte("endpoint-missing")}
+		_, err := GetEndpointTLS(cl, "deployment", data)
+		Expect(err).To(MatchError(ContainSubstring("failed to find endpoint client certificate secret")))
+	})
+})
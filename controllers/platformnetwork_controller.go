@@ -7,12 +7,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresspools"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/interfaceNetworks"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/networks"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/oamNetworks"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/system"
@@ -23,6 +25,8 @@ import (
 	hostController "github.com/wind-river/cloud-platform-deployment-manager/controllers/host"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
@@ -43,6 +47,18 @@ const (
 	AllocationTypeDynamic = "dynamic"
 )
 
+// Defines the reasons reported with the OAMUpdateInProgress condition.
+const (
+	OAMUpdateReasonApplying  = "Applying"
+	OAMUpdateReasonCompleted = "Completed"
+	OAMUpdateReasonFailed    = "Failed"
+)
+
+// Defines the reasons reported with the DeletionBlocked condition.
+const (
+	DeletionReasonInUse = "InUse"
+)
+
 var _ reconcile.Reconciler = &PlatformNetworkReconciler{}
 
 // PlatformNetworkReconciler reconciles a PlatformNetwork object
@@ -69,23 +85,43 @@ func makeRangeArray(ranges []starlingxv1.AllocationRange) [][]string {
 	return result
 }
 
-// compareRangeArrays compares two range arrays and returns true if they are
-// equal.
-func compareRangeArrays(x, y [][]string) bool {
-	if len(x) != len(y) {
-		return false
+// addressesEqual compares two IP addresses irrespective of how they are
+// formatted so that equivalent IPv6 representations are not reported as a
+// difference.  Values that are not addresses are compared as strings.
+func addressesEqual(x, y string) bool {
+	a, b := net.ParseIP(x), net.ParseIP(y)
+	if a == nil || b == nil {
+		return strings.EqualFold(x, y)
 	}
 
-	count := 0
+	return a.Equal(b)
+}
+
+// rangeArrayDelta returns the ranges of the first range array which are not
+// present in the second range array.
+func rangeArrayDelta(x, y [][]string) [][]string {
+	result := make([][]string, 0)
 	for _, o := range x {
+		found := false
 		for _, i := range y {
-			if strings.EqualFold(o[0], i[0]) && strings.EqualFold(o[1], i[1]) {
-				count++
+			if addressesEqual(o[0], i[0]) && addressesEqual(o[1], i[1]) {
+				found = true
+				break
 			}
 		}
+
+		if !found {
+			result = append(result, o)
+		}
 	}
 
-	return len(x) == count
+	return result
+}
+
+// compareRangeArrays compares two range arrays and returns true if they are
+// equal.  The order of the ranges is not significant.
+func compareRangeArrays(x, y [][]string) bool {
+	return len(x) == len(y) && len(rangeArrayDelta(x, y)) == 0 && len(rangeArrayDelta(y, x)) == 0
 }
 
 // oamUpdateRequired determines whether a oam network resource must
@@ -93,12 +129,14 @@ func compareRangeArrays(x, y [][]string) bool {
 // include in the request options to minimum churn and to ease debugging.
 func oamUpdateRequired(instance *starlingxv1.PlatformNetwork, p *oamNetworks.OAMNetwork, r *PlatformNetworkReconciler) (opts oamNetworks.OAMNetworkOpts, result bool) {
 	var delta strings.Builder
+	var attributes []starlingxv1.DeltaAttribute
 
 	spec := instance.Spec
 	instance_subnet := fmt.Sprintf("%s/%d", spec.Subnet, spec.Prefix)
 	if instance_subnet != p.OAMSubnet {
 		opts.OAMSubnet = &instance_subnet
 		delta.WriteString(fmt.Sprintf("\t+Subnet: %s\n", *opts.OAMSubnet))
+		attributes = common.AppendDeltaAttribute(attributes, "subnet", instance_subnet, p.OAMSubnet)
 		result = true
 	}
 
@@ -109,6 +147,7 @@ func oamUpdateRequired(instance *starlingxv1.PlatformNetwork, p *oamNetworks.OAM
 		if spec.Gateway != nil && (p.OAMGatewayIP == nil || !strings.EqualFold(*spec.Gateway, *p.OAMGatewayIP)) {
 			opts.OAMGatewayIP = spec.Gateway
 			delta.WriteString(fmt.Sprintf("\t+Gateway: %s\n", *opts.OAMGatewayIP))
+			attributes = common.AppendDeltaAttribute(attributes, "gateway", spec.Gateway, p.OAMGatewayIP)
 			result = true
 		}
 	}
@@ -116,18 +155,21 @@ func oamUpdateRequired(instance *starlingxv1.PlatformNetwork, p *oamNetworks.OAM
 	if spec.FloatingAddress != "" && spec.FloatingAddress != p.OAMFloatingIP {
 		opts.OAMFloatingIP = &spec.FloatingAddress
 		delta.WriteString(fmt.Sprintf("\t+Floating Address: %s\n", *opts.OAMFloatingIP))
+		attributes = common.AppendDeltaAttribute(attributes, "floatingAddress", spec.FloatingAddress, p.OAMFloatingIP)
 		result = true
 	}
 
 	if spec.Controller0Address != "" && spec.Controller0Address != p.OAMC0IP {
 		opts.OAMC0IP = &spec.Controller0Address
 		delta.WriteString(fmt.Sprintf("\t+Controller0 Address: %s\n", *opts.OAMC0IP))
+		attributes = common.AppendDeltaAttribute(attributes, "controller0Address", spec.Controller0Address, p.OAMC0IP)
 		result = true
 	}
 
 	if spec.Controller1Address != "" && spec.Controller1Address != p.OAMC1IP {
 		opts.OAMC1IP = &spec.Controller1Address
 		delta.WriteString(fmt.Sprintf("\t+Controller1 Address: %s\n", *opts.OAMC1IP))
+		attributes = common.AppendDeltaAttribute(attributes, "controller1Address", spec.Controller1Address, p.OAMC1IP)
 		result = true
 	}
 
@@ -139,6 +181,7 @@ func oamUpdateRequired(instance *starlingxv1.PlatformNetwork, p *oamNetworks.OAM
 			opts.OAMEndIP = &ranges[0][1]
 			delta.WriteString(fmt.Sprintf("\t+Start IP: %s\n", *opts.OAMStartIP))
 			delta.WriteString(fmt.Sprintf("\t+End IP: %s\n", *opts.OAMEndIP))
+			attributes = common.AppendDeltaAttribute(attributes, "allocation.ranges", ranges, tempRange)
 			result = true
 		}
 	}
@@ -149,6 +192,7 @@ func oamUpdateRequired(instance *starlingxv1.PlatformNetwork, p *oamNetworks.OAM
 	}
 
 	instance.Status.Delta = deltaString
+	instance.Status.DeltaAttributes = attributes
 	err := r.Client.Status().Update(context.TODO(), instance)
 	if err != nil {
 		logPlatformNetwork.Info(fmt.Sprintf("failed to update oam status:  %s\n", err))
@@ -161,6 +205,7 @@ func oamUpdateRequired(instance *starlingxv1.PlatformNetwork, p *oamNetworks.OAM
 // include in the request options to minimum churn and to ease debugging.
 func poolUpdateRequired(instance *starlingxv1.PlatformNetwork, p *addresspools.AddressPool, r *PlatformNetworkReconciler) (opts addresspools.AddressPoolOpts, result bool) {
 	var delta strings.Builder
+	var attributes []starlingxv1.DeltaAttribute
 	// The address pool name for network type mgmt has to be 'management'
 	// and cannot be anything else. GetAddrPoolNameByNetworkType ensures
 	// pool name is as per the requirement. This is the limitation on sysinv.
@@ -168,37 +213,43 @@ func poolUpdateRequired(instance *starlingxv1.PlatformNetwork, p *addresspools.A
 	if p.Name != poolName {
 		opts.Name = &poolName
 		delta.WriteString(fmt.Sprintf("\t+Name: %s\n", *opts.Name))
+		attributes = common.AppendDeltaAttribute(attributes, "name", poolName, p.Name)
 		result = true
 	}
 
 	spec := instance.Spec
-	if !strings.EqualFold(spec.Subnet, p.Network) {
+	if !addressesEqual(spec.Subnet, p.Network) {
 		opts.Network = &spec.Subnet
 		delta.WriteString(fmt.Sprintf("\t+Network: %s\n", *opts.Network))
+		attributes = common.AppendDeltaAttribute(attributes, "subnet", spec.Subnet, p.Network)
 		result = true
 	}
 
 	if spec.Prefix != p.Prefix {
 		opts.Prefix = &spec.Prefix
 		delta.WriteString(fmt.Sprintf("\t+Prefix: %d\n", *opts.Prefix))
+		attributes = common.AppendDeltaAttribute(attributes, "prefix", spec.Prefix, p.Prefix)
 		result = true
 	}
 
-	if spec.FloatingAddress != "" && spec.FloatingAddress != p.FloatingAddress {
+	if spec.FloatingAddress != "" && !addressesEqual(spec.FloatingAddress, p.FloatingAddress) {
 		opts.FloatingAddress = &spec.FloatingAddress
 		delta.WriteString(fmt.Sprintf("\t+Floating Address: %s\n", *opts.FloatingAddress))
+		attributes = common.AppendDeltaAttribute(attributes, "floatingAddress", spec.FloatingAddress, p.FloatingAddress)
 		result = true
 	}
 
-	if spec.Controller0Address != "" && spec.Controller0Address != p.Controller0Address {
+	if spec.Controller0Address != "" && !addressesEqual(spec.Controller0Address, p.Controller0Address) {
 		opts.Controller0Address = &spec.Controller0Address
 		delta.WriteString(fmt.Sprintf("\t+Controller0 Address: %s\n", *opts.Controller0Address))
+		attributes = common.AppendDeltaAttribute(attributes, "controller0Address", spec.Controller0Address, p.Controller0Address)
 		result = true
 	}
 
-	if spec.Controller1Address != "" && spec.Controller1Address != p.Controller1Address {
+	if spec.Controller1Address != "" && !addressesEqual(spec.Controller1Address, p.Controller1Address) {
 		opts.Controller1Address = &spec.Controller1Address
 		delta.WriteString(fmt.Sprintf("\t+Controller1 Address: %s\n", *opts.Controller1Address))
+		attributes = common.AppendDeltaAttribute(attributes, "controller1Address", spec.Controller1Address, p.Controller1Address)
 		result = true
 	}
 
@@ -206,9 +257,10 @@ func poolUpdateRequired(instance *starlingxv1.PlatformNetwork, p *addresspools.A
 		// TODO(alegacy): There is a sysinv bug in how the gateway address
 		//  gets registered in the database.  It doesn't have a "name" and
 		//  so causes an exception when a related route is added.
-		if spec.Gateway != nil && (p.Gateway == nil || !strings.EqualFold(*spec.Gateway, *p.Gateway)) {
+		if spec.Gateway != nil && (p.Gateway == nil || !addressesEqual(*spec.Gateway, *p.Gateway)) {
 			opts.Gateway = spec.Gateway
 			delta.WriteString(fmt.Sprintf("\t+Gateway: %s\n", *opts.Gateway))
+			attributes = common.AppendDeltaAttribute(attributes, "gateway", spec.Gateway, p.Gateway)
 			result = true
 		}
 	}
@@ -216,14 +268,23 @@ func poolUpdateRequired(instance *starlingxv1.PlatformNetwork, p *addresspools.A
 	if spec.Allocation.Order != nil && *spec.Allocation.Order != p.Order {
 		opts.Order = spec.Allocation.Order
 		delta.WriteString(fmt.Sprintf("\t+Order: %s\n", *opts.Order))
+		attributes = common.AppendDeltaAttribute(attributes, "allocation.order", spec.Allocation.Order, p.Order)
 		result = true
 	}
 
 	if len(spec.Allocation.Ranges) > 0 {
 		ranges := makeRangeArray(spec.Allocation.Ranges)
 		if !compareRangeArrays(ranges, p.Ranges) {
+			// The full set of ranges is always sent while only the ranges
+			// that differ from the live pool are reported in the delta.
 			opts.Ranges = &ranges
-			delta.WriteString(fmt.Sprintf("\t+Ranges: %s\n", *opts.Ranges))
+			attributes = common.AppendDeltaAttribute(attributes, "allocation.ranges", ranges, p.Ranges)
+			if added := rangeArrayDelta(ranges, p.Ranges); len(added) > 0 {
+				delta.WriteString(fmt.Sprintf("\t+Ranges: %s\n", added))
+			}
+			if removed := rangeArrayDelta(p.Ranges, ranges); len(removed) > 0 {
+				delta.WriteString(fmt.Sprintf("\t-Ranges: %s\n", removed))
+			}
 			result = true
 		}
 	}
@@ -234,6 +295,7 @@ func poolUpdateRequired(instance *starlingxv1.PlatformNetwork, p *addresspools.A
 	}
 
 	instance.Status.Delta = deltaString
+	instance.Status.DeltaAttributes = attributes
 	err := r.Client.Status().Update(context.TODO(), instance)
 	if err != nil {
 		logPlatformNetwork.Info(fmt.Sprintf("failed to update status:  %s\n", err))
@@ -314,10 +376,117 @@ func (r *PlatformNetworkReconciler) ReconcileNewAddressPool(client *gophercloud.
 	return pool, nil
 }
 
+// oamConfigPendingHosts returns the names of the unlocked controllers which
+// have not yet applied the latest configuration of the system.
+func oamConfigPendingHosts(objects []hosts.Host) []string {
+	result := make([]string, 0)
+	for _, h := range objects {
+		if h.Personality != hosts.PersonalityController || h.AdministrativeState != hosts.AdminUnlocked {
+			continue
+		}
+
+		if h.ConfigurationStatus != "" {
+			result = append(result, h.Hostname)
+		}
+	}
+
+	return result
+}
+
+// oamUpdateState returns the reason of the OAMUpdateInProgress condition if
+// it applies to the current generation of the resource.
+func oamUpdateState(instance *starlingxv1.PlatformNetwork) string {
+	condition := meta.FindStatusCondition(instance.Status.Conditions, starlingxv1.ConditionOAMUpdateInProgress)
+	if condition == nil {
+		return ""
+	}
+
+	if condition.Status == metav1.ConditionTrue {
+		return condition.Reason
+	} else if condition.ObservedGeneration == instance.Generation {
+		return condition.Reason
+	}
+
+	return ""
+}
+
+// setCondition updates a condition on the platform network status.
+func (r *PlatformNetworkReconciler) setCondition(instance *starlingxv1.PlatformNetwork, conditionType string, status metav1.ConditionStatus, reason, message string) error {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: instance.Generation,
+	})
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
+// ReconcileOAMUpdateProgress is responsible for tracking an OAM network update
+// that has already been applied to the system.  The update is only confirmed
+// once every unlocked controller has applied the new configuration and the
+// system reports the requested values.
+func (r *PlatformNetworkReconciler) ReconcileOAMUpdateProgress(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformNetwork, oam *oamNetworks.OAMNetwork) error {
+	objects, err := hosts.ListHosts(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return err
+	}
+
+	if pending := oamConfigPendingHosts(objects); len(pending) > 0 {
+		msg := fmt.Sprintf("waiting for %s to apply the oam network configuration",
+			strings.Join(pending, ", "))
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	if _, ok := oamUpdateRequired(instance, oam, r); ok {
+		msg := "oam network configuration does not match the requested values after it was applied"
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"%s; update the resource to retry", msg)
+
+		err = r.setCondition(instance, starlingxv1.ConditionOAMUpdateInProgress, metav1.ConditionFalse, OAMUpdateReasonFailed, msg)
+		if err != nil {
+			return err
+		}
+
+		return common.NewUserDataError(msg)
+	}
+
+	err = r.setCondition(instance, starlingxv1.ConditionOAMUpdateInProgress, metav1.ConditionFalse,
+		OAMUpdateReasonCompleted, "oam network configuration has been applied")
+	if err != nil {
+		return err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"oam network configuration has been applied on all controllers")
+
+	return nil
+}
+
 // ReconcileUpdated is a method which handles reconciling an existing data
 // resource and updates the corresponding system resource thru the system API to
-// match the desired state of the resource.
+// match the desired state of the resource.  Changing the oam network affects
+// every connection to the system through its previous addresses therefore the
+// update is tracked until all controllers have applied it.
 func (r *PlatformNetworkReconciler) ReconcileUpdatedOAMNetwork(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformNetwork, oam *oamNetworks.OAMNetwork) error {
+	switch oamUpdateState(instance) {
+	case OAMUpdateReasonApplying:
+		return r.ReconcileOAMUpdateProgress(client, instance, oam)
+	case OAMUpdateReasonFailed:
+		// Do not keep re-applying a configuration that the system did not
+		// accept until the resource is updated.
+		return common.NewUserDataError("oam network update failed; update the resource to retry")
+	}
+
 	if opts, ok := oamUpdateRequired(instance, oam, r); ok {
 		if instance.Status.Reconciled && r.StopAfterInSync() {
 			// Do not process any further changes once we have reached a
@@ -337,6 +506,13 @@ func (r *PlatformNetworkReconciler) ReconcileUpdatedOAMNetwork(client *gopherclo
 			return nil
 		}
 
+		if opts.OAMSubnet != nil || opts.OAMFloatingIP != nil || opts.OAMC0IP != nil || opts.OAMC1IP != nil {
+			r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+				"updating the oam network (floating address %s to %s); connections to the system through "+
+					"the previous oam addresses will be lost and the system endpoint must be updated if it "+
+					"refers to them", oam.OAMFloatingIP, instance.Spec.FloatingAddress)
+		}
+
 		// Update existing oam network
 		logPlatformNetwork.Info("updating oam network", "uuid", oam.UUID, "opts", opts)
 
@@ -351,6 +527,13 @@ func (r *PlatformNetworkReconciler) ReconcileUpdatedOAMNetwork(client *gopherclo
 		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
 			"oam network has been updated")
 
+		err = r.setCondition(instance, starlingxv1.ConditionOAMUpdateInProgress, metav1.ConditionTrue,
+			OAMUpdateReasonApplying, "waiting for the controllers to apply the oam network configuration")
+		if err != nil {
+			return err
+		}
+
+		return common.NewResourceStatusDependency("waiting for the controllers to apply the oam network configuration")
 	}
 
 	return nil
@@ -662,6 +845,149 @@ func (r *PlatformNetworkReconciler) ReconciledDeletedNetwork(client *gophercloud
 	return nil
 }
 
+// interfacesReferenceNetwork determines whether any interface of a host
+// profile is associated to a platform network.
+func interfacesReferenceNetwork(profile *starlingxv1.HostProfileSpec, name string) bool {
+	if profile.Interfaces == nil {
+		return false
+	}
+
+	list := make([]starlingxv1.CommonInterfaceInfo, 0)
+	for _, e := range profile.Interfaces.Ethernet {
+		list = append(list, e.CommonInterfaceInfo)
+	}
+	for _, v := range profile.Interfaces.VLAN {
+		list = append(list, v.CommonInterfaceInfo)
+	}
+	for _, b := range profile.Interfaces.Bond {
+		list = append(list, b.CommonInterfaceInfo)
+	}
+	for _, v := range profile.Interfaces.VF {
+		list = append(list, v.CommonInterfaceInfo)
+	}
+
+	for _, c := range list {
+		if c.PlatformNetworks == nil {
+			continue
+		}
+
+		for _, n := range *c.PlatformNetworks {
+			if string(n) == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// profilesReferencingNetwork returns the names of the host profiles which
+// still associate an interface to a platform network.
+func (r *PlatformNetworkReconciler) profilesReferencingNetwork(instance *starlingxv1.PlatformNetwork) ([]string, error) {
+	list := &starlingxv1.HostProfileList{}
+	err := r.Client.List(context.TODO(), list, client.InNamespace(instance.Namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list host profiles")
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	for i := range list.Items {
+		if interfacesReferenceNetwork(&list.Items[i].Spec, instance.Name) {
+			result = append(result, list.Items[i].Name)
+		}
+	}
+
+	return result, nil
+}
+
+// blockDeletion records why the deletion of a platform network cannot
+// proceed and returns an error so that it is retried later.
+func (r *PlatformNetworkReconciler) blockDeletion(instance *starlingxv1.PlatformNetwork, msg string) error {
+	r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency, msg)
+
+	err := r.setCondition(instance, starlingxv1.ConditionDeletionBlocked, metav1.ConditionTrue,
+		DeletionReasonInUse, msg)
+	if err != nil {
+		return err
+	}
+
+	return common.NewResourceStatusDependency(msg)
+}
+
+// ReleaseNetworkInterfaces is responsible for removing the interface
+// associations that refer to a platform network before it is deleted.  The
+// deletion is blocked while a host profile still refers to the network or
+// while an unlocked host still uses it since its associations can only be
+// removed while the host is locked.
+func (r *PlatformNetworkReconciler) ReleaseNetworkInterfaces(gcClient *gophercloud.ServiceClient, instance *starlingxv1.PlatformNetwork) error {
+	profiles, err := r.profilesReferencingNetwork(instance)
+	if err != nil {
+		return err
+	}
+
+	if len(profiles) > 0 {
+		msg := fmt.Sprintf("network is still referenced by host profiles: %s",
+			strings.Join(profiles, ", "))
+		return r.blockDeletion(instance, msg)
+	}
+
+	network, err := r.FindExistingNetwork(gcClient, instance)
+	if err != nil || network == nil {
+		return err
+	}
+
+	objects, err := hosts.ListHosts(gcClient)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return err
+	}
+
+	unlocked := make([]string, 0)
+	associations := make(map[string][]interfaceNetworks.InterfaceNetwork)
+	for _, h := range objects {
+		results, err := interfaceNetworks.ListInterfaceNetworks(gcClient, h.ID)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to list interface networks of host: %s", h.Hostname)
+			return err
+		}
+
+		for _, in := range results {
+			if in.NetworkUUID != network.UUID {
+				continue
+			}
+
+			if h.AdministrativeState == hosts.AdminUnlocked {
+				unlocked = append(unlocked, h.Hostname)
+				break
+			}
+
+			associations[h.Hostname] = append(associations[h.Hostname], in)
+		}
+	}
+
+	if len(unlocked) > 0 {
+		msg := fmt.Sprintf("network is still in use by unlocked hosts: %s",
+			strings.Join(unlocked, ", "))
+		return r.blockDeletion(instance, msg)
+	}
+
+	for hostname, list := range associations {
+		for _, in := range list {
+			err = interfaceNetworks.Delete(gcClient, in.UUID).ExtractErr()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to delete interface network: %s", in.UUID)
+				return err
+			}
+
+			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceDeleted,
+				"network has been removed from interface %q of host %q", in.InterfaceName, hostname)
+		}
+	}
+
+	return nil
+}
+
 // FindExistingNetwork attempts to re-use the existing resource referenced
 // by the ID value stored in the status or to find another resource with a
 // matching name.
@@ -820,7 +1146,13 @@ func (r *PlatformNetworkReconciler) IsPlatformNetworkInSync(client *gophercloud.
 		return false, err
 	}
 
-	return !(pool_update_required || nwk_update_required), err
+	secondary_update_required, err := r.SecondaryAddressPoolUpdateRequired(client, instance)
+	if err != nil {
+		err = common.NewSystemDependency("There was an error retrieving the secondary address pool.")
+		return false, err
+	}
+
+	return !(pool_update_required || nwk_update_required || secondary_update_required), err
 }
 
 func (r *PlatformNetworkReconciler) UpdateInsyncStatus(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformNetwork, oldStatus *starlingxv1.PlatformNetworkStatus) error {
@@ -847,6 +1179,10 @@ func (r *PlatformNetworkReconciler) ReconcileAddressPoolAndNetwork(client *gophe
 		}
 	}
 
+	if err == nil {
+		err = r.ReconcileSecondaryAddressPool(client, instance)
+	}
+
 	inSync = err == nil
 	instance.Status.InSync = inSync
 	instance.Status.Reconciled = inSync
@@ -1054,6 +1390,18 @@ func (r *PlatformNetworkReconciler) ReconcileResource(client *gophercloud.Servic
 	} else {
 		// Reverse the order of operations for deletes since there is a built-in
 		// dependency between the two.
+		if networkResourceRequired(instance) {
+			err = r.ReleaseNetworkInterfaces(client, instance)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = r.ReconcileSecondaryAddressPool(client, instance)
+		if err != nil {
+			return err
+		}
+
 		if networkResourceRequired(instance) {
 			err = r.ReconcileNetwork(client, instance)
 		} else {
@@ -1260,9 +1608,19 @@ func (r *PlatformNetworkReconciler) Reconcile(ctx context.Context, request ctrl.
 		return ctrl.Result{}, nil
 	}
 
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
 	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
 		instance.Status.Reconciled {
-		return ctrl.Result{}, nil
+		err = common.ReconcileConditions(r.Client, instance, &instance.Status.Conditions,
+			instance.Status.Reconciled, instance.Status.InSync)
+		return ctrl.Result{}, err
 	}
 
 	// Update scope from configuration
@@ -1300,13 +1658,17 @@ func (r *PlatformNetworkReconciler) Reconcile(ctx context.Context, request ctrl.
 		// wait.
 		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
 			"waiting for platform client creation")
-		return common.RetryMissingClient, nil
+		err = common.ReconcileBlocked(r.Client, instance, &instance.Status.Conditions,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, err
 	}
 
 	if !r.CloudManager.GetSystemReady(request.Namespace) {
 		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
 			"waiting for system reconciliation")
-		return common.RetrySystemNotReady, nil
+		err = common.ReconcileBlocked(r.Client, instance, &instance.Status.Conditions,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, err
 	}
 
 	// SetPlatformNetworkReconciling(true) so that host reconciler waits for platform
@@ -1314,7 +1676,16 @@ func (r *PlatformNetworkReconciler) Reconcile(ctx context.Context, request ctrl.
 	// propagates unlock_required strategy update.
 	r.CloudManager.SetPlatformNetworkReconciling(true)
 
+	platformClient = cloudManager.WithChangeInitiator(platformClient, instance)
 	err = r.ReconcileResource(platformClient, instance, request.NamespacedName.Namespace)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if instance.DeletionTimestamp.IsZero() {
+		err2 := common.UpdateStandardConditions(r.Client, instance, &instance.Status.Conditions,
+			instance.Status.Reconciled, instance.Status.InSync, err)
+		if err2 != nil {
+			logPlatformNetwork.Error(err2, "failed to update platform network conditions")
+		}
+	}
 	if err != nil {
 		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
 	}
@@ -1335,9 +1706,7 @@ func (r *PlatformNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.ReconcilerErrorHandler = &common.ErrorHandler{
 		CloudManager: tMgr,
 		Logger:       logPlatformNetwork}
-	r.ReconcilerEventLogger = &common.EventLogger{
-		EventRecorder: mgr.GetEventRecorderFor(PlatformNetworkControllerName),
-		Logger:        logPlatformNetwork}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(PlatformNetworkControllerName), logPlatformNetwork)
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.PlatformNetwork{}).
 		Complete(r)
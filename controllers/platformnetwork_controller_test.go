@@ -13,10 +13,13 @@ import (
 
 	"strings"
 
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresspools"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/networks"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	comm "github.com/wind-river/cloud-platform-deployment-manager/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/networkaddrpools"
 )
 
 const TestNamespace = "default"
@@ -607,3 +610,148 @@ var _ = Describe("Platformnetwork controller", func() {
 	})
 
 })
+
+var _ = Describe("OAM network update utils", func() {
+
+	Describe("oamConfigPendingHosts", func() {
+		It("Should only report unlocked controllers with out-of-date configuration", func() {
+			objects := []hosts.Host{
+				{Hostname: "controller-0", Personality: hosts.PersonalityController,
+					AdministrativeState: hosts.AdminUnlocked, ConfigurationStatus: "Config out-of-date"},
+				{Hostname: "controller-1", Personality: hosts.PersonalityController,
+					AdministrativeState: hosts.AdminUnlocked},
+				{Hostname: "controller-2", Personality: hosts.PersonalityController,
+					AdministrativeState: hosts.AdminLocked, ConfigurationStatus: "Config out-of-date"},
+				{Hostname: "worker-0", Personality: hosts.PersonalityWorker,
+					AdministrativeState: hosts.AdminUnlocked, ConfigurationStatus: "Config out-of-date"},
+			}
+			Expect(oamConfigPendingHosts(objects)).To(Equal([]string{"controller-0"}))
+			Expect(oamConfigPendingHosts(objects[1:])).To(BeEmpty())
+		})
+	})
+
+	Describe("oamUpdateState", func() {
+		It("Should report updates in progress and results of the current generation", func() {
+			instance := &starlingxv1.PlatformNetwork{}
+			instance.Generation = 2
+			Expect(oamUpdateState(instance)).To(Equal(""))
+
+			instance.Status.Conditions = []metav1.Condition{{
+				Type:               starlingxv1.ConditionOAMUpdateInProgress,
+				Status:             metav1.ConditionTrue,
+				Reason:             OAMUpdateReasonApplying,
+				ObservedGeneration: 1,
+			}}
+			Expect(oamUpdateState(instance)).To(Equal(OAMUpdateReasonApplying))
+
+			instance.Status.Conditions[0].Status = metav1.ConditionFalse
+			instance.Status.Conditions[0].Reason = OAMUpdateReasonFailed
+			Expect(oamUpdateState(instance)).To(Equal(""))
+
+			instance.Status.Conditions[0].ObservedGeneration = 2
+			Expect(oamUpdateState(instance)).To(Equal(OAMUpdateReasonFailed))
+		})
+	})
+})
+
+var _ = Describe("Address pool range utils", func() {
+
+	Describe("addressesEqual", func() {
+		It("Should ignore differences in address formatting", func() {
+			Expect(addressesEqual("fd00::0001", "FD00::1")).To(BeTrue())
+			Expect(addressesEqual("10.10.10.1", "10.10.10.1")).To(BeTrue())
+			Expect(addressesEqual("10.10.10.1", "10.10.10.2")).To(BeFalse())
+		})
+	})
+
+	Describe("compareRangeArrays", func() {
+		It("Should ignore the order of the ranges", func() {
+			x := [][]string{{"10.0.0.2", "10.0.0.50"}, {"10.0.0.100", "10.0.0.200"}}
+			y := [][]string{{"10.0.0.100", "10.0.0.200"}, {"10.0.0.2", "10.0.0.50"}}
+			Expect(compareRangeArrays(x, y)).To(BeTrue())
+			Expect(compareRangeArrays(x, y[:1])).To(BeFalse())
+		})
+	})
+
+	Describe("rangeArrayDelta", func() {
+		It("Should report the ranges that differ from the live pool", func() {
+			desired := [][]string{{"10.0.0.2", "10.0.0.100"}, {"10.0.0.150", "10.0.0.200"}}
+			current := [][]string{{"10.0.0.2", "10.0.0.50"}, {"10.0.0.150", "10.0.0.200"}}
+			Expect(rangeArrayDelta(desired, current)).To(Equal([][]string{{"10.0.0.2", "10.0.0.100"}}))
+			Expect(rangeArrayDelta(current, desired)).To(Equal([][]string{{"10.0.0.2", "10.0.0.50"}}))
+		})
+	})
+})
+
+var _ = Describe("Platform network deletion utils", func() {
+
+	Describe("interfacesReferenceNetwork", func() {
+		It("Should find networks associated to any interface type", func() {
+			networks := starlingxv1.PlatformNetworkItemList{"mgmt", "cluster-host"}
+			profile := &starlingxv1.HostProfileSpec{}
+			Expect(interfacesReferenceNetwork(profile, "mgmt")).To(BeFalse())
+
+			profile.Interfaces = &starlingxv1.InterfaceInfo{
+				Bond: starlingxv1.BondList{
+					{CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+						Name:             "bond0",
+						PlatformNetworks: &networks,
+					}},
+				},
+			}
+			Expect(interfacesReferenceNetwork(profile, "cluster-host")).To(BeTrue())
+			Expect(interfacesReferenceNetwork(profile, "oam")).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("Dual-stack platform network utils", func() {
+
+	Describe("secondaryPoolName", func() {
+		It("Should derive the name from the primary pool and address family", func() {
+			Expect(secondaryPoolName("management", "fd01::")).To(Equal("management-ipv6"))
+			Expect(secondaryPoolName("oam", "10.10.10.0")).To(Equal("oam-ipv4"))
+		})
+	})
+
+	Describe("secondaryPoolUpdateRequired", func() {
+		It("Should only report the attributes that differ", func() {
+			spec := &starlingxv1.SecondaryPoolInfo{
+				Subnet:          "fd01::",
+				Prefix:          64,
+				FloatingAddress: "fd01::2",
+				Allocation: starlingxv1.AllocationInfo{
+					Ranges: []starlingxv1.AllocationRange{{Start: "fd01::2", End: "fd01::ff"}},
+				},
+			}
+			pool := &addresspools.AddressPool{
+				Name:            "management-ipv6",
+				Network:         "FD01::",
+				Prefix:          64,
+				FloatingAddress: "fd01::0002",
+				Ranges:          [][]string{{"fd01::2", "fd01::ff"}},
+			}
+
+			_, ok := secondaryPoolUpdateRequired("management-ipv6", spec, pool)
+			Expect(ok).To(BeFalse())
+
+			spec.Prefix = 96
+			opts, ok := secondaryPoolUpdateRequired("management-ipv6", spec, pool)
+			Expect(ok).To(BeTrue())
+			Expect(*opts.Prefix).To(Equal(96))
+			Expect(opts.Network).To(BeNil())
+			Expect(opts.Ranges).To(BeNil())
+		})
+	})
+
+	Describe("findNetworkAddressPool", func() {
+		It("Should match both the network and the address pool", func() {
+			objects := []networkaddrpools.NetworkAddressPool{
+				{ID: "a", NetworkUUID: "net-1", AddressPoolUUID: "pool-1"},
+				{ID: "b", NetworkUUID: "net-1", AddressPoolUUID: "pool-2"},
+			}
+			Expect(findNetworkAddressPool(objects, "net-1", "pool-2").ID).To(Equal("b"))
+			Expect(findNetworkAddressPool(objects, "net-2", "pool-2")).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,329 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresspools"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/networks"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/networkaddrpools"
+)
+
+// secondaryPoolName returns the name of the address pool of the second
+// address family of a dual-stack network.  The name is derived from the name
+// of the primary pool so that both pools are easily related on the system.
+func secondaryPoolName(primary string, subnet string) string {
+	family := "ipv6"
+	if utils.IsIPv4(subnet) {
+		family = "ipv4"
+	}
+
+	return fmt.Sprintf("%s-%s", primary, family)
+}
+
+// secondaryPoolCreateOpts builds the options used to create the secondary
+// address pool of a dual-stack network.
+func secondaryPoolCreateOpts(name string, networkType string, spec *starlingxv1.SecondaryPoolInfo) addresspools.AddressPoolOpts {
+	opts := addresspools.AddressPoolOpts{
+		Name:    &name,
+		Network: &spec.Subnet,
+		Prefix:  &spec.Prefix,
+		Order:   spec.Allocation.Order,
+	}
+
+	if spec.FloatingAddress != "" {
+		opts.FloatingAddress = &spec.FloatingAddress
+	}
+
+	if spec.Controller0Address != "" {
+		opts.Controller0Address = &spec.Controller0Address
+	}
+
+	if spec.Controller1Address != "" {
+		opts.Controller1Address = &spec.Controller1Address
+	}
+
+	if networkType != networks.NetworkTypeOther {
+		opts.Gateway = spec.Gateway
+	}
+
+	if len(spec.Allocation.Ranges) > 0 {
+		ranges := makeRangeArray(spec.Allocation.Ranges)
+		opts.Ranges = &ranges
+	}
+
+	return opts
+}
+
+// secondaryPoolUpdateRequired determines whether the secondary address pool
+// of a dual-stack network must be updated to align with the stored value.
+// Only the updated fields are included in the request options.
+func secondaryPoolUpdateRequired(name string, spec *starlingxv1.SecondaryPoolInfo, p *addresspools.AddressPool) (opts addresspools.AddressPoolOpts, result bool) {
+	if p.Name != name {
+		opts.Name = &name
+		result = true
+	}
+
+	if !addressesEqual(spec.Subnet, p.Network) {
+		opts.Network = &spec.Subnet
+		result = true
+	}
+
+	if spec.Prefix != p.Prefix {
+		opts.Prefix = &spec.Prefix
+		result = true
+	}
+
+	if spec.FloatingAddress != "" && !addressesEqual(spec.FloatingAddress, p.FloatingAddress) {
+		opts.FloatingAddress = &spec.FloatingAddress
+		result = true
+	}
+
+	if spec.Controller0Address != "" && !addressesEqual(spec.Controller0Address, p.Controller0Address) {
+		opts.Controller0Address = &spec.Controller0Address
+		result = true
+	}
+
+	if spec.Controller1Address != "" && !addressesEqual(spec.Controller1Address, p.Controller1Address) {
+		opts.Controller1Address = &spec.Controller1Address
+		result = true
+	}
+
+	if spec.Gateway != nil && (p.Gateway == nil || !addressesEqual(*spec.Gateway, *p.Gateway)) {
+		opts.Gateway = spec.Gateway
+		result = true
+	}
+
+	if spec.Allocation.Order != nil && *spec.Allocation.Order != p.Order {
+		opts.Order = spec.Allocation.Order
+		result = true
+	}
+
+	if len(spec.Allocation.Ranges) > 0 {
+		ranges := makeRangeArray(spec.Allocation.Ranges)
+		if !compareRangeArrays(ranges, p.Ranges) {
+			opts.Ranges = &ranges
+			result = true
+		}
+	}
+
+	return opts, result
+}
+
+// findNetworkAddressPool returns the attachment of an address pool to a
+// network if it exists.
+func findNetworkAddressPool(objects []networkaddrpools.NetworkAddressPool, networkUUID string, poolUUID string) *networkaddrpools.NetworkAddressPool {
+	for i := range objects {
+		if objects[i].NetworkUUID == networkUUID && objects[i].AddressPoolUUID == poolUUID {
+			return &objects[i]
+		}
+	}
+
+	return nil
+}
+
+// findDualStackNetwork returns the system network to which the secondary
+// address pool is attached.  The networks which support dual-stack are unique
+// per type therefore the type is used when the network is not referenced by
+// the status; this also covers the oam network which is not managed through
+// the network API.
+func (r *PlatformNetworkReconciler) findDualStackNetwork(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformNetwork) (*networks.Network, error) {
+	results, err := networks.ListNetworks(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list networks")
+		return nil, err
+	}
+
+	for i := range results {
+		if instance.Status.ID != nil && results[i].UUID == *instance.Status.ID {
+			return &results[i], nil
+		}
+	}
+
+	for i := range results {
+		if results[i].Type == instance.Spec.Type {
+			return &results[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// FindSecondaryAddressPool attempts to re-use the secondary address pool
+// referenced by the status or to find another pool with a matching name.
+func (r *PlatformNetworkReconciler) FindSecondaryAddressPool(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformNetwork, name string) (*addresspools.AddressPool, error) {
+	results, err := addresspools.ListAddressPools(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list pools")
+		return nil, err
+	}
+
+	for i := range results {
+		if instance.Status.SecondaryPoolUUID != nil && results[i].ID == *instance.Status.SecondaryPoolUUID {
+			return &results[i], nil
+		}
+	}
+
+	for i := range results {
+		if name != "" && results[i].Name == name {
+			return &results[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// DeleteSecondaryAddressPool detaches the secondary address pool from its
+// network and deletes it once it has been removed from the specification.
+func (r *PlatformNetworkReconciler) DeleteSecondaryAddressPool(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformNetwork, network *networks.Network, attachments []networkaddrpools.NetworkAddressPool) error {
+	pool, err := r.FindSecondaryAddressPool(client, instance, "")
+	if err != nil {
+		return err
+	}
+
+	if pool != nil {
+		if network != nil {
+			if attachment := findNetworkAddressPool(attachments, network.UUID, pool.ID); attachment != nil {
+				err = networkaddrpools.Delete(client, attachment.ID).ExtractErr()
+				if err != nil {
+					err = perrors.Wrapf(err, "failed to detach secondary pool: %s", pool.ID)
+					return err
+				}
+			}
+		}
+
+		err = addresspools.Delete(client, pool.ID).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to delete secondary pool: %s", pool.ID)
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceDeleted,
+			"secondary address pool %q has been deleted", pool.Name)
+	}
+
+	instance.Status.SecondaryPoolUUID = nil
+
+	return nil
+}
+
+// ReconcileSecondaryAddressPool is responsible for creating or updating the
+// address pool of the second address family of a dual-stack network and for
+// attaching it to the network.  The pool is detached and deleted once it is
+// removed from the specification or the network is being deleted.
+func (r *PlatformNetworkReconciler) ReconcileSecondaryAddressPool(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformNetwork) error {
+	spec := instance.Spec.SecondaryPool
+	if !instance.DeletionTimestamp.IsZero() {
+		spec = nil
+	}
+
+	if spec == nil && instance.Status.SecondaryPoolUUID == nil {
+		return nil
+	}
+
+	network, err := r.findDualStackNetwork(client, instance)
+	if err != nil {
+		return err
+	}
+
+	attachments, err := networkaddrpools.ListNetworkAddressPools(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list network address pools")
+		return err
+	}
+
+	if spec == nil {
+		return r.DeleteSecondaryAddressPool(client, instance, network, attachments)
+	}
+
+	if network == nil {
+		msg := "waiting for the network to be created before attaching the secondary address pool"
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	name := secondaryPoolName(r.GetAddrPoolNameByNetworkType(instance.Spec.Type, instance.Name), spec.Subnet)
+
+	pool, err := r.FindSecondaryAddressPool(client, instance, name)
+	if err != nil {
+		return err
+	}
+
+	if pool == nil {
+		opts := secondaryPoolCreateOpts(name, instance.Spec.Type, spec)
+
+		logPlatformNetwork.Info("creating secondary address pool", "opts", opts)
+
+		pool, err = addresspools.Create(client, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to create secondary pool: %s", common.FormatStruct(opts))
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+			"secondary address pool %q has been created", name)
+
+	} else if opts, ok := secondaryPoolUpdateRequired(name, spec, pool); ok {
+		logPlatformNetwork.Info("updating secondary address pool", "uuid", pool.ID, "opts", opts)
+
+		result, err := addresspools.Update(client, pool.ID, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update secondary pool: %s", common.FormatStruct(opts))
+			return err
+		}
+
+		*pool = *result
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"secondary address pool %q has been updated", name)
+	}
+
+	if findNetworkAddressPool(attachments, network.UUID, pool.ID) == nil {
+		opts := networkaddrpools.NetworkAddressPoolOpts{
+			NetworkUUID:     network.UUID,
+			AddressPoolUUID: pool.ID,
+		}
+
+		logPlatformNetwork.Info("attaching secondary address pool", "opts", opts)
+
+		_, err = networkaddrpools.Create(client, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to attach secondary pool: %s", common.FormatStruct(opts))
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"secondary address pool %q has been attached to the network", name)
+	}
+
+	instance.Status.SecondaryPoolUUID = &pool.ID
+
+	return nil
+}
+
+// SecondaryAddressPoolUpdateRequired determines whether the secondary
+// address pool of a dual-stack network is out of sync with the system.
+func (r *PlatformNetworkReconciler) SecondaryAddressPoolUpdateRequired(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformNetwork) (bool, error) {
+	spec := instance.Spec.SecondaryPool
+	if spec == nil {
+		return instance.Status.SecondaryPoolUUID != nil, nil
+	}
+
+	name := secondaryPoolName(r.GetAddrPoolNameByNetworkType(instance.Spec.Type, instance.Name), spec.Subnet)
+
+	pool, err := r.FindSecondaryAddressPool(client, instance, name)
+	if err != nil {
+		return false, err
+	} else if pool == nil {
+		return true, nil
+	}
+
+	_, ok := secondaryPoolUpdateRequired(name, spec, pool)
+	return ok, nil
+}
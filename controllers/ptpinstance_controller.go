@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2022-2024 Wind River Systems, Inc. */
 
 package controllers
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -58,36 +59,43 @@ func instanceUpdateRequired(instance *starlingxv1.PtpInstance, i *ptpinstances.P
 	return result
 }
 
-func instanceParameterUpdateRequired(instance *starlingxv1.PtpInstance, i *ptpinstances.PTPInstance, r *PtpInstanceReconciler) (added []string, removed []string, result bool) {
-	configured := instance.Spec.InstanceParameters
-	current := i.Parameters
-	result = false
-
-	// Diff the lists to determine if changes need to be applied
+// ptpParameterDelta determines which PTP parameters need to be added and
+// removed to move from the current to the configured list of parameters.  The
+// returned delta is a printable summary of the changes and is empty when the
+// lists already match.
+func ptpParameterDelta(current, configured []string) (added []string, removed []string, delta string) {
 	added, removed, _ = utils.ListDelta(current, configured)
-	var delta strings.Builder
 
-	if len(added) > 0 || len(removed) > 0 {
-		result = true
+	var b strings.Builder
+	for _, a := range added {
+		b.WriteString("\t+ ")
+		b.WriteString(a)
+		b.WriteString("\n")
+	}
 
-		for _, a := range added {
-			delta.WriteString("\t+ ")
-			delta.WriteString(a)
-			delta.WriteString("\n")
-		}
+	for _, r := range removed {
+		b.WriteString("\t- ")
+		b.WriteString(r)
+		b.WriteString("\n")
+	}
 
-		for _, r := range removed {
-			delta.WriteString("\t- ")
-			delta.WriteString(r)
-			delta.WriteString("\n")
-		}
+	delta = b.String()
+	if delta != "" {
+		delta = "\n" + strings.TrimSuffix(delta, "\n")
 	}
-	deltaString := delta.String()
-	if deltaString != "" {
-		deltaString = "\n" + strings.TrimSuffix(deltaString, "\n")
+
+	return added, removed, delta
+}
+
+func instanceParameterUpdateRequired(instance *starlingxv1.PtpInstance, i *ptpinstances.PTPInstance, r *PtpInstanceReconciler) (added []string, removed []string, result bool) {
+	// Diff the lists to determine if changes need to be applied
+	added, removed, deltaString := ptpParameterDelta(i.Parameters, instance.Spec.InstanceParameters)
+	result = len(added) > 0 || len(removed) > 0
+	if result {
 		logPtpInstance.Info(fmt.Sprintf("delta configuration:%s\n", deltaString))
 	}
 	instance.Status.Delta = deltaString
+	instance.Status.DeltaAttributes = common.ParameterDeltaAttributes("parameters", added, removed)
 
 	err := r.Client.Status().Update(context.TODO(), instance)
 	if err != nil {
@@ -97,6 +105,23 @@ func instanceParameterUpdateRequired(instance *starlingxv1.PtpInstance, i *ptpin
 	return added, removed, result
 }
 
+// applyPTPConfiguration pushes the PTP instance and interface configuration
+// to the hosts.  Parameter changes made to existing PTP instances and
+// interfaces are not applied to the hosts until this is requested.
+func applyPTPConfiguration(client *gophercloud.ServiceClient) error {
+	logPtpInstance.Info("applying ptp configuration")
+
+	// The apply request has no response content which the client library
+	// still attempts to decode; that is not a failure.
+	err := ptpinstances.Apply(client, ptpinstances.PTPInstanceOpts{}).Err
+	if err != nil && err != io.EOF {
+		err = perrors.Wrap(err, "failed to apply ptp configuration")
+		return err
+	}
+
+	return nil
+}
+
 // ReconcileParamAdded is a method which handles adding new Parameters to
 // associate with an existing PTP instance
 func (r *PtpInstanceReconciler) ReconcileParamAdded(client *gophercloud.ServiceClient, params []string, i *ptpinstances.PTPInstance) (*ptpinstances.PTPInstance, error) {
@@ -345,9 +370,11 @@ func (r *PtpInstanceReconciler) ReconcileUpdated(client *gophercloud.ServiceClie
 			}
 		}
 
-		// Update PTP parameters associated with PTP instance
-		if len(added) > 0 {
-			new, err := r.ReconcileParamAdded(client, added, existing)
+		// Update PTP parameters associated with PTP instance.  Removed parameters
+		// are dropped first so that a parameter whose value has changed is
+		// never present twice.
+		if len(removed) > 0 {
+			new, err := r.ReconcileParamRemoved(client, removed, existing)
 			if err != nil {
 				return err
 			}
@@ -355,8 +382,8 @@ func (r *PtpInstanceReconciler) ReconcileUpdated(client *gophercloud.ServiceClie
 			*existing = *new
 		}
 
-		if len(removed) > 0 {
-			new2, err2 := r.ReconcileParamRemoved(client, removed, existing)
+		if len(added) > 0 {
+			new2, err2 := r.ReconcileParamAdded(client, added, existing)
 			if err2 != nil {
 				return err2
 			}
@@ -366,6 +393,14 @@ func (r *PtpInstanceReconciler) ReconcileUpdated(client *gophercloud.ServiceClie
 
 		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
 			"ptp instance has been updated")
+
+		err := applyPTPConfiguration(client)
+		if err != nil {
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"ptp configuration has been applied")
 	}
 
 	return nil
@@ -396,7 +431,12 @@ func (r *PtpInstanceReconciler) ReconcileResource(client *gophercloud.ServiceCli
 			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
 		}
 
-		if r.statusUpdateRequired(instance, found, inSync) {
+		updated := r.statusUpdateRequired(instance, found, inSync)
+		if common.SetStandardConditions(&instance.Status.Conditions, instance.Status.Reconciled, inSync, err, instance.Generation) {
+			updated = true
+		}
+
+		if updated {
 			// update the resource status to link it to the system object.
 			logPtpInstance.Info("updating PTP instance", "status", instance.Status)
 
@@ -594,9 +634,19 @@ func (r *PtpInstanceReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return ctrl.Result{}, nil
 	}
 
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
 	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
 		instance.Status.Reconciled {
-		return ctrl.Result{}, nil
+		err = common.ReconcileConditions(r.Client, instance, &instance.Status.Conditions,
+			instance.Status.Reconciled, instance.Status.InSync)
+		return ctrl.Result{}, err
 	}
 
 	// Update scope from configuration
@@ -634,16 +684,22 @@ func (r *PtpInstanceReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		// wait.
 		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
 			"waiting for platform client creation")
-		return common.RetryMissingClient, nil
+		err = common.ReconcileBlocked(r.Client, instance, &instance.Status.Conditions,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, err
 	}
 
 	if !r.CloudManager.GetSystemReady(request.Namespace) {
 		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
 			"waiting for system reconciliation")
-		return common.RetrySystemNotReady, nil
+		err = common.ReconcileBlocked(r.Client, instance, &instance.Status.Conditions,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, err
 	}
 
+	platformClient = cloudManager.WithChangeInitiator(platformClient, instance)
 	err = r.ReconcileResource(platformClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
 	if err != nil {
 		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
 	}
@@ -660,9 +716,7 @@ func (r *PtpInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.ReconcilerErrorHandler = &common.ErrorHandler{
 		CloudManager: tMgr,
 		Logger:       logPtpInstance}
-	r.ReconcilerEventLogger = &common.EventLogger{
-		EventRecorder: mgr.GetEventRecorderFor(PtpInstanceControllerName),
-		Logger:        logPtpInstance}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(PtpInstanceControllerName), logPtpInstance)
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.PtpInstance{}).
 		Complete(r)
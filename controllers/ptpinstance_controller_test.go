@@ -1,18 +1,28 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022 Wind River Systems, Inc. */
+/* Copyright(c) 2022-2024 Wind River Systems, Inc. */
 package controllers
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/ptpinstances"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	comm "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
 )
 
 var _ = Describe("PtpInstance controller", func() {
@@ -53,3 +63,151 @@ var _ = Describe("PtpInstance controller", func() {
 		})
 	})
 })
+
+// ptpSystem is a minimal stand-in for the PTP endpoints of the system API.  It
+// records the parameters of a single PTP instance or interface and counts the
+// number of times that the PTP configuration is applied.
+type ptpSystem struct {
+	server     *httptest.Server
+	parameters []string
+	applied    int
+}
+
+func newPTPSystem(parameters []string) *ptpSystem {
+	s := &ptpSystem{parameters: parameters}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/ptp_instances/apply":
+			s.applied++
+			w.WriteHeader(http.StatusNoContent)
+
+		case r.Method == http.MethodPatch:
+			var ops []struct {
+				Op    string `json:"op"`
+				Value string `json:"value"`
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&ops)).To(Succeed())
+			for _, op := range ops {
+				if op.Op == "add" {
+					s.parameters = append(s.parameters, op.Value)
+					continue
+				}
+
+				remaining := []string{}
+				for _, p := range s.parameters {
+					if p != op.Value {
+						remaining = append(remaining, p)
+					}
+				}
+				s.parameters = remaining
+			}
+			w.Header().Set("Content-Type", "application/json")
+			Expect(json.NewEncoder(w).Encode(map[string]interface{}{
+				"uuid":       "uuid",
+				"name":       "foo",
+				"parameters": s.parameters,
+			})).To(Succeed())
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return s
+}
+
+func (s *ptpSystem) client() *gophercloud.ServiceClient {
+	return &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{TokenID: "token"},
+		Endpoint:       s.server.URL + "/",
+	}
+}
+
+// ptpTestClient returns a kubernetes client which stores the given PTP
+// resources in memory.
+func ptpTestClient(objects ...client.Object) client.Client {
+	testScheme := runtime.NewScheme()
+	Expect(starlingxv1.AddToScheme(testScheme)).To(Succeed())
+	return fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objects...).Build()
+}
+
+var ptpParameterTests = []struct {
+	name       string
+	current    []string
+	configured []string
+	added      []string
+	removed    []string
+	delta      string
+}{
+	{name: "add-only",
+		current:    []string{"domainNumber=24"},
+		configured: []string{"domainNumber=24", "clientOnly=0"},
+		added:      []string{"clientOnly=0"},
+		delta:      "\n\t+ clientOnly=0"},
+	{name: "remove-only",
+		current:    []string{"domainNumber=24", "clientOnly=0"},
+		configured: []string{"domainNumber=24"},
+		removed:    []string{"clientOnly=0"},
+		delta:      "\n\t- clientOnly=0"},
+	{name: "mixed",
+		current:    []string{"domainNumber=24", "clientOnly=0"},
+		configured: []string{"domainNumber=25", "clientOnly=0"},
+		added:      []string{"domainNumber=25"},
+		removed:    []string{"domainNumber=24"},
+		delta:      "\n\t+ domainNumber=25\n\t- domainNumber=24"},
+	{name: "no-change",
+		current:    []string{"domainNumber=24", "clientOnly=0"},
+		configured: []string{"clientOnly=0", "domainNumber=24"}},
+}
+
+var _ = Describe("PtpInstance parameters", func() {
+	Describe("ptpParameterDelta utility", func() {
+		It("should report the parameters to add and remove", func() {
+			for _, tt := range ptpParameterTests {
+				added, removed, delta := ptpParameterDelta(tt.current, tt.configured)
+				Expect(added).To(ConsistOf(tt.added), tt.name)
+				Expect(removed).To(ConsistOf(tt.removed), tt.name)
+				Expect(delta).To(Equal(tt.delta), tt.name)
+			}
+		})
+	})
+
+	Describe("ReconcileUpdated method", func() {
+		It("should only apply the configuration when the parameters change", func() {
+			for _, tt := range ptpParameterTests {
+				instance := &starlingxv1.PtpInstance{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+					Spec: starlingxv1.PtpInstanceSpec{
+						Service:            "ptp4l",
+						InstanceParameters: tt.configured,
+					},
+				}
+				existing := &ptpinstances.PTPInstance{
+					UUID:       "uuid",
+					Name:       "foo",
+					Service:    "ptp4l",
+					Parameters: append([]string{}, tt.current...),
+				}
+
+				system := newPTPSystem(existing.Parameters)
+				r := &PtpInstanceReconciler{
+					Client: ptpTestClient(instance),
+					ReconcilerEventLogger: &common.EventLogger{
+						EventRecorder: record.NewFakeRecorder(16),
+						Logger:        logPtpInstance,
+					},
+				}
+
+				Expect(r.ReconcileUpdated(system.client(), instance, existing)).To(Succeed(), tt.name)
+				system.server.Close()
+
+				Expect(existing.Parameters).To(ConsistOf(tt.configured), tt.name)
+				if len(tt.added) > 0 || len(tt.removed) > 0 {
+					Expect(system.applied).To(Equal(1), tt.name)
+				} else {
+					Expect(system.applied).To(Equal(0), tt.name)
+				}
+			}
+		})
+	})
+})
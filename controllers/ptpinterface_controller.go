@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2022-2024 Wind River Systems, Inc. */
 
 package controllers
 
@@ -58,36 +58,14 @@ func interfaceUpdateRequired(instance *starlingxv1.PtpInterface, i *ptpinterface
 }
 
 func intefaceParameterUpdateRequired(instance *starlingxv1.PtpInterface, i *ptpinterfaces.PTPInterface, r *PtpInterfaceReconciler) (added []string, removed []string, result bool) {
-	configured := instance.Spec.InterfaceParameters
-	current := i.Parameters
-	result = false
-
 	// Diff the lists to determine if changes need to be applied
-	added, removed, _ = utils.ListDelta(current, configured)
-	var delta strings.Builder
-
-	if len(added) > 0 || len(removed) > 0 {
-		result = true
-
-		for _, a := range added {
-			delta.WriteString("\t+ ")
-			delta.WriteString(a)
-			delta.WriteString("\n")
-		}
-
-		for _, r := range removed {
-			delta.WriteString("\t- ")
-			delta.WriteString(r)
-			delta.WriteString("\n")
-		}
-	}
-
-	deltaString := delta.String()
-	if deltaString != "" {
-		deltaString = "\n" + strings.TrimSuffix(deltaString, "\n")
+	added, removed, deltaString := ptpParameterDelta(i.Parameters, instance.Spec.InterfaceParameters)
+	result = len(added) > 0 || len(removed) > 0
+	if result {
 		logPtpInterface.Info(fmt.Sprintf("delta configuration:%s\n", deltaString))
 	}
 	instance.Status.Delta = deltaString
+	instance.Status.DeltaAttributes = common.ParameterDeltaAttributes("parameters", added, removed)
 	err := r.Client.Status().Update(context.TODO(), instance)
 	if err != nil {
 		logPtpInterface.Info(fmt.Sprintf("failed to update status:  %s\n", err))
@@ -369,9 +347,11 @@ func (r *PtpInterfaceReconciler) ReconcileUpdated(client *gophercloud.ServiceCli
 			}
 		}
 
-		// Update PTP parameters associated with PTP interface
-		if len(added) > 0 {
-			new, err := r.ReconcileParamAdded(client, added, existing)
+		// Update PTP parameters associated with PTP interface.  Removed parameters
+		// are dropped first so that a parameter whose value has changed is
+		// never present twice.
+		if len(removed) > 0 {
+			new, err := r.ReconcileParamRemoved(client, removed, existing)
 			if err != nil {
 				return err
 			}
@@ -379,8 +359,8 @@ func (r *PtpInterfaceReconciler) ReconcileUpdated(client *gophercloud.ServiceCli
 			*existing = *new
 		}
 
-		if len(removed) > 0 {
-			new2, err2 := r.ReconcileParamRemoved(client, removed, existing)
+		if len(added) > 0 {
+			new2, err2 := r.ReconcileParamAdded(client, added, existing)
 			if err2 != nil {
 				return err2
 			}
@@ -390,6 +370,14 @@ func (r *PtpInterfaceReconciler) ReconcileUpdated(client *gophercloud.ServiceCli
 
 		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
 			"ptp interface has been updated")
+
+		err := applyPTPConfiguration(client)
+		if err != nil {
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"ptp configuration has been applied")
 	}
 
 	return nil
@@ -420,7 +408,12 @@ func (r *PtpInterfaceReconciler) ReconcileResource(client *gophercloud.ServiceCl
 			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
 		}
 
-		if r.statusUpdateRequired(instance, found, inSync) {
+		updated := r.statusUpdateRequired(instance, found, inSync)
+		if common.SetStandardConditions(&instance.Status.Conditions, instance.Status.Reconciled, inSync, err, instance.Generation) {
+			updated = true
+		}
+
+		if updated {
 			// update the resource status to link it to the system object.
 			logPtpInterface.Info("updating PTP interface", "status", instance.Status)
 
@@ -618,9 +611,19 @@ func (r *PtpInterfaceReconciler) Reconcile(ctx context.Context, request ctrl.Req
 		return ctrl.Result{}, nil
 	}
 
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
 	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
 		instance.Status.Reconciled {
-		return ctrl.Result{}, nil
+		err = common.ReconcileConditions(r.Client, instance, &instance.Status.Conditions,
+			instance.Status.Reconciled, instance.Status.InSync)
+		return ctrl.Result{}, err
 	}
 
 	// Update scope from configuration
@@ -658,16 +661,22 @@ func (r *PtpInterfaceReconciler) Reconcile(ctx context.Context, request ctrl.Req
 		// wait.
 		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
 			"waiting for platform client creation")
-		return common.RetryMissingClient, nil
+		err = common.ReconcileBlocked(r.Client, instance, &instance.Status.Conditions,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, err
 	}
 
 	if !r.CloudManager.GetSystemReady(request.Namespace) {
 		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
 			"waiting for system reconciliation")
-		return common.RetrySystemNotReady, nil
+		err = common.ReconcileBlocked(r.Client, instance, &instance.Status.Conditions,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, err
 	}
 
+	platformClient = cloudManager.WithChangeInitiator(platformClient, instance)
 	err = r.ReconcileResource(platformClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
 	if err != nil {
 		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
 	}
@@ -684,9 +693,7 @@ func (r *PtpInterfaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.ReconcilerErrorHandler = &common.ErrorHandler{
 		CloudManager: tMgr,
 		Logger:       logPtpInterface}
-	r.ReconcilerEventLogger = &common.EventLogger{
-		EventRecorder: mgr.GetEventRecorderFor(PtpInterfaceControllerName),
-		Logger:        logPtpInterface}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(PtpInterfaceControllerName), logPtpInterface)
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.PtpInterface{}).
 		Complete(r)
@@ -1,18 +1,21 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022 Wind River Systems, Inc. */
+/* Copyright(c) 2022-2024 Wind River Systems, Inc. */
 package controllers
 
 import (
 	"context"
 	"time"
 
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/ptpinterfaces"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	comm "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
 )
 
 var _ = Describe("PtpInterface controller", func() {
@@ -53,3 +56,44 @@ var _ = Describe("PtpInterface controller", func() {
 		})
 	})
 })
+
+var _ = Describe("PtpInterface parameters", func() {
+	Describe("ReconcileUpdated method", func() {
+		It("should only apply the configuration when the parameters change", func() {
+			for _, tt := range ptpParameterTests {
+				instance := &starlingxv1.PtpInterface{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+					Spec: starlingxv1.PtpInterfaceSpec{
+						PtpInstance:         "ptp1",
+						InterfaceParameters: tt.configured,
+					},
+				}
+				existing := &ptpinterfaces.PTPInterface{
+					UUID:            "uuid",
+					Name:            "foo",
+					PTPInstanceName: "ptp1",
+					Parameters:      append([]string{}, tt.current...),
+				}
+
+				system := newPTPSystem(existing.Parameters)
+				r := &PtpInterfaceReconciler{
+					Client: ptpTestClient(instance),
+					ReconcilerEventLogger: &common.EventLogger{
+						EventRecorder: record.NewFakeRecorder(16),
+						Logger:        logPtpInterface,
+					},
+				}
+
+				Expect(r.ReconcileUpdated(system.client(), instance, existing)).To(Succeed(), tt.name)
+				system.server.Close()
+
+				Expect(existing.Parameters).To(ConsistOf(tt.configured), tt.name)
+				if len(tt.added) > 0 || len(tt.removed) > 0 {
+					Expect(system.applied).To(Equal(1), tt.name)
+				} else {
+					Expect(system.applied).To(Equal(0), tt.name)
+				}
+			}
+		})
+	})
+})
@@ -0,0 +1,607 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/subclouds"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logRestore = log.Log.WithName("controller").WithName("restore")
+
+const RestoreControllerName = "restore-controller"
+
+var _ reconcile.Reconciler = &RestoreReconciler{}
+
+// RestoreReconciler reconciles a Restore object
+type RestoreReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+}
+
+// restoreInProgress determines whether a restore has been requested and has
+// neither completed nor failed.
+func restoreInProgress(instance *starlingxv1.Restore) bool {
+	switch instance.Status.Phase {
+	case starlingxv1.RestorePhaseRequested, starlingxv1.RestorePhaseRestoring:
+		return true
+	}
+
+	return false
+}
+
+// restoreStarted determines whether the system controller has started
+// working on a requested restore.  The subcloud is reinstalled first when
+// requested so the install states are also considered.
+func restoreStarted(subcloud *subclouds.Subcloud) bool {
+	switch subcloud.DeployStatus {
+	case subclouds.DeployPreRestore, subclouds.DeployRestoring,
+		subclouds.DeployRestorePrepFailed, subclouds.DeployRestoreFailed,
+		subclouds.DeployPreInstall, subclouds.DeployPreInstallFailed,
+		subclouds.DeployInstalling, subclouds.DeployInstallFailed:
+		return true
+	}
+
+	return false
+}
+
+// updateManagementState changes the management state of a subcloud.
+func (r *RestoreReconciler) updateManagementState(client *gophercloud.ServiceClient, instance *starlingxv1.Restore, state string) error {
+	opts := subclouds.SubcloudUpdateOpts{ManagementState: &state}
+
+	logRestore.Info("updating subcloud", "opts", opts)
+
+	_, err := subclouds.Update(client, instance.Spec.Subcloud, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to change subcloud %s to %s", instance.Spec.Subcloud, state)
+		return err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"subcloud %s has been %s", instance.Spec.Subcloud, state)
+
+	return nil
+}
+
+// restorePausedValue returns the value of the paused annotation set on the
+// resources of the system being restored.  It identifies the restore which
+// paused them so that resources paused by the user are left alone.
+func restorePausedValue(instance *starlingxv1.Restore) string {
+	return fmt.Sprintf("restore/%s/%s", instance.Namespace, instance.Name)
+}
+
+// listSystemResources returns the System and Host resources which configure
+// the subcloud being restored.
+func (r *RestoreReconciler) listSystemResources(instance *starlingxv1.Restore) ([]client.Object, error) {
+	namespace := *instance.Spec.SystemNamespace
+
+	systems := &starlingxv1.SystemList{}
+	err := r.Client.List(context.TODO(), systems, client.InNamespace(namespace))
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to list systems: %s", namespace)
+		return nil, err
+	}
+
+	hosts := &starlingxv1.HostList{}
+	err = r.Client.List(context.TODO(), hosts, client.InNamespace(namespace))
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to list hosts: %s", namespace)
+		return nil, err
+	}
+
+	result := make([]client.Object, 0, len(systems.Items)+len(hosts.Items))
+	for i := range systems.Items {
+		result = append(result, &systems.Items[i])
+	}
+	for i := range hosts.Items {
+		result = append(result, &hosts.Items[i])
+	}
+
+	return result, nil
+}
+
+// PauseSystemResources pauses the System and Host resources of the subcloud
+// so that they do not interfere with the restore.  Resources which are
+// already paused are left as they are.
+func (r *RestoreReconciler) PauseSystemResources(instance *starlingxv1.Restore) error {
+	if instance.Spec.SystemNamespace == nil {
+		return nil
+	}
+
+	objects, err := r.listSystemResources(instance)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objects {
+		annotations := obj.GetAnnotations()
+		if _, ok := annotations[cloudManager.PausedReconcile]; ok {
+			continue
+		}
+
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+
+		annotations[cloudManager.PausedReconcile] = restorePausedValue(instance)
+		obj.SetAnnotations(annotations)
+
+		err = r.Client.Update(context.TODO(), obj)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to pause %s", obj.GetName())
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReleaseSystemResources resumes the System and Host resources paused for
+// the restore.  Their status is reset so that they are fully reconciled
+// against the restored subcloud; this brings up the hosts which were not
+// restored along with controller-0.
+func (r *RestoreReconciler) ReleaseSystemResources(instance *starlingxv1.Restore) error {
+	if instance.Spec.SystemNamespace == nil {
+		return nil
+	}
+
+	objects, err := r.listSystemResources(instance)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objects {
+		annotations := obj.GetAnnotations()
+		if annotations[cloudManager.PausedReconcile] != restorePausedValue(instance) {
+			continue
+		}
+
+		switch o := obj.(type) {
+		case *starlingxv1.System:
+			o.Status.Reconciled = false
+		case *starlingxv1.Host:
+			o.Status.Reconciled = false
+		}
+
+		err = r.Client.Status().Update(context.TODO(), obj)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to reset status of %s", obj.GetName())
+			return err
+		}
+
+		delete(annotations, cloudManager.PausedReconcile)
+		obj.SetAnnotations(annotations)
+
+		err = r.Client.Update(context.TODO(), obj)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to resume %s", obj.GetName())
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReconcileNew requests the restore of a subcloud.  The system controller
+// only restores unmanaged subclouds which are not being deployed.  The System
+// and Host resources of the subcloud, if any, are paused first.
+func (r *RestoreReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.Restore, subcloud *subclouds.Subcloud) error {
+	if subcloud.InProgress() {
+		msg := fmt.Sprintf("waiting for subcloud %s to finish %s", subcloud.Name, subcloud.DeployStatus)
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	if subcloud.ManagementState == subclouds.ManagementManaged {
+		return r.updateManagementState(client, instance, subclouds.ManagementUnmanaged)
+	}
+
+	resource, err := getSubcloudResource(r.Client, instance.Namespace, instance.Spec.Subcloud)
+	if err != nil {
+		return err
+	}
+
+	password, err := getSubcloudSysadminPassword(r.Client, resource)
+	if err != nil {
+		return err
+	}
+
+	err = r.PauseSystemResources(instance)
+	if err != nil {
+		return err
+	}
+
+	opts := subclouds.RestoreOpts{
+		Subcloud:         instance.Spec.Subcloud,
+		SysadminPassword: password,
+		WithInstall:      instance.Spec.Reinstall,
+		LocalOnly:        instance.Spec.BackupLocation == starlingxv1.RestoreLocationLocal,
+		RegistryImages:   instance.Spec.RegistryImages,
+		Release:          instance.Spec.Release,
+	}
+
+	if instance.Spec.RestoreValues != nil {
+		opts.RestoreValues = []byte(*instance.Spec.RestoreValues)
+	}
+
+	logRestore.Info("restoring subcloud", "subcloud", opts.Subcloud,
+		"reinstall", opts.WithInstall, "local", opts.LocalOnly)
+
+	err = subclouds.Restore(client, opts).ExtractErr()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to restore subcloud: %s", instance.Spec.Subcloud)
+		return err
+	}
+
+	instance.Status.Phase = starlingxv1.RestorePhaseRequested
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+		"restore of subcloud %s has been requested", instance.Spec.Subcloud)
+
+	return nil
+}
+
+// ReconcileExisting follows the progress of a requested restore.  Once it
+// has completed the subcloud is managed again if its Subcloud resource
+// requests it, and its System and Host resources are released, so that
+// normal reconciliation resumes.  A failed restore is reported but not
+// retried until the resource is modified; the System and Host resources are
+// left paused so that the subcloud can be examined.
+func (r *RestoreReconciler) ReconcileExisting(client *gophercloud.ServiceClient, instance *starlingxv1.Restore, subcloud *subclouds.Subcloud) error {
+	status := &instance.Status
+
+	if status.Phase == starlingxv1.RestorePhaseRequested {
+		if !restoreStarted(subcloud) {
+			return nil
+		}
+
+		status.Phase = starlingxv1.RestorePhaseRestoring
+	}
+
+	switch {
+	case subcloud.InProgress():
+		return nil
+
+	case subcloud.Failed():
+		status.Phase = starlingxv1.RestorePhaseFailed
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"restore of subcloud %s has stopped in state %s: %s",
+			subcloud.Name, subcloud.DeployStatus, subcloud.ErrorDescription)
+		return nil
+
+	case subcloud.DeployStatus != subclouds.DeployComplete:
+		return nil
+	}
+
+	resource, err := getSubcloudResource(r.Client, instance.Namespace, instance.Spec.Subcloud)
+	if err != nil {
+		return err
+	}
+
+	if resource.Spec.Manage && subcloud.ManagementState != subclouds.ManagementManaged {
+		if subcloud.AvailabilityStatus != subclouds.AvailabilityOnline {
+			// The subcloud can only be managed once it is reachable again.
+			return nil
+		}
+
+		err = r.updateManagementState(client, instance, subclouds.ManagementManaged)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = r.ReleaseSystemResources(instance)
+	if err != nil {
+		return err
+	}
+
+	status.Phase = starlingxv1.RestorePhaseCompleted
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"restore of subcloud %s has completed", subcloud.Name)
+
+	// Kick the Subcloud resource so that it resumes reconciling the subcloud.
+	return r.CloudManager.NotifyResource(resource)
+}
+
+// statusUpdateRequired is a utility function which determines whether an
+// update is required to the restore status attribute.  Updating this
+// unnecessarily will result in an infinite reconciliation loop.
+func (r *RestoreReconciler) statusUpdateRequired(instance *starlingxv1.Restore, previous string, subcloud *subclouds.Subcloud, inSync bool) (result bool) {
+	status := &instance.Status
+
+	if status.Phase != previous {
+		result = true
+	}
+
+	if subcloud != nil {
+		if status.DeployStatus != subcloud.DeployStatus {
+			status.DeployStatus = subcloud.DeployStatus
+			result = true
+		}
+
+		if status.ErrorDescription != subcloud.ErrorDescription {
+			status.ErrorDescription = subcloud.ErrorDescription
+			result = true
+		}
+	}
+
+	if status.Phase == starlingxv1.RestorePhaseCompleted && !status.Reconciled {
+		// Record the fact that the subcloud has been restored for the
+		// current configuration.
+		status.Reconciled = true
+		result = true
+	}
+
+	if status.InSync != inSync {
+		status.InSync = inSync
+		result = true
+	}
+
+	return result
+}
+
+// ReconcileGeneration resets the status of the resource when its
+// configuration has been modified so that the subcloud is restored again.  A
+// restore which is already running is left to finish.
+func (r *RestoreReconciler) ReconcileGeneration(instance *starlingxv1.Restore) {
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation {
+		return
+	}
+
+	status := &instance.Status
+	if !restoreInProgress(instance) {
+		status.Phase = ""
+		status.Reconciled = false
+		status.ObservedGeneration = instance.ObjectMeta.Generation
+	}
+}
+
+// ReconcileResource interacts with the dcmanager API in order to restore a
+// subcloud and follow the restore until it has completed.
+func (r *RestoreReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.Restore) error {
+	subcloud, err := subclouds.GetSubcloud(client, instance.Spec.Subcloud)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get subcloud: %s", instance.Spec.Subcloud)
+		return err
+	}
+
+	previous := instance.Status.Phase
+
+	r.ReconcileGeneration(instance)
+
+	switch {
+	case subcloud == nil:
+		msg := fmt.Sprintf("waiting for subcloud %q to be added", instance.Spec.Subcloud)
+		err = common.NewResourceStatusDependency(msg)
+
+	case instance.Status.Phase == "":
+		err = r.ReconcileNew(client, instance, subcloud)
+
+	case restoreInProgress(instance):
+		err = r.ReconcileExisting(client, instance, subcloud)
+	}
+
+	inSync := err == nil
+
+	if instance.Status.InSync != inSync {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	if r.statusUpdateRequired(instance, previous, subcloud, inSync) {
+		logRestore.Info("updating restore", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	if err == nil && subcloud != nil && !instance.Status.Reconciled &&
+		instance.Status.Phase != starlingxv1.RestorePhaseFailed {
+		// The system controller runs the restore on its own; keep the status
+		// current until it has finished.
+		msg := fmt.Sprintf("waiting for subcloud %s to be restored", subcloud.Name)
+		m := NewRestoreProgressMonitor(instance, subcloud)
+		return r.CloudManager.StartMonitor(m, msg)
+	}
+
+	return err
+}
+
+// Reconcile reads that state of the cluster for a Restore object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=restores,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=restores/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=restores/finalizers,verbs=update
+func (r *RestoreReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logRestore
+	logRestore = logRestore.WithName(request.NamespacedName.String())
+	defer func() { logRestore = savedLog }()
+
+	// Fetch the Restore instance
+	instance := &starlingxv1.Restore{}
+	err := r.Client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logRestore.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		// A restore cannot be undone; deleting the resource leaves the
+		// subcloud as it is.
+		return reconcile.Result{}, nil
+	}
+
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
+		(instance.Status.Reconciled || instance.Status.Phase == starlingxv1.RestorePhaseFailed) {
+		return ctrl.Result{}, nil
+	}
+
+	if !utils.IsReconcilerEnabled(utils.Restore) {
+		return reconcile.Result{}, nil
+	}
+
+	if r.GetPlatformClient(request.Namespace) == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	if !r.CloudManager.GetSystemReady(request.Namespace) {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	dcClient := r.CloudManager.GetDistributedCloudClient(request.Namespace)
+	if dcClient == nil {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for dcmanager client creation")
+		return common.RetryTransientError, nil
+	}
+
+	dcClient = cloudManager.WithChangeInitiator(dcClient, instance)
+	err = r.ReconcileResource(dcClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if err != nil {
+		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logRestore}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(RestoreControllerName), logRestore)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.Restore{}).
+		Complete(r)
+}
+
+// DefaultRestoreProgressMonitorInterval represents the default interval
+// between polling attempts to check whether a subcloud restore has
+// progressed.  Reinstalling and restoring a subcloud takes a long time so
+// there is no point in polling frequently.
+const DefaultRestoreProgressMonitorInterval = time.Minute
+
+// restoreProgressMonitor waits for the deployment, availability or
+// management state of a subcloud being restored to change.  Once it has a
+// reconcilable event is generated to kick the reconciler so that the
+// restore can move on.
+type restoreProgressMonitor struct {
+	cloudManager.CommonMonitorBody
+	manager      cloudManager.CloudManager
+	namespace    string
+	name         string
+	deployStatus string
+	availability string
+	management   string
+}
+
+// NewRestoreProgressMonitor defines a convenience function to instantiate a
+// new restore progress monitor with all required attributes.
+func NewRestoreProgressMonitor(instance *starlingxv1.Restore, subcloud *subclouds.Subcloud) *cloudManager.Monitor {
+	logger := logRestore.WithName("progress-monitor")
+	return &cloudManager.Monitor{
+		MonitorBody: &restoreProgressMonitor{
+			namespace:    instance.Namespace,
+			name:         subcloud.Name,
+			deployStatus: subcloud.DeployStatus,
+			availability: subcloud.AvailabilityStatus,
+			management:   subcloud.ManagementState,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultRestoreProgressMonitorInterval,
+	}
+}
+
+// SetManager implements the MonitorManager interface so that the dcmanager
+// client can be retrieved since the monitor framework only supplies the
+// platform client.
+func (m *restoreProgressMonitor) SetManager(manager cloudManager.CloudManager) {
+	m.manager = manager
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *restoreProgressMonitor) Run(_ *gophercloud.ServiceClient) (stop bool, err error) {
+	client := m.manager.GetDistributedCloudClient(m.namespace)
+	if client == nil {
+		m.CommonMonitorBody.SetState("waiting for dcmanager client creation")
+		return false, nil
+	}
+
+	subcloud, err := subclouds.GetSubcloud(client, m.name)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to get subcloud %s: %s", m.name, err.Error())
+		return false, err
+	}
+
+	if subcloud == nil {
+		m.CommonMonitorBody.SetState("subcloud %s no longer exists", m.name)
+		return true, nil
+	}
+
+	if subcloud.DeployStatus != m.deployStatus ||
+		subcloud.AvailabilityStatus != m.availability ||
+		subcloud.ManagementState != m.management {
+		m.CommonMonitorBody.SetState("subcloud %s has progressed to %s (%s/%s)", m.name,
+			subcloud.DeployStatus, subcloud.ManagementState, subcloud.AvailabilityStatus)
+		return true, nil
+	}
+
+	m.CommonMonitorBody.SetState("waiting for subcloud %s to progress from %s (%s/%s)",
+		m.name, m.deployStatus, m.management, m.availability)
+
+	return false, nil
+}
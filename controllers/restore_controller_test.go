@@ -0,0 +1,151 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/subclouds"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Restore controller", func() {
+
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	Context("Restore with data", func() {
+		It("Should created successfully", func() {
+			ctx := context.Background()
+			key := types.NamespacedName{
+				Name:      "subcloud1-restore",
+				Namespace: "default",
+			}
+
+			created := &starlingxv1.Restore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "subcloud1-restore",
+					Namespace: "default",
+				},
+				Spec: starlingxv1.RestoreSpec{
+					Subcloud: "subcloud1",
+				}}
+			Expect(k8sClient.Create(ctx, created)).To(Succeed())
+
+			fetched := &starlingxv1.Restore{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, key, fetched)
+				return err == nil
+			}, timeout, interval).Should(BeTrue())
+			Expect(fetched.Spec.BackupLocation).To(Equal(starlingxv1.RestoreLocationCentral))
+			Expect(fetched.Spec.Reinstall).To(BeTrue())
+		})
+	})
+
+	Context("Restore status", func() {
+		It("Should only be started once the system controller reports it", func() {
+			subcloud := &subclouds.Subcloud{DeployStatus: subclouds.DeployComplete}
+			Expect(restoreStarted(subcloud)).To(BeFalse())
+
+			subcloud.DeployStatus = subclouds.DeployInstalling
+			Expect(restoreStarted(subcloud)).To(BeTrue())
+
+			subcloud.DeployStatus = subclouds.DeployRestoring
+			Expect(restoreStarted(subcloud)).To(BeTrue())
+			Expect(subcloud.InProgress()).To(BeTrue())
+		})
+
+		It("Should only be reconciled once completed", func() {
+			r := &RestoreReconciler{}
+			instance := &starlingxv1.Restore{}
+			instance.Status.Phase = starlingxv1.RestorePhaseRestoring
+			subcloud := &subclouds.Subcloud{DeployStatus: subclouds.DeployRestoring}
+
+			Expect(r.statusUpdateRequired(instance, "", subcloud, true)).To(BeTrue())
+			Expect(instance.Status.DeployStatus).To(Equal(subclouds.DeployRestoring))
+			Expect(instance.Status.Reconciled).To(BeFalse())
+			Expect(r.statusUpdateRequired(instance, instance.Status.Phase, subcloud, true)).To(BeFalse())
+
+			instance.Status.Phase = starlingxv1.RestorePhaseCompleted
+			subcloud.DeployStatus = subclouds.DeployComplete
+			Expect(r.statusUpdateRequired(instance, starlingxv1.RestorePhaseRestoring, subcloud, true)).To(BeTrue())
+			Expect(instance.Status.Reconciled).To(BeTrue())
+		})
+
+		It("Should not restart a restore which is running", func() {
+			r := &RestoreReconciler{}
+			instance := &starlingxv1.Restore{}
+			instance.Generation = 2
+			instance.Status.ObservedGeneration = 1
+			instance.Status.Phase = starlingxv1.RestorePhaseRestoring
+
+			r.ReconcileGeneration(instance)
+			Expect(instance.Status.Phase).To(Equal(starlingxv1.RestorePhaseRestoring))
+
+			instance.Status.Phase = starlingxv1.RestorePhaseFailed
+			r.ReconcileGeneration(instance)
+			Expect(instance.Status.Phase).To(BeEmpty())
+			Expect(instance.Status.ObservedGeneration).To(Equal(int64(2)))
+		})
+	})
+
+	Context("Restore of a managed system", func() {
+		It("Should pause the system resources until the restore has completed", func() {
+			namespace := "subcloud1"
+			instance := &starlingxv1.Restore{
+				ObjectMeta: metav1.ObjectMeta{Name: "subcloud1-restore", Namespace: "default"},
+				Spec:       starlingxv1.RestoreSpec{Subcloud: "subcloud1", SystemNamespace: &namespace},
+			}
+			system := &starlingxv1.System{
+				ObjectMeta: metav1.ObjectMeta{Name: "subcloud1", Namespace: namespace},
+				Status:     starlingxv1.SystemStatus{Reconciled: true},
+			}
+			controller0 := &starlingxv1.Host{
+				ObjectMeta: metav1.ObjectMeta{Name: "controller-0", Namespace: namespace},
+				Status:     starlingxv1.HostStatus{Reconciled: true},
+			}
+			worker0 := &starlingxv1.Host{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "worker-0",
+					Namespace:   namespace,
+					Annotations: map[string]string{cloudManager.PausedReconcile: "true"},
+				},
+				Status: starlingxv1.HostStatus{Reconciled: true},
+			}
+			r := &RestoreReconciler{Client: ptpTestClient(system, controller0, worker0)}
+
+			Expect(r.PauseSystemResources(instance)).To(Succeed())
+
+			host := &starlingxv1.Host{}
+			Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(controller0), host)).To(Succeed())
+			Expect(host.Annotations[cloudManager.PausedReconcile]).To(Equal("restore/default/subcloud1-restore"))
+			Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(worker0), host)).To(Succeed())
+			Expect(host.Annotations[cloudManager.PausedReconcile]).To(Equal("true"))
+
+			Expect(r.ReleaseSystemResources(instance)).To(Succeed())
+
+			Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(controller0), host)).To(Succeed())
+			Expect(host.Annotations).ToNot(HaveKey(cloudManager.PausedReconcile))
+			Expect(host.Status.Reconciled).To(BeFalse())
+
+			Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(worker0), host)).To(Succeed())
+			Expect(host.Annotations[cloudManager.PausedReconcile]).To(Equal("true"))
+			Expect(host.Status.Reconciled).To(BeTrue())
+
+			fetched := &starlingxv1.System{}
+			Expect(r.Client.Get(context.TODO(), client.ObjectKeyFromObject(system), fetched)).To(Succeed())
+			Expect(fetched.Annotations).ToNot(HaveKey(cloudManager.PausedReconcile))
+			Expect(fetched.Status.Reconciled).To(BeFalse())
+		})
+	})
+})
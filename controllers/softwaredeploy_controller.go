@@ -0,0 +1,661 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/software"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logSoftwareDeploy = log.Log.WithName("controller").WithName("softwaredeploy")
+
+const SoftwareDeployControllerName = "softwaredeploy-controller"
+
+var _ reconcile.Reconciler = &SoftwareDeployReconciler{}
+
+// SoftwareDeployReconciler reconciles a SoftwareDeploy object
+type SoftwareDeployReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+}
+
+// deployHostGroup defines the hosts of a single personality along with how
+// the release is deployed to them.
+type deployHostGroup struct {
+	personality string
+	applyType   string
+	maxParallel int
+}
+
+// deployHostGroups returns the host groups in the order in which the release
+// is deployed to them.  Controllers are always deployed first and workers
+// last.
+func deployHostGroups(instance *starlingxv1.SoftwareDeploy) []deployHostGroup {
+	spec := instance.Spec
+
+	maxWorkers := 0
+	if spec.MaxParallelWorkerHosts != nil {
+		maxWorkers = *spec.MaxParallelWorkerHosts
+	}
+
+	return []deployHostGroup{
+		{personality: hosts.PersonalityController, applyType: spec.ControllerApplyType},
+		{personality: hosts.PersonalityStorage, applyType: spec.StorageApplyType},
+		{personality: hosts.PersonalityWorker, applyType: spec.WorkerApplyType, maxParallel: maxWorkers},
+	}
+}
+
+// findRelease returns the release with the given identifier or nil if it is
+// not known to the system.
+func findRelease(releases []software.Release, id string) *software.Release {
+	for i := range releases {
+		if releases[i].ID == id {
+			return &releases[i]
+		}
+	}
+
+	return nil
+}
+
+// releaseDeployed determines whether a release state indicates that the
+// release has already been deployed to the system.
+func releaseDeployed(state string) bool {
+	return state == software.ReleaseStateDeployed || state == software.ReleaseStateCommitted
+}
+
+// deployInProgress determines whether a deployment is moving on its own or
+// is waiting on this controller to move it to its next step.
+func deployInProgress(deploy *software.Deploy) bool {
+	return deploy != nil && !deploy.Failed() && deploy.State != software.DeployStateCompleted
+}
+
+// nextDeployHosts selects the hosts to which the release must be deployed
+// next.  Each host group is only started once the hosts of the previous
+// groups have all been deployed, and within a group no more than one host is
+// deployed at a time unless the group is deployed in parallel.  Groups with
+// the "ignore" apply type are left for the operator to deploy.  Failed hosts
+// are never retried and prevent the next groups from being started.
+func nextDeployHosts(instance *starlingxv1.SoftwareDeploy, personalities map[string]string, states []software.DeployHostState) []string {
+	for _, group := range deployHostGroups(instance) {
+		if group.applyType == starlingxv1.ApplyTypeIgnore {
+			continue
+		}
+
+		pending := make([]string, 0)
+		inProgress := 0
+		done := true
+
+		for _, state := range states {
+			if personalities[state.Hostname] != group.personality {
+				continue
+			}
+
+			switch state.State {
+			case software.HostStateDeployed:
+				continue
+			case software.HostStatePending:
+				pending = append(pending, state.Hostname)
+			default:
+				inProgress++
+			}
+
+			done = false
+		}
+
+		if done {
+			continue
+		}
+
+		limit := 1
+		if group.applyType == starlingxv1.ApplyTypeParallel {
+			limit = len(pending) + inProgress
+			if group.maxParallel > 0 && group.maxParallel < limit {
+				limit = group.maxParallel
+			}
+		}
+
+		sort.Strings(pending)
+
+		if available := limit - inProgress; available < len(pending) {
+			if available < 0 {
+				available = 0
+			}
+			pending = pending[:available]
+		}
+
+		return pending
+	}
+
+	return nil
+}
+
+// ReconcileUpload uploads the release files when the release is not already
+// known to the system.
+func (r *SoftwareDeployReconciler) ReconcileUpload(client *gophercloud.ServiceClient, instance *starlingxv1.SoftwareDeploy) error {
+	if len(instance.Spec.Files) == 0 {
+		msg := fmt.Sprintf("release %s is not known to the system and no release files have been specified",
+			instance.Spec.Release)
+		return common.NewUserDataError(msg)
+	}
+
+	logSoftwareDeploy.Info("uploading release", "files", instance.Spec.Files)
+
+	err := software.Upload(client, instance.Spec.Files).ExtractErr()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to upload release files: %s",
+			strings.Join(instance.Spec.Files, ", "))
+		return err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+		"release %s has been uploaded", instance.Spec.Release)
+
+	return nil
+}
+
+// ReconcileHosts deploys the release to the next hosts according to the apply
+// type of each host group.  Releases which require a reboot are only
+// deployed to hosts which have been locked; the lock and unlock of each host
+// are left to the operator.
+func (r *SoftwareDeployReconciler) ReconcileHosts(platformClient *gophercloud.ServiceClient, client *gophercloud.ServiceClient, instance *starlingxv1.SoftwareDeploy, deploy *software.Deploy, states []software.DeployHostState) error {
+	objects, err := hosts.ListHosts(platformClient)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return err
+	}
+
+	personalities := make(map[string]string)
+	locked := make(map[string]bool)
+	for _, h := range objects {
+		personalities[h.Hostname] = h.Personality
+		locked[h.Hostname] = h.AdministrativeState == hosts.AdminLocked
+	}
+
+	unlocked := make([]string, 0)
+	for _, hostname := range nextDeployHosts(instance, personalities, states) {
+		if deploy.RebootRequired && !locked[hostname] {
+			unlocked = append(unlocked, hostname)
+			continue
+		}
+
+		logSoftwareDeploy.Info("deploying release to host", "hostname", hostname)
+
+		err = software.DeployHost(client, hostname).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to deploy release to host: %s", hostname)
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"release %s is being deployed to host %s", instance.Spec.Release, hostname)
+	}
+
+	if len(unlocked) > 0 {
+		msg := fmt.Sprintf("waiting for hosts to be locked before deploying release: %s",
+			strings.Join(unlocked, ", "))
+		return common.NewResourceStatusDependency(msg)
+	}
+
+	return nil
+}
+
+// ReconcileExisting is a method which handles moving an existing deployment
+// thru its lifecycle.  The hosts are deployed once the deployment has
+// started, the deployment is then activated and completed, and it is finally
+// removed from the system so that the next release can be deployed.  Failed
+// deployments are left on the system so that they can be examined.
+func (r *SoftwareDeployReconciler) ReconcileExisting(platformClient *gophercloud.ServiceClient, client *gophercloud.ServiceClient, instance *starlingxv1.SoftwareDeploy, deploy *software.Deploy, states []software.DeployHostState) error {
+	switch {
+	case deploy.State == software.DeployStateStartDone || deploy.State == software.DeployStateHost:
+		return r.ReconcileHosts(platformClient, client, instance, deploy, states)
+
+	case deploy.State == software.DeployStateHostDone:
+		logSoftwareDeploy.Info("activating release", "release", deploy.ToRelease)
+
+		err := software.Action(client, software.ActionActivate).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to activate release: %s", deploy.ToRelease)
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"release %s is being activated", deploy.ToRelease)
+
+	case deploy.State == software.DeployStateActivateDone:
+		logSoftwareDeploy.Info("completing release deployment", "release", deploy.ToRelease)
+
+		err := software.Action(client, software.ActionComplete).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to complete release deployment: %s", deploy.ToRelease)
+			return err
+		}
+
+	case deploy.State == software.DeployStateCompleted:
+		err := software.Delete(client).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to delete release deployment: %s", deploy.ToRelease)
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"release %s has been deployed", deploy.ToRelease)
+
+	case deploy.Failed() && instance.Status.State != deploy.State:
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"release %s deployment has stopped in state %s", deploy.ToRelease, deploy.State)
+	}
+
+	return nil
+}
+
+// ReconcileNew is a method which handles starting the deployment of a
+// release which has been uploaded to the system.
+func (r *SoftwareDeployReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.SoftwareDeploy, release *software.Release) (*software.Deploy, error) {
+	if release.State != software.ReleaseStateAvailable {
+		msg := fmt.Sprintf("waiting for release %s to become available: %s", release.ID, release.State)
+		return nil, common.NewResourceStatusDependency(msg)
+	}
+
+	logSoftwareDeploy.Info("starting release deployment", "release", release.ID)
+
+	err := software.Start(client, release.ID).ExtractErr()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to start release deployment: %s", release.ID)
+		return nil, err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+		"release %s deployment has been started", release.ID)
+
+	deploy, err := software.GetDeploy(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to get release deployment")
+		return nil, err
+	}
+
+	return deploy, nil
+}
+
+// statusUpdateRequired is a utility function which determines whether an
+// update is required to the software deployment status attribute.  Updating
+// this unnecessarily will result in an infinite reconciliation loop.
+func (r *SoftwareDeployReconciler) statusUpdateRequired(instance *starlingxv1.SoftwareDeploy, state string, states []software.DeployHostState, reason string, inSync bool) (result bool) {
+	status := &instance.Status
+
+	if status.State != state {
+		status.State = state
+		result = true
+	}
+
+	hostStatus := make([]starlingxv1.SoftwareDeployHostStatus, 0)
+	for _, s := range states {
+		hostStatus = append(hostStatus, starlingxv1.SoftwareDeployHostStatus{
+			Hostname: s.Hostname,
+			State:    s.State,
+		})
+	}
+
+	sort.Slice(hostStatus, func(i, j int) bool {
+		return hostStatus[i].Hostname < hostStatus[j].Hostname
+	})
+
+	if len(hostStatus) != len(status.Hosts) {
+		status.Hosts = hostStatus
+		result = true
+	} else {
+		for i := range hostStatus {
+			if hostStatus[i] != status.Hosts[i] {
+				status.Hosts = hostStatus
+				result = true
+				break
+			}
+		}
+	}
+
+	if status.Reason != reason {
+		status.Reason = reason
+		result = true
+	}
+
+	if releaseDeployed(state) && !status.Reconciled {
+		// Record the fact that the release has been deployed for the
+		// current configuration.
+		status.Reconciled = true
+		result = true
+	}
+
+	if status.InSync != inSync {
+		status.InSync = inSync
+		result = true
+	}
+
+	return result
+}
+
+// ReconcileGeneration resets the status of the resource when its
+// configuration has been modified so that the release is deployed again.
+func (r *SoftwareDeployReconciler) ReconcileGeneration(instance *starlingxv1.SoftwareDeploy) {
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation {
+		return
+	}
+
+	status := &instance.Status
+	status.State = ""
+	status.Hosts = nil
+	status.Reason = ""
+	status.Reconciled = false
+	status.ObservedGeneration = instance.ObjectMeta.Generation
+}
+
+// reconcileDeploy moves the release deployment to its next step and returns
+// the state to be reported in the resource status.
+func (r *SoftwareDeployReconciler) reconcileDeploy(platformClient *gophercloud.ServiceClient, client *gophercloud.ServiceClient, instance *starlingxv1.SoftwareDeploy, states *[]software.DeployHostState) (*software.Deploy, string, error) {
+	releases, err := software.ListReleaseStates(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list releases")
+		return nil, "", err
+	}
+
+	release := findRelease(releases, instance.Spec.Release)
+	if release == nil {
+		err = r.ReconcileUpload(client, instance)
+		if err == nil {
+			msg := fmt.Sprintf("waiting for release %s to be uploaded", instance.Spec.Release)
+			err = common.NewResourceStatusDependency(msg)
+		}
+		return nil, "", err
+	}
+
+	deploy, err := software.GetDeploy(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to get release deployment")
+		return nil, release.State, err
+	}
+
+	if deploy != nil && deploy.ToRelease != release.ID {
+		// USM only supports a single deployment at a time therefore wait
+		// for the other deployment to be removed.
+		msg := fmt.Sprintf("another release deployment already exists: %s (%s)",
+			deploy.ToRelease, deploy.State)
+		return nil, release.State, common.NewResourceConfigurationDependency(msg)
+	}
+
+	if deploy == nil {
+		if releaseDeployed(release.State) {
+			return nil, release.State, nil
+		}
+
+		deploy, err = r.ReconcileNew(client, instance, release)
+		if err != nil || deploy == nil {
+			return nil, release.State, err
+		}
+	}
+
+	*states, err = software.ListHostStates(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list host deployment states")
+		return deploy, deploy.State, err
+	}
+
+	err = r.ReconcileExisting(platformClient, client, instance, deploy, *states)
+	if err == nil && deploy.State == software.DeployStateCompleted {
+		// The deployment has been removed from the system.
+		return nil, software.ReleaseStateDeployed, nil
+	}
+
+	return deploy, deploy.State, err
+}
+
+// ReconcileResource interacts with the USM API in order to reconcile the
+// state of a release deployment with the state stored in the k8s database.
+func (r *SoftwareDeployReconciler) ReconcileResource(platformClient *gophercloud.ServiceClient, client *gophercloud.ServiceClient, instance *starlingxv1.SoftwareDeploy) error {
+	r.ReconcileGeneration(instance)
+
+	states := make([]software.DeployHostState, 0)
+	deploy, state, err := r.reconcileDeploy(platformClient, client, instance, &states)
+
+	inSync := err == nil
+
+	if instance.Status.InSync != inSync {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	reason := ""
+	if err != nil {
+		reason = err.Error()
+	}
+
+	if r.statusUpdateRequired(instance, state, states, reason, inSync) {
+		logSoftwareDeploy.Info("updating software deployment", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	if err == nil && deployInProgress(deploy) {
+		// USM runs each step on its own; keep the status current until it
+		// has finished.
+		msg := fmt.Sprintf("waiting for release %s deployment to progress", instance.Spec.Release)
+		m := NewSoftwareDeployProgressMonitor(instance, deploy, states)
+		return r.CloudManager.StartMonitor(m, msg)
+	}
+
+	return err
+}
+
+// Reconcile reads that state of the cluster for a SoftwareDeploy object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=softwaredeploys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=softwaredeploys/status,verbs=get;update;patch
+func (r *SoftwareDeployReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logSoftwareDeploy
+	logSoftwareDeploy = logSoftwareDeploy.WithName(request.NamespacedName.String())
+	defer func() { logSoftwareDeploy = savedLog }()
+
+	// Fetch the SoftwareDeploy instance
+	instance := &starlingxv1.SoftwareDeploy{}
+	err := r.Client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logSoftwareDeploy.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		// A deployment which is in progress is left on the system since
+		// aborting it requires the hosts to be rolled back by the operator.
+		return reconcile.Result{}, nil
+	}
+
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
+		instance.Status.Reconciled {
+		return ctrl.Result{}, nil
+	}
+
+	if !utils.IsReconcilerEnabled(utils.SoftwareDeploy) {
+		return reconcile.Result{}, nil
+	}
+
+	platformClient := r.GetPlatformClient(request.Namespace)
+	if platformClient == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	if !r.CloudManager.GetSystemReady(request.Namespace) {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	usmClient := r.CloudManager.GetSoftwareClient(request.Namespace)
+	if usmClient == nil {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for usm client creation")
+		return common.RetryTransientError, nil
+	}
+
+	platformClient = cloudManager.WithChangeInitiator(platformClient, instance)
+	usmClient = cloudManager.WithChangeInitiator(usmClient, instance)
+	err = r.ReconcileResource(platformClient, usmClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if err != nil {
+		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SoftwareDeployReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logSoftwareDeploy}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(SoftwareDeployControllerName), logSoftwareDeploy)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.SoftwareDeploy{}).
+		Complete(r)
+}
+
+// DefaultSoftwareDeployProgressMonitorInterval represents the default
+// interval between polling attempts to check whether a release deployment
+// has progressed.  Deploying a release to a host can take several minutes
+// so there is no point in polling frequently.
+const DefaultSoftwareDeployProgressMonitorInterval = 30 * time.Second
+
+// softwareDeployProgressMonitor waits for a release deployment to change
+// state or for the state of any of its hosts to change.  Once it has a
+// reconcilable event is generated to kick the reconciler so that the next
+// step can be run and the status can be refreshed.
+type softwareDeployProgressMonitor struct {
+	cloudManager.CommonMonitorBody
+	manager   cloudManager.CloudManager
+	namespace string
+	state     string
+	hosts     map[string]string
+}
+
+// NewSoftwareDeployProgressMonitor defines a convenience function to
+// instantiate a new release deployment progress monitor with all required
+// attributes.
+func NewSoftwareDeployProgressMonitor(instance *starlingxv1.SoftwareDeploy, deploy *software.Deploy, states []software.DeployHostState) *cloudManager.Monitor {
+	logger := logSoftwareDeploy.WithName("progress-monitor")
+
+	hostStates := make(map[string]string)
+	for _, s := range states {
+		hostStates[s.Hostname] = s.State
+	}
+
+	return &cloudManager.Monitor{
+		MonitorBody: &softwareDeployProgressMonitor{
+			namespace: instance.Namespace,
+			state:     deploy.State,
+			hosts:     hostStates,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultSoftwareDeployProgressMonitorInterval,
+	}
+}
+
+// SetManager implements the MonitorManager interface so that the USM client
+// can be retrieved since the monitor framework only supplies the platform
+// client.
+func (m *softwareDeployProgressMonitor) SetManager(manager cloudManager.CloudManager) {
+	m.manager = manager
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *softwareDeployProgressMonitor) Run(_ *gophercloud.ServiceClient) (stop bool, err error) {
+	client := m.manager.GetSoftwareClient(m.namespace)
+	if client == nil {
+		m.CommonMonitorBody.SetState("waiting for usm client creation")
+		return false, nil
+	}
+
+	deploy, err := software.GetDeploy(client)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to get release deployment: %s", err.Error())
+		return false, err
+	}
+
+	if deploy == nil {
+		m.CommonMonitorBody.SetState("release deployment no longer exists")
+		return true, nil
+	}
+
+	if deploy.State != m.state {
+		m.CommonMonitorBody.SetState("release deployment has progressed to %s", deploy.State)
+		return true, nil
+	}
+
+	states, err := software.ListHostStates(client)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to list host deployment states: %s", err.Error())
+		return false, err
+	}
+
+	for _, s := range states {
+		if m.hosts[s.Hostname] != s.State {
+			m.CommonMonitorBody.SetState("host %s deployment has progressed to %s",
+				s.Hostname, s.State)
+			return true, nil
+		}
+	}
+
+	m.CommonMonitorBody.SetState("waiting for release deployment to progress from %s", m.state)
+
+	return false, nil
+}
@@ -0,0 +1,130 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/software"
+)
+
+var _ = Describe("SoftwareDeploy controller", func() {
+
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	Context("SoftwareDeploy with data", func() {
+		It("Should created successfully", func() {
+			ctx := context.Background()
+			key := types.NamespacedName{
+				Name:      "foo",
+				Namespace: "default",
+			}
+
+			created := &starlingxv1.SoftwareDeploy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Spec: starlingxv1.SoftwareDeploySpec{
+					Release:             "stx-10.0.1",
+					ControllerApplyType: starlingxv1.ApplyTypeSerial,
+					StorageApplyType:    starlingxv1.ApplyTypeSerial,
+					WorkerApplyType:     starlingxv1.ApplyTypeSerial,
+				}}
+			Expect(k8sClient.Create(ctx, created)).To(Succeed())
+
+			fetched := &starlingxv1.SoftwareDeploy{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, key, fetched)
+				return err == nil
+			}, timeout, interval).Should(BeTrue())
+			Expect(fetched.Spec.Release).To(Equal("stx-10.0.1"))
+		})
+	})
+
+	Context("SoftwareDeploy host rollout", func() {
+		personalities := map[string]string{
+			"controller-0": "controller",
+			"controller-1": "controller",
+			"worker-0":     "worker",
+			"worker-1":     "worker",
+			"worker-2":     "worker",
+		}
+
+		It("Should deploy controllers before workers", func() {
+			instance := &starlingxv1.SoftwareDeploy{
+				Spec: starlingxv1.SoftwareDeploySpec{
+					ControllerApplyType: starlingxv1.ApplyTypeSerial,
+					WorkerApplyType:     starlingxv1.ApplyTypeParallel,
+				},
+			}
+			states := []software.DeployHostState{
+				{Hostname: "worker-0", State: software.HostStatePending},
+				{Hostname: "controller-1", State: software.HostStatePending},
+				{Hostname: "controller-0", State: software.HostStatePending},
+			}
+
+			Expect(nextDeployHosts(instance, personalities, states)).To(Equal([]string{"controller-0"}))
+
+			states[2].State = software.HostStateDeploying
+			Expect(nextDeployHosts(instance, personalities, states)).To(BeEmpty())
+
+			states[1].State = software.HostStateDeployed
+			states[2].State = software.HostStateDeployed
+			Expect(nextDeployHosts(instance, personalities, states)).To(Equal([]string{"worker-0"}))
+		})
+
+		It("Should limit the number of parallel worker hosts", func() {
+			maxHosts := 2
+			instance := &starlingxv1.SoftwareDeploy{
+				Spec: starlingxv1.SoftwareDeploySpec{
+					ControllerApplyType:    starlingxv1.ApplyTypeIgnore,
+					WorkerApplyType:        starlingxv1.ApplyTypeParallel,
+					MaxParallelWorkerHosts: &maxHosts,
+				},
+			}
+			states := []software.DeployHostState{
+				{Hostname: "controller-0", State: software.HostStatePending},
+				{Hostname: "worker-2", State: software.HostStatePending},
+				{Hostname: "worker-1", State: software.HostStateDeploying},
+				{Hostname: "worker-0", State: software.HostStatePending},
+			}
+
+			Expect(nextDeployHosts(instance, personalities, states)).To(Equal([]string{"worker-0"}))
+		})
+	})
+
+	Context("SoftwareDeploy status", func() {
+		It("Should report the state of each host", func() {
+			r := &SoftwareDeployReconciler{}
+			instance := &starlingxv1.SoftwareDeploy{}
+			states := []software.DeployHostState{
+				{Hostname: "controller-1", State: software.HostStatePending},
+				{Hostname: "controller-0", State: software.HostStateDeployed},
+			}
+
+			Expect(r.statusUpdateRequired(instance, software.DeployStateHost, states, "", true)).To(BeTrue())
+			Expect(instance.Status.State).To(Equal(software.DeployStateHost))
+			Expect(instance.Status.Hosts).To(Equal([]starlingxv1.SoftwareDeployHostStatus{
+				{Hostname: "controller-0", State: software.HostStateDeployed},
+				{Hostname: "controller-1", State: software.HostStatePending},
+			}))
+			Expect(instance.Status.Reconciled).To(BeFalse())
+			Expect(r.statusUpdateRequired(instance, software.DeployStateHost, states, "", true)).To(BeFalse())
+
+			Expect(r.statusUpdateRequired(instance, software.ReleaseStateDeployed, nil, "", true)).To(BeTrue())
+			Expect(instance.Status.Hosts).To(BeEmpty())
+			Expect(instance.Status.Reconciled).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,547 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/strategies"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logStrategy = log.Log.WithName("controller").WithName("strategy")
+
+const StrategyControllerName = "strategy-controller"
+
+const StrategyFinalizerName = "strategy.finalizers.windriver.com"
+
+var _ reconcile.Reconciler = &StrategyReconciler{}
+
+// StrategyReconciler reconciles a Strategy object
+type StrategyReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+}
+
+// strategyOpts is a utility function which converts the strategy spec into
+// the set of options expected by the VIM API.
+func strategyOpts(instance *starlingxv1.Strategy) strategies.StrategyOpts {
+	spec := instance.Spec
+
+	return strategies.StrategyOpts{
+		ControllerApplyType:    spec.ControllerApplyType,
+		StorageApplyType:       spec.StorageApplyType,
+		WorkerApplyType:        spec.WorkerApplyType,
+		MaxParallelWorkerHosts: spec.MaxParallelWorkerHosts,
+		DefaultInstanceAction:  spec.DefaultInstanceAction,
+		AlarmRestrictions:      spec.AlarmRestrictions,
+		ToVersion:              spec.ToVersion,
+	}
+}
+
+// strategyOwned is a utility function which determines whether a strategy
+// found on the system was created for this resource.
+func strategyOwned(instance *starlingxv1.Strategy, strategy *strategies.Strategy) bool {
+	return strategy != nil && instance.Status.ID != nil && *instance.Status.ID == strategy.ID
+}
+
+// deleteStrategy removes a strategy which is no longer needed so that
+// another strategy of the same type can be created.
+func (r *StrategyReconciler) deleteStrategy(client *gophercloud.ServiceClient, instance *starlingxv1.Strategy, strategy *strategies.Strategy) error {
+	logStrategy.Info("deleting strategy", "uuid", strategy.ID, "state", strategy.State)
+
+	err := strategies.Delete(client, instance.Spec.Type).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			err = perrors.Wrapf(err, "failed to delete %s strategy: %s", instance.Spec.Type, strategy.ID)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReconcileNew is a method which handles reconciling a new strategy resource
+// and requests that the VIM build the corresponding strategy.
+func (r *StrategyReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.Strategy) (*strategies.Strategy, error) {
+	opts := strategyOpts(instance)
+
+	logStrategy.Info("creating strategy", "type", instance.Spec.Type, "opts", opts)
+
+	strategy, err := strategies.Create(client, instance.Spec.Type, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to create: %s", common.FormatStruct(opts))
+		return nil, err
+	} else if strategy == nil {
+		msg := fmt.Sprintf("%s strategy was not returned by the system", instance.Spec.Type)
+		return nil, common.NewResourceStatusDependency(msg)
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+		"%s strategy has been created", instance.Spec.Type)
+
+	return strategy, nil
+}
+
+// ReconcileExisting is a method which handles moving an existing strategy
+// thru its lifecycle.  A strategy is applied once it has been built if
+// automatic apply is enabled, and it is removed from the system once it has
+// been applied so that subsequent strategies of the same type can be created.
+// Failed strategies are left on the system so that they can be examined.
+func (r *StrategyReconciler) ReconcileExisting(client *gophercloud.ServiceClient, instance *starlingxv1.Strategy, strategy *strategies.Strategy) error {
+	switch {
+	case strategy.State == strategies.StateReadyToApply && instance.Spec.AutoApply:
+		opts := strategies.StrategyActionOpts{Action: strategies.ActionApplyAll}
+
+		logStrategy.Info("applying strategy", "uuid", strategy.ID)
+
+		result, err := strategies.Action(client, instance.Spec.Type, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to apply %s strategy: %s", instance.Spec.Type, strategy.ID)
+			return err
+		} else if result != nil {
+			*strategy = *result
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"%s strategy is being applied", instance.Spec.Type)
+
+	case strategy.State == strategies.StateApplied:
+		err := r.deleteStrategy(client, instance, strategy)
+		if err != nil {
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"%s strategy has been applied", instance.Spec.Type)
+
+	case strategy.Failed() && instance.Status.State != strategy.State:
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+			"%s strategy has stopped in state %s: %s", instance.Spec.Type,
+			strategy.State, strategy.Phase().Reason)
+	}
+
+	return nil
+}
+
+// ReconciledDeleted is a method which handles reconciling a deleted strategy
+// resource.  A strategy that is being applied is aborted and the system
+// strategy is only deleted once it is no longer running.
+func (r *StrategyReconciler) ReconciledDeleted(client *gophercloud.ServiceClient, instance *starlingxv1.Strategy, strategy *strategies.Strategy) error {
+	if utils.ContainsString(instance.ObjectMeta.Finalizers, StrategyFinalizerName) {
+		if strategyOwned(instance, strategy) {
+			if strategy.State == strategies.StateApplying {
+				opts := strategies.StrategyActionOpts{Action: strategies.ActionAbort}
+
+				logStrategy.Info("aborting strategy", "uuid", strategy.ID)
+
+				_, err := strategies.Action(client, instance.Spec.Type, opts).Extract()
+				if err != nil {
+					err = perrors.Wrapf(err, "failed to abort %s strategy: %s", instance.Spec.Type, strategy.ID)
+					return err
+				}
+
+				r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+					"%s strategy is being aborted", instance.Spec.Type)
+			}
+
+			if strategy.InProgress() {
+				msg := fmt.Sprintf("waiting for %s strategy to stop before deleting it", instance.Spec.Type)
+				m := NewStrategyProgressMonitor(instance, strategy)
+				return r.CloudManager.StartMonitor(m, msg)
+			}
+
+			err := r.deleteStrategy(client, instance, strategy)
+			if err != nil {
+				return err
+			}
+
+			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceDeleted,
+				"%s strategy has been deleted", instance.Spec.Type)
+		}
+
+		// Remove the finalizer so the kubernetes delete operation can continue.
+		instance.ObjectMeta.Finalizers = utils.RemoveString(instance.ObjectMeta.Finalizers, StrategyFinalizerName)
+		if err := r.Client.Update(context.Background(), instance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// statusUpdateRequired is a utility function which determines whether an
+// update is required to the strategy status attribute.  Updating this
+// unnecessarily will result in an infinite reconciliation loop.
+func (r *StrategyReconciler) statusUpdateRequired(instance *starlingxv1.Strategy, strategy *strategies.Strategy, inSync bool) (result bool) {
+	status := &instance.Status
+
+	if strategy != nil {
+		phase := strategy.Phase()
+		stage := ""
+		if phase.CurrentStage < len(phase.Stages) {
+			stage = phase.Stages[phase.CurrentStage].StageName
+		} else if len(phase.Stages) > 0 {
+			stage = phase.Stages[len(phase.Stages)-1].StageName
+		}
+
+		if status.ID == nil || *status.ID != strategy.ID {
+			status.ID = &strategy.ID
+			result = true
+		}
+
+		if status.State != strategy.State {
+			status.State = strategy.State
+			result = true
+		}
+
+		if status.Phase != phase.PhaseName {
+			status.Phase = phase.PhaseName
+			result = true
+		}
+
+		if status.Progress != phase.CompletionPercentage {
+			status.Progress = phase.CompletionPercentage
+			result = true
+		}
+
+		if status.Stage != stage {
+			status.Stage = stage
+			result = true
+		}
+
+		if status.Reason != phase.Reason {
+			status.Reason = phase.Reason
+			result = true
+		}
+
+		if strategy.State == strategies.StateApplied && !status.Reconciled {
+			// Record the fact that the strategy has been applied for the
+			// current configuration.
+			status.Reconciled = true
+			result = true
+		}
+	}
+
+	if status.InSync != inSync {
+		status.InSync = inSync
+		result = true
+	}
+
+	return result
+}
+
+// FindExistingResource retrieves the current strategy of the type managed by
+// this resource.  A strategy which was not created for this resource is only
+// tolerated once this resource has been reconciled.
+func (r *StrategyReconciler) FindExistingResource(client *gophercloud.ServiceClient, instance *starlingxv1.Strategy) (*strategies.Strategy, error) {
+	strategy, err := strategies.GetStrategy(client, instance.Spec.Type)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get %s strategy", instance.Spec.Type)
+		return nil, err
+	}
+
+	if strategy != nil && !strategyOwned(instance, strategy) {
+		if !instance.DeletionTimestamp.IsZero() || instance.Status.Reconciled {
+			return nil, nil
+		}
+
+		// The VIM only supports a single strategy of each type therefore
+		// wait for the other strategy to be removed.
+		msg := fmt.Sprintf("another %s strategy already exists: %s (%s)",
+			instance.Spec.Type, strategy.ID, strategy.State)
+		return nil, common.NewResourceConfigurationDependency(msg)
+	}
+
+	return strategy, nil
+}
+
+// ReconcileGeneration resets the status of the resource when its
+// configuration has been modified so that a new strategy is built.  Any
+// strategy previously created for this resource is removed from the system
+// unless it is still running, in which case the change is deferred.
+func (r *StrategyReconciler) ReconcileGeneration(client *gophercloud.ServiceClient, instance *starlingxv1.Strategy, strategy *strategies.Strategy) (*strategies.Strategy, error) {
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation {
+		return strategy, nil
+	}
+
+	if strategy != nil {
+		if strategy.InProgress() {
+			msg := fmt.Sprintf("waiting for %s strategy to finish before applying changes", instance.Spec.Type)
+			m := NewStrategyProgressMonitor(instance, strategy)
+			return nil, r.CloudManager.StartMonitor(m, msg)
+		}
+
+		err := r.deleteStrategy(client, instance, strategy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status := &instance.Status
+	status.ID = nil
+	status.State = ""
+	status.Phase = ""
+	status.Progress = 0
+	status.Stage = ""
+	status.Reason = ""
+	status.Reconciled = false
+	status.ObservedGeneration = instance.ObjectMeta.Generation
+
+	return nil, nil
+}
+
+// ReconcileResource interacts with the VIM API in order to reconcile the
+// state of a strategy with the state stored in the k8s database.
+func (r *StrategyReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.Strategy) error {
+	strategy, err := r.FindExistingResource(client, instance)
+	if err != nil {
+		return err
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.ReconciledDeleted(client, instance, strategy)
+	}
+
+	strategy, err = r.ReconcileGeneration(client, instance, strategy)
+	if err != nil {
+		return err
+	}
+
+	if strategy == nil {
+		if !instance.Status.Reconciled {
+			strategy, err = r.ReconcileNew(client, instance)
+		}
+	} else {
+		err = r.ReconcileExisting(client, instance, strategy)
+	}
+
+	inSync := err == nil
+
+	if instance.Status.InSync != inSync {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	if r.statusUpdateRequired(instance, strategy, inSync) {
+		logStrategy.Info("updating strategy", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	if err == nil && strategy != nil && (strategy.InProgress() ||
+		(strategy.State == strategies.StateReadyToApply && instance.Spec.AutoApply)) {
+		// The VIM runs the strategy on its own; keep the status current
+		// until it has finished.
+		msg := fmt.Sprintf("waiting for %s strategy to progress", instance.Spec.Type)
+		m := NewStrategyProgressMonitor(instance, strategy)
+		return r.CloudManager.StartMonitor(m, msg)
+	}
+
+	return err
+}
+
+// Reconcile reads that state of the cluster for a Strategy object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=strategies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=strategies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=strategies/finalizers,verbs=update
+func (r *StrategyReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logStrategy
+	logStrategy = logStrategy.WithName(request.NamespacedName.String())
+	defer func() { logStrategy = savedLog }()
+
+	// Fetch the Strategy instance
+	instance := &starlingxv1.Strategy{}
+	err := r.Client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logStrategy.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
+	if instance.DeletionTimestamp.IsZero() {
+		if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
+			instance.Status.Reconciled {
+			return ctrl.Result{}, nil
+		}
+
+		// Ensure that the object has a finalizer setup as a pre-delete hook so
+		// that we can delete any system resources that we previously added.
+		if !utils.ContainsString(instance.ObjectMeta.Finalizers, StrategyFinalizerName) {
+			instance.ObjectMeta.Finalizers = append(instance.ObjectMeta.Finalizers, StrategyFinalizerName)
+			if err := r.Client.Update(context.Background(), instance); err != nil {
+				return reconcile.Result{}, err
+			}
+
+			// Might as well return immediately as the update is going to cause
+			// another reconcile event for this resource and we don't want to
+			// access the system API more than necessary.
+			return reconcile.Result{}, nil
+		}
+	}
+
+	if !utils.IsReconcilerEnabled(utils.Strategy) {
+		return reconcile.Result{}, nil
+	}
+
+	if r.GetPlatformClient(request.Namespace) == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	if !r.CloudManager.GetSystemReady(request.Namespace) {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	vimClient := r.CloudManager.GetOrchestrationClient(request.Namespace)
+	if vimClient == nil {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for vim client creation")
+		return common.RetryTransientError, nil
+	}
+
+	vimClient = cloudManager.WithChangeInitiator(vimClient, instance)
+	err = r.ReconcileResource(vimClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if err != nil {
+		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *StrategyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logStrategy}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(StrategyControllerName), logStrategy)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.Strategy{}).
+		Complete(r)
+}
+
+// DefaultStrategyProgressMonitorInterval represents the default interval
+// between polling attempts to check whether a strategy has progressed.  Each
+// strategy step typically locks, updates and unlocks a host so there is no
+// point in polling frequently.
+const DefaultStrategyProgressMonitorInterval = 30 * time.Second
+
+// strategyProgressMonitor waits for a strategy to change state or for the
+// completion percentage of its current phase to change.  Once it has a
+// reconcilable event is generated to kick the reconciler so that the status
+// can be refreshed.
+type strategyProgressMonitor struct {
+	cloudManager.CommonMonitorBody
+	manager      cloudManager.CloudManager
+	namespace    string
+	strategyType string
+	state        string
+	progress     int
+}
+
+// NewStrategyProgressMonitor defines a convenience function to instantiate
+// a new strategy progress monitor with all required attributes.
+func NewStrategyProgressMonitor(instance *starlingxv1.Strategy, strategy *strategies.Strategy) *cloudManager.Monitor {
+	logger := logStrategy.WithName("progress-monitor")
+	return &cloudManager.Monitor{
+		MonitorBody: &strategyProgressMonitor{
+			namespace:    instance.Namespace,
+			strategyType: instance.Spec.Type,
+			state:        strategy.State,
+			progress:     strategy.Phase().CompletionPercentage,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultStrategyProgressMonitorInterval,
+	}
+}
+
+// SetManager implements the MonitorManager interface so that the VIM client
+// can be retrieved since the monitor framework only supplies the platform
+// client.
+func (m *strategyProgressMonitor) SetManager(manager cloudManager.CloudManager) {
+	m.manager = manager
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *strategyProgressMonitor) Run(_ *gophercloud.ServiceClient) (stop bool, err error) {
+	client := m.manager.GetOrchestrationClient(m.namespace)
+	if client == nil {
+		m.CommonMonitorBody.SetState("waiting for vim client creation")
+		return false, nil
+	}
+
+	strategy, err := strategies.GetStrategy(client, m.strategyType)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to get %s strategy: %s", m.strategyType, err.Error())
+		return false, err
+	}
+
+	if strategy == nil {
+		m.CommonMonitorBody.SetState("%s strategy no longer exists", m.strategyType)
+		return true, nil
+	}
+
+	progress := strategy.Phase().CompletionPercentage
+	if strategy.State != m.state || progress != m.progress {
+		m.CommonMonitorBody.SetState("%s strategy has progressed to %s (%d%%)",
+			m.strategyType, strategy.State, progress)
+		return true, nil
+	}
+
+	m.CommonMonitorBody.SetState("waiting for %s strategy to progress from %s (%d%%)",
+		m.strategyType, m.state, m.progress)
+
+	return false, nil
+}
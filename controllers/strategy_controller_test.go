@@ -0,0 +1,92 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	comm "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/strategies"
+)
+
+var _ = Describe("Strategy controller", func() {
+
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	Context("Strategy with data", func() {
+		It("Should created successfully", func() {
+			ctx := context.Background()
+			key := types.NamespacedName{
+				Name:      "foo",
+				Namespace: "default",
+			}
+
+			created := &starlingxv1.Strategy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Spec: starlingxv1.StrategySpec{
+					Type:                  strategies.TypeSwPatch,
+					WorkerApplyType:       starlingxv1.ApplyTypeSerial,
+					DefaultInstanceAction: "stop-start",
+					AlarmRestrictions:     "strict",
+				}}
+			Expect(k8sClient.Create(ctx, created)).To(Succeed())
+
+			expected := created.DeepCopy()
+
+			fetched := &starlingxv1.Strategy{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, key, fetched)
+				return err == nil &&
+					fetched.ObjectMeta.ResourceVersion != expected.ObjectMeta.ResourceVersion
+			}, timeout, interval).Should(BeTrue())
+			_, found := comm.ListIntersect(fetched.ObjectMeta.Finalizers, []string{StrategyFinalizerName})
+			Expect(found).To(BeTrue())
+		})
+	})
+
+	Context("Strategy status", func() {
+		It("Should report the progress of the current phase", func() {
+			r := &StrategyReconciler{}
+			instance := &starlingxv1.Strategy{}
+			strategy := &strategies.Strategy{
+				ID:           "strategy-1",
+				State:        strategies.StateApplying,
+				CurrentPhase: "apply",
+				ApplyPhase: strategies.Phase{
+					PhaseName:            "apply",
+					CompletionPercentage: 40,
+					CurrentStage:         1,
+					Stages: []strategies.Stage{
+						{StageName: "sw-patch-controllers"},
+						{StageName: "sw-patch-worker-hosts"},
+					},
+				},
+			}
+
+			Expect(r.statusUpdateRequired(instance, strategy, true)).To(BeTrue())
+			Expect(*instance.Status.ID).To(Equal("strategy-1"))
+			Expect(instance.Status.Phase).To(Equal("apply"))
+			Expect(instance.Status.Progress).To(Equal(40))
+			Expect(instance.Status.Stage).To(Equal("sw-patch-worker-hosts"))
+			Expect(instance.Status.Reconciled).To(BeFalse())
+			Expect(r.statusUpdateRequired(instance, strategy, true)).To(BeFalse())
+
+			strategy.State = strategies.StateApplied
+			Expect(r.statusUpdateRequired(instance, strategy, true)).To(BeTrue())
+			Expect(instance.Status.Reconciled).To(BeTrue())
+		})
+	})
+})
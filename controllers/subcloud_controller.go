@@ -0,0 +1,767 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/subclouds"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logSubcloud = log.Log.WithName("controller").WithName("subcloud")
+
+const SubcloudControllerName = "subcloud-controller"
+
+const SubcloudFinalizerName = "subcloud.finalizers.windriver.com"
+
+// Defines the keys expected in the secrets and config maps referenced by a
+// subcloud.
+const (
+	SubcloudInstallValuesKey = "install_values"
+	SubcloudBMCPasswordKey   = "bmc_password"
+	SubcloudDeployConfigKey  = "deploy_config"
+)
+
+var _ reconcile.Reconciler = &SubcloudReconciler{}
+
+// SubcloudReconciler reconciles a Subcloud object
+type SubcloudReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+}
+
+// getSecretValue retrieves the value of a key from a secret in the namespace
+// of the subcloud.
+func (r *SubcloudReconciler) getSecretValue(instance *starlingxv1.Subcloud, name string, key string) (*string, error) {
+	secret := v1.Secret{}
+	secretName := types.NamespacedName{Namespace: instance.Namespace, Name: name}
+	err := r.Client.Get(context.TODO(), secretName, &secret)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			err = perrors.Wrapf(err, "failed to get subcloud secret %s", name)
+			return nil, err
+		}
+
+		msg := fmt.Sprintf("waiting for subcloud secret %q to be created", name)
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency, msg)
+		return nil, common.NewMissingKubernetesResource(msg)
+	}
+
+	value, ok := secret.Data[key]
+	if !ok {
+		msg := fmt.Sprintf("missing %q key in subcloud secret %s", key, name)
+		return nil, common.NewUserDataError(msg)
+	}
+
+	result := string(value)
+
+	return &result, nil
+}
+
+// getSubcloudResource retrieves the Subcloud resource referenced by a Backup
+// or Restore resource.
+func getSubcloudResource(c client.Client, namespace string, name string) (*starlingxv1.Subcloud, error) {
+	subcloud := &starlingxv1.Subcloud{}
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, subcloud)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			msg := fmt.Sprintf("waiting for subcloud %q to be created", name)
+			return nil, common.NewResourceConfigurationDependency(msg)
+		}
+
+		err = perrors.Wrapf(err, "failed to get subcloud: %s", name)
+		return nil, err
+	}
+
+	return subcloud, nil
+}
+
+// getSubcloudSysadminPassword retrieves the sysadmin password of a subcloud
+// from the secret referenced by its Subcloud resource.
+func getSubcloudSysadminPassword(c client.Client, subcloud *starlingxv1.Subcloud) (*string, error) {
+	secret := &v1.Secret{}
+	name := types.NamespacedName{Namespace: subcloud.Namespace, Name: subcloud.Spec.SysadminPasswordSecret}
+	err := c.Get(context.TODO(), name, secret)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			msg := fmt.Sprintf("waiting for subcloud secret %q to be created", name.Name)
+			return nil, common.NewMissingKubernetesResource(msg)
+		}
+
+		err = perrors.Wrapf(err, "failed to get subcloud secret %s", name.Name)
+		return nil, err
+	}
+
+	value, ok := secret.Data[v1.BasicAuthPasswordKey]
+	if !ok {
+		msg := fmt.Sprintf("missing %q key in subcloud secret %s", v1.BasicAuthPasswordKey, name.Name)
+		return nil, common.NewUserDataError(msg)
+	}
+
+	password := string(value)
+
+	return &password, nil
+}
+
+// getDeployConfig retrieves the deployment configuration of a subcloud from
+// the config map it references.
+func (r *SubcloudReconciler) getDeployConfig(instance *starlingxv1.Subcloud) ([]byte, error) {
+	if instance.Spec.DeployConfig == nil {
+		return nil, nil
+	}
+
+	name := *instance.Spec.DeployConfig
+
+	cm := v1.ConfigMap{}
+	cmName := types.NamespacedName{Namespace: instance.Namespace, Name: name}
+	err := r.Client.Get(context.TODO(), cmName, &cm)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			err = perrors.Wrapf(err, "failed to get subcloud deploy config %s", name)
+			return nil, err
+		}
+
+		msg := fmt.Sprintf("waiting for subcloud deploy config %q to be created", name)
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency, msg)
+		return nil, common.NewMissingKubernetesResource(msg)
+	}
+
+	value, ok := cm.Data[SubcloudDeployConfigKey]
+	if !ok {
+		msg := fmt.Sprintf("missing %q key in subcloud deploy config %s", SubcloudDeployConfigKey, name)
+		return nil, common.NewUserDataError(msg)
+	}
+
+	return []byte(value), nil
+}
+
+// getInstallValues retrieves the install values and BMC password of a
+// subcloud from the secret it references.
+func (r *SubcloudReconciler) getInstallValues(instance *starlingxv1.Subcloud) ([]byte, *string, error) {
+	if instance.Spec.InstallValuesSecret == nil {
+		return nil, nil, nil
+	}
+
+	name := *instance.Spec.InstallValuesSecret
+
+	values, err := r.getSecretValue(instance, name, SubcloudInstallValuesKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	password, err := r.getSecretValue(instance, name, SubcloudBMCPasswordKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return []byte(*values), password, nil
+}
+
+// ReconcileNew is a method which handles reconciling a new subcloud resource
+// and adds the subcloud to the system controller.  No deployment phase is run
+// until the subcloud has been added.
+func (r *SubcloudReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud) (*subclouds.Subcloud, error) {
+	installValues, bmcPassword, err := r.getInstallValues(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	deployConfig, err := r.getDeployConfig(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := subclouds.SubcloudOpts{
+		Name:             instance.Name,
+		BootstrapAddress: instance.Spec.BootstrapAddress,
+		BootstrapValues:  []byte(instance.Spec.BootstrapValues),
+		InstallValues:    installValues,
+		DeployConfig:     deployConfig,
+		BMCPassword:      bmcPassword,
+		Release:          instance.Spec.Release,
+		Description:      instance.Spec.Description,
+		Location:         instance.Spec.Location,
+	}
+
+	logSubcloud.Info("adding subcloud", "address", opts.BootstrapAddress)
+
+	subcloud, err := subclouds.Create(client, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to add subcloud: %s", instance.Name)
+		return nil, err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+		"subcloud has been added")
+
+	return subcloud, nil
+}
+
+// deployPhase requests that a deployment phase be run on a subcloud.
+func (r *SubcloudReconciler) deployPhase(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud, phase string) error {
+	opts := subclouds.PhaseOpts{}
+
+	if phase != subclouds.PhaseComplete {
+		password, err := r.getSecretValue(instance, instance.Spec.SysadminPasswordSecret, v1.BasicAuthPasswordKey)
+		if err != nil {
+			return err
+		}
+		opts.SysadminPassword = password
+	}
+
+	switch phase {
+	case subclouds.PhaseInstall:
+		installValues, bmcPassword, err := r.getInstallValues(instance)
+		if err != nil {
+			return err
+		}
+		opts.InstallValues = installValues
+		opts.BMCPassword = bmcPassword
+		opts.Release = instance.Spec.Release
+
+	case subclouds.PhaseConfigure:
+		deployConfig, err := r.getDeployConfig(instance)
+		if err != nil {
+			return err
+		}
+		opts.DeployConfig = deployConfig
+	}
+
+	logSubcloud.Info("running subcloud deployment phase", "phase", phase)
+
+	result, err := subclouds.Deploy(client, instance.Name, phase, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to run %s phase on subcloud: %s", phase, instance.Name)
+		return err
+	} else if result != nil && result.DeployStatus != "" {
+		*subcloud = *result
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"subcloud %s phase has been started", phase)
+
+	return nil
+}
+
+// subcloudManagementState is a utility function which returns the management
+// state requested by the subcloud spec.
+func subcloudManagementState(instance *starlingxv1.Subcloud) string {
+	if instance.Spec.Manage {
+		return subclouds.ManagementManaged
+	}
+
+	return subclouds.ManagementUnmanaged
+}
+
+// subcloudUpdateOpts is a utility function which determines the attributes
+// that differ between the subcloud spec and the subcloud on the system.  A
+// subcloud can only be managed once it is online.
+func subcloudUpdateOpts(instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) (opts subclouds.SubcloudUpdateOpts, result bool) {
+	spec := instance.Spec
+
+	if spec.Description != nil && *spec.Description != subcloud.Description {
+		opts.Description = spec.Description
+		result = true
+	}
+
+	if spec.Location != nil && *spec.Location != subcloud.Location {
+		opts.Location = spec.Location
+		result = true
+	}
+
+	state := subcloudManagementState(instance)
+	if state != subcloud.ManagementState &&
+		(state == subclouds.ManagementUnmanaged || subcloud.AvailabilityStatus == subclouds.AvailabilityOnline) {
+		opts.ManagementState = &state
+		result = true
+	}
+
+	return opts, result
+}
+
+// subcloudDeployed is a utility function which determines whether a subcloud
+// has reached the state requested by its spec.
+func subcloudDeployed(instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) bool {
+	if subcloud.DeployStatus != subclouds.DeployComplete {
+		return false
+	}
+
+	return subcloud.ManagementState == subcloudManagementState(instance)
+}
+
+// restorePending determines whether a Restore resource is about to restore,
+// or is restoring, the subcloud.
+func (r *SubcloudReconciler) restorePending(instance *starlingxv1.Subcloud) (bool, error) {
+	restores := &starlingxv1.RestoreList{}
+	err := r.Client.List(context.TODO(), restores, client.InNamespace(instance.Namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list restores")
+		return false, err
+	}
+
+	for _, restore := range restores.Items {
+		if restore.Spec.Subcloud != instance.Name || restore.Status.Reconciled ||
+			restore.Status.Phase == starlingxv1.RestorePhaseFailed {
+			continue
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// ReconcileExisting is a method which handles moving an existing subcloud
+// thru its deployment phases.  Each phase is started once the previous phase
+// has completed; the install and configure phases are skipped if the
+// subcloud does not reference install values or a deployment configuration.
+// Failed phases are reported but not retried so that they can be examined and
+// resumed from the system controller.
+func (r *SubcloudReconciler) ReconcileExisting(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) error {
+	spec := instance.Spec
+
+	restoring, err := r.restorePending(instance)
+	if err != nil {
+		return err
+	} else if restoring {
+		// The subcloud is unmanaged while it is being restored; leave it to
+		// the Restore resource until the restore has finished.
+		return nil
+	}
+
+	switch {
+	case subcloud.InProgress():
+		return nil
+
+	case subcloud.Failed():
+		if instance.Status.DeployStatus != subcloud.DeployStatus {
+			r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+				"subcloud deployment has stopped in state %s: %s",
+				subcloud.DeployStatus, subcloud.ErrorDescription)
+		}
+		return nil
+
+	case subcloud.DeployStatus == subclouds.DeployCreated && spec.InstallValuesSecret != nil:
+		return r.deployPhase(client, instance, subcloud, subclouds.PhaseInstall)
+
+	case subcloud.DeployStatus == subclouds.DeployCreated,
+		subcloud.DeployStatus == subclouds.DeployInstalled:
+		return r.deployPhase(client, instance, subcloud, subclouds.PhaseBootstrap)
+
+	case subcloud.DeployStatus == subclouds.DeployBootstrapped && spec.DeployConfig != nil:
+		return r.deployPhase(client, instance, subcloud, subclouds.PhaseConfigure)
+
+	case subcloud.DeployStatus == subclouds.DeployBootstrapped,
+		subcloud.DeployStatus == subclouds.DeployConfigured:
+		return r.deployPhase(client, instance, subcloud, subclouds.PhaseComplete)
+
+	case subcloud.DeployStatus == subclouds.DeployComplete:
+		opts, required := subcloudUpdateOpts(instance, subcloud)
+		if !required {
+			return nil
+		}
+
+		logSubcloud.Info("updating subcloud", "opts", opts)
+
+		result, err := subclouds.Update(client, instance.Name, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update subcloud: %s", instance.Name)
+			return err
+		} else if result != nil && result.DeployStatus != "" {
+			*subcloud = *result
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"subcloud has been updated")
+	}
+
+	return nil
+}
+
+// ReconciledDeleted is a method which handles reconciling a deleted subcloud
+// resource.  The subcloud is unmanaged before it is deleted and the system
+// controller only allows deleting it once it is offline.
+func (r *SubcloudReconciler) ReconciledDeleted(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) error {
+	if utils.ContainsString(instance.ObjectMeta.Finalizers, SubcloudFinalizerName) {
+		if subcloud != nil {
+			if subcloud.InProgress() {
+				msg := "waiting for subcloud deployment phase to finish before deleting it"
+				m := NewSubcloudDeployMonitor(instance, subcloud)
+				return r.CloudManager.StartMonitor(m, msg)
+			}
+
+			if subcloud.ManagementState == subclouds.ManagementManaged {
+				state := subclouds.ManagementUnmanaged
+				opts := subclouds.SubcloudUpdateOpts{ManagementState: &state}
+
+				logSubcloud.Info("unmanaging subcloud")
+
+				_, err := subclouds.Update(client, instance.Name, opts).Extract()
+				if err != nil {
+					err = perrors.Wrapf(err, "failed to unmanage subcloud: %s", instance.Name)
+					return err
+				}
+			}
+
+			if subcloud.AvailabilityStatus == subclouds.AvailabilityOnline {
+				msg := "waiting for subcloud to go offline before deleting it"
+				r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency, msg)
+				m := NewSubcloudDeployMonitor(instance, subcloud)
+				return r.CloudManager.StartMonitor(m, msg)
+			}
+
+			logSubcloud.Info("deleting subcloud")
+
+			err := subclouds.Delete(client, instance.Name).ExtractErr()
+			if err != nil {
+				if _, ok := err.(gophercloud.ErrDefault404); !ok {
+					err = perrors.Wrapf(err, "failed to delete subcloud: %s", instance.Name)
+					return err
+				}
+			}
+
+			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceDeleted,
+				"subcloud has been deleted")
+		}
+
+		// Remove the finalizer so the kubernetes delete operation can continue.
+		instance.ObjectMeta.Finalizers = utils.RemoveString(instance.ObjectMeta.Finalizers, SubcloudFinalizerName)
+		if err := r.Client.Update(context.Background(), instance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// statusUpdateRequired is a utility function which determines whether an
+// update is required to the subcloud status attribute.  Updating this
+// unnecessarily will result in an infinite reconciliation loop.
+func (r *SubcloudReconciler) statusUpdateRequired(instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud, inSync bool) (result bool) {
+	status := &instance.Status
+
+	if subcloud != nil {
+		if status.ID == nil || *status.ID != subcloud.ID {
+			id := subcloud.ID
+			status.ID = &id
+			result = true
+		}
+
+		if status.DeployStatus != subcloud.DeployStatus {
+			status.DeployStatus = subcloud.DeployStatus
+			result = true
+		}
+
+		if status.AvailabilityStatus != subcloud.AvailabilityStatus {
+			status.AvailabilityStatus = subcloud.AvailabilityStatus
+			result = true
+		}
+
+		if status.ManagementState != subcloud.ManagementState {
+			status.ManagementState = subcloud.ManagementState
+			result = true
+		}
+
+		if status.SyncStatus != subcloud.SyncStatus {
+			status.SyncStatus = subcloud.SyncStatus
+			result = true
+		}
+
+		if status.SoftwareVersion != subcloud.SoftwareVersion {
+			status.SoftwareVersion = subcloud.SoftwareVersion
+			result = true
+		}
+
+		if status.ErrorDescription != subcloud.ErrorDescription {
+			status.ErrorDescription = subcloud.ErrorDescription
+			result = true
+		}
+
+		if subcloudDeployed(instance, subcloud) && !status.Reconciled {
+			// Record the fact that the subcloud has been deployed for the
+			// current configuration.
+			status.Reconciled = true
+			result = true
+		}
+	}
+
+	if status.InSync != inSync {
+		status.InSync = inSync
+		result = true
+	}
+
+	return result
+}
+
+// ReconcileGeneration resets the reconciled state of the resource when its
+// configuration has been modified so that the changes are applied.  Only the
+// description, location and management state can be changed once the
+// subcloud has been added; the other attributes are only used by the
+// deployment phases that have not yet run.
+func (r *SubcloudReconciler) ReconcileGeneration(instance *starlingxv1.Subcloud) {
+	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation {
+		return
+	}
+
+	instance.Status.Reconciled = false
+	instance.Status.ObservedGeneration = instance.ObjectMeta.Generation
+}
+
+// ReconcileResource interacts with the dcmanager API in order to reconcile
+// the state of a subcloud with the state stored in the k8s database.
+func (r *SubcloudReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud) error {
+	subcloud, err := subclouds.GetSubcloud(client, instance.Name)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get subcloud: %s", instance.Name)
+		return err
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.ReconciledDeleted(client, instance, subcloud)
+	}
+
+	r.ReconcileGeneration(instance)
+
+	if subcloud == nil {
+		subcloud, err = r.ReconcileNew(client, instance)
+	} else {
+		err = r.ReconcileExisting(client, instance, subcloud)
+	}
+
+	inSync := err == nil
+
+	if instance.Status.InSync != inSync {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	if r.statusUpdateRequired(instance, subcloud, inSync) {
+		logSubcloud.Info("updating subcloud", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	if err == nil && subcloud != nil && !subcloudDeployed(instance, subcloud) {
+		// The system controller runs each deployment phase on its own; keep
+		// the status current until the next phase can be started.  Failed
+		// subclouds are also monitored so that a deployment resumed from the
+		// system controller is picked up.
+		msg := "waiting for subcloud deployment to progress"
+		m := NewSubcloudDeployMonitor(instance, subcloud)
+		return r.CloudManager.StartMonitor(m, msg)
+	}
+
+	return err
+}
+
+// Reconcile reads that state of the cluster for a Subcloud object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=subclouds,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=subclouds/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=subclouds/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=restores,verbs=get;list;watch
+func (r *SubcloudReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logSubcloud
+	logSubcloud = logSubcloud.WithName(request.NamespacedName.String())
+	defer func() { logSubcloud = savedLog }()
+
+	// Fetch the Subcloud instance
+	instance := &starlingxv1.Subcloud{}
+	err := r.Client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logSubcloud.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
+	if instance.DeletionTimestamp.IsZero() {
+		if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
+			instance.Status.Reconciled {
+			return ctrl.Result{}, nil
+		}
+
+		// Ensure that the object has a finalizer setup as a pre-delete hook so
+		// that we can delete any system resources that we previously added.
+		if !utils.ContainsString(instance.ObjectMeta.Finalizers, SubcloudFinalizerName) {
+			instance.ObjectMeta.Finalizers = append(instance.ObjectMeta.Finalizers, SubcloudFinalizerName)
+			if err := r.Client.Update(context.Background(), instance); err != nil {
+				return reconcile.Result{}, err
+			}
+
+			// Might as well return immediately as the update is going to cause
+			// another reconcile event for this resource and we don't want to
+			// access the system API more than necessary.
+			return reconcile.Result{}, nil
+		}
+	}
+
+	if !utils.IsReconcilerEnabled(utils.Subcloud) {
+		return reconcile.Result{}, nil
+	}
+
+	if r.GetPlatformClient(request.Namespace) == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	if !r.CloudManager.GetSystemReady(request.Namespace) {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	dcClient := r.CloudManager.GetDistributedCloudClient(request.Namespace)
+	if dcClient == nil {
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency,
+			"waiting for dcmanager client creation")
+		return common.RetryTransientError, nil
+	}
+
+	dcClient = cloudManager.WithChangeInitiator(dcClient, instance)
+	err = r.ReconcileResource(dcClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if err != nil {
+		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SubcloudReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logSubcloud}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(SubcloudControllerName), logSubcloud)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.Subcloud{}).
+		Complete(r)
+}
+
+// DefaultSubcloudDeployMonitorInterval represents the default interval
+// between polling attempts to check whether a subcloud deployment has
+// progressed.  Installing and bootstrapping a subcloud takes a long time so
+// there is no point in polling frequently.
+const DefaultSubcloudDeployMonitorInterval = time.Minute
+
+// subcloudDeployMonitor waits for the deployment, availability or management
+// state of a subcloud to change.  Once it has a reconcilable event is
+// generated to kick the reconciler so that the next phase can be started.
+type subcloudDeployMonitor struct {
+	cloudManager.CommonMonitorBody
+	manager      cloudManager.CloudManager
+	namespace    string
+	name         string
+	deployStatus string
+	availability string
+	management   string
+}
+
+// NewSubcloudDeployMonitor defines a convenience function to instantiate
+// a new subcloud deployment monitor with all required attributes.
+func NewSubcloudDeployMonitor(instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) *cloudManager.Monitor {
+	logger := logSubcloud.WithName("deploy-monitor")
+	return &cloudManager.Monitor{
+		MonitorBody: &subcloudDeployMonitor{
+			namespace:    instance.Namespace,
+			name:         instance.Name,
+			deployStatus: subcloud.DeployStatus,
+			availability: subcloud.AvailabilityStatus,
+			management:   subcloud.ManagementState,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultSubcloudDeployMonitorInterval,
+	}
+}
+
+// SetManager implements the MonitorManager interface so that the dcmanager
+// client can be retrieved since the monitor framework only supplies the
+// platform client.
+func (m *subcloudDeployMonitor) SetManager(manager cloudManager.CloudManager) {
+	m.manager = manager
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *subcloudDeployMonitor) Run(_ *gophercloud.ServiceClient) (stop bool, err error) {
+	client := m.manager.GetDistributedCloudClient(m.namespace)
+	if client == nil {
+		m.CommonMonitorBody.SetState("waiting for dcmanager client creation")
+		return false, nil
+	}
+
+	subcloud, err := subclouds.GetSubcloud(client, m.name)
+	if err != nil {
+		m.CommonMonitorBody.SetState("failed to get subcloud %s: %s", m.name, err.Error())
+		return false, err
+	}
+
+	if subcloud == nil {
+		m.CommonMonitorBody.SetState("subcloud %s no longer exists", m.name)
+		return true, nil
+	}
+
+	if subcloud.DeployStatus != m.deployStatus ||
+		subcloud.AvailabilityStatus != m.availability ||
+		subcloud.ManagementState != m.management {
+		m.CommonMonitorBody.SetState("subcloud %s has progressed to %s (%s/%s)", m.name,
+			subcloud.DeployStatus, subcloud.ManagementState, subcloud.AvailabilityStatus)
+		return true, nil
+	}
+
+	m.CommonMonitorBody.SetState("waiting for subcloud %s to progress from %s (%s/%s)",
+		m.name, m.deployStatus, m.management, m.availability)
+
+	return false, nil
+}
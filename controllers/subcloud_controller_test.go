@@ -0,0 +1,103 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	comm "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/subclouds"
+)
+
+var _ = Describe("Subcloud controller", func() {
+
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	Context("Subcloud with data", func() {
+		It("Should created successfully", func() {
+			ctx := context.Background()
+			key := types.NamespacedName{
+				Name:      "subcloud1",
+				Namespace: "default",
+			}
+
+			created := &starlingxv1.Subcloud{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "subcloud1",
+					Namespace: "default",
+				},
+				Spec: starlingxv1.SubcloudSpec{
+					BootstrapAddress:       "10.10.10.12",
+					BootstrapValues:        "system_mode: simplex\n",
+					SysadminPasswordSecret: "subcloud1-sysadmin",
+					Manage:                 true,
+				}}
+			Expect(k8sClient.Create(ctx, created)).To(Succeed())
+
+			expected := created.DeepCopy()
+
+			fetched := &starlingxv1.Subcloud{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, key, fetched)
+				return err == nil &&
+					fetched.ObjectMeta.ResourceVersion != expected.ObjectMeta.ResourceVersion
+			}, timeout, interval).Should(BeTrue())
+			_, found := comm.ListIntersect(fetched.ObjectMeta.Finalizers, []string{SubcloudFinalizerName})
+			Expect(found).To(BeTrue())
+		})
+	})
+
+	Context("Subcloud status", func() {
+		It("Should only be reconciled once deployed and managed", func() {
+			r := &SubcloudReconciler{}
+			instance := &starlingxv1.Subcloud{Spec: starlingxv1.SubcloudSpec{Manage: true}}
+			subcloud := &subclouds.Subcloud{
+				ID:                 3,
+				DeployStatus:       subclouds.DeployBootstrapping,
+				AvailabilityStatus: subclouds.AvailabilityOffline,
+				ManagementState:    subclouds.ManagementUnmanaged,
+			}
+
+			Expect(r.statusUpdateRequired(instance, subcloud, true)).To(BeTrue())
+			Expect(*instance.Status.ID).To(Equal(3))
+			Expect(instance.Status.DeployStatus).To(Equal(subclouds.DeployBootstrapping))
+			Expect(instance.Status.Reconciled).To(BeFalse())
+			Expect(r.statusUpdateRequired(instance, subcloud, true)).To(BeFalse())
+
+			subcloud.DeployStatus = subclouds.DeployComplete
+			Expect(r.statusUpdateRequired(instance, subcloud, true)).To(BeTrue())
+			Expect(instance.Status.Reconciled).To(BeFalse())
+
+			subcloud.ManagementState = subclouds.ManagementManaged
+			Expect(r.statusUpdateRequired(instance, subcloud, true)).To(BeTrue())
+			Expect(instance.Status.Reconciled).To(BeTrue())
+		})
+
+		It("Should only manage subclouds once online", func() {
+			instance := &starlingxv1.Subcloud{Spec: starlingxv1.SubcloudSpec{Manage: true}}
+			subcloud := &subclouds.Subcloud{
+				DeployStatus:       subclouds.DeployComplete,
+				AvailabilityStatus: subclouds.AvailabilityOffline,
+				ManagementState:    subclouds.ManagementUnmanaged,
+			}
+
+			_, required := subcloudUpdateOpts(instance, subcloud)
+			Expect(required).To(BeFalse())
+
+			subcloud.AvailabilityStatus = subclouds.AvailabilityOnline
+			opts, required := subcloudUpdateOpts(instance, subcloud)
+			Expect(required).To(BeTrue())
+			Expect(*opts.ManagementState).To(Equal(subclouds.ManagementManaged))
+		})
+	})
+})
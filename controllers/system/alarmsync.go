@@ -0,0 +1,191 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package system
+
+import (
+	"context"
+	"sync"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+var logAlarmSync = log.Log.WithName("controller").WithName("alarm-sync")
+
+const AlarmSyncName = "alarm-sync"
+
+// Defines the event reasons used to report alarm changes.
+const (
+	AlarmRaised  = "AlarmRaised"
+	AlarmCleared = "AlarmCleared"
+)
+
+// AlarmSyncReconciler mirrors the active platform alarms of each system into
+// Kubernetes so that they are visible to operators that do not watch the
+// platform itself.  The alarms are polled from the fault management API and
+// reported as events and as the alarms attribute of the System status.
+type AlarmSyncReconciler struct {
+	client.Client
+	cloudManager.CloudManager
+	common.ReconcilerEventLogger
+
+	lock sync.Mutex
+
+	// active defines the alarms read during the previous poll of each
+	// namespace.
+	active map[string][]starlingxv1.AlarmStatus
+}
+
+// previousAlarms returns the alarms read during the previous poll of a
+// namespace.  The alarms reported in the status are used after a restart so
+// that the alarms which are still active are not reported again.
+func (r *AlarmSyncReconciler) previousAlarms(instance *starlingxv1.System) []starlingxv1.AlarmStatus {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if previous, ok := r.active[instance.Namespace]; ok {
+		return previous
+	}
+
+	return instance.Status.Alarms
+}
+
+// setPreviousAlarms records the alarms read during a poll of a namespace.
+func (r *AlarmSyncReconciler) setPreviousAlarms(namespace string, active []starlingxv1.AlarmStatus) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if active == nil {
+		delete(r.active, namespace)
+		return
+	}
+
+	r.active[namespace] = active
+}
+
+// ReconcileEvents reports the alarms raised and cleared since the previous
+// poll as events on the System resource.
+func (r *AlarmSyncReconciler) ReconcileEvents(instance *starlingxv1.System, active []starlingxv1.AlarmStatus) {
+	previous := r.previousAlarms(instance)
+	raised, cleared := common.AlarmChanges(previous, active)
+
+	for _, a := range raised {
+		r.ReconcilerEventLogger.WarningEvent(instance, AlarmRaised,
+			"%s alarm %s raised on %s: %s", a.Severity, a.AlarmID, a.EntityInstanceID, a.Reason)
+	}
+
+	for _, a := range cleared {
+		r.ReconcilerEventLogger.NormalEvent(instance, AlarmCleared,
+			"%s alarm %s cleared on %s", a.Severity, a.AlarmID, a.EntityInstanceID)
+	}
+}
+
+// ReconcileStatus reports the most severe active alarms in the System status.
+func (r *AlarmSyncReconciler) ReconcileStatus(instance *starlingxv1.System, active []starlingxv1.AlarmStatus) error {
+	var result []starlingxv1.AlarmStatus
+	if len(active) > 0 {
+		result = active
+		if len(result) > common.MaxMirroredAlarms {
+			result = result[:common.MaxMirroredAlarms]
+		}
+	}
+
+	if equality.Semantic.DeepEqual(instance.Status.Alarms, result) {
+		return nil
+	}
+
+	instance.Status.Alarms = result
+
+	return r.Client.Status().Update(context.TODO(), instance)
+}
+
+// Reconcile polls the active alarms of a system and mirrors them into
+// Kubernetes.  The poll is repeated at the configured interval while alarm
+// synchronization is enabled.
+func (r *AlarmSyncReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	instance := &starlingxv1.System{}
+	err := r.Client.Get(ctx, request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			r.setPreviousAlarms(request.Namespace, nil)
+			cloudManager.ClearActiveAlarms(request.Namespace)
+			return ctrl.Result{}, nil
+		}
+		logAlarmSync.Error(err, "unable to read object", "request", request)
+		return ctrl.Result{}, err
+	}
+
+	interval := utils.GetAlarmSyncInterval()
+
+	if !utils.AlarmSyncEnabled() || !instance.DeletionTimestamp.IsZero() {
+		// Stale alarms are removed so that they are not mistaken for the
+		// current state of the system.
+		r.setPreviousAlarms(request.Namespace, nil)
+		cloudManager.ClearActiveAlarms(request.Namespace)
+		if instance.DeletionTimestamp.IsZero() {
+			err = r.ReconcileStatus(instance, nil)
+		}
+		return ctrl.Result{RequeueAfter: interval}, err
+	}
+
+	if !r.CloudManager.GetSystemReady(request.Namespace) {
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	faultClient := r.CloudManager.GetFaultClient(request.Namespace)
+	if faultClient == nil {
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	objects, err := alarms.ListAlarms(faultClient)
+	if err != nil {
+		logAlarmSync.Error(err, "failed to list alarms", "namespace", request.Namespace)
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	active := common.MirroredAlarms(objects)
+	cloudManager.RecordActiveAlarms(request.Namespace, active)
+
+	if utils.AlarmSyncEvents() {
+		r.ReconcileEvents(instance, active)
+	}
+	r.setPreviousAlarms(request.Namespace, active)
+
+	if utils.AlarmSyncStatus() {
+		err = r.ReconcileStatus(instance, active)
+	} else {
+		err = r.ReconcileStatus(instance, nil)
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// SetupWithManager sets up the alarm synchronization with the Manager.  Only
+// changes to the System specification trigger an immediate poll so that the
+// status updates made by the poll itself do not.
+func (r *AlarmSyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.CloudManager = tMgr
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(AlarmSyncName), logAlarmSync)
+	r.active = make(map[string][]starlingxv1.AlarmStatus)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(AlarmSyncName).
+		For(&starlingxv1.System{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
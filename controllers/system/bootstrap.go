@@ -0,0 +1,282 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/alarms"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// BootstrapPhaseInterval defines the interval at which the phases of the
+// bootstrap sequence are refreshed until every phase has been reached.
+const BootstrapPhaseInterval = 30 * time.Second
+
+// Controller1 defines the name of the second controller of a duplex or
+// standard system.
+const Controller1 = "controller-1"
+
+// Defines the reasons reported with the bootstrap phase conditions.
+const (
+	BootstrapReasonReached           = "Reached"
+	BootstrapReasonPending           = "Pending"
+	BootstrapReasonHealthCheckFailed = "HealthCheckFailed"
+	BootstrapReasonNotApplicable     = "NotApplicable"
+)
+
+// findHost returns the host with the given name or nil if it is not part of
+// the inventory.
+func findHost(objects []hosts.Host, hostname string) *hosts.Host {
+	for i := range objects {
+		if objects[i].Hostname == hostname {
+			return &objects[i]
+		}
+	}
+
+	return nil
+}
+
+// pendingHosts returns the names of the hosts of a personality which are not
+// yet unlocked and enabled along with the total number of hosts of that
+// personality.
+func pendingHosts(objects []hosts.Host, personality string) (pending []string, count int) {
+	for _, h := range objects {
+		if h.Personality != personality {
+			continue
+		}
+
+		count++
+		if !h.IsUnlockedEnabled() {
+			pending = append(pending, h.Hostname)
+		}
+	}
+
+	return pending, count
+}
+
+// bootstrapConditions determines which phases of the bootstrap sequence have
+// been reached from the hosts of the system inventory.  The personality of the
+// hosts which have yet to be added to the inventory is unknown therefore the
+// storage and worker phases are not considered to be reached while any such
+// host is missing.  The health check failures are reported against the phases
+// which have not been reached yet.
+func bootstrapConditions(objects []hosts.Host, missing []string, configured bool, simplex bool, failures map[string][]string) []metav1.Condition {
+	result := make([]metav1.Condition, 0, len(starlingxv1.BootstrapPhases))
+
+	for _, phase := range starlingxv1.BootstrapPhases {
+		condition := metav1.Condition{
+			Type:   phase,
+			Status: metav1.ConditionFalse,
+			Reason: BootstrapReasonPending,
+		}
+
+		switch phase {
+		case starlingxv1.BootstrapPhaseController0Configured:
+			condition.Message = "waiting for the system configuration to be applied"
+			if configured {
+				condition.Status = metav1.ConditionTrue
+				condition.Message = "the system configuration has been applied"
+			}
+
+		case starlingxv1.BootstrapPhaseController0Unlocked, starlingxv1.BootstrapPhaseController1Unlocked:
+			hostname := hosts.Controller0
+			if phase == starlingxv1.BootstrapPhaseController1Unlocked {
+				hostname = Controller1
+			}
+
+			condition.Message = fmt.Sprintf("waiting for %s to be unlocked and enabled", hostname)
+			if simplex && hostname == Controller1 {
+				condition.Status = metav1.ConditionTrue
+				condition.Reason = BootstrapReasonNotApplicable
+				condition.Message = "the system has a single controller"
+			} else if h := findHost(objects, hostname); h != nil && h.IsUnlockedEnabled() {
+				condition.Status = metav1.ConditionTrue
+				condition.Message = fmt.Sprintf("%s is unlocked and enabled", hostname)
+			}
+
+		case starlingxv1.BootstrapPhaseController1Installed:
+			condition.Message = fmt.Sprintf("waiting for %s to be installed", Controller1)
+			if simplex {
+				condition.Status = metav1.ConditionTrue
+				condition.Reason = BootstrapReasonNotApplicable
+				condition.Message = "the system has a single controller"
+			} else if h := findHost(objects, Controller1); h != nil && h.IsInventoryCollected() {
+				condition.Status = metav1.ConditionTrue
+				condition.Message = fmt.Sprintf("%s has been installed", Controller1)
+			}
+
+		case starlingxv1.BootstrapPhaseStorageUnlocked, starlingxv1.BootstrapPhaseWorkersUnlocked:
+			personality := hosts.PersonalityStorage
+			if phase == starlingxv1.BootstrapPhaseWorkersUnlocked {
+				personality = hosts.PersonalityWorker
+			}
+
+			pending, count := pendingHosts(objects, personality)
+			if count == 0 && len(missing) > 0 {
+				condition.Message = fmt.Sprintf("waiting for hosts to be added to the inventory: %s",
+					strings.Join(missing, ", "))
+			} else if count == 0 {
+				condition.Status = metav1.ConditionTrue
+				condition.Reason = BootstrapReasonNotApplicable
+				condition.Message = fmt.Sprintf("the system has no %s hosts", personality)
+			} else if len(pending) == 0 {
+				condition.Status = metav1.ConditionTrue
+				condition.Message = fmt.Sprintf("all %s hosts are unlocked and enabled", personality)
+			} else {
+				condition.Message = fmt.Sprintf("waiting for %s hosts to be unlocked and enabled: %s",
+					personality, strings.Join(pending, ", "))
+			}
+		}
+
+		if condition.Status == metav1.ConditionTrue {
+			if condition.Reason == BootstrapReasonPending {
+				condition.Reason = BootstrapReasonReached
+			}
+		} else if ids, ok := failures[phase]; ok && len(ids) > 0 {
+			condition.Reason = BootstrapReasonHealthCheckFailed
+			condition.Message = fmt.Sprintf("health check failed; active alarms: %s", strings.Join(ids, ", "))
+		}
+
+		result = append(result, condition)
+	}
+
+	return result
+}
+
+// missingHosts returns the names of the Host resources of a namespace which
+// are not yet part of the system inventory.
+func (r *SystemReconciler) missingHosts(namespace string, objects []hosts.Host) ([]string, error) {
+	list := &starlingxv1.HostList{}
+	err := r.List(context.TODO(), list, client.InNamespace(namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	for _, h := range list.Items {
+		if findHost(objects, h.Name) == nil {
+			result = append(result, h.Name)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// currentBootstrapPhase returns the first phase of the bootstrap sequence which
+// has not been reached or "Completed" if every phase has been reached.
+func currentBootstrapPhase(conditions []metav1.Condition) string {
+	for _, c := range conditions {
+		if c.Status != metav1.ConditionTrue {
+			return c.Type
+		}
+	}
+
+	return starlingxv1.BootstrapPhaseCompleted
+}
+
+// bootstrapHealthCheckFailures evaluates the health checks configured for the
+// phases of the bootstrap sequence.  Failing to read the alarms is not
+// considered an error since the hosts evaluate the health checks again before
+// they are unlocked.
+func (r *SystemReconciler) bootstrapHealthCheckFailures(instance *starlingxv1.System) map[string][]string {
+	if instance.Spec.Bootstrap == nil || len(instance.Spec.Bootstrap.HealthChecks) == 0 {
+		return nil
+	}
+
+	faultClient := r.CloudManager.GetFaultClient(instance.Namespace)
+	if faultClient == nil {
+		return nil
+	}
+
+	active, err := alarms.ListAlarms(faultClient)
+	if err != nil {
+		logSystem.Error(err, "failed to list alarms")
+		return nil
+	}
+
+	result := make(map[string][]string)
+	for i := range instance.Spec.Bootstrap.HealthChecks {
+		check := &instance.Spec.Bootstrap.HealthChecks[i]
+		result[check.Phase] = common.BootstrapHealthCheckFailures(check, active)
+	}
+
+	return result
+}
+
+// ReconcileBootstrapPhases is responsible for reporting the progress of the
+// controller bootstrap sequence as conditions of the System resource.  The
+// phases are refreshed periodically until every phase has been reached after
+// which they are no longer updated.
+func (r *SystemReconciler) ReconcileBootstrapPhases(platformClient *gophercloud.ServiceClient, instance *starlingxv1.System) (ctrl.Result, error) {
+	if instance.Status.BootstrapPhase == starlingxv1.BootstrapPhaseCompleted ||
+		instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
+		return ctrl.Result{}, nil
+	}
+
+	objects, err := hosts.ListHosts(platformClient)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return ctrl.Result{}, err
+	}
+
+	missing, err := r.missingHosts(instance.Namespace, objects)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	simplex := strings.EqualFold(instance.Status.SystemMode, string(cloudManager.SystemModeSimplex))
+	failures := r.bootstrapHealthCheckFailures(instance)
+	conditions := bootstrapConditions(objects, missing, instance.Status.Reconciled, simplex, failures)
+
+	changed := false
+	for _, c := range conditions {
+		existing := meta.FindStatusCondition(instance.Status.Conditions, c.Type)
+		if existing != nil && existing.Status == c.Status && existing.Reason == c.Reason && existing.Message == c.Message {
+			continue
+		}
+
+		c.ObservedGeneration = instance.Generation
+		meta.SetStatusCondition(&instance.Status.Conditions, c)
+		changed = true
+	}
+
+	phase := currentBootstrapPhase(conditions)
+	if phase != instance.Status.BootstrapPhase {
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"bootstrap phase is now %s", phase)
+		instance.Status.BootstrapPhase = phase
+		changed = true
+	}
+
+	if changed {
+		err = r.Client.Status().Update(context.TODO(), instance)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to update bootstrap phases")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if phase == starlingxv1.BootstrapPhaseCompleted {
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{RequeueAfter: BootstrapPhaseInterval}, nil
+}
@@ -0,0 +1,260 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package system
+
+import (
+	"context"
+	"time"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// CertificateExpiryWarning defines how long before its expiry an installed
+// certificate starts being reported as expiring.
+const CertificateExpiryWarning = 30 * 24 * time.Hour
+
+// CertManagerCertificateAnnotation is the annotation set by cert-manager on
+// the secrets it issues to name the Certificate which requested them.
+const CertManagerCertificateAnnotation = "cert-manager.io/certificate-name"
+
+// certManagerCertificateKind identifies the cert-manager Certificate
+// resources.  They are read as unstructured objects so that cert-manager is
+// only required when certificates refer to them.
+var certManagerCertificateKind = schema.GroupVersionKind{
+	Group:   "cert-manager.io",
+	Version: "v1",
+	Kind:    "Certificate",
+}
+
+// certManagerCertificateReady determines whether a cert-manager Certificate
+// reports that its secret holds an up to date certificate.
+func certManagerCertificateReady(obj *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, item := range conditions {
+		condition, ok := item.(map[string]interface{})
+		if ok && condition["type"] == "Ready" && condition["status"] == "True" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// certificateSecretName resolves the name of the secret from which a
+// certificate is installed.  Certificates issued by cert-manager are
+// installed from the secret of their Certificate resource once it is ready;
+// until then an empty name is returned.
+func (r *SystemReconciler) certificateSecretName(namespace string, c starlingxv1.CertificateInfo) (string, error) {
+	if c.Certificate == "" {
+		return c.Secret, nil
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(certManagerCertificateKind)
+
+	err := r.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: c.Certificate}, obj)
+	if err != nil {
+		if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return "", nil
+		}
+
+		err = perrors.Wrapf(err, "failed to get certificate: %s", c.Certificate)
+		return "", err
+	}
+
+	if !certManagerCertificateReady(obj) {
+		return "", nil
+	}
+
+	name, _, _ := unstructured.NestedString(obj.Object, "spec", "secretName")
+
+	return name, nil
+}
+
+// certificateStatusEqual is a utility which compares two lists of
+// certificate status.
+func certificateStatusEqual(a, b []starlingxv1.CertificateStatus) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].DeepEqual(&b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// certificateExpiring determines whether a certificate expires within the
+// warning period.
+func certificateExpiring(status starlingxv1.CertificateStatus, now time.Time) bool {
+	return status.NotAfter != nil && now.Add(CertificateExpiryWarning).After(status.NotAfter.Time)
+}
+
+// certificateRotationRequired determines whether any secret from which a
+// certificate was installed has changed since it was installed, or whether a
+// cert-manager Certificate has yet to be installed.
+func (r *SystemReconciler) certificateRotationRequired(instance *starlingxv1.System) bool {
+	installed := make(map[string]bool)
+	for _, c := range instance.Status.Certificates {
+		if r.secretChanged(instance.Namespace, c.Secret, c.ResourceVersion) {
+			return true
+		}
+
+		if c.Certificate != "" {
+			installed[c.Certificate] = true
+		}
+	}
+
+	if instance.Spec.Certificates != nil {
+		for _, c := range *instance.Spec.Certificates {
+			if c.Certificate != "" && !installed[c.Certificate] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// secretChanged determines whether a secret no longer matches the resource
+// version recorded when its contents were last applied to the system.
+func (r *SystemReconciler) secretChanged(namespace, name, resourceVersion string) bool {
+	secret := v1.Secret{}
+	err := r.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, &secret)
+	if err != nil {
+		// Let the regular reconcile path deal with missing secrets.
+		return false
+	}
+
+	return secret.ResourceVersion != resourceVersion
+}
+
+// systemReferencesSecret determines whether a system refers to a secret
+// from any of the attributes that are installed from secrets.  Every system
+// depends on its system endpoint secret.
+func systemReferencesSecret(instance *starlingxv1.System, name string) bool {
+	if name == endpointSecretName(instance) {
+		return true
+	}
+
+	if instance.Spec.Certificates != nil {
+		for _, c := range *instance.Spec.Certificates {
+			if c.Secret == name {
+				return true
+			}
+		}
+	}
+
+	// Secrets issued by cert-manager are only known once installed.
+	for _, c := range instance.Status.Certificates {
+		if c.Secret == name {
+			return true
+		}
+	}
+
+	if instance.Spec.License != nil && instance.Spec.License.Secret == name {
+		return true
+	}
+
+	if instance.Spec.Registries != nil {
+		for _, reg := range *instance.Spec.Registries {
+			if reg.Secret != nil && *reg.Secret == name {
+				return true
+			}
+		}
+	}
+
+	if instance.Spec.RemoteAuthentication != nil {
+		for _, d := range instance.Spec.RemoteAuthentication.Domains {
+			if d.Secret != nil && *d.Secret == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// systemReferencesCertificate determines whether a system installs a
+// certificate from a cert-manager Certificate.
+func systemReferencesCertificate(instance *starlingxv1.System, name string) bool {
+	if name == "" || instance.Spec.Certificates == nil {
+		return false
+	}
+
+	for _, c := range *instance.Spec.Certificates {
+		if c.Certificate == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findSystemsForSecret maps a secret to the set of systems which refer to it
+// so that those systems are reconciled whenever the secret changes.
+func (r *SystemReconciler) findSystemsForSecret(obj client.Object) []reconcile.Request {
+	list := &starlingxv1.SystemList{}
+	err := r.Client.List(context.TODO(), list, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		logSystem.Error(err, "failed to list systems for secret", "secret", obj.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for i := range list.Items {
+		s := &list.Items[i]
+		certificate := obj.GetAnnotations()[CertManagerCertificateAnnotation]
+		if !systemReferencesSecret(s, obj.GetName()) && !systemReferencesCertificate(s, certificate) {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: s.Namespace, Name: s.Name},
+		})
+	}
+
+	return requests
+}
+
+// updateCertificateStatus records the certificates installed from secrets in
+// the system status and warns about certificates that are about to expire.
+func (r *SystemReconciler) updateCertificateStatus(instance *starlingxv1.System, certificates []starlingxv1.CertificateStatus) error {
+	if certificateStatusEqual(instance.Status.Certificates, certificates) {
+		return nil
+	}
+
+	now := time.Now()
+	for _, c := range certificates {
+		if certificateExpiring(c, now) {
+			r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+				"%q certificate from secret %q expires on %s", c.Type, c.Secret,
+				c.NotAfter.UTC().Format(time.RFC3339))
+		}
+	}
+
+	instance.Status.Certificates = certificates
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
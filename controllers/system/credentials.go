@@ -0,0 +1,172 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package system
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// endpointSecretName returns the name of the secret which holds the system
+// endpoint credentials of a system.
+func endpointSecretName(instance *starlingxv1.System) string {
+	if instance.Spec.EndpointSecret != nil && *instance.Spec.EndpointSecret != "" {
+		return *instance.Spec.EndpointSecret
+	}
+
+	return cloudManager.SystemEndpointSecretName
+}
+
+// passwordRotationRequested determines whether a new password has been
+// stored in the system endpoint secret and has yet to be applied to the
+// keystone user used to access the system.
+func passwordRotationRequested(secret *v1.Secret) (string, bool) {
+	password, ok := secret.Data[cloudManager.NewPasswordKey]
+	if !ok || len(password) == 0 {
+		return "", false
+	}
+
+	return string(password), true
+}
+
+// getPlatformUserID returns the ID of the keystone user that the platform
+// client authenticated as.
+func getPlatformUserID(client *gophercloud.ServiceClient, secret *v1.Secret) (string, error) {
+	if userID := string(secret.Data[cloudManager.UserIDKey]); userID != "" {
+		return userID, nil
+	}
+
+	result, ok := client.ProviderClient.GetAuthResult().(tokens.CreateResult)
+	if !ok {
+		return "", perrors.New("unable to determine the platform user from the authentication result")
+	}
+
+	user, err := result.ExtractUser()
+	if err != nil {
+		return "", perrors.Wrap(err, "failed to extract the platform user")
+	}
+
+	return user.ID, nil
+}
+
+// passwordAccepted determines whether keystone accepts a password for the
+// platform user.  It is used to recognize a rotation that was applied to
+// keystone but not yet recorded in the system endpoint secret.
+func (r *SystemReconciler) passwordAccepted(secret *v1.Secret, password string) bool {
+	candidate := secret.DeepCopy()
+	candidate.Data[cloudManager.PasswordKey] = []byte(password)
+
+	options, err := cloudManager.GetAuthOptionsFromSecret(candidate)
+	if err != nil {
+		return false
+	}
+
+	endpointTLS, err := cloudManager.GetEndpointTLS(r.Client, secret.Namespace, secret.Data)
+	if err != nil {
+		return false
+	}
+
+	for _, opts := range options {
+		if _, err = cloudManager.NewAuthenticatedClient(opts, endpointTLS.Config); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReconcileCredentialRotation is responsible for rotating the password of the
+// keystone user used to access the system.  A rotation is requested by storing
+// the new password in the system endpoint secret alongside the current
+// password.  Once keystone has been updated the secret is rewritten with the
+// new password which causes the platform client to be rebuilt without
+// restarting the manager.  Credentials provided by an external secret
+// provider are rotated by the provider itself and are left untouched.
+func (r *SystemReconciler) ReconcileCredentialRotation(client *gophercloud.ServiceClient, instance *starlingxv1.System) error {
+	if _, ok := cloudManager.ExternalCredentialsDir(instance.Namespace, endpointSecretName(instance)); ok {
+		return nil
+	}
+
+	secret := &v1.Secret{}
+	secretName := types.NamespacedName{Namespace: instance.Namespace, Name: endpointSecretName(instance)}
+
+	err := r.Client.Get(context.TODO(), secretName, secret)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to find system endpoint secret")
+		return err
+	}
+
+	password, ok := passwordRotationRequested(secret)
+	if !ok {
+		return nil
+	}
+
+	current := string(secret.Data[cloudManager.PasswordKey])
+	username := string(secret.Data[cloudManager.UsernameKey])
+
+	if password != current {
+		userID, err := getPlatformUserID(client, secret)
+		if err != nil {
+			return err
+		}
+
+		availability := gophercloud.Availability(secret.Data[cloudManager.InterfaceKey])
+		if availability == "" {
+			availability = gophercloud.AvailabilityPublic
+		}
+
+		identity, err := openstack.NewIdentityV3(client.ProviderClient, gophercloud.EndpointOpts{
+			Type:         cloudManager.IdentityEndpointType,
+			Availability: availability,
+			Region:       string(secret.Data[cloudManager.RegionNameKey]),
+		})
+		if err != nil {
+			err = perrors.Wrap(err, "failed to create identity client")
+			return err
+		}
+
+		logSystem.Info("rotating platform credentials", "user", username)
+
+		opts := users.ChangePasswordOpts{OriginalPassword: current, Password: password}
+		err = users.ChangePassword(identity, userID, opts).ExtractErr()
+		if err != nil {
+			if !r.passwordAccepted(secret, password) {
+				r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceUpdated,
+					"failed to rotate the platform credentials of user %q: %s", username, err.Error())
+				err = perrors.Wrapf(err, "failed to change password of user %q", username)
+				return err
+			}
+
+			// A previous rotation was applied to keystone before the secret
+			// could be updated so only the secret needs to be updated now.
+			logSystem.Info("platform credentials have already been rotated", "user", username)
+		}
+	}
+
+	secret.Data[cloudManager.PasswordKey] = []byte(password)
+	delete(secret.Data, cloudManager.NewPasswordKey)
+
+	err = r.Client.Update(context.TODO(), secret)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to update system endpoint secret")
+		return err
+	}
+
+	r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+		"platform credentials of user %q have been rotated", username)
+
+	// The secret update causes the platform client to be rebuilt with the new
+	// credentials before any further changes are made to the system.
+	return common.NewResourceStatusDependency("waiting for the platform client to be rebuilt with the rotated credentials")
+}
@@ -0,0 +1,174 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ldapDomainSection returns the identity service parameter section used to
+// store the attributes of the domain at the specified position.
+func ldapDomainSection(index int) string {
+	return fmt.Sprintf("%s%d", utils.ServiceParamSectionIdentityLDAPDomainPrefix, index+1)
+}
+
+// isLDAPDomainSection determines whether a service parameter section is used
+// to store the attributes of a remote authentication domain.
+func isLDAPDomainSection(service, section string) bool {
+	return service == utils.ServiceTypeIdentity &&
+		strings.HasPrefix(section, utils.ServiceParamSectionIdentityLDAPDomainPrefix)
+}
+
+// isProtectedValue determines whether a service parameter value has been
+// masked by the system API.  Masked values cannot be compared to the desired
+// value.
+func isProtectedValue(value string) bool {
+	return value != "" && strings.Trim(value, "*") == ""
+}
+
+// ldapDomainParameters converts the attributes of a remote authentication
+// domain to the list of identity service parameters used to configure it.
+func ldapDomainParameters(domain *starlingxv1.LDAPDomainInfo, index int, bindDN, password string) starlingxv1.ServiceParameterList {
+	section := ldapDomainSection(index)
+
+	values := map[string]string{
+		utils.ServiceParamNameLDAPDomainName: domain.DomainName,
+		utils.ServiceParamNameLDAPURI:        domain.URI,
+		utils.ServiceParamNameLDAPSearchBase: domain.SearchBase,
+	}
+
+	if domain.UserSearchBase != nil {
+		values[utils.ServiceParamNameLDAPUserSearchBase] = *domain.UserSearchBase
+	}
+
+	if domain.GroupSearchBase != nil {
+		values[utils.ServiceParamNameLDAPGroupSearchBase] = *domain.GroupSearchBase
+	}
+
+	if domain.AccessFilter != nil {
+		values[utils.ServiceParamNameLDAPAccessFilter] = *domain.AccessFilter
+	}
+
+	if bindDN != "" {
+		values[utils.ServiceParamNameLDAPBindDN] = bindDN
+		values[utils.ServiceParamNameLDAPBindPassword] = password
+	}
+
+	for name, value := range domain.Parameters {
+		if _, ok := values[name]; !ok {
+			values[name] = value
+		}
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make(starlingxv1.ServiceParameterList, 0, len(names))
+	for _, name := range names {
+		result = append(result, starlingxv1.ServiceParameterInfo{
+			Service:    utils.ServiceTypeIdentity,
+			Section:    section,
+			ParamName:  name,
+			ParamValue: values[name],
+		})
+	}
+
+	return result
+}
+
+// getLDAPBindCredentials retrieves the DN and password used to bind to a
+// remote authentication domain from the specified secret.
+func (r *SystemReconciler) getLDAPBindCredentials(instance *starlingxv1.System, name string) (bindDN, password string, err error) {
+	secret := v1.Secret{}
+	secretName := types.NamespacedName{Namespace: instance.Namespace, Name: name}
+	err = r.Client.Get(context.TODO(), secretName, &secret)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			err = perrors.Wrap(err, "failed to get LDAP bind secret")
+			return "", "", err
+		}
+
+		msg := fmt.Sprintf("waiting for LDAP bind secret %q to be created", name)
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency, msg)
+		return "", "", common.NewMissingKubernetesResource(msg)
+	}
+
+	username, ok := secret.Data[v1.BasicAuthUsernameKey]
+	if !ok {
+		msg := fmt.Sprintf("missing %q key in LDAP bind secret %s", v1.BasicAuthUsernameKey, name)
+		return "", "", common.NewUserDataError(msg)
+	}
+
+	passwd, ok := secret.Data[v1.BasicAuthPasswordKey]
+	if !ok {
+		msg := fmt.Sprintf("missing %q key in LDAP bind secret %s", v1.BasicAuthPasswordKey, name)
+		return "", "", common.NewUserDataError(msg)
+	}
+
+	return string(username), string(passwd), nil
+}
+
+// ReconcileRemoteAuthentication configures the remote LDAP and Windows Active
+// Directory domains used to authenticate platform users.  Each domain is
+// stored as a section of identity service parameters therefore the domains are
+// reconciled as a set of service parameters.
+func (r *SystemReconciler) ReconcileRemoteAuthentication(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
+	if !utils.IsReconcilerEnabled(utils.RemoteAuthentication) {
+		return nil
+	}
+
+	if spec.RemoteAuthentication == nil {
+		return nil
+	}
+
+	desired := make(starlingxv1.ServiceParameterList, 0)
+	for index := range spec.RemoteAuthentication.Domains {
+		domain := &spec.RemoteAuthentication.Domains[index]
+
+		bindDN, password := "", ""
+		if domain.Secret != nil {
+			var err error
+			bindDN, password, err = r.getLDAPBindCredentials(instance, *domain.Secret)
+			if err != nil {
+				return err
+			}
+		}
+
+		desired = append(desired, ldapDomainParameters(domain, index, bindDN, password)...)
+	}
+
+	// The bind password is masked by the system API and cannot be compared
+	// therefore it is only written when the parameter is created.
+	for i := range desired {
+		sp := &desired[i]
+		if sp.ParamName != utils.ServiceParamNameLDAPBindPassword {
+			continue
+		}
+
+		for _, current := range info.ServiceParameters {
+			if current.Service == sp.Service && current.Section == sp.Section &&
+				current.ParamName == sp.ParamName && isProtectedValue(current.ParamValue) {
+				sp.ParamValue = current.ParamValue
+			}
+		}
+	}
+
+	return r.reconcileServiceParameterList(client, instance, desired, isLDAPDomainSection, info)
+}
@@ -0,0 +1,49 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package system
+
+import (
+	"context"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	v1 "k8s.io/api/core/v1"
+)
+
+// licenseRotationRequired determines whether the secret from which the
+// license was installed has changed since it was installed.
+func (r *SystemReconciler) licenseRotationRequired(instance *starlingxv1.System) bool {
+	status := instance.Status.License
+	if status == nil {
+		return false
+	}
+
+	return r.secretChanged(instance.Namespace, status.Secret, status.ResourceVersion)
+}
+
+// updateLicenseStatus records the secret from which the license was
+// installed in the system status so that later updates to the secret can be
+// detected.
+func (r *SystemReconciler) updateLicenseStatus(instance *starlingxv1.System, secret *v1.Secret) error {
+	status := &starlingxv1.LicenseStatus{
+		Secret:          secret.Name,
+		ResourceVersion: secret.ResourceVersion,
+	}
+
+	if instance.Status.License != nil && instance.Status.License.DeepEqual(status) {
+		return nil
+	}
+
+	instance.Status.License = status
+
+	err := r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,289 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/secrets"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// registrySection returns the docker service parameter section used to store
+// the attributes of a registry.
+func registrySection(name string) string {
+	return name + utils.ServiceParamSectionDockerRegistrySuffix
+}
+
+// registryManaged determines whether a service parameter section stores the
+// attributes of one of the registries listed in the spec.
+func registryManaged(spec *starlingxv1.SystemSpec, service, section string) bool {
+	if spec.Registries == nil || service != utils.ServiceTypeDocker {
+		return false
+	}
+
+	for _, reg := range *spec.Registries {
+		if registrySection(reg.Name) == section {
+			return true
+		}
+	}
+
+	return false
+}
+
+// registryParameters converts the attributes of a registry to the list of
+// docker service parameters used to configure it.
+func registryParameters(reg *starlingxv1.RegistryInfo, authSecretID string) starlingxv1.ServiceParameterList {
+	section := registrySection(reg.Name)
+
+	param := func(name, value string) starlingxv1.ServiceParameterInfo {
+		return starlingxv1.ServiceParameterInfo{
+			Service:    utils.ServiceTypeDocker,
+			Section:    section,
+			ParamName:  name,
+			ParamValue: value,
+		}
+	}
+
+	result := starlingxv1.ServiceParameterList{
+		param(utils.ServiceParamNameRegistryURL, reg.URL),
+	}
+
+	if reg.Type != nil {
+		result = append(result, param(utils.ServiceParamNameRegistryType, *reg.Type))
+	}
+
+	if authSecretID != "" {
+		result = append(result, param(utils.ServiceParamNameRegistryAuthSecret, authSecretID))
+	}
+
+	if reg.Insecure != nil {
+		secure := "True"
+		if *reg.Insecure {
+			secure = "False"
+		}
+		result = append(result, param(utils.ServiceParamNameRegistrySecure, secure))
+	}
+
+	return result
+}
+
+// findRegistryStatus returns the status of the credentials configured for a
+// registry if any.
+func findRegistryStatus(statuses []starlingxv1.RegistryStatus, name string) *starlingxv1.RegistryStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
+		}
+	}
+
+	return nil
+}
+
+// staleRegistryAuthSecrets returns the key manager secrets which are no
+// longer referenced by any registry.
+func staleRegistryAuthSecrets(previous, current []starlingxv1.RegistryStatus) []string {
+	result := make([]string, 0)
+	for _, p := range previous {
+		found := false
+		for _, c := range current {
+			if c.AuthSecretID == p.AuthSecretID {
+				found = true
+				break
+			}
+		}
+
+		if !found && p.AuthSecretID != "" {
+			result = append(result, p.AuthSecretID)
+		}
+	}
+
+	return result
+}
+
+// registryRotationRequired determines whether any secret from which registry
+// credentials were configured has changed since they were configured.
+func (r *SystemReconciler) registryRotationRequired(instance *starlingxv1.System) bool {
+	for _, s := range instance.Status.Registries {
+		if r.secretChanged(instance.Namespace, s.Secret, s.ResourceVersion) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getRegistrySecret retrieves the secret holding the credentials of a
+// registry.
+func (r *SystemReconciler) getRegistrySecret(instance *starlingxv1.System, name string) (*v1.Secret, error) {
+	secret := &v1.Secret{}
+	secretName := types.NamespacedName{Namespace: instance.Namespace, Name: name}
+	err := r.Client.Get(context.TODO(), secretName, secret)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			err = perrors.Wrap(err, "failed to get registry secret")
+			return nil, err
+		}
+
+		msg := fmt.Sprintf("waiting for registry secret %q to be created", name)
+		r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency, msg)
+		return nil, common.NewMissingKubernetesResource(msg)
+	}
+
+	for _, key := range []string{v1.BasicAuthUsernameKey, v1.BasicAuthPasswordKey} {
+		if _, ok := secret.Data[key]; !ok {
+			msg := fmt.Sprintf("missing %q key in registry secret %s", key, name)
+			return nil, common.NewUserDataError(msg)
+		}
+	}
+
+	return secret, nil
+}
+
+// createRegistryAuthSecret stores the credentials of a registry in the key
+// manager and returns the identifier of the resulting secret.
+func createRegistryAuthSecret(client *gophercloud.ServiceClient, reg *starlingxv1.RegistryInfo, secret *v1.Secret) (string, error) {
+	opts := secrets.CreateOpts{
+		Name: fmt.Sprintf("%s-%s", registrySection(reg.Name), secret.ResourceVersion),
+		Payload: fmt.Sprintf("username:%s password:%s",
+			secret.Data[v1.BasicAuthUsernameKey], secret.Data[v1.BasicAuthPasswordKey]),
+		PayloadContentType: "text/plain",
+		SecretType:         secrets.OpaqueSecret,
+	}
+
+	result, err := secrets.Create(client, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to store credentials of registry: %s", reg.Name)
+		return "", err
+	}
+
+	return path.Base(strings.TrimSuffix(result.SecretRef, "/")), nil
+}
+
+// ReconcileRegistries configures the registries used in place of the public
+// registries.  Each registry is stored as a section of docker service
+// parameters and its credentials are stored in the key manager.  New
+// credentials are stored whenever the referenced secret is updated.
+func (r *SystemReconciler) ReconcileRegistries(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
+	if !utils.IsReconcilerEnabled(utils.Registries) {
+		return nil
+	}
+
+	if spec.Registries == nil {
+		return nil
+	}
+
+	var keyManager *gophercloud.ServiceClient
+
+	statuses := make([]starlingxv1.RegistryStatus, 0)
+	desired := make(starlingxv1.ServiceParameterList, 0)
+	for i := range *spec.Registries {
+		reg := &(*spec.Registries)[i]
+
+		authSecretID := ""
+		if reg.Secret != nil {
+			secret, err := r.getRegistrySecret(instance, *reg.Secret)
+			if err != nil {
+				return err
+			}
+
+			current := findRegistryStatus(instance.Status.Registries, reg.Name)
+			if current != nil && current.Secret == secret.Name && current.ResourceVersion == secret.ResourceVersion {
+				authSecretID = current.AuthSecretID
+			} else {
+				if keyManager == nil {
+					keyManager, err = r.CloudManager.BuildPlatformClient(instance.Namespace,
+						cloudManager.KeyManagerEndpointName, cloudManager.KeyManagerEndpointType)
+					if err != nil {
+						err = perrors.Wrap(err, "failed to build key manager client")
+						return err
+					}
+				}
+
+				authSecretID, err = createRegistryAuthSecret(keyManager, reg, secret)
+				if err != nil {
+					return err
+				}
+
+				r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+					"credentials of registry %q have been stored from secret %q", reg.Name, secret.Name)
+			}
+
+			statuses = append(statuses, starlingxv1.RegistryStatus{
+				Name:            reg.Name,
+				Secret:          secret.Name,
+				ResourceVersion: secret.ResourceVersion,
+				AuthSecretID:    authSecretID,
+			})
+		}
+
+		desired = append(desired, registryParameters(reg, authSecretID)...)
+	}
+
+	previous := instance.Status.Registries
+
+	// Record the stored credentials before referring to them so that they
+	// are reused rather than stored again if the update fails.
+	if !registryStatusEqual(previous, statuses) {
+		instance.Status.Registries = statuses
+
+		err := r.Client.Status().Update(context.TODO(), instance)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update status: %s",
+				common.FormatStruct(instance.Status))
+			return err
+		}
+	}
+
+	owned := func(service, section string) bool {
+		return registryManaged(spec, service, section)
+	}
+
+	err := r.reconcileServiceParameterList(client, instance, desired, owned, info)
+	if err != nil {
+		return err
+	}
+
+	stale := staleRegistryAuthSecrets(previous, statuses)
+	if len(stale) > 0 && keyManager != nil {
+		for _, id := range stale {
+			// The old credentials are no longer in use therefore a failure to
+			// remove them is not fatal.
+			err = secrets.Delete(keyManager, id).ExtractErr()
+			if err != nil {
+				logSystem.Error(err, "failed to delete registry credentials", "id", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+// registryStatusEqual is a utility which compares two lists of registry
+// status.
+func registryStatusEqual(a, b []starlingxv1.RegistryStatus) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].DeepEqual(&b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,237 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package system
+
+import (
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/snmpcommunities"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/snmptrapdests"
+)
+
+// snmpCommunityDelta determines which communities must be added and which
+// must be removed so that the system matches the desired list.
+func snmpCommunityDelta(desired starlingxv1.SNMPCommunityList, current []snmpcommunities.Community) (added []string, removed []snmpcommunities.Community) {
+	for _, d := range desired {
+		found := false
+		for _, c := range current {
+			if c.Community == string(d) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			added = append(added, string(d))
+		}
+	}
+
+	for _, c := range current {
+		found := false
+		for _, d := range desired {
+			if c.Community == string(d) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			removed = append(removed, c)
+		}
+	}
+
+	return added, removed
+}
+
+// snmpTrapDestDelta determines which trap destinations must be added,
+// modified, or removed so that the system matches the desired list.  Trap
+// destinations are identified by their address.
+func snmpTrapDestDelta(desired starlingxv1.SNMPTrapDestinationList, current []snmptrapdests.TrapDest) (added []starlingxv1.SNMPTrapDestinationInfo, modified map[string]string, removed []snmptrapdests.TrapDest) {
+	modified = make(map[string]string)
+
+	for _, d := range desired {
+		found := false
+		for _, c := range current {
+			if c.IPAddress == d.Address {
+				found = true
+				if c.Community != d.Community {
+					modified[c.ID] = d.Community
+				}
+				break
+			}
+		}
+
+		if !found {
+			added = append(added, d)
+		}
+	}
+
+	for _, c := range current {
+		found := false
+		for _, d := range desired {
+			if c.IPAddress == d.Address {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			removed = append(removed, c)
+		}
+	}
+
+	return added, modified, removed
+}
+
+// ReconcileSNMPCommunities configures the SNMP communities to align with the
+// desired list.
+func (r *SystemReconciler) ReconcileSNMPCommunities(client *gophercloud.ServiceClient, instance *starlingxv1.System, desired starlingxv1.SNMPCommunityList, info *v1info.SystemInfo) error {
+	added, removed := snmpCommunityDelta(desired, info.SNMPCommunities)
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	for _, c := range removed {
+		logSystem.Info("deleting SNMP community", "community", c.ID)
+
+		err := snmpcommunities.Delete(client, c.ID).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to delete SNMP community: %s", c.ID)
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceDeleted,
+			"SNMP community %q has been deleted", c.Community)
+	}
+
+	for _, name := range added {
+		opts := snmpcommunities.CommunityOpts{
+			Community: &name,
+		}
+
+		logSystem.Info("creating SNMP community", "community", name)
+
+		_, err := snmpcommunities.Create(client, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to create SNMP community: %s", name)
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+			"SNMP community %q has been created", name)
+	}
+
+	result, err := snmpcommunities.ListCommunities(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to refresh SNMP community list")
+		return err
+	}
+
+	info.SNMPCommunities = result
+
+	return nil
+}
+
+// ReconcileSNMPTrapDestinations configures the SNMP trap destinations to
+// align with the desired list.
+func (r *SystemReconciler) ReconcileSNMPTrapDestinations(client *gophercloud.ServiceClient, instance *starlingxv1.System, desired starlingxv1.SNMPTrapDestinationList, info *v1info.SystemInfo) error {
+	added, modified, removed := snmpTrapDestDelta(desired, info.SNMPTrapDests)
+	if len(added) == 0 && len(modified) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	for _, t := range removed {
+		logSystem.Info("deleting SNMP trap destination", "address", t.IPAddress)
+
+		err := snmptrapdests.Delete(client, t.ID).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to delete SNMP trap destination: %s", t.ID)
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceDeleted,
+			"SNMP trap destination %q has been deleted", t.IPAddress)
+	}
+
+	for id, community := range modified {
+		opts := snmptrapdests.TrapDestOpts{
+			Community: &community,
+		}
+
+		logSystem.Info("updating SNMP trap destination", "id", id, "opts", opts)
+
+		result, err := snmptrapdests.Update(client, id, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update SNMP trap destination: %s, %s",
+				id, common.FormatStruct(opts))
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"SNMP trap destination %q has been updated", result.IPAddress)
+	}
+
+	for _, t := range added {
+		t := t
+		opts := snmptrapdests.TrapDestOpts{
+			IPAddress: &t.Address,
+			Community: &t.Community,
+		}
+
+		logSystem.Info("creating SNMP trap destination", "opts", opts)
+
+		_, err := snmptrapdests.Create(client, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to create SNMP trap destination: %s",
+				common.FormatStruct(opts))
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
+			"SNMP trap destination %q has been created", t.Address)
+	}
+
+	result, err := snmptrapdests.ListTrapDests(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to refresh SNMP trap destination list")
+		return err
+	}
+
+	info.SNMPTrapDests = result
+
+	return nil
+}
+
+// ReconcileSNMP configures the SNMP communities and trap destinations of the
+// system.  Communities are reconciled first so that the trap destinations can
+// refer to them.
+func (r *SystemReconciler) ReconcileSNMP(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
+	if !utils.IsReconcilerEnabled(utils.SNMP) {
+		return nil
+	}
+
+	if spec.SNMP == nil {
+		return nil
+	}
+
+	if spec.SNMP.Communities != nil {
+		err := r.ReconcileSNMPCommunities(client, instance, *spec.SNMP.Communities, info)
+		if err != nil {
+			return err
+		}
+	}
+
+	if spec.SNMP.TrapDestinations != nil {
+		err := r.ReconcileSNMPTrapDestinations(client, instance, *spec.SNMP.TrapDestinations, info)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package system
+
+import (
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagebackends"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/storageceph"
+)
+
+// StorageBackendCeph defines the type of Ceph storage backends.
+const StorageBackendCeph = "ceph"
+
+// cephReplicationUpdateRequired determines whether the replication factors
+// of an existing Ceph backend must be updated.  Both factors are always sent
+// together since the system validates them against each other.
+func cephReplicationUpdateRequired(spec starlingxv1.StorageBackend, sb *storagebackends.StorageBackend) (opts storagebackends.StorageBackendOpts, result bool) {
+	replication := sb.Capabilities.Replication
+	if spec.ReplicationFactor != nil && strconv.Itoa(*spec.ReplicationFactor) != replication {
+		replication = strconv.Itoa(*spec.ReplicationFactor)
+		result = true
+	}
+
+	minReplication := sb.Capabilities.MinReplication
+	if spec.MinReplicationFactor != nil && strconv.Itoa(*spec.MinReplicationFactor) != minReplication {
+		minReplication = strconv.Itoa(*spec.MinReplicationFactor)
+		result = true
+	}
+
+	if result {
+		capabilities := map[string]interface{}{
+			"replication":     replication,
+			"min_replication": minReplication,
+		}
+		opts.Capabilities = &capabilities
+	}
+
+	return opts, result
+}
+
+// findCephPool returns the pool quotas of a Ceph backend.
+func findCephPool(info *v1info.SystemInfo, id string) *storageceph.StorageCeph {
+	for i := range info.CephPools {
+		if info.CephPools[i].ID == id {
+			return &info.CephPools[i]
+		}
+	}
+
+	return nil
+}
+
+// quotaUpdateRequired is a utility which returns the desired quota of a pool
+// if it differs from its current quota.
+func quotaUpdateRequired(desired, current *int) *int {
+	if desired != nil && (current == nil || *desired != *current) {
+		return desired
+	}
+
+	return nil
+}
+
+// cephPoolQuotaUpdateRequired determines whether the pool quotas of an
+// existing Ceph backend must be updated.  Only the modified quotas are
+// included in the request.
+func cephPoolQuotaUpdateRequired(quotas *starlingxv1.CephPoolQuotaInfo, current *storageceph.StorageCeph) (opts storageceph.StorageCephOpts, result bool) {
+	if quotas == nil {
+		return opts, false
+	}
+
+	opts.CinderPoolGib = quotaUpdateRequired(quotas.Cinder, current.CinderPoolGib)
+	opts.GlancePoolGib = quotaUpdateRequired(quotas.Glance, current.GlancePoolGib)
+	opts.EphemeralPoolGib = quotaUpdateRequired(quotas.Ephemeral, current.EphemeralPoolGib)
+	opts.ObjectPoolGib = quotaUpdateRequired(quotas.Object, current.ObjectPoolGib)
+	opts.KubePoolGib = quotaUpdateRequired(quotas.Kubernetes, current.KubePoolGib)
+
+	result = opts.CinderPoolGib != nil || opts.GlancePoolGib != nil ||
+		opts.EphemeralPoolGib != nil || opts.ObjectPoolGib != nil || opts.KubePoolGib != nil
+
+	return opts, result
+}
+
+// ReconcileCephBackend updates the replication factors and pool quotas of
+// an existing Ceph storage backend to align with the desired state.  Returns
+// true if the backend was modified.
+func (r *SystemReconciler) ReconcileCephBackend(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec starlingxv1.StorageBackend, sb *storagebackends.StorageBackend, info *v1info.SystemInfo) (bool, error) {
+	if sb.Backend != StorageBackendCeph {
+		return false, nil
+	}
+
+	updated := false
+
+	if opts, ok := cephReplicationUpdateRequired(spec, sb); ok {
+		logSystem.Info("updating ceph replication", "name", sb.Name, "opts", opts)
+
+		result, err := storagebackends.Update(client, sb.ID, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update ceph replication: %s, %s",
+				sb.Name, common.FormatStruct(opts))
+			return false, err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"%s storage backend replication has been updated to %s (min %s)",
+			sb.Name, result.Capabilities.Replication, result.Capabilities.MinReplication)
+
+		updated = true
+	}
+
+	if spec.PoolQuotas == nil {
+		return updated, nil
+	}
+
+	current := findCephPool(info, sb.ID)
+	if current == nil {
+		return updated, common.NewSystemDependency("ceph pool quotas are not available for " + sb.Name)
+	}
+
+	if opts, ok := cephPoolQuotaUpdateRequired(spec.PoolQuotas, current); ok {
+		logSystem.Info("updating ceph pool quotas", "name", sb.Name, "opts", opts)
+
+		_, err := storageceph.Update(client, sb.ID, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update ceph pool quotas: %s, %s",
+				sb.Name, common.FormatStruct(opts))
+			return updated, err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"%s storage backend pool quotas have been updated", sb.Name)
+
+		updated = true
+	}
+
+	return updated, nil
+}
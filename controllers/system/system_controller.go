@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -37,16 +38,20 @@ import (
 	"github.com/wind-river/cloud-platform-deployment-manager/controllers/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/systemdetails"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 var logSystem = log.Log.WithName("controller").WithName("system")
@@ -159,6 +164,19 @@ func ntpUpdateRequired(spec *starlingxv1.SystemSpec, info *ntp.NTP) (ntpOpts ntp
 	return ntpOpts, result
 }
 
+// ntpClockHosts is a utility which returns the names of the hosts that
+// synchronize their clock with NTP.
+func ntpClockHosts(objects []hosts.Host) []string {
+	result := make([]string, 0)
+	for _, h := range objects {
+		if h.ClockSynchronization != nil && *h.ClockSynchronization == starlingxv1.ClockSynchronizationNTP {
+			result = append(result, h.Hostname)
+		}
+	}
+
+	return result
+}
+
 // ReconcileNTP configures the system resources to align with the desired NTP state.
 func (r *SystemReconciler) ReconcileNTP(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
 	if !utils.IsReconcilerEnabled(utils.NTP) {
@@ -166,6 +184,23 @@ func (r *SystemReconciler) ReconcileNTP(client *gophercloud.ServiceClient, insta
 	}
 
 	if ntpOpts, ok := ntpUpdateRequired(spec, info.NTP); ok {
+		if *ntpOpts.NTPServers == NoContent {
+			// Removing every NTP server would leave hosts which synchronize
+			// their clock with NTP without a time source so wait for them to
+			// be switched to PTP first.
+			objects, err := hosts.ListHosts(client)
+			if err != nil {
+				err = perrors.Wrap(err, "failed to list hosts")
+				return err
+			}
+
+			if names := ntpClockHosts(objects); len(names) > 0 {
+				msg := fmt.Sprintf("waiting for hosts to switch to ptp clock synchronization before removing NTP servers: %s",
+					strings.Join(names, ","))
+				return common.NewResourceConfigurationDependency(msg)
+			}
+		}
+
 		logSystem.Info("updating NTP servers", "opts", ntpOpts)
 
 		result, err := ntp.Update(client, info.NTP.ID, ntpOpts).Extract()
@@ -182,7 +217,8 @@ func (r *SystemReconciler) ReconcileNTP(client *gophercloud.ServiceClient, insta
 }
 
 // ReconcileStorageBackend configures the storage Backend to align with the desired Ceph State
-// Only supports creating storage backends
+// Missing backends are created while existing Ceph backends have their
+// replication factors and pool quotas updated.
 func (r *SystemReconciler) ReconcileStorageBackends(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
 	if !utils.IsReconcilerEnabled(utils.Backends) {
 		return nil
@@ -197,18 +233,24 @@ func (r *SystemReconciler) ReconcileStorageBackends(client *gophercloud.ServiceC
 
 	updated := false
 	for _, spec_sb := range *spec.Storage.Backends {
-		found := false
-		for _, info_sb := range info.StorageBackends {
+		var existing *storagebackends.StorageBackend
+		for i := range info.StorageBackends {
+			info_sb := &info.StorageBackends[i]
 			// The Type parameter in the spec maps to the Backend
 			// parameter in the request
 			if info_sb.Backend == spec_sb.Type &&
 				info_sb.Name == spec_sb.Name {
-				found = true
+				existing = info_sb
 				break
 			}
 		}
 
-		if found {
+		if existing != nil {
+			changed, err := r.ReconcileCephBackend(client, instance, spec_sb, existing, info)
+			if err != nil {
+				return err
+			}
+			updated = updated || changed
 			continue
 		}
 
@@ -225,9 +267,14 @@ func (r *SystemReconciler) ReconcileStorageBackends(client *gophercloud.ServiceC
 		// In the spec, the parameter is named ReplicationFactor,
 		// and it maps to the replication key in the Capabilities
 		// dictionary
-		if spec_sb.ReplicationFactor != nil {
+		if spec_sb.ReplicationFactor != nil || spec_sb.MinReplicationFactor != nil {
 			capabilities := make(map[string]interface{})
-			capabilities["replication"] = strconv.Itoa(*spec_sb.ReplicationFactor)
+			if spec_sb.ReplicationFactor != nil {
+				capabilities["replication"] = strconv.Itoa(*spec_sb.ReplicationFactor)
+			}
+			if spec_sb.MinReplicationFactor != nil {
+				capabilities["min_replication"] = strconv.Itoa(*spec_sb.MinReplicationFactor)
+			}
 			opts.Capabilities = &capabilities
 		}
 
@@ -252,24 +299,53 @@ func (r *SystemReconciler) ReconcileStorageBackends(client *gophercloud.ServiceC
 	return nil
 }
 
+// splitServerList is a utility which converts a comma separated list of
+// servers, as reported by the system API, into a slice.
+func splitServerList(value string) []string {
+	result := make([]string, 0)
+	for _, s := range strings.Split(value, ",") {
+		if s = strings.TrimSpace(s); s != "" && s != NoContent {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+// serverListDelta is a utility which determines which servers have been added
+// to or removed from a list of servers.
+func serverListDelta(current, desired []string) (added, removed []string) {
+	for _, s := range desired {
+		if !utils.ContainsString(current, s) {
+			added = append(added, s)
+		}
+	}
+
+	for _, s := range current {
+		if !utils.ContainsString(desired, s) {
+			removed = append(removed, s)
+		}
+	}
+
+	return added, removed
+}
+
 // dnsUpdateRequired determines whether an update is required to the DNS
 // system attributes and returns the attributes to be changed if an update
-// is necessary.
+// is necessary.  The full list of servers is always sent so that servers
+// dropped from the spec are removed and the declared order is preserved.
 func dnsUpdateRequired(spec *starlingxv1.SystemSpec, info *dns.DNS) (dnsOpts dns.DNSOpts, result bool) {
-	if spec.DNSServers != nil {
-		var nameservers string
+	if spec.DNSServers == nil || len(*spec.DNSServers) == 0 {
+		return dnsOpts, false
+	}
 
-		if len(*spec.DNSServers) != 0 {
-			d := starlingxv1.DNSServerListToStrings(*spec.DNSServers)
-			nameservers = strings.Join(d, ",")
-		} else {
-			nameservers = NoContent
-		}
+	desired := starlingxv1.DNSServerListToStrings(*spec.DNSServers)
+	current := splitServerList(info.Nameservers)
 
-		if (nameservers != NoContent && info.Nameservers != nameservers) || nameservers == NoContent && info.Nameservers != "" {
-			dnsOpts.Nameservers = &nameservers
-			result = true
-		}
+	if !reflect.DeepEqual(current, desired) {
+		nameservers := strings.Join(desired, ",")
+		dnsOpts.Nameservers = &nameservers
+		result = true
 	}
 
 	return dnsOpts, result
@@ -282,8 +358,15 @@ func (r *SystemReconciler) ReconcileDNS(client *gophercloud.ServiceClient, insta
 		return nil
 	}
 
+	current := splitServerList(info.DNS.Nameservers)
+	if spec.DNSServers != nil && len(*spec.DNSServers) == 0 && len(current) != 0 {
+		msg := "at least one DNS server must remain configured"
+		return common.NewValidationError(msg)
+	}
+
 	if dnsOpts, ok := dnsUpdateRequired(spec, info.DNS); ok {
-		logSystem.Info("updating DNS servers", "opts", dnsOpts)
+		added, removed := serverListDelta(current, splitServerList(*dnsOpts.Nameservers))
+		logSystem.Info("updating DNS servers", "opts", dnsOpts, "added", added, "removed", removed)
 
 		result, err := dns.Update(client, info.DNS.ID, dnsOpts).Extract()
 		if err != nil {
@@ -292,7 +375,8 @@ func (r *SystemReconciler) ReconcileDNS(client *gophercloud.ServiceClient, insta
 
 		info.DNS = result
 
-		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "DNS servers have been updated")
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+			"DNS servers have been updated to %s", *dnsOpts.Nameservers)
 	}
 
 	return nil
@@ -420,16 +504,54 @@ func (r *SystemReconciler) RefreshServiceParameterList(client *gophercloud.Servi
 	return nil
 }
 
-// ReconcileServiceParameters configures the system resources to align with the desired ServiceParameter state.
-func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
-	if !utils.IsReconcilerEnabled(utils.ServiceParameters) {
-		return nil
+// serviceParameterApplyRequired determines whether changes to the parameters
+// of a service must be explicitly applied before they take effect.
+func serviceParameterApplyRequired(service string) bool {
+	for _, s := range utils.ApplyRequiredServiceTypes {
+		if s == service {
+			return true
+		}
 	}
-	if spec.ServiceParameters == nil {
-		return nil
+	return false
+}
+
+// ApplyServiceParameters applies the parameters of each modified service that
+// requires it so that the new values are put into effect.
+func (r *SystemReconciler) ApplyServiceParameters(client *gophercloud.ServiceClient, instance *starlingxv1.System, services map[string]bool) error {
+	names := make([]string, 0, len(services))
+	for service := range services {
+		if serviceParameterApplyRequired(service) {
+			names = append(names, service)
+		}
+	}
+	sort.Strings(names)
+
+	for _, service := range names {
+		opts := serviceparameters.ServiceApplyOpts{
+			Service: &service,
+		}
+
+		logSystem.Info("applying service parameters", "service", service)
+
+		err := serviceparameters.Apply(client, opts).Err
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to apply service parameters: %s", common.FormatStruct(opts))
+			return err
+		}
+
+		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "ServiceParameters for %q have been applied", service)
 	}
+
+	return nil
+}
+
+// reconcileServiceParameterList configures the service parameters selected by
+// the owned function to align with the desired list.  Parameters which are
+// not owned are left untouched so that they can be managed separately.
+func (r *SystemReconciler) reconcileServiceParameterList(client *gophercloud.ServiceClient, instance *starlingxv1.System, desired starlingxv1.ServiceParameterList, owned func(service, section string) bool, info *v1info.SystemInfo) error {
 	updated := false
-	for _, spec_sp := range *spec.ServiceParameters {
+	changed := make(map[string]bool)
+	for _, spec_sp := range desired {
 		found := false
 		for _, info_sp := range info.ServiceParameters {
 			// A match occurs when service, section and paramname are equal
@@ -444,6 +566,7 @@ func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.Servic
 					}
 					// success
 					updated = true
+					changed[result.Service] = true
 					r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "ServiceParameter %q %q %q has been modified", result.Service, result.Section, result.ParamName)
 				}
 				break
@@ -466,6 +589,7 @@ func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.Servic
 			}
 			// success
 			updated = true
+			changed[result.Service] = true
 			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated, "ServiceParameter %q %q %q has been created", result.Service, result.Section, result.ParamName)
 		}
 	}
@@ -481,8 +605,11 @@ func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.Servic
 	updated = false
 
 	for _, info_sp := range info.ServiceParameters {
+		if !owned(info_sp.Service, info_sp.Section) {
+			continue
+		}
 		found := false
-		for _, spec_sp := range *spec.ServiceParameters {
+		for _, spec_sp := range desired {
 			// A match occurs when service, section and paramname are equal
 			if info_sp.Service == spec_sp.Service &&
 				info_sp.Section == spec_sp.Section &&
@@ -512,7 +639,8 @@ func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.Servic
 			}
 			// success
 			updated = true
-			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceDeleted, "ServiceParameter %q %q %q has been created", info_sp.Service, info_sp.Section, info_sp.ParamName)
+			changed[info_sp.Service] = true
+			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceDeleted, "ServiceParameter %q %q %q has been deleted", info_sp.Service, info_sp.Section, info_sp.ParamName)
 		}
 
 	}
@@ -524,7 +652,26 @@ func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.Servic
 		}
 	}
 
-	return nil
+	return r.ApplyServiceParameters(client, instance, changed)
+}
+
+// ReconcileServiceParameters configures the system resources to align with the desired ServiceParameter state.
+func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
+	if !utils.IsReconcilerEnabled(utils.ServiceParameters) {
+		return nil
+	}
+	if spec.ServiceParameters == nil {
+		return nil
+	}
+	owned := func(service, section string) bool {
+		// The remote authentication domains and registries are managed
+		// separately
+		if spec.RemoteAuthentication != nil && isLDAPDomainSection(service, section) {
+			return false
+		}
+		return !registryManaged(spec, service, section)
+	}
+	return r.reconcileServiceParameterList(client, instance, *spec.ServiceParameters, owned, info)
 }
 
 func ControllerNodesAvailable(objects []hosts.Host, required int) bool {
@@ -690,12 +837,47 @@ func systemUpdateRequired(instance *starlingxv1.System, spec *starlingxv1.System
 	return opts, result
 }
 
+// systemAttributeChanges describes each of the attributes modified by a
+// system update so that every change can be reported individually.
+func systemAttributeChanges(opts system.SystemOpts, s *system.System) []string {
+	result := make([]string, 0)
+
+	describe := func(name string, from string, to *string) {
+		if to != nil {
+			result = append(result, fmt.Sprintf("%s has been changed from %q to %q", name, from, *to))
+		}
+	}
+
+	describe("name", s.Name, opts.Name)
+	describe("description", s.Description, opts.Description)
+	describe("contact", s.Contact, opts.Contact)
+	describe("location", s.Location, opts.Location)
+	describe("latitude", s.Latitude, opts.Latitude)
+	describe("longitude", s.Longitude, opts.Longitude)
+	describe("vswitch type", s.Capabilities.VSwitchType, opts.VSwitchType)
+
+	return result
+}
+
+// timezoneUpdateRequired determines whether the system timezone must be
+// updated to align with the desired state.
+func timezoneUpdateRequired(spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) (opts systemdetails.SystemDetailsOpts, result bool) {
+	if spec.Timezone != nil && *spec.Timezone != info.Timezone {
+		opts.Timezone = spec.Timezone
+		result = true
+	}
+
+	return opts, result
+}
+
 // ReconcileSystemAttributes configures the system resources to align with the desired state.
 func (r *SystemReconciler) ReconcileSystemAttributes(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
 	if utils.IsReconcilerEnabled(utils.System) {
 		if opts, ok := systemUpdateRequired(instance, spec, &info.System); ok {
 			logSystem.Info("updating system config", "opts", opts)
 
+			changes := systemAttributeChanges(opts, &info.System)
+
 			result, err := system.Update(client, info.ID, opts).Extract()
 			if err != nil {
 				return err
@@ -703,7 +885,25 @@ func (r *SystemReconciler) ReconcileSystemAttributes(client *gophercloud.Service
 
 			info.System = *result
 
-			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "system has been updated")
+			for _, change := range changes {
+				r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated, "system %s", change)
+			}
+		}
+
+		if opts, ok := timezoneUpdateRequired(spec, info); ok {
+			logSystem.Info("updating system timezone", "opts", opts)
+
+			result, err := systemdetails.Update(client, info.ID, opts).Extract()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to update system timezone: %s",
+					common.FormatStruct(opts))
+				return err
+			}
+
+			r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceUpdated,
+				"system timezone has been changed from %q to %q", info.Timezone, result.Timezone)
+
+			info.Timezone = result.Timezone
 		}
 	}
 
@@ -762,8 +962,10 @@ func (r *SystemReconciler) ReconcileCertificates(client *gophercloud.ServiceClie
 
 	// Certificates cannot be deleted once they are installed so look at the
 	// list of certificates coming from the user and add any that are missing
-	// from the system.
+	// from the system.  A certificate is re-installed whenever the content
+	// of its secret changes since the new certificate has a new signature.
 	updated := false
+	statuses := make([]starlingxv1.CertificateStatus, 0)
 	for _, c := range *spec.Certificates {
 		secret := v1.Secret{}
 
@@ -825,6 +1027,15 @@ func (r *SystemReconciler) ReconcileCertificates(client *gophercloud.ServiceClie
 		// for the purpose of comparisons.
 		signature := fmt.Sprintf("%s_%d", c.Type, cert.SerialNumber)
 
+		statuses = append(statuses, starlingxv1.CertificateStatus{
+			Type:            c.Type,
+			Secret:          c.Secret,
+			Certificate:     c.Certificate,
+			ResourceVersion: secret.ResourceVersion,
+			Signature:       signature,
+			NotAfter:        &metav1.Time{Time: cert.NotAfter},
+		})
+
 		found := false
 		for _, certificate := range info.Certificates {
 			if certificate.Signature == signature {
@@ -866,7 +1077,7 @@ func (r *SystemReconciler) ReconcileCertificates(client *gophercloud.ServiceClie
 		info.Certificates = result
 	}
 
-	return nil
+	return r.updateCertificateStatus(instance, statuses)
 }
 
 // ReconcileLicense configures the system license to align with the desired
@@ -887,7 +1098,7 @@ func (r *SystemReconciler) ReconcileLicense(client *gophercloud.ServiceClient, i
 	err := r.Client.Get(context.TODO(), secretName, &secret)
 	if err != nil {
 		if !errors.IsNotFound(err) {
-			err = perrors.Wrap(err, "failed to get certificate secret")
+			err = perrors.Wrap(err, "failed to get license secret")
 			return err
 		}
 
@@ -898,7 +1109,7 @@ func (r *SystemReconciler) ReconcileLicense(client *gophercloud.ServiceClient, i
 
 	contents, ok := secret.Data[starlingxv1.SecretLicenseContentKey]
 	if !ok {
-		msg := fmt.Sprintf("missing %q key in certificate secret %s",
+		msg := fmt.Sprintf("missing %q key in license secret %s",
 			starlingxv1.SecretLicenseContentKey, spec.License.Secret)
 		return common.NewUserDataError(msg)
 	}
@@ -918,7 +1129,7 @@ func (r *SystemReconciler) ReconcileLicense(client *gophercloud.ServiceClient, i
 		}
 
 		r.ReconcilerEventLogger.NormalEvent(instance, common.ResourceCreated,
-			"license has been installed")
+			"license has been installed from secret %q", spec.License.Secret)
 
 		result, err := licenses.Get(client).Extract()
 		if err != nil {
@@ -929,7 +1140,7 @@ func (r *SystemReconciler) ReconcileLicense(client *gophercloud.ServiceClient, i
 		info.License = result
 	}
 
-	return nil
+	return r.updateLicenseStatus(instance, &secret)
 }
 
 // ReconcileSystemInitial is responsible for reconciling the system attributes
@@ -980,6 +1191,21 @@ func (r *SystemReconciler) ReconcileSystemInitial(client *gophercloud.ServiceCli
 		return err
 	}
 
+	err = r.ReconcileSNMP(client, instance, spec, info)
+	if err != nil {
+		return err
+	}
+
+	err = r.ReconcileRemoteAuthentication(client, instance, spec, info)
+	if err != nil {
+		return err
+	}
+
+	err = r.ReconcileRegistries(client, instance, spec, info)
+	if err != nil {
+		return err
+	}
+
 	err = r.ReconcileStorageBackends(client, instance, spec, info)
 	if err != nil {
 		return err
@@ -1023,9 +1249,18 @@ func (r *SystemReconciler) ReconcileRequired(instance *starlingxv1.System, spec
 		return err, false
 	}
 
+	// The endpoint secret, the bootstrap health checks, the software patches
+	// and the preflight health check are not system attributes therefore they
+	// never differ from the current configuration.
+	current.EndpointSecret = spec.EndpointSecret
+	current.Bootstrap = spec.Bootstrap
+	current.Software = spec.Software
+	current.Preflight = spec.Preflight
+
 	if spec.DeepEqual(current) {
 		logSystem.V(2).Info("no changes between spec and current configuration")
 		instance.Status.Delta = ""
+		instance.Status.DeltaAttributes = nil
 		return nil, false
 	}
 
@@ -1038,9 +1273,15 @@ func (r *SystemReconciler) ReconcileRequired(instance *starlingxv1.System, spec
 		logSystem.Info(fmt.Sprintf("failed to get Delta status:  %s\n", err))
 	}
 
-	if deltaString != "" {
+	deltaAttributes, err := common.GetDeltaAttributes(spec, current)
+	if err != nil {
+		logSystem.Info(fmt.Sprintf("failed to get delta attributes:  %s\n", err))
+	}
+
+	if deltaString != "" || len(deltaAttributes) > 0 {
 		logSystem.Info(fmt.Sprintf("delta configuration:%s\n", deltaString))
 		instance.Status.Delta = deltaString
+		instance.Status.DeltaAttributes = deltaAttributes
 		err = r.Client.Status().Update(context.TODO(), instance)
 		if err != nil {
 			logSystem.Info(fmt.Sprintf("failed to update status:  %s\n", err))
@@ -1221,18 +1462,29 @@ func (r *SystemReconciler) GetCertificateSignatures(instance *starlingxv1.System
 
 	for _, c := range *instance.Spec.Certificates {
 		// Ignore certificates installed during bootstrap/initial unlock
-		// - Openstack_CA/OpenLDAP/Docker/SSL(HTTPS)
-		if c.Type == starlingxv1.OpenstackCACertificate || c.Type == starlingxv1.DockerCertificate ||
-			c.Type == starlingxv1.PlatformCertificate || c.Type == starlingxv1.OpenLDAPCertificate {
+		// - Openstack_CA/OpenLDAP/Docker/SSL(HTTPS) unless they are issued
+		// by cert-manager.
+		if c.Certificate == "" && (c.Type == starlingxv1.OpenstackCACertificate || c.Type == starlingxv1.DockerCertificate ||
+			c.Type == starlingxv1.PlatformCertificate || c.Type == starlingxv1.OpenLDAPCertificate) {
 			logSystem.Info("Ignoring certificate created at bootstrap and managed by the system.",
 				"secret", c.Secret, "type", c.Type)
 			continue
 		}
 
+		name, err := r.certificateSecretName(instance.Namespace, c)
+		if err != nil {
+			return err
+		} else if name == "" {
+			// The secret is installed once cert-manager has issued it.
+			msg := fmt.Sprintf("waiting for %q certificate %q to be issued", c.Type, c.Certificate)
+			r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency, msg)
+			continue
+		}
+
 		secret := v1.Secret{}
 
-		secretName := types.NamespacedName{Namespace: instance.Namespace, Name: c.Secret}
-		err := r.Client.Get(context.TODO(), secretName, &secret)
+		secretName := types.NamespacedName{Namespace: instance.Namespace, Name: name}
+		err = r.Client.Get(context.TODO(), secretName, &secret)
 		if err != nil {
 			if !errors.IsNotFound(err) {
 				err = perrors.Wrap(err, "failed to get certificate secret")
@@ -1242,26 +1494,26 @@ func (r *SystemReconciler) GetCertificateSignatures(instance *starlingxv1.System
 			// If we don't find the corresponding secret, this is most likely
 			// a certificate installed outside the scope of deployment-manager
 			// and will be ignored here.
-			msg := fmt.Sprintf("skipping %q certificate %q from system", c.Type, c.Secret)
+			msg := fmt.Sprintf("skipping %q certificate %q from system", c.Type, name)
 			r.ReconcilerEventLogger.WarningEvent(instance, common.ResourceDependency, msg)
 		}
 
 		pemBlock, ok := secret.Data[starlingxv1.SecretCertKey]
 		if !ok {
 			msg := fmt.Sprintf("missing %q key in certificate secret %s",
-				starlingxv1.SecretCertKey, c.Secret)
+				starlingxv1.SecretCertKey, name)
 			return common.NewUserDataError(msg)
 		}
 
 		block, _ := pem.Decode(pemBlock)
 		if block == nil {
-			msg := fmt.Sprintf("unexpected certificate contents in secret %s", c.Secret)
+			msg := fmt.Sprintf("unexpected certificate contents in secret %s", name)
 			return common.NewUserDataError(msg)
 		}
 
 		cert, err = x509.ParseCertificate(block.Bytes)
 		if cert == nil || err != nil {
-			msg := fmt.Sprintf("corrupt certificate contents in secret %s", c.Secret)
+			msg := fmt.Sprintf("corrupt certificate contents in secret %s", name)
 			return common.NewUserDataError(msg)
 		}
 
@@ -1270,9 +1522,10 @@ func (r *SystemReconciler) GetCertificateSignatures(instance *starlingxv1.System
 		signature := fmt.Sprintf("%s_%d", c.Type, cert.SerialNumber)
 
 		certificate := starlingxv1.CertificateInfo{
-			Type:      c.Type,
-			Secret:    c.Secret,
-			Signature: signature,
+			Type:        c.Type,
+			Secret:      name,
+			Certificate: c.Certificate,
+			Signature:   signature,
 		}
 		result = append(result, certificate)
 	}
@@ -1289,11 +1542,11 @@ func (r *SystemReconciler) GetCertificateSignatures(instance *starlingxv1.System
 // state of a data network with the state stored in the k8s database.
 func (r *SystemReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.System) (err error) {
 
-	systemInfo := v1info.SystemInfo{}
-	err = systemInfo.PopulateSystemInfo(client)
+	info, err := r.CloudManager.GetSystemInfo(instance.Namespace, client)
 	if err != nil {
 		return err
 	}
+	systemInfo := *info
 
 	defaults, err := r.GetSystemDefaults(instance)
 	if err != nil {
@@ -1391,32 +1644,37 @@ func (r *SystemReconciler) StopAfterInSync() bool {
 // (It seems Client.Get does not update Status value from configuration)
 // "bootstrap" if "bootstrap" in configuration or deploymentScope not specified
 // "principal" if "principal" in configuration
+// The scope requested through the spec of the v2 API is conveyed by the
+// conversion webhook in a dedicated annotation which takes precedence.
 func (r *SystemReconciler) GetScopeConfig(instance *starlingxv1.System) (scope string, err error) {
 	// Set default value for deployment scope
 	deploymentScope := cloudManager.ScopeBootstrap
 	// Set DeploymentScope from configuration
 	annotation := instance.GetObjectMeta().GetAnnotations()
 	if annotation != nil {
-		config, ok := annotation["kubectl.kubernetes.io/last-applied-configuration"]
-		if ok {
-			status_config := &starlingxv1.System{}
-			err := json.Unmarshal([]byte(config), &status_config)
-			if err == nil {
-				if status_config.Status.DeploymentScope != "" {
-					lowerCaseScope := strings.ToLower(status_config.Status.DeploymentScope)
-					switch lowerCaseScope {
-					case cloudManager.ScopeBootstrap:
-						deploymentScope = cloudManager.ScopeBootstrap
-					case cloudManager.ScopePrincipal:
-						deploymentScope = cloudManager.ScopePrincipal
-					default:
-						err = fmt.Errorf("Unsupported DeploymentScope: %s",
-							status_config.Status.DeploymentScope)
-						return deploymentScope, err
-					}
+		requested, ok := annotation[starlingxv1.DeploymentScopeAnnotation]
+		if !ok {
+			config, ok := annotation["kubectl.kubernetes.io/last-applied-configuration"]
+			if ok {
+				status_config := &starlingxv1.System{}
+				err := json.Unmarshal([]byte(config), &status_config)
+				if err != nil {
+					err = perrors.Wrapf(err, "failed to Unmarshal annotaion last-applied-configuration")
+					return deploymentScope, err
 				}
-			} else {
-				err = perrors.Wrapf(err, "failed to Unmarshal annotaion last-applied-configuration")
+				requested = status_config.Status.DeploymentScope
+			}
+		}
+
+		if requested != "" {
+			lowerCaseScope := strings.ToLower(requested)
+			switch lowerCaseScope {
+			case cloudManager.ScopeBootstrap:
+				deploymentScope = cloudManager.ScopeBootstrap
+			case cloudManager.ScopePrincipal:
+				deploymentScope = cloudManager.ScopePrincipal
+			default:
+				err = fmt.Errorf("Unsupported DeploymentScope: %s", requested)
 				return deploymentScope, err
 			}
 		}
@@ -1520,12 +1778,13 @@ func (r *SystemReconciler) UpdateConfigStatus(instance *starlingxv1.System) (err
 	return nil
 }
 
-// Filter out certificates with type other than "ssl_ca"
+// Filter out certificates with type other than "ssl_ca" unless they are
+// issued by cert-manager
 func clean_deprecated_certificates(certs starlingxv1.CertificateList) starlingxv1.CertificateList {
 	originalLength := len(certs)
 	filteredCerts := make([]starlingxv1.CertificateInfo, 0, originalLength)
 	for _, cert := range certs {
-		if cert.Type == starlingxv1.PlatformCACertificate {
+		if cert.Type == starlingxv1.PlatformCACertificate || cert.Certificate != "" {
 			filteredCerts = append(filteredCerts, cert)
 		}
 	}
@@ -1536,6 +1795,7 @@ func clean_deprecated_certificates(certs starlingxv1.CertificateList) starlingxv
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=systems,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=systems/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=systems/finalizers,verbs=update
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch
 func (r *SystemReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
 	_ = log.FromContext(ctx)
 
@@ -1562,7 +1822,17 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 	// Cancel any existing monitors
 	r.CloudManager.CancelMonitor(instance)
 
+	// Each system may target a different endpoint therefore the clients of
+	// the namespace are built from the secret referenced by the system.
+	r.CloudManager.SetEndpointSecret(request.Namespace, endpointSecretName(instance))
+
 	platformClient := r.CloudManager.GetPlatformClient(request.Namespace)
+	if platformClient != nil && r.CloudManager.PlatformClientStale(request.Namespace) {
+		// The system endpoint secret has changed since the client was built
+		// therefore rebuild it so that the current credentials are used.
+		logSystem.Info("system endpoint secret has changed; rebuilding platform client")
+		platformClient = nil
+	}
 
 	// Restore the data network status
 	if r.checkRestoreInProgress(instance) {
@@ -1597,10 +1867,32 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	// Leave the resource untouched while the paused annotation is present.
+	paused, err := common.ReconcilePaused(r.Client, r.ReconcilerEventLogger, instance, &instance.Status.Conditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
 	if instance.Status.ObservedGeneration == instance.ObjectMeta.Generation &&
 		instance.Status.Reconciled &&
-		platformClient != nil {
-		return ctrl.Result{}, nil
+		platformClient != nil &&
+		!r.certificateRotationRequired(instance) &&
+		!r.licenseRotationRequired(instance) &&
+		!r.registryRotationRequired(instance) {
+		err = common.ReconcileConditions(r.Client, instance, &instance.Status.Conditions,
+			instance.Status.Reconciled, instance.Status.InSync)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		result, err := r.ReconcileBootstrapPhases(platformClient, instance)
+		if err != nil {
+			return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+		}
+
+		return result, nil
 	}
 
 	// Update scope from configuration
@@ -1640,6 +1932,16 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		// Create the platform client
 		platformClient, err = r.CloudManager.BuildPlatformClient(request.Namespace, cloudManager.SystemEndpointName, cloudManager.SystemEndpointType)
 		if err != nil {
+			if cloudManager.IsAuthenticationError(err) {
+				// Report rejected credentials through the Authenticated
+				// condition so that they are distinguishable from other
+				// reconciliation failures.
+				err2 := common.UpdateStandardConditions(r.Client, instance, &instance.Status.Conditions,
+					instance.Status.Reconciled, instance.Status.InSync, err)
+				if err2 != nil {
+					logSystem.Error(err2, "failed to update system conditions")
+				}
+			}
 			return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
 		}
 
@@ -1664,12 +1966,33 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		logSystem.V(2).Info("Strategy not applied")
 	}
 
+	platformClient = cloudManager.WithChangeInitiator(platformClient, instance)
+
+	err = r.ReconcileCredentialRotation(platformClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if err != nil {
+		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+	}
+
 	err = r.ReconcileResource(platformClient, instance)
+	common.ReportPlannedChange(r.ReconcilerEventLogger, instance, err)
+	if instance.DeletionTimestamp.IsZero() {
+		err2 := common.UpdateStandardConditions(r.Client, instance, &instance.Status.Conditions,
+			instance.Status.Reconciled, instance.Status.InSync, err)
+		if err2 != nil {
+			logSystem.Error(err2, "failed to update system conditions")
+		}
+	}
 	if err != nil {
 		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
 	}
 
-	return ctrl.Result{}, nil
+	result, err := r.ReconcileBootstrapPhases(platformClient, instance)
+	if err != nil {
+		return r.ReconcilerErrorHandler.HandleReconcilerError(request, err)
+	}
+
+	return result, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -1682,11 +2005,18 @@ func (r *SystemReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.ReconcilerErrorHandler = &common.ErrorHandler{
 		CloudManager: tMgr,
 		Logger:       logSystem}
-	r.ReconcilerEventLogger = &common.EventLogger{
-		EventRecorder: mgr.GetEventRecorderFor(SystemControllerName),
-		Logger:        logSystem}
+	r.ReconcilerEventLogger = common.NewEventLogger(mgr.GetEventRecorderFor(SystemControllerName), logSystem)
+
+	// Platform alarms are mirrored independently of the reconciliation of
+	// the system so that they are refreshed at their own interval.
+	if err := (&AlarmSyncReconciler{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.System{}).
+		Watches(&source.Kind{Type: &v1.Secret{}},
+			handler.EnqueueRequestsFromMapFunc(r.findSystemsForSecret)).
 		Complete(r)
 }
 
@@ -1,15 +1,28 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022 Wind River Systems, Inc. */
+/* Copyright(c) 2022-2024 Wind River Systems, Inc. */
 package system
 
 import (
 	"context"
+	"time"
+
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/dns"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagebackends"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/system"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/snmpcommunities"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/snmptrapdests"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/storageceph"
 )
 
 var _ = Describe("System controller", func() {
@@ -76,6 +89,316 @@ var _ = Describe("System controller", func() {
 			outCerts := clean_deprecated_certificates(certs)
 			Expect(outCerts).To(Equal(expOutCerts))
 		})
+
+		It("Should keep certificates issued by cert-manager", func() {
+			certs := starlingxv1.CertificateList{
+				{Type: starlingxv1.PlatformCertificate, Certificate: "system-restapi-gui-certificate"},
+				{Type: starlingxv1.DockerCertificate, Secret: "docker"},
+			}
+			outCerts := clean_deprecated_certificates(certs)
+			Expect(outCerts).To(Equal(certs[:1]))
+		})
+	})
+
+	Context("Test dnsUpdateRequired func", func() {
+		It("Should replace the full list of servers in the declared order", func() {
+			servers := starlingxv1.DNSServerList{"8.8.4.4", "8.8.8.8"}
+			spec := &starlingxv1.SystemSpec{DNSServers: &servers}
+
+			_, ok := dnsUpdateRequired(spec, &dns.DNS{Nameservers: "8.8.4.4, 8.8.8.8"})
+			Expect(ok).To(BeFalse())
+
+			opts, ok := dnsUpdateRequired(spec, &dns.DNS{Nameservers: "8.8.8.8,8.8.4.4,1.1.1.1"})
+			Expect(ok).To(BeTrue())
+			Expect(*opts.Nameservers).To(Equal("8.8.4.4,8.8.8.8"))
+		})
+
+		It("Should report the servers added and removed", func() {
+			added, removed := serverListDelta([]string{"8.8.8.8", "1.1.1.1"}, []string{"8.8.4.4", "8.8.8.8"})
+			Expect(added).To(Equal([]string{"8.8.4.4"}))
+			Expect(removed).To(Equal([]string{"1.1.1.1"}))
+		})
+	})
+
+	Context("Test ntpClockHosts func", func() {
+		It("Should report the hosts synchronizing with NTP", func() {
+			ntpSource := starlingxv1.ClockSynchronizationNTP
+			ptpSource := starlingxv1.ClockSynchronizationPTP
+			objects := []hosts.Host{
+				{Hostname: "controller-0", ClockSynchronization: &ntpSource},
+				{Hostname: "controller-1", ClockSynchronization: &ptpSource},
+				{Hostname: "worker-0"},
+			}
+			Expect(ntpClockHosts(objects)).To(Equal([]string{"controller-0"}))
+		})
+	})
+
+	Context("Test serviceParameterApplyRequired func", func() {
+		It("Should only require apply for services that need it", func() {
+			Expect(serviceParameterApplyRequired("platform")).To(BeTrue())
+			Expect(serviceParameterApplyRequired("kubernetes")).To(BeTrue())
+			Expect(serviceParameterApplyRequired("horizon")).To(BeFalse())
+		})
+	})
+
+	Context("Test system attribute update funcs", func() {
+		It("Should describe each modified attribute", func() {
+			name := "system-1"
+			contact := "ops@example.com"
+			current := &system.System{Name: "system-0", Contact: "admin@example.com", Location: "lab"}
+			opts := system.SystemOpts{Name: &name, Contact: &contact}
+			Expect(systemAttributeChanges(opts, current)).To(Equal([]string{
+				`name has been changed from "system-0" to "system-1"`,
+				`contact has been changed from "admin@example.com" to "ops@example.com"`,
+			}))
+			Expect(systemAttributeChanges(system.SystemOpts{}, current)).To(BeEmpty())
+		})
+
+		It("Should only update the timezone when it differs", func() {
+			timezone := "America/Toronto"
+			info := &v1info.SystemInfo{Timezone: "UTC"}
+			opts, ok := timezoneUpdateRequired(&starlingxv1.SystemSpec{Timezone: &timezone}, info)
+			Expect(ok).To(BeTrue())
+			Expect(*opts.Timezone).To(Equal(timezone))
+
+			info.Timezone = timezone
+			_, ok = timezoneUpdateRequired(&starlingxv1.SystemSpec{Timezone: &timezone}, info)
+			Expect(ok).To(BeFalse())
+			_, ok = timezoneUpdateRequired(&starlingxv1.SystemSpec{}, info)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("Test ceph backend update funcs", func() {
+		It("Should send both replication factors when either changes", func() {
+			replication := 3
+			sb := &storagebackends.StorageBackend{Backend: "ceph",
+				Capabilities: storagebackends.Capabilities{Replication: "2", MinReplication: "1"}}
+			opts, ok := cephReplicationUpdateRequired(starlingxv1.StorageBackend{ReplicationFactor: &replication}, sb)
+			Expect(ok).To(BeTrue())
+			Expect(*opts.Capabilities).To(Equal(map[string]interface{}{"replication": "3", "min_replication": "1"}))
+
+			replication = 2
+			_, ok = cephReplicationUpdateRequired(starlingxv1.StorageBackend{ReplicationFactor: &replication}, sb)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("Should only update the modified pool quotas", func() {
+			cinder, glance, current := 200, 50, 100
+			quotas := &starlingxv1.CephPoolQuotaInfo{Cinder: &cinder, Glance: &glance}
+			pool := &storageceph.StorageCeph{CinderPoolGib: &current, GlancePoolGib: &glance}
+			opts, ok := cephPoolQuotaUpdateRequired(quotas, pool)
+			Expect(ok).To(BeTrue())
+			Expect(*opts.CinderPoolGib).To(Equal(200))
+			Expect(opts.GlancePoolGib).To(BeNil())
+
+			pool.CinderPoolGib = &cinder
+			_, ok = cephPoolQuotaUpdateRequired(quotas, pool)
+			Expect(ok).To(BeFalse())
+			_, ok = cephPoolQuotaUpdateRequired(nil, pool)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("Test SNMP delta funcs", func() {
+		It("Should add and remove communities to match the spec", func() {
+			desired := starlingxv1.SNMPCommunityList{"public", "monitoring"}
+			current := []snmpcommunities.Community{{ID: "1", Community: "public"}, {ID: "2", Community: "old"}}
+			added, removed := snmpCommunityDelta(desired, current)
+			Expect(added).To(Equal([]string{"monitoring"}))
+			Expect(removed).To(Equal([]snmpcommunities.Community{{ID: "2", Community: "old"}}))
+		})
+
+		It("Should add, modify and remove trap destinations to match the spec", func() {
+			desired := starlingxv1.SNMPTrapDestinationList{
+				{Address: "10.10.10.1", Community: "monitoring"},
+				{Address: "10.10.10.2", Community: "public"},
+			}
+			current := []snmptrapdests.TrapDest{
+				{ID: "1", IPAddress: "10.10.10.1", Community: "public"},
+				{ID: "3", IPAddress: "10.10.10.3", Community: "public"},
+			}
+			added, modified, removed := snmpTrapDestDelta(desired, current)
+			Expect(added).To(Equal([]starlingxv1.SNMPTrapDestinationInfo{{Address: "10.10.10.2", Community: "public"}}))
+			Expect(modified).To(Equal(map[string]string{"1": "monitoring"}))
+			Expect(removed).To(Equal([]snmptrapdests.TrapDest{{ID: "3", IPAddress: "10.10.10.3", Community: "public"}}))
+		})
+	})
+
+	Context("Test ldapDomainParameters func", func() {
+		It("Should convert a domain to identity service parameters", func() {
+			filter := "memberOf=cn=admins,dc=example,dc=com"
+			domain := starlingxv1.LDAPDomainInfo{
+				DomainName:   "example.com",
+				URI:          "ldaps://ad.example.com",
+				SearchBase:   "dc=example,dc=com",
+				AccessFilter: &filter,
+			}
+			params := ldapDomainParameters(&domain, 1, "cn=bind,dc=example,dc=com", "secret")
+			Expect(params).To(HaveLen(6))
+			for _, p := range params {
+				Expect(p.Service).To(Equal("identity"))
+				Expect(p.Section).To(Equal("ldap_domain2"))
+				Expect(isLDAPDomainSection(p.Service, p.Section)).To(BeTrue())
+			}
+			Expect(params).To(ContainElement(starlingxv1.ServiceParameterInfo{
+				Service: "identity", Section: "ldap_domain2", ParamName: "ldap_default_authtok", ParamValue: "secret"}))
+		})
+
+		It("Should detect masked values", func() {
+			Expect(isProtectedValue("******")).To(BeTrue())
+			Expect(isProtectedValue("secret")).To(BeFalse())
+			Expect(isProtectedValue("")).To(BeFalse())
+		})
+	})
+
+	Context("Test registry funcs", func() {
+		It("Should convert a registry to docker service parameters", func() {
+			insecure := true
+			reg := starlingxv1.RegistryInfo{Name: "docker", URL: "registry.local:9001/docker.io", Insecure: &insecure}
+			params := registryParameters(&reg, "1234")
+			Expect(params).To(Equal(starlingxv1.ServiceParameterList{
+				{Service: "docker", Section: "docker-registry", ParamName: "url", ParamValue: "registry.local:9001/docker.io"},
+				{Service: "docker", Section: "docker-registry", ParamName: "auth-secret", ParamValue: "1234"},
+				{Service: "docker", Section: "docker-registry", ParamName: "secure", ParamValue: "False"},
+			}))
+
+			spec := &starlingxv1.SystemSpec{Registries: &starlingxv1.RegistryList{reg}}
+			Expect(registryManaged(spec, "docker", "docker-registry")).To(BeTrue())
+			Expect(registryManaged(spec, "docker", "k8s-registry")).To(BeFalse())
+		})
+
+		It("Should only report credentials that are no longer referenced", func() {
+			previous := []starlingxv1.RegistryStatus{{Name: "docker", AuthSecretID: "1"}, {Name: "k8s", AuthSecretID: "2"}}
+			current := []starlingxv1.RegistryStatus{{Name: "docker", AuthSecretID: "3"}, {Name: "k8s", AuthSecretID: "2"}}
+			Expect(staleRegistryAuthSecrets(previous, current)).To(Equal([]string{"1"}))
+		})
+	})
+
+	Context("Test certificate status funcs", func() {
+		It("Should only report certificates expiring within the warning period", func() {
+			now := time.Now()
+			status := starlingxv1.CertificateStatus{Type: starlingxv1.PlatformCACertificate, Secret: "ca"}
+			Expect(certificateExpiring(status, now)).To(BeFalse())
+
+			status.NotAfter = &metav1.Time{Time: now.Add(2 * CertificateExpiryWarning)}
+			Expect(certificateExpiring(status, now)).To(BeFalse())
+
+			status.NotAfter = &metav1.Time{Time: now.Add(CertificateExpiryWarning / 2)}
+			Expect(certificateExpiring(status, now)).To(BeTrue())
+		})
+
+		It("Should detect changes to the installed certificates", func() {
+			a := []starlingxv1.CertificateStatus{{Type: starlingxv1.PlatformCACertificate, Secret: "ca", ResourceVersion: "1"}}
+			b := []starlingxv1.CertificateStatus{{Type: starlingxv1.PlatformCACertificate, Secret: "ca", ResourceVersion: "1"}}
+			Expect(certificateStatusEqual(a, b)).To(BeTrue())
+
+			b[0].ResourceVersion = "2"
+			Expect(certificateStatusEqual(a, b)).To(BeFalse())
+			Expect(certificateStatusEqual(a, nil)).To(BeFalse())
+		})
+
+		It("Should map secrets to the systems referring to them", func() {
+			certs := starlingxv1.CertificateList{{Type: starlingxv1.PlatformCACertificate, Secret: "ca"}}
+			instance := &starlingxv1.System{Spec: starlingxv1.SystemSpec{Certificates: &certs}}
+			Expect(systemReferencesSecret(instance, "ca")).To(BeTrue())
+			Expect(systemReferencesSecret(instance, "other")).To(BeFalse())
+
+			instance.Spec.License = &starlingxv1.LicenseInfo{Secret: "license"}
+			Expect(systemReferencesSecret(instance, "license")).To(BeTrue())
+
+			Expect(systemReferencesSecret(instance, cloudManager.SystemEndpointSecretName)).To(BeTrue())
+
+			endpoint := "edge-1-endpoint"
+			instance.Spec.EndpointSecret = &endpoint
+			Expect(systemReferencesSecret(instance, endpoint)).To(BeTrue())
+			Expect(systemReferencesSecret(instance, cloudManager.SystemEndpointSecretName)).To(BeFalse())
+		})
+
+		It("Should map cert-manager secrets to the systems referring to them", func() {
+			certs := starlingxv1.CertificateList{{Type: starlingxv1.PlatformCertificate, Certificate: "restapi"}}
+			instance := &starlingxv1.System{Spec: starlingxv1.SystemSpec{Certificates: &certs}}
+			Expect(systemReferencesCertificate(instance, "restapi")).To(BeTrue())
+			Expect(systemReferencesCertificate(instance, "")).To(BeFalse())
+			Expect(systemReferencesSecret(instance, "restapi-tls")).To(BeFalse())
+
+			instance.Status.Certificates = []starlingxv1.CertificateStatus{
+				{Type: starlingxv1.PlatformCertificate, Secret: "restapi-tls", Certificate: "restapi"},
+			}
+			Expect(systemReferencesSecret(instance, "restapi-tls")).To(BeTrue())
+		})
+
+		It("Should only use the secret of a ready cert-manager certificate", func() {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "False"},
+					},
+				},
+			}}
+			Expect(certManagerCertificateReady(obj)).To(BeFalse())
+
+			obj.Object["status"] = map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Issuing", "status": "True"},
+					map[string]interface{}{"type": "Ready", "status": "True"},
+				},
+			}
+			Expect(certManagerCertificateReady(obj)).To(BeTrue())
+		})
+	})
+
+	Context("Test passwordRotationRequested func", func() {
+		It("Should only request a rotation when a new password is stored", func() {
+			secret := &v1.Secret{Data: map[string][]byte{cloudManager.PasswordKey: []byte("old")}}
+			_, ok := passwordRotationRequested(secret)
+			Expect(ok).To(BeFalse())
+
+			secret.Data[cloudManager.NewPasswordKey] = []byte("")
+			_, ok = passwordRotationRequested(secret)
+			Expect(ok).To(BeFalse())
+
+			secret.Data[cloudManager.NewPasswordKey] = []byte("new")
+			password, ok := passwordRotationRequested(secret)
+			Expect(ok).To(BeTrue())
+			Expect(password).To(Equal("new"))
+		})
+	})
+
+	Context("Test bootstrapConditions func", func() {
+		It("Should report the phases reached by the hosts of the inventory", func() {
+			collected := hosts.InventoryCollected
+			objects := []hosts.Host{
+				{Hostname: hosts.Controller0, Personality: hosts.PersonalityController,
+					AdministrativeState: hosts.AdminUnlocked, OperationalStatus: hosts.OperEnabled},
+				{Hostname: Controller1, Personality: hosts.PersonalityController, InventoryState: &collected,
+					AdministrativeState: hosts.AdminLocked, OperationalStatus: hosts.OperDisabled},
+				{Hostname: "worker-0", Personality: hosts.PersonalityWorker,
+					AdministrativeState: hosts.AdminLocked, OperationalStatus: hosts.OperDisabled},
+			}
+			failures := map[string][]string{starlingxv1.BootstrapPhaseController1Unlocked: {"200.004"}}
+
+			conditions := bootstrapConditions(objects, nil, true, false, failures)
+			reasons := make(map[string]string)
+			for _, c := range conditions {
+				reasons[c.Type] = c.Reason
+			}
+			Expect(reasons).To(Equal(map[string]string{
+				starlingxv1.BootstrapPhaseController0Configured: BootstrapReasonReached,
+				starlingxv1.BootstrapPhaseController0Unlocked:   BootstrapReasonReached,
+				starlingxv1.BootstrapPhaseController1Installed:  BootstrapReasonReached,
+				starlingxv1.BootstrapPhaseController1Unlocked:   BootstrapReasonHealthCheckFailed,
+				starlingxv1.BootstrapPhaseStorageUnlocked:       BootstrapReasonNotApplicable,
+				starlingxv1.BootstrapPhaseWorkersUnlocked:       BootstrapReasonPending,
+			}))
+			Expect(currentBootstrapPhase(conditions)).To(Equal(starlingxv1.BootstrapPhaseController1Unlocked))
+
+			conditions = bootstrapConditions(objects[:1], []string{"storage-0"}, true, true, nil)
+			Expect(currentBootstrapPhase(conditions)).To(Equal(starlingxv1.BootstrapPhaseStorageUnlocked))
+
+			conditions = bootstrapConditions(objects[:1], nil, true, true, nil)
+			Expect(currentBootstrapPhase(conditions)).To(Equal(starlingxv1.BootstrapPhaseCompleted))
+		})
 	})
 
 })
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022 Wind River Systems, Inc. */
+/* Copyright(c) 2022-2024 Wind River Systems, Inc. */
 
 package main
 
@@ -16,11 +16,14 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	starlingxv2 "github.com/wind-river/cloud-platform-deployment-manager/api/v2"
 	config2 "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/controllers"
 	"github.com/wind-river/cloud-platform-deployment-manager/controllers/host"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
 	"github.com/wind-river/cloud-platform-deployment-manager/controllers/system"
 	//+kubebuilder:scaffold:imports
 )
@@ -34,6 +37,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(starlingxv1.AddToScheme(scheme))
+	utilruntime.Must(starlingxv2.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -74,6 +78,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The state of the resources is reported from the manager cache whenever
+	// the metrics are scraped.
+	metrics.Registry.MustRegister(cloudManager.NewResourceCollector(mgr.GetClient()))
+
+	if err = (&controllers.ApplicationReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Application")
+		os.Exit(1)
+	}
+	if err = (&controllers.BackupReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Backup")
+		os.Exit(1)
+	}
 	if err = (&controllers.DataNetworkReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
@@ -81,6 +103,13 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "DataNetwork")
 		os.Exit(1)
 	}
+	if err = (&controllers.DeviceImageReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DeviceImage")
+		os.Exit(1)
+	}
 	if err = (&controllers.HostProfileReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
@@ -95,6 +124,20 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "Host")
 		os.Exit(1)
 	}
+	if err = (&host.HostAdoptionReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "HostAdoption")
+		os.Exit(1)
+	}
+	if err = (&controllers.KubeUpgradeReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "KubeUpgrade")
+		os.Exit(1)
+	}
 	if err = (&controllers.PlatformNetworkReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
@@ -116,6 +159,34 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "PtpInterface")
 		os.Exit(1)
 	}
+	if err = (&controllers.RestoreReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Restore")
+		os.Exit(1)
+	}
+	if err = (&controllers.SoftwareDeployReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SoftwareDeploy")
+		os.Exit(1)
+	}
+	if err = (&controllers.StrategyReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Strategy")
+		os.Exit(1)
+	}
+	if err = (&controllers.SubcloudReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Subcloud")
+		os.Exit(1)
+	}
 	if err = (&system.SystemReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
@@ -123,10 +194,22 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "System")
 		os.Exit(1)
 	}
+	if err = (&starlingxv1.Application{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Application")
+		os.Exit(1)
+	}
+	if err = (&starlingxv1.Backup{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Backup")
+		os.Exit(1)
+	}
 	if err = (&starlingxv1.DataNetwork{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "DataNetwork")
 		os.Exit(1)
 	}
+	if err = (&starlingxv1.DeviceImage{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "DeviceImage")
+		os.Exit(1)
+	}
 	if err = (&starlingxv1.HostProfile{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "HostProfile")
 		os.Exit(1)
@@ -135,6 +218,10 @@ func main() {
 		setupLog.Error(err, "unable to create webhook", "webhook", "Host")
 		os.Exit(1)
 	}
+	if err = (&starlingxv1.KubeUpgrade{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "KubeUpgrade")
+		os.Exit(1)
+	}
 	if err = (&starlingxv1.PlatformNetwork{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "PlatformNetwork")
 		os.Exit(1)
@@ -147,6 +234,22 @@ func main() {
 		setupLog.Error(err, "unable to create webhook", "webhook", "PtpInterface")
 		os.Exit(1)
 	}
+	if err = (&starlingxv1.Restore{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Restore")
+		os.Exit(1)
+	}
+	if err = (&starlingxv1.SoftwareDeploy{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "SoftwareDeploy")
+		os.Exit(1)
+	}
+	if err = (&starlingxv1.Strategy{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Strategy")
+		os.Exit(1)
+	}
+	if err = (&starlingxv1.Subcloud{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Subcloud")
+		os.Exit(1)
+	}
 	if err = (&starlingxv1.System{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "System")
 		os.Exit(1)
@@ -0,0 +1,8 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package alarms contains functionality for working with Fault Management
+// active alarm resources.  Alarms are only ever read by the Deployment
+// Manager to report the state of the system; they are raised and cleared by
+// the platform itself.
+package alarms
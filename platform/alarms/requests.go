@@ -0,0 +1,38 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package alarms
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List returns a Pager which allows you to iterate over a collection of
+// active alarms.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return AlarmPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListAlarms is a convenience function to list and extract the entire list
+// of active alarms.
+func ListAlarms(c *gophercloud.ServiceClient) ([]Alarm, error) {
+	pages, err := List(c).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractAlarms(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
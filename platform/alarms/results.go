@@ -0,0 +1,74 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package alarms
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Alarm defines the data associated to a single active alarm instance.
+type Alarm struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// AlarmID defines the identifier of the alarm type (e.g., 100.119).
+	AlarmID string `json:"alarm_id"`
+
+	// State defines the state of the alarm (e.g., set).
+	State string `json:"alarm_state"`
+
+	// EntityTypeID defines the type of the entity which raised the alarm.
+	EntityTypeID string `json:"entity_type_id"`
+
+	// EntityInstanceID defines the instance of the entity which raised the
+	// alarm in the form "host=<hostname>.<type>=<name>".
+	EntityInstanceID string `json:"entity_instance_id"`
+
+	// Severity defines the severity of the alarm.
+	Severity string `json:"severity"`
+
+	// ReasonText defines the human readable description of the alarm.
+	ReasonText string `json:"reason_text"`
+
+	// Timestamp defines the time at which the alarm was raised.
+	Timestamp string `json:"timestamp"`
+
+	// ManagementAffecting defines whether the alarm prevents management
+	// actions (e.g., lock or unlock) from being safely executed.  It is
+	// reported by the API as either "True" or "False".
+	ManagementAffecting string `json:"mgmt_affecting"`
+}
+
+// IsManagementAffecting determines whether the alarm prevents management
+// actions from being safely executed.
+func (a Alarm) IsManagementAffecting() bool {
+	return strings.EqualFold(a.ManagementAffecting, "true")
+}
+
+// AlarmPage is the page returned by a pager when traversing over a
+// collection of alarms.
+type AlarmPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether an AlarmPage struct is empty.
+func (r AlarmPage) IsEmpty() (bool, error) {
+	is, err := ExtractAlarms(r)
+	return len(is) == 0, err
+}
+
+// ExtractAlarms accepts a Page struct, specifically an AlarmPage struct, and
+// extracts the elements into a slice of Alarm structs. In other words, a
+// generic collection is mapped into a relevant slice.
+func ExtractAlarms(r pagination.Page) ([]Alarm, error) {
+	var s struct {
+		Alarms []Alarm `json:"alarms"`
+	}
+
+	err := (r.(AlarmPage)).ExtractInto(&s)
+
+	return s.Alarms, err
+}
@@ -0,0 +1,14 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package alarms
+
+import "github.com/gophercloud/gophercloud"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("v1", "alarms")
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
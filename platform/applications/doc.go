@@ -0,0 +1,8 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package applications contains functionality for working with System
+// Inventory application resources.  This includes uploading application
+// tarballs, applying, updating and removing applications and deleting them
+// from the system.
+package applications
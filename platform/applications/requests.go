@@ -0,0 +1,207 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package applications
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Defines the directives which move an application thru its lifecycle.
+const (
+	DirectiveApply  = "apply"
+	DirectiveRemove = "remove"
+)
+
+// UploadOpts defines the attributes used to upload a new application.
+type UploadOpts struct {
+	Name       string
+	AppVersion *string
+}
+
+// UpdateOpts defines the attributes used to update an applied application to
+// the version found in another tarball.
+type UpdateOpts struct {
+	Name       string
+	AppVersion *string
+
+	// ReuseUserValues defines whether the user overrides of the current
+	// version are carried over to the new version.
+	ReuseUserValues bool
+}
+
+// toMultipart formats the upload options and tarball content into a
+// multipart form body as expected by the system API.
+func (opts UploadOpts) toMultipart(filename string, content io.Reader) (*bytes.Buffer, string, error) {
+	fields := map[string]*string{
+		"name":        &opts.Name,
+		"app_version": opts.AppVersion,
+	}
+
+	return toMultipart(fields, filename, content)
+}
+
+// toMultipart formats the update options and tarball content into a
+// multipart form body as expected by the system API.
+func (opts UpdateOpts) toMultipart(filename string, content io.Reader) (*bytes.Buffer, string, error) {
+	reuse := strconv.FormatBool(opts.ReuseUserValues)
+	fields := map[string]*string{
+		"name":              &opts.Name,
+		"app_version":       opts.AppVersion,
+		"reuse_user_values": &reuse,
+	}
+
+	return toMultipart(fields, filename, content)
+}
+
+func toMultipart(fields map[string]*string, filename string, content io.Reader) (*bytes.Buffer, string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	for key, value := range fields {
+		if value == nil {
+			continue
+		}
+
+		err := writer.WriteField(key, *value)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, err = io.Copy(part, content)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, writer.FormDataContentType(), nil
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// applications.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return ApplicationPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific application based on its unique name.
+func Get(c *gophercloud.ServiceClient, name string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, name), &r.Body, nil)
+	return r
+}
+
+// Upload uploads a new application using the attributes and tarball content
+// provided.
+func Upload(c *gophercloud.ServiceClient, opts UploadOpts, filename string, content io.Reader) (r UploadResult) {
+	body, contentType, err := opts.toMultipart(filename, content)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Request("POST", uploadURL(c), &gophercloud.RequestOpts{
+		RawBody:      body,
+		JSONResponse: &r.Body,
+		MoreHeaders:  map[string]string{"Content-Type": contentType},
+		OkCodes:      []int{200, 201, 202},
+	})
+
+	return r
+}
+
+// Update requests that an applied application be updated to the version
+// found in the tarball content provided.
+func Update(c *gophercloud.ServiceClient, opts UpdateOpts, filename string, content io.Reader) (r UpdateResult) {
+	body, contentType, err := opts.toMultipart(filename, content)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Request("POST", updateURL(c), &gophercloud.RequestOpts{
+		RawBody:      body,
+		JSONResponse: &r.Body,
+		MoreHeaders:  map[string]string{"Content-Type": contentType},
+		OkCodes:      []int{200, 202},
+	})
+
+	return r
+}
+
+// Apply requests that an uploaded application be applied.
+func Apply(c *gophercloud.ServiceClient, name string) (r UpdateResult) {
+	return directive(c, name, DirectiveApply)
+}
+
+// Remove requests that an applied application be removed.  The application
+// remains uploaded to the system.
+func Remove(c *gophercloud.ServiceClient, name string) (r UpdateResult) {
+	return directive(c, name, DirectiveRemove)
+}
+
+func directive(c *gophercloud.ServiceClient, name string, directive string) (r UpdateResult) {
+	body := map[string]interface{}{"values": map[string]interface{}{}}
+	_, r.Err = c.Patch(directiveURL(c, name, directive), body, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
+
+// Delete accepts a unique name and deletes the uploaded application
+// associated with it.
+func Delete(c *gophercloud.ServiceClient, name string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, name), nil)
+	return r
+}
+
+// GetApplication is a convenience function to retrieve an application.  A
+// nil application is returned if it has not been uploaded to the system.
+func GetApplication(c *gophercloud.ServiceClient, name string) (*Application, error) {
+	app, err := Get(c, name).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return app, nil
+}
+
+// ListApplications is a convenience function to list and extract the entire
+// list of applications.
+func ListApplications(c *gophercloud.ServiceClient) ([]Application, error) {
+	pages, err := List(c).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractApplications(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
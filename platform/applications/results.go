@@ -0,0 +1,118 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package applications
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Defines the application states reported by the system.
+const (
+	StatusUploading    = "uploading"
+	StatusUploaded     = "uploaded"
+	StatusUploadFailed = "upload-failed"
+	StatusApplying     = "applying"
+	StatusApplied      = "applied"
+	StatusApplyFailed  = "apply-failed"
+	StatusUpdating     = "updating"
+	StatusUpdateFailed = "update-failed"
+	StatusRemoving     = "removing"
+	StatusRemoveFailed = "remove-failed"
+	StatusRecovering   = "recovering"
+)
+
+// Extract interprets any commonResult as an Application.
+func (r commonResult) Extract() (*Application, error) {
+	var s Application
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UploadResult represents the result of an upload operation.
+type UploadResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update, apply or remove
+// operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Application defines the data associated to a single system application.
+type Application struct {
+	// Name defines the unique name of the application.
+	Name string `json:"name"`
+
+	// Version defines the version of the application tarball.
+	Version string `json:"app_version"`
+
+	// Status defines the current lifecycle state of the application.
+	Status string `json:"status"`
+
+	// Progress defines the progress of the current operation, if any.
+	Progress *string `json:"progress,omitempty"`
+
+	// Active defines whether the application is currently applied.
+	Active bool `json:"active"`
+}
+
+// InProgress determines whether an operation is running on the application.
+func (a Application) InProgress() bool {
+	switch a.Status {
+	case StatusUploading, StatusApplying, StatusUpdating, StatusRemoving, StatusRecovering:
+		return true
+	}
+
+	return false
+}
+
+// Failed determines whether the last operation on the application failed.
+func (a Application) Failed() bool {
+	switch a.Status {
+	case StatusUploadFailed, StatusApplyFailed, StatusUpdateFailed, StatusRemoveFailed:
+		return true
+	}
+
+	return false
+}
+
+// ApplicationPage is the page returned by a pager when traversing over a
+// collection of applications.
+type ApplicationPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether an ApplicationPage struct is empty.
+func (r ApplicationPage) IsEmpty() (bool, error) {
+	is, err := ExtractApplications(r)
+	return len(is) == 0, err
+}
+
+// ExtractApplications accepts a Page struct, specifically an ApplicationPage
+// struct, and extracts the elements into a slice of Application structs.
+func ExtractApplications(r pagination.Page) ([]Application, error) {
+	var s struct {
+		Applications []Application `json:"apps"`
+	}
+
+	err := (r.(ApplicationPage)).ExtractInto(&s)
+
+	return s.Applications, err
+}
@@ -0,0 +1,38 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package applications
+
+import "github.com/gophercloud/gophercloud"
+
+func resourceURL(c *gophercloud.ServiceClient, name string) string {
+	return c.ServiceURL("apps", name)
+}
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("apps")
+}
+
+func getURL(c *gophercloud.ServiceClient, name string) string {
+	return resourceURL(c, name)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func uploadURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func updateURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("apps", "update")
+}
+
+func directiveURL(c *gophercloud.ServiceClient, name string, directive string) string {
+	return resourceURL(c, name) + "?directive=" + directive
+}
+
+func deleteURL(c *gophercloud.ServiceClient, name string) string {
+	return resourceURL(c, name)
+}
@@ -0,0 +1,8 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package deviceimages contains functionality for working with System
+// Inventory device image resources.  This includes uploading device images,
+// applying them to labelled devices, tracking the per device write state and
+// initiating the write operation on a host.
+package deviceimages
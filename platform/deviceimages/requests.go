@@ -0,0 +1,200 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package deviceimages
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Defines the supported device image actions.
+const (
+	ActionApply  = "apply"
+	ActionRemove = "remove"
+)
+
+// DeviceImageOpts defines the attributes used to create a new device image.
+type DeviceImageOpts struct {
+	BitstreamType string
+	PCIVendor     string
+	PCIDevice     string
+	BitstreamID   *string
+	KeySignature  *string
+	RevokeKeyID   *int
+	Name          *string
+	Description   *string
+	ImageVersion  *string
+}
+
+// toMultipart formats the create options and image content into a multipart
+// form body as expected by the system API.
+func (opts DeviceImageOpts) toMultipart(filename string, content io.Reader) (*bytes.Buffer, string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	fields := map[string]*string{
+		"bitstream_type": &opts.BitstreamType,
+		"pci_vendor":     &opts.PCIVendor,
+		"pci_device":     &opts.PCIDevice,
+		"bitstream_id":   opts.BitstreamID,
+		"key_signature":  opts.KeySignature,
+		"name":           opts.Name,
+		"description":    opts.Description,
+		"image_version":  opts.ImageVersion,
+	}
+
+	if opts.RevokeKeyID != nil {
+		value := strconv.Itoa(*opts.RevokeKeyID)
+		fields["revoke_key_id"] = &value
+	}
+
+	for key, value := range fields {
+		if value == nil {
+			continue
+		}
+
+		err := writer.WriteField(key, *value)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, err = io.Copy(part, content)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, writer.FormDataContentType(), nil
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// device images.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return DeviceImagePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific device image based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return r
+}
+
+// Create uploads a new device image using the attributes and content
+// provided.
+func Create(c *gophercloud.ServiceClient, opts DeviceImageOpts, filename string, content io.Reader) (r CreateResult) {
+	body, contentType, err := opts.toMultipart(filename, content)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Request("POST", createURL(c), &gophercloud.RequestOpts{
+		RawBody:      body,
+		JSONResponse: &r.Body,
+		MoreHeaders:  map[string]string{"Content-Type": contentType},
+		OkCodes:      []int{200, 201, 202},
+	})
+
+	return r
+}
+
+// Apply applies a device image to all devices that match the specified set of
+// device labels.
+func Apply(c *gophercloud.ServiceClient, id string, labels map[string]string) (r UpdateResult) {
+	_, r.Err = c.Patch(actionURL(c, id, ActionApply), labels, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
+
+// Remove removes a device image from all devices that match the specified set
+// of device labels.
+func Remove(c *gophercloud.ServiceClient, id string, labels map[string]string) (r UpdateResult) {
+	_, r.Err = c.Patch(actionURL(c, id, ActionRemove), labels, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
+
+// Delete accepts a unique ID and deletes the device image associated with it.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return r
+}
+
+// ListDeviceImages is a convenience function to list and extract the entire
+// list of device images.
+func ListDeviceImages(c *gophercloud.ServiceClient) ([]DeviceImage, error) {
+	pages, err := List(c).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractDeviceImages(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
+
+// ListStates returns a Pager which allows you to iterate over a collection of
+// device image states.
+func ListStates(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listStatesURL(c), func(r pagination.PageResult) pagination.Page {
+		return DeviceImageStatePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListDeviceImageStates is a convenience function to list and extract the
+// entire list of device image states.
+func ListDeviceImageStates(c *gophercloud.ServiceClient) ([]DeviceImageState, error) {
+	pages, err := ListStates(c).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractDeviceImageStates(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
+
+// UpdateHost initiates writing all pending device images to the devices of a
+// host.
+func UpdateHost(c *gophercloud.ServiceClient, hostid string) (r HostUpdateResult) {
+	_, r.Err = c.Post(hostUpdateURL(c, hostid), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
@@ -0,0 +1,173 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package deviceimages
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Defines the supported bitstream types.
+const (
+	BitstreamTypeFunctional    = "functional"
+	BitstreamTypeRootKey       = "root-key"
+	BitstreamTypeKeyRevocation = "key-revocation"
+)
+
+// Defines the device image write states.
+const (
+	StatusPending    = "pending"
+	StatusInProgress = "in-progress"
+	StatusCompleted  = "completed"
+	StatusFailed     = "failed"
+)
+
+// Extract interprets any commonResult as a DeviceImage.
+func (r commonResult) Extract() (*DeviceImage, error) {
+	var s DeviceImage
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an apply or remove operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// HostUpdateResult represents the result of a host device image update
+// operation.
+type HostUpdateResult struct {
+	gophercloud.ErrResult
+}
+
+// DeviceImage defines the data associated to a single device image instance.
+type DeviceImage struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// BitstreamType defines the type of the device image.
+	BitstreamType string `json:"bitstream_type"`
+
+	// PCIVendor defines the PCI vendor identifier of the target devices.
+	PCIVendor string `json:"pci_vendor"`
+
+	// PCIDevice defines the PCI device identifier of the target devices.
+	PCIDevice string `json:"pci_device"`
+
+	// BitstreamID defines the bitstream identifier of a functional image.
+	BitstreamID *string `json:"bitstream_id,omitempty"`
+
+	// KeySignature defines the key signature of a root-key image.
+	KeySignature *string `json:"key_signature,omitempty"`
+
+	// RevokeKeyID defines the key identifier of a key-revocation image.
+	RevokeKeyID *int `json:"revoke_key_id,omitempty"`
+
+	// Name defines the name of the device image.
+	Name *string `json:"name,omitempty"`
+
+	// Description defines the description of the device image.
+	Description *string `json:"description,omitempty"`
+
+	// ImageVersion defines the version of the device image.
+	ImageVersion *string `json:"image_version,omitempty"`
+
+	// Applied defines whether the image has been applied to any devices.
+	Applied bool `json:"applied"`
+
+	// AppliedLabels defines the set of device labels to which the image has
+	// been applied.
+	AppliedLabels []map[string]string `json:"applied_labels,omitempty"`
+}
+
+// DeviceImagePage is the page returned by a pager when traversing over a
+// collection of device images.
+type DeviceImagePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a DeviceImagePage struct is empty.
+func (r DeviceImagePage) IsEmpty() (bool, error) {
+	is, err := ExtractDeviceImages(r)
+	return len(is) == 0, err
+}
+
+// ExtractDeviceImages accepts a Page struct, specifically a DeviceImagePage
+// struct, and extracts the elements into a slice of DeviceImage structs. In
+// other words, a generic collection is mapped into a relevant slice.
+func ExtractDeviceImages(r pagination.Page) ([]DeviceImage, error) {
+	var s struct {
+		DeviceImages []DeviceImage `json:"device_images"`
+	}
+
+	err := (r.(DeviceImagePage)).ExtractInto(&s)
+
+	return s.DeviceImages, err
+}
+
+// DeviceImageState defines the write state of a device image on a single
+// device.
+type DeviceImageState struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// HostID defines the unique UUID value of the host.
+	HostID string `json:"host_uuid"`
+
+	// PCIDeviceID defines the unique UUID value of the PCI device.
+	PCIDeviceID string `json:"pcidevice_uuid"`
+
+	// ImageID defines the unique UUID value of the device image.
+	ImageID string `json:"image_uuid"`
+
+	// Status defines the current write state.
+	Status string `json:"status"`
+
+	// UpdateStartTime defines the time at which the write was started.
+	UpdateStartTime *string `json:"update_start_time,omitempty"`
+}
+
+// DeviceImageStatePage is the page returned by a pager when traversing over a
+// collection of device image states.
+type DeviceImageStatePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a DeviceImageStatePage struct is empty.
+func (r DeviceImageStatePage) IsEmpty() (bool, error) {
+	is, err := ExtractDeviceImageStates(r)
+	return len(is) == 0, err
+}
+
+// ExtractDeviceImageStates accepts a Page struct, specifically a
+// DeviceImageStatePage struct, and extracts the elements into a slice of
+// DeviceImageState structs.
+func ExtractDeviceImageStates(r pagination.Page) ([]DeviceImageState, error) {
+	var s struct {
+		States []DeviceImageState `json:"device_image_state"`
+	}
+
+	err := (r.(DeviceImageStatePage)).ExtractInto(&s)
+
+	return s.States, err
+}
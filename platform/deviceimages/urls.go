@@ -0,0 +1,42 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package deviceimages
+
+import "github.com/gophercloud/gophercloud"
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("device_images", id)
+}
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("device_images")
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func actionURL(c *gophercloud.ServiceClient, id string, action string) string {
+	return resourceURL(c, id) + "?action=" + action
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func listStatesURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("device_image_state")
+}
+
+func hostUpdateURL(c *gophercloud.ServiceClient, hostid string) string {
+	return c.ServiceURL("ihosts", hostid, "device_image_update")
+}
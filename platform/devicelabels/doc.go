@@ -0,0 +1,7 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package devicelabels contains functionality for working with System
+// Inventory PCI device label resources.  Device labels are used to select
+// which devices a device image is applied to.
+package devicelabels
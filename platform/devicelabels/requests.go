@@ -0,0 +1,58 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package devicelabels
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List returns a Pager which allows you to iterate over a collection of
+// device labels.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return DeviceLabelPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Create assigns a set of labels to a PCI device.
+func Create(c *gophercloud.ServiceClient, deviceid string, labels map[string]string) (r CreateResult) {
+	reqBody := map[string]string{"pcidevice_uuid": deviceid}
+	for key, value := range labels {
+		reqBody[key] = value
+	}
+
+	_, r.Err = c.Post(createURL(c), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+
+	return r
+}
+
+// Delete accepts a unique ID and deletes the device label associated with it.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return r
+}
+
+// ListDeviceLabels is a convenience function to list and extract the entire
+// list of device labels.
+func ListDeviceLabels(c *gophercloud.ServiceClient) ([]DeviceLabel, error) {
+	pages, err := List(c).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractDeviceLabels(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
@@ -0,0 +1,62 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package devicelabels
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// DeviceLabel defines the data associated to a single device label instance.
+type DeviceLabel struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// HostID defines the unique UUID value of the host.
+	HostID string `json:"host_uuid"`
+
+	// PCIDeviceID defines the unique UUID value of the PCI device.
+	PCIDeviceID string `json:"pcidevice_uuid"`
+
+	// Key defines the label key.
+	Key string `json:"label_key"`
+
+	// Value defines the label value.
+	Value string `json:"label_value"`
+}
+
+// DeviceLabelPage is the page returned by a pager when traversing over a
+// collection of device labels.
+type DeviceLabelPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a DeviceLabelPage struct is empty.
+func (r DeviceLabelPage) IsEmpty() (bool, error) {
+	is, err := ExtractDeviceLabels(r)
+	return len(is) == 0, err
+}
+
+// ExtractDeviceLabels accepts a Page struct, specifically a DeviceLabelPage
+// struct, and extracts the elements into a slice of DeviceLabel structs. In
+// other words, a generic collection is mapped into a relevant slice.
+func ExtractDeviceLabels(r pagination.Page) ([]DeviceLabel, error) {
+	var s struct {
+		DeviceLabels []DeviceLabel `json:"device_labels"`
+	}
+
+	err := (r.(DeviceLabelPage)).ExtractInto(&s)
+
+	return s.DeviceLabels, err
+}
@@ -0,0 +1,7 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package helmcharts contains functionality for working with System Inventory
+// helm chart resources.  This includes reading and modifying the user
+// overrides and attributes of the charts of a system application.
+package helmcharts
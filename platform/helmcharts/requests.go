@@ -0,0 +1,106 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package helmcharts
+
+import (
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines how the values supplied on an update are combined with the current
+// user overrides.
+const (
+	// FlagReset replaces the current user overrides with the values supplied.
+	FlagReset = "reset"
+
+	// FlagReuse merges the values supplied into the current user overrides.
+	FlagReuse = "reuse"
+)
+
+// OverridesOpts defines the attributes used to modify the user overrides of
+// a chart.
+type OverridesOpts struct {
+	// Values defines the user overrides as a YAML document.
+	Values string
+
+	// Flag defines how the values are combined with the current user
+	// overrides.
+	Flag string
+}
+
+// ToHelmChartUpdateMap formats the overrides options into a request body.
+func (opts OverridesOpts) ToHelmChartUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"flag":       opts.Flag,
+		"attributes": map[string]interface{}{},
+		"values": map[string]interface{}{
+			"files": []string{opts.Values},
+			"set":   []string{},
+		},
+	}
+}
+
+// AttributesOpts defines the attributes used to modify the attributes of a
+// chart.
+type AttributesOpts struct {
+	Enabled *bool
+}
+
+// ToHelmChartUpdateMap formats the attributes options into a request body.
+func (opts AttributesOpts) ToHelmChartUpdateMap() map[string]interface{} {
+	attributes := make(map[string]interface{})
+	if opts.Enabled != nil {
+		attributes["enabled"] = strconv.FormatBool(*opts.Enabled)
+	}
+
+	return map[string]interface{}{
+		"flag":       FlagReuse,
+		"attributes": attributes,
+		"values":     map[string]interface{}{},
+	}
+}
+
+// Get retrieves a specific chart of an application based on its name and
+// namespace.
+func Get(c *gophercloud.ServiceClient, appName string, namespace string, name string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, appName, namespace, name), &r.Body, nil)
+	return r
+}
+
+// UpdateOverrides modifies the user overrides of a chart.
+func UpdateOverrides(c *gophercloud.ServiceClient, appName string, namespace string, name string, opts OverridesOpts) (r UpdateResult) {
+	_, r.Err = c.Patch(updateURL(c, appName, namespace, name), opts.ToHelmChartUpdateMap(), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
+
+// UpdateAttributes modifies the attributes of a chart.
+func UpdateAttributes(c *gophercloud.ServiceClient, appName string, namespace string, name string, opts AttributesOpts) (r UpdateResult) {
+	_, r.Err = c.Patch(updateURL(c, appName, namespace, name), opts.ToHelmChartUpdateMap(), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
+
+// Delete clears the user overrides of a chart.
+func Delete(c *gophercloud.ServiceClient, appName string, namespace string, name string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, appName, namespace, name), nil)
+	return r
+}
+
+// GetHelmChart is a convenience function to retrieve a chart.  A nil chart is
+// returned if the application does not provide the chart.
+func GetHelmChart(c *gophercloud.ServiceClient, appName string, namespace string, name string) (*HelmChart, error) {
+	chart, err := Get(c, appName, namespace, name).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return chart, nil
+}
@@ -0,0 +1,58 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package helmcharts
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Extract interprets any commonResult as a HelmChart.
+func (r commonResult) Extract() (*HelmChart, error) {
+	var s HelmChart
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Attributes defines the attributes of a chart.
+type Attributes struct {
+	// Enabled defines whether the chart is deployed when the application is
+	// applied.
+	Enabled bool `json:"enabled"`
+}
+
+// HelmChart defines the data associated to a single chart of a system
+// application.
+type HelmChart struct {
+	// Name defines the name of the chart.
+	Name string `json:"name"`
+
+	// Namespace defines the namespace in which the chart is deployed.
+	Namespace string `json:"namespace"`
+
+	// Attributes defines the attributes of the chart.
+	Attributes Attributes `json:"attributes"`
+
+	// UserOverrides defines the user overrides of the chart as a YAML
+	// document.
+	UserOverrides *string `json:"user_overrides,omitempty"`
+}
@@ -0,0 +1,29 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package helmcharts
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func resourceURL(c *gophercloud.ServiceClient, appName string, namespace string, name string) string {
+	query := url.Values{}
+	query.Set("app_name", appName)
+	query.Set("namespace", namespace)
+	return c.ServiceURL("helm_charts", name) + "?" + query.Encode()
+}
+
+func getURL(c *gophercloud.ServiceClient, appName string, namespace string, name string) string {
+	return resourceURL(c, appName, namespace, name)
+}
+
+func updateURL(c *gophercloud.ServiceClient, appName string, namespace string, name string) string {
+	return resourceURL(c, appName, namespace, name)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, appName string, namespace string, name string) string {
+	return resourceURL(c, appName, namespace, name)
+}
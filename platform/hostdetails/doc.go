@@ -0,0 +1,8 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package hostdetails contains functionality for reading the runtime details
+// of a System Inventory host which are not exposed by the hosts package, such
+// as its installation progress, uptime, running software load, and board
+// management MAC address.
+package hostdetails
@@ -0,0 +1,45 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package hostdetails
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Get retrieves the runtime details of a specific host based on its unique
+// ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return r
+}
+
+// List returns a Pager which allows you to iterate over the runtime details
+// of every host.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return HostDetailsPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListHostDetails is a convenience function to list and extract the runtime
+// details of every host.
+func ListHostDetails(c *gophercloud.ServiceClient) ([]HostDetails, error) {
+	pages, err := List(c).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractHostDetails(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
@@ -0,0 +1,85 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package hostdetails
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Defines the installation states reported by the system for a host.
+const (
+	InstallStatePreInstall  = "preinstall"
+	InstallStateInstalling  = "installing"
+	InstallStatePostInstall = "postinstall"
+	InstallStateFailed      = "failed"
+	InstallStateCompleted   = "completed"
+)
+
+// Extract interprets any commonResult as a HostDetails.
+func (r commonResult) Extract() (*HostDetails, error) {
+	var s HostDetails
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// HostDetails defines the runtime details associated to a single host.
+type HostDetails struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// InstallState defines the current stage of the installation of the host.
+	InstallState *string `json:"install_state,omitempty"`
+
+	// InstallStateInfo defines additional progress information about the
+	// current installation stage (e.g., "15/1125").
+	InstallStateInfo *string `json:"install_state_info,omitempty"`
+
+	// Uptime defines the number of seconds since the host was last booted.
+	Uptime int `json:"uptime"`
+
+	// SoftwareLoad defines the software version running on the host.
+	SoftwareLoad *string `json:"software_load,omitempty"`
+
+	// TargetLoad defines the software version that the host is to run.
+	TargetLoad *string `json:"target_load,omitempty"`
+
+	// BMMAC defines the MAC address of the board management controller of
+	// the host.
+	BMMAC *string `json:"bm_mac,omitempty"`
+}
+
+// HostDetailsPage is the page returned by a pager when traversing over a
+// collection of hosts.
+type HostDetailsPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a HostDetailsPage struct is empty.
+func (r HostDetailsPage) IsEmpty() (bool, error) {
+	is, err := ExtractHostDetails(r)
+	return len(is) == 0, err
+}
+
+// ExtractHostDetails accepts a Page struct, specifically a HostDetailsPage
+// struct, and extracts the elements into a slice of HostDetails structs. In
+// other words, a generic collection is mapped into a relevant slice.
+func ExtractHostDetails(r pagination.Page) ([]HostDetails, error) {
+	var s struct {
+		Hosts []HostDetails `json:"ihosts"`
+	}
+
+	err := (r.(HostDetailsPage)).ExtractInto(&s)
+
+	return s.Hosts, err
+}
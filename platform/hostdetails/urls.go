@@ -0,0 +1,14 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package hostdetails
+
+import "github.com/gophercloud/gophercloud"
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("ihosts", id)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("ihosts")
+}
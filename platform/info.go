@@ -11,6 +11,14 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/licenses"
 	"github.com/pkg/errors"
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/devicelabels"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/pcidevices"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/sensorgroups"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/sensors"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/snmpcommunities"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/snmptrapdests"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/storageceph"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/systemdetails"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresses"
@@ -48,6 +56,45 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/volumegroups"
 )
 
+// PortDevice defines the hardware attributes of an ethernet port.  These are
+// returned by the system API alongside the port but are not exposed by the
+// ports client.
+type PortDevice struct {
+	// Name is the system assigned name of the port.
+	Name string `json:"name"`
+
+	// Vendor is the PCI vendor name of the port.
+	Vendor string `json:"pvendor"`
+
+	// Device is the PCI device name of the port.
+	Device string `json:"pdevice"`
+}
+
+// listPorts is a utility which lists the ports of a host along with their
+// hardware attributes using a single request to the system API.
+func listPorts(client *gophercloud.ServiceClient, hostid string) ([]ports.Port, []PortDevice, error) {
+	pages, err := ports.List(client, hostid, nil).AllPages()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results, err := ports.ExtractPorts(pages)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var s struct {
+		Devices []PortDevice `json:"ethernet_ports"`
+	}
+
+	err = pages.(ports.PortPage).ExtractInto(&s)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return results, s.Devices, nil
+}
+
 // HostInfo defines the system resources that are collected thru the system API.
 // Since various methods that deal with specific resources often needs related
 // information from other resource those pieces of information are being
@@ -66,6 +113,7 @@ type HostInfo struct {
 	InterfaceDataNetworks []interfaceDataNetworks.InterfaceDataNetwork
 	Pools                 []addresspools.AddressPool
 	Ports                 []ports.Port
+	PortDevices           []PortDevice
 	Interfaces            []interfaces.Interface
 	Addresses             []addresses.Address
 	Routes                []routes.Route
@@ -78,9 +126,16 @@ type HostInfo struct {
 	StorageTiers          map[string]*storagetiers.StorageTier
 	FileSystems           []hostFilesystems.FileSystem
 	PTPInstances          []ptpinstances.PTPInstance
+	PCIDevices            []pcidevices.PCIDevice
+	DeviceLabels          []devicelabels.DeviceLabel
+	SensorGroups          []sensorgroups.SensorGroup
+	Sensors               []sensors.Sensor
 	PTPInterfaces         []ptpinterfaces.PTPInterface
 }
 
+// storageBackendCeph defines the type of Ceph storage backends.
+const storageBackendCeph = "ceph"
+
 type SystemInfo struct {
 	system.System
 	DRBD              *drbd.DRBD
@@ -92,6 +147,10 @@ type SystemInfo struct {
 	StorageBackends   []storagebackends.StorageBackend
 	FileSystems       []controllerFilesystems.FileSystem
 	License           *licenses.License
+	SNMPCommunities   []snmpcommunities.Community
+	SNMPTrapDests     []snmptrapdests.TrapDest
+	Timezone          string
+	CephPools         []storageceph.StorageCeph
 }
 
 func (in *SystemInfo) PopulateSystemInfo(client *gophercloud.ServiceClient) error {
@@ -104,6 +163,13 @@ func (in *SystemInfo) PopulateSystemInfo(client *gophercloud.ServiceClient) erro
 	}
 	in.System = *result
 
+	details, err := systemdetails.Get(client, result.ID).Extract()
+	if err != nil {
+		err = errors.Wrapf(err, "failed to get system details")
+		return err
+	}
+	in.Timezone = details.Timezone
+
 	in.DRBD, err = drbd.GetDefaultDRBD(client)
 	if err != nil {
 		err = errors.Wrap(err, "failed to get DRBD info")
@@ -148,6 +214,21 @@ func (in *SystemInfo) PopulateSystemInfo(client *gophercloud.ServiceClient) erro
 		return err
 	}
 
+	in.CephPools = make([]storageceph.StorageCeph, 0)
+	for _, sb := range in.StorageBackends {
+		if sb.Backend != storageBackendCeph {
+			continue
+		}
+
+		pool, err := storageceph.Get(client, sb.ID).Extract()
+		if err != nil {
+			err = errors.Wrapf(err, "failed to get ceph pool quotas for %s", sb.Name)
+			return err
+		}
+
+		in.CephPools = append(in.CephPools, *pool)
+	}
+
 	in.FileSystems, err = controllerFilesystems.ListFileSystems(client)
 	if err != nil {
 		err = errors.Wrap(err, "failed to get filesystem list")
@@ -162,6 +243,24 @@ func (in *SystemInfo) PopulateSystemInfo(client *gophercloud.ServiceClient) erro
 		}
 	}
 
+	// The SNMP API is not available on all releases therefore its absence is
+	// not considered an error.
+	in.SNMPCommunities, err = snmpcommunities.ListCommunities(client)
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			err = errors.Wrap(err, "failed to get SNMP community list")
+			return err
+		}
+	}
+
+	in.SNMPTrapDests, err = snmptrapdests.ListTrapDests(client)
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			err = errors.Wrap(err, "failed to get SNMP trap destination list")
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -299,7 +398,7 @@ func (in *HostInfo) PopulateHostInfo(client *gophercloud.ServiceClient, hostid s
 		return err
 	}
 
-	in.Ports, err = ports.ListPorts(client, hostid)
+	in.Ports, in.PortDevices, err = listPorts(client, hostid)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to list ports for host %s", hostid)
 		return err
@@ -391,9 +490,51 @@ func (in *HostInfo) PopulateHostInfo(client *gophercloud.ServiceClient, hostid s
 		return err
 	}
 
+	in.PCIDevices, err = pcidevices.ListPCIDevices(client, hostid)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to list PCI devices for host %s", hostid)
+		return err
+	}
+
+	in.DeviceLabels, err = ListDeviceLabels(client, hostid)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to list device labels for host %s", hostid)
+		return err
+	}
+
+	in.SensorGroups, err = sensorgroups.ListSensorGroups(client, hostid)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to list sensor groups for host %s", hostid)
+		return err
+	}
+
+	in.Sensors, err = sensors.ListSensors(client, hostid)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to list sensors for host %s", hostid)
+		return err
+	}
+
 	return nil
 }
 
+// ListDeviceLabels is a utility function which returns the device labels
+// assigned to the PCI devices of a single host.
+func ListDeviceLabels(client *gophercloud.ServiceClient, hostid string) ([]devicelabels.DeviceLabel, error) {
+	objects, err := devicelabels.ListDeviceLabels(client)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]devicelabels.DeviceLabel, 0)
+	for _, l := range objects {
+		if l.HostID == hostid {
+			result = append(result, l)
+		}
+	}
+
+	return result, nil
+}
+
 // findPortInterfaceUUID is a utility function which accepts a port name and
 // attempts to find the interface UUID which represents the interface associated
 // to the port.
@@ -488,6 +629,63 @@ func (in *HostInfo) FindInterfacePortName(id string) (string, bool) {
 	return "", false
 }
 
+// FindInterfacePort is a utility function which searches the list of ports
+// and returns the port that is associated to the interface ID value specified.
+func (in *HostInfo) FindInterfacePort(id string) (*ports.Port, bool) {
+	for _, p := range in.Ports {
+		if p.InterfaceID == id {
+			return &p, true
+		}
+	}
+	return nil, false
+}
+
+// FindPCIDeviceByAddress is a utility function to find a PCI device by its PCI
+// bus address.
+func (in *HostInfo) FindPCIDeviceByAddress(pciaddr string) (*pcidevices.PCIDevice, bool) {
+	for _, d := range in.PCIDevices {
+		if strings.EqualFold(d.PCIAddress, pciaddr) {
+			return &d, true
+		}
+	}
+	return nil, false
+}
+
+// FindDeviceLabels is a utility function to find the labels assigned to a PCI
+// device.
+func (in *HostInfo) FindDeviceLabels(deviceid string) map[string]string {
+	result := make(map[string]string)
+	for _, l := range in.DeviceLabels {
+		if l.PCIDeviceID == deviceid {
+			result[l.Key] = l.Value
+		}
+	}
+	return result
+}
+
+// FindSensorGroupByName is a utility function to find a sensor group by its
+// name.
+func (in *HostInfo) FindSensorGroupByName(name string) (*sensorgroups.SensorGroup, bool) {
+	for _, g := range in.SensorGroups {
+		if g.Name == name {
+			return &g, true
+		}
+	}
+	return nil, false
+}
+
+// FindSensorsByGroup is a utility function to find the sensors that belong to
+// a sensor group.
+func (in *HostInfo) FindSensorsByGroup(groupid string) []sensors.Sensor {
+	result := make([]sensors.Sensor, 0)
+	for _, s := range in.Sensors {
+		if s.SensorGroupID == groupid {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
 // findAddressUUID is a utility function which finds a system address object
 // by its unique attributes.
 func (in *HostInfo) FindAddressUUID(ifname string, address string, prefix int) (*addresses.Address, bool) {
@@ -0,0 +1,8 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package kubeupgrades contains functionality for working with System
+// Inventory Kubernetes upgrade resources.  This includes starting an upgrade,
+// moving it thru its states, upgrading the control plane and kubelet of each
+// host and aborting a failed upgrade.
+package kubeupgrades
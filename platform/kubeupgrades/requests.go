@@ -0,0 +1,154 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package kubeupgrades
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+	common "github.com/gophercloud/gophercloud/starlingx"
+)
+
+// KubeUpgradeOpts defines the attributes used to start a new upgrade.
+type KubeUpgradeOpts struct {
+	ToVersion string `json:"to_version"`
+	Force     bool   `json:"force"`
+}
+
+// KubeUpgradeStateOpts defines the attributes used to move an upgrade to its
+// next state.
+type KubeUpgradeStateOpts struct {
+	State *string `json:"state,omitempty" mapstructure:"state"`
+}
+
+// HostUpgradeOpts defines the attributes used to upgrade the control plane
+// or the kubelet of a host.
+type HostUpgradeOpts struct {
+	Force bool `json:"force"`
+}
+
+// Get retrieves the current upgrade.
+func Get(c *gophercloud.ServiceClient) (r GetResult) {
+	_, r.Err = c.Get(getURL(c), &r.Body, nil)
+	return r
+}
+
+// Create requests that an upgrade to the specified version be started.
+func Create(c *gophercloud.ServiceClient, opts KubeUpgradeOpts) (r CreateResult) {
+	_, r.Err = c.Post(createURL(c), opts, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return r
+}
+
+// Update requests that the current upgrade be moved to the specified state.
+func Update(c *gophercloud.ServiceClient, state string) (r UpdateResult) {
+	reqBody, err := common.ConvertToPatchMap(KubeUpgradeStateOpts{State: &state}, common.ReplaceOp)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	// Send request to API
+	_, r.Err = c.Patch(updateURL(c), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return r
+}
+
+// Delete deletes the current upgrade once it has completed or been aborted.
+func Delete(c *gophercloud.ServiceClient) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c), nil)
+	return r
+}
+
+// ListHosts returns a Pager which allows you to iterate over the upgrade
+// state of each host.
+func ListHosts(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listHostsURL(c), func(r pagination.PageResult) pagination.Page {
+		return HostUpgradePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// UpgradeControlPlane initiates the upgrade of the control plane of a host.
+func UpgradeControlPlane(c *gophercloud.ServiceClient, hostid string, opts HostUpgradeOpts) (r HostUpgradeResult) {
+	_, r.Err = c.Post(controlPlaneURL(c, hostid), opts, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
+
+// UpgradeKubelet initiates the upgrade of the kubelet of a host.
+func UpgradeKubelet(c *gophercloud.ServiceClient, hostid string, opts HostUpgradeOpts) (r HostUpgradeResult) {
+	_, r.Err = c.Post(kubeletURL(c, hostid), opts, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
+
+// GetKubeUpgrade is a convenience function to retrieve the current upgrade.
+// A nil upgrade is returned if no upgrade is in progress.
+func GetKubeUpgrade(c *gophercloud.ServiceClient) (*KubeUpgrade, error) {
+	upgrade, err := Get(c).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return upgrade, nil
+}
+
+// ListHostUpgrades is a convenience function to list and extract the entire
+// list of host upgrade states.
+func ListHostUpgrades(c *gophercloud.ServiceClient) ([]HostUpgrade, error) {
+	pages, err := ListHosts(c).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractHostUpgrades(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
+
+// ListVersions returns a Pager which allows you to iterate over the
+// Kubernetes versions known to the system.
+func ListVersions(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listVersionsURL(c), func(r pagination.PageResult) pagination.Page {
+		return VersionPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ActiveVersion is a convenience function to retrieve the Kubernetes version
+// currently running on the system.  An empty string is returned if no
+// version is reported as active.
+func ActiveVersion(c *gophercloud.ServiceClient) (string, error) {
+	pages, err := ListVersions(c).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	objs, err := ExtractVersions(pages)
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range objs {
+		if v.State == VersionStateActive {
+			return v.Version, nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,198 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package kubeupgrades
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Defines the upgrade states reported by the system.  The states are listed
+// in the order in which they are reached.
+const (
+	StateUpgradeStarted              = "upgrade-started"
+	StateDownloadingImages           = "downloading-images"
+	StateDownloadingImagesFailed     = "downloading-images-failed"
+	StateDownloadedImages            = "downloaded-images"
+	StateUpgradingNetworking         = "upgrading-networking"
+	StateUpgradingNetworkingFailed   = "upgrading-networking-failed"
+	StateUpgradedNetworking          = "upgraded-networking"
+	StateUpgradingFirstMaster        = "upgrading-first-master"
+	StateUpgradingFirstMasterFailed  = "upgrading-first-master-failed"
+	StateUpgradedFirstMaster         = "upgraded-first-master"
+	StateUpgradingSecondMaster       = "upgrading-second-master"
+	StateUpgradingSecondMasterFailed = "upgrading-second-master-failed"
+	StateUpgradedSecondMaster        = "upgraded-second-master"
+	StateUpgradingKubelets           = "upgrading-kubelets"
+	StateUpgradeComplete             = "upgrade-complete"
+	StateUpgradeAborting             = "upgrade-aborting"
+	StateUpgradeAbortingFailed       = "upgrade-aborting-failed"
+	StateUpgradeAborted              = "upgrade-aborted"
+)
+
+// Defines the upgrade states reported for each host.
+const (
+	HostStatusUpgradingControlPlane       = "upgrading-control-plane"
+	HostStatusUpgradingControlPlaneFailed = "upgrading-control-plane-failed"
+	HostStatusUpgradingKubelet            = "upgrading-kubelet"
+	HostStatusUpgradingKubeletFailed      = "upgrading-kubelet-failed"
+)
+
+// VersionStateActive is the state of the Kubernetes version currently
+// running on the system.
+const VersionStateActive = "active"
+
+// Extract interprets any commonResult as a KubeUpgrade.
+func (r commonResult) Extract() (*KubeUpgrade, error) {
+	var s KubeUpgrade
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a KubeUpgrade.  The system reports the
+// current upgrade as a list therefore a nil upgrade is returned if the list
+// is empty.
+func (r GetResult) Extract() (*KubeUpgrade, error) {
+	var s struct {
+		KubeUpgrades []KubeUpgrade `json:"kube_upgrades"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil || len(s.KubeUpgrades) == 0 {
+		return nil, err
+	}
+	return &s.KubeUpgrades[0], nil
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// HostUpgradeResult represents the result of a host control plane or kubelet
+// upgrade operation.
+type HostUpgradeResult struct {
+	gophercloud.ErrResult
+}
+
+// KubeUpgrade defines the data associated to the current Kubernetes upgrade.
+type KubeUpgrade struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// FromVersion defines the Kubernetes version being upgraded from.
+	FromVersion string `json:"from_version"`
+
+	// ToVersion defines the Kubernetes version being upgraded to.
+	ToVersion string `json:"to_version"`
+
+	// State defines the current upgrade state.
+	State string `json:"state"`
+}
+
+// Failed determines whether the upgrade has stopped in a failed state.
+func (u KubeUpgrade) Failed() bool {
+	return strings.HasSuffix(u.State, "-failed")
+}
+
+// HostUpgrade defines the upgrade state of a single host.
+type HostUpgrade struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// HostID defines the unique UUID value of the host.
+	HostID string `json:"host_uuid"`
+
+	// TargetVersion defines the Kubernetes version the host is upgraded to.
+	TargetVersion string `json:"target_version"`
+
+	// ControlPlaneVersion defines the Kubernetes version of the control plane
+	// of the host.  It is "N/A" for hosts which do not run the control plane.
+	ControlPlaneVersion string `json:"control_plane_version"`
+
+	// KubeletVersion defines the Kubernetes version of the kubelet of the
+	// host.
+	KubeletVersion string `json:"kubelet_version"`
+
+	// Status defines the current upgrade operation of the host, if any.
+	Status *string `json:"status,omitempty"`
+}
+
+// HostUpgradePage is the page returned by a pager when traversing over a
+// collection of host upgrade states.
+type HostUpgradePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a HostUpgradePage struct is empty.
+func (r HostUpgradePage) IsEmpty() (bool, error) {
+	is, err := ExtractHostUpgrades(r)
+	return len(is) == 0, err
+}
+
+// ExtractHostUpgrades accepts a Page struct, specifically a HostUpgradePage
+// struct, and extracts the elements into a slice of HostUpgrade structs.
+func ExtractHostUpgrades(r pagination.Page) ([]HostUpgrade, error) {
+	var s struct {
+		HostUpgrades []HostUpgrade `json:"kube_host_upgrades"`
+	}
+
+	err := (r.(HostUpgradePage)).ExtractInto(&s)
+
+	return s.HostUpgrades, err
+}
+
+// Version defines the data associated to a single Kubernetes version.
+type Version struct {
+	// Version defines the Kubernetes version (e.g., v1.24.4).
+	Version string `json:"version"`
+
+	// State defines whether the version is active, available or partially
+	// deployed.
+	State string `json:"state"`
+}
+
+// VersionPage is the page returned by a pager when traversing over a
+// collection of Kubernetes versions.
+type VersionPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a VersionPage struct is empty.
+func (r VersionPage) IsEmpty() (bool, error) {
+	is, err := ExtractVersions(r)
+	return len(is) == 0, err
+}
+
+// ExtractVersions accepts a Page struct, specifically a VersionPage struct,
+// and extracts the elements into a slice of Version structs.
+func ExtractVersions(r pagination.Page) ([]Version, error) {
+	var s struct {
+		Versions []Version `json:"kube_versions"`
+	}
+
+	err := (r.(VersionPage)).ExtractInto(&s)
+
+	return s.Versions, err
+}
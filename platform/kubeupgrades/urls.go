@@ -0,0 +1,42 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package kubeupgrades
+
+import "github.com/gophercloud/gophercloud"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("kube_upgrade")
+}
+
+func getURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func updateURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func deleteURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func listHostsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("kube_host_upgrades")
+}
+
+func controlPlaneURL(c *gophercloud.ServiceClient, hostid string) string {
+	return c.ServiceURL("ihosts", hostid, "kube_upgrade_control_plane")
+}
+
+func kubeletURL(c *gophercloud.ServiceClient, hostid string) string {
+	return c.ServiceURL("ihosts", hostid, "kube_upgrade_kubelet")
+}
+
+func listVersionsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("kube_versions")
+}
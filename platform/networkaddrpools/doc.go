@@ -0,0 +1,8 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package networkaddrpools contains functionality for working with System
+// Inventory network address pool resources.  A network address pool attaches
+// an additional address pool to a platform network so that a dual-stack
+// network has a pool for each address family.
+package networkaddrpools
@@ -0,0 +1,61 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package networkaddrpools
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// NetworkAddressPoolOpts defines the attributes used to attach an address
+// pool to a network.
+type NetworkAddressPoolOpts struct {
+	NetworkUUID     string `json:"network_uuid"`
+	AddressPoolUUID string `json:"address_pool_uuid"`
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// network address pools.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return NetworkAddressPoolPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Create attaches an address pool to a network.
+func Create(c *gophercloud.ServiceClient, opts NetworkAddressPoolOpts) (r CreateResult) {
+	_, r.Err = c.Post(createURL(c), opts, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+
+	return r
+}
+
+// Delete accepts a unique ID and detaches the address pool from the network
+// associated with it.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return r
+}
+
+// ListNetworkAddressPools is a convenience function to list and extract the
+// entire list of network address pools.
+func ListNetworkAddressPools(c *gophercloud.ServiceClient) ([]NetworkAddressPool, error) {
+	pages, err := List(c).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractNetworkAddressPools(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
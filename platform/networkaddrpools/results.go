@@ -0,0 +1,75 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package networkaddrpools
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Extract interprets any commonResult as a NetworkAddressPool.
+func (r commonResult) Extract() (*NetworkAddressPool, error) {
+	var s NetworkAddressPool
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// NetworkAddressPool defines the data associated to a single network address
+// pool instance.
+type NetworkAddressPool struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// NetworkUUID defines the unique UUID value of the network.
+	NetworkUUID string `json:"network_uuid"`
+
+	// NetworkName defines the name of the network.
+	NetworkName string `json:"network_name"`
+
+	// AddressPoolUUID defines the unique UUID value of the address pool.
+	AddressPoolUUID string `json:"address_pool_uuid"`
+
+	// AddressPoolName defines the name of the address pool.
+	AddressPoolName string `json:"address_pool_name"`
+}
+
+// NetworkAddressPoolPage is the page returned by a pager when traversing over
+// a collection of network address pools.
+type NetworkAddressPoolPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a NetworkAddressPoolPage struct is empty.
+func (r NetworkAddressPoolPage) IsEmpty() (bool, error) {
+	is, err := ExtractNetworkAddressPools(r)
+	return len(is) == 0, err
+}
+
+// ExtractNetworkAddressPools accepts a Page struct, specifically a
+// NetworkAddressPoolPage struct, and extracts the elements into a slice of
+// NetworkAddressPool structs. In other words, a generic collection is mapped
+// into a relevant slice.
+func ExtractNetworkAddressPools(r pagination.Page) ([]NetworkAddressPool, error) {
+	var s struct {
+		NetworkAddressPools []NetworkAddressPool `json:"network_addresspools"`
+	}
+
+	err := (r.(NetworkAddressPoolPage)).ExtractInto(&s)
+
+	return s.NetworkAddressPools, err
+}
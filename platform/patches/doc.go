@@ -0,0 +1,7 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package patches contains functionality for working with the software
+// patches of the Patching API.  Patches are uploaded from a directory on the
+// active controller, applied to the system and then installed on each host.
+package patches
@@ -0,0 +1,61 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package patches
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// List retrieves the patches known to the system.
+func List(c *gophercloud.ServiceClient) (r ListResult) {
+	_, r.Err = c.Get(listURL(c), &r.Body, nil)
+	return r
+}
+
+// UploadDir requests that every patch found in a directory of the active
+// controller be uploaded.
+func UploadDir(c *gophercloud.ServiceClient, dir string) (r ActionResult) {
+	query := url.Values{"dir0": []string{dir}}
+	_, r.Err = c.Post(uploadDirURL(c)+"?"+query.Encode(), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
+
+// Apply requests that the specified patches be applied to the system.
+func Apply(c *gophercloud.ServiceClient, ids []string) (r ActionResult) {
+	_, r.Err = c.Post(applyURL(c, ids), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
+
+// ListHosts retrieves the patch state of every host.
+func ListHosts(c *gophercloud.ServiceClient) (r ListHostsResult) {
+	_, r.Err = c.Get(listHostsURL(c), &r.Body, nil)
+	return r
+}
+
+// HostInstall requests that the applied patches be installed on a host.  The
+// installation completes asynchronously.
+func HostInstall(c *gophercloud.ServiceClient, hostname string) (r ActionResult) {
+	_, r.Err = c.Post(hostInstallURL(c, hostname), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
+
+// ListPatches is a convenience function to retrieve and extract the patches
+// known to the system keyed by patch ID.
+func ListPatches(c *gophercloud.ServiceClient) (map[string]Patch, error) {
+	return List(c).Extract()
+}
+
+// ListHostStates is a convenience function to retrieve and extract the patch
+// state of every host.
+func ListHostStates(c *gophercloud.ServiceClient) ([]HostState, error) {
+	return ListHosts(c).Extract()
+}
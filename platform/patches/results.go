@@ -0,0 +1,147 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package patches
+
+import (
+	"errors"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the patch states reported by the Patching API.
+const (
+	StateAvailable     = "Available"
+	StatePartialApply  = "Partial-Apply"
+	StateApplied       = "Applied"
+	StatePartialRemove = "Partial-Remove"
+	StateCommitted     = "Committed"
+)
+
+// Defines the installation states of a host reported by the Patching API.
+const (
+	HostStateIdle       = "idle"
+	HostStateInstalling = "installing"
+)
+
+// Patch defines the data associated to a single patch.
+type Patch struct {
+	// RepoState defines the state of the patch in the patch repository.
+	RepoState string `json:"repostate"`
+
+	// PatchState defines the state of the patch across the hosts.
+	PatchState string `json:"patchstate"`
+
+	// Status defines the release status of the patch (e.g., REL).
+	Status string `json:"status"`
+
+	// RequiresReboot defines whether hosts must be rebooted to install the
+	// patch.
+	RequiresReboot string `json:"reboot_required"`
+}
+
+// IsApplied determines whether a patch has been applied to the system.
+func (p Patch) IsApplied() bool {
+	switch p.RepoState {
+	case StateApplied, StateCommitted:
+		return true
+	}
+
+	return false
+}
+
+// HostState defines the patch state of a single host.
+type HostState struct {
+	// Hostname defines the name of the host.
+	Hostname string `json:"hostname"`
+
+	// PatchCurrent defines whether every applied patch is installed on the
+	// host.
+	PatchCurrent bool `json:"patch_current"`
+
+	// PatchFailed defines whether the last installation failed.
+	PatchFailed bool `json:"patch_failed"`
+
+	// RequiresReboot defines whether the host must be rebooted to complete
+	// the installation.
+	RequiresReboot bool `json:"requires_reboot"`
+
+	// State defines the installation state of the host (e.g., idle).
+	State string `json:"state"`
+}
+
+// actionResponse defines the messages returned by each Patching API request.
+// The request is considered to have failed if an error message is returned
+// even though the HTTP status is successful.
+type actionResponse struct {
+	Info    string `json:"info"`
+	Warning string `json:"warning"`
+	Error   string `json:"error"`
+}
+
+// err returns the error reported in the response, if any.
+func (r actionResponse) err() error {
+	if r.Error != "" {
+		return errors.New(r.Error)
+	}
+
+	return nil
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListResult as a map of patches keyed by patch ID.
+func (r ListResult) Extract() (map[string]Patch, error) {
+	var s struct {
+		actionResponse
+		Patches map[string]Patch `json:"pd"`
+	}
+
+	err := r.ExtractInto(&s)
+	if err == nil {
+		err = s.err()
+	}
+
+	return s.Patches, err
+}
+
+// ListHostsResult represents the result of a list hosts operation.
+type ListHostsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListHostsResult as a list of host patch states.
+func (r ListHostsResult) Extract() ([]HostState, error) {
+	var s struct {
+		Hosts []HostState `json:"data"`
+	}
+
+	err := r.ExtractInto(&s)
+
+	return s.Hosts, err
+}
+
+// ActionResult represents the result of an upload, apply or install
+// operation.
+type ActionResult struct {
+	gophercloud.Result
+}
+
+// ExtractErr interprets an ActionResult and returns the error reported by
+// the Patching API, if any.
+func (r ActionResult) ExtractErr() error {
+	if r.Err != nil {
+		return r.Err
+	}
+
+	var s actionResponse
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return err
+	}
+
+	return s.err()
+}
@@ -0,0 +1,26 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package patches
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("v1", "query")
+}
+
+func uploadDirURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("v1", "upload_dir")
+}
+
+func applyURL(c *gophercloud.ServiceClient, ids []string) string {
+	return c.ServiceURL(append([]string{"v1", "apply"}, ids...)...)
+}
+
+func listHostsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("v1", "query_hosts")
+}
+
+func hostInstallURL(c *gophercloud.ServiceClient, hostname string) string {
+	return c.ServiceURL("v1", "host_install_async", hostname)
+}
@@ -0,0 +1,8 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package pcidevices contains functionality for working with System Inventory
+// PCI device resources.  The inventory client does not yet provide support for
+// these resources so a minimal implementation is maintained here following the
+// same conventions.
+package pcidevices
@@ -0,0 +1,115 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package pcidevices
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Defines the PCI class identifiers of interest.
+const (
+	// PCIClassAccelerator is the class of processing accelerator devices such
+	// as FEC devices.
+	PCIClassAccelerator = "120000"
+)
+
+// Extract interprets any commonResult as a PCIDevice.
+func (r commonResult) Extract() (*PCIDevice, error) {
+	var s PCIDevice
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// PCIDevice defines the data associated to a single PCI device instance.
+type PCIDevice struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// HostID defines the unique UUID value of the host.
+	HostID string `json:"host_uuid"`
+
+	// Name defines the system assigned name of the device.
+	Name string `json:"name"`
+
+	// PCIAddress defines the PCI bus address of the device.
+	PCIAddress string `json:"pciaddr"`
+
+	// ClassID defines the PCI class identifier of the device.
+	ClassID string `json:"pclass_id"`
+
+	// VendorID defines the PCI vendor identifier of the device.
+	VendorID string `json:"pvendor_id"`
+
+	// DeviceID defines the PCI device identifier of the device.
+	DeviceID string `json:"pdevice_id"`
+
+	// Class defines the PCI class name of the device.
+	Class string `json:"pclass"`
+
+	// Vendor defines the PCI vendor name of the device.
+	Vendor string `json:"pvendor"`
+
+	// Device defines the PCI device name of the device.
+	Device string `json:"pdevice"`
+
+	// Node defines the NUMA node to which the device is attached.
+	Node int `json:"numa_node"`
+
+	// Enabled defines whether the device is enabled for use.
+	Enabled bool `json:"enabled"`
+
+	// Driver defines the driver bound to the device.
+	Driver *string `json:"driver,omitempty"`
+
+	// SriovTotalVFs defines the maximum number of virtual functions supported
+	// by the device.
+	SriovTotalVFs *int `json:"sriov_totalvfs,omitempty"`
+
+	// SriovNumVFs defines the number of virtual functions configured on the
+	// device.
+	SriovNumVFs *int `json:"sriov_numvfs,omitempty"`
+
+	// SriovVFDriver defines the driver bound to the virtual functions.
+	SriovVFDriver *string `json:"sriov_vf_driver,omitempty"`
+}
+
+// PCIDevicePage is the page returned by a pager when traversing over a
+// collection of PCI devices.
+type PCIDevicePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a PCIDevicePage struct is empty.
+func (r PCIDevicePage) IsEmpty() (bool, error) {
+	is, err := ExtractPCIDevices(r)
+	return len(is) == 0, err
+}
+
+// ExtractPCIDevices accepts a Page struct, specifically a PCIDevicePage
+// struct, and extracts the elements into a slice of PCIDevice structs. In
+// other words, a generic collection is mapped into a relevant slice.
+func ExtractPCIDevices(r pagination.Page) ([]PCIDevice, error) {
+	var s struct {
+		PCIDevices []PCIDevice `json:"pci_devices"`
+	}
+
+	err := (r.(PCIDevicePage)).ExtractInto(&s)
+
+	return s.PCIDevices, err
+}
@@ -0,0 +1,384 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package redfish
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Defines the Redfish service root and the collections used by the client.
+const (
+	ServiceRoot = "/redfish/v1"
+	Managers    = ServiceRoot + "/Managers"
+	Systems     = ServiceRoot + "/Systems"
+)
+
+// Defines the Redfish actions used by the client.
+const (
+	ActionInsertMedia = "#VirtualMedia.InsertMedia"
+	ActionEjectMedia  = "#VirtualMedia.EjectMedia"
+	ActionReset       = "#ComputerSystem.Reset"
+)
+
+// Defines the virtual media types that can be used to boot an install image.
+const (
+	MediaTypeCD  = "CD"
+	MediaTypeDVD = "DVD"
+)
+
+// Defines the boot source override values used to boot from virtual media
+// once.
+const (
+	BootSourceCd    = "Cd"
+	BootEnabledOnce = "Once"
+)
+
+// Defines the power states and reset types used to power-cycle a host.
+const (
+	PowerStateOff         = "Off"
+	ResetTypeOn           = "On"
+	ResetTypeForceRestart = "ForceRestart"
+)
+
+// DefaultTimeout defines the maximum time allowed for a single request to
+// the BMC.
+const DefaultTimeout = 60 * time.Second
+
+// Link defines a reference to another Redfish resource.
+type Link struct {
+	ID string `json:"@odata.id"`
+}
+
+// Collection defines a generic Redfish resource collection.
+type Collection struct {
+	Members []Link `json:"Members"`
+}
+
+// Action defines the target of a Redfish action.
+type Action struct {
+	Target string `json:"target"`
+}
+
+// Manager defines the subset of the Redfish manager resource used by the
+// client.
+type Manager struct {
+	ID            string         `json:"Id"`
+	VirtualMedia  *Link          `json:"VirtualMedia,omitempty"`
+	SerialConsole *SerialConsole `json:"SerialConsole,omitempty"`
+}
+
+// VirtualMedia defines the subset of the Redfish virtual media resource used
+// by the client.
+type VirtualMedia struct {
+	ID         string            `json:"Id"`
+	MediaTypes []string          `json:"MediaTypes"`
+	Image      string            `json:"Image"`
+	Inserted   bool              `json:"Inserted"`
+	Actions    map[string]Action `json:"Actions"`
+}
+
+// System defines the subset of the Redfish computer system resource used by
+// the client.
+type System struct {
+	ID         string            `json:"Id"`
+	PowerState string            `json:"PowerState"`
+	Actions    map[string]Action `json:"Actions"`
+}
+
+// Client defines a Redfish client bound to a single BMC.
+type Client struct {
+	address  string
+	endpoint string
+	username string
+	password string
+	http     *http.Client
+}
+
+// NewClient defines a convenience function to instantiate a new Redfish
+// client for the BMC at the given address.  The address may be a hostname or
+// an IP address and may optionally include a port.
+func NewClient(address, username, password string, insecure bool) *Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecure {
+		// BMCs are commonly deployed with self-signed certificates.
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return &Client{
+		address:  address,
+		endpoint: "https://" + formatHost(address),
+		username: username,
+		password: password,
+		http:     &http.Client{Transport: transport, Timeout: DefaultTimeout},
+	}
+}
+
+// formatHost is a utility which adds the brackets required to use an IPv6
+// address as the host portion of a URL.
+func formatHost(address string) string {
+	if _, _, err := net.SplitHostPort(address); err == nil {
+		return address
+	}
+
+	if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+		return "[" + address + "]"
+	}
+
+	return address
+}
+
+// do executes a single request against the BMC and decodes the response body
+// into result if one was provided.
+func (c *Client) do(method, path string, body interface{}, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.endpoint+path, reader)
+	if err != nil {
+		return err
+	}
+
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return ErrUnauthorized{Method: method, Path: path, StatusCode: resp.StatusCode}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		content, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("redfish %s %s failed with status %d: %s",
+			method, path, resp.StatusCode, strings.TrimSpace(string(content)))
+	}
+
+	if result != nil && resp.StatusCode != http.StatusNoContent {
+		return json.NewDecoder(resp.Body).Decode(result)
+	}
+
+	return nil
+}
+
+// ErrUnauthorized defines the error returned when the BMC rejects the
+// credentials used by the client.
+type ErrUnauthorized struct {
+	Method     string
+	Path       string
+	StatusCode int
+}
+
+// Error implements the error interface for ErrUnauthorized.
+func (e ErrUnauthorized) Error() string {
+	return fmt.Sprintf("redfish %s %s was not authorized: status %d",
+		e.Method, e.Path, e.StatusCode)
+}
+
+// firstMember is a utility which returns the first member of a collection.
+// Hosts managed by this client are expected to expose a single manager and
+// a single computer system.
+func (c *Client) firstMember(path string) (string, error) {
+	collection := Collection{}
+	err := c.do(http.MethodGet, path, nil, &collection)
+	if err != nil {
+		return "", err
+	}
+
+	if len(collection.Members) == 0 {
+		return "", fmt.Errorf("redfish collection %s has no members", path)
+	}
+
+	return collection.Members[0].ID, nil
+}
+
+// GetSystem retrieves the computer system managed by the BMC.
+func (c *Client) GetSystem() (string, *System, error) {
+	path, err := c.firstMember(Systems)
+	if err != nil {
+		return "", nil, err
+	}
+
+	system := System{}
+	err = c.do(http.MethodGet, path, nil, &system)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return path, &system, nil
+}
+
+// GetManager retrieves the manager resource that represents the BMC itself.
+func (c *Client) GetManager() (string, *Manager, error) {
+	path, err := c.firstMember(Managers)
+	if err != nil {
+		return "", nil, err
+	}
+
+	manager := Manager{}
+	err = c.do(http.MethodGet, path, nil, &manager)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return path, &manager, nil
+}
+
+// findVirtualMedia is a utility which returns the first virtual media device
+// of the BMC that can be used to present a CD or DVD image.
+func (c *Client) findVirtualMedia() (*VirtualMedia, error) {
+	path, manager, err := c.GetManager()
+	if err != nil {
+		return nil, err
+	}
+
+	if manager.VirtualMedia == nil {
+		return nil, fmt.Errorf("redfish manager %s does not support virtual media", path)
+	}
+
+	collection := Collection{}
+	err = c.do(http.MethodGet, manager.VirtualMedia.ID, nil, &collection)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range collection.Members {
+		media := VirtualMedia{}
+		err = c.do(http.MethodGet, member.ID, nil, &media)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range media.MediaTypes {
+			if t == MediaTypeCD || t == MediaTypeDVD {
+				return &media, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("redfish manager %s has no CD or DVD virtual media", path)
+}
+
+// InsertMedia mounts the image at the given URL as a virtual CD on the BMC.
+// Any image that is already inserted is ejected first.
+func (c *Client) InsertMedia(image string) error {
+	media, err := c.findVirtualMedia()
+	if err != nil {
+		return err
+	}
+
+	if media.Inserted {
+		if media.Image == image {
+			return nil
+		}
+
+		action, ok := media.Actions[ActionEjectMedia]
+		if !ok {
+			return fmt.Errorf("virtual media %s does not support %s", media.ID, ActionEjectMedia)
+		}
+
+		err = c.do(http.MethodPost, action.Target, map[string]interface{}{}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	action, ok := media.Actions[ActionInsertMedia]
+	if !ok {
+		return fmt.Errorf("virtual media %s does not support %s", media.ID, ActionInsertMedia)
+	}
+
+	body := map[string]interface{}{
+		"Image":          image,
+		"Inserted":       true,
+		"WriteProtected": true,
+	}
+
+	return c.do(http.MethodPost, action.Target, body, nil)
+}
+
+// SetOneTimeBoot configures the computer system to boot from the virtual CD
+// on its next boot only.
+func (c *Client) SetOneTimeBoot() error {
+	path, err := c.firstMember(Systems)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"Boot": map[string]string{
+			"BootSourceOverrideTarget":  BootSourceCd,
+			"BootSourceOverrideEnabled": BootEnabledOnce,
+		},
+	}
+
+	return c.do(http.MethodPatch, path, body, nil)
+}
+
+// PowerCycle restarts the computer system, or powers it on if it is
+// currently powered off.
+func (c *Client) PowerCycle() error {
+	_, system, err := c.GetSystem()
+	if err != nil {
+		return err
+	}
+
+	action, ok := system.Actions[ActionReset]
+	if !ok {
+		return fmt.Errorf("system %s does not support %s", system.ID, ActionReset)
+	}
+
+	resetType := ResetTypeForceRestart
+	if system.PowerState == PowerStateOff {
+		resetType = ResetTypeOn
+	}
+
+	body := map[string]string{"ResetType": resetType}
+
+	return c.do(http.MethodPost, action.Target, body, nil)
+}
+
+// BootFromVirtualMedia performs the full sequence required to install a host
+// from an ISO image: the image is mounted as a virtual CD, the system is set
+// to boot from it once, and the system is then power-cycled.
+func (c *Client) BootFromVirtualMedia(image string) error {
+	err := c.InsertMedia(image)
+	if err != nil {
+		return err
+	}
+
+	err = c.SetOneTimeBoot()
+	if err != nil {
+		return err
+	}
+
+	return c.PowerCycle()
+}
+
+// VerifyCredentials confirms that the BMC accepts the credentials used by the
+// client by reading the computer system collection which, unlike the service
+// root, always requires authentication.
+func (c *Client) VerifyCredentials() error {
+	return c.do(http.MethodGet, Systems, nil, &Collection{})
+}
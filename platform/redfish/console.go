@@ -0,0 +1,195 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package redfish
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// DefaultConsolePort defines the port used to reach the serial console SSH
+// service when the BMC does not report one.
+const DefaultConsolePort = 22
+
+// Defines the terminal settings requested for serial console sessions.
+const (
+	consoleTerminal = "vt100"
+	consoleRows     = 24
+	consoleColumns  = 200
+)
+
+// SerialConsoleSSH defines the subset of the Redfish serial console SSH
+// service settings used by the client.
+type SerialConsoleSSH struct {
+	ServiceEnabled       bool    `json:"ServiceEnabled"`
+	Port                 *int    `json:"Port,omitempty"`
+	SharedWithManagerCLI bool    `json:"SharedWithManagerCLI"`
+	ConsoleEntryCommand  *string `json:"ConsoleEntryCommand,omitempty"`
+}
+
+// SerialConsole defines the subset of the Redfish manager serial console
+// settings used by the client.
+type SerialConsole struct {
+	SSH *SerialConsoleSSH `json:"SSH,omitempty"`
+}
+
+// ConsoleSession defines an open serial console session.  Reading from the
+// session returns the raw console output of the computer system.
+type ConsoleSession struct {
+	io.Reader
+	client  *ssh.Client
+	session *ssh.Session
+	stdin   io.WriteCloser
+}
+
+// Close terminates the serial console session.
+func (s *ConsoleSession) Close() error {
+	s.stdin.Close()
+	s.session.Close()
+	return s.client.Close()
+}
+
+// GetSerialConsole retrieves the serial console SSH service settings of the
+// BMC.
+func (c *Client) GetSerialConsole() (*SerialConsoleSSH, error) {
+	path, manager, err := c.GetManager()
+	if err != nil {
+		return nil, err
+	}
+
+	if manager.SerialConsole == nil || manager.SerialConsole.SSH == nil {
+		return nil, fmt.Errorf("redfish manager %s does not support a serial console over SSH", path)
+	}
+
+	if !manager.SerialConsole.SSH.ServiceEnabled {
+		return nil, fmt.Errorf("redfish manager %s serial console SSH service is disabled", path)
+	}
+
+	return manager.SerialConsole.SSH, nil
+}
+
+// hostKeyCallback is a utility which builds the host key verification
+// function used for serial console sessions.  The host key is expected in
+// authorized_keys format.
+func hostKeyCallback(hostKey string, insecure bool) (ssh.HostKeyCallback, error) {
+	if hostKey != "" {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse serial console host key: %s", err.Error())
+		}
+
+		return ssh.FixedHostKey(key), nil
+	}
+
+	if insecure {
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+
+	return nil, fmt.Errorf("a serial console host key is required unless insecure access is allowed")
+}
+
+// OpenSerialConsole opens a session to the serial console of the computer
+// system through the SSH service of the BMC.  BMCs that share the service
+// with their command line interface are sent the console entry command that
+// they advertise.
+func (c *Client) OpenSerialConsole(hostKey string, insecure bool) (*ConsoleSession, error) {
+	settings, err := c.GetSerialConsole()
+	if err != nil {
+		return nil, err
+	}
+
+	callback, err := hostKeyCallback(hostKey, insecure)
+	if err != nil {
+		return nil, err
+	}
+
+	host := c.address
+	if h, _, err := net.SplitHostPort(c.address); err == nil {
+		host = h
+	}
+
+	port := DefaultConsolePort
+	if settings.Port != nil {
+		port = *settings.Port
+	}
+
+	config := &ssh.ClientConfig{
+		User: c.username,
+		Auth: []ssh.AuthMethod{
+			ssh.Password(c.password),
+			ssh.KeyboardInteractive(func(user, instruction string, questions []string, echos []bool) ([]string, error) {
+				answers := make([]string, len(questions))
+				for i := range answers {
+					answers[i] = c.password
+				}
+				return answers, nil
+			}),
+		},
+		HostKeyCallback: callback,
+		Timeout:         DefaultTimeout,
+	}
+
+	client, err := ssh.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(port)), config)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := client.NewSession()
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	result, err := startConsole(session, settings)
+	if err != nil {
+		session.Close()
+		client.Close()
+		return nil, err
+	}
+
+	result.client = client
+	result.session = session
+
+	return result, nil
+}
+
+// startConsole is a utility which starts the remote shell of a serial
+// console session and enters the console when required.
+func startConsole(session *ssh.Session, settings *SerialConsoleSSH) (*ConsoleSession, error) {
+	modes := ssh.TerminalModes{ssh.ECHO: 0}
+	err := session.RequestPty(consoleTerminal, consoleRows, consoleColumns, modes)
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	// The input stream is kept open for the duration of the session since
+	// some BMCs terminate the console as soon as it is closed.
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	err = session.Shell()
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.SharedWithManagerCLI && settings.ConsoleEntryCommand != nil {
+		_, err = fmt.Fprintf(stdin, "%s\n", *settings.ConsoleEntryCommand)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ConsoleSession{Reader: stdout, stdin: stdin}, nil
+}
@@ -0,0 +1,10 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package redfish contains a minimal client for the subset of the DMTF
+// Redfish API required to install a host from virtual media and to read its
+// serial console.  The client
+// talks directly to the host board management controller rather than to the
+// system API therefore it is only usable for hosts that have a reachable
+// Redfish capable BMC.
+package redfish
@@ -0,0 +1,8 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package sensorgroups contains functionality for working with System
+// Inventory host sensor group resources.  Sensor groups are discovered from
+// the board management controller and define the audit interval and the
+// actions taken when the sensors of the group raise an alarm.
+package sensorgroups
@@ -0,0 +1,108 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package sensorgroups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+	common "github.com/gophercloud/gophercloud/starlingx"
+)
+
+// SensorGroupOpts defines the attributes of a sensor group that can be
+// modified.
+type SensorGroupOpts struct {
+	AuditInterval   *int    `json:"audit_interval_group,omitempty" mapstructure:"audit_interval_group"`
+	Suppress        *string `json:"suppress,omitempty" mapstructure:"suppress"`
+	ActionsCritical *string `json:"actions_critical_group,omitempty" mapstructure:"actions_critical_group"`
+	ActionsMajor    *string `json:"actions_major_group,omitempty" mapstructure:"actions_major_group"`
+	ActionsMinor    *string `json:"actions_minor_group,omitempty" mapstructure:"actions_minor_group"`
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSensorGroupListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. SortKey allows you to sort by a particular sensor group attribute.
+// SortDir sets the direction, and is either `asc' or `desc'. Marker and Limit
+// are used for pagination.
+type ListOpts struct {
+	Marker  string `q:"marker"`
+	Limit   int    `q:"limit"`
+	SortKey string `q:"sort_key"`
+	SortDir string `q:"sort_dir"`
+}
+
+// ToSensorGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSensorGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// sensor groups. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, hostid string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c, hostid)
+	if opts != nil {
+		query, err := opts.ToSensorGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return SensorGroupPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific sensor group based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return r
+}
+
+// Update accepts a SensorGroupOpts struct and updates an existing sensor
+// group using the values provided.
+func Update(c *gophercloud.ServiceClient, id string, opts SensorGroupOpts) (r UpdateResult) {
+	reqBody, err := common.ConvertToPatchMap(opts, common.ReplaceOp)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	// Send request to API
+	_, r.Err = c.Patch(updateURL(c, id), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return r
+}
+
+// ListSensorGroups is a convenience function to list and extract the entire
+// list of sensor groups on a specific host.
+func ListSensorGroups(c *gophercloud.ServiceClient, hostid string) ([]SensorGroup, error) {
+	pages, err := List(c, hostid, nil).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractSensorGroups(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
@@ -0,0 +1,104 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package sensorgroups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Defines the values used by the system API to represent a suppressed or
+// unsuppressed sensor group.
+const (
+	SuppressTrue  = "True"
+	SuppressFalse = "False"
+)
+
+// Extract interprets any commonResult as a SensorGroup.
+func (r commonResult) Extract() (*SensorGroup, error) {
+	var s SensorGroup
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// SensorGroup defines the data associated to a single sensor group instance.
+type SensorGroup struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// HostID defines the unique UUID value of the host.
+	HostID string `json:"host_uuid"`
+
+	// Name defines the name of the sensor group as reported by the board
+	// management controller.
+	Name string `json:"sensorgroupname"`
+
+	// SensorType defines the type of the sensors in the group (e.g.,
+	// temperature, voltage, fan).
+	SensorType string `json:"sensortype"`
+
+	// DataType defines whether the sensors of the group report discrete or
+	// analog values.
+	DataType string `json:"datatype"`
+
+	// AuditInterval defines the interval, in seconds, between audits of the
+	// sensors of the group.
+	AuditInterval int `json:"audit_interval_group"`
+
+	// Suppress defines whether alarms are suppressed for the whole group.
+	Suppress string `json:"suppress"`
+
+	// ActionsCritical defines the action taken on a critical sensor alarm.
+	ActionsCritical string `json:"actions_critical_group"`
+
+	// ActionsMajor defines the action taken on a major sensor alarm.
+	ActionsMajor string `json:"actions_major_group"`
+
+	// ActionsMinor defines the action taken on a minor sensor alarm.
+	ActionsMinor string `json:"actions_minor_group"`
+}
+
+// IsSuppressed returns whether alarms are suppressed for the sensor group.
+func (in *SensorGroup) IsSuppressed() bool {
+	return in.Suppress == SuppressTrue
+}
+
+// SensorGroupPage is the page returned by a pager when traversing over a
+// collection of sensor groups.
+type SensorGroupPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a SensorGroupPage struct is empty.
+func (r SensorGroupPage) IsEmpty() (bool, error) {
+	is, err := ExtractSensorGroups(r)
+	return len(is) == 0, err
+}
+
+// ExtractSensorGroups accepts a Page struct, specifically a SensorGroupPage
+// struct, and extracts the elements into a slice of SensorGroup structs. In
+// other words, a generic collection is mapped into a relevant slice.
+func ExtractSensorGroups(r pagination.Page) ([]SensorGroup, error) {
+	var s struct {
+		SensorGroups []SensorGroup `json:"isensorgroups"`
+	}
+
+	err := (r.(SensorGroupPage)).ExtractInto(&s)
+
+	return s.SensorGroups, err
+}
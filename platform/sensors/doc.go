@@ -0,0 +1,7 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package sensors contains functionality for working with System Inventory
+// host sensor resources.  Individual sensors may be suppressed so that they
+// no longer raise alarms.
+package sensors
@@ -0,0 +1,103 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package sensors
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+	common "github.com/gophercloud/gophercloud/starlingx"
+)
+
+// SensorOpts defines the attributes of a sensor that can be modified.
+type SensorOpts struct {
+	Suppress *string `json:"suppress,omitempty" mapstructure:"suppress"`
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSensorListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. SortKey allows you to sort by a particular sensor attribute.
+// SortDir sets the direction, and is either `asc' or `desc'. Marker and Limit
+// are used for pagination.
+type ListOpts struct {
+	Marker  string `q:"marker"`
+	Limit   int    `q:"limit"`
+	SortKey string `q:"sort_key"`
+	SortDir string `q:"sort_dir"`
+}
+
+// ToSensorListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSensorListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// sensors. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, hostid string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c, hostid)
+	if opts != nil {
+		query, err := opts.ToSensorListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return SensorPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific sensor based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return r
+}
+
+// Update accepts a SensorOpts struct and updates an existing sensor using the
+// values provided.
+func Update(c *gophercloud.ServiceClient, id string, opts SensorOpts) (r UpdateResult) {
+	reqBody, err := common.ConvertToPatchMap(opts, common.ReplaceOp)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	// Send request to API
+	_, r.Err = c.Patch(updateURL(c, id), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return r
+}
+
+// ListSensors is a convenience function to list and extract the entire list
+// of sensors on a specific host.
+func ListSensors(c *gophercloud.ServiceClient, hostid string) ([]Sensor, error) {
+	pages, err := List(c, hostid, nil).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractSensors(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
@@ -0,0 +1,93 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package sensors
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Defines the values used by the system API to represent a suppressed or
+// unsuppressed sensor.
+const (
+	SuppressTrue  = "True"
+	SuppressFalse = "False"
+)
+
+// Extract interprets any commonResult as a Sensor.
+func (r commonResult) Extract() (*Sensor, error) {
+	var s Sensor
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// Sensor defines the data associated to a single sensor instance.
+type Sensor struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// HostID defines the unique UUID value of the host.
+	HostID string `json:"host_uuid"`
+
+	// SensorGroupID defines the unique UUID value of the sensor group to
+	// which the sensor belongs.
+	SensorGroupID string `json:"sensorgroup_uuid"`
+
+	// Name defines the name of the sensor as reported by the board management
+	// controller.
+	Name string `json:"sensorname"`
+
+	// SensorType defines the type of the sensor.
+	SensorType string `json:"sensortype"`
+
+	// Status defines the current alarm status of the sensor.
+	Status string `json:"status"`
+
+	// Suppress defines whether alarms are suppressed for the sensor.
+	Suppress string `json:"suppress"`
+}
+
+// IsSuppressed returns whether alarms are suppressed for the sensor.
+func (in *Sensor) IsSuppressed() bool {
+	return in.Suppress == SuppressTrue
+}
+
+// SensorPage is the page returned by a pager when traversing over a
+// collection of sensors.
+type SensorPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a SensorPage struct is empty.
+func (r SensorPage) IsEmpty() (bool, error) {
+	is, err := ExtractSensors(r)
+	return len(is) == 0, err
+}
+
+// ExtractSensors accepts a Page struct, specifically a SensorPage struct, and
+// extracts the elements into a slice of Sensor structs. In other words, a
+// generic collection is mapped into a relevant slice.
+func ExtractSensors(r pagination.Page) ([]Sensor, error) {
+	var s struct {
+		Sensors []Sensor `json:"isensors"`
+	}
+
+	err := (r.(SensorPage)).ExtractInto(&s)
+
+	return s.Sensors, err
+}
@@ -0,0 +1,7 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package snmpcommunities contains functionality for working with System
+// Inventory SNMP community resources.  A community string grants read-only
+// access to the SNMP agent of the system.
+package snmpcommunities
@@ -0,0 +1,66 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package snmpcommunities
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+	common "github.com/gophercloud/gophercloud/starlingx"
+)
+
+// CommunityOpts defines the attributes of a community that can be set when
+// it is created.
+type CommunityOpts struct {
+	Community *string `json:"community,omitempty" mapstructure:"community"`
+}
+
+// List returns a Pager which allows you to iterate over the collection of
+// SNMP communities.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return CommunityPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Create accepts a CommunityOpts struct and creates a new community using the
+// values provided.
+func Create(c *gophercloud.ServiceClient, opts CommunityOpts) (r CreateResult) {
+	reqBody, err := common.ConvertToCreateMap(opts)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Post(createURL(c), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	return r
+}
+
+// Delete accepts a unique ID and deletes the community associated with it.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return r
+}
+
+// ListCommunities is a convenience function to list and extract the entire
+// list of SNMP communities.
+func ListCommunities(c *gophercloud.ServiceClient) ([]Community, error) {
+	pages, err := List(c).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractCommunities(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
@@ -0,0 +1,70 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package snmpcommunities
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Extract interprets any commonResult as a Community.
+func (r commonResult) Extract() (*Community, error) {
+	var s Community
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Community defines the data associated to a single SNMP community instance.
+type Community struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// Community defines the community string.
+	Community string `json:"community"`
+
+	// View defines the MIB view which is accessible to the community.
+	View string `json:"view"`
+
+	// Access defines the access level granted to the community.
+	Access string `json:"access"`
+}
+
+// CommunityPage is the page returned by a pager when traversing over a
+// collection of communities.
+type CommunityPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a CommunityPage struct is empty.
+func (r CommunityPage) IsEmpty() (bool, error) {
+	is, err := ExtractCommunities(r)
+	return len(is) == 0, err
+}
+
+// ExtractCommunities accepts a Page struct, specifically a CommunityPage
+// struct, and extracts the elements into a slice of Community structs. In
+// other words, a generic collection is mapped into a relevant slice.
+func ExtractCommunities(r pagination.Page) ([]Community, error) {
+	var s struct {
+		Communities []Community `json:"icommunity"`
+	}
+
+	err := (r.(CommunityPage)).ExtractInto(&s)
+
+	return s.Communities, err
+}
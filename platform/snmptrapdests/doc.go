@@ -0,0 +1,8 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package snmptrapdests contains functionality for working with System
+// Inventory SNMP trap destination resources.  A trap destination defines an
+// address to which SNMP traps are sent along with the community string used
+// to send them.
+package snmptrapdests
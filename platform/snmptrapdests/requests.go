@@ -0,0 +1,85 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package snmptrapdests
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+	common "github.com/gophercloud/gophercloud/starlingx"
+)
+
+// TrapDestOpts defines the attributes of a trap destination that can be set
+// when it is created or modified.
+type TrapDestOpts struct {
+	IPAddress *string `json:"ip_address,omitempty" mapstructure:"ip_address"`
+	Community *string `json:"community,omitempty" mapstructure:"community"`
+}
+
+// List returns a Pager which allows you to iterate over the collection of
+// SNMP trap destinations.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return TrapDestPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Create accepts a TrapDestOpts struct and creates a new trap destination
+// using the values provided.
+func Create(c *gophercloud.ServiceClient, opts TrapDestOpts) (r CreateResult) {
+	reqBody, err := common.ConvertToCreateMap(opts)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Post(createURL(c), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	return r
+}
+
+// Update accepts a TrapDestOpts struct and updates an existing trap
+// destination using the values provided.
+func Update(c *gophercloud.ServiceClient, id string, opts TrapDestOpts) (r UpdateResult) {
+	reqBody, err := common.ConvertToPatchMap(opts, common.ReplaceOp)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	// Send request to API
+	_, r.Err = c.Patch(updateURL(c, id), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return r
+}
+
+// Delete accepts a unique ID and deletes the trap destination associated
+// with it.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return r
+}
+
+// ListTrapDests is a convenience function to list and extract the entire
+// list of SNMP trap destinations.
+func ListTrapDests(c *gophercloud.ServiceClient) ([]TrapDest, error) {
+	pages, err := List(c).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := pages.IsEmpty()
+	if empty || err != nil {
+		return nil, err
+	}
+
+	objs, err := ExtractTrapDests(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, err
+}
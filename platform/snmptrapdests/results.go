@@ -0,0 +1,82 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package snmptrapdests
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Extract interprets any commonResult as a TrapDest.
+func (r commonResult) Extract() (*TrapDest, error) {
+	var s TrapDest
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// TrapDest defines the data associated to a single SNMP trap destination
+// instance.
+type TrapDest struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// IPAddress defines the address to which traps are sent.
+	IPAddress string `json:"ip_address"`
+
+	// Community defines the community string used to send traps.
+	Community string `json:"community"`
+
+	// Type defines the type of trap sent to the destination.
+	Type string `json:"type"`
+
+	// Port defines the port to which traps are sent.
+	Port int `json:"port"`
+
+	// Transport defines the transport protocol used to send traps.
+	Transport string `json:"transport"`
+}
+
+// TrapDestPage is the page returned by a pager when traversing over a
+// collection of trap destinations.
+type TrapDestPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a TrapDestPage struct is empty.
+func (r TrapDestPage) IsEmpty() (bool, error) {
+	is, err := ExtractTrapDests(r)
+	return len(is) == 0, err
+}
+
+// ExtractTrapDests accepts a Page struct, specifically a TrapDestPage struct,
+// and extracts the elements into a slice of TrapDest structs. In other words,
+// a generic collection is mapped into a relevant slice.
+func ExtractTrapDests(r pagination.Page) ([]TrapDest, error) {
+	var s struct {
+		TrapDests []TrapDest `json:"itrapdest"`
+	}
+
+	err := (r.(TrapDestPage)).ExtractInto(&s)
+
+	return s.TrapDests, err
+}
@@ -0,0 +1,8 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package software contains functionality for working with the releases and
+// deployments of the Unified Software Management (USM) API.  A release is
+// uploaded to the system, its deployment is started, each host is deployed
+// in turn and the deployment is then activated and completed.
+package software
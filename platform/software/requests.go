@@ -0,0 +1,106 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package software
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the actions which move a deployment to its next step.
+const (
+	ActionActivate = "activate"
+	ActionComplete = "complete"
+)
+
+// ListReleases retrieves the releases known to the system.
+func ListReleases(c *gophercloud.ServiceClient) (r ListReleasesResult) {
+	_, r.Err = c.Get(releasesURL(c), &r.Body, nil)
+	return r
+}
+
+// Upload requests that the release files found on the active controller be
+// uploaded to the system.
+func Upload(c *gophercloud.ServiceClient, paths []string) (r ActionResult) {
+	body, err := json.Marshal(paths)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	// Files which are local to the active controller are referenced by path
+	// rather than being sent as a multipart request.
+	_, r.Err = c.Post(releasesURL(c), nil, &r.Body, &gophercloud.RequestOpts{
+		RawBody:     bytes.NewReader(body),
+		MoreHeaders: map[string]string{"Content-Type": "text/plain"},
+		OkCodes:     []int{200},
+	})
+	return r
+}
+
+// Get retrieves the current deployment.
+func Get(c *gophercloud.ServiceClient) (r GetResult) {
+	_, r.Err = c.Get(deployURL(c), &r.Body, nil)
+	return r
+}
+
+// Start requests that the deployment of a release be started.
+func Start(c *gophercloud.ServiceClient, release string) (r ActionResult) {
+	_, r.Err = c.Post(deployStartURL(c, release), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
+
+// Action requests that the current deployment be activated or completed.
+func Action(c *gophercloud.ServiceClient, action string) (r ActionResult) {
+	_, r.Err = c.Post(deployActionURL(c, action), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
+
+// Delete deletes the current deployment once it has completed.
+func Delete(c *gophercloud.ServiceClient) (r ActionResult) {
+	_, r.Err = c.Delete(deployURL(c), &gophercloud.RequestOpts{
+		JSONResponse: &r.Body,
+		OkCodes:      []int{200, 202, 204},
+	})
+	return r
+}
+
+// ListHosts retrieves the deployment state of every host.
+func ListHosts(c *gophercloud.ServiceClient) (r ListHostsResult) {
+	_, r.Err = c.Get(deployHostsURL(c), &r.Body, nil)
+	return r
+}
+
+// DeployHost requests that the release being deployed be deployed to a host.
+// The host is deployed asynchronously.
+func DeployHost(c *gophercloud.ServiceClient, hostname string) (r ActionResult) {
+	_, r.Err = c.Post(deployHostURL(c, hostname), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
+
+// GetDeploy is a convenience function to retrieve and extract the current
+// deployment.  A nil deployment is returned if none exists.
+func GetDeploy(c *gophercloud.ServiceClient) (*Deploy, error) {
+	return Get(c).Extract()
+}
+
+// ListReleaseStates is a convenience function to retrieve and extract the
+// releases known to the system.
+func ListReleaseStates(c *gophercloud.ServiceClient) ([]Release, error) {
+	return ListReleases(c).Extract()
+}
+
+// ListHostStates is a convenience function to retrieve and extract the
+// deployment state of every host.
+func ListHostStates(c *gophercloud.ServiceClient) ([]DeployHostState, error) {
+	return ListHosts(c).Extract()
+}
@@ -0,0 +1,181 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package software
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the release states reported by the USM API.
+const (
+	ReleaseStateAvailable = "available"
+	ReleaseStateDeploying = "deploying"
+	ReleaseStateDeployed  = "deployed"
+	ReleaseStateCommitted = "committed"
+)
+
+// Defines the deployment states reported by the USM API.
+const (
+	DeployStateStart          = "start"
+	DeployStateStartDone      = "start-done"
+	DeployStateStartFailed    = "start-failed"
+	DeployStateHost           = "host"
+	DeployStateHostDone       = "host-done"
+	DeployStateHostFailed     = "host-failed"
+	DeployStateActivate       = "activate"
+	DeployStateActivateDone   = "activate-done"
+	DeployStateActivateFailed = "activate-failed"
+	DeployStateCompleted      = "completed"
+)
+
+// Defines the host deployment states reported by the USM API.
+const (
+	HostStatePending   = "pending"
+	HostStateDeploying = "deploying"
+	HostStateDeployed  = "deployed"
+	HostStateFailed    = "failed"
+)
+
+// Release defines the data associated to a single release.
+type Release struct {
+	// ID defines the identifier of the release (e.g., stx-10.0.1).
+	ID string `json:"release_id"`
+
+	// State defines the state of the release.
+	State string `json:"state"`
+
+	// Version defines the software version of the release.
+	Version string `json:"sw_version"`
+
+	// RebootRequired defines whether hosts must be rebooted to deploy the
+	// release.
+	RebootRequired bool `json:"reboot_required"`
+}
+
+// Deploy defines the data associated to the current deployment.
+type Deploy struct {
+	// FromRelease defines the release from which the system is moving.
+	FromRelease string `json:"from_release"`
+
+	// ToRelease defines the release being deployed.
+	ToRelease string `json:"to_release"`
+
+	// State defines the state of the deployment.
+	State string `json:"state"`
+
+	// RebootRequired defines whether hosts must be rebooted to deploy the
+	// release.
+	RebootRequired bool `json:"reboot_required"`
+}
+
+// Failed determines whether the deployment has stopped on a failure.
+func (d Deploy) Failed() bool {
+	return strings.HasSuffix(d.State, "-failed")
+}
+
+// DeployHostState defines the deployment state of a single host.
+type DeployHostState struct {
+	// Hostname defines the name of the host.
+	Hostname string `json:"hostname"`
+
+	// SoftwareRelease defines the release currently running on the host.
+	SoftwareRelease string `json:"software_release"`
+
+	// TargetRelease defines the release being deployed to the host.
+	TargetRelease string `json:"target_release"`
+
+	// RebootRequired defines whether the host must be rebooted.
+	RebootRequired bool `json:"reboot_required"`
+
+	// State defines the deployment state of the host.
+	State string `json:"host_state"`
+}
+
+// actionResponse defines the messages returned by each USM API request.  The
+// request is considered to have failed if an error message is returned even
+// though the HTTP status is successful.
+type actionResponse struct {
+	Info    string `json:"info"`
+	Warning string `json:"warning"`
+	Error   string `json:"error"`
+}
+
+// err returns the error reported in the response, if any.
+func (r actionResponse) err() error {
+	if r.Error != "" {
+		return errors.New(r.Error)
+	}
+
+	return nil
+}
+
+// ListReleasesResult represents the result of a list releases operation.
+type ListReleasesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListReleasesResult as a list of releases.
+func (r ListReleasesResult) Extract() ([]Release, error) {
+	var s []Release
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a Deploy.  A nil deployment is returned
+// if the USM API reports that no deployment exists.
+func (r GetResult) Extract() (*Deploy, error) {
+	var s []Deploy
+	err := r.ExtractInto(&s)
+	if err != nil || len(s) == 0 {
+		return nil, err
+	}
+
+	return &s[0], nil
+}
+
+// ListHostsResult represents the result of a list hosts operation.
+type ListHostsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListHostsResult as a list of host deployment states.
+func (r ListHostsResult) Extract() ([]DeployHostState, error) {
+	var s []DeployHostState
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ActionResult represents the result of an upload, start, host, activate,
+// complete or delete operation.
+type ActionResult struct {
+	gophercloud.Result
+}
+
+// ExtractErr interprets an ActionResult and returns the error reported by
+// the USM API, if any.
+func (r ActionResult) ExtractErr() error {
+	if r.Err != nil {
+		return r.Err
+	}
+
+	if r.Body == nil {
+		return nil
+	}
+
+	var s actionResponse
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return err
+	}
+
+	return s.err()
+}
@@ -0,0 +1,30 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package software
+
+import "github.com/gophercloud/gophercloud"
+
+func releasesURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("v1", "release")
+}
+
+func deployURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("v1", "deploy")
+}
+
+func deployStartURL(c *gophercloud.ServiceClient, release string) string {
+	return c.ServiceURL("v1", "deploy", release, "start")
+}
+
+func deployActionURL(c *gophercloud.ServiceClient, action string) string {
+	return c.ServiceURL("v1", "deploy", action)
+}
+
+func deployHostsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("v1", "deploy_host")
+}
+
+func deployHostURL(c *gophercloud.ServiceClient, hostname string) string {
+	return c.ServiceURL("v1", "deploy_host", hostname)
+}
@@ -0,0 +1,7 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package storageceph contains functionality for working with the Ceph
+// specific attributes of a System Inventory storage backend, such as the
+// quotas of the pools used by each service.
+package storageceph
@@ -0,0 +1,42 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package storageceph
+
+import (
+	"github.com/gophercloud/gophercloud"
+	common "github.com/gophercloud/gophercloud/starlingx"
+)
+
+// StorageCephOpts defines the Ceph backend attributes that can be modified.
+type StorageCephOpts struct {
+	CinderPoolGib    *int `json:"cinder_pool_gib,omitempty" mapstructure:"cinder_pool_gib"`
+	GlancePoolGib    *int `json:"glance_pool_gib,omitempty" mapstructure:"glance_pool_gib"`
+	EphemeralPoolGib *int `json:"ephemeral_pool_gib,omitempty" mapstructure:"ephemeral_pool_gib"`
+	ObjectPoolGib    *int `json:"object_pool_gib,omitempty" mapstructure:"object_pool_gib"`
+	KubePoolGib      *int `json:"kube_pool_gib,omitempty" mapstructure:"kube_pool_gib"`
+}
+
+// Get retrieves the Ceph attributes of a specific storage backend based on
+// its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return r
+}
+
+// Update accepts a StorageCephOpts struct and updates an existing Ceph
+// storage backend using the values provided.
+func Update(c *gophercloud.ServiceClient, id string, opts StorageCephOpts) (r UpdateResult) {
+	reqBody, err := common.ConvertToPatchMap(opts, common.ReplaceOp)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	// Send request to API
+	_, r.Err = c.Patch(updateURL(c, id), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return r
+}
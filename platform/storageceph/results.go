@@ -0,0 +1,50 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package storageceph
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Extract interprets any commonResult as a StorageCeph.
+func (r commonResult) Extract() (*StorageCeph, error) {
+	var s StorageCeph
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// StorageCeph defines the Ceph specific attributes of a storage backend.
+type StorageCeph struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// CinderPoolGib defines the quota of the block storage pool.
+	CinderPoolGib *int `json:"cinder_pool_gib,omitempty"`
+
+	// GlancePoolGib defines the quota of the image pool.
+	GlancePoolGib *int `json:"glance_pool_gib,omitempty"`
+
+	// EphemeralPoolGib defines the quota of the ephemeral storage pool.
+	EphemeralPoolGib *int `json:"ephemeral_pool_gib,omitempty"`
+
+	// ObjectPoolGib defines the quota of the object storage pool.
+	ObjectPoolGib *int `json:"object_pool_gib,omitempty"`
+
+	// KubePoolGib defines the quota of the Kubernetes persistent volume pool.
+	KubePoolGib *int `json:"kube_pool_gib,omitempty"`
+}
@@ -0,0 +1,9 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package strategies contains functionality for working with VIM update
+// orchestration strategies.  This includes creating a strategy of a given
+// type (e.g., sw-patch, kube-upgrade, fw-update), querying its progress,
+// applying or aborting it and deleting it once it is no longer needed.  The
+// VIM only allows a single strategy of each type to exist at any time.
+package strategies
@@ -0,0 +1,88 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package strategies
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the supported strategy types.
+const (
+	TypeSwPatch     = "sw-patch"
+	TypeKubeUpgrade = "kube-upgrade"
+	TypeFwUpdate    = "fw-update"
+)
+
+// Defines the supported strategy actions.
+const (
+	ActionApplyAll = "apply-all"
+	ActionAbort    = "abort"
+)
+
+// StrategyOpts defines the attributes used to create a new strategy.  Not
+// every attribute is supported by every strategy type; unset attributes are
+// omitted from the request.
+type StrategyOpts struct {
+	ControllerApplyType    *string `json:"controller-apply-type,omitempty"`
+	StorageApplyType       *string `json:"storage-apply-type,omitempty"`
+	WorkerApplyType        string  `json:"worker-apply-type"`
+	MaxParallelWorkerHosts *int    `json:"max-parallel-worker-hosts,omitempty"`
+	DefaultInstanceAction  string  `json:"default-instance-action"`
+	AlarmRestrictions      string  `json:"alarm-restrictions"`
+	ToVersion              *string `json:"to-version,omitempty"`
+}
+
+// StrategyActionOpts defines the attributes of a strategy action request.
+type StrategyActionOpts struct {
+	Action  string  `json:"action"`
+	StageID *string `json:"stage-id,omitempty"`
+}
+
+// Get retrieves the current strategy of the specified type.
+func Get(c *gophercloud.ServiceClient, strategyType string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, strategyType), &r.Body, nil)
+	return r
+}
+
+// Create requests that a new strategy of the specified type be built using
+// the attributes provided.
+func Create(c *gophercloud.ServiceClient, strategyType string, opts StrategyOpts) (r CreateResult) {
+	_, r.Err = c.Post(createURL(c, strategyType), opts, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	return r
+}
+
+// Action requests that the specified action be performed on the current
+// strategy of the specified type.
+func Action(c *gophercloud.ServiceClient, strategyType string, opts StrategyActionOpts) (r ActionResult) {
+	_, r.Err = c.Post(actionURL(c, strategyType), opts, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
+
+// Delete deletes the current strategy of the specified type.
+func Delete(c *gophercloud.ServiceClient, strategyType string) (r DeleteResult) {
+	// The VIM API expects an empty JSON body on delete requests.
+	_, r.Err = c.Delete(deleteURL(c, strategyType), &gophercloud.RequestOpts{
+		JSONBody: map[string]interface{}{},
+		OkCodes:  []int{200, 202, 204},
+	})
+	return r
+}
+
+// GetStrategy is a convenience function to retrieve and extract the current
+// strategy of the specified type.  A nil strategy is returned if none exists.
+func GetStrategy(c *gophercloud.ServiceClient, strategyType string) (*Strategy, error) {
+	strategy, err := Get(c, strategyType).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return strategy, nil
+}
@@ -0,0 +1,164 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package strategies
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the strategy states reported by the VIM.
+const (
+	StateInitial      = "initial"
+	StateBuilding     = "building"
+	StateBuildFailed  = "build-failed"
+	StateBuildTimeout = "build-timeout"
+	StateReadyToApply = "ready-to-apply"
+	StateApplying     = "applying"
+	StateApplyFailed  = "apply-failed"
+	StateApplyTimeout = "apply-timeout"
+	StateApplied      = "applied"
+	StateAborting     = "aborting"
+	StateAbortFailed  = "abort-failed"
+	StateAbortTimeout = "abort-timeout"
+	StateAborted      = "aborted"
+)
+
+// Extract interprets any commonResult as a Strategy.  A nil strategy is
+// returned if the VIM reports that no strategy exists.
+func (r commonResult) Extract() (*Strategy, error) {
+	var s struct {
+		Strategy *Strategy `json:"strategy"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil || s.Strategy == nil || s.Strategy.ID == "" {
+		return nil, err
+	}
+	return s.Strategy, nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// ActionResult represents the result of an apply or abort operation.
+type ActionResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Stage defines the data associated to a single stage of a strategy phase.
+type Stage struct {
+	StageID    int    `json:"stage-id"`
+	StageName  string `json:"stage-name"`
+	Result     string `json:"result"`
+	Reason     string `json:"reason"`
+	Inprogress bool   `json:"inprogress"`
+}
+
+// Phase defines the data associated to a single phase of a strategy.
+type Phase struct {
+	PhaseName            string  `json:"phase-name"`
+	CompletionPercentage int     `json:"completion-percentage"`
+	TotalStages          int     `json:"total-stages"`
+	CurrentStage         int     `json:"current-stage"`
+	Result               string  `json:"result"`
+	Reason               string  `json:"reason"`
+	Inprogress           bool    `json:"inprogress"`
+	Stages               []Stage `json:"stages"`
+}
+
+// Strategy defines the data associated to a single strategy instance.
+type Strategy struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// Name defines the type of the strategy.
+	Name string `json:"name"`
+
+	// ControllerApplyType defines the apply type for controller hosts.
+	ControllerApplyType string `json:"controller-apply-type"`
+
+	// StorageApplyType defines the apply type for storage hosts.
+	StorageApplyType string `json:"storage-apply-type"`
+
+	// WorkerApplyType defines the apply type for worker hosts.
+	WorkerApplyType string `json:"worker-apply-type"`
+
+	// MaxParallelWorkerHosts defines the maximum number of worker hosts
+	// updated in parallel.
+	MaxParallelWorkerHosts int `json:"max-parallel-worker-hosts"`
+
+	// DefaultInstanceAction defines the action applied to instances.
+	DefaultInstanceAction string `json:"default-instance-action"`
+
+	// AlarmRestrictions defines the strictness of alarm checks.
+	AlarmRestrictions string `json:"alarm-restrictions"`
+
+	// State defines the current state of the strategy.
+	State string `json:"state"`
+
+	// CurrentPhase defines the name of the phase currently running.
+	CurrentPhase string `json:"current-phase"`
+
+	// CurrentPhaseCompletionPercentage defines the completion percentage
+	// of the phase currently running.
+	CurrentPhaseCompletionPercentage int `json:"current-phase-completion-percentage"`
+
+	// BuildPhase defines the progress of the build phase.
+	BuildPhase Phase `json:"build-phase"`
+
+	// ApplyPhase defines the progress of the apply phase.
+	ApplyPhase Phase `json:"apply-phase"`
+
+	// AbortPhase defines the progress of the abort phase.
+	AbortPhase Phase `json:"abort-phase"`
+}
+
+// Phase returns the phase currently running or the last phase that ran.
+func (in *Strategy) Phase() *Phase {
+	switch in.CurrentPhase {
+	case "abort":
+		return &in.AbortPhase
+	case "apply":
+		return &in.ApplyPhase
+	default:
+		return &in.BuildPhase
+	}
+}
+
+// InProgress determines whether the strategy is currently being built,
+// applied or aborted.
+func (in *Strategy) InProgress() bool {
+	switch in.State {
+	case StateInitial, StateBuilding, StateApplying, StateAborting:
+		return true
+	}
+
+	return false
+}
+
+// Failed determines whether the strategy has reached a failed state.
+func (in *Strategy) Failed() bool {
+	switch in.State {
+	case StateBuildFailed, StateBuildTimeout, StateApplyFailed, StateApplyTimeout,
+		StateAbortFailed, StateAbortTimeout, StateAborted:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,26 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package strategies
+
+import "github.com/gophercloud/gophercloud"
+
+func rootURL(c *gophercloud.ServiceClient, strategyType string) string {
+	return c.ServiceURL("api", "orchestration", strategyType, "strategy")
+}
+
+func getURL(c *gophercloud.ServiceClient, strategyType string) string {
+	return rootURL(c, strategyType)
+}
+
+func createURL(c *gophercloud.ServiceClient, strategyType string) string {
+	return rootURL(c, strategyType)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, strategyType string) string {
+	return rootURL(c, strategyType)
+}
+
+func actionURL(c *gophercloud.ServiceClient, strategyType string) string {
+	return c.ServiceURL("api", "orchestration", strategyType, "strategy", "actions")
+}
@@ -0,0 +1,10 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package subclouds contains functionality for working with Distributed Cloud
+// subcloud resources thru the dcmanager API.  This includes adding a subcloud
+// using the phased deployment API, running each deployment phase (i.e.,
+// install, bootstrap, configure), querying the deployment status, changing the
+// management state, backing up a subcloud, restoring a subcloud from a backup
+// and deleting a subcloud.
+package subclouds
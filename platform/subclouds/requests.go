@@ -0,0 +1,341 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package subclouds
+
+import (
+	"bytes"
+	"encoding/base64"
+	"mime/multipart"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the deployment phases which can be requested on a subcloud.
+const (
+	PhaseInstall   = "install"
+	PhaseBootstrap = "bootstrap"
+	PhaseConfigure = "configure"
+	PhaseComplete  = "complete"
+)
+
+// Defines the subcloud management states.
+const (
+	ManagementManaged   = "managed"
+	ManagementUnmanaged = "unmanaged"
+)
+
+// SubcloudOpts defines the attributes used to add a new subcloud.  The
+// bootstrap values are required; the install values and deploy config are
+// only needed if the corresponding phases are to be run.
+type SubcloudOpts struct {
+	Name             string
+	BootstrapAddress string
+	BootstrapValues  []byte
+	InstallValues    []byte
+	DeployConfig     []byte
+	BMCPassword      *string
+	Release          *string
+	Description      *string
+	Location         *string
+}
+
+// PhaseOpts defines the attributes of a deployment phase request.  The
+// sysadmin password is required by every phase except completion.
+type PhaseOpts struct {
+	SysadminPassword *string
+	BMCPassword      *string
+	InstallValues    []byte
+	DeployConfig     []byte
+	Release          *string
+}
+
+// SubcloudUpdateOpts defines the attributes which can be updated on an
+// existing subcloud.
+type SubcloudUpdateOpts struct {
+	ManagementState *string
+	Description     *string
+	Location        *string
+}
+
+// encodePassword encodes a password as expected by the dcmanager API.
+func encodePassword(password *string) *string {
+	if password == nil {
+		return nil
+	}
+
+	value := base64.StdEncoding.EncodeToString([]byte(*password))
+	return &value
+}
+
+// toMultipart formats a set of fields and files into a multipart form body as
+// expected by the dcmanager API.  Unset fields and empty files are omitted.
+func toMultipart(fields map[string]*string, files map[string][]byte) (*bytes.Buffer, string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	for key, value := range fields {
+		if value == nil {
+			continue
+		}
+
+		err := writer.WriteField(key, *value)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	for key, content := range files {
+		if len(content) == 0 {
+			continue
+		}
+
+		part, err := writer.CreateFormFile(key, key+".yaml")
+		if err != nil {
+			return nil, "", err
+		}
+
+		_, err = part.Write(content)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	err := writer.Close()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, writer.FormDataContentType(), nil
+}
+
+// RestoreOpts defines the attributes of a request to restore a subcloud from
+// one of its platform backups.  The subcloud must be unmanaged.
+type RestoreOpts struct {
+	Subcloud         string
+	SysadminPassword *string
+	RestoreValues    []byte
+	WithInstall      bool
+	LocalOnly        bool
+	RegistryImages   bool
+	Release          *string
+}
+
+// BackupOpts defines the attributes of a request to back up the platform of
+// a subcloud.  The subcloud must be managed, online and fully deployed.
+type BackupOpts struct {
+	Subcloud         string
+	SysadminPassword *string
+	BackupValues     []byte
+	LocalOnly        bool
+	RegistryImages   bool
+}
+
+// ToMultipart formats the create options into a multipart form body.
+func (opts SubcloudOpts) ToMultipart() (*bytes.Buffer, string, error) {
+	fields := map[string]*string{
+		"name":              &opts.Name,
+		"bootstrap_address": &opts.BootstrapAddress,
+		"bmc_password":      encodePassword(opts.BMCPassword),
+		"release":           opts.Release,
+		"description":       opts.Description,
+		"location":          opts.Location,
+	}
+
+	files := map[string][]byte{
+		"bootstrap_values": opts.BootstrapValues,
+		"install_values":   opts.InstallValues,
+		"deploy_config":    opts.DeployConfig,
+	}
+
+	return toMultipart(fields, files)
+}
+
+// ToMultipart formats the phase options into a multipart form body.
+func (opts PhaseOpts) ToMultipart() (*bytes.Buffer, string, error) {
+	fields := map[string]*string{
+		"sysadmin_password": encodePassword(opts.SysadminPassword),
+		"bmc_password":      encodePassword(opts.BMCPassword),
+		"release":           opts.Release,
+	}
+
+	files := map[string][]byte{
+		"install_values": opts.InstallValues,
+		"deploy_config":  opts.DeployConfig,
+	}
+
+	return toMultipart(fields, files)
+}
+
+// ToMultipart formats the update options into a multipart form body.
+func (opts SubcloudUpdateOpts) ToMultipart() (*bytes.Buffer, string, error) {
+	fields := map[string]*string{
+		"management-state": opts.ManagementState,
+		"description":      opts.Description,
+		"location":         opts.Location,
+	}
+
+	return toMultipart(fields, nil)
+}
+
+// ToMultipart formats the restore options into a multipart form body.
+func (opts RestoreOpts) ToMultipart() (*bytes.Buffer, string, error) {
+	withInstall := strconv.FormatBool(opts.WithInstall)
+	localOnly := strconv.FormatBool(opts.LocalOnly)
+	registryImages := strconv.FormatBool(opts.RegistryImages)
+
+	fields := map[string]*string{
+		"subcloud":          &opts.Subcloud,
+		"sysadmin_password": encodePassword(opts.SysadminPassword),
+		"with_install":      &withInstall,
+		"local_only":        &localOnly,
+		"registry_images":   &registryImages,
+		"release":           opts.Release,
+	}
+
+	files := map[string][]byte{
+		"restore_values": opts.RestoreValues,
+	}
+
+	return toMultipart(fields, files)
+}
+
+// ToMultipart formats the backup options into a multipart form body.
+func (opts BackupOpts) ToMultipart() (*bytes.Buffer, string, error) {
+	localOnly := strconv.FormatBool(opts.LocalOnly)
+	registryImages := strconv.FormatBool(opts.RegistryImages)
+
+	fields := map[string]*string{
+		"subcloud":          &opts.Subcloud,
+		"sysadmin_password": encodePassword(opts.SysadminPassword),
+		"local_only":        &localOnly,
+		"registry_images":   &registryImages,
+	}
+
+	files := map[string][]byte{
+		"backup_values": opts.BackupValues,
+	}
+
+	return toMultipart(fields, files)
+}
+
+// Get retrieves a specific subcloud based on its name.
+func Get(c *gophercloud.ServiceClient, name string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, name), &r.Body, nil)
+	return r
+}
+
+// Create adds a new subcloud using the attributes provided.  No deployment
+// phase is run until explicitly requested.
+func Create(c *gophercloud.ServiceClient, opts SubcloudOpts) (r CreateResult) {
+	body, contentType, err := opts.ToMultipart()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Request("POST", createURL(c), &gophercloud.RequestOpts{
+		RawBody:      body,
+		JSONResponse: &r.Body,
+		MoreHeaders:  map[string]string{"Content-Type": contentType},
+		OkCodes:      []int{200, 201, 202},
+	})
+
+	return r
+}
+
+// Deploy requests that a deployment phase be run on an existing subcloud.
+func Deploy(c *gophercloud.ServiceClient, name string, phase string, opts PhaseOpts) (r DeployResult) {
+	body, contentType, err := opts.ToMultipart()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Request("PATCH", phaseURL(c, name, phase), &gophercloud.RequestOpts{
+		RawBody:      body,
+		JSONResponse: &r.Body,
+		MoreHeaders:  map[string]string{"Content-Type": contentType},
+		OkCodes:      []int{200, 202},
+	})
+
+	return r
+}
+
+// Update modifies the attributes of an existing subcloud.
+func Update(c *gophercloud.ServiceClient, name string, opts SubcloudUpdateOpts) (r UpdateResult) {
+	body, contentType, err := opts.ToMultipart()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Request("PATCH", updateURL(c, name), &gophercloud.RequestOpts{
+		RawBody:      body,
+		JSONResponse: &r.Body,
+		MoreHeaders:  map[string]string{"Content-Type": contentType},
+		OkCodes:      []int{200},
+	})
+
+	return r
+}
+
+// Restore requests that a subcloud be restored from its platform backup.  The
+// restore runs in the background and is tracked thru the deployment status of
+// the subcloud.
+func Restore(c *gophercloud.ServiceClient, opts RestoreOpts) (r RestoreResult) {
+	body, contentType, err := opts.ToMultipart()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Request("PATCH", restoreURL(c), &gophercloud.RequestOpts{
+		RawBody:     body,
+		MoreHeaders: map[string]string{"Content-Type": contentType},
+		OkCodes:     []int{200, 202},
+	})
+
+	return r
+}
+
+// Backup requests that the platform of a subcloud be backed up.  The backup
+// runs in the background and is tracked thru the backup status of the
+// subcloud.
+func Backup(c *gophercloud.ServiceClient, opts BackupOpts) (r BackupResult) {
+	body, contentType, err := opts.ToMultipart()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Request("POST", backupURL(c), &gophercloud.RequestOpts{
+		RawBody:     body,
+		MoreHeaders: map[string]string{"Content-Type": contentType},
+		OkCodes:     []int{200, 202},
+	})
+
+	return r
+}
+
+// Delete accepts a subcloud name and deletes the subcloud associated with it.
+// The subcloud must be unmanaged and offline.
+func Delete(c *gophercloud.ServiceClient, name string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, name), nil)
+	return r
+}
+
+// GetSubcloud is a convenience function to retrieve and extract a subcloud.
+// A nil subcloud is returned if it does not exist.
+func GetSubcloud(c *gophercloud.ServiceClient, name string) (*Subcloud, error) {
+	subcloud, err := Get(c, name).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return subcloud, nil
+}
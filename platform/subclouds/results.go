@@ -0,0 +1,199 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package subclouds
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the subcloud deployment states reported by dcmanager.
+const (
+	DeployCreating           = "creating"
+	DeployCreateFailed       = "create-failed"
+	DeployCreated            = "create-complete"
+	DeployPreInstall         = "pre-install"
+	DeployPreInstallFailed   = "pre-install-failed"
+	DeployInstalling         = "installing"
+	DeployInstallFailed      = "install-failed"
+	DeployInstalled          = "install-complete"
+	DeployPreBootstrap       = "pre-bootstrap"
+	DeployPreBootstrapFailed = "pre-bootstrap-failed"
+	DeployBootstrapping      = "bootstrapping"
+	DeployBootstrapFailed    = "bootstrap-failed"
+	DeployBootstrapped       = "bootstrap-complete"
+	DeployPreConfig          = "pre-config"
+	DeployPreConfigFailed    = "pre-config-failed"
+	DeployConfiguring        = "configuring"
+	DeployConfigFailed       = "config-failed"
+	DeployConfigured         = "config-complete"
+	DeployComplete           = "complete"
+	DeployAbortingInstall    = "aborting-install"
+	DeployInstallAborted     = "install-aborted"
+	DeployAbortingBootstrap  = "aborting-bootstrap"
+	DeployBootstrapAborted   = "bootstrap-aborted"
+	DeployAbortingConfig     = "aborting-config"
+	DeployConfigAborted      = "config-aborted"
+	DeployPreRestore         = "pre-restore"
+	DeployRestorePrepFailed  = "restore-prep-failed"
+	DeployRestoring          = "restoring"
+	DeployRestoreFailed      = "restore-failed"
+)
+
+// Defines the subcloud backup states reported by dcmanager.
+const (
+	BackupStatusValidating      = "validating"
+	BackupStatusValidateFailed  = "validate-failed"
+	BackupStatusPreBackup       = "pre-backup"
+	BackupStatusPrepFailed      = "prep-failed"
+	BackupStatusBackingUp       = "backing-up"
+	BackupStatusFailed          = "failed"
+	BackupStatusCompleteCentral = "complete-central"
+	BackupStatusCompleteLocal   = "complete-local"
+)
+
+// Defines the subcloud availability states.
+const (
+	AvailabilityOnline  = "online"
+	AvailabilityOffline = "offline"
+)
+
+// Extract interprets any commonResult as a Subcloud.
+func (r commonResult) Extract() (*Subcloud, error) {
+	var s Subcloud
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// DeployResult represents the result of a deployment phase operation.
+type DeployResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// RestoreResult represents the result of a restore operation.
+type RestoreResult struct {
+	gophercloud.ErrResult
+}
+
+// BackupResult represents the result of a backup operation.
+type BackupResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Subcloud defines the data associated to a single subcloud instance.
+type Subcloud struct {
+	// ID defines the system assigned unique identifier.
+	ID int `json:"id"`
+
+	// Name defines the name of the subcloud.
+	Name string `json:"name"`
+
+	// Description defines the description of the subcloud.
+	Description string `json:"description"`
+
+	// Location defines the location of the subcloud.
+	Location string `json:"location"`
+
+	// SoftwareVersion defines the release installed on the subcloud.
+	SoftwareVersion string `json:"software-version"`
+
+	// ManagementState defines whether the subcloud is managed.
+	ManagementState string `json:"management-state"`
+
+	// AvailabilityStatus defines whether the subcloud is reachable.
+	AvailabilityStatus string `json:"availability-status"`
+
+	// DeployStatus defines the current deployment state.
+	DeployStatus string `json:"deploy-status"`
+
+	// BackupStatus defines the current backup state.
+	BackupStatus string `json:"backup-status"`
+
+	// BackupDatetime defines when the last successful backup was taken.
+	BackupDatetime string `json:"backup-datetime"`
+
+	// ErrorDescription defines the reason reported for the last failure.
+	ErrorDescription string `json:"error-description"`
+
+	// SyncStatus defines the overall synchronization state of the subcloud.
+	SyncStatus string `json:"sync-status"`
+}
+
+// Failed determines whether the last deployment phase of the subcloud has
+// failed or was aborted.
+func (in *Subcloud) Failed() bool {
+	return strings.HasSuffix(in.DeployStatus, "-failed") ||
+		strings.HasSuffix(in.DeployStatus, "-aborted")
+}
+
+// InProgress determines whether a deployment phase is currently running on
+// the subcloud.
+func (in *Subcloud) InProgress() bool {
+	switch in.DeployStatus {
+	case DeployCreating, DeployPreInstall, DeployInstalling,
+		DeployPreBootstrap, DeployBootstrapping, DeployPreConfig,
+		DeployConfiguring, DeployAbortingInstall, DeployAbortingBootstrap,
+		DeployAbortingConfig, DeployPreRestore, DeployRestoring:
+		return true
+	}
+
+	return false
+}
+
+// BackupInProgress determines whether a backup is currently running on the
+// subcloud.
+func (in *Subcloud) BackupInProgress() bool {
+	switch in.BackupStatus {
+	case BackupStatusValidating, BackupStatusPreBackup, BackupStatusBackingUp:
+		return true
+	}
+
+	return false
+}
+
+// BackupFailed determines whether the last backup of the subcloud has failed.
+func (in *Subcloud) BackupFailed() bool {
+	switch in.BackupStatus {
+	case BackupStatusValidateFailed, BackupStatusPrepFailed, BackupStatusFailed:
+		return true
+	}
+
+	return false
+}
+
+// BackupComplete determines whether the last backup of the subcloud has
+// completed.
+func (in *Subcloud) BackupComplete() bool {
+	switch in.BackupStatus {
+	case BackupStatusCompleteCentral, BackupStatusCompleteLocal:
+		return true
+	}
+
+	return false
+}
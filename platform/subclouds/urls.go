@@ -0,0 +1,38 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package subclouds
+
+import "github.com/gophercloud/gophercloud"
+
+func resourceURL(c *gophercloud.ServiceClient, name string) string {
+	return c.ServiceURL("subclouds", name)
+}
+
+func getURL(c *gophercloud.ServiceClient, name string) string {
+	return resourceURL(c, name)
+}
+
+func updateURL(c *gophercloud.ServiceClient, name string) string {
+	return resourceURL(c, name)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, name string) string {
+	return resourceURL(c, name)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("phased-subcloud-deploy")
+}
+
+func phaseURL(c *gophercloud.ServiceClient, name string, phase string) string {
+	return c.ServiceURL("phased-subcloud-deploy", name, phase)
+}
+
+func restoreURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("subcloud-backup", "restore")
+}
+
+func backupURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("subcloud-backup")
+}
@@ -0,0 +1,7 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+// Package systemdetails contains functionality for working with the attributes
+// of a System Inventory system which are not exposed by the system package,
+// such as its timezone.
+package systemdetails
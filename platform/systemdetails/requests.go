@@ -0,0 +1,38 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package systemdetails
+
+import (
+	"github.com/gophercloud/gophercloud"
+	common "github.com/gophercloud/gophercloud/starlingx"
+)
+
+// SystemDetailsOpts defines the attributes of a system that can be modified
+// through this package.
+type SystemDetailsOpts struct {
+	Timezone *string `json:"timezone,omitempty" mapstructure:"timezone"`
+}
+
+// Get retrieves the details of a specific system based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return r
+}
+
+// Update accepts a SystemDetailsOpts struct and updates an existing system
+// using the values provided.
+func Update(c *gophercloud.ServiceClient, id string, opts SystemDetailsOpts) (r UpdateResult) {
+	reqBody, err := common.ConvertToPatchMap(opts, common.ReplaceOp)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	// Send request to API
+	_, r.Err = c.Patch(updateURL(c, id), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return r
+}
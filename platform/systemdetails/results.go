@@ -0,0 +1,38 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package systemdetails
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Extract interprets any commonResult as a SystemDetails.
+func (r commonResult) Extract() (*SystemDetails, error) {
+	var s SystemDetails
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// SystemDetails defines the additional attributes associated to a system.
+type SystemDetails struct {
+	// ID defines the system assigned unique UUID value.
+	ID string `json:"uuid"`
+
+	// Timezone defines the timezone configured on the system.
+	Timezone string `json:"timezone"`
+}
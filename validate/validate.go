@@ -0,0 +1,574 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	starlingxv2 "github.com/wind-river/cloud-platform-deployment-manager/api/v2"
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/controllers/manager"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Defines the severity of the problems reported while validating a bundle.
+// Errors make a bundle invalid while warnings report references which can
+// only be resolved against the target system.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Defines the kinds of the resources which are referenced by other resources.
+const (
+	kindHost         = "Host"
+	kindHostProfile  = "HostProfile"
+	kindDataNetwork  = "DataNetwork"
+	kindPtpInstance  = "PtpInstance"
+	kindPtpInterface = "PtpInterface"
+	kindSecret       = "Secret"
+)
+
+// Problem defines a single issue found while validating a bundle along with
+// the location of the document and the path of the field which caused it.
+type Problem struct {
+	File     string
+	Line     int
+	Severity string
+	Kind     string
+	Name     string
+	Path     string
+	Message  string
+}
+
+func (in Problem) String() string {
+	location := in.File
+	if in.Line > 0 {
+		location = fmt.Sprintf("%s:%d", in.File, in.Line)
+	}
+
+	object := ""
+	if in.Kind != "" {
+		object = fmt.Sprintf(" %s/%s:", in.Kind, in.Name)
+	}
+
+	path := ""
+	if in.Path != "" {
+		path = fmt.Sprintf(" %s:", in.Path)
+	}
+
+	return fmt.Sprintf("%s: %s:%s%s %s", location, in.Severity, object, path, in.Message)
+}
+
+// Document defines a single YAML document of a bundle and the resource
+// decoded from it.  The object is nil if the document could not be decoded.
+type Document struct {
+	File      string
+	Line      int
+	Kind      string
+	Namespace string
+	Name      string
+	Object    runtime.Object
+}
+
+// Bundle defines a set of deployment resources loaded from one or more YAML
+// files which are validated together so that the references between them
+// can be resolved without access to a cluster.
+type Bundle struct {
+	Documents []*Document
+	problems  []Problem
+	scheme    *runtime.Scheme
+	decoder   runtime.Decoder
+}
+
+// NewBundle returns a new empty bundle.
+func NewBundle() *Bundle {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = starlingxv1.AddToScheme(scheme)
+	_ = starlingxv2.AddToScheme(scheme)
+
+	// Strict decoding rejects unknown and duplicate fields which would
+	// otherwise be silently dropped when the resources are applied.
+	codecs := serializer.NewCodecFactory(scheme, serializer.EnableStrict)
+
+	return &Bundle{
+		Documents: make([]*Document, 0),
+		problems:  make([]Problem, 0),
+		scheme:    scheme,
+		decoder:   codecs.UniversalDeserializer(),
+	}
+}
+
+// report records a problem against a document.
+func (b *Bundle) report(d *Document, severity, path, format string, args ...interface{}) {
+	b.problems = append(b.problems, Problem{
+		File:     d.File,
+		Line:     d.Line,
+		Severity: severity,
+		Kind:     d.Kind,
+		Name:     d.Name,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// chunk defines the raw contents of a YAML document and the line on which
+// its content starts.
+type chunk struct {
+	line int
+	data string
+}
+
+// splitDocuments splits a multi-document YAML stream on its document
+// separators.  Documents which only contain comments are dropped.
+func splitDocuments(data string) []chunk {
+	result := make([]chunk, 0)
+	lines := strings.Split(data, "\n")
+
+	current := make([]string, 0)
+	start := 0
+	flush := func() {
+		if start > 0 {
+			result = append(result, chunk{line: start, data: strings.Join(current, "\n")})
+		}
+		current = make([]string, 0)
+		start = 0
+	}
+
+	for i, line := range lines {
+		if strings.HasPrefix(line, "---") {
+			rest := strings.TrimSpace(line[3:])
+			if rest == "" || strings.HasPrefix(rest, "#") {
+				flush()
+				continue
+			}
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if start == 0 && trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+			start = i + 1
+		}
+
+		current = append(current, line)
+	}
+	flush()
+
+	return result
+}
+
+// Load decodes each document of a YAML stream into the bundle.  Documents
+// which cannot be decoded are reported as problems rather than returned as
+// errors so that every document of the stream is examined.
+func (b *Bundle) Load(file string, data []byte) {
+	for _, c := range splitDocuments(string(data)) {
+		d := &Document{File: file, Line: c.line}
+		b.Documents = append(b.Documents, d)
+
+		header := struct {
+			metav1.TypeMeta   `json:",inline"`
+			metav1.ObjectMeta `json:"metadata,omitempty"`
+		}{}
+
+		err := yaml.Unmarshal([]byte(c.data), &header)
+		if err != nil {
+			b.report(d, SeverityError, "", "invalid YAML: %s", err.Error())
+			continue
+		}
+
+		d.Kind = header.Kind
+		d.Namespace = header.Namespace
+		d.Name = header.Name
+
+		if header.APIVersion == "" || header.Kind == "" {
+			b.report(d, SeverityError, "", "document must specify both an apiVersion and a kind")
+			continue
+		} else if header.Name == "" {
+			b.report(d, SeverityError, "$.metadata.name", "resource must have a name")
+		}
+
+		obj, _, err := b.decoder.Decode([]byte(c.data), nil, nil)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				b.report(d, SeverityWarning, "", "%s %s is not a known resource type and was not validated",
+					header.APIVersion, header.Kind)
+			} else {
+				b.report(d, SeverityError, "", "does not match the resource schema: %s", err.Error())
+			}
+			continue
+		}
+
+		// Resources written against a newer API version are validated in
+		// the version used by the webhooks and the reconcilers.
+		if convertible, ok := obj.(conversion.Convertible); ok {
+			hub, err := b.scheme.New(starlingxv1.GroupVersion.WithKind(header.Kind))
+			if err == nil {
+				err = convertible.ConvertTo(hub.(conversion.Hub))
+			}
+			if err != nil {
+				b.report(d, SeverityError, "", "unable to convert to %s: %s", starlingxv1.GroupVersion, err.Error())
+				continue
+			}
+			obj = hub
+		}
+
+		d.Object = obj
+	}
+}
+
+// index defines the names of the resources of each kind within a namespace.
+type index map[string]map[string]*Document
+
+// buildIndex organizes the documents by namespace and kind and reports any
+// resource which is defined more than once.  Documents which could not be
+// decoded are still included so that references to them are not reported on
+// top of their own problems.
+func (b *Bundle) buildIndex() map[string]index {
+	result := make(map[string]index)
+
+	for _, d := range b.Documents {
+		if d.Kind == "" || d.Name == "" {
+			continue
+		}
+
+		namespace, ok := result[d.Namespace]
+		if !ok {
+			namespace = make(index)
+			result[d.Namespace] = namespace
+		}
+
+		kind, ok := namespace[d.Kind]
+		if !ok {
+			kind = make(map[string]*Document)
+			namespace[d.Kind] = kind
+		}
+
+		if previous, ok := kind[d.Name]; ok {
+			b.report(d, SeverityError, "$.metadata.name", "resource is already defined at %s:%d",
+				previous.File, previous.Line)
+			continue
+		}
+
+		kind[d.Name] = d
+	}
+
+	return result
+}
+
+// has determines whether a resource of a given kind is defined.
+func (in index) has(kind, name string) bool {
+	_, ok := in[kind][name]
+	return ok
+}
+
+// validateSchema runs the validation which the admission webhooks apply to
+// each resource.  The webhooks are run without access to a cluster therefore
+// the checks which depend on other resources are replaced by the reference
+// checks of the bundle.
+func (b *Bundle) validateSchema(d *Document) {
+	obj := d.Object
+	if system, ok := obj.(*starlingxv1.System); ok && system.Spec.Certificates != nil {
+		// The webhook waits for the certificate secrets to appear in the
+		// cluster; they are checked as references instead.
+		system = system.DeepCopy()
+		system.Spec.Certificates = nil
+		obj = system
+	}
+
+	validator, ok := obj.(webhook.Validator)
+	if !ok {
+		return
+	}
+
+	err := validator.ValidateCreate()
+	if err != nil {
+		b.report(d, SeverityError, "$.spec", "%s", err.Error())
+	}
+}
+
+// poolNames returns the names of the address pools created for the platform
+// networks of a namespace.
+func poolNames(namespace index) map[string]bool {
+	result := make(map[string]bool)
+
+	for _, d := range namespace["PlatformNetwork"] {
+		network, ok := d.Object.(*starlingxv1.PlatformNetwork)
+		if !ok {
+			continue
+		}
+
+		name := network.Name
+		if network.Spec.Type == manager.MgmtNetworkType {
+			name = manager.MgmtAddrPoolName
+		}
+		result[name] = true
+
+		if network.Spec.SecondaryPool != nil {
+			family := "ipv6"
+			if common.IsIPv4(network.Spec.SecondaryPool.Subnet) {
+				family = "ipv4"
+			}
+			result[fmt.Sprintf("%s-%s", name, family)] = true
+		}
+	}
+
+	return result
+}
+
+// checkProfileReferences ensures that the resources referenced by the
+// attributes of a profile, or of the overrides of a host, are defined in the
+// bundle.  Address pools are only reported as warnings since the pools
+// created when the system is installed are not part of a deployment.
+func (b *Bundle) checkProfileReferences(d *Document, namespace index, pools map[string]bool, spec *starlingxv1.HostProfileSpec, prefix string) {
+	check := func(kind, name, path string) {
+		if !namespace.has(kind, name) {
+			b.report(d, SeverityError, path, "references undefined %s %q", kind, name)
+		}
+	}
+
+	checkPool := func(pool *string, path string) {
+		if pool != nil && !pools[*pool] {
+			b.report(d, SeverityWarning, path,
+				"references address pool %q which is not defined by any platform network; it must already exist on the system", *pool)
+		}
+	}
+
+	checkInterface := func(path string, info starlingxv1.CommonInterfaceInfo) {
+		if info.DataNetworks != nil {
+			for _, name := range starlingxv1.DataNetworkItemListToStrings(*info.DataNetworks) {
+				check(kindDataNetwork, name, path+".dataNetworks")
+			}
+		}
+
+		if info.PtpInterfaces != nil {
+			for _, name := range starlingxv1.PtpInterfaceItemListToStrings(*info.PtpInterfaces) {
+				check(kindPtpInterface, name, path+".ptpInterfaces")
+			}
+		}
+
+		checkPool(info.IPv4Pool, path+".ipv4Pool")
+		checkPool(info.IPv6Pool, path+".ipv6Pool")
+	}
+
+	for _, name := range spec.PtpInstances {
+		check(kindPtpInstance, string(name), prefix+".ptpInstances")
+	}
+
+	if bm := spec.BoardManagement; bm != nil && bm.Credentials != nil && bm.Credentials.Password != nil {
+		check(kindSecret, bm.Credentials.Password.Secret, prefix+".boardManagement.credentials.password.secret")
+	}
+
+	if spec.Interfaces == nil {
+		return
+	}
+
+	for _, e := range spec.Interfaces.Ethernet {
+		checkInterface(fmt.Sprintf("%s.interfaces.ethernet[name=%s]", prefix, e.Name), e.CommonInterfaceInfo)
+	}
+
+	for _, bond := range spec.Interfaces.Bond {
+		checkInterface(fmt.Sprintf("%s.interfaces.bond[name=%s]", prefix, bond.Name), bond.CommonInterfaceInfo)
+	}
+
+	for _, v := range spec.Interfaces.VLAN {
+		checkInterface(fmt.Sprintf("%s.interfaces.vlan[name=%s]", prefix, v.Name), v.CommonInterfaceInfo)
+	}
+
+	for _, vf := range spec.Interfaces.VF {
+		checkInterface(fmt.Sprintf("%s.interfaces.vf[name=%s]", prefix, vf.Name), vf.CommonInterfaceInfo)
+	}
+}
+
+// hasProfile determines whether a profile is defined in the bundle or is one
+// of the built-in profiles.
+func hasProfile(namespace index, name string) bool {
+	if namespace.has(kindHostProfile, name) {
+		return true
+	}
+
+	_, ok := starlingxv1.BuiltinHostProfile(name, "")
+	return ok
+}
+
+// checkReferences ensures that each resource referenced by a document is
+// defined in the bundle.
+func (b *Bundle) checkReferences(d *Document, namespace index, pools map[string]bool) {
+	switch obj := d.Object.(type) {
+	case *starlingxv1.HostProfile:
+		if obj.Spec.Base != nil && *obj.Spec.Base != "" && !hasProfile(namespace, *obj.Spec.Base) {
+			b.report(d, SeverityError, "$.spec.base", "references undefined %s %q", kindHostProfile, *obj.Spec.Base)
+		}
+
+		for _, name := range obj.Spec.Mixins {
+			if name != "" && !hasProfile(namespace, name) {
+				b.report(d, SeverityError, "$.spec.mixins", "references undefined %s %q", kindHostProfile, name)
+			}
+		}
+
+		b.checkProfileReferences(d, namespace, pools, &obj.Spec, "$.spec")
+
+	case *starlingxv1.Host:
+		if obj.Spec.Profile != "" && !hasProfile(namespace, obj.Spec.Profile) {
+			b.report(d, SeverityError, "$.spec.profile", "references undefined %s %q", kindHostProfile, obj.Spec.Profile)
+		}
+
+		if obj.Spec.CloneFrom != nil && !namespace.has(kindHost, *obj.Spec.CloneFrom) {
+			b.report(d, SeverityError, "$.spec.cloneFrom", "references undefined %s %q", kindHost, *obj.Spec.CloneFrom)
+		}
+
+		if obj.Spec.Overrides != nil {
+			b.checkProfileReferences(d, namespace, pools, obj.Spec.Overrides, "$.spec.overrides")
+		}
+
+	case *starlingxv1.PtpInterface:
+		if !namespace.has(kindPtpInstance, obj.Spec.PtpInstance) {
+			b.report(d, SeverityError, "$.spec.ptpinstance", "references undefined %s %q", kindPtpInstance, obj.Spec.PtpInstance)
+		}
+
+	case *starlingxv1.System:
+		if obj.Spec.Certificates != nil {
+			for _, c := range *obj.Spec.Certificates {
+				// The same certificates are exempt from the webhook since
+				// they are installed before the system is reconciled.
+				if c.Type == starlingxv1.OpenstackCACertificate || c.Type == starlingxv1.OpenLDAPCertificate ||
+					c.Type == starlingxv1.DockerCertificate || c.Type == starlingxv1.PlatformCertificate {
+					continue
+				}
+
+				if !namespace.has(kindSecret, c.Secret) {
+					b.report(d, SeverityError, fmt.Sprintf("$.spec.certificates[type=%s].secret", c.Type),
+						"references undefined %s %q", kindSecret, c.Secret)
+				}
+			}
+		}
+
+		if obj.Spec.License != nil && !namespace.has(kindSecret, obj.Spec.License.Secret) {
+			b.report(d, SeverityError, "$.spec.license.secret", "references undefined %s %q", kindSecret, obj.Spec.License.Secret)
+		}
+	}
+}
+
+// profileLookup returns a function which retrieves profiles from a
+// namespace of the bundle or otherwise from the built-in profiles.
+func profileLookup(namespace index) starlingxv1.HostProfileLookup {
+	return func(name string) (*starlingxv1.HostProfileSpec, error) {
+		if d, ok := namespace[kindHostProfile][name]; ok {
+			if profile, ok := d.Object.(*starlingxv1.HostProfile); ok {
+				return &profile.Spec, nil
+			}
+		}
+
+		if builtin, ok := starlingxv1.BuiltinHostProfile(name, ""); ok {
+			return &builtin.Spec, nil
+		}
+
+		return nil, fmt.Errorf("profile %q is not defined", name)
+	}
+}
+
+// flattenProfiles flattens the hierarchy of each profile and of the profile
+// of each host.  Problems with the hierarchy itself are reported against the
+// profile which declares it; problems with the merged attributes are reported
+// against each host since they may depend on its overrides.
+func (b *Bundle) flattenProfiles(d *Document, namespace index) {
+	lookup := profileLookup(namespace)
+
+	switch obj := d.Object.(type) {
+	case *starlingxv1.HostProfile:
+		visited := map[string]bool{obj.Name: true}
+		_, err := starlingxv1.FlattenHostProfile(lookup, &obj.Spec, &starlingxv1.HostProfileSpec{}, visited)
+
+		var mergeErr starlingxv1.ProfileMergeError
+		if errors.As(err, &mergeErr) {
+			b.report(d, SeverityError, "$.spec", "unable to flatten profile: %s", err.Error())
+		}
+
+	case *starlingxv1.Host:
+		profile, err := lookup(obj.Spec.Profile)
+		if err != nil {
+			// Reported as an undefined reference.
+			return
+		}
+
+		visited := map[string]bool{obj.Spec.Profile: true}
+		composite, err := starlingxv1.FlattenHostProfile(lookup, profile, &starlingxv1.HostProfileSpec{}, visited)
+		if err != nil {
+			// Reported against the profile which is in error.
+			return
+		}
+
+		if obj.Spec.Overrides != nil {
+			composite, err = starlingxv1.MergeHostProfiles(composite, obj.Spec.Overrides.DeepCopy())
+			if err != nil {
+				b.report(d, SeverityError, "$.spec.overrides", "unable to merge overrides: %s", err.Error())
+				return
+			}
+		}
+
+		// The default attributes of the host are only known once it has
+		// been installed therefore the composite profile is incomplete and
+		// data networks have already been checked as references.
+		err = starlingxv1.ValidateCompositeProfile(composite, nil, nil, false)
+		if err != nil {
+			b.report(d, SeverityError, "$.spec.profile", "%s", err.Error())
+		}
+	}
+}
+
+// Validate examines every resource of the bundle and returns the problems
+// found, including those found while loading it, ordered by their location.
+func (b *Bundle) Validate() []Problem {
+	loaded := len(b.problems)
+	namespaces := b.buildIndex()
+
+	pools := make(map[string]map[string]bool)
+	for name, namespace := range namespaces {
+		pools[name] = poolNames(namespace)
+	}
+
+	for _, d := range b.Documents {
+		if d.Object == nil {
+			continue
+		}
+
+		namespace := namespaces[d.Namespace]
+		b.validateSchema(d)
+		b.checkReferences(d, namespace, pools[d.Namespace])
+		b.flattenProfiles(d, namespace)
+	}
+
+	result := make([]Problem, len(b.problems))
+	copy(result, b.problems)
+	b.problems = b.problems[:loaded]
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].File != result[j].File {
+			return result[i].File < result[j].File
+		}
+		return result[i].Line < result[j].Line
+	})
+
+	return result
+}
+
+// HasErrors determines whether any of a list of problems is an error.
+func HasErrors(problems []Problem) bool {
+	for _, p := range problems {
+		if p.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
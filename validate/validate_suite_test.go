@@ -0,0 +1,182 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2024 Wind River Systems, Inc. */
+
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestValidate(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Validate Suite")
+}
+
+const validBundle = `# A minimal deployment
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: bmc-secret
+  namespace: deployment
+type: kubernetes.io/basic-auth
+stringData:
+  username: admin
+  password: secret
+---
+apiVersion: starlingx.windriver.com/v1
+kind: DataNetwork
+metadata:
+  name: group0-data0
+  namespace: deployment
+spec:
+  type: vlan
+---
+apiVersion: starlingx.windriver.com/v1
+kind: PtpInstance
+metadata:
+  name: ptp1
+  namespace: deployment
+spec:
+  service: ptp4l
+---
+apiVersion: starlingx.windriver.com/v1
+kind: PtpInterface
+metadata:
+  name: ptp1if1
+  namespace: deployment
+spec:
+  ptpinstance: ptp1
+---
+apiVersion: starlingx.windriver.com/v1
+kind: HostProfile
+metadata:
+  name: controller-profile
+  namespace: deployment
+spec:
+  personality: controller
+  boardManagement:
+    credentials:
+      password:
+        secret: bmc-secret
+  interfaces:
+    ethernet:
+    - name: data0
+      class: data
+      port:
+        name: enp0s9
+      dataNetworks:
+      - group0-data0
+      ptpInterfaces:
+      - ptp1if1
+---
+apiVersion: starlingx.windriver.com/v1
+kind: Host
+metadata:
+  name: controller-0
+  namespace: deployment
+spec:
+  profile: controller-profile
+  overrides:
+    ptpInstances:
+    - ptp1
+`
+
+// messages returns the string form of each problem.
+func messages(problems []Problem) []string {
+	result := make([]string, 0, len(problems))
+	for _, p := range problems {
+		result = append(result, p.String())
+	}
+	return result
+}
+
+var _ = Describe("Bundle validation", func() {
+	Describe("splitDocuments", func() {
+		It("locates the first line of content of each document", func() {
+			chunks := splitDocuments("# comment\n---\napiVersion: v1\n---\n\n# only a comment\n--- # named\n\nkind: Secret\n")
+			Expect(chunks).To(HaveLen(2))
+			Expect(chunks[0].line).To(Equal(3))
+			Expect(chunks[1].line).To(Equal(9))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts a consistent bundle", func() {
+			b := NewBundle()
+			b.Load("bundle.yaml", []byte(validBundle))
+			Expect(messages(b.Validate())).To(BeEmpty())
+			Expect(b.Documents).To(HaveLen(6))
+		})
+
+		It("reports schema errors with their location", func() {
+			b := NewBundle()
+			b.Load("bundle.yaml", []byte(strings.Replace(validBundle, "  type: vlan", "  type: vlan\n  colour: blue", 1)))
+			problems := b.Validate()
+			Expect(problems).To(HaveLen(1))
+			Expect(problems[0].Line).To(Equal(13))
+			Expect(problems[0].Kind).To(Equal("DataNetwork"))
+			Expect(problems[0].Message).To(ContainSubstring("colour"))
+			Expect(HasErrors(problems)).To(BeTrue())
+		})
+
+		It("reports webhook validation errors", func() {
+			b := NewBundle()
+			b.Load("bundle.yaml", []byte(strings.Replace(validBundle, "  profile: controller-profile\n", "", 1)))
+			problems := b.Validate()
+			Expect(messages(problems)).To(ConsistOf(
+				"bundle.yaml:59: error: Host/controller-0: $.spec: host must specify a profile or a host to clone from, or its namespace must have the deployment-manager/default-host-profile annotation"))
+		})
+
+		It("reports undefined references", func() {
+			b := NewBundle()
+			bundle := strings.Replace(validBundle, "      - group0-data0", "      - group0-data1", 1)
+			bundle = strings.Replace(bundle, "  ptpinstance: ptp1", "  ptpinstance: ptp2", 1)
+			bundle = strings.Replace(bundle, "        secret: bmc-secret", "        secret: bmc-missing", 1)
+			b.Load("bundle.yaml", []byte(bundle))
+			Expect(messages(b.Validate())).To(ConsistOf(
+				"bundle.yaml:29: error: PtpInterface/ptp1if1: $.spec.ptpinstance: references undefined PtpInstance \"ptp2\"",
+				"bundle.yaml:37: error: HostProfile/controller-profile: $.spec.boardManagement.credentials.password.secret: references undefined Secret \"bmc-missing\"",
+				"bundle.yaml:37: error: HostProfile/controller-profile: $.spec.interfaces.ethernet[name=data0].dataNetworks: references undefined DataNetwork \"group0-data1\""))
+		})
+
+		It("reports undefined address pools as warnings", func() {
+			b := NewBundle()
+			b.Load("bundle.yaml", []byte(strings.Replace(validBundle, "      class: data\n", "      class: data\n      ipv4Mode: pool\n      ipv4Pool: pool0\n", 1)))
+			problems := b.Validate()
+			Expect(problems).To(HaveLen(1))
+			Expect(problems[0].Severity).To(Equal(SeverityWarning))
+			Expect(problems[0].Path).To(Equal("$.spec.interfaces.ethernet[name=data0].ipv4Pool"))
+			Expect(HasErrors(problems)).To(BeFalse())
+		})
+
+		It("reports profile loops and duplicate resources", func() {
+			b := NewBundle()
+			b.Load("bundle.yaml", []byte(strings.Replace(validBundle, "  personality: controller\n", "  personality: controller\n  base: controller-profile\n", 1)))
+			b.Load("extra.yaml", []byte("apiVersion: starlingx.windriver.com/v1\nkind: PtpInstance\nmetadata:\n  name: ptp1\n  namespace: deployment\nspec:\n  service: ptp4l\n"))
+			Expect(messages(b.Validate())).To(ConsistOf(
+				"bundle.yaml:37: error: HostProfile/controller-profile: $.spec: unable to flatten profile: profile loop detected at: controller-profile",
+				"extra.yaml:1: error: PtpInstance/ptp1: $.metadata.name: resource is already defined at bundle.yaml:21"))
+		})
+
+		It("validates resources written against the v2 API", func() {
+			b := NewBundle()
+			b.Load("system.yaml", []byte("apiVersion: starlingx.windriver.com/v2\nkind: System\nmetadata:\n  name: vbox\n  namespace: deployment\nspec:\n  deploymentScope: principal\n  license:\n    secret: license\n"))
+			Expect(messages(b.Validate())).To(ConsistOf(
+				"system.yaml:1: error: System/vbox: $.spec.license.secret: references undefined Secret \"license\""))
+			Expect(b.Documents[0].Object).To(BeAssignableToTypeOf(&starlingxv1.System{}))
+		})
+
+		It("resolves built-in profiles", func() {
+			b := NewBundle()
+			b.Load("bundle.yaml", []byte(strings.Replace(validBundle, "  personality: controller\n", "  base: builtin-standard-worker-v1\n", 1)))
+			Expect(messages(b.Validate())).To(BeEmpty())
+		})
+	})
+})